@@ -7,7 +7,7 @@ import { clearSession, createSession, getSession, getValidAccessToken } from "@/
 import type { ApiCurrency, ApiCountry, ApiRateSource } from "@/lib/exchange-rate-mapper";
 import { buildPairSnapshots, type ExchangeRateRowInput } from "@/lib/pair-snapshot";
 import type { PairSnapshot, Profile } from "@/types";
-import type { ActionState } from "@/lib/action-state";
+import type { ActionState, LoginActionState } from "@/lib/action-state";
 import type { AuthSession } from "@/types";
 
 const API_BASE_URL = "https://api.rate-pulse.me";
@@ -38,7 +38,161 @@ function readApiErrorMessage(error: unknown, fallback: string) {
   return fallback;
 }
 
-export async function loginAction(_: ActionState, formData: FormData): Promise<ActionState> {
+interface SignInResponse {
+  session_id: string;
+  access_token: string;
+  access_token_expires_at: string;
+  refresh_token: string;
+  refresh_token_expires_at: string;
+  recovery_codes?: string[];
+  user: {
+    user_id: number;
+    username: string;
+    email: string;
+    first_name: string;
+    last_name: string;
+    user_type?: string;
+    time_zone: string;
+    language_preference: string;
+    country_of_residence: string;
+    country_of_birth: string;
+  };
+}
+
+interface MFAChallengeResponse {
+  mfa_required: boolean;
+  mfa_enrollment_required: boolean;
+  mfa_token: string;
+}
+
+// sessionFromSignIn maps a sign-in response with tokens to the session cookie payload.
+function sessionFromSignIn(data: SignInResponse): AuthSession {
+  const user = data.user;
+  const userType = user.user_type ?? "free";
+
+  const profile: Profile = {
+    timeZone: user.time_zone,
+    languagePref: user.language_preference,
+    countryOfResidence: user.country_of_residence,
+    countryOfBirth: user.country_of_birth,
+  };
+
+  return {
+    userID: user.user_id,
+    email: user.email,
+    name: user.username,
+    firstName: user.first_name,
+    lastName: user.last_name,
+    role: toSessionRole(userType),
+    sessionId: data.session_id,
+    accessToken: data.access_token,
+    accessTokenExpiresAt: data.access_token_expires_at,
+    refreshToken: data.refresh_token,
+    refreshTokenExpiresAt: data.refresh_token_expires_at,
+    profile,
+  };
+}
+
+// startMFAStep moves the login form to the code step; admins without an authenticator app
+// enrol one first.
+async function startMFAStep(data: MFAChallengeResponse): Promise<LoginActionState> {
+  const mfaToken = data.mfa_token;
+
+  if (!data.mfa_enrollment_required) {
+    return {
+      status: "idle",
+      message: "Enter the 6-digit code from your authenticator app, or a recovery code.",
+      mfaToken,
+    };
+  }
+
+  const res = await fetch(`${API_BASE_URL}/users/signin/mfa/enroll`, {
+    method: "POST",
+    headers: { "Content-Type": "application/json" },
+    body: JSON.stringify({ mfa_token: mfaToken }),
+  });
+  if (!res.ok) {
+    const error = await res.json().catch(() => null);
+    return {
+      status: "error",
+      message: readApiErrorMessage(error, "Could not start two-factor enrolment. Please sign in again."),
+    };
+  }
+
+  const enrollment = await res.json();
+  return {
+    status: "idle",
+    message: "Admin accounts require two-factor authentication. Add this key to your authenticator app, then enter the 6-digit code it shows.",
+    mfaToken,
+    totpEnrollment: {
+      secret: enrollment.secret,
+      provisioningUri: enrollment.provisioning_uri,
+    },
+  };
+}
+
+async function completeMFAStep(state: LoginActionState, formData: FormData): Promise<LoginActionState> {
+  const mfaToken = String(formData.get("mfa_token") ?? "");
+  const code = String(formData.get("code") ?? "").trim();
+
+  if (!code) {
+    return { ...state, status: "error", message: "Enter the code from your authenticator app." };
+  }
+
+  const res = await fetch(`${API_BASE_URL}/users/signin/mfa`, {
+    method: "POST",
+    headers: { "Content-Type": "application/json" },
+    body: JSON.stringify({ mfa_token: mfaToken, code }),
+  });
+  if (!res.ok) {
+    const error = await res.json().catch(() => null);
+    // An expired or exhausted challenge cannot be retried; start over from the password step.
+    if (res.status === 401 && readApiErrorMessage(error, "") !== "invalid two-factor code") {
+      return { status: "error", message: "Your sign-in expired. Please sign in again." };
+    }
+    return {
+      ...state,
+      status: "error",
+      message: readApiErrorMessage(error, "Invalid two-factor code."),
+    };
+  }
+
+  const data: SignInResponse = await res.json();
+  const session = sessionFromSignIn(data);
+  await createSession(session);
+
+  const redirectTo = session.role === "admin" ? "/admin" : "/profile";
+  if (data.recovery_codes && data.recovery_codes.length > 0) {
+    return {
+      status: "success",
+      message: "Two-factor authentication is on. Save these recovery codes somewhere safe; each works once and they will not be shown again.",
+      recoveryCodes: data.recovery_codes,
+      redirectTo,
+    };
+  }
+
+  return { status: "success", message: "Signed in.", redirectTo };
+}
+
+export async function loginAction(state: LoginActionState, formData: FormData): Promise<LoginActionState> {
+  if (formData.get("mfa_token")) {
+    let result: LoginActionState;
+    try {
+      result = await completeMFAStep(state, formData);
+    } catch (err) {
+      return {
+        ...state,
+        status: "error",
+        message: err instanceof Error ? err.message : String(err),
+      };
+    }
+
+    if (result.status === "success" && !result.recoveryCodes) {
+      redirect(result.redirectTo ?? "/profile");
+    }
+    return result;
+  }
+
   const email = String(formData.get("email") ?? "").trim().toLowerCase();
   const password = String(formData.get("password") ?? "").trim();
   let redirectTo = "/profile";
@@ -69,31 +223,11 @@ export async function loginAction(_: ActionState, formData: FormData): Promise<A
     }
 
     const data = await res.json();
-    const user = data.user ?? data;
-    const userType = user.user_type ?? "free";
-
-    const profile: Profile = {
-      timeZone: user.time_zone,
-      languagePref: user.language_preference,
-      countryOfResidence: user.country_of_residence,
-      countryOfBirth: user.country_of_birth,
-    };
-
-    const session: AuthSession = {
-      userID: user.user_id,
-      email: user.email,
-      name: user.username,
-      firstName: user.first_name,
-      lastName: user.last_name,
-      role: toSessionRole(userType),
-      sessionId: data.session_id,
-      accessToken: data.access_token,
-      accessTokenExpiresAt: data.access_token_expires_at,
-      refreshToken: data.refresh_token,
-      refreshTokenExpiresAt: data.refresh_token_expires_at,
-      profile,
-    };
+    if (data.mfa_required) {
+      return await startMFAStep(data);
+    }
 
+    const session = sessionFromSignIn(data);
     await createSession(session);
     redirectTo = session.role === "admin" ? "/admin" : "/profile";
   } catch (err) {
@@ -164,6 +298,8 @@ export async function logoutAction() {
       method: "POST",
       headers: {
         "Content-Type": "application/json",
+        // The API revokes the access token sent along, so it stops working right away.
+        ...(session.accessToken && { Authorization: `Bearer ${session.accessToken}` }),
       },
       body: JSON.stringify({
         refresh_token: session.refreshToken,
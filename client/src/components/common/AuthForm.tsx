@@ -2,7 +2,7 @@
 
 import { useActionState } from "react";
 
-import type { ActionState } from "@/lib/action-state";
+import type { LoginActionState } from "@/lib/action-state";
 import { initialActionState } from "@/lib/action-state";
 
 import { Alert } from "@/components/ui/alert";
@@ -15,17 +15,91 @@ import { TextLink } from "@/components/ui/text-link";
 
 interface AuthFormProps {
   mode: "login" | "signup";
-  action: (state: ActionState, payload: FormData) => Promise<ActionState>;
+  action: (state: LoginActionState, payload: FormData) => Promise<LoginActionState>;
 }
 
 export function AuthForm({ mode, action }: AuthFormProps) {
-  const [state, formAction] = useActionState<ActionState, FormData>(
+  const [state, formAction] = useActionState<LoginActionState, FormData>(
     action,
     initialActionState,
   );
 
   const isSignup = mode === "signup";
 
+  if (state.recoveryCodes) {
+    return (
+      <Panel variant="glass" padding="lg">
+        <div className="mb-8">
+          <Text variant="overlineAccent">Two-factor authentication</Text>
+          <Heading level="h1" className="mt-3">
+            Save your recovery codes
+          </Heading>
+        </div>
+
+        <Alert className="border-status-success/30 bg-status-success/10 text-status-success">
+          {state.message}
+        </Alert>
+        <ul className="mt-4 grid grid-cols-2 gap-2 font-mono text-sm">
+          {state.recoveryCodes.map((code) => (
+            <li key={code}>{code}</li>
+          ))}
+        </ul>
+
+        <Text variant="muted" className="mt-6">
+          <TextLink href={state.redirectTo ?? "/profile"}>Continue</TextLink>
+        </Text>
+      </Panel>
+    );
+  }
+
+  if (state.mfaToken) {
+    return (
+      <Panel variant="glass" padding="lg">
+        <div className="mb-8">
+          <Text variant="overlineAccent">Two-factor authentication</Text>
+          <Heading level="h1" className="mt-3">
+            {state.totpEnrollment ? "Set up your authenticator app" : "Enter your code"}
+          </Heading>
+        </div>
+
+        <form action={formAction} className="space-y-4">
+          <input type="hidden" name="mfa_token" value={state.mfaToken} />
+
+          {state.totpEnrollment ? (
+            <div className="space-y-2 text-sm">
+              <Text variant="muted">Setup key</Text>
+              <p className="break-all font-mono">{state.totpEnrollment.secret}</p>
+              <Text variant="muted">Or open this link on the device with your authenticator app</Text>
+              <a className="break-all font-mono underline" href={state.totpEnrollment.provisioningUri}>
+                {state.totpEnrollment.provisioningUri}
+              </a>
+            </div>
+          ) : null}
+
+          <FieldLabel>
+            <FieldCaption>Code</FieldCaption>
+            <Input
+              required
+              name="code"
+              autoComplete="one-time-code"
+              placeholder={state.totpEnrollment ? "123456" : "123456 or a recovery code"}
+            />
+          </FieldLabel>
+
+          {state.status === "error" ? (
+            <Alert>{state.message}</Alert>
+          ) : (
+            <Text variant="muted">{state.message}</Text>
+          )}
+
+          <SubmitButton className="w-full" pendingLabel="Verifying...">
+            Verify
+          </SubmitButton>
+        </form>
+      </Panel>
+    );
+  }
+
   return (
     <Panel variant="glass" padding="lg">
       <div className="mb-8">
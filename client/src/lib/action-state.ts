@@ -7,3 +7,14 @@ export const initialActionState: ActionState = {
   status: "idle",
   message: "",
 };
+
+// LoginActionState carries the second sign-in step when the account uses two-factor authentication.
+export interface LoginActionState extends ActionState {
+  mfaToken?: string;
+  totpEnrollment?: {
+    secret: string;
+    provisioningUri: string;
+  };
+  recoveryCodes?: string[];
+  redirectTo?: string;
+}
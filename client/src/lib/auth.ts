@@ -135,6 +135,11 @@ export async function getValidAccessToken() {
     ...session,
     accessToken: data.access_token,
     accessTokenExpiresAt: data.access_token_expires_at,
+    // The API rotates the refresh token on every renew; reusing the old one signs the session out.
+    ...(typeof data.refresh_token === "string" && {
+      refreshToken: data.refresh_token,
+      refreshTokenExpiresAt: data.refresh_token_expires_at,
+    }),
   };
 
   await createSession(updatedSession);
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ThanhVinhTong/rate-pulse/service"
+	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/gin-gonic/gin"
+)
+
+// createAPIKeyRequest represents the request body for issuing an API key to the authenticated user.
+// scopes: read:rates, read:historical (plans with historical data) or admin (admins only).
+type createAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required,max=100"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1,dive,oneof=read:rates read:historical admin"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// createAPIKey issues an API key for machine-to-machine access.
+//
+// POST /api-keys
+//
+// Request body: createAPIKeyRequest (JSON)
+// Response: CreatedAPIKey object; the key itself is only returned here and cannot be retrieved later
+// Status codes:
+//   - 200 OK: Key created successfully
+//   - 400 Bad Request: Invalid request body, or the active key limit is reached
+//   - 401 Unauthorized: Missing or invalid access token
+//   - 403 Forbidden: A scope is not available to the user or their plan
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) createAPIKey(ctx *gin.Context) {
+	var req createAPIKeyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	key, err := server.services.APIKeys.CreateAPIKey(ctx, service.CreateAPIKeyInput{
+		UserID:    authPayload.UserID,
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, key)
+}
+
+// listAPIKeys lists the authenticated user's API keys, including revoked and expired ones.
+//
+// GET /api-keys
+//
+// Status codes:
+//   - 200 OK: Keys retrieved successfully
+//   - 401 Unauthorized: Missing or invalid access token
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) listAPIKeys(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	keys, err := server.services.APIKeys.ListAPIKeys(ctx, authPayload.UserID)
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, keys)
+}
+
+type apiKeyURIRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+// revokeAPIKey revokes one of the authenticated user's API keys; it stops working immediately.
+//
+// DELETE /api-keys/:id
+//
+// Status codes:
+//   - 200 OK: Key revoked successfully
+//   - 400 Bad Request: Invalid key ID
+//   - 404 Not Found: Key does not exist, belongs to another user or is already revoked
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) revokeAPIKey(ctx *gin.Context) {
+	var req apiKeyURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	key, err := server.services.APIKeys.RevokeAPIKey(ctx, service.RevokeAPIKeyInput{
+		UserID:   authPayload.UserID,
+		APIKeyID: req.ID,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, key)
+}
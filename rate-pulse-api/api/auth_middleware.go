@@ -1,11 +1,14 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
+	"github.com/ThanhVinhTong/rate-pulse/service"
 	"github.com/ThanhVinhTong/rate-pulse/token"
 	"github.com/gin-gonic/gin"
 )
@@ -14,6 +17,10 @@ const (
 	authorizationHeaderKey  = "authorization"
 	authorizationTypeBearer = "bearer"
 	authorizationPayloadKey = "authorization_payload"
+	apiKeyHeaderKey         = "X-API-Key"
+	// apiKeyAuthenticationKey holds the outcome of authenticating the X-API-Key, so the rate limit
+	// and auth middlewares look the key up once per request.
+	apiKeyAuthenticationKey = "api_key_authentication"
 )
 
 // User type constants for authorization
@@ -24,31 +31,59 @@ const (
 	UserTypeAdmin      = "admin"
 )
 
-func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
+// authMiddleware authenticates a bearer access token or, when no authorization header is
+// sent, an X-API-Key. A key is only let through when it holds one of the given scopes, so
+// routes registered without scopes stay closed to keys.
+func authMiddleware(
+	tokenMaker token.Maker,
+	revocations token.RevocationList,
+	apiKeys service.APIKeyUseCase,
+	scopes ...string,
+) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authorizationHeader := ctx.GetHeader(authorizationHeaderKey)
 		if len(authorizationHeader) == 0 {
-			err := errors.New("authorization header is required")
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+			if len(ctx.GetHeader(apiKeyHeaderKey)) == 0 {
+				err := errors.New("authorization header is required")
+				ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+				return
+			}
+			if authenticateAPIKey(ctx, apiKeys, scopes) {
+				ctx.Next()
+			}
 			return
 		}
 
-		fields := strings.Fields(authorizationHeader)
-		if len(fields) < 2 {
-			err := errors.New("invalid authorization header format")
+		payload, err := verifyAuthorizationHeader(ctx, tokenMaker, revocations, authorizationHeader)
+		if err != nil {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
 			return
 		}
 
-		authorizationType := strings.ToLower(fields[0])
-		if authorizationType != authorizationTypeBearer {
-			err := fmt.Errorf("unsupported authorization type %s", authorizationType)
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+		ctx.Set(authorizationPayloadKey, payload)
+		ctx.Next()
+	}
+}
+
+// optionalAuthMiddleware lets anonymous requests through but still authenticates callers
+// that send an authorization header or an API key, so public routes can personalise their
+// response. Credentials that are present but invalid are rejected rather than silently ignored.
+func optionalAuthMiddleware(
+	tokenMaker token.Maker,
+	revocations token.RevocationList,
+	apiKeys service.APIKeyUseCase,
+	scopes ...string,
+) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authorizationHeader := ctx.GetHeader(authorizationHeaderKey)
+		if len(authorizationHeader) == 0 {
+			if len(ctx.GetHeader(apiKeyHeaderKey)) == 0 || authenticateAPIKey(ctx, apiKeys, scopes) {
+				ctx.Next()
+			}
 			return
 		}
 
-		accessToken := fields[1]
-		payload, err := tokenMaker.VerifyToken(accessToken)
+		payload, err := verifyAuthorizationHeader(ctx, tokenMaker, revocations, authorizationHeader)
 		if err != nil {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
 			return
@@ -59,6 +94,79 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 	}
 }
 
+// apiKeyAuthentication is the principal of the X-API-Key, or the error authenticating it.
+type apiKeyAuthentication struct {
+	principal service.APIKeyPrincipal
+	err       error
+}
+
+// resolveAPIKey authenticates the X-API-Key header the first time it is called for a request and
+// returns the same outcome afterwards.
+func resolveAPIKey(ctx *gin.Context, apiKeys service.APIKeyUseCase) (service.APIKeyPrincipal, error) {
+	if resolved, ok := ctx.Get(apiKeyAuthenticationKey); ok {
+		if authentication, ok := resolved.(apiKeyAuthentication); ok {
+			return authentication.principal, authentication.err
+		}
+	}
+
+	principal, err := apiKeys.AuthenticateAPIKey(ctx, ctx.GetHeader(apiKeyHeaderKey))
+	ctx.Set(apiKeyAuthenticationKey, apiKeyAuthentication{principal: principal, err: err})
+	return principal, err
+}
+
+// authenticateAPIKey resolves the X-API-Key header and stores the owner's payload on the context.
+// It aborts the request and returns false when the key is invalid or holds none of the scopes the
+// route accepts.
+func authenticateAPIKey(ctx *gin.Context, apiKeys service.APIKeyUseCase, scopes []string) bool {
+	principal, err := resolveAPIKey(ctx, apiKeys)
+	if err != nil {
+		RespondServiceError(ctx, err)
+		ctx.Abort()
+		return false
+	}
+
+	if !slices.ContainsFunc(scopes, func(scope string) bool { return slices.Contains(principal.Scopes, scope) }) {
+		err := errors.New("api key does not have the scope required by this route")
+		if len(scopes) == 0 {
+			err = errors.New("api keys cannot be used on this route, sign in instead")
+		}
+		ctx.AbortWithStatusJSON(http.StatusForbidden, errorResponse(err))
+		return false
+	}
+
+	ctx.Set(authorizationPayloadKey, principal.Payload)
+	return true
+}
+
+// verifyAuthorizationHeader verifies the bearer access token of the header and rejects it when
+// it was revoked by a sign-out, a password change or an admin.
+func verifyAuthorizationHeader(
+	ctx context.Context,
+	tokenMaker token.Maker,
+	revocations token.RevocationList,
+	authorizationHeader string,
+) (*token.Payload, error) {
+	accessToken, err := bearerToken(authorizationHeader)
+	if err != nil {
+		return nil, err
+	}
+	return token.VerifyAccessToken(ctx, tokenMaker, revocations, accessToken)
+}
+
+func bearerToken(authorizationHeader string) (string, error) {
+	fields := strings.Fields(authorizationHeader)
+	if len(fields) < 2 {
+		return "", errors.New("invalid authorization header format")
+	}
+
+	authorizationType := strings.ToLower(fields[0])
+	if authorizationType != authorizationTypeBearer {
+		return "", fmt.Errorf("unsupported authorization type %s", authorizationType)
+	}
+
+	return fields[1], nil
+}
+
 // adminMiddleware checks if the authenticated user has admin privileges
 func adminMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -30,17 +30,22 @@ func RespondServiceError(ctx *gin.Context, err error) {
 		ctx.JSON(http.StatusBadRequest, serviceErrorResponse(err))
 	case service.ErrInvalidCredentials.Code,
 		service.ErrUnauthorized.Code,
+		service.ErrInactiveUser.Code,
 		service.ErrSessionNotFound.Code,
 		service.ErrSessionBlocked.Code,
-		service.ErrSessionExpired.Code:
+		service.ErrSessionExpired.Code,
+		service.ErrRefreshTokenReused.Code:
 		ctx.JSON(http.StatusUnauthorized, serviceErrorResponse(err))
-	case service.ErrEmailNotVerified.Code:
+	case service.ErrEmailNotVerified.Code,
+		service.ErrForbidden.Code:
 		ctx.JSON(http.StatusForbidden, serviceErrorResponse(err))
 	case service.ErrNotFound.Code:
 		ctx.JSON(http.StatusNotFound, serviceErrorResponse(err))
 	case service.ErrDuplicateEmail.Code,
 		service.ErrDuplicateExchangeRate.Code:
 		ctx.JSON(http.StatusConflict, serviceErrorResponse(err))
+	case service.ErrQuotaExceeded.Code:
+		ctx.JSON(http.StatusTooManyRequests, serviceErrorResponse(err))
 	default:
 		ctx.JSON(http.StatusInternalServerError, serviceErrorResponse(err))
 	}
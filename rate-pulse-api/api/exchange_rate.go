@@ -2,6 +2,7 @@ package api
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/ThanhVinhTong/rate-pulse/service"
@@ -214,14 +215,19 @@ func (server *Server) deleteExchangeRate(ctx *gin.Context) {
 
 // getHistoricalRequest represents the query parameters for fetching historical data.
 // The API intelligently samples data points using NTILE bucketing to ensure
-// consistent data density regardless of time range.
+// consistent data density regardless of time range. With interval=1d it instead
+// returns the end-of-day rate for each calendar day in the requested time zone.
 type getHistoricalRequest struct {
 	SourceCurrencyID      int32  `form:"source_currency_id" binding:"required,min=1"`
 	DestinationCurrencyID int32  `form:"destination_currency_id" binding:"required,min=1"`
 	SourceID              int32  `form:"source_id" binding:"required,min=1"`
 	TypeID                int32  `form:"type_id" binding:"required,min=1"`
-	TimeRange             string `form:"time_range" binding:"required"`
+	TimeRange             string `form:"time_range" binding:"required_without=From"`
 	DataPoints            int32  `form:"data_points"`
+	From                  string `form:"from"`
+	To                    string `form:"to"`
+	TZ                    string `form:"tz"`
+	Interval              string `form:"interval" binding:"omitempty,oneof=1d"`
 }
 
 // getHistoricalData returns exchange rate history with evenly distributed data points.
@@ -234,13 +240,23 @@ type getHistoricalRequest struct {
 //   - source_currency_id: The source currency ID (required, must be >= 1)
 //   - destination_currency_id: The destination currency ID (required, must be >= 1)
 //   - source_id: The rate source ID (required, must be >= 1)
-//   - time_range: Time range (required, e.g. "24h", "7d", "2w", "1m", "1y", "all")
+//   - time_range: Time range ending now (required unless from is set, e.g. "24h", "7d", "2w", "1m", "1y", "all")
+//   - from: Range start, RFC3339 or YYYY-MM-DD in tz (optional, replaces time_range)
+//   - to: Range end, RFC3339 or an inclusive YYYY-MM-DD in tz (optional, default: now)
+//   - tz: IANA time zone for dates and day buckets (optional, default: the signed-in user's time_zone, then UTC)
+//   - interval: "1d" for one end-of-day rate per calendar day (optional, default: NTILE sampling)
 //   - data_points: Number of data points to return (optional, default: 50, max: 500)
 //
+// The range is held to the historical_days of the caller's plan (the free plan when anonymous):
+// a time_range reaching further back is clamped, a from outside the window is rejected.
+//
 // Response: Array of HistoricalDataPoint objects
 // Status codes:
 //   - 200 OK: Historical data retrieved successfully
 //   - 400 Bad Request: Missing or invalid parameters
+//   - 401 Unauthorized: An authorization header or API key was sent but is invalid
+//   - 403 Forbidden: from is outside the plan's history window, or the API key lacks read:historical
+//   - 429 Too Many Requests: The daily quota of the caller's plan is exhausted
 //   - 500 Internal Server Error: Database or server error
 func (server *Server) getHistoricalData(ctx *gin.Context) {
 	var req getHistoricalRequest
@@ -249,7 +265,20 @@ func (server *Server) getHistoricalData(ctx *gin.Context) {
 		return
 	}
 
-	server.cachedJSON(ctx, cacheKeyForRequest(ctx, "exchange-rates:historical"), cacheTTLHistoricalData, func() (any, error) {
+	var userID int32
+	var userType string
+	if authPayload, ok := authPayloadFromGinContext(ctx); ok {
+		userID = authPayload.UserID
+		userType = authPayload.UserType
+	}
+
+	cacheKey := cacheKeyForRequest(ctx, "exchange-rates:historical")
+	if userID > 0 {
+		// The user's plan and saved time zone shape the response, so it cannot share the anonymous entry.
+		cacheKey = cacheKeyForRequestWithQueryValue(ctx, "exchange-rates:historical", "user_id", strconv.Itoa(int(userID)))
+	}
+
+	server.cachedJSON(ctx, cacheKey, cacheTTLHistoricalData, func() (any, error) {
 		return server.services.FX.GetHistoricalData(ctx, service.GetHistoricalDataInput{
 			SourceCurrencyID:      req.SourceCurrencyID,
 			DestinationCurrencyID: req.DestinationCurrencyID,
@@ -257,6 +286,157 @@ func (server *Server) getHistoricalData(ctx *gin.Context) {
 			TypeID:                req.TypeID,
 			TimeRange:             req.TimeRange,
 			DataPoints:            req.DataPoints,
+			From:                  req.From,
+			To:                    req.To,
+			TimeZone:              req.TZ,
+			Interval:              req.Interval,
+			UserID:                userID,
+			UserType:              userType,
+		})
+	})
+}
+
+// getCandlesRequest represents the query parameters for OHLC candles of a rate source's currency pair.
+type getCandlesRequest struct {
+	SourceCurrencyID      int32  `form:"source_currency_id" binding:"required,min=1"`
+	DestinationCurrencyID int32  `form:"destination_currency_id" binding:"required,min=1"`
+	SourceID              int32  `form:"source_id" binding:"required,min=1"`
+	TypeID                int32  `form:"type_id" binding:"required,min=1"`
+	Interval              string `form:"interval" binding:"required,oneof=1h 1d 1w 1M"`
+	TimeRange             string `form:"time_range" binding:"required"`
+	Limit                 int32  `form:"limit" binding:"omitempty,min=1,max=1000"`
+}
+
+// getCandles returns open/high/low/close candles with a sample count per interval.
+// Candles are aligned to calendar hours, days, ISO weeks or months in UTC, so every
+// candle covers the same span of time.
+//
+// GET /exchange-rates/candles
+//
+// Query parameters:
+//   - source_currency_id: The source currency ID (required, must be >= 1)
+//   - destination_currency_id: The destination currency ID (required, must be >= 1)
+//   - source_id: The rate source ID (required, must be >= 1)
+//   - type_id: The exchange rate type ID (required, must be >= 1)
+//   - interval: Candle interval (required, one of "1h", "1d", "1w", "1M")
+//   - time_range: Time range (required, e.g. "24h", "7d", "2w", "1m", "1y", "all")
+//   - limit: Maximum number of most recent candles (optional, default: 500, max: 1000)
+//
+// Response: Array of Candle objects, oldest first
+// Status codes:
+//   - 200 OK: Candles retrieved successfully
+//   - 400 Bad Request: Missing or invalid parameters
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) getCandles(ctx *gin.Context) {
+	var req getCandlesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	server.cachedJSON(ctx, cacheKeyForRequest(ctx, "exchange-rates:candles"), cacheTTLCandles, func() (any, error) {
+		return server.services.FX.GetCandles(ctx, service.GetCandlesInput{
+			SourceCurrencyID:      req.SourceCurrencyID,
+			DestinationCurrencyID: req.DestinationCurrencyID,
+			SourceID:              req.SourceID,
+			TypeID:                req.TypeID,
+			Interval:              req.Interval,
+			TimeRange:             req.TimeRange,
+			Limit:                 req.Limit,
+		})
+	})
+}
+
+// getCrossRateRequest represents the query parameters for a rate between two currencies at one source.
+type getCrossRateRequest struct {
+	FromCurrencyID  int32  `form:"from_currency_id" binding:"required,min=1"`
+	ToCurrencyID    int32  `form:"to_currency_id" binding:"required,min=1"`
+	SourceID        int32  `form:"source_id" binding:"required,min=1"`
+	TypeID          int32  `form:"type_id" binding:"required,min=1"`
+	PivotCurrencyID *int32 `form:"pivot_currency_id" binding:"omitempty,min=1"`
+}
+
+// getCrossRate returns how many to_currency units one from_currency unit buys at a source.
+// When the source does not publish the pair, the rate is triangulated through a pivot currency
+// (e.g. AUD->VND->JPY) using the source's latest rates of the same type.
+//
+// GET /exchange-rates/cross
+//
+// Query parameters:
+//   - from_currency_id: The currency being converted (required, must be >= 1)
+//   - to_currency_id: The currency received (required, must be >= 1)
+//   - source_id: The rate source ID (required, must be >= 1)
+//   - type_id: The exchange rate type ID (required, must be >= 1)
+//   - pivot_currency_id: Only triangulate through this currency (optional)
+//
+// Response: CrossRate object with the path and the rate legs used
+// Status codes:
+//   - 200 OK: Rate derived successfully
+//   - 400 Bad Request: Missing or invalid parameters
+//   - 404 Not Found: No direct rate and no pivot currency links the pair
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) getCrossRate(ctx *gin.Context) {
+	var req getCrossRateRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	server.cachedJSON(ctx, cacheKeyForRequest(ctx, "exchange-rates:cross"), cacheTTLExchangeRatesLatest, func() (any, error) {
+		return server.services.FX.GetCrossRate(ctx, service.CrossRateInput{
+			FromCurrencyID:  req.FromCurrencyID,
+			ToCurrencyID:    req.ToCurrencyID,
+			SourceID:        req.SourceID,
+			TypeID:          req.TypeID,
+			PivotCurrencyID: req.PivotCurrencyID,
+		})
+	})
+}
+
+// getSpreadsRequest represents the query parameters for buy/sell spreads of a currency pair.
+type getSpreadsRequest struct {
+	SourceCurrencyID      int32  `form:"source_currency_id" binding:"required,min=1"`
+	DestinationCurrencyID int32  `form:"destination_currency_id" binding:"required,min=1"`
+	SourceID              int32  `form:"source_id" binding:"omitempty,min=1"`
+	Channel               string `form:"channel" binding:"omitempty,max=50"`
+	TimeRange             string `form:"time_range"`
+	Interval              string `form:"interval" binding:"omitempty,oneof=1h 1d 1w 1M"`
+}
+
+// getSpreads pairs each rate source's buy and sell rate types for a currency pair (e.g. Buy
+// Transfer with Sell Transfer) and reports the mid rate, absolute spread and spread in basis
+// points, ranked by tightest spread within each channel.
+//
+// GET /exchange-rates/spreads
+//
+// Query parameters:
+//   - source_currency_id: The source currency ID as published by the banks (required, must be >= 1)
+//   - destination_currency_id: The destination currency ID (required, must be >= 1)
+//   - source_id: Only this rate source (optional)
+//   - channel: Only this channel, e.g. "cash" or "transfer" (optional)
+//   - time_range: Also return a spread series over this range (optional, e.g. "7d", "1m", "1y")
+//   - interval: Series bucket size (optional, one of "1h", "1d", "1w", "1M", default: "1d")
+//
+// Response: ExchangeRateSpreads object with the current ranking and, if requested, the series
+// Status codes:
+//   - 200 OK: Spreads retrieved successfully
+//   - 400 Bad Request: Missing or invalid parameters
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) getSpreads(ctx *gin.Context) {
+	var req getSpreadsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	server.cachedJSON(ctx, cacheKeyForRequest(ctx, "exchange-rates:spreads"), cacheTTLCandles, func() (any, error) {
+		return server.services.FX.GetSpreads(ctx, service.GetSpreadsInput{
+			SourceCurrencyID:      req.SourceCurrencyID,
+			DestinationCurrencyID: req.DestinationCurrencyID,
+			SourceID:              req.SourceID,
+			Channel:               req.Channel,
+			TimeRange:             req.TimeRange,
+			Interval:              req.Interval,
 		})
 	})
 }
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// sseHeartbeatInterval keeps idle streams open through proxies that drop silent connections.
+const sseHeartbeatInterval = 25 * time.Second
+
+// streamExchangeRatesRequest represents the optional filters of a live rate stream.
+// source_currency_id is the base currency, as in /exchange-rates-latest.
+type streamExchangeRatesRequest struct {
+	SourceCurrencyID int32 `form:"source_currency_id" binding:"omitempty,min=1"`
+	SourceID         int32 `form:"source_id" binding:"omitempty,min=1"`
+	TypeID           int32 `form:"type_id" binding:"omitempty,min=1"`
+}
+
+// streamExchangeRates pushes active rates to the client as server-sent events as soon as they are written.
+// Updates fan out through Redis pub/sub, so a client receives rates written through any replica.
+//
+// GET /exchange-rates/stream?source_currency_id=1&source_id=10&type_id=4
+//
+// Query parameters (all optional; omitted filters match every rate):
+//   - source_currency_id: Base currency of the rates
+//   - source_id: Rate source
+//   - type_id: Exchange rate type
+//
+// Response: text/event-stream of "rate" events whose data is a RateUpdate JSON object,
+// with a ": ping" comment every 25 seconds while idle
+// Status codes:
+//   - 200 OK: Stream opened
+//   - 400 Bad Request: Invalid filter parameters
+//   - 503 Service Unavailable: Live updates are unavailable
+func (server *Server) streamExchangeRates(ctx *gin.Context) {
+	var req streamExchangeRatesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	updates, err := server.rateUpdates.SubscribeRateUpdates(ctx.Request.Context(), pubsub.RateFilter{
+		SourceCurrencyID: req.SourceCurrencyID,
+		SourceID:         req.SourceID,
+		TypeID:           req.TypeID,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("cannot subscribe to exchange rate updates")
+		ctx.JSON(http.StatusServiceUnavailable, apiErrorResponse{
+			Code:    service.ErrInternal.Code,
+			Message: "live exchange rate updates are unavailable",
+		})
+		return
+	}
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+	ctx.Writer.Flush()
+
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	// Loop on the request context rather than ctx.Stream, whose CloseNotify is deprecated.
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				return
+			}
+			ctx.SSEvent("rate", update)
+		case <-heartbeat.C:
+			if _, err := io.WriteString(ctx.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+		case <-ctx.Request.Context().Done():
+			return
+		}
+		ctx.Writer.Flush()
+	}
+}
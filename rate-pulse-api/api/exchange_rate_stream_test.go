@@ -0,0 +1,81 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRateUpdates struct {
+	filter  pubsub.RateFilter
+	updates chan pubsub.RateUpdate
+	err     error
+}
+
+func (f *fakeRateUpdates) SubscribeRateUpdates(ctx context.Context, filter pubsub.RateFilter) (<-chan pubsub.RateUpdate, error) {
+	f.filter = filter
+	return f.updates, f.err
+}
+
+func TestStreamExchangeRatesSendsRateEvents(t *testing.T) {
+	server := newTestServer(t, nil)
+	rateUpdates := &fakeRateUpdates{updates: make(chan pubsub.RateUpdate, 1)}
+	server.SetRateUpdates(rateUpdates)
+	rateUpdates.updates <- pubsub.RateUpdate{RateID: 42, RateValue: "25000", SourceCurrencyID: 1, DestinationCurrencyID: 2, SourceID: 10, TypeID: 4}
+	close(rateUpdates.updates)
+
+	httpServer := httptest.NewServer(server.router)
+	defer httpServer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		httpServer.URL+"/exchange-rates/stream?source_currency_id=1&source_id=10&type_id=4", nil)
+	require.NoError(t, err)
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+	require.Equal(t, pubsub.RateFilter{SourceCurrencyID: 1, SourceID: 10, TypeID: 4}, rateUpdates.filter)
+
+	var lines []string
+	scanner := bufio.NewScanner(response.Body)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	body := strings.Join(lines, "\n")
+	require.Contains(t, body, "event:rate")
+	require.Contains(t, body, `"rate_id":42`)
+}
+
+func TestStreamExchangeRatesInvalidFilter(t *testing.T) {
+	server := newTestServer(t, nil)
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/exchange-rates/stream?source_id=-1", nil)
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestStreamExchangeRatesUnavailable(t *testing.T) {
+	server := newTestServer(t, nil)
+	server.SetRateUpdates(&fakeRateUpdates{err: errors.New("redis is down")})
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/exchange-rates/stream", nil)
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+}
@@ -35,6 +35,12 @@ func mustJSON(t *testing.T, v any) *bytes.Reader {
 	return bytes.NewReader(b)
 }
 
+func emptyAnomalyBaselineRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"history_count", "history_mean", "history_stddev", "history_median", "peer_count", "peer_median",
+	}).AddRow(int64(0), "0", "0", "0", int64(0), "0")
+}
+
 func TestGetExchangeRate_HappyPath(t *testing.T) {
 	server, mock, mockDB := newExchangeRateServerWithMockDB(t)
 	defer mockDB.Close()
@@ -42,9 +48,11 @@ func TestGetExchangeRate_HappyPath(t *testing.T) {
 	rows := sqlmock.NewRows([]string{
 		"rate_id", "rate_value", "source_currency_id", "destination_currency_id",
 		"valid_from_date", "valid_to_date", "source_id", "type_id", "created_at", "updated_at",
+		"status", "quarantine_reason",
 	}).AddRow(
 		1, "17695.08", 1, 2, time.Now(), sql.NullTime{}, sql.NullInt32{Int32: 10, Valid: true},
 		sql.NullInt32{Int32: 3, Valid: true}, sql.NullTime{}, sql.NullTime{},
+		"active", sql.NullString{},
 	)
 
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT rate_id, rate_value`)).
@@ -248,16 +256,21 @@ func TestCreateExchangeRate_BusinessRule_DuplicateSameRecord(t *testing.T) {
 	firstRows := sqlmock.NewRows([]string{
 		"rate_id", "rate_value", "source_currency_id", "destination_currency_id",
 		"valid_from_date", "valid_to_date", "source_id", "updated_at", "created_at", "type_id",
+		"status", "quarantine_reason",
 	}).AddRow(
 		1, "17695.08", 1, 2, time.Now(), sql.NullTime{},
 		sql.NullInt32{Int32: 10, Valid: true}, sql.NullTime{}, sql.NullTime{},
-		sql.NullInt32{Int32: 1, Valid: true},
+		sql.NullInt32{Int32: 1, Valid: true}, "active", sql.NullString{},
 	)
 
+	mock.ExpectQuery(regexp.QuoteMeta(`WITH history AS`)).
+		WillReturnRows(emptyAnomalyBaselineRows())
 	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO exchange_rates`)).
 		WillReturnRows(firstRows)
 
 	// 2) second insert (same payload) => duplicate
+	mock.ExpectQuery(regexp.QuoteMeta(`WITH history AS`)).
+		WillReturnRows(emptyAnomalyBaselineRows())
 	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO exchange_rates`)).
 		WillReturnError(&pq.Error{Code: "23505", Message: "duplicate key value violates unique constraint"})
 
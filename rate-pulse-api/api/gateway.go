@@ -0,0 +1,25 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetGatewayHandler serves the grpc-gateway routes generated from the protos under /v2.
+// The handler runs behind the same recovery, request ID, logging, rate limit and CORS middleware as the Gin routes.
+func (server *Server) SetGatewayHandler(handler http.Handler) {
+	if handler == nil {
+		return
+	}
+
+	server.router.Any("/v2/*path", gatewayHandler(handler))
+}
+
+// gatewayHandler forwards the request ID chosen by requestIDMiddleware so both APIs log the same ID.
+func gatewayHandler(handler http.Handler) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Request.Header.Set(requestIDHeaderKey, ctx.GetString(requestIDContextKey))
+		handler.ServeHTTP(ctx.Writer, ctx.Request)
+	}
+}
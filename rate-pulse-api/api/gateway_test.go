@@ -0,0 +1,38 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ThanhVinhTong/rate-pulse/docs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetGatewayHandlerServesV2(t *testing.T) {
+	server := newTestServer(t, nil)
+	var gotPath, gotRequestID string
+	server.SetGatewayHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotRequestID = r.Header.Get(requestIDHeaderKey)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v2/exchange-rates/1", nil))
+
+	require.Equal(t, http.StatusNoContent, recorder.Code)
+	require.Equal(t, "/v2/exchange-rates/1", gotPath)
+	require.NotEmpty(t, gotRequestID)
+	require.Equal(t, gotRequestID, recorder.Header().Get(requestIDHeaderKey))
+}
+
+func TestSwaggerV2ServesGeneratedSpec(t *testing.T) {
+	server := newTestServer(t, nil)
+
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/swagger/v2.json", nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.JSONEq(t, string(docs.SwaggerJSON), recorder.Body.String())
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ThanhVinhTong/rate-pulse/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ingestExchangeRateRow is one scraped rate, keyed the way the scrapers publish it.
+// valid_from_date defaults to the time of the request when omitted.
+type ingestExchangeRateRow struct {
+	SourceCode              string    `json:"source_code" binding:"required"`
+	SourceCurrencyCode      string    `json:"source_currency_code" binding:"required"`
+	DestinationCurrencyCode string    `json:"destination_currency_code" binding:"required"`
+	TypeName                string    `json:"type_name" binding:"required"`
+	RateValue               string    `json:"rate_value" binding:"required"`
+	ValidFromDate           time.Time `json:"valid_from_date"`
+}
+
+// ingestExchangeRatesRequest represents the request body for a batch of scraped rates.
+type ingestExchangeRatesRequest struct {
+	Rates []ingestExchangeRateRow `json:"rates" binding:"required,min=1,max=1000,dive"`
+}
+
+// ingestExchangeRates loads a batch of scraped rates through the FX service.
+// Rows already published for the same source, pair and type within the ingestion bucket
+// are reported as duplicates, so a scraper can safely resend a batch.
+//
+// POST /admin/ingest/exchange-rates
+//
+// Request body: ingestExchangeRatesRequest (JSON)
+// Response: IngestExchangeRatesResult with inserted/quarantined/duplicate/rejected counts and per-row status
+// Status codes:
+//   - 200 OK: Batch processed, individual rows may still be rejected
+//   - 400 Bad Request: Invalid request body or validation error
+//   - 401 Unauthorized: Missing or invalid access token
+//   - 403 Forbidden: Caller is not an admin
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) ingestExchangeRates(ctx *gin.Context) {
+	var req ingestExchangeRatesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	rows := make([]service.IngestExchangeRateRow, len(req.Rates))
+	for i, rate := range req.Rates {
+		rows[i] = service.IngestExchangeRateRow{
+			SourceCode:              rate.SourceCode,
+			SourceCurrencyCode:      rate.SourceCurrencyCode,
+			DestinationCurrencyCode: rate.DestinationCurrencyCode,
+			TypeName:                rate.TypeName,
+			RateValue:               rate.RateValue,
+			ValidFromDate:           rate.ValidFromDate,
+		}
+	}
+
+	result, err := server.services.FX.IngestExchangeRates(ctx, service.IngestExchangeRatesInput{Rates: rows})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	if result.Inserted > 0 {
+		server.deleteExchangeRateCaches(ctx)
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
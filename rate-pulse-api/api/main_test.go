@@ -8,8 +8,10 @@ import (
 	"time"
 
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
 	"github.com/ThanhVinhTong/rate-pulse/service"
 	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/ThanhVinhTong/rate-pulse/totp"
 	"github.com/ThanhVinhTong/rate-pulse/util"
 	"github.com/ThanhVinhTong/rate-pulse/worker"
 	"github.com/gin-gonic/gin"
@@ -27,9 +29,18 @@ func (noopTaskDistributor) DistributeTaskSendVerifyEmail(
 	return nil
 }
 
+func (noopTaskDistributor) DistributeTaskSendPasswordResetEmail(
+	ctx context.Context,
+	payload *worker.PayloadSendPasswordResetEmail,
+	opts ...asynq.Option,
+) error {
+	return nil
+}
+
 func newTestServer(t *testing.T, store db.Store) *Server {
 	config := util.Config{
 		TokenSymmetricKey:    util.RandomString(32),
+		TOTPEncryptionKey:    util.RandomString(32),
 		AccessTokenDuration:  time.Minute,
 		RefreshTokenDuration: time.Hour,
 	}
@@ -38,10 +49,13 @@ func newTestServer(t *testing.T, store db.Store) *Server {
 	if err != nil {
 		log.Fatal("Cannot create token maker: ", err)
 	}
+	totpCipher, err := totp.NewSecretCipher(config.TOTPEncryptionKey)
+	require.NoError(t, err)
 
 	taskDistributor := noopTaskDistributor{}
-	services := service.NewServices(config, store, tokenMaker, taskDistributor)
-	server, err := NewServer(config, store, services, tokenMaker, nil)
+	revocations := token.NewMemoryRevocationList(config.AccessTokenDuration)
+	services := service.NewServices(config, store, tokenMaker, revocations, totpCipher, taskDistributor, pubsub.NoopBroker{})
+	server, err := NewServer(config, store, services, tokenMaker, revocations, nil)
 	require.NoError(t, err)
 
 	return server
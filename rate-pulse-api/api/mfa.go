@@ -0,0 +1,224 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ThanhVinhTong/rate-pulse/service"
+	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/gin-gonic/gin"
+)
+
+// mfaChallengeResponse is returned by loginUser instead of tokens when the user has to
+// complete the sign-in with a second factor.
+type mfaChallengeResponse struct {
+	MFARequired           bool         `json:"mfa_required"`
+	MFAEnrollmentRequired bool         `json:"mfa_enrollment_required"`
+	MFAToken              string       `json:"mfa_token"`
+	MFATokenExpiresAt     time.Time    `json:"mfa_token_expires_at"`
+	User                  userResponse `json:"user"`
+}
+
+type mfaTokenRequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+}
+
+type completeSignInMFARequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+type mfaCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// completeSignInMFA exchanges the MFA token of a sign-in and a TOTP or recovery code for a session.
+//
+// POST /users/signin/mfa
+//
+// Response: loginUserResponse; recovery_codes is set once when the code confirmed an enrolment
+// Status codes:
+//   - 200 OK: Signed in successfully
+//   - 400 Bad Request: Missing fields, or no authenticator app enrolled yet
+//   - 401 Unauthorized: Invalid code, or invalid, expired or used MFA token
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) completeSignInMFA(ctx *gin.Context) {
+	var req completeSignInMFARequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.services.Auth.CompleteSignInMFA(ctx, service.CompleteSignInMFAInput{
+		MFAToken:  req.MFAToken,
+		Code:      req.Code,
+		UserAgent: ctx.Request.UserAgent(),
+		ClientIP:  ctx.ClientIP(),
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newLoginUserResponse(result))
+}
+
+// enrollSignInTOTP starts the authenticator app enrolment an admin needs before signing in.
+//
+// POST /users/signin/mfa/enroll
+//
+// Response: TOTPEnrollment with the secret and its otpauth:// URI for the QR code
+// Status codes:
+//   - 200 OK: Enrolment started
+//   - 400 Bad Request: Missing MFA token, or TOTP is already enabled
+//   - 401 Unauthorized: Invalid, expired or used MFA token
+//   - 403 Forbidden: The MFA token is for a sign-in that does not require enrolment
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) enrollSignInTOTP(ctx *gin.Context) {
+	var req mfaTokenRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	enrollment, err := server.services.Auth.EnrollSignInTOTP(ctx, service.EnrollSignInTOTPInput{
+		MFAToken: req.MFAToken,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, enrollment)
+}
+
+// getMyMFAStatus reports whether the authenticated user has TOTP enabled and whether it is required.
+//
+// GET /me/mfa
+//
+// Response: MFAStatus
+// Status codes:
+//   - 200 OK: Status retrieved successfully
+//   - 401 Unauthorized: Missing or invalid access token
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) getMyMFAStatus(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	status, err := server.services.MFA.GetMFAStatus(ctx, authPayload.UserID)
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, status)
+}
+
+// enrollMyTOTP starts an authenticator app enrolment; it is enabled by confirmMyTOTP.
+//
+// POST /me/mfa/totp
+//
+// Response: TOTPEnrollment with the secret and its otpauth:// URI for the QR code
+// Status codes:
+//   - 200 OK: Enrolment started
+//   - 400 Bad Request: TOTP is already enabled
+//   - 401 Unauthorized: Missing or invalid access token
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) enrollMyTOTP(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	enrollment, err := server.services.MFA.EnrollTOTP(ctx, authPayload.UserID)
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, enrollment)
+}
+
+// confirmMyTOTP enables the pending authenticator app with a first code.
+//
+// POST /me/mfa/totp/confirm
+//
+// Response: RecoveryCodes, only returned once
+// Status codes:
+//   - 200 OK: TOTP enabled
+//   - 400 Bad Request: Missing or invalid code, or no pending enrolment
+//   - 401 Unauthorized: Missing or invalid access token
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) confirmMyTOTP(ctx *gin.Context) {
+	var req mfaCodeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	codes, err := server.services.MFA.ConfirmTOTP(ctx, service.MFACodeInput{
+		UserID: authPayload.UserID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, codes)
+}
+
+// disableMyTOTP turns two-factor authentication off with a TOTP or recovery code.
+//
+// POST /me/mfa/totp/disable
+//
+// Status codes:
+//   - 200 OK: TOTP disabled
+//   - 400 Bad Request: Missing or invalid code, or TOTP is not enabled
+//   - 401 Unauthorized: Missing or invalid access token
+//   - 403 Forbidden: Admins cannot disable two-factor authentication
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) disableMyTOTP(ctx *gin.Context) {
+	var req mfaCodeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	err := server.services.MFA.DisableTOTP(ctx, service.MFACodeInput{
+		UserID: authPayload.UserID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Two-factor authentication disabled"})
+}
+
+// regenerateMyRecoveryCodes replaces the recovery codes of the authenticated user.
+//
+// POST /me/mfa/recovery-codes
+//
+// Response: RecoveryCodes, only returned once; the previous codes stop working
+// Status codes:
+//   - 200 OK: Recovery codes replaced
+//   - 400 Bad Request: Missing or invalid TOTP code, or TOTP is not enabled
+//   - 401 Unauthorized: Missing or invalid access token
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) regenerateMyRecoveryCodes(ctx *gin.Context) {
+	var req mfaCodeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	codes, err := server.services.MFA.RegenerateRecoveryCodes(ctx, service.MFACodeInput{
+		UserID: authPayload.UserID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, codes)
+}
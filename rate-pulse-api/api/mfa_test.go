@@ -0,0 +1,54 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignInMFAValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		payload map[string]any
+	}{
+		{"complete without code", "/users/signin/mfa", map[string]any{"mfa_token": "token"}},
+		{"complete without mfa token", "/users/signin/mfa", map[string]any{"code": "123456"}},
+		{"enroll without mfa token", "/users/signin/mfa/enroll", map[string]any{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, db.NewStore(nil))
+			req := makeJSONRequest(t, http.MethodPost, tt.path, tt.payload)
+			w := serveRequest(server, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func TestMyMFARoutesRequireAuth(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+	}{
+		{http.MethodGet, "/me/mfa"},
+		{http.MethodPost, "/me/mfa/totp"},
+		{http.MethodPost, "/me/mfa/totp/confirm"},
+		{http.MethodPost, "/me/mfa/totp/disable"},
+		{http.MethodPost, "/me/mfa/recovery-codes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			server := newTestServer(t, db.NewStore(nil))
+			w := serveRequest(server, httptest.NewRequest(tt.method, tt.path, nil))
+
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/service"
 	"github.com/ThanhVinhTong/rate-pulse/token"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
@@ -24,7 +26,7 @@ func addAuthorization(
 	userType string,
 	duration time.Duration,
 ) {
-	token, payload, err := tokenMaker.CreateToken(userID, username, email, userType, duration)
+	token, payload, err := tokenMaker.CreateToken(userID, username, email, userType, duration, token.TokenTypeAccess)
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
 	require.NotEmpty(t, payload.ID)
@@ -116,7 +118,7 @@ func TestAuthMiddleware(t *testing.T) {
 			authPath := "/auth"
 			server.router.GET(
 				authPath,
-				authMiddleware(server.tokenMaker),
+				authMiddleware(server.tokenMaker, server.revocations, server.services.APIKeys),
 				func(ctx *gin.Context) {
 					ctx.JSON(http.StatusOK, gin.H{"message": "test"})
 				},
@@ -133,6 +135,251 @@ func TestAuthMiddleware(t *testing.T) {
 	}
 }
 
+func TestAuthMiddlewareRejectsRevokedToken(t *testing.T) {
+	server := newTestServer(t, db.NewStore(nil))
+
+	authPath := "/auth"
+	server.router.GET(
+		authPath,
+		authMiddleware(server.tokenMaker, server.revocations, server.services.APIKeys),
+		func(ctx *gin.Context) {
+			ctx.JSON(http.StatusOK, gin.H{"message": "test"})
+		},
+	)
+
+	request, err := http.NewRequest(http.MethodGet, authPath, nil)
+	require.NoError(t, err)
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 7, "test@example.com", "testuser", "free", time.Minute)
+
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	// Deactivating, downgrading or deleting the user revokes every access token issued so far.
+	require.NoError(t, server.revocations.RevokeUserTokens(context.Background(), 7))
+
+	recorder = httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestOptionalAuthMiddleware(t *testing.T) {
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Anonymous",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"user_id":0`)
+			},
+		},
+		{
+			name: "Authenticated",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, 7, "test@example.com", "testuser", "free", time.Minute)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"user_id":7`)
+			},
+		},
+		{
+			name: "ExpiredToken",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, 7, "test@example.com", "testuser", "free", -time.Minute)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, db.NewStore(nil))
+
+			optionalAuthPath := "/optional-auth"
+			server.router.GET(
+				optionalAuthPath,
+				optionalAuthMiddleware(server.tokenMaker, server.revocations, server.services.APIKeys),
+				func(ctx *gin.Context) {
+					var userID int32
+					if payload, ok := ctx.Get(authorizationPayloadKey); ok {
+						userID = payload.(*token.Payload).UserID
+					}
+					ctx.JSON(http.StatusOK, gin.H{"user_id": userID})
+				},
+			)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, optionalAuthPath, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+// fakeAPIKeys accepts "rp_rates" of a free user and "rp_enterprise" of an enterprise user, both
+// with the read:rates scope, and rejects every other key.
+type fakeAPIKeys struct {
+	service.APIKeyUseCase
+}
+
+func (fakeAPIKeys) AuthenticateAPIKey(ctx context.Context, rawKey string) (service.APIKeyPrincipal, error) {
+	payload := &token.Payload{UserID: 7, Username: "testuser", UserType: UserTypeFree}
+	apiKeyID := int32(1)
+	switch rawKey {
+	case "rp_rates":
+	case "rp_enterprise":
+		payload.UserType = UserTypeEnterprise
+		apiKeyID = 2
+	default:
+		return service.APIKeyPrincipal{}, service.Wrap(nil, service.ErrUnauthorized.Code, "invalid api key")
+	}
+	return service.APIKeyPrincipal{APIKeyID: apiKeyID, Payload: payload, Scopes: []string{service.APIKeyScopeReadRates}}, nil
+}
+
+// countingAPIKeys counts the calls to AuthenticateAPIKey.
+type countingAPIKeys struct {
+	fakeAPIKeys
+	calls int
+}
+
+func (c *countingAPIKeys) AuthenticateAPIKey(ctx context.Context, rawKey string) (service.APIKeyPrincipal, error) {
+	c.calls++
+	return c.fakeAPIKeys.AuthenticateAPIKey(ctx, rawKey)
+}
+
+func TestAPIKeyAuthentication(t *testing.T) {
+	testCases := []struct {
+		name       string
+		middleware gin.HandlerFunc
+		apiKey     string
+		code       int
+		userID     int32
+	}{
+		{
+			name:       "ScopedRoute",
+			middleware: authMiddleware(nil, nil, fakeAPIKeys{}, service.APIKeyScopeReadRates),
+			apiKey:     "rp_rates",
+			code:       http.StatusOK,
+			userID:     7,
+		},
+		{
+			name:       "MissingScope",
+			middleware: authMiddleware(nil, nil, fakeAPIKeys{}, service.APIKeyScopeAdmin),
+			apiKey:     "rp_rates",
+			code:       http.StatusForbidden,
+		},
+		{
+			name:       "RouteClosedToKeys",
+			middleware: authMiddleware(nil, nil, fakeAPIKeys{}),
+			apiKey:     "rp_rates",
+			code:       http.StatusForbidden,
+		},
+		{
+			name:       "InvalidKey",
+			middleware: optionalAuthMiddleware(nil, nil, fakeAPIKeys{}, service.APIKeyScopeReadRates),
+			apiKey:     "rp_unknown",
+			code:       http.StatusUnauthorized,
+		},
+		{
+			name:       "AnonymousPublicRoute",
+			middleware: optionalAuthMiddleware(nil, nil, fakeAPIKeys{}, service.APIKeyScopeReadRates),
+			code:       http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/api-key", tc.middleware, func(ctx *gin.Context) {
+				var userID int32
+				if payload, ok := authPayloadFromGinContext(ctx); ok {
+					userID = payload.UserID
+				}
+				ctx.JSON(http.StatusOK, gin.H{"user_id": userID})
+			})
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodGet, "/api-key", nil)
+			if tc.apiKey != "" {
+				request.Header.Set(apiKeyHeaderKey, tc.apiKey)
+			}
+			router.ServeHTTP(recorder, request)
+
+			require.Equal(t, tc.code, recorder.Code)
+			if tc.code == http.StatusOK {
+				require.Contains(t, recorder.Body.String(), fmt.Sprintf(`"user_id":%d`, tc.userID))
+			}
+		})
+	}
+}
+
+// fakeQuotas reports the daily quota of user 8 as exhausted and lets everyone else through.
+type fakeQuotas struct {
+	service.QuotaUseCase
+}
+
+func (fakeQuotas) ConsumeDailyQuota(ctx context.Context, input service.QuotaInput) (service.Usage, error) {
+	limit, remaining := int32(100), int32(42)
+	usage := service.Usage{DailyLimit: &limit, RequestsRemaining: &remaining, ResetsAt: time.Now().Add(time.Hour)}
+	if input.UserID == 8 {
+		remaining = 0
+		return usage, service.Wrap(nil, service.ErrQuotaExceeded.Code, "daily request quota of your plan is exhausted")
+	}
+	return usage, nil
+}
+
+func TestQuotaMiddleware(t *testing.T) {
+	testCases := []struct {
+		name      string
+		userID    int32
+		code      int
+		remaining string
+	}{
+		{name: "Anonymous", code: http.StatusOK},
+		{name: "WithinQuota", userID: 7, code: http.StatusOK, remaining: "42"},
+		{name: "Exhausted", userID: 8, code: http.StatusTooManyRequests, remaining: "0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, db.NewStore(nil))
+			server.services.Quotas = fakeQuotas{}
+
+			router := gin.New()
+			router.GET("/metered", func(ctx *gin.Context) {
+				if tc.userID > 0 {
+					ctx.Set(authorizationPayloadKey, &token.Payload{UserID: tc.userID, UserType: UserTypeFree})
+				}
+			}, server.quotaMiddleware(), func(ctx *gin.Context) {
+				ctx.JSON(http.StatusOK, gin.H{})
+			})
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metered", nil))
+
+			require.Equal(t, tc.code, recorder.Code)
+			require.Equal(t, tc.remaining, recorder.Header().Get("X-Quota-Remaining"))
+			if tc.code == http.StatusTooManyRequests {
+				require.NotEmpty(t, recorder.Header().Get("Retry-After"))
+				require.Contains(t, recorder.Body.String(), service.ErrQuotaExceeded.Code)
+			}
+		})
+	}
+}
+
 func TestClientIdentifierFallsBackWhenClientIPUnavailable(t *testing.T) {
 	recorder := httptest.NewRecorder()
 	ctx, _ := gin.CreateTestContext(recorder)
@@ -142,3 +389,153 @@ func TestClientIdentifierFallsBackWhenClientIPUnavailable(t *testing.T) {
 
 	require.Equal(t, "unknown", clientIdentifier(ctx))
 }
+
+func TestRateLimitMiddlewarePolicies(t *testing.T) {
+	server := newTestServer(t, db.NewStore(nil))
+	server.rateLimits = RateLimitPolicies(3)
+	server.services.APIKeys = fakeAPIKeys{}
+
+	router := gin.New()
+	router.Use(server.rateLimitMiddleware())
+	router.POST("/users/signin", func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{})
+	})
+	router.GET("/currencies", func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{})
+	})
+	router.Any("/v2/*path", func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{})
+	})
+
+	send := func(method string, path string, userType string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, path, nil)
+		if userType != "" {
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 7, "user@email.com", "user", userType, time.Minute)
+		}
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+		return recorder
+	}
+	sendWithAPIKey := func(method string, path string, apiKey string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, path, nil)
+		request.Header.Set(apiKeyHeaderKey, apiKey)
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	// Sign-in is held to its own strict limit, whatever the caller's plan.
+	for i := 0; i < strictRouteLimits["POST /users/signin"]; i++ {
+		require.Equal(t, http.StatusOK, send(http.MethodPost, "/users/signin", "").Code)
+	}
+	recorder := send(http.MethodPost, "/users/signin", "")
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	require.NotEmpty(t, recorder.Header().Get("Retry-After"))
+
+	// Keys that do not authenticate are still keyed by address, so a fresh key per request gets no new budget.
+	require.Equal(t, http.StatusTooManyRequests, sendWithAPIKey(http.MethodPost, "/users/signin", "rp_random1").Code)
+	require.Equal(t, http.StatusTooManyRequests, sendWithAPIKey(http.MethodPost, "/users/signin", "rp_random2").Code)
+
+	// The gateway route shares the budget of the Gin route it mirrors.
+	require.Equal(t, http.StatusTooManyRequests, send(http.MethodPost, "/v2/users/signin", "").Code)
+
+	// A valid key gets a bucket of its own.
+	require.Equal(t, http.StatusOK, sendWithAPIKey(http.MethodPost, "/users/signin", "rp_rates").Code)
+
+	// A valid key gets the limit of its owner's user type.
+	recorder = sendWithAPIKey(http.MethodGet, "/currencies", "rp_enterprise")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "30", recorder.Header().Get("X-RateLimit-Limit"))
+
+	// Other routes from the same address keep their own budget.
+	recorder = send(http.MethodGet, "/currencies", "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "3", recorder.Header().Get("X-RateLimit-Limit"))
+
+	// Signed-in users are keyed by ID and get the limit of their user type.
+	recorder = send(http.MethodGet, "/currencies", UserTypePremium)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "9", recorder.Header().Get("X-RateLimit-Limit"))
+	require.Equal(t, "8", recorder.Header().Get("X-RateLimit-Remaining"))
+}
+
+func TestRateLimitAndAuthMiddlewareAuthenticateAPIKeyOnce(t *testing.T) {
+	testCases := []struct {
+		name   string
+		apiKey string
+		code   int
+	}{
+		{name: "ValidKey", apiKey: "rp_rates", code: http.StatusOK},
+		{name: "InvalidKey", apiKey: "rp_unknown", code: http.StatusUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			apiKeys := &countingAPIKeys{}
+			server := newTestServer(t, db.NewStore(nil))
+			server.rateLimits = RateLimitPolicies(3)
+			server.services.APIKeys = apiKeys
+
+			router := gin.New()
+			router.Use(server.rateLimitMiddleware())
+			router.GET("/api-key", authMiddleware(nil, nil, apiKeys, service.APIKeyScopeReadRates), func(ctx *gin.Context) {
+				ctx.JSON(http.StatusOK, gin.H{})
+			})
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodGet, "/api-key", nil)
+			request.Header.Set(apiKeyHeaderKey, tc.apiKey)
+			router.ServeHTTP(recorder, request)
+
+			require.Equal(t, tc.code, recorder.Code)
+			require.Equal(t, 1, apiKeys.calls)
+		})
+	}
+}
+
+// fakeUsers reports user 9's email as unverified and every other user's as verified.
+type fakeUsers struct {
+	service.UserUseCase
+}
+
+func (fakeUsers) RequireVerifiedEmail(ctx context.Context, userID int32) error {
+	if userID == 9 {
+		return service.Wrap(nil, service.ErrEmailNotVerified.Code, "please verify your email address first")
+	}
+	return nil
+}
+
+func TestVerifiedEmailMiddleware(t *testing.T) {
+	testCases := []struct {
+		name   string
+		userID int32
+		code   int
+	}{
+		{name: "Verified", userID: 7, code: http.StatusOK},
+		{name: "Unverified", userID: 9, code: http.StatusForbidden},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, db.NewStore(nil))
+			server.services.Users = fakeUsers{}
+
+			server.router.POST(
+				"/gated",
+				authMiddleware(server.tokenMaker, server.revocations, server.services.APIKeys),
+				server.verifiedEmailMiddleware(),
+				func(ctx *gin.Context) {
+					ctx.JSON(http.StatusOK, gin.H{"message": "test"})
+				},
+			)
+
+			request, err := http.NewRequest(http.MethodPost, "/gated", nil)
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.userID, "test@example.com", "testuser", "free", time.Minute)
+
+			recorder := httptest.NewRecorder()
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.code, recorder.Code)
+		})
+	}
+}
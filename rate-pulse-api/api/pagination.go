@@ -0,0 +1,39 @@
+package api
+
+import (
+	"time"
+
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+// pageRequest holds the cursor pagination query parameters shared by every list endpoint.
+//
+// Query parameters:
+//   - cursor: The next_cursor of the previous page (optional, omit for the first page)
+//   - page_size: The number of rows per page (optional, default: 20, max: 100)
+//   - sort: The sort key, prefixed with "-" for descending (optional, endpoint specific)
+type pageRequest struct {
+	Cursor   string `form:"cursor"`
+	PageSize int32  `form:"page_size" binding:"omitempty,min=1,max=100"`
+	Sort     string `form:"sort"`
+}
+
+func (req pageRequest) pageInput() service.PageInput {
+	return service.PageInput{
+		Cursor:   req.Cursor,
+		PageSize: req.PageSize,
+		Sort:     req.Sort,
+	}
+}
+
+// parseOptionalRangeTime parses a from/to filter given as RFC3339 or as a YYYY-MM-DD date in UTC.
+func parseOptionalRangeTime(field string, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := parseFeeRuleDate(value)
+	if err != nil {
+		return nil, service.Wrap(err, service.ErrInvalidInput.Code, field+" must be YYYY-MM-DD or RFC3339")
+	}
+	return &parsed, nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/service"
 	"github.com/ThanhVinhTong/rate-pulse/token"
 	"github.com/ThanhVinhTong/rate-pulse/util"
 	"github.com/gin-gonic/gin"
@@ -109,14 +110,50 @@ func (server *Server) requirePaymentOwner(ctx *gin.Context, payment db.Payment,
 	return nil
 }
 
+type listAllPaymentsRequest struct {
+	pageRequest
+	Status         string `form:"status" binding:"omitempty,oneof=pending completed failed refunded"`
+	SubscriptionID *int32 `form:"subscription_id" binding:"omitempty,min=1"`
+	From           string `form:"from"`
+	To             string `form:"to"`
+}
+
+// listAllPayments pages through every payment, newest first unless sort says otherwise.
+//
+// GET /admin/payments?status=completed&from=2026-01-01&sort=-payment_date&cursor=...
+//
+// sort is payment_date or payment_id, prefixed with "-" for descending; from/to bound payment_date.
 func (server *Server) listAllPayments(ctx *gin.Context) {
-	payments, err := server.store.GetAllPayments(ctx)
+	var req listAllPaymentsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	from, err := parseOptionalRangeTime("from", req.From)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		RespondServiceError(ctx, err)
+		return
+	}
+	to, err := parseOptionalRangeTime("to", req.To)
+	if err != nil {
+		RespondServiceError(ctx, err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, payments)
+	page, err := server.services.Payments.ListPayments(ctx, service.ListPaymentsInput{
+		Page:           req.pageInput(),
+		Status:         stringPtrIfNotEmpty(req.Status),
+		SubscriptionID: req.SubscriptionID,
+		From:           from,
+		To:             to,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, page)
 }
 
 type listPaymentsByStatusRequest struct {
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/ThanhVinhTong/rate-pulse/service"
+	"github.com/gin-gonic/gin"
+)
+
+type listQuarantinedExchangeRatesRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=50"`
+}
+
+// listQuarantinedExchangeRates lists rates held back by anomaly detection, newest first.
+// Each rate carries the QuarantineReason explaining how far it deviated.
+//
+// GET /admin/exchange-rates/quarantined?page_id=1&page_size=10
+//
+// Status codes:
+//   - 200 OK: Quarantined rates retrieved successfully
+//   - 400 Bad Request: Invalid pagination parameters
+//   - 401 Unauthorized: Missing or invalid access token
+//   - 403 Forbidden: Caller is not an admin
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) listQuarantinedExchangeRates(ctx *gin.Context) {
+	var req listQuarantinedExchangeRatesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	rates, err := server.services.FX.ListQuarantinedExchangeRates(ctx, service.ListQuarantinedExchangeRatesInput{
+		PageID:   req.PageID,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rates)
+}
+
+type reviewQuarantinedExchangeRateRequest struct {
+	Action string `json:"action" binding:"required,oneof=approve reject"`
+}
+
+// reviewQuarantinedExchangeRate approves or rejects a quarantined rate. Approved rates go live
+// with their original timestamps; rejected rates are kept for audit but never served.
+//
+// PUT /admin/exchange-rates/:id/review
+//
+// Request body: reviewQuarantinedExchangeRateRequest (JSON)
+// Status codes:
+//   - 200 OK: Rate reviewed successfully
+//   - 400 Bad Request: Invalid rate ID or action
+//   - 401 Unauthorized: Missing or invalid access token
+//   - 403 Forbidden: Caller is not an admin
+//   - 404 Not Found: Rate does not exist or is not quarantined
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) reviewQuarantinedExchangeRate(ctx *gin.Context) {
+	var uri getExchangeRateRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req reviewQuarantinedExchangeRateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	rate, err := server.services.FX.ReviewQuarantinedExchangeRate(ctx, service.ReviewQuarantinedExchangeRateInput{
+		RateID: uri.ID,
+		Action: req.Action,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	if rate.Status == service.ExchangeRateStatusActive {
+		server.deleteExchangeRateCaches(ctx)
+	}
+
+	ctx.JSON(http.StatusOK, rate)
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ThanhVinhTong/rate-pulse/service"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// quotaMiddleware meters authenticated rate reads against the daily quota of the caller's plan.
+// Anonymous requests are only held to the per-minute rate limit. A failure to count fails open,
+// like the rate limiter, so a broken usage table does not take the rate API down with it.
+func (server *Server) quotaMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		payload, ok := authPayloadFromGinContext(ctx)
+		if !ok {
+			ctx.Next()
+			return
+		}
+
+		usage, err := server.services.Quotas.ConsumeDailyQuota(ctx, service.QuotaInput{
+			UserID:   payload.UserID,
+			UserType: payload.UserType,
+		})
+		exhausted := service.ServiceErrorCode(err) == service.ErrQuotaExceeded.Code
+		if err != nil && !exhausted {
+			log.Error().
+				Err(err).
+				Str("request_id", requestIDFromGinContext(ctx)).
+				Int32("user_id", payload.UserID).
+				Msg("failed to meter request against daily quota")
+			ctx.Next()
+			return
+		}
+
+		if usage.DailyLimit != nil {
+			ctx.Header("X-Quota-Limit", strconv.Itoa(int(*usage.DailyLimit)))
+			ctx.Header("X-Quota-Remaining", strconv.Itoa(int(*usage.RequestsRemaining)))
+			ctx.Header("X-Quota-Reset", strconv.FormatInt(usage.ResetsAt.Unix(), 10))
+		}
+		if exhausted {
+			retryAfter := max(int64(time.Until(usage.ResetsAt).Seconds()), 1)
+			ctx.Header("Retry-After", strconv.FormatInt(retryAfter, 10))
+			RespondServiceError(ctx, err)
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next()
+	}
+}
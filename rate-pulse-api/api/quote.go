@@ -0,0 +1,123 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/ThanhVinhTong/rate-pulse/service"
+	"github.com/gin-gonic/gin"
+)
+
+// createQuoteRequest represents the request body for pricing a conversion through one rate source.
+// amount is denominated in from_currency_id; the quote is returned in to_currency_id.
+type createQuoteRequest struct {
+	Amount          string `json:"amount" binding:"required"`
+	FromCurrencyID  int32  `json:"from_currency_id" binding:"required,min=1"`
+	ToCurrencyID    int32  `json:"to_currency_id" binding:"required,min=1"`
+	SourceID        int32  `json:"source_id" binding:"required,min=1"`
+	TypeID          int32  `json:"type_id" binding:"required,min=1"`
+	TransactionType string `json:"transaction_type"`
+	Channel         string `json:"channel"`
+	EffectiveDate   string `json:"effective_date"`
+}
+
+// createQuote prices a conversion using the source's latest rate and its active fee rule.
+//
+// POST /quotes
+//
+// Request body: createQuoteRequest (JSON)
+// Response: Quote object with the applied rate, each fee in the destination currency and the net amount
+// Status codes:
+//   - 200 OK: Quote calculated successfully
+//   - 400 Bad Request: Invalid request body or validation error
+//   - 404 Not Found: The source has no rate for the currency pair and type
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) createQuote(ctx *gin.Context) {
+	var req createQuoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	effectiveDate, err := parseOptionalFeeRuleDate("effective_date", stringPtrIfNotEmpty(req.EffectiveDate))
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	input := service.QuoteInput{
+		Amount:          req.Amount,
+		FromCurrencyID:  req.FromCurrencyID,
+		ToCurrencyID:    req.ToCurrencyID,
+		SourceID:        req.SourceID,
+		TypeID:          req.TypeID,
+		TransactionType: req.TransactionType,
+		Channel:         req.Channel,
+	}
+	if effectiveDate != nil {
+		input.EffectiveDate = *effectiveDate
+	}
+
+	quote, err := server.services.FX.Quote(ctx, input)
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, quote)
+}
+
+// compareQuotesRequest represents the query parameters for ranking rate sources on one conversion.
+type compareQuotesRequest struct {
+	Amount          string `form:"amount" binding:"required"`
+	FromCurrencyID  int32  `form:"from_currency_id" binding:"required,min=1"`
+	ToCurrencyID    int32  `form:"to_currency_id" binding:"required,min=1"`
+	TypeID          int32  `form:"type_id" binding:"required,min=1"`
+	TransactionType string `form:"transaction_type"`
+	Channel         string `form:"channel"`
+	EffectiveDate   string `form:"effective_date"`
+}
+
+// compareQuotes ranks every active rate source by the net amount received after fees.
+// Sources without a rate for the currency pair and type are left out of the ranking.
+//
+// GET /quotes/compare
+//
+// Query parameters: compareQuotesRequest
+// Response: list of RankedQuote objects, best net amount first
+// Status codes:
+//   - 200 OK: Ranking calculated successfully
+//   - 400 Bad Request: Invalid query parameters or validation error
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) compareQuotes(ctx *gin.Context) {
+	var req compareQuotesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	effectiveDate, err := parseOptionalFeeRuleDate("effective_date", stringPtrIfNotEmpty(req.EffectiveDate))
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	input := service.CompareQuotesInput{
+		Amount:          req.Amount,
+		FromCurrencyID:  req.FromCurrencyID,
+		ToCurrencyID:    req.ToCurrencyID,
+		TypeID:          req.TypeID,
+		TransactionType: req.TransactionType,
+		Channel:         req.Channel,
+	}
+	if effectiveDate != nil {
+		input.EffectiveDate = *effectiveDate
+	}
+
+	quotes, err := server.services.FX.CompareQuotes(ctx, input)
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, quotes)
+}
@@ -0,0 +1,205 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/ThanhVinhTong/rate-pulse/service"
+	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/gin-gonic/gin"
+)
+
+// createRateAlertRequest represents the request body for watching a source's rate on a currency pair.
+// The watched value is to_currency units per 1 from_currency unit.
+// condition: above | below (threshold required) or percent_change (change_percent required).
+type createRateAlertRequest struct {
+	SourceID       int32   `json:"source_id" binding:"required,min=1"`
+	TypeID         int32   `json:"type_id" binding:"required,min=1"`
+	FromCurrencyID int32   `json:"from_currency_id" binding:"required,min=1"`
+	ToCurrencyID   int32   `json:"to_currency_id" binding:"required,min=1"`
+	Condition      string  `json:"condition" binding:"required,oneof=above below percent_change"`
+	Threshold      *string `json:"threshold"`
+	ChangePercent  *string `json:"change_percent"`
+	WindowHours    int32   `json:"window_hours" binding:"omitempty,min=1,max=720"`
+}
+
+// createRateAlert creates a rate alert owned by the authenticated user.
+//
+// POST /alerts
+//
+// Request body: createRateAlertRequest (JSON)
+// Response: RateAlert object on success, error message on failure
+// Status codes:
+//   - 200 OK: Alert created successfully
+//   - 400 Bad Request: Invalid request body or validation error
+//   - 401 Unauthorized: Missing or invalid access token
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) createRateAlert(ctx *gin.Context) {
+	var req createRateAlertRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	alert, err := server.services.Alerts.CreateRateAlert(ctx, service.CreateRateAlertInput{
+		UserID:         authPayload.UserID,
+		SourceID:       req.SourceID,
+		TypeID:         req.TypeID,
+		FromCurrencyID: req.FromCurrencyID,
+		ToCurrencyID:   req.ToCurrencyID,
+		Condition:      req.Condition,
+		Threshold:      req.Threshold,
+		ChangePercent:  req.ChangePercent,
+		WindowHours:    req.WindowHours,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, alert)
+}
+
+type rateAlertURIRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+// getRateAlert retrieves one of the authenticated user's rate alerts.
+//
+// GET /alerts/:id
+//
+// Status codes:
+//   - 200 OK: Alert retrieved successfully
+//   - 400 Bad Request: Invalid alert ID
+//   - 404 Not Found: Alert does not exist or belongs to another user
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) getRateAlert(ctx *gin.Context) {
+	var req rateAlertURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	alert, err := server.services.Alerts.GetRateAlert(ctx, service.GetRateAlertInput{
+		UserID:  authPayload.UserID,
+		AlertID: req.ID,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, alert)
+}
+
+type listRateAlertsRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=50"`
+}
+
+// listRateAlerts lists the authenticated user's rate alerts.
+//
+// GET /alerts?page_id=1&page_size=10
+//
+// Status codes:
+//   - 200 OK: Alerts retrieved successfully
+//   - 400 Bad Request: Invalid pagination parameters
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) listRateAlerts(ctx *gin.Context) {
+	var req listRateAlertsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	alerts, err := server.services.Alerts.ListRateAlerts(ctx, service.ListRateAlertsInput{
+		UserID:   authPayload.UserID,
+		PageID:   req.PageID,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, alerts)
+}
+
+type updateRateAlertRequest struct {
+	Condition     *string `json:"condition" binding:"omitempty,oneof=above below percent_change"`
+	Threshold     *string `json:"threshold"`
+	ChangePercent *string `json:"change_percent"`
+	WindowHours   *int32  `json:"window_hours" binding:"omitempty,min=1,max=720"`
+	IsActive      *bool   `json:"is_active"`
+}
+
+// updateRateAlert changes the trigger or pauses one of the authenticated user's rate alerts.
+//
+// PUT /alerts/:id
+//
+// Request body: updateRateAlertRequest (JSON), only provided fields are changed
+// Status codes:
+//   - 200 OK: Alert updated successfully
+//   - 400 Bad Request: Invalid request body or validation error
+//   - 404 Not Found: Alert does not exist or belongs to another user
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) updateRateAlert(ctx *gin.Context) {
+	var uri rateAlertURIRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	var req updateRateAlertRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	alert, err := server.services.Alerts.UpdateRateAlert(ctx, service.UpdateRateAlertInput{
+		UserID:        authPayload.UserID,
+		AlertID:       uri.ID,
+		Condition:     req.Condition,
+		Threshold:     req.Threshold,
+		ChangePercent: req.ChangePercent,
+		WindowHours:   req.WindowHours,
+		IsActive:      req.IsActive,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, alert)
+}
+
+// deleteRateAlert deletes one of the authenticated user's rate alerts.
+//
+// DELETE /alerts/:id
+//
+// Status codes:
+//   - 200 OK: Alert deleted successfully
+//   - 400 Bad Request: Invalid alert ID
+//   - 404 Not Found: Alert does not exist or belongs to another user
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) deleteRateAlert(ctx *gin.Context) {
+	var req rateAlertURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	err := server.services.Alerts.DeleteRateAlert(ctx, service.DeleteRateAlertInput{
+		UserID:  authPayload.UserID,
+		AlertID: req.ID,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Rate alert deleted successfully"})
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/service"
 	"github.com/ThanhVinhTong/rate-pulse/util"
 	"github.com/gin-gonic/gin"
 )
@@ -52,7 +53,7 @@ func (server *Server) createRateSource(ctx *gin.Context) {
 		return
 	}
 
-	server.deleteCacheKeys(ctx, cacheKeyRateSources, cacheKeyRateSourceMetadata)
+	server.deleteCacheKeyPrefix(ctx, cacheKeyRateSources)
 	ctx.JSON(http.StatusOK, rateSource)
 }
 
@@ -106,16 +107,62 @@ func (server *Server) listRateSourceMetadata(ctx *gin.Context) {
 	})
 }
 
-// Response: Array of RateSource objects on success, error message on failure
+// listRateSourceRequest represents the query parameters for listing rate sources one cursor page at a time.
+type listRateSourceRequest struct {
+	pageRequest
+	SourceStatus  string `form:"source_status"`
+	SourceCountry string `form:"source_country"`
+}
+
+// listRateSource retrieves a page of rate sources.
+//
+// GET /rate-sources?source_status=active&sort=source_name&cursor=...
+//
+// Query parameters:
+//   - cursor, page_size: Cursor pagination (optional, default page_size: 20, max: 100)
+//   - sort: source_name or source_id, prefixed with "-" for descending (optional, default: source_id)
+//   - source_status, source_country: Exact match filters (optional)
+//
+// Response: Page of RateSource objects with next_cursor on success, error message on failure
 // Status codes:
 //   - 200 OK: Rate sources retrieved successfully
+//   - 400 Bad Request: Invalid cursor, sort or page size
 //   - 500 Internal Server Error: Database or server error
 func (server *Server) listRateSource(ctx *gin.Context) {
-	server.cachedStoreJSON(ctx, cacheKeyRateSources, cacheTTLRateSources, func() (any, error) {
-		return server.store.ListRateSources(ctx)
+	var req listRateSourceRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	server.cachedJSON(ctx, cacheKeyForRequest(ctx, "rate-sources"), cacheTTLRateSources, func() (any, error) {
+		return server.services.RateSources.ListRateSources(ctx, service.ListRateSourcesInput{
+			Page:    req.pageInput(),
+			Status:  stringPtrIfNotEmpty(req.SourceStatus),
+			Country: stringPtrIfNotEmpty(req.SourceCountry),
+		})
 	})
 }
 
+// getRateSourceFreshness reports when each rate source last produced a rate and whether it is stale.
+// It is not cached so a scraper that stops is visible on the next request.
+//
+// GET /rate-sources/freshness
+//
+// Response: RateSourceFreshnessReport object; status is degraded when any active source is stale
+// Status codes:
+//   - 200 OK: Freshness report retrieved successfully
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) getRateSourceFreshness(ctx *gin.Context) {
+	report, err := server.services.Health.GetRateSourceFreshness(ctx)
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
 // updateRateSourceRequest represents the request body for updating a rate source.
 // It contains all the optional fields for rate source updates.
 type updateRateSourceRequest struct {
@@ -124,6 +171,8 @@ type updateRateSourceRequest struct {
 	SourceCountry *string `json:"source_country"`
 	SourceStatus  *string `json:"source_status"`
 	SourceCode    *string `json:"source_code"`
+	// ExpectedIntervalMinutes is how often the source is expected to publish a new rate.
+	ExpectedIntervalMinutes *int32 `json:"expected_interval_minutes" binding:"omitempty,min=5,max=10080"`
 }
 
 type updateRateSourceURIRequest struct {
@@ -169,7 +218,18 @@ func (server *Server) updateRateSource(ctx *gin.Context) {
 		return
 	}
 
-	server.deleteCacheKeys(ctx, cacheKeyRateSources, cacheKeyRateSourceMetadata)
+	if req.ExpectedIntervalMinutes != nil {
+		rateSource, err = server.store.UpdateRateSourceExpectedInterval(ctx, db.UpdateRateSourceExpectedIntervalParams{
+			SourceID:                uriReq.ID,
+			ExpectedIntervalMinutes: *req.ExpectedIntervalMinutes,
+		})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	server.deleteCacheKeyPrefix(ctx, cacheKeyRateSources)
 	ctx.JSON(http.StatusOK, rateSource)
 }
 
@@ -205,6 +265,6 @@ func (server *Server) deleteRateSource(ctx *gin.Context) {
 		return
 	}
 
-	server.deleteCacheKeys(ctx, cacheKeyRateSources, cacheKeyRateSourceMetadata)
+	server.deleteCacheKeyPrefix(ctx, cacheKeyRateSources)
 	ctx.JSON(http.StatusOK, gin.H{"message": "Rate source deleted successfully"})
 }
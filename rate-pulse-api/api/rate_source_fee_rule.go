@@ -105,7 +105,9 @@ func (server *Server) getRateSourceFeeRule(ctx *gin.Context) {
 }
 
 type listRateSourceFeeRulesRequest struct {
+	pageRequest
 	SourceID *int32 `form:"source_id" binding:"omitempty,min=1"`
+	TypeID   *int32 `form:"type_id" binding:"omitempty,min=1"`
 	ActiveOn string `form:"active_on"`
 }
 
@@ -124,7 +126,9 @@ func (server *Server) listRateSourceFeeRules(ctx *gin.Context) {
 
 	server.cachedJSON(ctx, cacheKeyForRequest(ctx, "rate-source-fee-rules"), cacheTTLRateSourceFeeRules, func() (any, error) {
 		return server.services.FeeRules.ListRateSourceFeeRules(ctx, service.ListRateSourceFeeRulesInput{
+			Page:     req.pageInput(),
 			SourceID: req.SourceID,
+			TypeID:   req.TypeID,
 			ActiveOn: activeOn,
 		})
 	})
@@ -2,37 +2,85 @@ package api
 
 import (
 	"fmt"
+	"math"
 	"net"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/ThanhVinhTong/rate-pulse/ratelimit"
+	"github.com/ThanhVinhTong/rate-pulse/token"
 	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
 )
 
-// rateLimitMiddleware enforces rate limiting on all requests
-// Uses an existing auth payload when one is available, otherwise the client address.
+// Stricter per-minute limits for routes that guess credentials or send email, applied to
+// every caller regardless of plan.
+var strictRouteLimits = map[string]int{
+	"POST /users/signin":                 5,
+	"POST /users/signin/mfa":             10,
+	"POST /users/signin/mfa/enroll":      5,
+	"POST /me/mfa/totp/confirm":          10,
+	"POST /me/mfa/totp/disable":          10,
+	"POST /me/mfa/recovery-codes":        10,
+	"POST /users/signup":                 5,
+	"POST /users/verify-email":           10,
+	"POST /users/verify-email/resend":    3,
+	"POST /users/renew-access-token":     30,
+	"POST /users/password-reset/request": 3,
+	"POST /users/password-reset/confirm": 10,
+}
+
+// gatewayPathPrefix is where grpc-gateway serves the transcoded routes. They all match the
+// /v2/*path route, so the policy is chosen from the request path with the prefix removed, which
+// keeps /v2/users/signin on the same strict budget as /users/signin.
+const gatewayPathPrefix = "/v2"
+
+// RateLimitPolicies derives the per-minute policies from RATE_LIMIT_PER_MINUTE: anonymous and
+// free callers get the base limit, paid plans and admins a multiple of it.
+func RateLimitPolicies(requestsPerMin int) ratelimit.Policies {
+	perMinute := func(name string, limit int) ratelimit.Policy {
+		return ratelimit.Policy{Name: name, Limit: limit, Period: time.Minute, Burst: limit}
+	}
+
+	policies := ratelimit.Policies{
+		Default: perMinute("default", requestsPerMin),
+		UserTypes: map[string]ratelimit.Policy{
+			UserTypePremium:    perMinute(UserTypePremium, requestsPerMin*3),
+			UserTypeEnterprise: perMinute(UserTypeEnterprise, requestsPerMin*10),
+			UserTypeAdmin:      perMinute(UserTypeAdmin, requestsPerMin*10),
+		},
+		Routes: make(map[string]ratelimit.Policy, len(strictRouteLimits)),
+	}
+	for route, limit := range strictRouteLimits {
+		policies.Routes[route] = perMinute(route, limit)
+	}
+	return policies
+}
+
+// rateLimitMiddleware enforces rate limiting on all requests.
+// It runs before authentication, so it reads the bearer token itself to key signed-in users by
+// ID and pick the policy of their user type; valid API keys are keyed by key with the policy of their
+// owner's user type, and everyone else by IP.
 func (server *Server) rateLimitMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		// Determine the identifier: user ID if authenticated, otherwise IP
-		var identifier string
-		if payload, ok := authPayloadFromGinContext(ctx); ok {
-			identifier = fmt.Sprintf("user:%d", payload.UserID)
-		} else {
-			identifier = clientIdentifier(ctx)
-		}
+		identifier, userType := server.rateLimitIdentity(ctx)
+		policy := server.rateLimits.Select(rateLimitRoute(ctx), userType)
 
-		// Check rate limit
-		allowed, remaining, resetTime := server.rateLimiter.Allow(ctx, identifier)
+		result, err := server.rateLimiter.Allow(ctx, identifier, policy)
+		if err != nil {
+			log.Error().Err(err).Str("policy", policy.Name).Msg("rate limiter failed, allowing request")
+			ctx.Next()
+			return
+		}
 
-		// Add rate limit headers to response
-		ctx.Header("X-RateLimit-Limit", strconv.Itoa(server.config.RateLimitPerMinute))
-		ctx.Header("X-RateLimit-Remaining", strconv.Itoa(remaining))
-		ctx.Header("X-RateLimit-Reset", strconv.FormatInt(resetTime, 10))
+		ctx.Header("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+		ctx.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		ctx.Header("X-RateLimit-Reset", strconv.FormatInt(result.ResetAt.Unix(), 10))
 
-		if !allowed {
-			retryAfter := resetTime - time.Now().Unix()
+		if !result.Allowed {
+			retryAfter := int64(math.Ceil(result.RetryAfter.Seconds()))
 			if retryAfter < 1 {
 				retryAfter = 1
 			}
@@ -48,6 +96,36 @@ func (server *Server) rateLimitMiddleware() gin.HandlerFunc {
 	}
 }
 
+func (server *Server) rateLimitIdentity(ctx *gin.Context) (string, string) {
+	if authorizationHeader := ctx.GetHeader(authorizationHeaderKey); authorizationHeader != "" {
+		if accessToken, err := bearerToken(authorizationHeader); err == nil {
+			if payload, err := server.tokenMaker.VerifyToken(accessToken, token.TokenTypeAccess); err == nil {
+				return fmt.Sprintf("user:%d", payload.UserID), payload.UserType
+			}
+		}
+	}
+
+	// Only a key that authenticates gets its own bucket; keyed on the raw header, a caller could
+	// send a fresh random key with every request and never be limited.
+	// The key takes the policy of its owner's user type, like the owner's bearer token would.
+	if ctx.GetHeader(apiKeyHeaderKey) != "" {
+		if principal, err := resolveAPIKey(ctx, server.services.APIKeys); err == nil {
+			return fmt.Sprintf("key:%d", principal.APIKeyID), principal.Payload.UserType
+		}
+	}
+
+	return "ip:" + clientIdentifier(ctx), ""
+}
+
+// rateLimitRoute is the "METHOD /path" the policy is selected by.
+func rateLimitRoute(ctx *gin.Context) string {
+	fullPath := ctx.FullPath()
+	if fullPath == gatewayPathPrefix+"/*path" {
+		fullPath = strings.TrimPrefix(ctx.Request.URL.Path, gatewayPathPrefix)
+	}
+	return ctx.Request.Method + " " + fullPath
+}
+
 func clientIdentifier(ctx *gin.Context) string {
 	clientIP := strings.TrimSpace(ctx.ClientIP())
 	if clientIP != "" {
@@ -6,27 +6,34 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/ThanhVinhTong/rate-pulse/cache"
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	cacheKeyPrefix = "rate-pulse:http:v1:"
+	cacheKeyPrefix = cache.HTTPKeyPrefix
 
 	cacheTTLExchangeRatesLatest = 2 * time.Hour
 	cacheTTLHistoricalData      = 24 * time.Hour
+	cacheTTLCandles             = time.Hour
 	cacheTTLReferenceDataMonth  = 30 * 24 * time.Hour
 	cacheTTLRateSources         = 7 * 24 * time.Hour
 	cacheTTLRateSourceFeeRules  = time.Hour
 )
 
 const (
-	cacheKeyCurrencies         = cacheKeyPrefix + "currencies"
-	cacheKeyCurrencyCodesNames = cacheKeyPrefix + "currencies:codes-and-names"
-	cacheKeyCountries          = cacheKeyPrefix + "countries"
-	cacheKeyExchangeRateTypes  = cacheKeyPrefix + "exchange-rate-types"
-	cacheKeyRateSources        = cacheKeyPrefix + "rate-sources"
-	cacheKeyRateSourceMetadata = cacheKeyPrefix + "rate-sources:metadata"
-	cacheKeyRateSourceFeeRules = cacheKeyPrefix + "rate-source-fee-rules"
+	cacheKeyCurrencies          = cacheKeyPrefix + "currencies"
+	cacheKeyCurrencyCodesNames  = cacheKeyPrefix + "currencies:codes-and-names"
+	cacheKeyCountries           = cacheKeyPrefix + "countries"
+	cacheKeyExchangeRateTypes   = cacheKeyPrefix + "exchange-rate-types"
+	cacheKeyRateSources         = cacheKeyPrefix + "rate-sources"
+	cacheKeyRateSourceMetadata  = cacheKeyPrefix + "rate-sources:metadata"
+	cacheKeyRateSourceFeeRules  = cacheKeyPrefix + "rate-source-fee-rules"
+	cacheKeyExchangeRatesLatest = cacheKeyPrefix + "exchange-rates-latest"
+	cacheKeyHistoricalData      = cacheKeyPrefix + "exchange-rates:historical"
+	cacheKeyCandles             = cacheKeyPrefix + "exchange-rates:candles"
+	cacheKeyCrossRates          = cacheKeyPrefix + "exchange-rates:cross"
+	cacheKeySpreads             = cacheKeyPrefix + "exchange-rates:spreads"
 )
 
 func (server *Server) cachedJSON(ctx *gin.Context, key string, ttl time.Duration, fetch func() (any, error)) {
@@ -81,6 +88,19 @@ func (server *Server) deleteCacheKeyPrefix(ctx *gin.Context, prefix string) {
 	_ = server.responseCache.DeleteByPrefix(ctx.Request.Context(), prefix)
 }
 
+// deleteExchangeRateCaches drops every cached response derived from exchange rates after new rates go live.
+func (server *Server) deleteExchangeRateCaches(ctx *gin.Context) {
+	for _, prefix := range []string{
+		cacheKeyExchangeRatesLatest,
+		cacheKeyHistoricalData,
+		cacheKeyCandles,
+		cacheKeyCrossRates,
+		cacheKeySpreads,
+	} {
+		server.deleteCacheKeyPrefix(ctx, prefix)
+	}
+}
+
 func cacheKeyForRequest(ctx *gin.Context, namespace string) string {
 	query, _ := url.ParseQuery(ctx.Request.URL.RawQuery)
 	encodedQuery := query.Encode()
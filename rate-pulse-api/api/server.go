@@ -6,6 +6,7 @@ import (
 
 	"github.com/ThanhVinhTong/rate-pulse/cache"
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
 	"github.com/ThanhVinhTong/rate-pulse/ratelimit"
 	"github.com/ThanhVinhTong/rate-pulse/service"
 	"github.com/ThanhVinhTong/rate-pulse/token"
@@ -20,9 +21,12 @@ type Server struct {
 	config        util.Config
 	store         db.Store
 	tokenMaker    token.Maker
+	revocations   token.RevocationList
 	services      *service.Services
 	responseCache cache.ResponseCache
-	rateLimiter   *ratelimit.RateLimiter
+	rateUpdates   pubsub.Subscriber
+	rateLimiter   ratelimit.Limiter
+	rateLimits    ratelimit.Policies
 	router        *gin.Engine
 }
 
@@ -31,6 +35,7 @@ func NewServer(
 	store db.Store,
 	services *service.Services,
 	tokenMaker token.Maker,
+	revocations token.RevocationList,
 	redisClient *redis.Client,
 ) (*Server, error) {
 	// Initialize rate limiter with default 300 req/min if not configured
@@ -40,13 +45,21 @@ func NewServer(
 	}
 	config.RateLimitPerMinute = requestsPerMin
 
+	algorithm, err := ratelimit.ParseAlgorithm(config.RateLimitAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
 	server := &Server{
 		config:        config,
 		store:         store,
 		tokenMaker:    tokenMaker,
+		revocations:   revocations,
 		services:      services,
 		responseCache: cache.NoopResponseCache{},
-		rateLimiter:   ratelimit.NewRateLimiter(redisClient, requestsPerMin),
+		rateUpdates:   pubsub.NoopBroker{},
+		rateLimiter:   ratelimit.New(redisClient, algorithm),
+		rateLimits:    RateLimitPolicies(requestsPerMin),
 	}
 
 	server.setupRouter()
@@ -61,6 +74,14 @@ func (server *Server) SetResponseCache(responseCache cache.ResponseCache) {
 	server.responseCache = responseCache
 }
 
+func (server *Server) SetRateUpdates(rateUpdates pubsub.Subscriber) {
+	if rateUpdates == nil {
+		return
+	}
+
+	server.rateUpdates = rateUpdates
+}
+
 func (server *Server) setupRouter() {
 	router := gin.New()
 	router.Use(ginRecovery())
@@ -78,16 +99,21 @@ func (server *Server) setupRouter() {
 			"https://rate-pulse.vincenttong.workers.dev",
 		},
 		AllowMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders: []string{"Origin", "Content-Type", "Accept", "Authorization"},
+		AllowHeaders: []string{"Origin", "Content-Type", "Accept", "Authorization", apiKeyHeaderKey},
 		MaxAge:       12 * time.Hour,
 	}))
 
 	// Public routes (no authentication required)
 	router.POST("/users/signup", server.createUser)
 	router.POST("/users/signin", server.loginUser)
+	router.POST("/users/signin/mfa", server.completeSignInMFA)
+	router.POST("/users/signin/mfa/enroll", server.enrollSignInTOTP)
 	router.POST("/users/signout", server.logoutUser)
 	router.POST("/users/renew-access-token", server.renewAccessToken)
 	router.POST("/users/verify-email", server.verifyEmail)
+	router.POST("/users/verify-email/resend", server.resendVerifyEmail)
+	router.POST("/users/password-reset/request", server.requestPasswordReset)
+	router.POST("/users/password-reset/confirm", server.confirmPasswordReset)
 
 	router.GET("/health", func(ctx *gin.Context) {
 		ctx.JSON(http.StatusOK, gin.H{"message": "OK"})
@@ -95,35 +121,53 @@ func (server *Server) setupRouter() {
 	registerSwaggerRoutes(router)
 
 	// Public read-only market & reference data (no auth) — browse exchange-rates & historical UIs while logged out.
-	// Register specific paths before /:id routes.
-	router.GET("/currencies", server.listCurrency)
-	router.GET("/currencies/codes-and-names", server.listCurrencyCodesAndNames)
-	router.GET("/currencies/:id", server.getCurrency)
-	router.GET("/exchange-rates/:id", server.getExchangeRate)
-	router.GET("/exchange-rates-latest", server.listExchangeRateToday)
-	router.GET("/exchange-rates/historical", server.getHistoricalData)
-	router.GET("/exchange-rate-types", server.listExchangeRateTypes)
-	router.GET("/rate-sources", server.listRateSource)
-	router.GET("/rate-sources/metadata", server.listRateSourceMetadata)
-	router.GET("/rate-sources/:id", server.getRateSource)
-	router.GET("/rate-source-fee-rules/active", server.getActiveRateSourceFeeRule)
-	router.GET("/rate-source-fee-rules/:id", server.getRateSourceFeeRule)
-	router.GET("/rate-source-fee-rules", server.listRateSourceFeeRules)
-	router.GET("/subscription-plans", server.listActiveSubscriptionPlans)
-	router.GET("/subscription-plans/:id", server.getSubscriptionPlan)
-	router.GET("/countries/code/:country_code", server.getCountryByCode)
-	router.GET("/countries/:id", server.getCountry)
-	router.GET("/countries", server.listCountry)
-
-	// Protected routes (authentication required)
-	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
-	adminRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker), adminMiddleware())
+	// Register specific paths before /:id routes. Machine clients may send an X-API-Key with the read:rates scope.
+	// Signed-in callers and API keys are metered against the daily quota of their plan.
+	publicRoutes := router.Group("/").Use(
+		optionalAuthMiddleware(server.tokenMaker, server.revocations, server.services.APIKeys, service.APIKeyScopeReadRates),
+		server.quotaMiddleware(),
+	)
+	publicRoutes.GET("/currencies", server.listCurrency)
+	publicRoutes.GET("/currencies/codes-and-names", server.listCurrencyCodesAndNames)
+	publicRoutes.GET("/currencies/:id", server.getCurrency)
+	publicRoutes.GET("/exchange-rates/:id", server.getExchangeRate)
+	publicRoutes.GET("/exchange-rates-latest", server.listExchangeRateToday)
+	router.GET("/exchange-rates/historical",
+		optionalAuthMiddleware(server.tokenMaker, server.revocations, server.services.APIKeys, service.APIKeyScopeReadHistorical),
+		server.quotaMiddleware(),
+		server.getHistoricalData,
+	)
+	publicRoutes.GET("/exchange-rates/candles", server.getCandles)
+	publicRoutes.GET("/exchange-rates/cross", server.getCrossRate)
+	publicRoutes.GET("/exchange-rates/spreads", server.getSpreads)
+	publicRoutes.GET("/exchange-rates/stream", server.streamExchangeRates)
+	publicRoutes.GET("/exchange-rate-types", server.listExchangeRateTypes)
+	publicRoutes.GET("/rate-sources", server.listRateSource)
+	publicRoutes.GET("/rate-sources/metadata", server.listRateSourceMetadata)
+	publicRoutes.GET("/rate-sources/freshness", server.getRateSourceFreshness)
+	publicRoutes.GET("/rate-sources/:id", server.getRateSource)
+	publicRoutes.GET("/rate-source-fee-rules/active", server.getActiveRateSourceFeeRule)
+	publicRoutes.GET("/rate-source-fee-rules/:id", server.getRateSourceFeeRule)
+	publicRoutes.GET("/rate-source-fee-rules", server.listRateSourceFeeRules)
+	publicRoutes.GET("/subscription-plans", server.listActiveSubscriptionPlans)
+	publicRoutes.GET("/subscription-plans/:id", server.getSubscriptionPlan)
+	publicRoutes.GET("/countries/code/:country_code", server.getCountryByCode)
+	publicRoutes.GET("/countries/:id", server.getCountry)
+	publicRoutes.GET("/countries", server.listCountry)
+	publicRoutes.GET("/quotes/compare", server.compareQuotes)
+	publicRoutes.POST("/quotes", server.createQuote)
+
+	// Protected routes (authentication required). Creating subscriptions, alerts and API keys
+	// also requires a verified email.
+	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker, server.revocations, server.services.APIKeys))
+	adminRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker, server.revocations, server.services.APIKeys, service.APIKeyScopeAdmin), adminMiddleware())
 
 	// add `users` routes
 	authRoutes.GET("/users/:id", server.getUser)
 	authRoutes.GET("/users", server.listUser)
 	authRoutes.PUT("/users/:id", server.updateUser)
 	adminRoutes.PUT("/admin/users/:id", server.adminUpdateUser)
+	adminRoutes.POST("/admin/users/:id/sessions/block", server.blockUserSessions)
 	adminRoutes.DELETE("/admin/users/:id", server.deleteUser)
 
 	// add `currencies` routes (mutations only; reads are public above)
@@ -135,6 +179,9 @@ func (server *Server) setupRouter() {
 	adminRoutes.POST("/admin/exchange-rates", server.createExchangeRate)
 	adminRoutes.PUT("/admin/exchange-rates/:id", server.updateExchangeRate)
 	adminRoutes.DELETE("/admin/exchange-rates/:id", server.deleteExchangeRate)
+	adminRoutes.POST("/admin/ingest/exchange-rates", server.ingestExchangeRates)
+	adminRoutes.GET("/admin/exchange-rates/quarantined", server.listQuarantinedExchangeRates)
+	adminRoutes.PUT("/admin/exchange-rates/:id/review", server.reviewQuarantinedExchangeRate)
 
 	// add `rate-sources` routes (mutations only; reads are public above)
 	adminRoutes.POST("/admin/rate-sources", server.createRateSource)
@@ -158,7 +205,7 @@ func (server *Server) setupRouter() {
 	adminRoutes.DELETE("/admin/subscription-plans/:id", server.deleteSubscriptionPlan)
 
 	// add `user_subscriptions` routes
-	authRoutes.POST("/subscriptions", server.createUserSubscription)
+	authRoutes.POST("/subscriptions", server.verifiedEmailMiddleware(), server.createUserSubscription)
 	authRoutes.GET("/subscriptions", server.listMyUserSubscriptions)
 	authRoutes.GET("/subscriptions/active", server.getMyActiveUserSubscription)
 	adminRoutes.GET("/admin/subscriptions", server.listAllUserSubscriptions)
@@ -190,6 +237,32 @@ func (server *Server) setupRouter() {
 	authRoutes.PUT("/currency-preference/:currency_id", server.updateCurrencyPreference)
 	authRoutes.DELETE("/currency-preference/:currency_id", server.deleteCurrencyPreference)
 
+	// Any API key may read its owner's usage, so ETL jobs can watch their quota.
+	router.GET("/me/usage",
+		authMiddleware(server.tokenMaker, server.revocations, server.services.APIKeys,
+			service.APIKeyScopeReadRates, service.APIKeyScopeReadHistorical, service.APIKeyScopeAdmin),
+		server.getMyUsage,
+	)
+
+	authRoutes.GET("/me/sessions", server.listMySessions)
+	authRoutes.DELETE("/me/sessions", server.revokeAllMySessions)
+	authRoutes.DELETE("/me/sessions/:id", server.revokeMySession)
+	authRoutes.GET("/me/mfa", server.getMyMFAStatus)
+	authRoutes.POST("/me/mfa/totp", server.enrollMyTOTP)
+	authRoutes.POST("/me/mfa/totp/confirm", server.confirmMyTOTP)
+	authRoutes.POST("/me/mfa/totp/disable", server.disableMyTOTP)
+	authRoutes.POST("/me/mfa/recovery-codes", server.regenerateMyRecoveryCodes)
+
+	authRoutes.POST("/api-keys", server.verifiedEmailMiddleware(), server.createAPIKey)
+	authRoutes.GET("/api-keys", server.listAPIKeys)
+	authRoutes.DELETE("/api-keys/:id", server.revokeAPIKey)
+
+	authRoutes.POST("/alerts", server.verifiedEmailMiddleware(), server.createRateAlert)
+	authRoutes.GET("/alerts", server.listRateAlerts)
+	authRoutes.GET("/alerts/:id", server.getRateAlert)
+	authRoutes.PUT("/alerts/:id", server.updateRateAlert)
+	authRoutes.DELETE("/alerts/:id", server.deleteRateAlert)
+
 	server.router = router
 }
 
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/ThanhVinhTong/rate-pulse/service"
+	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// listMySessions lists the devices the authenticated user is signed in on.
+//
+// GET /me/sessions
+//
+// Response: array of Session objects (device, IP, last used), most recently used first
+// Status codes:
+//   - 200 OK: Sessions retrieved successfully
+//   - 401 Unauthorized: Missing or invalid access token
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) listMySessions(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	sessions, err := server.services.Sessions.ListSessions(ctx, authPayload.UserID)
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sessions)
+}
+
+type sessionURIRequest struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// revokeMySession signs the authenticated user out of one device; its refresh token stops working.
+// Access tokens are revoked per user, not per session, so every access token of the user stops
+// working too and the other devices renew theirs with their refresh token.
+//
+// DELETE /me/sessions/:id
+//
+// Status codes:
+//   - 200 OK: Session revoked successfully
+//   - 400 Bad Request: Invalid session ID
+//   - 401 Unauthorized: Missing or invalid access token
+//   - 404 Not Found: Session does not exist, belongs to another user or is already revoked
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) revokeMySession(ctx *gin.Context) {
+	var req sessionURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	err := server.services.Sessions.RevokeSession(ctx, service.RevokeSessionInput{
+		UserID:    authPayload.UserID,
+		SessionID: uuid.MustParse(req.ID),
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Session revoked successfully"})
+}
+
+// revokeAllMySessions signs the authenticated user out everywhere.
+//
+// DELETE /me/sessions
+//
+// Response: RevokedSessions with the number of sessions revoked
+// Status codes:
+//   - 200 OK: Sessions revoked successfully
+//   - 401 Unauthorized: Missing or invalid access token
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) revokeAllMySessions(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	revoked, err := server.services.Sessions.RevokeAllSessions(ctx, authPayload.UserID)
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revoked)
+}
+
+type adminUserURIRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+// blockUserSessions blocks every active session of a user, e.g. after an account compromise.
+//
+// POST /admin/users/:id/sessions/block
+//
+// Response: RevokedSessions with the number of sessions blocked
+// Status codes:
+//   - 200 OK: Sessions blocked successfully
+//   - 400 Bad Request: Invalid user ID
+//   - 401 Unauthorized: Missing or invalid access token, or not an admin
+//   - 404 Not Found: User does not exist
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) blockUserSessions(ctx *gin.Context) {
+	var req adminUserURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	blocked, err := server.services.Sessions.BlockUserSessions(ctx, req.ID)
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, blocked)
+}
@@ -1,13 +1,18 @@
 package api
 
 import (
+	"encoding"
 	"encoding/json"
 	"net/http"
+	"reflect"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ThanhVinhTong/rate-pulse/docs"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 func registerSwaggerRoutes(router *gin.Engine) {
@@ -17,6 +22,7 @@ func registerSwaggerRoutes(router *gin.Engine) {
 	router.GET("/swagger/doc.json", func(ctx *gin.Context) {
 		ctx.JSON(http.StatusOK, buildSwaggerSpec(router.Routes()))
 	})
+	router.GET("/swagger/v2.json", serveGatewaySwaggerSpec)
 }
 
 func redirectToSwaggerIndex(ctx *gin.Context) {
@@ -27,33 +33,22 @@ func serveSwaggerUI(ctx *gin.Context) {
 	ctx.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerHTML))
 }
 
-func buildSwaggerSpec(routes gin.RoutesInfo) map[string]any {
-	spec := map[string]any{}
-	if err := json.Unmarshal(docs.SwaggerJSON, &spec); err != nil {
-		spec = map[string]any{
-			"swagger": "2.0",
-			"info": map[string]any{
-				"title":   "Rate Pulse API",
-				"version": "1.0",
-			},
-		}
-	}
-
-	spec["basePath"] = "/"
-	spec["paths"] = buildSwaggerPaths(routes)
-	spec["securityDefinitions"] = map[string]any{
-		"BearerAuth": map[string]any{
-			"type":        "apiKey",
-			"name":        "Authorization",
-			"in":          "header",
-			"description": `Use "Bearer <access_token>".`,
-		},
-	}
-
-	return spec
+// serveGatewaySwaggerSpec serves the OpenAPI document generated from the protos by `make protoc`.
+// It describes the /v2 grpc-gateway routes with their request and response schemas.
+func serveGatewaySwaggerSpec(ctx *gin.Context) {
+	ctx.Data(http.StatusOK, "application/json; charset=utf-8", docs.SwaggerJSON)
 }
 
-func buildSwaggerPaths(routes gin.RoutesInfo) map[string]any {
+/*
+buildSwaggerSpec documents the Gin routes from the structs their handlers bind and return.
+- uri, form and json tags decide whether a field is a path, query or body parameter
+- binding rules become schema constraints (required, min/max, len, oneof, email)
+- response and error schemas are reflected from the returned models and apiErrorResponse
+*/
+func buildSwaggerSpec(routes gin.RoutesInfo) map[string]any {
+	builder := &swaggerBuilder{definitions: make(map[string]any)}
+	builder.schemaFor(reflect.TypeOf(apiErrorResponse{}))
+
 	paths := make(map[string]any)
 	sort.Slice(routes, func(i, j int) bool {
 		if routes[i].Path == routes[j].Path {
@@ -61,9 +56,8 @@ func buildSwaggerPaths(routes gin.RoutesInfo) map[string]any {
 		}
 		return routes[i].Path < routes[j].Path
 	})
-
 	for _, route := range routes {
-		if strings.HasPrefix(route.Path, "/swagger") {
+		if !isDocumentedSwaggerRoute(route.Path) {
 			continue
 		}
 
@@ -73,62 +67,423 @@ func buildSwaggerPaths(routes gin.RoutesInfo) map[string]any {
 			methods = make(map[string]any)
 			paths[path] = methods
 		}
+		methods[strings.ToLower(route.Method)] = builder.operation(route, swaggerRoutes[swaggerRouteKey(route.Method, route.Path)])
+	}
 
-		methods[strings.ToLower(route.Method)] = swaggerOperation(route)
+	return map[string]any{
+		"swagger": "2.0",
+		"info": map[string]any{
+			"title":   "Rate Pulse API",
+			"version": "1.0",
+		},
+		"basePath": "/",
+		"consumes": []string{"application/json"},
+		"produces": []string{"application/json"},
+		"paths":    paths,
+		"securityDefinitions": map[string]any{
+			"BearerAuth": map[string]any{
+				"type":        "apiKey",
+				"name":        "Authorization",
+				"in":          "header",
+				"description": `Use "Bearer <access_token>".`,
+			},
+			"ApiKeyAuth": map[string]any{
+				"type":        "apiKey",
+				"name":        apiKeyHeaderKey,
+				"in":          "header",
+				"description": "API key from POST /api-keys. Public rate routes need read:rates, historical data read:historical and /admin/ routes admin.",
+			},
+		},
+		"definitions": builder.definitions,
 	}
+}
 
-	return paths
+// isDocumentedSwaggerRoute skips the docs themselves and the /v2 gateway, which has its own spec.
+func isDocumentedSwaggerRoute(path string) bool {
+	return !strings.HasPrefix(path, "/swagger") && !strings.HasPrefix(path, "/v2/")
 }
 
-func swaggerOperation(route gin.RouteInfo) map[string]any {
+type swaggerBuilder struct {
+	definitions map[string]any
+}
+
+func (builder *swaggerBuilder) operation(route gin.RouteInfo, doc swaggerRoute) map[string]any {
 	operation := map[string]any{
 		"tags":        []string{swaggerTag(route.Path)},
-		"summary":     swaggerSummary(route.Method, route.Path),
-		"operationId": swaggerOperationID(route.Method, route.Path),
-		"responses": map[string]any{
-			"200": map[string]any{"description": "OK"},
-			"400": map[string]any{"description": "Bad Request"},
-			"401": map[string]any{"description": "Unauthorized"},
-			"500": map[string]any{"description": "Internal Server Error"},
-		},
+		"summary":     doc.Summary,
+		"operationId": swaggerOperationID(route),
+	}
+	if doc.Summary == "" {
+		operation["summary"] = route.Method + " " + swaggerPath(route.Path)
 	}
 
-	if params := swaggerPathParameters(route.Path); len(params) > 0 {
+	params, hasBody := builder.parameters(route.Path, doc.Requests)
+	if len(params) > 0 {
 		operation["parameters"] = params
 	}
-	if isAuthenticatedSwaggerRoute(route.Path) {
-		operation["security"] = []map[string][]string{{"BearerAuth": []string{}}}
+
+	success := map[string]any{"description": "OK"}
+	if doc.Response != nil {
+		success["schema"] = builder.schemaFor(reflect.TypeOf(doc.Response))
+	}
+	if doc.Produces != "" {
+		operation["produces"] = []string{doc.Produces}
+	}
+
+	responses := map[string]any{"200": success}
+	errorSchema := map[string]any{"$ref": "#/definitions/" + swaggerDefinitionName(reflect.TypeOf(apiErrorResponse{}))}
+	addError := func(code int) {
+		responses[strconv.Itoa(code)] = map[string]any{
+			"description": http.StatusText(code),
+			"schema":      errorSchema,
+		}
+	}
+	if len(params) > 0 || hasBody {
+		addError(http.StatusBadRequest)
+	}
+
+	auth := doc.Auth
+	if strings.HasPrefix(route.Path, "/admin/") {
+		auth = swaggerAuthAdmin
+	}
+	switch auth {
+	case swaggerAuthOptional:
+		operation["security"] = []map[string][]string{{}, {"BearerAuth": {}}, {"ApiKeyAuth": {}}}
+		addError(http.StatusUnauthorized)
+	case swaggerAuthRequired:
+		operation["security"] = []map[string][]string{{"BearerAuth": {}}}
+		addError(http.StatusUnauthorized)
+	case swaggerAuthAdmin:
+		operation["security"] = []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}}
+		addError(http.StatusUnauthorized)
+		addError(http.StatusForbidden)
+	}
+	if strings.Contains(route.Path, "/:") {
+		addError(http.StatusNotFound)
 	}
+	addError(http.StatusTooManyRequests)
+	addError(http.StatusInternalServerError)
+	operation["responses"] = responses
 
 	return operation
 }
 
-func swaggerPath(path string) string {
-	segments := strings.Split(path, "/")
-	for i, segment := range segments {
-		if strings.HasPrefix(segment, ":") {
-			segments[i] = "{" + strings.TrimPrefix(segment, ":") + "}"
+// parameters lists the path and query parameters of a route and its JSON body, if any.
+func (builder *swaggerBuilder) parameters(path string, requests []any) ([]map[string]any, bool) {
+	pathParams := make(map[string]map[string]any)
+	var queryParams []map[string]any
+	var body reflect.Type
+
+	for _, request := range requests {
+		t := reflect.TypeOf(request)
+		for _, field := range swaggerStructFields(t) {
+			if name := tagName(field.Tag.Get("uri")); name != "" {
+				param := builder.parameter(name, "path", field)
+				param["required"] = true
+				pathParams[name] = param
+			}
+			if name := tagName(field.Tag.Get("form")); name != "" {
+				queryParams = append(queryParams, builder.parameter(name, "query", field))
+			}
+			if name := tagName(field.Tag.Get("json")); name != "" && name != "-" {
+				body = t
+			}
 		}
 	}
-	return strings.Join(segments, "/")
-}
 
-func swaggerPathParameters(path string) []map[string]any {
 	var params []map[string]any
 	for _, segment := range strings.Split(path, "/") {
-		if !strings.HasPrefix(segment, ":") {
+		if !strings.HasPrefix(segment, ":") && !strings.HasPrefix(segment, "*") {
 			continue
 		}
-
+		name := segment[1:]
+		param, ok := pathParams[name]
+		if !ok {
+			param = map[string]any{"name": name, "in": "path", "required": true, "type": "string"}
+		}
+		params = append(params, param)
+	}
+	params = append(params, queryParams...)
+	if body != nil {
 		params = append(params, map[string]any{
-			"name":        strings.TrimPrefix(segment, ":"),
-			"in":          "path",
-			"required":    true,
-			"type":        "string",
-			"description": "Path parameter",
+			"name":     "body",
+			"in":       "body",
+			"required": true,
+			"schema":   builder.schemaFor(body),
 		})
 	}
-	return params
+
+	return params, body != nil
+}
+
+func (builder *swaggerBuilder) parameter(name, in string, field reflect.StructField) map[string]any {
+	param := map[string]any{"name": name, "in": in}
+	for key, value := range builder.schemaFor(field.Type) {
+		param[key] = value
+	}
+	if applySwaggerBindingRules(param, field.Type, field.Tag.Get("binding")) {
+		param["required"] = true
+	}
+	if rule := bindingRule(field.Tag.Get("binding"), "required_without"); rule != "" {
+		param["description"] = "Required when " + jsonFieldName(rule) + " is not set"
+	}
+	if value, ok := formDefault(field.Tag.Get("form")); ok {
+		param["default"] = swaggerValue(field.Type, value)
+	}
+	return param
+}
+
+// schemaFor returns the schema of t as encoding/json writes it; named structs become definitions.
+func (builder *swaggerBuilder) schemaFor(t reflect.Type) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case reflect.TypeOf(time.Time{}):
+		return map[string]any{"type": "string", "format": "date-time"}
+	case reflect.TypeOf(uuid.UUID{}):
+		return map[string]any{"type": "string", "format": "uuid"}
+	case reflect.TypeOf(json.RawMessage{}):
+		return map[string]any{}
+	}
+	if reflect.PointerTo(t).Implements(reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()) {
+		return map[string]any{"type": "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return map[string]any{"type": "integer", "format": "int64"}
+	case reflect.Float32:
+		return map[string]any{"type": "number", "format": "float"}
+	case reflect.Float64:
+		return map[string]any{"type": "number", "format": "double"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]any{"type": "string", "format": "byte"}
+		}
+		return map[string]any{"type": "array", "items": builder.schemaFor(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": builder.schemaFor(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return builder.structSchema(t)
+		}
+		name := swaggerDefinitionName(t)
+		if _, ok := builder.definitions[name]; !ok {
+			// Reserve the name first so self-referencing types terminate.
+			builder.definitions[name] = map[string]any{}
+			builder.definitions[name] = builder.structSchema(t)
+		}
+		return map[string]any{"$ref": "#/definitions/" + name}
+	default:
+		return map[string]any{}
+	}
+}
+
+func (builder *swaggerBuilder) structSchema(t reflect.Type) map[string]any {
+	properties := make(map[string]any)
+	var required []string
+	for _, field := range swaggerStructFields(t) {
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+		if name == "" {
+			// Fields bound from the path or query string are not part of the body.
+			if field.Tag.Get("uri") != "" || field.Tag.Get("form") != "" {
+				continue
+			}
+			name = field.Name
+		}
+
+		schema := builder.schemaFor(field.Type)
+		if _, isRef := schema["$ref"]; !isRef {
+			if applySwaggerBindingRules(schema, field.Type, field.Tag.Get("binding")) {
+				required = append(required, name)
+			}
+		} else if bindingRule(field.Tag.Get("binding"), "required") != "" {
+			required = append(required, name)
+		}
+		properties[name] = schema
+	}
+
+	schema := map[string]any{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// swaggerStructFields flattens embedded structs the same way encoding/json does.
+func swaggerStructFields(t reflect.Type) []reflect.StructField {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var fields []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				fields = append(fields, swaggerStructFields(embedded)...)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+/*
+applySwaggerBindingRules copies validator rules onto a schema and reports whether the field is required.
+- min/max/len limit the value of numbers, the length of strings and the size of arrays
+- rules after dive apply to the array items
+*/
+func applySwaggerBindingRules(schema map[string]any, t reflect.Type, binding string) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	required := false
+	rules := strings.Split(binding, ",")
+	for i, rule := range rules {
+		name, value, _ := strings.Cut(rule, "=")
+		switch name {
+		case "required":
+			required = true
+		case "email":
+			schema["format"] = "email"
+		case "oneof":
+			var enum []any
+			for _, option := range strings.Fields(value) {
+				enum = append(enum, swaggerValue(t, option))
+			}
+			schema["enum"] = enum
+		case "min", "max", "len":
+			limit, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			for _, key := range swaggerLimitKeys(t, name) {
+				schema[key] = limit
+			}
+		case "dive":
+			if items, ok := schema["items"].(map[string]any); ok {
+				if _, isRef := items["$ref"]; !isRef {
+					applySwaggerBindingRules(items, t.Elem(), strings.Join(rules[i+1:], ","))
+				}
+			}
+			return required
+		}
+	}
+	return required
+}
+
+func swaggerLimitKeys(t reflect.Type, rule string) []string {
+	var minKey, maxKey string
+	switch t.Kind() {
+	case reflect.String:
+		minKey, maxKey = "minLength", "maxLength"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		minKey, maxKey = "minItems", "maxItems"
+	default:
+		minKey, maxKey = "minimum", "maximum"
+	}
+
+	switch rule {
+	case "min":
+		return []string{minKey}
+	case "max":
+		return []string{maxKey}
+	default:
+		return []string{minKey, maxKey}
+	}
+}
+
+// swaggerValue converts an enum or default value from a struct tag to the field's JSON type.
+func swaggerValue(t reflect.Type, value string) any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if parsed, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return parsed
+		}
+	case reflect.Float32, reflect.Float64:
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+			return parsed
+		}
+	case reflect.Bool:
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+	}
+	return value
+}
+
+func bindingRule(binding, name string) string {
+	for _, rule := range strings.Split(binding, ",") {
+		ruleName, value, _ := strings.Cut(rule, "=")
+		if ruleName == name {
+			if value == "" {
+				return ruleName
+			}
+			return value
+		}
+	}
+	return ""
+}
+
+func formDefault(tag string) (string, bool) {
+	for _, option := range strings.Split(tag, ",")[1:] {
+		if value, ok := strings.CutPrefix(option, "default="); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+func tagName(tag string) string {
+	name, _, _ := strings.Cut(tag, ",")
+	return name
+}
+
+// swaggerDefinitionName qualifies a type with its package so api, service and db models never collide.
+func swaggerDefinitionName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if index := strings.LastIndex(pkg, "/"); index >= 0 {
+		pkg = pkg[index+1:]
+	}
+	if pkg == "sqlc" {
+		pkg = "db"
+	}
+	return pkg + "." + t.Name()
+}
+
+func swaggerPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = "{" + strings.TrimPrefix(segment, ":") + "}"
+		}
+	}
+	return strings.Join(segments, "/")
 }
 
 func swaggerTag(path string) string {
@@ -142,30 +497,18 @@ func swaggerTag(path string) string {
 	return segments[0]
 }
 
-func swaggerSummary(method string, path string) string {
-	return method + " " + swaggerPath(path)
-}
-
-func swaggerOperationID(method string, path string) string {
-	replacer := strings.NewReplacer("/", "_", ":", "", "-", "_", "{", "", "}", "")
-	return strings.Trim(replacer.Replace(strings.ToLower(method+"_"+path)), "_")
-}
-
-func isAuthenticatedSwaggerRoute(path string) bool {
-	if strings.HasPrefix(path, "/admin/") {
-		return true
+// swaggerOperationID uses the handler's method name, e.g. "getUser", and falls back to the route for closures.
+func swaggerOperationID(route gin.RouteInfo) string {
+	handler := strings.TrimSuffix(route.Handler, "-fm")
+	if index := strings.LastIndex(handler, "."); index >= 0 {
+		handler = handler[index+1:]
 	}
-
-	switch {
-	case path == "/users" || strings.HasPrefix(path, "/users/"):
-		return true
-	case strings.HasPrefix(path, "/rate-source-preferences"):
-		return true
-	case strings.HasPrefix(path, "/currency-preference"):
-		return true
-	default:
-		return false
+	if handler != "" && !strings.HasPrefix(handler, "func") {
+		return handler
 	}
+
+	replacer := strings.NewReplacer("/", "_", ":", "", "-", "_")
+	return strings.Trim(replacer.Replace(strings.ToLower(route.Method+"_"+route.Path)), "_")
 }
 
 const swaggerHTML = `<!doctype html>
@@ -183,14 +526,18 @@ const swaggerHTML = `<!doctype html>
 <body>
   <div id="swagger-ui"></div>
   <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-standalone-preset.js"></script>
   <script>
     window.onload = function () {
       window.ui = SwaggerUIBundle({
-        url: "/swagger/doc.json",
+        urls: [
+          { url: "/swagger/doc.json", name: "REST API" },
+          { url: "/swagger/v2.json", name: "gRPC gateway (/v2)" }
+        ],
         dom_id: "#swagger-ui",
         deepLinking: true,
-        presets: [SwaggerUIBundle.presets.apis],
-        layout: "BaseLayout"
+        presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+        layout: "StandaloneLayout"
       });
     };
   </script>
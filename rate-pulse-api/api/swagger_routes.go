@@ -0,0 +1,661 @@
+package api
+
+import (
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+type swaggerAuth int
+
+const (
+	swaggerAuthNone swaggerAuth = iota
+	// swaggerAuthOptional routes accept anonymous callers, but reject an invalid token.
+	swaggerAuthOptional
+	swaggerAuthRequired
+	// swaggerAuthAdmin is implied for every /admin/ route.
+	swaggerAuthAdmin
+)
+
+// swaggerRoute documents one Gin route for buildSwaggerSpec.
+// Requests lists the structs the handler binds; Response is a value of the type written on 200 OK.
+type swaggerRoute struct {
+	Summary  string
+	Auth     swaggerAuth
+	Requests []any
+	Response any
+	Produces string
+}
+
+// messageResponse is the gin.H{"message": ...} body returned by delete and sign-out handlers.
+type messageResponse struct {
+	Message string `json:"message"`
+}
+
+// verifyEmailResponse is the body returned by verifyEmail.
+type verifyEmailResponse struct {
+	Message string       `json:"message"`
+	User    userResponse `json:"user"`
+}
+
+func swaggerRouteKey(method, path string) string {
+	return method + " " + path
+}
+
+// swaggerRoutes must have an entry for every route registered in setupRouter; TestSwaggerRoutesDocumentEveryRoute enforces it.
+var swaggerRoutes = map[string]swaggerRoute{
+	// Auth
+	"POST /users/signup": {
+		Summary:  "Create a user and send a verification email",
+		Requests: []any{createUserRequest{}},
+		Response: userResponse{},
+	},
+	"POST /users/signin": {
+		Summary:  "Sign in and create a session; with TOTP enabled, and always for admins, returns an mfa_token for POST /users/signin/mfa instead",
+		Requests: []any{loginUserRequest{}},
+		Response: loginUserResponse{},
+	},
+	"POST /users/signin/mfa": {
+		Summary:  "Complete a sign-in with a TOTP or recovery code and create a session",
+		Requests: []any{completeSignInMFARequest{}},
+		Response: loginUserResponse{},
+	},
+	"POST /users/signin/mfa/enroll": {
+		Summary:  "Start the authenticator app enrolment an admin needs to complete their sign-in",
+		Requests: []any{mfaTokenRequest{}},
+		Response: service.TOTPEnrollment{},
+	},
+	"POST /users/signout": {
+		Summary:  "Revoke the session of a refresh token",
+		Requests: []any{logoutRequest{}},
+		Response: messageResponse{},
+	},
+	"POST /users/renew-access-token": {
+		Summary:  "Rotate a refresh token into a new access and refresh token",
+		Requests: []any{renewAccessTokenRequest{}},
+		Response: renewAccessTokenResponse{},
+	},
+	"POST /users/verify-email": {
+		Summary:  "Verify an email address",
+		Requests: []any{verifyEmailRequest{}},
+		Response: verifyEmailResponse{},
+	},
+	"POST /users/verify-email/resend": {
+		Summary:  "Send a new verification email",
+		Requests: []any{resendVerifyEmailRequest{}},
+		Response: messageResponse{},
+	},
+	"POST /users/password-reset/request": {
+		Summary:  "Email a password reset link",
+		Requests: []any{requestPasswordResetRequest{}},
+		Response: messageResponse{},
+	},
+	"POST /users/password-reset/confirm": {
+		Summary:  "Set a new password from a reset link and revoke every session",
+		Requests: []any{confirmPasswordResetRequest{}},
+		Response: messageResponse{},
+	},
+	"GET /health": {
+		Summary:  "Liveness check",
+		Response: messageResponse{},
+	},
+
+	// Users
+	"GET /users/:id": {
+		Summary:  "Get a user",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{getUserRequest{}},
+		Response: userResponse{},
+	},
+	"GET /users": {
+		Summary:  "List users",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{listUserRequest{}},
+		Response: listUserResponse{},
+	},
+	"PUT /users/:id": {
+		Summary:  "Update the authenticated user",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{updateUserURIRequest{}, updateUserRequest{}},
+		Response: userResponse{},
+	},
+	"PUT /admin/users/:id": {
+		Summary:  "Update any user",
+		Requests: []any{updateUserURIRequest{}, adminUpdateUserRequest{}},
+		Response: userResponse{},
+	},
+	"POST /admin/users/:id/sessions/block": {
+		Summary:  "Block every active session of a user",
+		Requests: []any{adminUserURIRequest{}},
+		Response: service.RevokedSessions{},
+	},
+	"DELETE /admin/users/:id": {
+		Summary:  "Delete a user",
+		Requests: []any{deleteUserRequest{}},
+		Response: messageResponse{},
+	},
+
+	// Currencies
+	"GET /currencies": {
+		Summary:  "List currencies",
+		Auth:     swaggerAuthOptional,
+		Response: []db.GetAllCurrenciesRow{},
+	},
+	"GET /currencies/codes-and-names": {
+		Summary:  "List currency codes and names",
+		Auth:     swaggerAuthOptional,
+		Response: []db.GetAllCurrencyCodesAndNamesRow{},
+	},
+	"GET /currencies/:id": {
+		Summary:  "Get a currency",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{getCurrencyRequest{}},
+		Response: db.GetCurrencyByIDRow{},
+	},
+	"POST /admin/currencies": {
+		Summary:  "Create a currency",
+		Requests: []any{createCurrencyRequest{}},
+		Response: db.Currency{},
+	},
+	"PUT /admin/currencies/:id": {
+		Summary:  "Update a currency",
+		Requests: []any{updateCurrencyURIRequest{}, updateCurrencyRequest{}},
+		Response: db.Currency{},
+	},
+	"DELETE /admin/currencies/:id": {
+		Summary:  "Delete a currency",
+		Requests: []any{deleteCurrencyRequest{}},
+		Response: messageResponse{},
+	},
+
+	// Exchange rates
+	"GET /exchange-rates/:id": {
+		Summary:  "Get an exchange rate",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{getExchangeRateRequest{}},
+		Response: service.ExchangeRate{},
+	},
+	"GET /exchange-rates-latest": {
+		Summary:  "List the latest exchange rates for a source currency",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{listExchangeRateRequest{}},
+		Response: []service.LatestExchangeRate{},
+	},
+	"GET /exchange-rates/historical": {
+		Summary:  "Get historical exchange rates for a pair",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{getHistoricalRequest{}},
+		Response: []service.HistoricalDataPoint{},
+	},
+	"GET /exchange-rates/candles": {
+		Summary:  "Get OHLC candles for a pair",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{getCandlesRequest{}},
+		Response: []service.Candle{},
+	},
+	"GET /exchange-rates/cross": {
+		Summary:  "Derive a cross rate through a pivot currency",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{getCrossRateRequest{}},
+		Response: service.CrossRate{},
+	},
+	"GET /exchange-rates/spreads": {
+		Summary:  "Get buy/sell spreads for a pair",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{getSpreadsRequest{}},
+		Response: service.ExchangeRateSpreads{},
+	},
+	"GET /exchange-rates/stream": {
+		Summary:  "Stream live exchange rate updates as server-sent events",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{streamExchangeRatesRequest{}},
+		Response: pubsub.RateUpdate{},
+		Produces: "text/event-stream",
+	},
+	"GET /exchange-rate-types": {
+		Summary:  "List exchange rate types",
+		Auth:     swaggerAuthOptional,
+		Response: []exchangeRateTypeDTO{},
+	},
+	"POST /admin/exchange-rates": {
+		Summary:  "Create an exchange rate",
+		Requests: []any{createExchangeRateRequest{}},
+		Response: service.ExchangeRate{},
+	},
+	"PUT /admin/exchange-rates/:id": {
+		Summary:  "Update an exchange rate",
+		Requests: []any{updateExchangeRateURIRequest{}, updateExchangeRateRequest{}},
+		Response: service.ExchangeRate{},
+	},
+	"DELETE /admin/exchange-rates/:id": {
+		Summary:  "Delete an exchange rate",
+		Requests: []any{deleteExchangeRateRequest{}},
+		Response: messageResponse{},
+	},
+	"POST /admin/ingest/exchange-rates": {
+		Summary:  "Ingest a batch of scraped exchange rates",
+		Requests: []any{ingestExchangeRatesRequest{}},
+		Response: service.IngestExchangeRatesResult{},
+	},
+	"GET /admin/exchange-rates/quarantined": {
+		Summary:  "List quarantined exchange rates",
+		Requests: []any{listQuarantinedExchangeRatesRequest{}},
+		Response: []service.ExchangeRate{},
+	},
+	"PUT /admin/exchange-rates/:id/review": {
+		Summary:  "Approve or reject a quarantined exchange rate",
+		Requests: []any{getExchangeRateRequest{}, reviewQuarantinedExchangeRateRequest{}},
+		Response: service.ExchangeRate{},
+	},
+
+	// Quotes
+	"POST /quotes": {
+		Summary:  "Quote a conversion at one source including fees",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{createQuoteRequest{}},
+		Response: service.Quote{},
+	},
+	"GET /quotes/compare": {
+		Summary:  "Rank sources by the amount received",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{compareQuotesRequest{}},
+		Response: []service.RankedQuote{},
+	},
+
+	// Rate sources
+	"GET /rate-sources": {
+		Summary:  "List rate sources",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{listRateSourceRequest{}},
+		Response: service.RateSourcePage{},
+	},
+	"GET /rate-sources/metadata": {
+		Summary:  "List rate source metadata",
+		Auth:     swaggerAuthOptional,
+		Response: []db.ListRateSourceMetadataRow{},
+	},
+	"GET /rate-sources/freshness": {
+		Summary:  "Report when each rate source last produced a rate",
+		Auth:     swaggerAuthOptional,
+		Response: service.RateSourceFreshnessReport{},
+	},
+	"GET /rate-sources/:id": {
+		Summary:  "Get a rate source",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{getRateSourceRequest{}},
+		Response: db.GetRateSourceByIDRow{},
+	},
+	"POST /admin/rate-sources": {
+		Summary:  "Create a rate source",
+		Requests: []any{createRateSourceRequest{}},
+		Response: db.RateSource{},
+	},
+	"PUT /admin/rate-sources/:id": {
+		Summary:  "Update a rate source",
+		Requests: []any{updateRateSourceURIRequest{}, updateRateSourceRequest{}},
+		Response: db.RateSource{},
+	},
+	"DELETE /admin/rate-sources/:id": {
+		Summary:  "Delete a rate source",
+		Requests: []any{deleteRateSourceRequest{}},
+		Response: messageResponse{},
+	},
+
+	// Rate source fee rules
+	"GET /rate-source-fee-rules/active": {
+		Summary:  "Get the fee rule in effect for a source, type and channel",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{getActiveRateSourceFeeRuleRequest{}},
+		Response: service.RateSourceFeeRule{},
+	},
+	"GET /rate-source-fee-rules/:id": {
+		Summary:  "Get a fee rule",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{rateSourceFeeRuleURIRequest{}},
+		Response: service.RateSourceFeeRule{},
+	},
+	"GET /rate-source-fee-rules": {
+		Summary:  "List fee rules",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{listRateSourceFeeRulesRequest{}},
+		Response: service.RateSourceFeeRulePage{},
+	},
+	"POST /admin/rate-source-fee-rules": {
+		Summary:  "Create a fee rule",
+		Requests: []any{createRateSourceFeeRuleRequest{}},
+		Response: service.RateSourceFeeRule{},
+	},
+	"PUT /admin/rate-source-fee-rules/:id": {
+		Summary:  "Update a fee rule",
+		Requests: []any{rateSourceFeeRuleURIRequest{}, updateRateSourceFeeRuleRequest{}},
+		Response: service.RateSourceFeeRule{},
+	},
+	"DELETE /admin/rate-source-fee-rules/:id": {
+		Summary:  "Delete a fee rule",
+		Requests: []any{rateSourceFeeRuleURIRequest{}},
+		Response: messageResponse{},
+	},
+
+	// Countries
+	"GET /countries": {
+		Summary:  "List countries",
+		Auth:     swaggerAuthOptional,
+		Response: []db.GetAllCountriesRow{},
+	},
+	"GET /countries/:id": {
+		Summary:  "Get a country",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{getCountryRequest{}},
+		Response: db.GetCountryByIDRow{},
+	},
+	"GET /countries/code/:country_code": {
+		Summary:  "Get a country by its ISO code",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{getCountryByCodeRequest{}},
+		Response: db.GetCountryByCodeRow{},
+	},
+	"POST /admin/countries": {
+		Summary:  "Create a country",
+		Requests: []any{createCountryRequest{}},
+		Response: db.Country{},
+	},
+	"PUT /admin/countries/:id": {
+		Summary:  "Update a country",
+		Requests: []any{updateCountryURIRequest{}, updateCountryRequest{}},
+		Response: db.Country{},
+	},
+	"DELETE /admin/countries/:id": {
+		Summary:  "Delete a country",
+		Requests: []any{deleteCountryRequest{}},
+		Response: messageResponse{},
+	},
+
+	// Subscription plans
+	"GET /subscription-plans": {
+		Summary:  "List active subscription plans",
+		Auth:     swaggerAuthOptional,
+		Response: []db.SubscriptionPlan{},
+	},
+	"GET /subscription-plans/:id": {
+		Summary:  "Get a subscription plan",
+		Auth:     swaggerAuthOptional,
+		Requests: []any{getSubscriptionPlanRequest{}},
+		Response: db.SubscriptionPlan{},
+	},
+	"POST /admin/subscription-plans": {
+		Summary:  "Create a subscription plan",
+		Requests: []any{createSubscriptionPlanRequest{}},
+		Response: db.SubscriptionPlan{},
+	},
+	"GET /admin/subscription-plans": {
+		Summary:  "List all subscription plans",
+		Response: []db.SubscriptionPlan{},
+	},
+	"PUT /admin/subscription-plans/:id": {
+		Summary:  "Update a subscription plan",
+		Requests: []any{updateSubscriptionPlanURIRequest{}, updateSubscriptionPlanRequest{}},
+		Response: db.SubscriptionPlan{},
+	},
+	"DELETE /admin/subscription-plans/:id": {
+		Summary:  "Delete a subscription plan",
+		Requests: []any{deleteSubscriptionPlanRequest{}},
+		Response: messageResponse{},
+	},
+
+	// Subscriptions
+	"POST /subscriptions": {
+		Summary:  "Subscribe the authenticated user to a plan; requires a verified email",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{createUserSubscriptionRequest{}},
+		Response: db.UserSubscription{},
+	},
+	"GET /subscriptions": {
+		Summary:  "List the authenticated user's subscriptions",
+		Auth:     swaggerAuthRequired,
+		Response: []db.UserSubscription{},
+	},
+	"GET /subscriptions/active": {
+		Summary:  "Get the authenticated user's active subscription",
+		Auth:     swaggerAuthRequired,
+		Response: db.UserSubscription{},
+	},
+	"GET /admin/subscriptions": {
+		Summary:  "List all subscriptions",
+		Requests: []any{listAllUserSubscriptionsRequest{}},
+		Response: service.UserSubscriptionPage{},
+	},
+	"GET /admin/subscriptions/status": {
+		Summary:  "List subscriptions by status",
+		Requests: []any{listUserSubscriptionsByStatusRequest{}},
+		Response: []db.UserSubscription{},
+	},
+	"PUT /admin/subscriptions/:id": {
+		Summary:  "Update a subscription",
+		Requests: []any{userSubscriptionURIRequest{}, updateUserSubscriptionRequest{}},
+		Response: db.UserSubscription{},
+	},
+	"DELETE /admin/subscriptions/:id": {
+		Summary:  "Delete a subscription",
+		Requests: []any{userSubscriptionURIRequest{}},
+		Response: messageResponse{},
+	},
+
+	// Payments
+	"GET /payments": {
+		Summary:  "List the authenticated user's payments",
+		Auth:     swaggerAuthRequired,
+		Response: []db.Payment{},
+	},
+	"GET /payments/:id": {
+		Summary:  "Get one of the authenticated user's payments",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{paymentURIRequest{}},
+		Response: db.Payment{},
+	},
+	"POST /admin/payments": {
+		Summary:  "Record a payment",
+		Requests: []any{createPaymentRequest{}},
+		Response: db.Payment{},
+	},
+	"GET /admin/payments": {
+		Summary:  "List all payments",
+		Requests: []any{listAllPaymentsRequest{}},
+		Response: service.PaymentPage{},
+	},
+	"GET /admin/payments/status": {
+		Summary:  "List payments by status",
+		Requests: []any{listPaymentsByStatusRequest{}},
+		Response: []db.Payment{},
+	},
+	"GET /admin/payments/:id": {
+		Summary:  "Get a payment",
+		Requests: []any{paymentURIRequest{}},
+		Response: db.Payment{},
+	},
+	"PUT /admin/payments/:id": {
+		Summary:  "Update a payment",
+		Requests: []any{paymentURIRequest{}, updatePaymentRequest{}},
+		Response: db.Payment{},
+	},
+	"DELETE /admin/payments/:id": {
+		Summary:  "Delete a payment",
+		Requests: []any{paymentURIRequest{}},
+		Response: messageResponse{},
+	},
+
+	// Rate source preferences
+	"POST /rate-source-preferences": {
+		Summary:  "Add a rate source preference",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{createRateSourcePreferenceRequest{}},
+		Response: db.UserRateSourcePreference{},
+	},
+	"GET /rate-source-preferences-userid": {
+		Summary:  "List the authenticated user's rate source preferences",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{getRateSourcePreferencesByUserIDRequest{}},
+		Response: []db.UserRateSourcePreference{},
+	},
+	"GET /rate-source-preferences-sourceid": {
+		Summary:  "List the authenticated user's rate source preferences by source",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{getRateSourcePreferencesBySourceIDRequest{}},
+		Response: []db.UserRateSourcePreference{},
+	},
+	"GET /rate-source-preferences": {
+		Summary:  "List rate source preferences",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{listRateSourcePreferencesRequest{}},
+		Response: []db.UserRateSourcePreference{},
+	},
+	"PUT /rate-source-preferences/:source_id": {
+		Summary:  "Update a rate source preference",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{updateRateSourcePreferenceRequest{}},
+		Response: db.UserRateSourcePreference{},
+	},
+	"DELETE /rate-source-preferences/:source_id": {
+		Summary:  "Delete a rate source preference",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{deleteRateSourcePreferenceRequest{}},
+		Response: messageResponse{},
+	},
+
+	// Currency preferences
+	"POST /currency-preference": {
+		Summary:  "Add a currency preference",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{createCurrencyPreferenceRequest{}},
+		Response: db.UserCurrencyPreference{},
+	},
+	"GET /currency-preference-userid": {
+		Summary:  "List the authenticated user's currency preferences",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{getCurrencyPreferencesByUserIDRequest{}},
+		Response: []db.UserCurrencyPreference{},
+	},
+	"GET /currency-preference-currid/:currency_id": {
+		Summary:  "List the authenticated user's preferences for a currency",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{getCurrencyPreferencesByCurrencyIDRequest{}},
+		Response: []db.UserCurrencyPreference{},
+	},
+	"GET /currency-preferences": {
+		Summary:  "List currency preferences",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{listAllCurrencyPreferencesRequest{}},
+		Response: []db.UserCurrencyPreference{},
+	},
+	"PUT /currency-preference/:currency_id": {
+		Summary:  "Update a currency preference",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{updateCurrencyPreferenceRequest{}},
+		Response: db.UserCurrencyPreference{},
+	},
+	"DELETE /currency-preference/:currency_id": {
+		Summary:  "Delete a currency preference",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{deleteCurrencyPreferenceRequest{}},
+		Response: messageResponse{},
+	},
+
+	// API keys
+	"GET /me/usage": {
+		Summary:  "Get the plan limits and today's usage of the authenticated user",
+		Auth:     swaggerAuthRequired,
+		Response: service.Usage{},
+	},
+	"GET /me/sessions": {
+		Summary:  "List the devices the authenticated user is signed in on",
+		Auth:     swaggerAuthRequired,
+		Response: []service.Session{},
+	},
+	"DELETE /me/sessions": {
+		Summary:  "Sign the authenticated user out everywhere",
+		Auth:     swaggerAuthRequired,
+		Response: service.RevokedSessions{},
+	},
+	"DELETE /me/sessions/:id": {
+		Summary:  "Sign the authenticated user out of one device; every access token of the user is revoked, so other devices renew theirs",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{sessionURIRequest{}},
+		Response: messageResponse{},
+	},
+	"GET /me/mfa": {
+		Summary:  "Get the two-factor authentication status of the authenticated user",
+		Auth:     swaggerAuthRequired,
+		Response: service.MFAStatus{},
+	},
+	"POST /me/mfa/totp": {
+		Summary:  "Start an authenticator app enrolment",
+		Auth:     swaggerAuthRequired,
+		Response: service.TOTPEnrollment{},
+	},
+	"POST /me/mfa/totp/confirm": {
+		Summary:  "Enable the pending authenticator app; the recovery codes are only returned once",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{mfaCodeRequest{}},
+		Response: service.RecoveryCodes{},
+	},
+	"POST /me/mfa/totp/disable": {
+		Summary:  "Disable two-factor authentication with a TOTP or recovery code; not allowed for admins",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{mfaCodeRequest{}},
+		Response: messageResponse{},
+	},
+	"POST /me/mfa/recovery-codes": {
+		Summary:  "Replace the recovery codes with a TOTP code; the new codes are only returned once",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{mfaCodeRequest{}},
+		Response: service.RecoveryCodes{},
+	},
+	"POST /api-keys": {
+		Summary:  "Create an API key; the key is only returned once and requires a verified email",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{createAPIKeyRequest{}},
+		Response: service.CreatedAPIKey{},
+	},
+	"GET /api-keys": {
+		Summary:  "List the authenticated user's API keys",
+		Auth:     swaggerAuthRequired,
+		Response: []service.APIKey{},
+	},
+	"DELETE /api-keys/:id": {
+		Summary:  "Revoke an API key",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{apiKeyURIRequest{}},
+		Response: service.APIKey{},
+	},
+
+	// Rate alerts
+	"POST /alerts": {
+		Summary:  "Create a rate alert; requires a verified email",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{createRateAlertRequest{}},
+		Response: service.RateAlert{},
+	},
+	"GET /alerts": {
+		Summary:  "List the authenticated user's rate alerts",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{listRateAlertsRequest{}},
+		Response: []service.RateAlert{},
+	},
+	"GET /alerts/:id": {
+		Summary:  "Get a rate alert",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{rateAlertURIRequest{}},
+		Response: service.RateAlert{},
+	},
+	"PUT /alerts/:id": {
+		Summary:  "Update or pause a rate alert",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{rateAlertURIRequest{}, updateRateAlertRequest{}},
+		Response: service.RateAlert{},
+	},
+	"DELETE /alerts/:id": {
+		Summary:  "Delete a rate alert",
+		Auth:     swaggerAuthRequired,
+		Requests: []any{rateAlertURIRequest{}},
+		Response: messageResponse{},
+	},
+}
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwaggerRoutesDocumentEveryRoute(t *testing.T) {
+	server := newTestServer(t, nil)
+
+	registered := make(map[string]bool)
+	for _, route := range server.router.Routes() {
+		if !isDocumentedSwaggerRoute(route.Path) {
+			continue
+		}
+		key := swaggerRouteKey(route.Method, route.Path)
+		registered[key] = true
+		require.Contains(t, swaggerRoutes, key, "route %s has no swaggerRoutes entry", key)
+	}
+	for key := range swaggerRoutes {
+		require.True(t, registered[key], "swaggerRoutes entry %s has no registered route", key)
+	}
+}
+
+func TestSwaggerDocServesGinSpec(t *testing.T) {
+	server := newTestServer(t, nil)
+
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/swagger/doc.json", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var spec struct {
+		Paths       map[string]map[string]swaggerTestOperation `json:"paths"`
+		Definitions map[string]swaggerTestSchema               `json:"definitions"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &spec))
+	require.NotContains(t, spec.Paths, "/swagger/doc.json")
+
+	t.Run("ErrorResponse", func(t *testing.T) {
+		errorSchema := spec.Definitions["api.apiErrorResponse"]
+		require.Contains(t, errorSchema.Properties, "code")
+		require.Contains(t, errorSchema.Properties, "message")
+		require.Equal(t, "array", errorSchema.Properties["fields"].Type)
+
+		operation := spec.Paths["/admin/exchange-rates/{id}"]["put"]
+		for _, code := range []string{"400", "401", "403", "404", "429", "500"} {
+			require.Equal(t, "#/definitions/api.apiErrorResponse", operation.Responses[code].Schema.Ref, code)
+		}
+	})
+
+	t.Run("QueryBindingRules", func(t *testing.T) {
+		operation := spec.Paths["/users"]["get"]
+		require.Equal(t, "listUser", operation.OperationID)
+		require.Equal(t, []map[string]any{{"BearerAuth": []any{}}}, operation.Security)
+
+		pageSize := operation.parameter("page_size")
+		require.Equal(t, "query", pageSize.In)
+		require.Equal(t, "integer", pageSize.Type)
+		require.False(t, pageSize.Required)
+		require.Equal(t, 1.0, *pageSize.Minimum)
+		require.Equal(t, 100.0, *pageSize.Maximum)
+		require.Equal(t, "query", operation.parameter("cursor").In)
+
+		interval := spec.Paths["/exchange-rates/candles"]["get"].parameter("interval")
+		require.Equal(t, []any{"1h", "1d", "1w", "1M"}, interval.Enum)
+	})
+
+	t.Run("PathParameters", func(t *testing.T) {
+		id := spec.Paths["/exchange-rates/{id}"]["get"].parameter("id")
+		require.Equal(t, "path", id.In)
+		require.Equal(t, "integer", id.Type)
+		require.True(t, id.Required)
+		require.Equal(t, 1.0, *id.Minimum)
+	})
+
+	t.Run("BodySchema", func(t *testing.T) {
+		body := spec.Paths["/admin/exchange-rates"]["post"].parameter("body")
+		require.Equal(t, "#/definitions/api.createExchangeRateRequest", body.Schema.Ref)
+
+		request := spec.Definitions["api.createExchangeRateRequest"]
+		require.ElementsMatch(t, []string{"rate_value", "source_currency_id", "destination_currency_id", "valid_from_date"}, request.Required)
+		require.Equal(t, 1.0, *request.Properties["source_currency_id"].Minimum)
+		require.Equal(t, 2.0, *request.Properties["type"].Maximum)
+		require.Equal(t, "date-time", request.Properties["valid_from_date"].Format)
+
+		review := spec.Definitions["api.reviewQuarantinedExchangeRateRequest"]
+		require.Equal(t, []any{"approve", "reject"}, review.Properties["action"].Enum)
+	})
+
+	t.Run("ResponseSchema", func(t *testing.T) {
+		success := spec.Paths["/alerts"]["get"].Responses["200"].Schema
+		require.Equal(t, "array", success.Type)
+		require.Equal(t, "#/definitions/service.RateAlert", success.Items.Ref)
+		require.Contains(t, spec.Definitions["service.RateAlert"].Properties, "alert_id")
+
+		// sqlc models have no json tags, so encoding/json writes the Go field names.
+		payment := spec.Definitions["db.Payment"]
+		require.Contains(t, payment.Properties, "PaymentID")
+		require.Equal(t, "#/definitions/sql.NullString", payment.Properties["TransactionID"].Ref)
+
+		historical := spec.Paths["/exchange-rates/historical"]["get"]
+		require.Len(t, historical.Security, 3)
+	})
+}
+
+type swaggerTestSchema struct {
+	Ref        string                       `json:"$ref"`
+	Type       string                       `json:"type"`
+	Format     string                       `json:"format"`
+	Enum       []any                        `json:"enum"`
+	Minimum    *float64                     `json:"minimum"`
+	Maximum    *float64                     `json:"maximum"`
+	Required   []string                     `json:"required"`
+	Items      *swaggerTestSchema           `json:"items"`
+	Properties map[string]swaggerTestSchema `json:"properties"`
+}
+
+type swaggerTestParameter struct {
+	Name     string            `json:"name"`
+	In       string            `json:"in"`
+	Type     string            `json:"type"`
+	Required bool              `json:"required"`
+	Enum     []any             `json:"enum"`
+	Minimum  *float64          `json:"minimum"`
+	Maximum  *float64          `json:"maximum"`
+	Schema   swaggerTestSchema `json:"schema"`
+}
+
+type swaggerTestOperation struct {
+	OperationID string                 `json:"operationId"`
+	Parameters  []swaggerTestParameter `json:"parameters"`
+	Security    []map[string]any       `json:"security"`
+	Responses   map[string]struct {
+		Schema swaggerTestSchema `json:"schema"`
+	} `json:"responses"`
+}
+
+func (operation swaggerTestOperation) parameter(name string) swaggerTestParameter {
+	for _, param := range operation.Parameters {
+		if param.Name == name {
+			return param
+		}
+	}
+	return swaggerTestParameter{}
+}
@@ -6,15 +6,21 @@ import (
 
 	"github.com/ThanhVinhTong/rate-pulse/service"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type renewAccessTokenRequest struct {
 	RefreshToken string `json:"refresh_token" binding:"required"`
 }
 
+// renewAccessTokenResponse carries the rotated refresh token; the one sent in the request
+// no longer works, and sending it again signs the whole session out.
 type renewAccessTokenResponse struct {
-	AccessToken          string    `json:"access_token"`
-	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+	SessionID             uuid.UUID `json:"session_id"`
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
 }
 
 func (server *Server) renewAccessToken(ctx *gin.Context) {
@@ -26,6 +32,8 @@ func (server *Server) renewAccessToken(ctx *gin.Context) {
 
 	res, err := server.services.Auth.RenewAccessToken(ctx, service.RenewAccessTokenInput{
 		RefreshToken: req.RefreshToken,
+		UserAgent:    ctx.Request.UserAgent(),
+		ClientIP:     ctx.ClientIP(),
 	})
 	if err != nil {
 		RespondServiceError(ctx, err)
@@ -33,7 +41,10 @@ func (server *Server) renewAccessToken(ctx *gin.Context) {
 	}
 
 	ctx.JSON(http.StatusOK, renewAccessTokenResponse{
-		AccessToken:          res.AccessToken,
-		AccessTokenExpiresAt: res.AccessTokenExpiresAt,
+		SessionID:             res.SessionID,
+		AccessToken:           res.AccessToken,
+		AccessTokenExpiresAt:  res.AccessTokenExpiresAt,
+		RefreshToken:          res.RefreshToken,
+		RefreshTokenExpiresAt: res.RefreshTokenExpiresAt,
 	})
 }
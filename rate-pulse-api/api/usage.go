@@ -0,0 +1,33 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/ThanhVinhTong/rate-pulse/service"
+	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/gin-gonic/gin"
+)
+
+// getMyUsage reports the authenticated user's plan limits and today's metered requests.
+// Reading it does not count against the quota, so API keys may poll it.
+//
+// GET /me/usage
+//
+// Response: Usage object; the limits are null for admins, who are not metered
+// Status codes:
+//   - 200 OK: Usage retrieved successfully
+//   - 401 Unauthorized: Missing or invalid access token or API key
+//   - 500 Internal Server Error: Database or server error
+func (server *Server) getMyUsage(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	usage, err := server.services.Quotas.GetUsage(ctx, service.QuotaInput{
+		UserID:   authPayload.UserID,
+		UserType: authPayload.UserType,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, usage)
+}
@@ -54,6 +54,20 @@ type verifyEmailRequest struct {
 	SecretCode string `json:"secret_code" binding:"required"`
 }
 
+type resendVerifyEmailRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type requestPasswordResetRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type confirmPasswordResetRequest struct {
+	ResetID     int64  `json:"reset_id" binding:"required,min=1"`
+	SecretCode  string `json:"secret_code" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
 // newUserResponse creates a userResponse from a db.User, excluding sensitive data.
 func newUserResponse(user db.User) userResponse {
 	return userResponse{
@@ -169,26 +183,38 @@ func (server *Server) getUser(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, newUserResponseFromServiceUser(user))
 }
 
-// listUserRequest represents the query parameters for listing users with pagination.
-// PageID starts from 1 and PageSize must be between 5 and 10.
+// listUserRequest represents the query parameters for listing users one cursor page at a time.
 type listUserRequest struct {
-	PageID   int32 `form:"page_id" binding:"required,min=1"`
-	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+	pageRequest
+	UserType    string `form:"user_type" binding:"omitempty,oneof=free premium enterprise admin"`
+	IsActive    *bool  `form:"is_active"`
+	CreatedFrom string `form:"created_from"`
+	CreatedTo   string `form:"created_to"`
+}
+
+// listUserResponse is one page of users; next_cursor is empty on the last page.
+type listUserResponse struct {
+	Users      []userResponse `json:"users"`
+	NextCursor string         `json:"next_cursor"`
 }
 
-// listUser retrieves a paginated list of users.
-// Pagination is controlled via query parameters page_id and page_size.
+// listUser retrieves a page of users.
+// Pagination is controlled via the cursor, page_size and sort query parameters.
 //
-// GET /users?page_id=1&page_size=10
+// GET /users?page_size=20&sort=-created_at&cursor=...
 //
 // Query parameters:
-//   - page_id: The page number to retrieve (required, must be >= 1)
-//   - page_size: The number of users per page (required, must be between 5 and 10)
+//   - cursor: The next_cursor of the previous page (optional)
+//   - page_size: The number of users per page (optional, default: 20, max: 100)
+//   - sort: created_at or user_id, prefixed with "-" for descending (optional, default: created_at)
+//   - user_type: Only users of this type (optional, free, premium, enterprise or admin)
+//   - is_active: Only active or only deactivated users (optional)
+//   - created_from, created_to: Half-open created_at range, RFC3339 or YYYY-MM-DD (optional)
 //
-// Response: Array of User objects on success, error message on failure
+// Response: Page of User objects with next_cursor on success, error message on failure
 // Status codes:
 //   - 200 OK: Users retrieved successfully
-//   - 400 Bad Request: Invalid or missing pagination parameters
+//   - 400 Bad Request: Invalid cursor, sort, filter or page size
 //   - 500 Internal Server Error: Database or server error
 func (server *Server) listUser(ctx *gin.Context) {
 	var req listUserRequest
@@ -197,21 +223,38 @@ func (server *Server) listUser(ctx *gin.Context) {
 		return
 	}
 
-	users, err := server.services.Users.ListUsers(ctx, service.ListUsersInput{
-		PageID:   req.PageID,
-		PageSize: req.PageSize,
+	createdFrom, err := parseOptionalRangeTime("created_from", req.CreatedFrom)
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+	createdTo, err := parseOptionalRangeTime("created_to", req.CreatedTo)
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	page, err := server.services.Users.ListUsers(ctx, service.ListUsersInput{
+		Page:        req.pageInput(),
+		UserType:    stringPtrIfNotEmpty(req.UserType),
+		IsActive:    req.IsActive,
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
 	})
 	if err != nil {
 		RespondServiceError(ctx, err)
 		return
 	}
 
-	responses := make([]userResponse, len(users))
-	for i, user := range users {
-		responses[i] = newUserResponseFromServiceUser(user)
+	response := listUserResponse{
+		Users:      make([]userResponse, len(page.Users)),
+		NextCursor: page.NextCursor,
+	}
+	for i, user := range page.Users {
+		response.Users[i] = newUserResponseFromServiceUser(user)
 	}
 
-	ctx.JSON(http.StatusOK, responses)
+	ctx.JSON(http.StatusOK, response)
 }
 
 // updateUserRequest represents the request body for updating a user.
@@ -390,8 +433,23 @@ type loginUserResponse struct {
 	RefreshToken          string       `json:"refresh_token"`
 	RefreshTokenExpiresAt time.Time    `json:"refresh_token_expires_at"`
 	User                  userResponse `json:"user"`
+	RecoveryCodes         []string     `json:"recovery_codes,omitempty"`
+}
+
+func newLoginUserResponse(result service.SignInResult) loginUserResponse {
+	return loginUserResponse{
+		SessionID:             result.SessionID,
+		AccessToken:           result.AccessToken,
+		AccessTokenExpiresAt:  result.AccessTokenExpiresAt,
+		RefreshToken:          result.RefreshToken,
+		RefreshTokenExpiresAt: result.RefreshTokenExpiresAt,
+		User:                  newUserResponseFromServiceUser(result.User),
+		RecoveryCodes:         result.RecoveryCodes,
+	}
 }
 
+// loginUser checks the password and creates a session, or returns an mfaChallengeResponse when
+// the user has TOTP enabled or is an admin; the sign-in is then completed by completeSignInMFA.
 func (server *Server) loginUser(ctx *gin.Context) {
 	var req loginUserRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -410,15 +468,18 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		return
 	}
 
-	res := loginUserResponse{
-		SessionID:             args.SessionID,
-		AccessToken:           args.AccessToken,
-		AccessTokenExpiresAt:  args.AccessTokenExpiresAt,
-		RefreshToken:          args.RefreshToken,
-		RefreshTokenExpiresAt: args.RefreshTokenExpiresAt,
-		User:                  newUserResponseFromServiceUser(args.User),
+	if args.MFARequired {
+		ctx.JSON(http.StatusOK, mfaChallengeResponse{
+			MFARequired:           true,
+			MFAEnrollmentRequired: args.MFAEnrollmentRequired,
+			MFAToken:              args.MFAToken,
+			MFATokenExpiresAt:     args.MFATokenExpiresAt,
+			User:                  newUserResponseFromServiceUser(args.User),
+		})
+		return
 	}
-	ctx.JSON(http.StatusOK, res)
+
+	ctx.JSON(http.StatusOK, newLoginUserResponse(args))
 }
 
 func (server *Server) verifyEmail(ctx *gin.Context) {
@@ -443,8 +504,69 @@ func (server *Server) verifyEmail(ctx *gin.Context) {
 	})
 }
 
+// resendVerifyEmail sends a new verification link. The response is the same whether or not an
+// unverified account uses the email.
+func (server *Server) resendVerifyEmail(ctx *gin.Context) {
+	var req resendVerifyEmailRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err := server.services.Auth.ResendVerifyEmail(ctx, service.ResendVerifyEmailInput{
+		Email: req.Email,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "If an unverified account uses this email, a new verification link has been sent"})
+}
+
+// requestPasswordReset emails a one-time reset link. The response is the same whether or not
+// an account uses the email.
+func (server *Server) requestPasswordReset(ctx *gin.Context) {
+	var req requestPasswordResetRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err := server.services.Auth.RequestPasswordReset(ctx, service.RequestPasswordResetInput{
+		Email: req.Email,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "If an account uses this email, a password reset link has been sent"})
+}
+
+// confirmPasswordReset sets a new password from a reset link and signs the user out everywhere.
+func (server *Server) confirmPasswordReset(ctx *gin.Context) {
+	var req confirmPasswordResetRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err := server.services.Auth.ConfirmPasswordReset(ctx, service.ConfirmPasswordResetInput{
+		ResetID:     req.ResetID,
+		SecretCode:  req.SecretCode,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Password has been reset, please sign in again"})
+}
+
 // logoutUser handles user sign out by revoking the current session.
-// It expects a refresh token in the request body.
+// It expects a refresh token in the request body; a bearer access token, when sent, is revoked too.
 func (server *Server) logoutUser(ctx *gin.Context) {
 	var req logoutRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -452,7 +574,11 @@ func (server *Server) logoutUser(ctx *gin.Context) {
 		return
 	}
 
-	err := server.services.Auth.SignOut(ctx, req.RefreshToken)
+	accessToken, _ := bearerToken(ctx.GetHeader(authorizationHeaderKey))
+	err := server.services.Auth.SignOut(ctx, service.SignOutInput{
+		RefreshToken: req.RefreshToken,
+		AccessToken:  accessToken,
+	})
 	if err != nil {
 		RespondServiceError(ctx, err)
 		return
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/service"
 	"github.com/ThanhVinhTong/rate-pulse/token"
 	"github.com/ThanhVinhTong/rate-pulse/util"
 	"github.com/gin-gonic/gin"
@@ -80,14 +81,50 @@ func (server *Server) getMyActiveUserSubscription(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, subscription)
 }
 
+type listAllUserSubscriptionsRequest struct {
+	pageRequest
+	Status string `form:"status" binding:"omitempty,oneof=active cancelled expired suspended pending"`
+	PlanID *int32 `form:"plan_id" binding:"omitempty,min=1"`
+	From   string `form:"from"`
+	To     string `form:"to"`
+}
+
+// listAllUserSubscriptions pages through every subscription, latest start_date first unless sort says otherwise.
+//
+// GET /admin/subscriptions?status=active&plan_id=2&sort=-start_date&cursor=...
+//
+// sort is start_date or subscription_id, prefixed with "-" for descending; from/to bound start_date.
 func (server *Server) listAllUserSubscriptions(ctx *gin.Context) {
-	subscriptions, err := server.store.GetAllUserSubscriptions(ctx)
+	var req listAllUserSubscriptionsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	from, err := parseOptionalRangeTime("from", req.From)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		RespondServiceError(ctx, err)
+		return
+	}
+	to, err := parseOptionalRangeTime("to", req.To)
+	if err != nil {
+		RespondServiceError(ctx, err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, subscriptions)
+	page, err := server.services.Subscriptions.ListUserSubscriptions(ctx, service.ListUserSubscriptionsInput{
+		Page:   req.pageInput(),
+		Status: stringPtrIfNotEmpty(req.Status),
+		PlanID: req.PlanID,
+		From:   from,
+		To:     to,
+	})
+	if err != nil {
+		RespondServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, page)
 }
 
 type listUserSubscriptionsByStatusRequest struct {
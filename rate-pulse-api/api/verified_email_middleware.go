@@ -0,0 +1,21 @@
+package api
+
+import (
+	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/gin-gonic/gin"
+)
+
+// verifiedEmailMiddleware keeps users whose users.email_verified is false from creating
+// subscriptions, alerts and API keys. With ALLOW_UNVERIFIED_SIGN_IN they can still sign in and
+// read everything else. It runs after authMiddleware.
+func (server *Server) verifiedEmailMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+		if err := server.services.Users.RequireVerifiedEmail(ctx, authPayload.UserID); err != nil {
+			RespondServiceError(ctx, err)
+			ctx.Abort()
+			return
+		}
+		ctx.Next()
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"time"
 )
 
+// HTTPKeyPrefix namespaces cached REST responses so other transports can invalidate them.
+const HTTPKeyPrefix = "rate-pulse:http:v1:"
+
 type ResponseCache interface {
 	Get(ctx context.Context, key string) ([]byte, bool, error)
 	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
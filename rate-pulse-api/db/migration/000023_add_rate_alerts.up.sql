@@ -0,0 +1,42 @@
+-- A rate alert watches one source/type/currency pair and emails its owner when
+-- the rate crosses a threshold or moves by more than change_percent within
+-- window_hours. last_rate_* remembers the previous evaluation so "crosses"
+-- only fires on the transition, not on every new row beyond the threshold.
+CREATE TABLE IF NOT EXISTS rate_alerts (
+    alert_id          SERIAL PRIMARY KEY,
+    user_id           INT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    source_id         INT NOT NULL REFERENCES rate_sources(source_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    type_id           INT NOT NULL REFERENCES exchange_rate_types(type_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    from_currency_id  INT NOT NULL REFERENCES currencies(currency_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    to_currency_id    INT NOT NULL REFERENCES currencies(currency_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    condition         VARCHAR(20) NOT NULL,
+    threshold         NUMERIC(20,10),
+    change_percent    NUMERIC(10,4),
+    window_hours      INT NOT NULL DEFAULT 24,
+    is_active         BOOLEAN NOT NULL DEFAULT TRUE,
+    last_rate_id      INT,
+    last_rate_value   NUMERIC(20,10),
+    last_triggered_at TIMESTAMPTZ,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_rate_alerts_condition
+        CHECK (condition IN ('above', 'below', 'percent_change')),
+    CONSTRAINT chk_rate_alerts_threshold
+        CHECK (condition = 'percent_change' OR (threshold IS NOT NULL AND threshold > 0)),
+    CONSTRAINT chk_rate_alerts_change_percent
+        CHECK (condition <> 'percent_change' OR (change_percent IS NOT NULL AND change_percent > 0)),
+    CONSTRAINT chk_rate_alerts_window_hours
+        CHECK (window_hours BETWEEN 1 AND 720),
+    CONSTRAINT chk_rate_alerts_currency_pair
+        CHECK (from_currency_id <> to_currency_id)
+);
+
+CREATE INDEX IF NOT EXISTS rate_alerts_user_id_idx
+ON rate_alerts(user_id);
+
+CREATE INDEX IF NOT EXISTS rate_alerts_active_idx
+ON rate_alerts(source_id, type_id)
+WHERE is_active = TRUE;
+
+ALTER TABLE IF EXISTS rate_alerts ENABLE ROW LEVEL SECURITY;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_exchange_rates_source_pair_type_valid_from;
@@ -0,0 +1,3 @@
+-- Supports the per (source, pair, type, time-bucket) duplicate check used by bulk ingestion.
+CREATE INDEX IF NOT EXISTS idx_exchange_rates_source_pair_type_valid_from
+    ON exchange_rates(source_id, source_currency_id, destination_currency_id, type_id, valid_from_date);
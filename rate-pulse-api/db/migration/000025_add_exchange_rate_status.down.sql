@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_exchange_rates_quarantined;
+
+ALTER TABLE exchange_rates
+    DROP CONSTRAINT IF EXISTS chk_exchange_rates_status;
+
+ALTER TABLE exchange_rates
+    DROP COLUMN IF EXISTS quarantine_reason,
+    DROP COLUMN IF EXISTS status;
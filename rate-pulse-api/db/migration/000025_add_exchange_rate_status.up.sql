@@ -0,0 +1,14 @@
+-- Rates that deviate too far from the source's recent history or from other
+-- sources are stored as 'quarantined' and stay out of every public read until
+-- an admin approves ('active') or rejects ('rejected') them.
+ALTER TABLE exchange_rates
+    ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'active',
+    ADD COLUMN IF NOT EXISTS quarantine_reason VARCHAR(255);
+
+ALTER TABLE exchange_rates
+    ADD CONSTRAINT chk_exchange_rates_status
+        CHECK (status IN ('active', 'quarantined', 'rejected'));
+
+CREATE INDEX IF NOT EXISTS idx_exchange_rates_quarantined
+    ON exchange_rates(created_at DESC)
+    WHERE status = 'quarantined';
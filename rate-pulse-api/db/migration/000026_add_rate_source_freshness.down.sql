@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_exchange_rates_source_created_at;
+
+ALTER TABLE rate_sources
+    DROP CONSTRAINT IF EXISTS chk_rate_sources_expected_interval;
+
+ALTER TABLE rate_sources
+    DROP COLUMN IF EXISTS stale_notified_at,
+    DROP COLUMN IF EXISTS expected_interval_minutes;
@@ -0,0 +1,14 @@
+-- Each source is expected to publish a new rate at least every
+-- expected_interval_minutes (the scraper runs every two hours). stale_notified_at
+-- records when admins were last told the source had gone quiet.
+ALTER TABLE rate_sources
+    ADD COLUMN IF NOT EXISTS expected_interval_minutes INT NOT NULL DEFAULT 120,
+    ADD COLUMN IF NOT EXISTS stale_notified_at TIMESTAMPTZ;
+
+ALTER TABLE rate_sources
+    ADD CONSTRAINT chk_rate_sources_expected_interval
+        CHECK (expected_interval_minutes > 0);
+
+-- Supports finding the last ingested rate of each source.
+CREATE INDEX IF NOT EXISTS idx_exchange_rates_source_created_at
+    ON exchange_rates(source_id, created_at DESC);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_user_subscriptions_status_start_date;
+DROP INDEX IF EXISTS idx_users_created_at;
@@ -0,0 +1,6 @@
+-- Support the status and date range filters of the paginated admin list endpoints.
+CREATE INDEX IF NOT EXISTS idx_users_created_at
+    ON users(created_at);
+
+CREATE INDEX IF NOT EXISTS idx_user_subscriptions_status_start_date
+    ON user_subscriptions(status, start_date);
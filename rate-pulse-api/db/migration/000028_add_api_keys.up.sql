@@ -0,0 +1,23 @@
+-- An API key lets a machine client act as its owner without a session. Only the
+-- SHA-256 of the key is stored; key_prefix is the non-secret head shown in lists
+-- so owners can tell their keys apart. scopes limit what the key may call.
+CREATE TABLE IF NOT EXISTS api_keys (
+    api_key_id   SERIAL PRIMARY KEY,
+    user_id      INT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    name         VARCHAR(100) NOT NULL,
+    key_prefix   VARCHAR(16) NOT NULL,
+    key_hash     VARCHAR(64) NOT NULL UNIQUE,
+    scopes       TEXT[] NOT NULL,
+    expires_at   TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at   TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_api_keys_scopes
+        CHECK (cardinality(scopes) > 0 AND scopes <@ ARRAY['read:rates', 'read:historical', 'admin']::TEXT[])
+);
+
+CREATE INDEX IF NOT EXISTS api_keys_user_id_idx
+ON api_keys(user_id);
+
+ALTER TABLE IF EXISTS api_keys ENABLE ROW LEVEL SECURITY;
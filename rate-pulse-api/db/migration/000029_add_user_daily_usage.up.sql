@@ -0,0 +1,12 @@
+-- One row per user and UTC day counts the metered rate reads made against the
+-- daily quota of the user's plan (subscription_plans.rate_limit_per_day).
+CREATE TABLE IF NOT EXISTS user_daily_usage (
+    user_id       INT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    usage_date    DATE NOT NULL,
+    request_count INT NOT NULL DEFAULT 0,
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, usage_date)
+);
+
+ALTER TABLE IF EXISTS user_daily_usage ENABLE ROW LEVEL SECURITY;
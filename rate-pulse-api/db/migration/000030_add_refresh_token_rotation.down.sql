@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS refresh_tokens;
+
+DROP INDEX IF EXISTS sessions_user_id_idx;
+
+ALTER TABLE IF EXISTS sessions
+DROP COLUMN IF EXISTS last_used_at;
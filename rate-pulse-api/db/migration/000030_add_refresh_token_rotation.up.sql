@@ -0,0 +1,27 @@
+-- A session is a family of refresh tokens: every renew rotates the session's refresh
+-- token and records the new one here. Presenting a token that was already rotated
+-- means it leaked, so the whole session is blocked.
+ALTER TABLE IF EXISTS sessions
+ADD COLUMN IF NOT EXISTS last_used_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    token_id   UUID PRIMARY KEY,
+    session_id UUID NOT NULL REFERENCES sessions(session_id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    rotated_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS refresh_tokens_session_id_idx
+ON refresh_tokens(session_id);
+
+CREATE INDEX IF NOT EXISTS sessions_user_id_idx
+ON sessions(user_id);
+
+-- Sessions created before rotation carry the ID of their only refresh token.
+INSERT INTO refresh_tokens (token_id, session_id, expires_at)
+SELECT session_id, session_id, expires_at
+FROM sessions
+ON CONFLICT (token_id) DO NOTHING;
+
+ALTER TABLE IF EXISTS refresh_tokens ENABLE ROW LEVEL SECURITY;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS password_resets (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    email VARCHAR(100) NOT NULL,
+    secret_code_hash VARCHAR(255) NOT NULL UNIQUE,
+    is_used BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expired_at TIMESTAMPTZ NOT NULL DEFAULT (CURRENT_TIMESTAMP + INTERVAL '30 minutes'),
+
+    CONSTRAINT password_resets_expiry_after_created
+        CHECK (expired_at > created_at)
+);
+
+CREATE INDEX IF NOT EXISTS password_resets_user_id_idx
+ON password_resets(user_id);
+
+CREATE INDEX IF NOT EXISTS password_resets_active_user_idx
+ON password_resets(user_id)
+WHERE is_used = FALSE;
+
+ALTER TABLE IF EXISTS password_resets ENABLE ROW LEVEL SECURITY;
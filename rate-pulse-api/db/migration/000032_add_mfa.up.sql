@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS user_totp (
+    user_id INT PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    secret_ciphertext TEXT NOT NULL,
+    confirmed_at TIMESTAMPTZ,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT mfa_recovery_codes_user_code_unique
+        UNIQUE (user_id, code_hash)
+);
+
+CREATE TABLE IF NOT EXISTS mfa_challenges (
+    token_hash VARCHAR(64) PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    attempts INT NOT NULL DEFAULT 0,
+    consumed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL,
+
+    CONSTRAINT mfa_challenges_expiry_after_created
+        CHECK (expires_at > created_at)
+);
+
+CREATE INDEX IF NOT EXISTS mfa_challenges_user_id_idx
+ON mfa_challenges(user_id);
+
+ALTER TABLE IF EXISTS user_totp ENABLE ROW LEVEL SECURITY;
+ALTER TABLE IF EXISTS mfa_recovery_codes ENABLE ROW LEVEL SECURITY;
+ALTER TABLE IF EXISTS mfa_challenges ENABLE ROW LEVEL SECURITY;
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_rate_source_fee_rules_effective_from_keyset;
+
+CREATE INDEX IF NOT EXISTS idx_user_subscriptions_status_start_date
+    ON user_subscriptions(status, start_date);
+
+DROP INDEX IF EXISTS idx_user_subscriptions_status_start_date_keyset;
+DROP INDEX IF EXISTS idx_user_subscriptions_start_date_keyset;
+DROP INDEX IF EXISTS idx_payments_status_payment_date_keyset;
+DROP INDEX IF EXISTS idx_payments_payment_date_keyset;
+DROP INDEX IF EXISTS idx_users_created_at_keyset;
@@ -0,0 +1,21 @@
+-- Match the (sort key, id) keyset of each paginated list query so a page is an index range scan.
+-- The nullable created_at and payment_date are indexed with the same COALESCE the queries sort by.
+CREATE INDEX IF NOT EXISTS idx_users_created_at_keyset
+    ON users((COALESCE(created_at, 'epoch'::timestamptz)), user_id);
+
+CREATE INDEX IF NOT EXISTS idx_payments_payment_date_keyset
+    ON payments((COALESCE(payment_date, 'epoch'::timestamptz)), payment_id);
+
+CREATE INDEX IF NOT EXISTS idx_payments_status_payment_date_keyset
+    ON payments(payment_status, (COALESCE(payment_date, 'epoch'::timestamptz)), payment_id);
+
+CREATE INDEX IF NOT EXISTS idx_user_subscriptions_start_date_keyset
+    ON user_subscriptions(start_date, subscription_id);
+
+CREATE INDEX IF NOT EXISTS idx_user_subscriptions_status_start_date_keyset
+    ON user_subscriptions(status, start_date, subscription_id);
+
+DROP INDEX IF EXISTS idx_user_subscriptions_status_start_date;
+
+CREATE INDEX IF NOT EXISTS idx_rate_source_fee_rules_effective_from_keyset
+    ON rate_source_fee_rules(effective_from, fee_rule_id);
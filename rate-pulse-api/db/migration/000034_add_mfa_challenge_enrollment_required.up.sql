@@ -0,0 +1,4 @@
+-- Marks the challenges of sign-ins that must enrol an authenticator app before completing, so
+-- EnrollSignInTOTP cannot be reached with the token of an ordinary two-factor sign-in.
+ALTER TABLE mfa_challenges
+    ADD COLUMN IF NOT EXISTS enrollment_required BOOLEAN NOT NULL DEFAULT false;
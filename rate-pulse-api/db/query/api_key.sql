@@ -0,0 +1,52 @@
+-- name: CreateAPIKey :one
+INSERT INTO api_keys (
+    user_id,
+    name,
+    key_prefix,
+    key_hash,
+    scopes,
+    expires_at
+) VALUES ($1, $2, $3, $4, $5, $6) RETURNING *;
+
+-- name: ListAPIKeysByUser :many
+SELECT * FROM api_keys
+WHERE user_id = $1
+ORDER BY created_at DESC, api_key_id DESC;
+
+-- name: CountActiveAPIKeysByUser :one
+SELECT COUNT(*) FROM api_keys
+WHERE user_id = $1
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > now());
+
+-- name: GetAPIKeyOwnerByHash :one
+-- Resolves a presented key to its owner; revoked and expired keys are left to the caller.
+SELECT
+    k.api_key_id,
+    k.user_id,
+    k.scopes,
+    k.expires_at,
+    k.revoked_at,
+    u.username,
+    u.email,
+    u.user_type,
+    u.is_active
+FROM api_keys k
+JOIN users u ON u.user_id = k.user_id
+WHERE k.key_hash = $1
+LIMIT 1;
+
+-- name: RevokeAPIKey :one
+UPDATE api_keys
+SET revoked_at = now()
+WHERE api_key_id = $1
+  AND user_id = $2
+  AND revoked_at IS NULL
+RETURNING *;
+
+-- name: TouchAPIKeyLastUsed :exec
+-- Writes at most once a minute per key so busy ETL jobs do not turn every request into an UPDATE.
+UPDATE api_keys
+SET last_used_at = now()
+WHERE api_key_id = $1
+  AND (last_used_at IS NULL OR last_used_at < now() - INTERVAL '1 minute');
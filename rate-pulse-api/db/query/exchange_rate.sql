@@ -1,19 +1,276 @@
+-- name: CloseSupersededExchangeRates :execrows
+-- Sets valid_to_date on every open rate of the given (source, pair, type) series to the
+-- valid_from_date of the next active rate in the series. The closed rows stay as history.
+UPDATE exchange_rates er
+SET valid_to_date = superseded.next_valid_from_date
+FROM (
+  SELECT cur.rate_id, MIN(later.valid_from_date) AS next_valid_from_date
+  FROM (
+    SELECT DISTINCT snapshot.*
+    FROM unnest(
+      sqlc.arg(source_ids)::INT[],
+      sqlc.arg(source_currency_ids)::INT[],
+      sqlc.arg(destination_currency_ids)::INT[],
+      sqlc.arg(type_ids)::INT[]
+    ) AS snapshot(source_id, source_currency_id, destination_currency_id, type_id)
+  ) series
+  JOIN exchange_rates cur
+    ON cur.source_id = series.source_id
+   AND cur.source_currency_id = series.source_currency_id
+   AND cur.destination_currency_id = series.destination_currency_id
+   AND cur.type_id = series.type_id
+  JOIN exchange_rates later
+    ON later.source_id = cur.source_id
+   AND later.source_currency_id = cur.source_currency_id
+   AND later.destination_currency_id = cur.destination_currency_id
+   AND later.type_id = cur.type_id
+   AND later.valid_from_date > cur.valid_from_date
+   AND later.status = 'active'
+  WHERE cur.valid_to_date IS NULL
+  GROUP BY cur.rate_id
+) superseded
+WHERE er.rate_id = superseded.rate_id;
+
 -- name: CreateExchangeRate :one
-INSERT INTO exchange_rates (rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, type_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO exchange_rates (rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, type_id, status, quarantine_reason)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 RETURNING *;
 
 -- name: GetExchangeRateByID :one
-SELECT rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, type_id, created_at, updated_at
+SELECT rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, type_id, created_at, updated_at, status, quarantine_reason
 FROM exchange_rates
 WHERE rate_id = $1 
 LIMIT 1;
 
+-- name: GetExchangeRateAnomalyBaseline :one
+-- Summarises what a new rate is checked against: the source's most recent active rates for
+-- the pair and type, and the latest active rate of every other source published since peer_since.
+WITH history AS (
+  SELECT rate_value
+  FROM exchange_rates
+  WHERE source_id = sqlc.arg(source_id)::INT
+    AND type_id = sqlc.arg(type_id)::INT
+    AND source_currency_id = sqlc.arg(source_currency_id)::INT
+    AND destination_currency_id = sqlc.arg(destination_currency_id)::INT
+    AND status = 'active'
+  ORDER BY updated_at DESC NULLS LAST, rate_id DESC
+  LIMIT sqlc.arg(history_size)::INT
+), peers AS (
+  SELECT DISTINCT ON (source_id) rate_value
+  FROM exchange_rates
+  WHERE source_id <> sqlc.arg(source_id)::INT
+    AND type_id = sqlc.arg(type_id)::INT
+    AND source_currency_id = sqlc.arg(source_currency_id)::INT
+    AND destination_currency_id = sqlc.arg(destination_currency_id)::INT
+    AND status = 'active'
+    AND updated_at >= sqlc.arg(peer_since)::TIMESTAMPTZ
+  ORDER BY source_id, updated_at DESC NULLS LAST, rate_id DESC
+)
+SELECT
+  (SELECT COUNT(*) FROM history) AS history_count,
+  (SELECT COALESCE(AVG(rate_value), 0) FROM history)::NUMERIC AS history_mean,
+  (SELECT COALESCE(STDDEV_SAMP(rate_value), 0) FROM history)::NUMERIC AS history_stddev,
+  (SELECT COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY rate_value), 0) FROM history)::NUMERIC AS history_median,
+  (SELECT COUNT(*) FROM peers) AS peer_count,
+  (SELECT COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY rate_value), 0) FROM peers)::NUMERIC AS peer_median;
+
+-- name: ListExchangeRateAnomalyBaselines :many
+-- Returns the GetExchangeRateAnomalyBaseline of every distinct (source, pair, type) series in one query.
+SELECT series.source_id, series.type_id, series.source_currency_id, series.destination_currency_id,
+       history.history_count, history.history_mean, history.history_stddev, history.history_median,
+       peers.peer_count, peers.peer_median
+FROM (
+  SELECT DISTINCT snapshot.*
+  FROM unnest(
+    sqlc.arg(source_ids)::INT[],
+    sqlc.arg(type_ids)::INT[],
+    sqlc.arg(source_currency_ids)::INT[],
+    sqlc.arg(destination_currency_ids)::INT[]
+  ) AS snapshot(source_id, type_id, source_currency_id, destination_currency_id)
+) series
+CROSS JOIN LATERAL (
+  SELECT COUNT(*) AS history_count,
+         COALESCE(AVG(recent.rate_value), 0)::NUMERIC AS history_mean,
+         COALESCE(STDDEV_SAMP(recent.rate_value), 0)::NUMERIC AS history_stddev,
+         COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY recent.rate_value), 0)::NUMERIC AS history_median
+  FROM (
+    SELECT rate_value
+    FROM exchange_rates
+    WHERE source_id = series.source_id
+      AND type_id = series.type_id
+      AND source_currency_id = series.source_currency_id
+      AND destination_currency_id = series.destination_currency_id
+      AND status = 'active'
+    ORDER BY updated_at DESC NULLS LAST, rate_id DESC
+    LIMIT sqlc.arg(history_size)::INT
+  ) recent
+) history
+CROSS JOIN LATERAL (
+  SELECT COUNT(*) AS peer_count,
+         COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY latest.rate_value), 0)::NUMERIC AS peer_median
+  FROM (
+    SELECT DISTINCT ON (source_id) rate_value
+    FROM exchange_rates
+    WHERE source_id <> series.source_id
+      AND type_id = series.type_id
+      AND source_currency_id = series.source_currency_id
+      AND destination_currency_id = series.destination_currency_id
+      AND status = 'active'
+      AND updated_at >= sqlc.arg(peer_since)::TIMESTAMPTZ
+    ORDER BY source_id, updated_at DESC NULLS LAST, rate_id DESC
+  ) latest
+) peers;
+
+-- name: GetExchangeRateForPairAsOf :one
+-- Returns the rate a source had published for a currency pair at a point in time.
+SELECT * FROM exchange_rates
+WHERE source_id = sqlc.arg(source_id)
+  AND type_id = sqlc.arg(type_id)
+  AND (
+    (source_currency_id = sqlc.arg(currency_a) AND destination_currency_id = sqlc.arg(currency_b))
+    OR (source_currency_id = sqlc.arg(currency_b) AND destination_currency_id = sqlc.arg(currency_a))
+  )
+  AND updated_at <= sqlc.arg(as_of)
+  AND status = 'active'
+ORDER BY updated_at DESC, rate_id DESC
+LIMIT 1;
+
+-- name: GetLatestExchangeRateForPair :one
+-- Returns the most recent rate a source published for a currency pair in either direction.
+SELECT * FROM exchange_rates
+WHERE source_id = sqlc.arg(source_id)
+  AND type_id = sqlc.arg(type_id)
+  AND (
+    (source_currency_id = sqlc.arg(currency_a) AND destination_currency_id = sqlc.arg(currency_b))
+    OR (source_currency_id = sqlc.arg(currency_b) AND destination_currency_id = sqlc.arg(currency_a))
+  )
+  AND status = 'active'
+ORDER BY updated_at DESC NULLS LAST, rate_id DESC
+LIMIT 1;
+
+-- name: ListLatestExchangeRatesAgainstCurrency :many
+-- Returns, for each of the given sources, the most recent rate of every pair that includes
+-- currency_id, once per quoted direction. Lets a quote comparison load every source's rates at once.
+SELECT DISTINCT ON (source_id, source_currency_id, destination_currency_id) * FROM exchange_rates
+WHERE source_id = ANY(sqlc.arg(source_ids)::INT[])
+  AND type_id = sqlc.arg(type_id)
+  AND (source_currency_id = sqlc.arg(currency_id) OR destination_currency_id = sqlc.arg(currency_id))
+  AND status = 'active'
+ORDER BY source_id, source_currency_id, destination_currency_id, updated_at DESC NULLS LAST, rate_id DESC;
+
+-- name: LockExchangeRateSeries :exec
+-- Takes one transaction-scoped advisory lock per distinct (source, pair, type) series, in a
+-- fixed order so two snapshots sharing series cannot deadlock. Concurrent snapshots of the same
+-- series then run InsertExchangeRatesSnapshot and CloseSupersededExchangeRates one at a time.
+SELECT pg_advisory_xact_lock(series.lock_key)
+FROM (
+  SELECT DISTINCT hashtextextended(concat_ws(':',
+    snapshot.source_id, snapshot.type_id, snapshot.source_currency_id, snapshot.destination_currency_id
+  ), 0) AS lock_key
+  FROM unnest(
+    sqlc.arg(source_ids)::INT[],
+    sqlc.arg(type_ids)::INT[],
+    sqlc.arg(source_currency_ids)::INT[],
+    sqlc.arg(destination_currency_ids)::INT[]
+  ) AS snapshot(source_id, type_id, source_currency_id, destination_currency_id)
+  ORDER BY lock_key
+) series;
+
+-- name: InsertExchangeRatesSnapshot :many
+-- Inserts every rate of a snapshot in a single multi-row statement, skipping a rate when its
+-- source already published the pair and type within the same time bucket. Rejected rates do
+-- not count, so a corrected replay of the bucket is accepted. Run it after LockExchangeRateSeries
+-- in the same transaction; on its own two concurrent inserts can both miss each other's row.
+INSERT INTO exchange_rates (rate_value, source_currency_id, destination_currency_id, valid_from_date, source_id, type_id, status, quarantine_reason)
+SELECT snapshot.rate_value, snapshot.source_currency_id, snapshot.destination_currency_id,
+       snapshot.valid_from_date, snapshot.source_id, snapshot.type_id,
+       snapshot.status, NULLIF(snapshot.quarantine_reason, '')
+FROM unnest(
+  sqlc.arg(rate_values)::NUMERIC[],
+  sqlc.arg(source_currency_ids)::INT[],
+  sqlc.arg(destination_currency_ids)::INT[],
+  sqlc.arg(valid_from_dates)::TIMESTAMPTZ[],
+  sqlc.arg(source_ids)::INT[],
+  sqlc.arg(type_ids)::INT[],
+  sqlc.arg(statuses)::TEXT[],
+  sqlc.arg(quarantine_reasons)::TEXT[],
+  sqlc.arg(bucket_starts)::TIMESTAMPTZ[],
+  sqlc.arg(bucket_ends)::TIMESTAMPTZ[]
+) AS snapshot(rate_value, source_currency_id, destination_currency_id, valid_from_date, source_id, type_id,
+              status, quarantine_reason, bucket_start, bucket_end)
+WHERE NOT EXISTS (
+  SELECT 1 FROM exchange_rates er
+  WHERE er.source_id = snapshot.source_id
+    AND er.type_id = snapshot.type_id
+    AND er.source_currency_id = snapshot.source_currency_id
+    AND er.destination_currency_id = snapshot.destination_currency_id
+    AND er.valid_from_date >= snapshot.bucket_start
+    AND er.valid_from_date < snapshot.bucket_end
+    AND er.status <> 'rejected'
+)
+RETURNING *;
+
+-- name: ListLatestExchangeRatesForSource :many
+-- Returns the most recent rate for every currency pair a source publishes for one type.
+SELECT DISTINCT ON (source_currency_id, destination_currency_id) *
+FROM exchange_rates
+WHERE source_id = sqlc.arg(source_id)
+  AND type_id = sqlc.arg(type_id)
+  AND status = 'active'
+ORDER BY source_currency_id, destination_currency_id, updated_at DESC NULLS LAST, rate_id DESC;
+
+-- name: ListLatestExchangeRatesForPair :many
+-- Returns each source's most recent rate for a currency pair for each of the given types.
+SELECT DISTINCT ON (source_id, type_id) *
+FROM exchange_rates
+WHERE source_currency_id = sqlc.arg(source_currency_id)
+  AND destination_currency_id = sqlc.arg(destination_currency_id)
+  AND type_id = ANY(sqlc.arg(type_ids)::INT[])
+  AND (sqlc.narg(source_id)::INT IS NULL OR source_id = sqlc.narg(source_id))
+  AND status = 'active'
+ORDER BY source_id, type_id, updated_at DESC NULLS LAST, rate_id DESC;
+
+-- name: ListExchangeRateSpreadSamples :many
+-- Returns the last rate per source, type and calendar bucket for a currency pair, so buy and
+-- sell types can be paired bucket by bucket; start_time is widened to its bucket start.
+SELECT DISTINCT ON (er.source_id, er.type_id, bucket_start)
+  date_trunc(sqlc.arg(bucket_unit)::TEXT, er.updated_at, sqlc.arg(time_zone)::TEXT)::TIMESTAMPTZ AS bucket_start,
+  er.source_id,
+  er.type_id,
+  er.rate_value
+FROM exchange_rates er
+WHERE er.source_currency_id = sqlc.arg(source_currency_id)
+  AND er.destination_currency_id = sqlc.arg(destination_currency_id)
+  AND er.type_id = ANY(sqlc.arg(type_ids)::INT[])
+  AND (sqlc.narg(source_id)::INT IS NULL OR er.source_id = sqlc.narg(source_id))
+  AND er.updated_at >= date_trunc(sqlc.arg(bucket_unit)::TEXT, sqlc.arg(start_time)::TIMESTAMPTZ, sqlc.arg(time_zone)::TEXT)
+  AND er.updated_at < sqlc.arg(end_time)::TIMESTAMPTZ
+  AND er.status = 'active'
+ORDER BY er.source_id, er.type_id, bucket_start, er.updated_at DESC, er.rate_id DESC;
+
+-- name: ListQuarantinedExchangeRates :many
+-- Lists rates held back by anomaly detection, newest first, for admin review.
+SELECT * FROM exchange_rates
+WHERE status = 'quarantined'
+ORDER BY created_at DESC, rate_id DESC
+LIMIT $1
+OFFSET $2;
+
+-- name: ReviewQuarantinedExchangeRate :one
+-- Releases ('active') or discards ('rejected') a quarantined rate. updated_at is left alone
+-- so an approved rate keeps its place in the history.
+UPDATE exchange_rates
+SET status = sqlc.arg(status)
+WHERE rate_id = sqlc.arg(rate_id)
+  AND status = 'quarantined'
+RETURNING *;
+
 -- name: GetAllExchangeRatesToday :many
 SELECT rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, type_id, created_at, updated_at
 FROM exchange_rates
 WHERE created_at >= (SELECT NOW()::date)
 AND source_currency_id = $1
+AND status = 'active'
 ORDER BY rate_id DESC
 LIMIT $2; -- $2: page size
 
@@ -33,6 +290,7 @@ JOIN currencies dc ON er.destination_currency_id = dc.currency_id
 LEFT JOIN rate_sources rs ON er.source_id = rs.source_id
 LEFT JOIN exchange_rate_types ert ON er.type_id = ert.type_id
 WHERE er.source_currency_id = $1
+  AND er.status = 'active'
 ORDER BY
   er.destination_currency_id,
   er.source_id,
@@ -58,12 +316,9 @@ RETURNING *;
 DELETE FROM exchange_rates
 WHERE rate_id = $1;
 
--- name: DeleteAllExchangeRates :exec
-DELETE FROM exchange_rates;
-
 -- name: GetHistoricalData :many
 -- Fetches evenly distributed exchange rate data points across a time range.
--- Returns up to num_data_points evenly spaced samples from the time range [start_time, now).
+-- Returns up to num_data_points evenly spaced samples from the time range [start_time, end_time).
 -- Parameters:
 --   $1: source_currency_id
 --   $2: destination_currency_id
@@ -71,6 +326,7 @@ DELETE FROM exchange_rates;
 --   $4: start_time (UpdatedAt in struct)
 --   $5: type_id
 --   $6: num_data_points
+--   $7: end_time (UpdatedAt_2 in struct)
 WITH bucketed AS (
   SELECT 
     er.rate_value,
@@ -83,7 +339,51 @@ WITH bucketed AS (
     AND er.source_id = $3
     AND er.updated_at >= $4
     AND er.type_id = $5
+    AND er.updated_at < $7
+    AND er.status = 'active'
 )
 SELECT DISTINCT ON (bucket) rate_value, updated_at, type_id
 FROM bucketed
 ORDER BY bucket, updated_at DESC;
+
+-- name: GetExchangeRateCandles :many
+-- Aggregates a source's rates for a currency pair into open/high/low/close candles, placing
+-- each rate by valid_from_date, the time the source quoted it, rather than when the row was written.
+-- Buckets are calendar aligned with date_trunc in the given time zone, so every candle
+-- spans a full hour, day, ISO week or month; start_time is widened to its bucket start.
+-- Returns the most recent candles first.
+SELECT
+  date_trunc(sqlc.arg(bucket_unit)::TEXT, er.valid_from_date, sqlc.arg(time_zone)::TEXT)::TIMESTAMPTZ AS bucket_start,
+  (array_agg(er.rate_value ORDER BY er.valid_from_date, er.rate_id))[1]::NUMERIC AS open_rate,
+  MAX(er.rate_value)::NUMERIC AS high_rate,
+  MIN(er.rate_value)::NUMERIC AS low_rate,
+  (array_agg(er.rate_value ORDER BY er.valid_from_date DESC, er.rate_id DESC))[1]::NUMERIC AS close_rate,
+  COUNT(*) AS sample_count
+FROM exchange_rates er
+WHERE er.source_currency_id = sqlc.arg(source_currency_id)
+  AND er.destination_currency_id = sqlc.arg(destination_currency_id)
+  AND er.source_id = sqlc.arg(source_id)
+  AND er.type_id = sqlc.arg(type_id)
+  AND er.valid_from_date >= date_trunc(sqlc.arg(bucket_unit)::TEXT, sqlc.arg(start_time)::TIMESTAMPTZ, sqlc.arg(time_zone)::TEXT)
+  AND er.valid_from_date < sqlc.arg(end_time)::TIMESTAMPTZ
+  AND er.status = 'active'
+GROUP BY 1
+ORDER BY 1 DESC
+LIMIT sqlc.arg(max_candles);
+
+-- name: GetDailyHistoricalData :many
+-- Returns the last rate of each calendar day in the given time zone, matching end-of-day bank quotes.
+SELECT DISTINCT ON (day_start)
+  date_trunc('day', er.updated_at, sqlc.arg(time_zone)::TEXT)::TIMESTAMPTZ AS day_start,
+  er.rate_value,
+  er.updated_at,
+  er.type_id
+FROM exchange_rates er
+WHERE er.source_currency_id = sqlc.arg(source_currency_id)
+  AND er.destination_currency_id = sqlc.arg(destination_currency_id)
+  AND er.source_id = sqlc.arg(source_id)
+  AND er.type_id = sqlc.arg(type_id)
+  AND er.updated_at >= sqlc.arg(start_time)
+  AND er.updated_at < sqlc.arg(end_time)
+  AND er.status = 'active'
+ORDER BY day_start, er.updated_at DESC;
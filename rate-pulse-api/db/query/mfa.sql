@@ -0,0 +1,108 @@
+-- name: UpsertUserTOTP :one
+-- Starts or restarts an enrolment; a confirmed secret is only replaced by disabling it first.
+INSERT INTO user_totp (
+    user_id,
+    secret_ciphertext
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (user_id) DO UPDATE
+SET
+    secret_ciphertext = EXCLUDED.secret_ciphertext,
+    last_used_step = 0,
+    updated_at = now()
+WHERE user_totp.confirmed_at IS NULL
+RETURNING *;
+
+-- name: GetUserTOTP :one
+SELECT * FROM user_totp
+WHERE user_id = $1
+LIMIT 1;
+
+-- name: ConfirmUserTOTP :one
+UPDATE user_totp
+SET
+    confirmed_at = now(),
+    last_used_step = @last_used_step,
+    updated_at = now()
+WHERE
+    user_id = @user_id
+    AND confirmed_at IS NULL
+RETURNING *;
+
+-- name: UpdateUserTOTPStep :execrows
+-- Records the step of an accepted code; a step at or before the last one is a replay.
+UPDATE user_totp
+SET
+    last_used_step = @last_used_step,
+    updated_at = now()
+WHERE
+    user_id = @user_id
+    AND last_used_step < @last_used_step;
+
+-- name: DeleteUserTOTP :execrows
+DELETE FROM user_totp
+WHERE user_id = $1;
+
+-- name: CreateRecoveryCode :exec
+INSERT INTO mfa_recovery_codes (
+    user_id,
+    code_hash
+) VALUES (
+    $1, $2
+);
+
+-- name: DeleteRecoveryCodes :exec
+DELETE FROM mfa_recovery_codes
+WHERE user_id = $1;
+
+-- name: UseRecoveryCode :execrows
+UPDATE mfa_recovery_codes
+SET used_at = now()
+WHERE
+    user_id = $1
+    AND code_hash = $2
+    AND used_at IS NULL;
+
+-- name: CountUnusedRecoveryCodes :one
+SELECT COUNT(*) FROM mfa_recovery_codes
+WHERE
+    user_id = $1
+    AND used_at IS NULL;
+
+-- name: CreateMFAChallenge :one
+INSERT INTO mfa_challenges (
+    token_hash,
+    user_id,
+    expires_at,
+    enrollment_required
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: GetMFAChallenge :one
+SELECT * FROM mfa_challenges
+WHERE token_hash = $1
+LIMIT 1;
+
+-- Takes one of the attempts of an open challenge before its code is checked, so parallel
+-- requests on the same token cannot try more than max_attempts codes.
+-- name: ReserveMFAChallengeAttempt :one
+UPDATE mfa_challenges
+SET attempts = attempts + 1
+WHERE
+    token_hash = sqlc.arg(token_hash)
+    AND attempts < sqlc.arg(max_attempts)::INT
+    AND consumed_at IS NULL
+    AND expires_at > now()
+RETURNING *;
+
+-- name: ConsumeMFAChallenge :execrows
+UPDATE mfa_challenges
+SET consumed_at = now()
+WHERE
+    token_hash = sqlc.arg(token_hash)
+    AND attempts <= sqlc.arg(max_attempts)::INT
+    AND consumed_at IS NULL
+    AND expires_at > now();
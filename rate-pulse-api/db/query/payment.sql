@@ -30,9 +30,53 @@ SELECT * FROM payments
 WHERE payment_status = $1
 ORDER BY payment_date DESC;
 
--- name: GetAllPayments :many
+-- name: ListPaymentsByDate :many
+-- Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+-- both the WHERE and the ORDER BY. after_id/after_time are the last row of the previous page.
 SELECT * FROM payments
-ORDER BY payment_date DESC;
+WHERE (sqlc.narg(payment_status)::varchar IS NULL OR payment_status = sqlc.narg(payment_status))
+  AND (sqlc.narg(subscription_id)::integer IS NULL OR subscription_id = sqlc.narg(subscription_id))
+  AND (sqlc.narg(date_from)::timestamptz IS NULL OR payment_date >= sqlc.narg(date_from))
+  AND (sqlc.narg(date_to)::timestamptz IS NULL OR payment_date < sqlc.narg(date_to))
+  AND (
+    sqlc.narg(after_id)::integer IS NULL
+    OR (COALESCE(payment_date, 'epoch'), payment_id) > (sqlc.narg(after_time)::timestamptz, sqlc.narg(after_id))
+  )
+ORDER BY COALESCE(payment_date, 'epoch'), payment_id
+LIMIT @page_limit;
+
+-- name: ListPaymentsByDateDesc :many
+SELECT * FROM payments
+WHERE (sqlc.narg(payment_status)::varchar IS NULL OR payment_status = sqlc.narg(payment_status))
+  AND (sqlc.narg(subscription_id)::integer IS NULL OR subscription_id = sqlc.narg(subscription_id))
+  AND (sqlc.narg(date_from)::timestamptz IS NULL OR payment_date >= sqlc.narg(date_from))
+  AND (sqlc.narg(date_to)::timestamptz IS NULL OR payment_date < sqlc.narg(date_to))
+  AND (
+    sqlc.narg(after_id)::integer IS NULL
+    OR (COALESCE(payment_date, 'epoch'), payment_id) < (sqlc.narg(after_time)::timestamptz, sqlc.narg(after_id))
+  )
+ORDER BY COALESCE(payment_date, 'epoch') DESC, payment_id DESC
+LIMIT @page_limit;
+
+-- name: ListPaymentsByID :many
+SELECT * FROM payments
+WHERE (sqlc.narg(payment_status)::varchar IS NULL OR payment_status = sqlc.narg(payment_status))
+  AND (sqlc.narg(subscription_id)::integer IS NULL OR subscription_id = sqlc.narg(subscription_id))
+  AND (sqlc.narg(date_from)::timestamptz IS NULL OR payment_date >= sqlc.narg(date_from))
+  AND (sqlc.narg(date_to)::timestamptz IS NULL OR payment_date < sqlc.narg(date_to))
+  AND (sqlc.narg(after_id)::integer IS NULL OR payment_id > sqlc.narg(after_id))
+ORDER BY payment_id
+LIMIT @page_limit;
+
+-- name: ListPaymentsByIDDesc :many
+SELECT * FROM payments
+WHERE (sqlc.narg(payment_status)::varchar IS NULL OR payment_status = sqlc.narg(payment_status))
+  AND (sqlc.narg(subscription_id)::integer IS NULL OR subscription_id = sqlc.narg(subscription_id))
+  AND (sqlc.narg(date_from)::timestamptz IS NULL OR payment_date >= sqlc.narg(date_from))
+  AND (sqlc.narg(date_to)::timestamptz IS NULL OR payment_date < sqlc.narg(date_to))
+  AND (sqlc.narg(after_id)::integer IS NULL OR payment_id < sqlc.narg(after_id))
+ORDER BY payment_id DESC
+LIMIT @page_limit;
 
 -- name: UpdatePayment :one
 UPDATE payments
@@ -0,0 +1,55 @@
+-- name: CreateRateAlert :one
+INSERT INTO rate_alerts (
+    user_id,
+    source_id,
+    type_id,
+    from_currency_id,
+    to_currency_id,
+    condition,
+    threshold,
+    change_percent,
+    window_hours
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+)
+RETURNING *;
+
+-- name: GetRateAlertForUser :one
+SELECT * FROM rate_alerts
+WHERE alert_id = $1 AND user_id = $2
+LIMIT 1;
+
+-- name: ListRateAlertsByUser :many
+SELECT * FROM rate_alerts
+WHERE user_id = $1
+ORDER BY alert_id
+LIMIT $2 OFFSET $3;
+
+-- name: ListActiveRateAlerts :many
+SELECT * FROM rate_alerts
+WHERE is_active = TRUE
+ORDER BY alert_id;
+
+-- name: UpdateRateAlert :one
+UPDATE rate_alerts
+SET
+    condition = COALESCE(sqlc.narg(condition), condition),
+    threshold = COALESCE(sqlc.narg(threshold), threshold),
+    change_percent = COALESCE(sqlc.narg(change_percent), change_percent),
+    window_hours = COALESCE(sqlc.narg(window_hours), window_hours),
+    is_active = COALESCE(sqlc.narg(is_active), is_active),
+    updated_at = CURRENT_TIMESTAMP
+WHERE alert_id = sqlc.arg(alert_id) AND user_id = sqlc.arg(user_id)
+RETURNING *;
+
+-- name: UpdateRateAlertEvaluation :exec
+UPDATE rate_alerts
+SET
+    last_rate_id = sqlc.arg(last_rate_id),
+    last_rate_value = sqlc.arg(last_rate_value),
+    last_triggered_at = COALESCE(sqlc.narg(last_triggered_at), last_triggered_at)
+WHERE alert_id = sqlc.arg(alert_id);
+
+-- name: DeleteRateAlert :execrows
+DELETE FROM rate_alerts
+WHERE alert_id = $1 AND user_id = $2;
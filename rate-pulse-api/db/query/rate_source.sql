@@ -11,6 +11,11 @@ WHERE source_id = $1 LIMIT 1;
 SELECT source_id, source_name, source_link, source_country, source_status, source_code FROM rate_sources
 WHERE source_code = $1 LIMIT 1;
 
+-- name: ListActiveRateSources :many
+SELECT source_id, source_name, source_code FROM rate_sources
+WHERE source_status = 'active'
+ORDER BY source_id;
+
 -- name: ListRateSourceMetadata :many
 SELECT source_id, source_name, source_code, source_link, currency_id FROM rate_sources
 ORDER BY source_id;
@@ -19,6 +24,73 @@ ORDER BY source_id;
 SELECT source_id, source_name, source_link, source_country, source_status, source_code FROM rate_sources
 ORDER BY source_id;
 
+-- name: ListRateSourcesPageByName :many
+-- Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+-- both the WHERE and the ORDER BY. after_id/after_name are the last row of the previous page.
+SELECT source_id, source_name, source_link, source_country, source_status, source_code FROM rate_sources
+WHERE (sqlc.narg(source_status)::varchar IS NULL OR source_status = sqlc.narg(source_status))
+  AND (sqlc.narg(source_country)::varchar IS NULL OR source_country = sqlc.narg(source_country))
+  AND (
+    sqlc.narg(after_id)::integer IS NULL
+    OR (source_name, source_id) > (sqlc.narg(after_name)::varchar, sqlc.narg(after_id))
+  )
+ORDER BY source_name, source_id
+LIMIT @page_limit;
+
+-- name: ListRateSourcesPageByNameDesc :many
+SELECT source_id, source_name, source_link, source_country, source_status, source_code FROM rate_sources
+WHERE (sqlc.narg(source_status)::varchar IS NULL OR source_status = sqlc.narg(source_status))
+  AND (sqlc.narg(source_country)::varchar IS NULL OR source_country = sqlc.narg(source_country))
+  AND (
+    sqlc.narg(after_id)::integer IS NULL
+    OR (source_name, source_id) < (sqlc.narg(after_name)::varchar, sqlc.narg(after_id))
+  )
+ORDER BY source_name DESC, source_id DESC
+LIMIT @page_limit;
+
+-- name: ListRateSourcesPage :many
+SELECT source_id, source_name, source_link, source_country, source_status, source_code FROM rate_sources
+WHERE (sqlc.narg(source_status)::varchar IS NULL OR source_status = sqlc.narg(source_status))
+  AND (sqlc.narg(source_country)::varchar IS NULL OR source_country = sqlc.narg(source_country))
+  AND (sqlc.narg(after_id)::integer IS NULL OR source_id > sqlc.narg(after_id))
+ORDER BY source_id
+LIMIT @page_limit;
+
+-- name: ListRateSourcesPageDesc :many
+SELECT source_id, source_name, source_link, source_country, source_status, source_code FROM rate_sources
+WHERE (sqlc.narg(source_status)::varchar IS NULL OR source_status = sqlc.narg(source_status))
+  AND (sqlc.narg(source_country)::varchar IS NULL OR source_country = sqlc.narg(source_country))
+  AND (sqlc.narg(after_id)::integer IS NULL OR source_id < sqlc.narg(after_id))
+ORDER BY source_id DESC
+LIMIT @page_limit;
+
+-- name: ListRateSourceFreshness :many
+-- A source owes a new rate every expected_interval_minutes and turns stale once
+-- another half interval has passed without one, counted from its last ingested
+-- rate or, if it never produced one, from when the source was created.
+SELECT
+  rs.source_id,
+  rs.source_name,
+  rs.source_code,
+  rs.source_status,
+  rs.expected_interval_minutes,
+  rs.stale_notified_at,
+  latest.last_ingested_at,
+  (COALESCE(latest.last_ingested_at, rs.created_at, CURRENT_TIMESTAMP)
+    + make_interval(mins => rs.expected_interval_minutes * 3 / 2))::TIMESTAMPTZ AS stale_at
+FROM rate_sources rs
+LEFT JOIN LATERAL (
+  SELECT MAX(er.created_at) AS last_ingested_at
+  FROM exchange_rates er
+  WHERE er.source_id = rs.source_id
+) latest ON TRUE
+ORDER BY rs.source_id;
+
+-- name: MarkRateSourceStaleNotified :exec
+UPDATE rate_sources
+SET stale_notified_at = $2
+WHERE source_id = $1;
+
 -- name: UpdateRateSource :one
 UPDATE rate_sources
 SET 
@@ -30,6 +102,12 @@ SET
 WHERE source_id = $1
 RETURNING *;
 
+-- name: UpdateRateSourceExpectedInterval :one
+UPDATE rate_sources
+SET expected_interval_minutes = $2
+WHERE source_id = $1
+RETURNING *;
+
 -- name: DeleteRateSource :exec
 DELETE FROM rate_sources
 WHERE source_id = $1;
\ No newline at end of file
@@ -32,21 +32,61 @@ SELECT * FROM rate_source_fee_rules
 WHERE fee_rule_id = $1
 LIMIT 1;
 
--- name: ListRateSourceFeeRules :many
+-- name: ListRateSourceFeeRulesByEffectiveFrom :many
+-- Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+-- both the WHERE and the ORDER BY. after_id/after_date are the last row of the previous page.
 SELECT * FROM rate_source_fee_rules
-ORDER BY source_id, type_id, transaction_type, channel, effective_from DESC, fee_rule_id DESC;
+WHERE (sqlc.narg(source_id)::integer IS NULL OR source_id = sqlc.narg(source_id))
+  AND (sqlc.narg(type_id)::integer IS NULL OR type_id = sqlc.narg(type_id))
+  AND (
+    sqlc.narg(active_on)::date IS NULL
+    OR (effective_from <= sqlc.narg(active_on) AND (effective_to IS NULL OR effective_to >= sqlc.narg(active_on)))
+  )
+  AND (
+    sqlc.narg(after_id)::integer IS NULL
+    OR (effective_from, fee_rule_id) > (sqlc.narg(after_date)::date, sqlc.narg(after_id))
+  )
+ORDER BY effective_from, fee_rule_id
+LIMIT @page_limit;
 
--- name: ListRateSourceFeeRulesBySource :many
+-- name: ListRateSourceFeeRulesByEffectiveFromDesc :many
 SELECT * FROM rate_source_fee_rules
-WHERE source_id = $1
-ORDER BY type_id, transaction_type, channel, effective_from DESC, fee_rule_id DESC;
+WHERE (sqlc.narg(source_id)::integer IS NULL OR source_id = sqlc.narg(source_id))
+  AND (sqlc.narg(type_id)::integer IS NULL OR type_id = sqlc.narg(type_id))
+  AND (
+    sqlc.narg(active_on)::date IS NULL
+    OR (effective_from <= sqlc.narg(active_on) AND (effective_to IS NULL OR effective_to >= sqlc.narg(active_on)))
+  )
+  AND (
+    sqlc.narg(after_id)::integer IS NULL
+    OR (effective_from, fee_rule_id) < (sqlc.narg(after_date)::date, sqlc.narg(after_id))
+  )
+ORDER BY effective_from DESC, fee_rule_id DESC
+LIMIT @page_limit;
+
+-- name: ListRateSourceFeeRules :many
+SELECT * FROM rate_source_fee_rules
+WHERE (sqlc.narg(source_id)::integer IS NULL OR source_id = sqlc.narg(source_id))
+  AND (sqlc.narg(type_id)::integer IS NULL OR type_id = sqlc.narg(type_id))
+  AND (
+    sqlc.narg(active_on)::date IS NULL
+    OR (effective_from <= sqlc.narg(active_on) AND (effective_to IS NULL OR effective_to >= sqlc.narg(active_on)))
+  )
+  AND (sqlc.narg(after_id)::integer IS NULL OR fee_rule_id > sqlc.narg(after_id))
+ORDER BY fee_rule_id
+LIMIT @page_limit;
 
--- name: ListActiveRateSourceFeeRulesBySource :many
+-- name: ListRateSourceFeeRulesDesc :many
 SELECT * FROM rate_source_fee_rules
-WHERE source_id = $1
-  AND effective_from <= $2
-  AND (effective_to IS NULL OR effective_to >= $2)
-ORDER BY type_id, transaction_type, channel, effective_from DESC, fee_rule_id DESC;
+WHERE (sqlc.narg(source_id)::integer IS NULL OR source_id = sqlc.narg(source_id))
+  AND (sqlc.narg(type_id)::integer IS NULL OR type_id = sqlc.narg(type_id))
+  AND (
+    sqlc.narg(active_on)::date IS NULL
+    OR (effective_from <= sqlc.narg(active_on) AND (effective_to IS NULL OR effective_to >= sqlc.narg(active_on)))
+  )
+  AND (sqlc.narg(after_id)::integer IS NULL OR fee_rule_id < sqlc.narg(after_id))
+ORDER BY fee_rule_id DESC
+LIMIT @page_limit;
 
 -- name: GetActiveRateSourceFeeRule :one
 SELECT * FROM rate_source_fee_rules
@@ -59,6 +99,17 @@ WHERE source_id = $1
 ORDER BY effective_from DESC, fee_rule_id DESC
 LIMIT 1;
 
+-- name: ListActiveRateSourceFeeRulesForSources :many
+-- Returns the rule GetActiveRateSourceFeeRule would pick for each of the given sources, in one query.
+SELECT DISTINCT ON (source_id) * FROM rate_source_fee_rules
+WHERE source_id = ANY(sqlc.arg(source_ids)::INT[])
+  AND type_id = sqlc.arg(type_id)
+  AND transaction_type = sqlc.arg(transaction_type)
+  AND channel = sqlc.arg(channel)
+  AND effective_from <= sqlc.arg(effective_on)
+  AND (effective_to IS NULL OR effective_to >= sqlc.arg(effective_on))
+ORDER BY source_id, effective_from DESC, fee_rule_id DESC;
+
 -- name: UpdateRateSourceFeeRule :one
 UPDATE rate_source_fee_rules
 SET
@@ -11,4 +11,70 @@ INSERT INTO sessions (
 
 -- name: GetSessionByID :one
 SELECT * FROM sessions
-WHERE session_id = $1 LIMIT 1;
\ No newline at end of file
+WHERE session_id = $1 LIMIT 1;
+
+-- name: CreateRefreshToken :one
+INSERT INTO refresh_tokens (
+    token_id,
+    session_id,
+    expires_at
+) VALUES ($1, $2, $3) RETURNING *;
+
+-- name: GetRefreshTokenSession :one
+-- Resolves a presented refresh token to its session; rotated tokens are still returned
+-- so the caller can detect reuse.
+SELECT
+    rt.token_id,
+    rt.rotated_at,
+    s.session_id,
+    s.user_id,
+    s.refresh_token,
+    s.is_blocked,
+    s.expires_at
+FROM refresh_tokens rt
+JOIN sessions s ON s.session_id = rt.session_id
+WHERE rt.token_id = $1
+LIMIT 1;
+
+-- name: RotateRefreshToken :one
+-- Returns no row when the token was already rotated, so two concurrent renews with the
+-- same token cannot both succeed.
+UPDATE refresh_tokens
+SET rotated_at = now()
+WHERE token_id = $1
+  AND rotated_at IS NULL
+RETURNING *;
+
+-- name: UpdateSessionRefreshToken :one
+UPDATE sessions
+SET refresh_token = $2,
+    user_agent = $3,
+    client_ip = $4,
+    last_used_at = now(),
+    updated_at = now()
+WHERE session_id = $1
+  AND is_blocked IS NOT TRUE
+RETURNING *;
+
+-- name: ListActiveSessionsByUser :many
+SELECT * FROM sessions
+WHERE user_id = $1
+  AND is_blocked IS NOT TRUE
+  AND expires_at > now()
+ORDER BY last_used_at DESC, created_at DESC;
+
+-- name: BlockSession :execrows
+UPDATE sessions
+SET is_blocked = TRUE,
+    updated_at = now()
+WHERE session_id = $1
+  AND user_id = $2
+  AND is_blocked IS NOT TRUE;
+
+-- name: BlockUserSessions :execrows
+UPDATE sessions
+SET is_blocked = TRUE,
+    updated_at = now()
+WHERE user_id = $1
+  AND is_blocked IS NOT TRUE
+  AND expires_at > now();
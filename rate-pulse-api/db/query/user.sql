@@ -28,12 +28,60 @@ WHERE email = $1 LIMIT 1;
 SELECT * FROM users
 WHERE username = $1 LIMIT 1;
 
--- name: ListUsers :many
+-- name: ListUsersByCreatedAt :many
+-- Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+-- both the WHERE and the ORDER BY. after_id/after_time are the last row of the previous page.
 SELECT * FROM users
-ORDER BY created_at 
-LIMIT $1
-OFFSET $2;
+WHERE (sqlc.narg(user_type)::varchar IS NULL OR user_type = sqlc.narg(user_type))
+  AND (sqlc.narg(is_active)::boolean IS NULL OR (is_active IS NOT FALSE) = sqlc.narg(is_active))
+  AND (sqlc.narg(created_from)::timestamptz IS NULL OR created_at >= sqlc.narg(created_from))
+  AND (sqlc.narg(created_to)::timestamptz IS NULL OR created_at < sqlc.narg(created_to))
+  AND (
+    sqlc.narg(after_id)::integer IS NULL
+    OR (COALESCE(created_at, 'epoch'), user_id) > (sqlc.narg(after_time)::timestamptz, sqlc.narg(after_id))
+  )
+ORDER BY COALESCE(created_at, 'epoch'), user_id
+LIMIT @page_limit;
 
+-- name: ListUsersByCreatedAtDesc :many
+SELECT * FROM users
+WHERE (sqlc.narg(user_type)::varchar IS NULL OR user_type = sqlc.narg(user_type))
+  AND (sqlc.narg(is_active)::boolean IS NULL OR (is_active IS NOT FALSE) = sqlc.narg(is_active))
+  AND (sqlc.narg(created_from)::timestamptz IS NULL OR created_at >= sqlc.narg(created_from))
+  AND (sqlc.narg(created_to)::timestamptz IS NULL OR created_at < sqlc.narg(created_to))
+  AND (
+    sqlc.narg(after_id)::integer IS NULL
+    OR (COALESCE(created_at, 'epoch'), user_id) < (sqlc.narg(after_time)::timestamptz, sqlc.narg(after_id))
+  )
+ORDER BY COALESCE(created_at, 'epoch') DESC, user_id DESC
+LIMIT @page_limit;
+
+-- name: ListUsersByID :many
+SELECT * FROM users
+WHERE (sqlc.narg(user_type)::varchar IS NULL OR user_type = sqlc.narg(user_type))
+  AND (sqlc.narg(is_active)::boolean IS NULL OR (is_active IS NOT FALSE) = sqlc.narg(is_active))
+  AND (sqlc.narg(created_from)::timestamptz IS NULL OR created_at >= sqlc.narg(created_from))
+  AND (sqlc.narg(created_to)::timestamptz IS NULL OR created_at < sqlc.narg(created_to))
+  AND (sqlc.narg(after_id)::integer IS NULL OR user_id > sqlc.narg(after_id))
+ORDER BY user_id
+LIMIT @page_limit;
+
+-- name: ListUsersByIDDesc :many
+SELECT * FROM users
+WHERE (sqlc.narg(user_type)::varchar IS NULL OR user_type = sqlc.narg(user_type))
+  AND (sqlc.narg(is_active)::boolean IS NULL OR (is_active IS NOT FALSE) = sqlc.narg(is_active))
+  AND (sqlc.narg(created_from)::timestamptz IS NULL OR created_at >= sqlc.narg(created_from))
+  AND (sqlc.narg(created_to)::timestamptz IS NULL OR created_at < sqlc.narg(created_to))
+  AND (sqlc.narg(after_id)::integer IS NULL OR user_id < sqlc.narg(after_id))
+ORDER BY user_id DESC
+LIMIT @page_limit;
+
+-- name: ListActiveAdminEmails :many
+SELECT email FROM users
+WHERE user_type = 'admin' AND is_active IS NOT FALSE
+ORDER BY user_id;
+
+-- A new email is unverified unless the caller sets email_verified itself.
 -- name: UpdateUser :one
 UPDATE users
 SET
@@ -41,7 +89,7 @@ SET
     email = COALESCE(sqlc.narg(email), email),
     password = COALESCE(sqlc.narg(password), password),
     user_type = COALESCE(sqlc.narg(user_type), user_type),
-    email_verified = COALESCE(sqlc.narg(email_verified), email_verified),
+    email_verified = COALESCE(sqlc.narg(email_verified), email_verified AND COALESCE(sqlc.narg(email), email) = email),
     time_zone = COALESCE(sqlc.narg(time_zone), time_zone),
     language_preference = COALESCE(sqlc.narg(language_preference), language_preference),
     country_of_residence = COALESCE(sqlc.narg(country_of_residence), country_of_residence),
@@ -0,0 +1,35 @@
+-- name: GetUserPlanLimits :one
+-- The plan behind the user's current active subscription, as GetActiveUserSubscriptionByUserID picks it.
+SELECT
+    sp.plan_id,
+    sp.plan_name,
+    sp.historical_days,
+    sp.rate_limit_per_day
+FROM user_subscriptions us
+JOIN subscription_plans sp ON sp.plan_id = us.plan_id
+WHERE us.user_id = $1 AND us.status = 'active'
+ORDER BY us.start_date DESC
+LIMIT 1;
+
+-- name: IncrementDailyUsage :one
+-- Counts one request unless the day's count already reached daily_limit; no row comes back
+-- when the quota is exhausted, so the check and the increment cannot race.
+INSERT INTO user_daily_usage (
+    user_id,
+    usage_date,
+    request_count
+) VALUES (
+    sqlc.arg(user_id), sqlc.arg(usage_date), 1
+)
+ON CONFLICT (user_id, usage_date) DO UPDATE
+SET
+    request_count = user_daily_usage.request_count + 1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_daily_usage.request_count < sqlc.arg(daily_limit)::INT
+RETURNING request_count;
+
+-- name: GetDailyUsage :one
+SELECT COALESCE(
+    (SELECT request_count FROM user_daily_usage WHERE user_id = $1 AND usage_date = $2),
+    0
+)::INT AS request_count;
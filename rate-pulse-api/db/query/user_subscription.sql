@@ -30,9 +30,53 @@ SELECT * FROM user_subscriptions
 WHERE status = $1
 ORDER BY start_date DESC;
 
--- name: GetAllUserSubscriptions :many
+-- name: ListUserSubscriptionsByStartDate :many
+-- Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+-- both the WHERE and the ORDER BY. after_id/after_time are the last row of the previous page.
 SELECT * FROM user_subscriptions
-ORDER BY start_date DESC;
+WHERE (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+  AND (sqlc.narg(plan_id)::integer IS NULL OR plan_id = sqlc.narg(plan_id))
+  AND (sqlc.narg(date_from)::timestamptz IS NULL OR start_date >= sqlc.narg(date_from))
+  AND (sqlc.narg(date_to)::timestamptz IS NULL OR start_date < sqlc.narg(date_to))
+  AND (
+    sqlc.narg(after_id)::integer IS NULL
+    OR (start_date, subscription_id) > (sqlc.narg(after_time)::timestamptz, sqlc.narg(after_id))
+  )
+ORDER BY start_date, subscription_id
+LIMIT @page_limit;
+
+-- name: ListUserSubscriptionsByStartDateDesc :many
+SELECT * FROM user_subscriptions
+WHERE (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+  AND (sqlc.narg(plan_id)::integer IS NULL OR plan_id = sqlc.narg(plan_id))
+  AND (sqlc.narg(date_from)::timestamptz IS NULL OR start_date >= sqlc.narg(date_from))
+  AND (sqlc.narg(date_to)::timestamptz IS NULL OR start_date < sqlc.narg(date_to))
+  AND (
+    sqlc.narg(after_id)::integer IS NULL
+    OR (start_date, subscription_id) < (sqlc.narg(after_time)::timestamptz, sqlc.narg(after_id))
+  )
+ORDER BY start_date DESC, subscription_id DESC
+LIMIT @page_limit;
+
+-- name: ListUserSubscriptionsByID :many
+SELECT * FROM user_subscriptions
+WHERE (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+  AND (sqlc.narg(plan_id)::integer IS NULL OR plan_id = sqlc.narg(plan_id))
+  AND (sqlc.narg(date_from)::timestamptz IS NULL OR start_date >= sqlc.narg(date_from))
+  AND (sqlc.narg(date_to)::timestamptz IS NULL OR start_date < sqlc.narg(date_to))
+  AND (sqlc.narg(after_id)::integer IS NULL OR subscription_id > sqlc.narg(after_id))
+ORDER BY subscription_id
+LIMIT @page_limit;
+
+-- name: ListUserSubscriptionsByIDDesc :many
+SELECT * FROM user_subscriptions
+WHERE (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+  AND (sqlc.narg(plan_id)::integer IS NULL OR plan_id = sqlc.narg(plan_id))
+  AND (sqlc.narg(date_from)::timestamptz IS NULL OR start_date >= sqlc.narg(date_from))
+  AND (sqlc.narg(date_to)::timestamptz IS NULL OR start_date < sqlc.narg(date_to))
+  AND (sqlc.narg(after_id)::integer IS NULL OR subscription_id < sqlc.narg(after_id))
+ORDER BY subscription_id DESC
+LIMIT @page_limit;
 
 -- name: UpdateUserSubscription :one
 UPDATE user_subscriptions
@@ -47,13 +47,15 @@ RETURNING
     created_at,
     expired_at;
 
+-- Only verifies the address the link was sent to, not one the user has since changed to.
 -- name: UpdateUserEmailVerified :one
 UPDATE users
 SET
     email_verified = TRUE,
     updated_at = CURRENT_TIMESTAMP
 WHERE
-    user_id = $1
+    user_id = @user_id
+    AND email = @email
 RETURNING
     user_id,
     username,
@@ -69,4 +71,4 @@ RETURNING
     created_at,
     updated_at,
     first_name,
-    last_name;
\ No newline at end of file
+    last_name;
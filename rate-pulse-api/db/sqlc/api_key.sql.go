@@ -0,0 +1,204 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: api_key.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+const countActiveAPIKeysByUser = `-- name: CountActiveAPIKeysByUser :one
+SELECT COUNT(*) FROM api_keys
+WHERE user_id = $1
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > now())
+`
+
+func (q *Queries) CountActiveAPIKeysByUser(ctx context.Context, userID int32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countActiveAPIKeysByUser, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAPIKey = `-- name: CreateAPIKey :one
+INSERT INTO api_keys (
+    user_id,
+    name,
+    key_prefix,
+    key_hash,
+    scopes,
+    expires_at
+) VALUES ($1, $2, $3, $4, $5, $6) RETURNING api_key_id, user_id, name, key_prefix, key_hash, scopes, expires_at, last_used_at, revoked_at, created_at
+`
+
+type CreateAPIKeyParams struct {
+	UserID    int32
+	Name      string
+	KeyPrefix string
+	KeyHash   string
+	Scopes    []string
+	ExpiresAt sql.NullTime
+}
+
+func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (ApiKey, error) {
+	row := q.db.QueryRowContext(ctx, createAPIKey,
+		arg.UserID,
+		arg.Name,
+		arg.KeyPrefix,
+		arg.KeyHash,
+		pq.Array(arg.Scopes),
+		arg.ExpiresAt,
+	)
+	var i ApiKey
+	err := row.Scan(
+		&i.ApiKeyID,
+		&i.UserID,
+		&i.Name,
+		&i.KeyPrefix,
+		&i.KeyHash,
+		pq.Array(&i.Scopes),
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAPIKeyOwnerByHash = `-- name: GetAPIKeyOwnerByHash :one
+SELECT
+    k.api_key_id,
+    k.user_id,
+    k.scopes,
+    k.expires_at,
+    k.revoked_at,
+    u.username,
+    u.email,
+    u.user_type,
+    u.is_active
+FROM api_keys k
+JOIN users u ON u.user_id = k.user_id
+WHERE k.key_hash = $1
+LIMIT 1
+`
+
+type GetAPIKeyOwnerByHashRow struct {
+	ApiKeyID  int32
+	UserID    int32
+	Scopes    []string
+	ExpiresAt sql.NullTime
+	RevokedAt sql.NullTime
+	Username  string
+	Email     string
+	UserType  sql.NullString
+	IsActive  sql.NullBool
+}
+
+// Resolves a presented key to its owner; revoked and expired keys are left to the caller.
+func (q *Queries) GetAPIKeyOwnerByHash(ctx context.Context, keyHash string) (GetAPIKeyOwnerByHashRow, error) {
+	row := q.db.QueryRowContext(ctx, getAPIKeyOwnerByHash, keyHash)
+	var i GetAPIKeyOwnerByHashRow
+	err := row.Scan(
+		&i.ApiKeyID,
+		&i.UserID,
+		pq.Array(&i.Scopes),
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.Username,
+		&i.Email,
+		&i.UserType,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const listAPIKeysByUser = `-- name: ListAPIKeysByUser :many
+SELECT api_key_id, user_id, name, key_prefix, key_hash, scopes, expires_at, last_used_at, revoked_at, created_at FROM api_keys
+WHERE user_id = $1
+ORDER BY created_at DESC, api_key_id DESC
+`
+
+func (q *Queries) ListAPIKeysByUser(ctx context.Context, userID int32) ([]ApiKey, error) {
+	rows, err := q.db.QueryContext(ctx, listAPIKeysByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ApiKey
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.ApiKeyID,
+			&i.UserID,
+			&i.Name,
+			&i.KeyPrefix,
+			&i.KeyHash,
+			pq.Array(&i.Scopes),
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeAPIKey = `-- name: RevokeAPIKey :one
+UPDATE api_keys
+SET revoked_at = now()
+WHERE api_key_id = $1
+  AND user_id = $2
+  AND revoked_at IS NULL
+RETURNING api_key_id, user_id, name, key_prefix, key_hash, scopes, expires_at, last_used_at, revoked_at, created_at
+`
+
+type RevokeAPIKeyParams struct {
+	ApiKeyID int32
+	UserID   int32
+}
+
+func (q *Queries) RevokeAPIKey(ctx context.Context, arg RevokeAPIKeyParams) (ApiKey, error) {
+	row := q.db.QueryRowContext(ctx, revokeAPIKey, arg.ApiKeyID, arg.UserID)
+	var i ApiKey
+	err := row.Scan(
+		&i.ApiKeyID,
+		&i.UserID,
+		&i.Name,
+		&i.KeyPrefix,
+		&i.KeyHash,
+		pq.Array(&i.Scopes),
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const touchAPIKeyLastUsed = `-- name: TouchAPIKeyLastUsed :exec
+UPDATE api_keys
+SET last_used_at = now()
+WHERE api_key_id = $1
+  AND (last_used_at IS NULL OR last_used_at < now() - INTERVAL '1 minute')
+`
+
+// Writes at most once a minute per key so busy ETL jobs do not turn every request into an UPDATE.
+func (q *Queries) TouchAPIKeyLastUsed(ctx context.Context, apiKeyID int32) error {
+	_, err := q.db.ExecContext(ctx, touchAPIKeyLastUsed, apiKeyID)
+	return err
+}
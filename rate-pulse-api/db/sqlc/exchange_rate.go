@@ -0,0 +1,22 @@
+package db
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RateIn returns how many units of currencyID one unit of the other currency of the rate buys.
+// rate_value is stored as units of the source currency per 1 destination currency, so the value is
+// rate_value when currencyID is the source currency and its inverse otherwise.
+func (rate ExchangeRate) RateIn(currencyID int32) (float64, error) {
+	value, err := strconv.ParseFloat(strings.TrimSpace(rate.RateValue), 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) || value <= 0 {
+		return 0, fmt.Errorf("exchange rate %d has an invalid rate_value %q", rate.RateID, rate.RateValue)
+	}
+	if rate.SourceCurrencyID == currencyID {
+		return value, nil
+	}
+	return 1 / value, nil
+}
@@ -9,12 +9,68 @@ import (
 	"context"
 	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
+const closeSupersededExchangeRates = `-- name: CloseSupersededExchangeRates :execrows
+UPDATE exchange_rates er
+SET valid_to_date = superseded.next_valid_from_date
+FROM (
+  SELECT cur.rate_id, MIN(later.valid_from_date) AS next_valid_from_date
+  FROM (
+    SELECT DISTINCT snapshot.*
+    FROM unnest(
+      $1::INT[],
+      $2::INT[],
+      $3::INT[],
+      $4::INT[]
+    ) AS snapshot(source_id, source_currency_id, destination_currency_id, type_id)
+  ) series
+  JOIN exchange_rates cur
+    ON cur.source_id = series.source_id
+   AND cur.source_currency_id = series.source_currency_id
+   AND cur.destination_currency_id = series.destination_currency_id
+   AND cur.type_id = series.type_id
+  JOIN exchange_rates later
+    ON later.source_id = cur.source_id
+   AND later.source_currency_id = cur.source_currency_id
+   AND later.destination_currency_id = cur.destination_currency_id
+   AND later.type_id = cur.type_id
+   AND later.valid_from_date > cur.valid_from_date
+   AND later.status = 'active'
+  WHERE cur.valid_to_date IS NULL
+  GROUP BY cur.rate_id
+) superseded
+WHERE er.rate_id = superseded.rate_id
+`
+
+type CloseSupersededExchangeRatesParams struct {
+	SourceIds              []int32
+	SourceCurrencyIds      []int32
+	DestinationCurrencyIds []int32
+	TypeIds                []int32
+}
+
+// Sets valid_to_date on every open rate of the given (source, pair, type) series to the
+// valid_from_date of the next active rate in the series. The closed rows stay as history.
+func (q *Queries) CloseSupersededExchangeRates(ctx context.Context, arg CloseSupersededExchangeRatesParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, closeSupersededExchangeRates,
+		pq.Array(arg.SourceIds),
+		pq.Array(arg.SourceCurrencyIds),
+		pq.Array(arg.DestinationCurrencyIds),
+		pq.Array(arg.TypeIds),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const createExchangeRate = `-- name: CreateExchangeRate :one
-INSERT INTO exchange_rates (rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, type_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, updated_at, created_at, type_id
+INSERT INTO exchange_rates (rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, type_id, status, quarantine_reason)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, updated_at, created_at, type_id, status, quarantine_reason
 `
 
 type CreateExchangeRateParams struct {
@@ -25,6 +81,8 @@ type CreateExchangeRateParams struct {
 	ValidToDate           sql.NullTime
 	SourceID              sql.NullInt32
 	TypeID                sql.NullInt32
+	Status                string
+	QuarantineReason      sql.NullString
 }
 
 func (q *Queries) CreateExchangeRate(ctx context.Context, arg CreateExchangeRateParams) (ExchangeRate, error) {
@@ -36,6 +94,8 @@ func (q *Queries) CreateExchangeRate(ctx context.Context, arg CreateExchangeRate
 		arg.ValidToDate,
 		arg.SourceID,
 		arg.TypeID,
+		arg.Status,
+		arg.QuarantineReason,
 	)
 	var i ExchangeRate
 	err := row.Scan(
@@ -49,19 +109,12 @@ func (q *Queries) CreateExchangeRate(ctx context.Context, arg CreateExchangeRate
 		&i.UpdatedAt,
 		&i.CreatedAt,
 		&i.TypeID,
+		&i.Status,
+		&i.QuarantineReason,
 	)
 	return i, err
 }
 
-const deleteAllExchangeRates = `-- name: DeleteAllExchangeRates :exec
-DELETE FROM exchange_rates
-`
-
-func (q *Queries) DeleteAllExchangeRates(ctx context.Context) error {
-	_, err := q.db.ExecContext(ctx, deleteAllExchangeRates)
-	return err
-}
-
 const deleteExchangeRate = `-- name: DeleteExchangeRate :exec
 DELETE FROM exchange_rates
 WHERE rate_id = $1
@@ -77,6 +130,7 @@ SELECT rate_id, rate_value, source_currency_id, destination_currency_id, valid_f
 FROM exchange_rates
 WHERE created_at >= (SELECT NOW()::date)
 AND source_currency_id = $1
+AND status = 'active'
 ORDER BY rate_id DESC
 LIMIT $2
 `
@@ -150,6 +204,7 @@ JOIN currencies dc ON er.destination_currency_id = dc.currency_id
 LEFT JOIN rate_sources rs ON er.source_id = rs.source_id
 LEFT JOIN exchange_rate_types ert ON er.type_id = ert.type_id
 WHERE er.source_currency_id = $1
+  AND er.status = 'active'
 ORDER BY
   er.destination_currency_id,
   er.source_id,
@@ -207,8 +262,151 @@ func (q *Queries) GetAllExchangeRatesTodayNormalised(ctx context.Context, arg Ge
 	return items, nil
 }
 
+const getDailyHistoricalData = `-- name: GetDailyHistoricalData :many
+SELECT DISTINCT ON (day_start)
+  date_trunc('day', er.updated_at, $1::TEXT)::TIMESTAMPTZ AS day_start,
+  er.rate_value,
+  er.updated_at,
+  er.type_id
+FROM exchange_rates er
+WHERE er.source_currency_id = $2
+  AND er.destination_currency_id = $3
+  AND er.source_id = $4
+  AND er.type_id = $5
+  AND er.updated_at >= $6
+  AND er.updated_at < $7
+  AND er.status = 'active'
+ORDER BY day_start, er.updated_at DESC
+`
+
+type GetDailyHistoricalDataParams struct {
+	TimeZone              string
+	SourceCurrencyID      int32
+	DestinationCurrencyID int32
+	SourceID              sql.NullInt32
+	TypeID                sql.NullInt32
+	StartTime             sql.NullTime
+	EndTime               sql.NullTime
+}
+
+type GetDailyHistoricalDataRow struct {
+	DayStart  time.Time
+	RateValue string
+	UpdatedAt sql.NullTime
+	TypeID    sql.NullInt32
+}
+
+// Returns the last rate of each calendar day in the given time zone, matching end-of-day bank quotes.
+func (q *Queries) GetDailyHistoricalData(ctx context.Context, arg GetDailyHistoricalDataParams) ([]GetDailyHistoricalDataRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDailyHistoricalData,
+		arg.TimeZone,
+		arg.SourceCurrencyID,
+		arg.DestinationCurrencyID,
+		arg.SourceID,
+		arg.TypeID,
+		arg.StartTime,
+		arg.EndTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDailyHistoricalDataRow
+	for rows.Next() {
+		var i GetDailyHistoricalDataRow
+		if err := rows.Scan(
+			&i.DayStart,
+			&i.RateValue,
+			&i.UpdatedAt,
+			&i.TypeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExchangeRateAnomalyBaseline = `-- name: GetExchangeRateAnomalyBaseline :one
+WITH history AS (
+  SELECT rate_value
+  FROM exchange_rates
+  WHERE source_id = $1::INT
+    AND type_id = $2::INT
+    AND source_currency_id = $3::INT
+    AND destination_currency_id = $4::INT
+    AND status = 'active'
+  ORDER BY updated_at DESC NULLS LAST, rate_id DESC
+  LIMIT $5::INT
+), peers AS (
+  SELECT DISTINCT ON (source_id) rate_value
+  FROM exchange_rates
+  WHERE source_id <> $1::INT
+    AND type_id = $2::INT
+    AND source_currency_id = $3::INT
+    AND destination_currency_id = $4::INT
+    AND status = 'active'
+    AND updated_at >= $6::TIMESTAMPTZ
+  ORDER BY source_id, updated_at DESC NULLS LAST, rate_id DESC
+)
+SELECT
+  (SELECT COUNT(*) FROM history) AS history_count,
+  (SELECT COALESCE(AVG(rate_value), 0) FROM history)::NUMERIC AS history_mean,
+  (SELECT COALESCE(STDDEV_SAMP(rate_value), 0) FROM history)::NUMERIC AS history_stddev,
+  (SELECT COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY rate_value), 0) FROM history)::NUMERIC AS history_median,
+  (SELECT COUNT(*) FROM peers) AS peer_count,
+  (SELECT COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY rate_value), 0) FROM peers)::NUMERIC AS peer_median
+`
+
+type GetExchangeRateAnomalyBaselineParams struct {
+	SourceID              int32
+	TypeID                int32
+	SourceCurrencyID      int32
+	DestinationCurrencyID int32
+	HistorySize           int32
+	PeerSince             time.Time
+}
+
+type GetExchangeRateAnomalyBaselineRow struct {
+	HistoryCount  int64
+	HistoryMean   string
+	HistoryStddev string
+	HistoryMedian string
+	PeerCount     int64
+	PeerMedian    string
+}
+
+// Summarises what a new rate is checked against: the source's most recent active rates for
+// the pair and type, and the latest active rate of every other source published since peer_since.
+func (q *Queries) GetExchangeRateAnomalyBaseline(ctx context.Context, arg GetExchangeRateAnomalyBaselineParams) (GetExchangeRateAnomalyBaselineRow, error) {
+	row := q.db.QueryRowContext(ctx, getExchangeRateAnomalyBaseline,
+		arg.SourceID,
+		arg.TypeID,
+		arg.SourceCurrencyID,
+		arg.DestinationCurrencyID,
+		arg.HistorySize,
+		arg.PeerSince,
+	)
+	var i GetExchangeRateAnomalyBaselineRow
+	err := row.Scan(
+		&i.HistoryCount,
+		&i.HistoryMean,
+		&i.HistoryStddev,
+		&i.HistoryMedian,
+		&i.PeerCount,
+		&i.PeerMedian,
+	)
+	return i, err
+}
+
 const getExchangeRateByID = `-- name: GetExchangeRateByID :one
-SELECT rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, type_id, created_at, updated_at
+SELECT rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, type_id, created_at, updated_at, status, quarantine_reason
 FROM exchange_rates
 WHERE rate_id = $1 
 LIMIT 1
@@ -225,6 +423,8 @@ type GetExchangeRateByIDRow struct {
 	TypeID                sql.NullInt32
 	CreatedAt             sql.NullTime
 	UpdatedAt             sql.NullTime
+	Status                string
+	QuarantineReason      sql.NullString
 }
 
 func (q *Queries) GetExchangeRateByID(ctx context.Context, rateID int32) (GetExchangeRateByIDRow, error) {
@@ -241,6 +441,144 @@ func (q *Queries) GetExchangeRateByID(ctx context.Context, rateID int32) (GetExc
 		&i.TypeID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.QuarantineReason,
+	)
+	return i, err
+}
+
+const getExchangeRateCandles = `-- name: GetExchangeRateCandles :many
+SELECT
+  date_trunc($1::TEXT, er.valid_from_date, $2::TEXT)::TIMESTAMPTZ AS bucket_start,
+  (array_agg(er.rate_value ORDER BY er.valid_from_date, er.rate_id))[1]::NUMERIC AS open_rate,
+  MAX(er.rate_value)::NUMERIC AS high_rate,
+  MIN(er.rate_value)::NUMERIC AS low_rate,
+  (array_agg(er.rate_value ORDER BY er.valid_from_date DESC, er.rate_id DESC))[1]::NUMERIC AS close_rate,
+  COUNT(*) AS sample_count
+FROM exchange_rates er
+WHERE er.source_currency_id = $3
+  AND er.destination_currency_id = $4
+  AND er.source_id = $5
+  AND er.type_id = $6
+  AND er.valid_from_date >= date_trunc($1::TEXT, $7::TIMESTAMPTZ, $2::TEXT)
+  AND er.valid_from_date < $8::TIMESTAMPTZ
+  AND er.status = 'active'
+GROUP BY 1
+ORDER BY 1 DESC
+LIMIT $9
+`
+
+type GetExchangeRateCandlesParams struct {
+	BucketUnit            string
+	TimeZone              string
+	SourceCurrencyID      int32
+	DestinationCurrencyID int32
+	SourceID              sql.NullInt32
+	TypeID                sql.NullInt32
+	StartTime             time.Time
+	EndTime               time.Time
+	MaxCandles            int32
+}
+
+type GetExchangeRateCandlesRow struct {
+	BucketStart time.Time
+	OpenRate    string
+	HighRate    string
+	LowRate     string
+	CloseRate   string
+	SampleCount int64
+}
+
+// Aggregates a source's rates for a currency pair into open/high/low/close candles, placing
+// each rate by valid_from_date, the time the source quoted it, rather than when the row was written.
+// Buckets are calendar aligned with date_trunc in the given time zone, so every candle
+// spans a full hour, day, ISO week or month; start_time is widened to its bucket start.
+// Returns the most recent candles first.
+func (q *Queries) GetExchangeRateCandles(ctx context.Context, arg GetExchangeRateCandlesParams) ([]GetExchangeRateCandlesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getExchangeRateCandles,
+		arg.BucketUnit,
+		arg.TimeZone,
+		arg.SourceCurrencyID,
+		arg.DestinationCurrencyID,
+		arg.SourceID,
+		arg.TypeID,
+		arg.StartTime,
+		arg.EndTime,
+		arg.MaxCandles,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetExchangeRateCandlesRow
+	for rows.Next() {
+		var i GetExchangeRateCandlesRow
+		if err := rows.Scan(
+			&i.BucketStart,
+			&i.OpenRate,
+			&i.HighRate,
+			&i.LowRate,
+			&i.CloseRate,
+			&i.SampleCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExchangeRateForPairAsOf = `-- name: GetExchangeRateForPairAsOf :one
+SELECT rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, updated_at, created_at, type_id, status, quarantine_reason FROM exchange_rates
+WHERE source_id = $1
+  AND type_id = $2
+  AND (
+    (source_currency_id = $3 AND destination_currency_id = $4)
+    OR (source_currency_id = $4 AND destination_currency_id = $3)
+  )
+  AND updated_at <= $5
+  AND status = 'active'
+ORDER BY updated_at DESC, rate_id DESC
+LIMIT 1
+`
+
+type GetExchangeRateForPairAsOfParams struct {
+	SourceID  sql.NullInt32
+	TypeID    sql.NullInt32
+	CurrencyA int32
+	CurrencyB int32
+	AsOf      sql.NullTime
+}
+
+// Returns the rate a source had published for a currency pair at a point in time.
+func (q *Queries) GetExchangeRateForPairAsOf(ctx context.Context, arg GetExchangeRateForPairAsOfParams) (ExchangeRate, error) {
+	row := q.db.QueryRowContext(ctx, getExchangeRateForPairAsOf,
+		arg.SourceID,
+		arg.TypeID,
+		arg.CurrencyA,
+		arg.CurrencyB,
+		arg.AsOf,
+	)
+	var i ExchangeRate
+	err := row.Scan(
+		&i.RateID,
+		&i.RateValue,
+		&i.SourceCurrencyID,
+		&i.DestinationCurrencyID,
+		&i.ValidFromDate,
+		&i.ValidToDate,
+		&i.SourceID,
+		&i.UpdatedAt,
+		&i.CreatedAt,
+		&i.TypeID,
+		&i.Status,
+		&i.QuarantineReason,
 	)
 	return i, err
 }
@@ -258,6 +596,8 @@ WITH bucketed AS (
     AND er.source_id = $3
     AND er.updated_at >= $4
     AND er.type_id = $5
+    AND er.updated_at < $7
+    AND er.status = 'active'
 )
 SELECT DISTINCT ON (bucket) rate_value, updated_at, type_id
 FROM bucketed
@@ -271,6 +611,7 @@ type GetHistoricalDataParams struct {
 	UpdatedAt             sql.NullTime
 	TypeID                sql.NullInt32
 	Ntile                 int32
+	UpdatedAt_2           sql.NullTime
 }
 
 type GetHistoricalDataRow struct {
@@ -280,7 +621,7 @@ type GetHistoricalDataRow struct {
 }
 
 // Fetches evenly distributed exchange rate data points across a time range.
-// Returns up to num_data_points evenly spaced samples from the time range [start_time, now).
+// Returns up to num_data_points evenly spaced samples from the time range [start_time, end_time).
 // Parameters:
 //
 //	$1: source_currency_id
@@ -289,6 +630,7 @@ type GetHistoricalDataRow struct {
 //	$4: start_time (UpdatedAt in struct)
 //	$5: type_id
 //	$6: num_data_points
+//	$7: end_time (UpdatedAt_2 in struct)
 func (q *Queries) GetHistoricalData(ctx context.Context, arg GetHistoricalDataParams) ([]GetHistoricalDataRow, error) {
 	rows, err := q.db.QueryContext(ctx, getHistoricalData,
 		arg.SourceCurrencyID,
@@ -297,6 +639,7 @@ func (q *Queries) GetHistoricalData(ctx context.Context, arg GetHistoricalDataPa
 		arg.UpdatedAt,
 		arg.TypeID,
 		arg.Ntile,
+		arg.UpdatedAt_2,
 	)
 	if err != nil {
 		return nil, err
@@ -319,6 +662,617 @@ func (q *Queries) GetHistoricalData(ctx context.Context, arg GetHistoricalDataPa
 	return items, nil
 }
 
+const getLatestExchangeRateForPair = `-- name: GetLatestExchangeRateForPair :one
+SELECT rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, updated_at, created_at, type_id, status, quarantine_reason FROM exchange_rates
+WHERE source_id = $1
+  AND type_id = $2
+  AND (
+    (source_currency_id = $3 AND destination_currency_id = $4)
+    OR (source_currency_id = $4 AND destination_currency_id = $3)
+  )
+  AND status = 'active'
+ORDER BY updated_at DESC NULLS LAST, rate_id DESC
+LIMIT 1
+`
+
+type GetLatestExchangeRateForPairParams struct {
+	SourceID  sql.NullInt32
+	TypeID    sql.NullInt32
+	CurrencyA int32
+	CurrencyB int32
+}
+
+// Returns the most recent rate a source published for a currency pair in either direction.
+func (q *Queries) GetLatestExchangeRateForPair(ctx context.Context, arg GetLatestExchangeRateForPairParams) (ExchangeRate, error) {
+	row := q.db.QueryRowContext(ctx, getLatestExchangeRateForPair,
+		arg.SourceID,
+		arg.TypeID,
+		arg.CurrencyA,
+		arg.CurrencyB,
+	)
+	var i ExchangeRate
+	err := row.Scan(
+		&i.RateID,
+		&i.RateValue,
+		&i.SourceCurrencyID,
+		&i.DestinationCurrencyID,
+		&i.ValidFromDate,
+		&i.ValidToDate,
+		&i.SourceID,
+		&i.UpdatedAt,
+		&i.CreatedAt,
+		&i.TypeID,
+		&i.Status,
+		&i.QuarantineReason,
+	)
+	return i, err
+}
+
+const lockExchangeRateSeries = `-- name: LockExchangeRateSeries :exec
+SELECT pg_advisory_xact_lock(series.lock_key)
+FROM (
+  SELECT DISTINCT hashtextextended(concat_ws(':',
+    snapshot.source_id, snapshot.type_id, snapshot.source_currency_id, snapshot.destination_currency_id
+  ), 0) AS lock_key
+  FROM unnest(
+    $1::INT[],
+    $2::INT[],
+    $3::INT[],
+    $4::INT[]
+  ) AS snapshot(source_id, type_id, source_currency_id, destination_currency_id)
+  ORDER BY lock_key
+) series
+`
+
+type LockExchangeRateSeriesParams struct {
+	SourceIds              []int32
+	TypeIds                []int32
+	SourceCurrencyIds      []int32
+	DestinationCurrencyIds []int32
+}
+
+// Takes one transaction-scoped advisory lock per distinct (source, pair, type) series, in a
+// fixed order so two snapshots sharing series cannot deadlock. Concurrent snapshots of the same
+// series then run InsertExchangeRatesSnapshot and CloseSupersededExchangeRates one at a time.
+func (q *Queries) LockExchangeRateSeries(ctx context.Context, arg LockExchangeRateSeriesParams) error {
+	_, err := q.db.ExecContext(ctx, lockExchangeRateSeries,
+		pq.Array(arg.SourceIds),
+		pq.Array(arg.TypeIds),
+		pq.Array(arg.SourceCurrencyIds),
+		pq.Array(arg.DestinationCurrencyIds),
+	)
+	return err
+}
+
+const insertExchangeRatesSnapshot = `-- name: InsertExchangeRatesSnapshot :many
+INSERT INTO exchange_rates (rate_value, source_currency_id, destination_currency_id, valid_from_date, source_id, type_id, status, quarantine_reason)
+SELECT snapshot.rate_value, snapshot.source_currency_id, snapshot.destination_currency_id,
+       snapshot.valid_from_date, snapshot.source_id, snapshot.type_id,
+       snapshot.status, NULLIF(snapshot.quarantine_reason, '')
+FROM unnest(
+  $1::NUMERIC[],
+  $2::INT[],
+  $3::INT[],
+  $4::TIMESTAMPTZ[],
+  $5::INT[],
+  $6::INT[],
+  $7::TEXT[],
+  $8::TEXT[],
+  $9::TIMESTAMPTZ[],
+  $10::TIMESTAMPTZ[]
+) AS snapshot(rate_value, source_currency_id, destination_currency_id, valid_from_date, source_id, type_id,
+              status, quarantine_reason, bucket_start, bucket_end)
+WHERE NOT EXISTS (
+  SELECT 1 FROM exchange_rates er
+  WHERE er.source_id = snapshot.source_id
+    AND er.type_id = snapshot.type_id
+    AND er.source_currency_id = snapshot.source_currency_id
+    AND er.destination_currency_id = snapshot.destination_currency_id
+    AND er.valid_from_date >= snapshot.bucket_start
+    AND er.valid_from_date < snapshot.bucket_end
+    AND er.status <> 'rejected'
+)
+RETURNING rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, updated_at, created_at, type_id, status, quarantine_reason
+`
+
+type InsertExchangeRatesSnapshotParams struct {
+	RateValues             []string
+	SourceCurrencyIds      []int32
+	DestinationCurrencyIds []int32
+	ValidFromDates         []time.Time
+	SourceIds              []int32
+	TypeIds                []int32
+	Statuses               []string
+	QuarantineReasons      []string
+	BucketStarts           []time.Time
+	BucketEnds             []time.Time
+}
+
+// Inserts every rate of a snapshot in a single multi-row statement, skipping a rate when its
+// source already published the pair and type within the same time bucket. Rejected rates do
+// not count, so a corrected replay of the bucket is accepted. Run it after LockExchangeRateSeries
+// in the same transaction; on its own two concurrent inserts can both miss each other's row.
+func (q *Queries) InsertExchangeRatesSnapshot(ctx context.Context, arg InsertExchangeRatesSnapshotParams) ([]ExchangeRate, error) {
+	rows, err := q.db.QueryContext(ctx, insertExchangeRatesSnapshot,
+		pq.Array(arg.RateValues),
+		pq.Array(arg.SourceCurrencyIds),
+		pq.Array(arg.DestinationCurrencyIds),
+		pq.Array(arg.ValidFromDates),
+		pq.Array(arg.SourceIds),
+		pq.Array(arg.TypeIds),
+		pq.Array(arg.Statuses),
+		pq.Array(arg.QuarantineReasons),
+		pq.Array(arg.BucketStarts),
+		pq.Array(arg.BucketEnds),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExchangeRate
+	for rows.Next() {
+		var i ExchangeRate
+		if err := rows.Scan(
+			&i.RateID,
+			&i.RateValue,
+			&i.SourceCurrencyID,
+			&i.DestinationCurrencyID,
+			&i.ValidFromDate,
+			&i.ValidToDate,
+			&i.SourceID,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+			&i.TypeID,
+			&i.Status,
+			&i.QuarantineReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExchangeRateAnomalyBaselines = `-- name: ListExchangeRateAnomalyBaselines :many
+SELECT series.source_id, series.type_id, series.source_currency_id, series.destination_currency_id,
+       history.history_count, history.history_mean, history.history_stddev, history.history_median,
+       peers.peer_count, peers.peer_median
+FROM (
+  SELECT DISTINCT snapshot.*
+  FROM unnest(
+    $1::INT[],
+    $2::INT[],
+    $3::INT[],
+    $4::INT[]
+  ) AS snapshot(source_id, type_id, source_currency_id, destination_currency_id)
+) series
+CROSS JOIN LATERAL (
+  SELECT COUNT(*) AS history_count,
+         COALESCE(AVG(recent.rate_value), 0)::NUMERIC AS history_mean,
+         COALESCE(STDDEV_SAMP(recent.rate_value), 0)::NUMERIC AS history_stddev,
+         COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY recent.rate_value), 0)::NUMERIC AS history_median
+  FROM (
+    SELECT rate_value
+    FROM exchange_rates
+    WHERE source_id = series.source_id
+      AND type_id = series.type_id
+      AND source_currency_id = series.source_currency_id
+      AND destination_currency_id = series.destination_currency_id
+      AND status = 'active'
+    ORDER BY updated_at DESC NULLS LAST, rate_id DESC
+    LIMIT $5::INT
+  ) recent
+) history
+CROSS JOIN LATERAL (
+  SELECT COUNT(*) AS peer_count,
+         COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY latest.rate_value), 0)::NUMERIC AS peer_median
+  FROM (
+    SELECT DISTINCT ON (source_id) rate_value
+    FROM exchange_rates
+    WHERE source_id <> series.source_id
+      AND type_id = series.type_id
+      AND source_currency_id = series.source_currency_id
+      AND destination_currency_id = series.destination_currency_id
+      AND status = 'active'
+      AND updated_at >= $6::TIMESTAMPTZ
+    ORDER BY source_id, updated_at DESC NULLS LAST, rate_id DESC
+  ) latest
+) peers
+`
+
+type ListExchangeRateAnomalyBaselinesParams struct {
+	SourceIds              []int32
+	TypeIds                []int32
+	SourceCurrencyIds      []int32
+	DestinationCurrencyIds []int32
+	HistorySize            int32
+	PeerSince              time.Time
+}
+
+type ListExchangeRateAnomalyBaselinesRow struct {
+	SourceID              int32
+	TypeID                int32
+	SourceCurrencyID      int32
+	DestinationCurrencyID int32
+	HistoryCount          int64
+	HistoryMean           string
+	HistoryStddev         string
+	HistoryMedian         string
+	PeerCount             int64
+	PeerMedian            string
+}
+
+// Returns the GetExchangeRateAnomalyBaseline of every distinct (source, pair, type) series in one query.
+func (q *Queries) ListExchangeRateAnomalyBaselines(ctx context.Context, arg ListExchangeRateAnomalyBaselinesParams) ([]ListExchangeRateAnomalyBaselinesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listExchangeRateAnomalyBaselines,
+		pq.Array(arg.SourceIds),
+		pq.Array(arg.TypeIds),
+		pq.Array(arg.SourceCurrencyIds),
+		pq.Array(arg.DestinationCurrencyIds),
+		arg.HistorySize,
+		arg.PeerSince,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListExchangeRateAnomalyBaselinesRow
+	for rows.Next() {
+		var i ListExchangeRateAnomalyBaselinesRow
+		if err := rows.Scan(
+			&i.SourceID,
+			&i.TypeID,
+			&i.SourceCurrencyID,
+			&i.DestinationCurrencyID,
+			&i.HistoryCount,
+			&i.HistoryMean,
+			&i.HistoryStddev,
+			&i.HistoryMedian,
+			&i.PeerCount,
+			&i.PeerMedian,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExchangeRateSpreadSamples = `-- name: ListExchangeRateSpreadSamples :many
+SELECT DISTINCT ON (er.source_id, er.type_id, bucket_start)
+  date_trunc($1::TEXT, er.updated_at, $2::TEXT)::TIMESTAMPTZ AS bucket_start,
+  er.source_id,
+  er.type_id,
+  er.rate_value
+FROM exchange_rates er
+WHERE er.source_currency_id = $3
+  AND er.destination_currency_id = $4
+  AND er.type_id = ANY($5::INT[])
+  AND ($6::INT IS NULL OR er.source_id = $6)
+  AND er.updated_at >= date_trunc($1::TEXT, $7::TIMESTAMPTZ, $2::TEXT)
+  AND er.updated_at < $8::TIMESTAMPTZ
+  AND er.status = 'active'
+ORDER BY er.source_id, er.type_id, bucket_start, er.updated_at DESC, er.rate_id DESC
+`
+
+type ListExchangeRateSpreadSamplesParams struct {
+	BucketUnit            string
+	TimeZone              string
+	SourceCurrencyID      int32
+	DestinationCurrencyID int32
+	TypeIds               []int32
+	SourceID              sql.NullInt32
+	StartTime             time.Time
+	EndTime               time.Time
+}
+
+type ListExchangeRateSpreadSamplesRow struct {
+	BucketStart time.Time
+	SourceID    sql.NullInt32
+	TypeID      sql.NullInt32
+	RateValue   string
+}
+
+// Returns the last rate per source, type and calendar bucket for a currency pair, so buy and
+// sell types can be paired bucket by bucket; start_time is widened to its bucket start.
+func (q *Queries) ListExchangeRateSpreadSamples(ctx context.Context, arg ListExchangeRateSpreadSamplesParams) ([]ListExchangeRateSpreadSamplesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listExchangeRateSpreadSamples,
+		arg.BucketUnit,
+		arg.TimeZone,
+		arg.SourceCurrencyID,
+		arg.DestinationCurrencyID,
+		pq.Array(arg.TypeIds),
+		arg.SourceID,
+		arg.StartTime,
+		arg.EndTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListExchangeRateSpreadSamplesRow
+	for rows.Next() {
+		var i ListExchangeRateSpreadSamplesRow
+		if err := rows.Scan(
+			&i.BucketStart,
+			&i.SourceID,
+			&i.TypeID,
+			&i.RateValue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLatestExchangeRatesAgainstCurrency = `-- name: ListLatestExchangeRatesAgainstCurrency :many
+SELECT DISTINCT ON (source_id, source_currency_id, destination_currency_id) rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, updated_at, created_at, type_id, status, quarantine_reason FROM exchange_rates
+WHERE source_id = ANY($1::INT[])
+  AND type_id = $2
+  AND (source_currency_id = $3 OR destination_currency_id = $3)
+  AND status = 'active'
+ORDER BY source_id, source_currency_id, destination_currency_id, updated_at DESC NULLS LAST, rate_id DESC
+`
+
+type ListLatestExchangeRatesAgainstCurrencyParams struct {
+	SourceIds  []int32
+	TypeID     sql.NullInt32
+	CurrencyID int32
+}
+
+// Returns, for each of the given sources, the most recent rate of every pair that includes
+// currency_id, once per quoted direction. Lets a quote comparison load every source's rates at once.
+func (q *Queries) ListLatestExchangeRatesAgainstCurrency(ctx context.Context, arg ListLatestExchangeRatesAgainstCurrencyParams) ([]ExchangeRate, error) {
+	rows, err := q.db.QueryContext(ctx, listLatestExchangeRatesAgainstCurrency, pq.Array(arg.SourceIds), arg.TypeID, arg.CurrencyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExchangeRate
+	for rows.Next() {
+		var i ExchangeRate
+		if err := rows.Scan(
+			&i.RateID,
+			&i.RateValue,
+			&i.SourceCurrencyID,
+			&i.DestinationCurrencyID,
+			&i.ValidFromDate,
+			&i.ValidToDate,
+			&i.SourceID,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+			&i.TypeID,
+			&i.Status,
+			&i.QuarantineReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLatestExchangeRatesForPair = `-- name: ListLatestExchangeRatesForPair :many
+SELECT DISTINCT ON (source_id, type_id) rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, updated_at, created_at, type_id, status, quarantine_reason
+FROM exchange_rates
+WHERE source_currency_id = $1
+  AND destination_currency_id = $2
+  AND type_id = ANY($3::INT[])
+  AND ($4::INT IS NULL OR source_id = $4)
+  AND status = 'active'
+ORDER BY source_id, type_id, updated_at DESC NULLS LAST, rate_id DESC
+`
+
+type ListLatestExchangeRatesForPairParams struct {
+	SourceCurrencyID      int32
+	DestinationCurrencyID int32
+	TypeIds               []int32
+	SourceID              sql.NullInt32
+}
+
+// Returns each source's most recent rate for a currency pair for each of the given types.
+func (q *Queries) ListLatestExchangeRatesForPair(ctx context.Context, arg ListLatestExchangeRatesForPairParams) ([]ExchangeRate, error) {
+	rows, err := q.db.QueryContext(ctx, listLatestExchangeRatesForPair,
+		arg.SourceCurrencyID,
+		arg.DestinationCurrencyID,
+		pq.Array(arg.TypeIds),
+		arg.SourceID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExchangeRate
+	for rows.Next() {
+		var i ExchangeRate
+		if err := rows.Scan(
+			&i.RateID,
+			&i.RateValue,
+			&i.SourceCurrencyID,
+			&i.DestinationCurrencyID,
+			&i.ValidFromDate,
+			&i.ValidToDate,
+			&i.SourceID,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+			&i.TypeID,
+			&i.Status,
+			&i.QuarantineReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLatestExchangeRatesForSource = `-- name: ListLatestExchangeRatesForSource :many
+SELECT DISTINCT ON (source_currency_id, destination_currency_id) rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, updated_at, created_at, type_id, status, quarantine_reason
+FROM exchange_rates
+WHERE source_id = $1
+  AND type_id = $2
+  AND status = 'active'
+ORDER BY source_currency_id, destination_currency_id, updated_at DESC NULLS LAST, rate_id DESC
+`
+
+type ListLatestExchangeRatesForSourceParams struct {
+	SourceID sql.NullInt32
+	TypeID   sql.NullInt32
+}
+
+// Returns the most recent rate for every currency pair a source publishes for one type.
+func (q *Queries) ListLatestExchangeRatesForSource(ctx context.Context, arg ListLatestExchangeRatesForSourceParams) ([]ExchangeRate, error) {
+	rows, err := q.db.QueryContext(ctx, listLatestExchangeRatesForSource, arg.SourceID, arg.TypeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExchangeRate
+	for rows.Next() {
+		var i ExchangeRate
+		if err := rows.Scan(
+			&i.RateID,
+			&i.RateValue,
+			&i.SourceCurrencyID,
+			&i.DestinationCurrencyID,
+			&i.ValidFromDate,
+			&i.ValidToDate,
+			&i.SourceID,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+			&i.TypeID,
+			&i.Status,
+			&i.QuarantineReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listQuarantinedExchangeRates = `-- name: ListQuarantinedExchangeRates :many
+SELECT rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, updated_at, created_at, type_id, status, quarantine_reason FROM exchange_rates
+WHERE status = 'quarantined'
+ORDER BY created_at DESC, rate_id DESC
+LIMIT $1
+OFFSET $2
+`
+
+type ListQuarantinedExchangeRatesParams struct {
+	Limit  int32
+	Offset int32
+}
+
+// Lists rates held back by anomaly detection, newest first, for admin review.
+func (q *Queries) ListQuarantinedExchangeRates(ctx context.Context, arg ListQuarantinedExchangeRatesParams) ([]ExchangeRate, error) {
+	rows, err := q.db.QueryContext(ctx, listQuarantinedExchangeRates, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExchangeRate
+	for rows.Next() {
+		var i ExchangeRate
+		if err := rows.Scan(
+			&i.RateID,
+			&i.RateValue,
+			&i.SourceCurrencyID,
+			&i.DestinationCurrencyID,
+			&i.ValidFromDate,
+			&i.ValidToDate,
+			&i.SourceID,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+			&i.TypeID,
+			&i.Status,
+			&i.QuarantineReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reviewQuarantinedExchangeRate = `-- name: ReviewQuarantinedExchangeRate :one
+UPDATE exchange_rates
+SET status = $1
+WHERE rate_id = $2
+  AND status = 'quarantined'
+RETURNING rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, updated_at, created_at, type_id, status, quarantine_reason
+`
+
+type ReviewQuarantinedExchangeRateParams struct {
+	Status string
+	RateID int32
+}
+
+// Releases ('active') or discards ('rejected') a quarantined rate. updated_at is left alone
+// so an approved rate keeps its place in the history.
+func (q *Queries) ReviewQuarantinedExchangeRate(ctx context.Context, arg ReviewQuarantinedExchangeRateParams) (ExchangeRate, error) {
+	row := q.db.QueryRowContext(ctx, reviewQuarantinedExchangeRate, arg.Status, arg.RateID)
+	var i ExchangeRate
+	err := row.Scan(
+		&i.RateID,
+		&i.RateValue,
+		&i.SourceCurrencyID,
+		&i.DestinationCurrencyID,
+		&i.ValidFromDate,
+		&i.ValidToDate,
+		&i.SourceID,
+		&i.UpdatedAt,
+		&i.CreatedAt,
+		&i.TypeID,
+		&i.Status,
+		&i.QuarantineReason,
+	)
+	return i, err
+}
+
 const updateExchangeRate = `-- name: UpdateExchangeRate :one
 UPDATE exchange_rates
 SET 
@@ -331,7 +1285,7 @@ SET
     type_id = COALESCE($8, type_id),
     updated_at = CURRENT_TIMESTAMP
 WHERE rate_id = $1
-RETURNING rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, updated_at, created_at, type_id
+RETURNING rate_id, rate_value, source_currency_id, destination_currency_id, valid_from_date, valid_to_date, source_id, updated_at, created_at, type_id, status, quarantine_reason
 `
 
 type UpdateExchangeRateParams struct {
@@ -368,6 +1322,8 @@ func (q *Queries) UpdateExchangeRate(ctx context.Context, arg UpdateExchangeRate
 		&i.UpdatedAt,
 		&i.CreatedAt,
 		&i.TypeID,
+		&i.Status,
+		&i.QuarantineReason,
 	)
 	return i, err
 }
@@ -0,0 +1,25 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeRateRateIn(t *testing.T) {
+	rate := ExchangeRate{RateID: 1, RateValue: "25000", SourceCurrencyID: 1, DestinationCurrencyID: 2}
+
+	value, err := rate.RateIn(1)
+	require.NoError(t, err)
+	require.Equal(t, 25000.0, value)
+
+	value, err = rate.RateIn(2)
+	require.NoError(t, err)
+	require.InDelta(t, 0.00004, value, 1e-12)
+
+	for _, invalid := range []string{"0", "-1", "NaN", "Inf", "abc"} {
+		rate.RateValue = invalid
+		_, err = rate.RateIn(1)
+		require.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,138 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RefreshExchangeRate is one rate in a snapshot. An empty Status stores the rate as active.
+type RefreshExchangeRate struct {
+	RateValue             string
+	SourceCurrencyID      int32
+	DestinationCurrencyID int32
+	ValidFromDate         time.Time
+	SourceID              int32
+	TypeID                int32
+	Status                string
+	QuarantineReason      string
+}
+
+// RefreshExchangeRatesParams defines a snapshot of scraped rates, from one or more sources.
+// A source publishes at most one rate per pair and type inside a Bucket of time.
+type RefreshExchangeRatesParams struct {
+	Bucket time.Duration
+	Rates  []RefreshExchangeRate
+}
+
+// RefreshExchangeRatesResult contains the stored rate of every snapshot rate, in the order
+// of the params and zero when it was skipped, and how many open rates were closed.
+type RefreshExchangeRatesResult struct {
+	Rates  []ExchangeRate
+	Closed int64
+}
+
+// exchangeRateBucketKey identifies the time bucket of a source, pair and type.
+type exchangeRateBucketKey struct {
+	sourceID              int32
+	sourceCurrencyID      int32
+	destinationCurrencyID int32
+	typeID                int32
+	bucketStart           time.Time
+}
+
+// RefreshExchangeRatesTx applies a snapshot in a single transaction and keeps every
+// older rate as history.
+// It locks each (source, pair, type) series of the snapshot once, inserts the whole snapshot
+// with one multi-row statement that skips rates whose bucket already has one, then sets
+// valid_to_date on the open rows the new active rates supersede. When the snapshot itself
+// holds several rates for one bucket, only the first is inserted.
+func (store *SQLStore) RefreshExchangeRatesTx(ctx context.Context, arg RefreshExchangeRatesParams) (RefreshExchangeRatesResult, error) {
+	if arg.Bucket <= 0 {
+		return RefreshExchangeRatesResult{}, fmt.Errorf("bucket must be greater than 0")
+	}
+	if len(arg.Rates) == 0 {
+		return RefreshExchangeRatesResult{}, nil
+	}
+
+	positions := make(map[exchangeRateBucketKey]int, len(arg.Rates))
+	insertArg := InsertExchangeRatesSnapshotParams{}
+	for i, r := range arg.Rates {
+		if r.SourceID <= 0 || r.TypeID <= 0 {
+			return RefreshExchangeRatesResult{}, fmt.Errorf("source_id and type_id must be greater than 0")
+		}
+		// Postgres keeps microseconds; truncating first keeps the stored rate in the same bucket.
+		validFromDate := r.ValidFromDate.UTC().Truncate(time.Microsecond)
+		bucketStart := validFromDate.Truncate(arg.Bucket)
+		key := exchangeRateBucketKey{r.SourceID, r.SourceCurrencyID, r.DestinationCurrencyID, r.TypeID, bucketStart}
+		if _, ok := positions[key]; ok {
+			continue
+		}
+		positions[key] = i
+
+		status := r.Status
+		if status == "" {
+			status = "active"
+		}
+		insertArg.RateValues = append(insertArg.RateValues, r.RateValue)
+		insertArg.SourceCurrencyIds = append(insertArg.SourceCurrencyIds, r.SourceCurrencyID)
+		insertArg.DestinationCurrencyIds = append(insertArg.DestinationCurrencyIds, r.DestinationCurrencyID)
+		insertArg.ValidFromDates = append(insertArg.ValidFromDates, validFromDate)
+		insertArg.SourceIds = append(insertArg.SourceIds, r.SourceID)
+		insertArg.TypeIds = append(insertArg.TypeIds, r.TypeID)
+		insertArg.Statuses = append(insertArg.Statuses, status)
+		insertArg.QuarantineReasons = append(insertArg.QuarantineReasons, r.QuarantineReason)
+		insertArg.BucketStarts = append(insertArg.BucketStarts, bucketStart)
+		insertArg.BucketEnds = append(insertArg.BucketEnds, bucketStart.Add(arg.Bucket))
+	}
+
+	result := RefreshExchangeRatesResult{Rates: make([]ExchangeRate, len(arg.Rates))}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.LockExchangeRateSeries(ctx, LockExchangeRateSeriesParams{
+			SourceIds:              insertArg.SourceIds,
+			TypeIds:                insertArg.TypeIds,
+			SourceCurrencyIds:      insertArg.SourceCurrencyIds,
+			DestinationCurrencyIds: insertArg.DestinationCurrencyIds,
+		})
+		if err != nil {
+			return err
+		}
+
+		rates, err := q.InsertExchangeRatesSnapshot(ctx, insertArg)
+		if err != nil {
+			return err
+		}
+
+		closeArg := CloseSupersededExchangeRatesParams{}
+		for _, rate := range rates {
+			key := exchangeRateBucketKey{
+				rate.SourceID.Int32, rate.SourceCurrencyID, rate.DestinationCurrencyID, rate.TypeID.Int32,
+				rate.ValidFromDate.UTC().Truncate(arg.Bucket),
+			}
+			i, ok := positions[key]
+			if !ok {
+				return fmt.Errorf("inserted exchange rate %d does not match the snapshot", rate.RateID)
+			}
+			result.Rates[i] = rate
+
+			if rate.Status == "active" {
+				closeArg.SourceIds = append(closeArg.SourceIds, key.sourceID)
+				closeArg.SourceCurrencyIds = append(closeArg.SourceCurrencyIds, key.sourceCurrencyID)
+				closeArg.DestinationCurrencyIds = append(closeArg.DestinationCurrencyIds, key.destinationCurrencyID)
+				closeArg.TypeIds = append(closeArg.TypeIds, key.typeID)
+			}
+		}
+		if len(closeArg.SourceIds) == 0 {
+			return nil
+		}
+
+		result.Closed, err = q.CloseSupersededExchangeRates(ctx, closeArg)
+		return err
+	})
+	if err != nil {
+		return RefreshExchangeRatesResult{}, err
+	}
+
+	return result, nil
+}
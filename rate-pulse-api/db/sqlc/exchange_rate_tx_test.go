@@ -0,0 +1,204 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore(t *testing.T) (Store, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	return NewStore(sqlDB), mock
+}
+
+func exchangeRateRows(rates ...ExchangeRate) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{
+		"rate_id", "rate_value", "source_currency_id", "destination_currency_id", "valid_from_date", "valid_to_date",
+		"source_id", "updated_at", "created_at", "type_id", "status", "quarantine_reason",
+	})
+	for _, rate := range rates {
+		rows.AddRow(
+			rate.RateID, rate.RateValue, rate.SourceCurrencyID, rate.DestinationCurrencyID, rate.ValidFromDate, rate.ValidToDate,
+			rate.SourceID, rate.UpdatedAt, rate.CreatedAt, rate.TypeID, rate.Status, rate.QuarantineReason,
+		)
+	}
+	return rows
+}
+
+func storedExchangeRate(rateID int32, r RefreshExchangeRate) ExchangeRate {
+	status := r.Status
+	if status == "" {
+		status = "active"
+	}
+	return ExchangeRate{
+		RateID:                rateID,
+		RateValue:             r.RateValue,
+		SourceCurrencyID:      r.SourceCurrencyID,
+		DestinationCurrencyID: r.DestinationCurrencyID,
+		ValidFromDate:         r.ValidFromDate,
+		SourceID:              sql.NullInt32{Int32: r.SourceID, Valid: true},
+		TypeID:                sql.NullInt32{Int32: r.TypeID, Valid: true},
+		Status:                status,
+		QuarantineReason:      sql.NullString{String: r.QuarantineReason, Valid: r.QuarantineReason != ""},
+	}
+}
+
+var (
+	snapshotTime   = time.Date(2026, 5, 12, 9, 30, 0, 0, time.UTC)
+	snapshotBucket = time.Date(2026, 5, 12, 8, 0, 0, 0, time.UTC)
+)
+
+func TestRefreshExchangeRatesTxInsertsSnapshotAndClosesSupersededRates(t *testing.T) {
+	store, mock := newTestStore(t)
+	usd := RefreshExchangeRate{RateValue: "25000", SourceCurrencyID: 1, DestinationCurrencyID: 2, ValidFromDate: snapshotTime, SourceID: 10, TypeID: 4}
+	eur := RefreshExchangeRate{RateValue: "27000", SourceCurrencyID: 1, DestinationCurrencyID: 3, ValidFromDate: snapshotTime, SourceID: 10, TypeID: 4}
+	otherSource := RefreshExchangeRate{RateValue: "25100", SourceCurrencyID: 1, DestinationCurrencyID: 2, ValidFromDate: snapshotTime, SourceID: 11, TypeID: 4}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT pg_advisory_xact_lock").
+		WithArgs(pq.Array([]int32{10, 10, 11}), pq.Array([]int32{4, 4, 4}), pq.Array([]int32{1, 1, 1}), pq.Array([]int32{2, 3, 2})).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	// The whole snapshot goes in with one statement.
+	mock.ExpectQuery("INSERT INTO exchange_rates .+ FROM unnest").
+		WithArgs(
+			pq.Array([]string{"25000", "27000", "25100"}),
+			pq.Array([]int32{1, 1, 1}),
+			pq.Array([]int32{2, 3, 2}),
+			pq.Array([]time.Time{snapshotTime, snapshotTime, snapshotTime}),
+			pq.Array([]int32{10, 10, 11}),
+			pq.Array([]int32{4, 4, 4}),
+			pq.Array([]string{"active", "active", "active"}),
+			pq.Array([]string{"", "", ""}),
+			pq.Array([]time.Time{snapshotBucket, snapshotBucket, snapshotBucket}),
+			pq.Array([]time.Time{snapshotBucket.Add(2 * time.Hour), snapshotBucket.Add(2 * time.Hour), snapshotBucket.Add(2 * time.Hour)}),
+		).
+		// RETURNING order is not guaranteed; rates are matched back to the snapshot by bucket.
+		WillReturnRows(exchangeRateRows(storedExchangeRate(102, otherSource), storedExchangeRate(100, usd), storedExchangeRate(101, eur)))
+	// The open rows are closed at the new rate's valid_from_date instead of being deleted.
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE exchange_rates er\nSET valid_to_date = superseded.next_valid_from_date")).
+		WithArgs(pq.Array([]int32{11, 10, 10}), pq.Array([]int32{1, 1, 1}), pq.Array([]int32{2, 2, 3}), pq.Array([]int32{4, 4, 4})).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	result, err := store.RefreshExchangeRatesTx(context.Background(), RefreshExchangeRatesParams{
+		Bucket: 2 * time.Hour,
+		Rates:  []RefreshExchangeRate{usd, eur, otherSource},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, int64(3), result.Closed)
+	require.Len(t, result.Rates, 3)
+	require.Equal(t, int32(100), result.Rates[0].RateID)
+	require.Equal(t, int32(101), result.Rates[1].RateID)
+	require.Equal(t, int32(102), result.Rates[2].RateID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshExchangeRatesTxKeepsHistory(t *testing.T) {
+	// Neither statement that touches existing rows may remove them.
+	require.NotContains(t, closeSupersededExchangeRates, "DELETE")
+	require.NotContains(t, insertExchangeRatesSnapshot, "DELETE")
+	require.NotContains(t, insertExchangeRatesSnapshot, "ON CONFLICT")
+	// Only open rows are closed, and only by a later active rate of the same series.
+	require.Contains(t, closeSupersededExchangeRates, "WHERE cur.valid_to_date IS NULL")
+	require.Contains(t, closeSupersededExchangeRates, "later.valid_from_date > cur.valid_from_date")
+	require.Contains(t, closeSupersededExchangeRates, "later.status = 'active'")
+}
+
+func TestRefreshExchangeRatesTxSkipsTakenBuckets(t *testing.T) {
+	store, mock := newTestStore(t)
+	rate := RefreshExchangeRate{RateValue: "25000", SourceCurrencyID: 1, DestinationCurrencyID: 2, ValidFromDate: snapshotTime, SourceID: 10, TypeID: 4}
+	sameBucket := rate
+	sameBucket.RateValue = "25010"
+	sameBucket.ValidFromDate = snapshotTime.Add(20 * time.Minute)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT pg_advisory_xact_lock").WillReturnResult(sqlmock.NewResult(0, 0))
+	// The second rate shares the first one's bucket and is not sent.
+	mock.ExpectQuery("INSERT INTO exchange_rates").
+		WithArgs(
+			pq.Array([]string{"25000"}), pq.Array([]int32{1}), pq.Array([]int32{2}), pq.Array([]time.Time{snapshotTime}),
+			pq.Array([]int32{10}), pq.Array([]int32{4}), pq.Array([]string{"active"}), pq.Array([]string{""}),
+			pq.Array([]time.Time{snapshotBucket}), pq.Array([]time.Time{snapshotBucket.Add(2 * time.Hour)}),
+		).
+		// The bucket already had a rate, so nothing is inserted and nothing is closed.
+		WillReturnRows(exchangeRateRows())
+	mock.ExpectCommit()
+
+	result, err := store.RefreshExchangeRatesTx(context.Background(), RefreshExchangeRatesParams{
+		Bucket: 2 * time.Hour,
+		Rates:  []RefreshExchangeRate{rate, sameBucket},
+	})
+
+	require.NoError(t, err)
+	require.Zero(t, result.Closed)
+	require.Equal(t, []ExchangeRate{{}, {}}, result.Rates)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshExchangeRatesTxQuarantinedRateDoesNotSupersede(t *testing.T) {
+	store, mock := newTestStore(t)
+	rate := RefreshExchangeRate{
+		RateValue: "90000", SourceCurrencyID: 1, DestinationCurrencyID: 2, ValidFromDate: snapshotTime, SourceID: 10, TypeID: 4,
+		Status: "quarantined", QuarantineReason: "deviates",
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT pg_advisory_xact_lock").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("INSERT INTO exchange_rates").
+		WithArgs(
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			pq.Array([]string{"quarantined"}), pq.Array([]string{"deviates"}), sqlmock.AnyArg(), sqlmock.AnyArg(),
+		).
+		WillReturnRows(exchangeRateRows(storedExchangeRate(100, rate)))
+	mock.ExpectCommit()
+
+	result, err := store.RefreshExchangeRatesTx(context.Background(), RefreshExchangeRatesParams{
+		Bucket: 2 * time.Hour,
+		Rates:  []RefreshExchangeRate{rate},
+	})
+
+	require.NoError(t, err)
+	require.Zero(t, result.Closed)
+	require.Equal(t, "quarantined", result.Rates[0].Status)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshExchangeRatesTxRollsBackOnError(t *testing.T) {
+	store, mock := newTestStore(t)
+	rate := RefreshExchangeRate{RateValue: "25000", SourceCurrencyID: 1, DestinationCurrencyID: 2, ValidFromDate: snapshotTime, SourceID: 10, TypeID: 4}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT pg_advisory_xact_lock").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("INSERT INTO exchange_rates").WillReturnRows(exchangeRateRows(storedExchangeRate(100, rate)))
+	mock.ExpectExec("UPDATE exchange_rates er").WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	result, err := store.RefreshExchangeRatesTx(context.Background(), RefreshExchangeRatesParams{
+		Bucket: 2 * time.Hour,
+		Rates:  []RefreshExchangeRate{rate},
+	})
+
+	require.ErrorIs(t, err, sql.ErrConnDone)
+	require.Empty(t, result)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshExchangeRatesTxEmptySnapshot(t *testing.T) {
+	store, mock := newTestStore(t)
+
+	result, err := store.RefreshExchangeRatesTx(context.Background(), RefreshExchangeRatesParams{Bucket: 2 * time.Hour})
+
+	require.NoError(t, err)
+	require.Empty(t, result)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,316 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: mfa.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const confirmUserTOTP = `-- name: ConfirmUserTOTP :one
+UPDATE user_totp
+SET
+    confirmed_at = now(),
+    last_used_step = $1,
+    updated_at = now()
+WHERE
+    user_id = $2
+    AND confirmed_at IS NULL
+RETURNING user_id, secret_ciphertext, confirmed_at, last_used_step, created_at, updated_at
+`
+
+type ConfirmUserTOTPParams struct {
+	LastUsedStep int64
+	UserID       int32
+}
+
+func (q *Queries) ConfirmUserTOTP(ctx context.Context, arg ConfirmUserTOTPParams) (UserTotp, error) {
+	row := q.db.QueryRowContext(ctx, confirmUserTOTP, arg.LastUsedStep, arg.UserID)
+	var i UserTotp
+	err := row.Scan(
+		&i.UserID,
+		&i.SecretCiphertext,
+		&i.ConfirmedAt,
+		&i.LastUsedStep,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const consumeMFAChallenge = `-- name: ConsumeMFAChallenge :execrows
+UPDATE mfa_challenges
+SET consumed_at = now()
+WHERE
+    token_hash = $1
+    AND attempts <= $2::INT
+    AND consumed_at IS NULL
+    AND expires_at > now()
+`
+
+type ConsumeMFAChallengeParams struct {
+	TokenHash   string
+	MaxAttempts int32
+}
+
+func (q *Queries) ConsumeMFAChallenge(ctx context.Context, arg ConsumeMFAChallengeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, consumeMFAChallenge, arg.TokenHash, arg.MaxAttempts)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const countUnusedRecoveryCodes = `-- name: CountUnusedRecoveryCodes :one
+SELECT COUNT(*) FROM mfa_recovery_codes
+WHERE
+    user_id = $1
+    AND used_at IS NULL
+`
+
+func (q *Queries) CountUnusedRecoveryCodes(ctx context.Context, userID int32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUnusedRecoveryCodes, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createMFAChallenge = `-- name: CreateMFAChallenge :one
+INSERT INTO mfa_challenges (
+    token_hash,
+    user_id,
+    expires_at,
+    enrollment_required
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING token_hash, user_id, attempts, consumed_at, created_at, expires_at, enrollment_required
+`
+
+type CreateMFAChallengeParams struct {
+	TokenHash          string
+	UserID             int32
+	ExpiresAt          time.Time
+	EnrollmentRequired bool
+}
+
+func (q *Queries) CreateMFAChallenge(ctx context.Context, arg CreateMFAChallengeParams) (MfaChallenge, error) {
+	row := q.db.QueryRowContext(ctx, createMFAChallenge,
+		arg.TokenHash,
+		arg.UserID,
+		arg.ExpiresAt,
+		arg.EnrollmentRequired,
+	)
+	var i MfaChallenge
+	err := row.Scan(
+		&i.TokenHash,
+		&i.UserID,
+		&i.Attempts,
+		&i.ConsumedAt,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.EnrollmentRequired,
+	)
+	return i, err
+}
+
+const createRecoveryCode = `-- name: CreateRecoveryCode :exec
+INSERT INTO mfa_recovery_codes (
+    user_id,
+    code_hash
+) VALUES (
+    $1, $2
+)
+`
+
+type CreateRecoveryCodeParams struct {
+	UserID   int32
+	CodeHash string
+}
+
+func (q *Queries) CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) error {
+	_, err := q.db.ExecContext(ctx, createRecoveryCode, arg.UserID, arg.CodeHash)
+	return err
+}
+
+const deleteRecoveryCodes = `-- name: DeleteRecoveryCodes :exec
+DELETE FROM mfa_recovery_codes
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteRecoveryCodes(ctx context.Context, userID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteRecoveryCodes, userID)
+	return err
+}
+
+const deleteUserTOTP = `-- name: DeleteUserTOTP :execrows
+DELETE FROM user_totp
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserTOTP(ctx context.Context, userID int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteUserTOTP, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getMFAChallenge = `-- name: GetMFAChallenge :one
+SELECT token_hash, user_id, attempts, consumed_at, created_at, expires_at, enrollment_required FROM mfa_challenges
+WHERE token_hash = $1
+LIMIT 1
+`
+
+func (q *Queries) GetMFAChallenge(ctx context.Context, tokenHash string) (MfaChallenge, error) {
+	row := q.db.QueryRowContext(ctx, getMFAChallenge, tokenHash)
+	var i MfaChallenge
+	err := row.Scan(
+		&i.TokenHash,
+		&i.UserID,
+		&i.Attempts,
+		&i.ConsumedAt,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.EnrollmentRequired,
+	)
+	return i, err
+}
+
+const getUserTOTP = `-- name: GetUserTOTP :one
+SELECT user_id, secret_ciphertext, confirmed_at, last_used_step, created_at, updated_at FROM user_totp
+WHERE user_id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetUserTOTP(ctx context.Context, userID int32) (UserTotp, error) {
+	row := q.db.QueryRowContext(ctx, getUserTOTP, userID)
+	var i UserTotp
+	err := row.Scan(
+		&i.UserID,
+		&i.SecretCiphertext,
+		&i.ConfirmedAt,
+		&i.LastUsedStep,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const reserveMFAChallengeAttempt = `-- name: ReserveMFAChallengeAttempt :one
+UPDATE mfa_challenges
+SET attempts = attempts + 1
+WHERE
+    token_hash = $1
+    AND attempts < $2::INT
+    AND consumed_at IS NULL
+    AND expires_at > now()
+RETURNING token_hash, user_id, attempts, consumed_at, created_at, expires_at, enrollment_required
+`
+
+type ReserveMFAChallengeAttemptParams struct {
+	TokenHash   string
+	MaxAttempts int32
+}
+
+// Takes one of the attempts of an open challenge before its code is checked, so parallel
+// requests on the same token cannot try more than max_attempts codes.
+func (q *Queries) ReserveMFAChallengeAttempt(ctx context.Context, arg ReserveMFAChallengeAttemptParams) (MfaChallenge, error) {
+	row := q.db.QueryRowContext(ctx, reserveMFAChallengeAttempt, arg.TokenHash, arg.MaxAttempts)
+	var i MfaChallenge
+	err := row.Scan(
+		&i.TokenHash,
+		&i.UserID,
+		&i.Attempts,
+		&i.ConsumedAt,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.EnrollmentRequired,
+	)
+	return i, err
+}
+
+const updateUserTOTPStep = `-- name: UpdateUserTOTPStep :execrows
+UPDATE user_totp
+SET
+    last_used_step = $1,
+    updated_at = now()
+WHERE
+    user_id = $2
+    AND last_used_step < $1
+`
+
+type UpdateUserTOTPStepParams struct {
+	LastUsedStep int64
+	UserID       int32
+}
+
+// Records the step of an accepted code; a step at or before the last one is a replay.
+func (q *Queries) UpdateUserTOTPStep(ctx context.Context, arg UpdateUserTOTPStepParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateUserTOTPStep, arg.LastUsedStep, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const upsertUserTOTP = `-- name: UpsertUserTOTP :one
+INSERT INTO user_totp (
+    user_id,
+    secret_ciphertext
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (user_id) DO UPDATE
+SET
+    secret_ciphertext = EXCLUDED.secret_ciphertext,
+    last_used_step = 0,
+    updated_at = now()
+WHERE user_totp.confirmed_at IS NULL
+RETURNING user_id, secret_ciphertext, confirmed_at, last_used_step, created_at, updated_at
+`
+
+type UpsertUserTOTPParams struct {
+	UserID           int32
+	SecretCiphertext string
+}
+
+// Starts or restarts an enrolment; a confirmed secret is only replaced by disabling it first.
+func (q *Queries) UpsertUserTOTP(ctx context.Context, arg UpsertUserTOTPParams) (UserTotp, error) {
+	row := q.db.QueryRowContext(ctx, upsertUserTOTP, arg.UserID, arg.SecretCiphertext)
+	var i UserTotp
+	err := row.Scan(
+		&i.UserID,
+		&i.SecretCiphertext,
+		&i.ConfirmedAt,
+		&i.LastUsedStep,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :execrows
+UPDATE mfa_recovery_codes
+SET used_at = now()
+WHERE
+    user_id = $1
+    AND code_hash = $2
+    AND used_at IS NULL
+`
+
+type UseRecoveryCodeParams struct {
+	UserID   int32
+	CodeHash string
+}
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, useRecoveryCode, arg.UserID, arg.CodeHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
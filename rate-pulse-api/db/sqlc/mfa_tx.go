@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+// ConfirmTOTPTxParams defines the pending TOTP enrolment being confirmed, the step of
+// the code that confirmed it and the hashes of the user's new recovery codes.
+type ConfirmTOTPTxParams struct {
+	UserID             int32
+	LastUsedStep       int64
+	RecoveryCodeHashes []string
+}
+
+// ConfirmTOTPTx confirms a pending TOTP enrolment and replaces the user's recovery
+// codes in one transaction, so an enabled authenticator always comes with codes.
+func (store *SQLStore) ConfirmTOTPTx(ctx context.Context, arg ConfirmTOTPTxParams) (UserTotp, error) {
+	var result UserTotp
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		userTOTP, err := q.ConfirmUserTOTP(ctx, ConfirmUserTOTPParams{
+			UserID:       arg.UserID,
+			LastUsedStep: arg.LastUsedStep,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := replaceRecoveryCodes(ctx, q, arg.UserID, arg.RecoveryCodeHashes); err != nil {
+			return err
+		}
+
+		result = userTOTP
+		return nil
+	})
+	if err != nil {
+		return UserTotp{}, err
+	}
+
+	return result, nil
+}
+
+// ReplaceRecoveryCodesTxParams defines the user and the hashes of their new recovery codes.
+type ReplaceRecoveryCodesTxParams struct {
+	UserID     int32
+	CodeHashes []string
+}
+
+// ReplaceRecoveryCodesTx deletes every recovery code of the user, used or not, and
+// stores the new ones in one transaction.
+func (store *SQLStore) ReplaceRecoveryCodesTx(ctx context.Context, arg ReplaceRecoveryCodesTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		return replaceRecoveryCodes(ctx, q, arg.UserID, arg.CodeHashes)
+	})
+}
+
+// DisableTOTPTx deletes the user's TOTP secret and recovery codes in one transaction.
+// It returns sql.ErrNoRows when the user has no TOTP secret.
+func (store *SQLStore) DisableTOTPTx(ctx context.Context, userID int32) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		deleted, err := q.DeleteUserTOTP(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return sql.ErrNoRows
+		}
+
+		return q.DeleteRecoveryCodes(ctx, userID)
+	})
+}
+
+func replaceRecoveryCodes(ctx context.Context, q *Queries, userID int32, codeHashes []string) error {
+	if err := q.DeleteRecoveryCodes(ctx, userID); err != nil {
+		return err
+	}
+
+	for _, codeHash := range codeHashes {
+		err := q.CreateRecoveryCode(ctx, CreateRecoveryCodeParams{
+			UserID:   userID,
+			CodeHash: codeHash,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
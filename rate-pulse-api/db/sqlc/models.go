@@ -11,6 +11,19 @@ import (
 	"github.com/google/uuid"
 )
 
+type ApiKey struct {
+	ApiKeyID   int32
+	UserID     int32
+	Name       string
+	KeyPrefix  string
+	KeyHash    string
+	Scopes     []string
+	ExpiresAt  sql.NullTime
+	LastUsedAt sql.NullTime
+	RevokedAt  sql.NullTime
+	CreatedAt  time.Time
+}
+
 type Country struct {
 	CountryID   int32
 	CountryName string
@@ -40,6 +53,8 @@ type ExchangeRate struct {
 	UpdatedAt             sql.NullTime
 	CreatedAt             sql.NullTime
 	TypeID                sql.NullInt32
+	Status                string
+	QuarantineReason      sql.NullString
 }
 
 type ExchangeRateType struct {
@@ -47,6 +62,34 @@ type ExchangeRateType struct {
 	TypeName string
 }
 
+type MfaChallenge struct {
+	TokenHash          string
+	UserID             int32
+	Attempts           int32
+	ConsumedAt         sql.NullTime
+	CreatedAt          time.Time
+	ExpiresAt          time.Time
+	EnrollmentRequired bool
+}
+
+type MfaRecoveryCode struct {
+	ID        int64
+	UserID    int32
+	CodeHash  string
+	UsedAt    sql.NullTime
+	CreatedAt time.Time
+}
+
+type PasswordReset struct {
+	ID             int64
+	UserID         int32
+	Email          string
+	SecretCodeHash string
+	IsUsed         bool
+	CreatedAt      time.Time
+	ExpiredAt      time.Time
+}
+
 type Payment struct {
 	PaymentID      int32
 	SubscriptionID int32
@@ -60,16 +103,37 @@ type Payment struct {
 	UpdatedAt      sql.NullTime
 }
 
+type RateAlert struct {
+	AlertID         int32
+	UserID          int32
+	SourceID        int32
+	TypeID          int32
+	FromCurrencyID  int32
+	ToCurrencyID    int32
+	Condition       string
+	Threshold       sql.NullString
+	ChangePercent   sql.NullString
+	WindowHours     int32
+	IsActive        bool
+	LastRateID      sql.NullInt32
+	LastRateValue   sql.NullString
+	LastTriggeredAt sql.NullTime
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
 type RateSource struct {
-	SourceID      int32
-	SourceName    string
-	SourceLink    sql.NullString
-	SourceCountry sql.NullString
-	SourceStatus  sql.NullString
-	UpdatedAt     sql.NullTime
-	CreatedAt     sql.NullTime
-	SourceCode    sql.NullString
-	CurrencyID    sql.NullInt32
+	SourceID                int32
+	SourceName              string
+	SourceLink              sql.NullString
+	SourceCountry           sql.NullString
+	SourceStatus            sql.NullString
+	UpdatedAt               sql.NullTime
+	CreatedAt               sql.NullTime
+	SourceCode              sql.NullString
+	CurrencyID              sql.NullInt32
+	ExpectedIntervalMinutes int32
+	StaleNotifiedAt         sql.NullTime
 }
 
 type RateSourceFeeRule struct {
@@ -99,6 +163,14 @@ type RateSourceFeeRule struct {
 	SwiftFeeIncluded   bool
 }
 
+type RefreshToken struct {
+	TokenID   uuid.UUID
+	SessionID uuid.UUID
+	ExpiresAt time.Time
+	RotatedAt sql.NullTime
+	CreatedAt time.Time
+}
+
 type Session struct {
 	SessionID    uuid.UUID
 	UserID       int32
@@ -109,6 +181,7 @@ type Session struct {
 	ExpiresAt    time.Time
 	CreatedAt    sql.NullTime
 	UpdatedAt    sql.NullTime
+	LastUsedAt   time.Time
 }
 
 type SubscriptionPlan struct {
@@ -150,6 +223,13 @@ type UserCurrencyPreference struct {
 	CreatedAt    sql.NullTime
 }
 
+type UserDailyUsage struct {
+	UserID       int32
+	UsageDate    time.Time
+	RequestCount int32
+	UpdatedAt    time.Time
+}
+
 type UserRateSourcePreference struct {
 	SourceID  int32
 	UserID    int32
@@ -170,6 +250,15 @@ type UserSubscription struct {
 	UpdatedAt      sql.NullTime
 }
 
+type UserTotp struct {
+	UserID           int32
+	SecretCiphertext string
+	ConfirmedAt      sql.NullTime
+	LastUsedStep     int64
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
 type VerifyEmail struct {
 	ID             int64
 	UserID         int32
@@ -0,0 +1,186 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: password_reset.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createPasswordReset = `-- name: CreatePasswordReset :one
+INSERT INTO password_resets (
+    user_id,
+    email,
+    secret_code_hash
+) VALUES (
+    $1, $2, $3
+)
+RETURNING
+    id,
+    user_id,
+    email,
+    secret_code_hash,
+    is_used,
+    created_at,
+    expired_at
+`
+
+type CreatePasswordResetParams struct {
+	UserID         int32
+	Email          string
+	SecretCodeHash string
+}
+
+func (q *Queries) CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) (PasswordReset, error) {
+	row := q.db.QueryRowContext(ctx, createPasswordReset, arg.UserID, arg.Email, arg.SecretCodeHash)
+	var i PasswordReset
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Email,
+		&i.SecretCodeHash,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const expireUserPasswordResets = `-- name: ExpireUserPasswordResets :exec
+UPDATE password_resets
+SET
+    is_used = TRUE
+WHERE
+    user_id = $1
+    AND is_used = FALSE
+`
+
+func (q *Queries) ExpireUserPasswordResets(ctx context.Context, userID int32) error {
+	_, err := q.db.ExecContext(ctx, expireUserPasswordResets, userID)
+	return err
+}
+
+const getPasswordReset = `-- name: GetPasswordReset :one
+SELECT
+    id,
+    user_id,
+    email,
+    secret_code_hash,
+    is_used,
+    created_at,
+    expired_at
+FROM password_resets
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetPasswordReset(ctx context.Context, id int64) (PasswordReset, error) {
+	row := q.db.QueryRowContext(ctx, getPasswordReset, id)
+	var i PasswordReset
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Email,
+		&i.SecretCodeHash,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const updatePasswordReset = `-- name: UpdatePasswordReset :one
+UPDATE password_resets
+SET
+    is_used = TRUE
+WHERE
+    id = $1
+    AND user_id = $2
+    AND secret_code_hash = $3
+    AND is_used = FALSE
+    AND expired_at > now()
+RETURNING
+    id,
+    user_id,
+    email,
+    secret_code_hash,
+    is_used,
+    created_at,
+    expired_at
+`
+
+type UpdatePasswordResetParams struct {
+	ID             int64
+	UserID         int32
+	SecretCodeHash string
+}
+
+func (q *Queries) UpdatePasswordReset(ctx context.Context, arg UpdatePasswordResetParams) (PasswordReset, error) {
+	row := q.db.QueryRowContext(ctx, updatePasswordReset, arg.ID, arg.UserID, arg.SecretCodeHash)
+	var i PasswordReset
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Email,
+		&i.SecretCodeHash,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :one
+UPDATE users
+SET
+    password = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    user_id = $1
+RETURNING
+    user_id,
+    username,
+    email,
+    password,
+    user_type,
+    email_verified,
+    time_zone,
+    language_preference,
+    country_of_residence,
+    country_of_birth,
+    is_active,
+    created_at,
+    updated_at,
+    first_name,
+    last_name
+`
+
+type UpdateUserPasswordParams struct {
+	UserID   int32
+	Password string
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, updateUserPassword, arg.UserID, arg.Password)
+	var i User
+	err := row.Scan(
+		&i.UserID,
+		&i.Username,
+		&i.Email,
+		&i.Password,
+		&i.UserType,
+		&i.EmailVerified,
+		&i.TimeZone,
+		&i.LanguagePreference,
+		&i.CountryOfResidence,
+		&i.CountryOfBirth,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FirstName,
+		&i.LastName,
+	)
+	return i, err
+}
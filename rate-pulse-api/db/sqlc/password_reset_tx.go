@@ -0,0 +1,61 @@
+package db
+
+import "context"
+
+// ResetPasswordTxParams defines the password reset being consumed and the new
+// password hash of its user.
+type ResetPasswordTxParams struct {
+	ResetID        int64
+	UserID         int32
+	SecretCodeHash string
+	HashedPassword string
+}
+
+// ResetPasswordTxResult contains the consumed password reset and updated user.
+type ResetPasswordTxResult struct {
+	PasswordReset PasswordReset
+	User          User
+}
+
+// ResetPasswordTx marks a valid, unused password reset as used, sets the new
+// password, expires the user's other outstanding resets and blocks all of their
+// sessions in one transaction.
+func (store *SQLStore) ResetPasswordTx(ctx context.Context, arg ResetPasswordTxParams) (ResetPasswordTxResult, error) {
+	var result ResetPasswordTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		passwordReset, err := q.UpdatePasswordReset(ctx, UpdatePasswordResetParams{
+			ID:             arg.ResetID,
+			UserID:         arg.UserID,
+			SecretCodeHash: arg.SecretCodeHash,
+		})
+		if err != nil {
+			return err
+		}
+
+		user, err := q.UpdateUserPassword(ctx, UpdateUserPasswordParams{
+			UserID:   passwordReset.UserID,
+			Password: arg.HashedPassword,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := q.ExpireUserPasswordResets(ctx, user.UserID); err != nil {
+			return err
+		}
+
+		if _, err := q.BlockUserSessions(ctx, user.UserID); err != nil {
+			return err
+		}
+
+		result.PasswordReset = passwordReset
+		result.User = user
+		return nil
+	})
+	if err != nil {
+		return ResetPasswordTxResult{}, err
+	}
+
+	return result, nil
+}
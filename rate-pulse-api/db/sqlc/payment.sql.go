@@ -70,45 +70,6 @@ func (q *Queries) DeletePayment(ctx context.Context, paymentID int32) error {
 	return err
 }
 
-const getAllPayments = `-- name: GetAllPayments :many
-SELECT payment_id, subscription_id, transaction_id, amount, currency_code, payment_method, payment_status, payment_date, created_at, updated_at FROM payments
-ORDER BY payment_date DESC
-`
-
-func (q *Queries) GetAllPayments(ctx context.Context) ([]Payment, error) {
-	rows, err := q.db.QueryContext(ctx, getAllPayments)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Payment
-	for rows.Next() {
-		var i Payment
-		if err := rows.Scan(
-			&i.PaymentID,
-			&i.SubscriptionID,
-			&i.TransactionID,
-			&i.Amount,
-			&i.CurrencyCode,
-			&i.PaymentMethod,
-			&i.PaymentStatus,
-			&i.PaymentDate,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const getPaymentByID = `-- name: GetPaymentByID :one
 SELECT payment_id, subscription_id, transaction_id, amount, currency_code, payment_method, payment_status, payment_date, created_at, updated_at FROM payments
 WHERE payment_id = $1 LIMIT 1
@@ -236,6 +197,262 @@ func (q *Queries) GetPaymentsByUserID(ctx context.Context, userID int32) ([]Paym
 	return items, nil
 }
 
+const listPaymentsByDate = `-- name: ListPaymentsByDate :many
+SELECT payment_id, subscription_id, transaction_id, amount, currency_code, payment_method, payment_status, payment_date, created_at, updated_at FROM payments
+WHERE ($1::varchar IS NULL OR payment_status = $1)
+  AND ($2::integer IS NULL OR subscription_id = $2)
+  AND ($3::timestamptz IS NULL OR payment_date >= $3)
+  AND ($4::timestamptz IS NULL OR payment_date < $4)
+  AND (
+    $5::integer IS NULL
+    OR (COALESCE(payment_date, 'epoch'), payment_id) > ($6::timestamptz, $5)
+  )
+ORDER BY COALESCE(payment_date, 'epoch'), payment_id
+LIMIT $7
+`
+
+type ListPaymentsByDateParams struct {
+	PaymentStatus  sql.NullString
+	SubscriptionID sql.NullInt32
+	DateFrom       sql.NullTime
+	DateTo         sql.NullTime
+	AfterID        sql.NullInt32
+	AfterTime      sql.NullTime
+	PageLimit      int32
+}
+
+// Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+// both the WHERE and the ORDER BY. after_id/after_time are the last row of the previous page.
+func (q *Queries) ListPaymentsByDate(ctx context.Context, arg ListPaymentsByDateParams) ([]Payment, error) {
+	rows, err := q.db.QueryContext(ctx, listPaymentsByDate,
+		arg.PaymentStatus,
+		arg.SubscriptionID,
+		arg.DateFrom,
+		arg.DateTo,
+		arg.AfterID,
+		arg.AfterTime,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Payment
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.PaymentID,
+			&i.SubscriptionID,
+			&i.TransactionID,
+			&i.Amount,
+			&i.CurrencyCode,
+			&i.PaymentMethod,
+			&i.PaymentStatus,
+			&i.PaymentDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPaymentsByDateDesc = `-- name: ListPaymentsByDateDesc :many
+SELECT payment_id, subscription_id, transaction_id, amount, currency_code, payment_method, payment_status, payment_date, created_at, updated_at FROM payments
+WHERE ($1::varchar IS NULL OR payment_status = $1)
+  AND ($2::integer IS NULL OR subscription_id = $2)
+  AND ($3::timestamptz IS NULL OR payment_date >= $3)
+  AND ($4::timestamptz IS NULL OR payment_date < $4)
+  AND (
+    $5::integer IS NULL
+    OR (COALESCE(payment_date, 'epoch'), payment_id) < ($6::timestamptz, $5)
+  )
+ORDER BY COALESCE(payment_date, 'epoch') DESC, payment_id DESC
+LIMIT $7
+`
+
+type ListPaymentsByDateDescParams struct {
+	PaymentStatus  sql.NullString
+	SubscriptionID sql.NullInt32
+	DateFrom       sql.NullTime
+	DateTo         sql.NullTime
+	AfterID        sql.NullInt32
+	AfterTime      sql.NullTime
+	PageLimit      int32
+}
+
+func (q *Queries) ListPaymentsByDateDesc(ctx context.Context, arg ListPaymentsByDateDescParams) ([]Payment, error) {
+	rows, err := q.db.QueryContext(ctx, listPaymentsByDateDesc,
+		arg.PaymentStatus,
+		arg.SubscriptionID,
+		arg.DateFrom,
+		arg.DateTo,
+		arg.AfterID,
+		arg.AfterTime,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Payment
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.PaymentID,
+			&i.SubscriptionID,
+			&i.TransactionID,
+			&i.Amount,
+			&i.CurrencyCode,
+			&i.PaymentMethod,
+			&i.PaymentStatus,
+			&i.PaymentDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPaymentsByID = `-- name: ListPaymentsByID :many
+SELECT payment_id, subscription_id, transaction_id, amount, currency_code, payment_method, payment_status, payment_date, created_at, updated_at FROM payments
+WHERE ($1::varchar IS NULL OR payment_status = $1)
+  AND ($2::integer IS NULL OR subscription_id = $2)
+  AND ($3::timestamptz IS NULL OR payment_date >= $3)
+  AND ($4::timestamptz IS NULL OR payment_date < $4)
+  AND ($5::integer IS NULL OR payment_id > $5)
+ORDER BY payment_id
+LIMIT $6
+`
+
+type ListPaymentsByIDParams struct {
+	PaymentStatus  sql.NullString
+	SubscriptionID sql.NullInt32
+	DateFrom       sql.NullTime
+	DateTo         sql.NullTime
+	AfterID        sql.NullInt32
+	PageLimit      int32
+}
+
+func (q *Queries) ListPaymentsByID(ctx context.Context, arg ListPaymentsByIDParams) ([]Payment, error) {
+	rows, err := q.db.QueryContext(ctx, listPaymentsByID,
+		arg.PaymentStatus,
+		arg.SubscriptionID,
+		arg.DateFrom,
+		arg.DateTo,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Payment
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.PaymentID,
+			&i.SubscriptionID,
+			&i.TransactionID,
+			&i.Amount,
+			&i.CurrencyCode,
+			&i.PaymentMethod,
+			&i.PaymentStatus,
+			&i.PaymentDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPaymentsByIDDesc = `-- name: ListPaymentsByIDDesc :many
+SELECT payment_id, subscription_id, transaction_id, amount, currency_code, payment_method, payment_status, payment_date, created_at, updated_at FROM payments
+WHERE ($1::varchar IS NULL OR payment_status = $1)
+  AND ($2::integer IS NULL OR subscription_id = $2)
+  AND ($3::timestamptz IS NULL OR payment_date >= $3)
+  AND ($4::timestamptz IS NULL OR payment_date < $4)
+  AND ($5::integer IS NULL OR payment_id < $5)
+ORDER BY payment_id DESC
+LIMIT $6
+`
+
+type ListPaymentsByIDDescParams struct {
+	PaymentStatus  sql.NullString
+	SubscriptionID sql.NullInt32
+	DateFrom       sql.NullTime
+	DateTo         sql.NullTime
+	AfterID        sql.NullInt32
+	PageLimit      int32
+}
+
+func (q *Queries) ListPaymentsByIDDesc(ctx context.Context, arg ListPaymentsByIDDescParams) ([]Payment, error) {
+	rows, err := q.db.QueryContext(ctx, listPaymentsByIDDesc,
+		arg.PaymentStatus,
+		arg.SubscriptionID,
+		arg.DateFrom,
+		arg.DateTo,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Payment
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.PaymentID,
+			&i.SubscriptionID,
+			&i.TransactionID,
+			&i.Amount,
+			&i.CurrencyCode,
+			&i.PaymentMethod,
+			&i.PaymentStatus,
+			&i.PaymentDate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updatePayment = `-- name: UpdatePayment :one
 UPDATE payments
 SET
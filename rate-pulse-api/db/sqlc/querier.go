@@ -12,34 +12,54 @@ import (
 )
 
 type Querier interface {
+	BlockSession(ctx context.Context, arg BlockSessionParams) (int64, error)
+	BlockUserSessions(ctx context.Context, userID int32) (int64, error)
+	// Sets valid_to_date on every open rate of the given (source, pair, type) series to the
+	// valid_from_date of the next active rate in the series. The closed rows stay as history.
+	CloseSupersededExchangeRates(ctx context.Context, arg CloseSupersededExchangeRatesParams) (int64, error)
+	ConfirmUserTOTP(ctx context.Context, arg ConfirmUserTOTPParams) (UserTotp, error)
+	ConsumeMFAChallenge(ctx context.Context, arg ConsumeMFAChallengeParams) (int64, error)
+	CountActiveAPIKeysByUser(ctx context.Context, userID int32) (int64, error)
+	CountUnusedRecoveryCodes(ctx context.Context, userID int32) (int64, error)
+	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (ApiKey, error)
 	CreateCountry(ctx context.Context, arg CreateCountryParams) (Country, error)
 	CreateCurrency(ctx context.Context, arg CreateCurrencyParams) (Currency, error)
 	CreateCurrencyPreference(ctx context.Context, arg CreateCurrencyPreferenceParams) (UserCurrencyPreference, error)
 	CreateExchangeRate(ctx context.Context, arg CreateExchangeRateParams) (ExchangeRate, error)
 	CreateExchangeRateType(ctx context.Context, typeName string) (ExchangeRateType, error)
+	CreateMFAChallenge(ctx context.Context, arg CreateMFAChallengeParams) (MfaChallenge, error)
+	CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) (PasswordReset, error)
 	CreatePayment(ctx context.Context, arg CreatePaymentParams) (Payment, error)
+	CreateRateAlert(ctx context.Context, arg CreateRateAlertParams) (RateAlert, error)
 	CreateRateSource(ctx context.Context, arg CreateRateSourceParams) (RateSource, error)
 	CreateRateSourceFeeRule(ctx context.Context, arg CreateRateSourceFeeRuleParams) (RateSourceFeeRule, error)
 	CreateRateSourcePreference(ctx context.Context, arg CreateRateSourcePreferenceParams) (UserRateSourcePreference, error)
+	CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) error
+	CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (RefreshToken, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateSubscriptionPlan(ctx context.Context, arg CreateSubscriptionPlanParams) (SubscriptionPlan, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	CreateUserSubscription(ctx context.Context, arg CreateUserSubscriptionParams) (UserSubscription, error)
 	CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error)
-	DeleteAllExchangeRates(ctx context.Context) error
 	DeleteCountry(ctx context.Context, countryID int32) error
 	DeleteCurrency(ctx context.Context, currencyID int32) error
 	DeleteCurrencyPreference(ctx context.Context, arg DeleteCurrencyPreferenceParams) error
 	DeleteExchangeRate(ctx context.Context, rateID int32) error
 	DeleteExchangeRateType(ctx context.Context, typeID int32) error
 	DeletePayment(ctx context.Context, paymentID int32) error
+	DeleteRateAlert(ctx context.Context, arg DeleteRateAlertParams) (int64, error)
 	DeleteRateSource(ctx context.Context, sourceID int32) error
 	DeleteRateSourceFeeRule(ctx context.Context, feeRuleID int32) error
 	DeleteRateSourcePreference(ctx context.Context, arg DeleteRateSourcePreferenceParams) error
+	DeleteRecoveryCodes(ctx context.Context, userID int32) error
 	DeleteSubscriptionPlan(ctx context.Context, planID int32) error
 	DeleteUserByEmail(ctx context.Context, email string) error
 	DeleteUserByID(ctx context.Context, userID int32) error
 	DeleteUserSubscription(ctx context.Context, subscriptionID int32) error
+	DeleteUserTOTP(ctx context.Context, userID int32) (int64, error)
+	ExpireUserPasswordResets(ctx context.Context, userID int32) error
+	// Resolves a presented key to its owner; revoked and expired keys are left to the caller.
+	GetAPIKeyOwnerByHash(ctx context.Context, keyHash string) (GetAPIKeyOwnerByHashRow, error)
 	GetActiveRateSourceFeeRule(ctx context.Context, arg GetActiveRateSourceFeeRuleParams) (RateSourceFeeRule, error)
 	GetActiveSubscriptionPlans(ctx context.Context) ([]SubscriptionPlan, error)
 	GetActiveUserSubscriptionByUserID(ctx context.Context, userID int32) (UserSubscription, error)
@@ -50,21 +70,33 @@ type Querier interface {
 	GetAllExchangeRatesToday(ctx context.Context, arg GetAllExchangeRatesTodayParams) ([]GetAllExchangeRatesTodayRow, error)
 	// $2: page size
 	GetAllExchangeRatesTodayNormalised(ctx context.Context, arg GetAllExchangeRatesTodayNormalisedParams) ([]GetAllExchangeRatesTodayNormalisedRow, error)
-	GetAllPayments(ctx context.Context) ([]Payment, error)
 	GetAllRateSourcePreferences(ctx context.Context, arg GetAllRateSourcePreferencesParams) ([]UserRateSourcePreference, error)
 	GetAllSubscriptionPlans(ctx context.Context) ([]SubscriptionPlan, error)
-	GetAllUserSubscriptions(ctx context.Context) ([]UserSubscription, error)
 	GetCountriesByCurrencyID(ctx context.Context, currencyID int32) ([]GetCountriesByCurrencyIDRow, error)
 	GetCountryByCode(ctx context.Context, countryCode sql.NullString) (GetCountryByCodeRow, error)
 	GetCountryByID(ctx context.Context, countryID int32) (GetCountryByIDRow, error)
 	GetCurrencyByID(ctx context.Context, currencyID int32) (GetCurrencyByIDRow, error)
 	GetCurrencyPreferencesByCurrencyID(ctx context.Context, arg GetCurrencyPreferencesByCurrencyIDParams) ([]UserCurrencyPreference, error)
 	GetCurrencyPreferencesByUserID(ctx context.Context, arg GetCurrencyPreferencesByUserIDParams) ([]UserCurrencyPreference, error)
+	// Returns the last rate of each calendar day in the given time zone, matching end-of-day bank quotes.
+	GetDailyHistoricalData(ctx context.Context, arg GetDailyHistoricalDataParams) ([]GetDailyHistoricalDataRow, error)
+	GetDailyUsage(ctx context.Context, arg GetDailyUsageParams) (int32, error)
+	// Summarises what a new rate is checked against: the source's most recent active rates for
+	// the pair and type, and the latest active rate of every other source published since peer_since.
+	GetExchangeRateAnomalyBaseline(ctx context.Context, arg GetExchangeRateAnomalyBaselineParams) (GetExchangeRateAnomalyBaselineRow, error)
 	GetExchangeRateByID(ctx context.Context, rateID int32) (GetExchangeRateByIDRow, error)
+	// Aggregates a source's rates for a currency pair into open/high/low/close candles, placing
+	// each rate by valid_from_date, the time the source quoted it, rather than when the row was written.
+	// Buckets are calendar aligned with date_trunc in the given time zone, so every candle
+	// spans a full hour, day, ISO week or month; start_time is widened to its bucket start.
+	// Returns the most recent candles first.
+	GetExchangeRateCandles(ctx context.Context, arg GetExchangeRateCandlesParams) ([]GetExchangeRateCandlesRow, error)
+	// Returns the rate a source had published for a currency pair at a point in time.
+	GetExchangeRateForPairAsOf(ctx context.Context, arg GetExchangeRateForPairAsOfParams) (ExchangeRate, error)
 	GetExchangeRateType(ctx context.Context, typeID int32) (ExchangeRateType, error)
 	GetExchangeRateTypeByName(ctx context.Context, typeName string) (ExchangeRateType, error)
 	// Fetches evenly distributed exchange rate data points across a time range.
-	// Returns up to num_data_points evenly spaced samples from the time range [start_time, now).
+	// Returns up to num_data_points evenly spaced samples from the time range [start_time, end_time).
 	// Parameters:
 	//   $1: source_currency_id
 	//   $2: destination_currency_id
@@ -72,47 +104,149 @@ type Querier interface {
 	//   $4: start_time (UpdatedAt in struct)
 	//   $5: type_id
 	//   $6: num_data_points
+	//   $7: end_time (UpdatedAt_2 in struct)
 	GetHistoricalData(ctx context.Context, arg GetHistoricalDataParams) ([]GetHistoricalDataRow, error)
+	// Returns the most recent rate a source published for a currency pair in either direction.
+	GetLatestExchangeRateForPair(ctx context.Context, arg GetLatestExchangeRateForPairParams) (ExchangeRate, error)
+	GetMFAChallenge(ctx context.Context, tokenHash string) (MfaChallenge, error)
+	GetPasswordReset(ctx context.Context, id int64) (PasswordReset, error)
 	GetPaymentByID(ctx context.Context, paymentID int32) (Payment, error)
 	GetPaymentByTransactionID(ctx context.Context, transactionID sql.NullString) (Payment, error)
 	GetPaymentsByStatus(ctx context.Context, paymentStatus sql.NullString) ([]Payment, error)
 	GetPaymentsByUserID(ctx context.Context, userID int32) ([]Payment, error)
+	GetRateAlertForUser(ctx context.Context, arg GetRateAlertForUserParams) (RateAlert, error)
 	GetRateSourceByCode(ctx context.Context, sourceCode sql.NullString) (GetRateSourceByCodeRow, error)
 	GetRateSourceByID(ctx context.Context, sourceID int32) (GetRateSourceByIDRow, error)
 	GetRateSourceFeeRuleByID(ctx context.Context, feeRuleID int32) (RateSourceFeeRule, error)
 	GetRateSourcePreferencesBySourceID(ctx context.Context, arg GetRateSourcePreferencesBySourceIDParams) ([]UserRateSourcePreference, error)
 	GetRateSourcePreferencesByUserID(ctx context.Context, arg GetRateSourcePreferencesByUserIDParams) ([]UserRateSourcePreference, error)
+	// Resolves a presented refresh token to its session; rotated tokens are still returned
+	// so the caller can detect reuse.
+	GetRefreshTokenSession(ctx context.Context, tokenID uuid.UUID) (GetRefreshTokenSessionRow, error)
 	GetSessionByID(ctx context.Context, sessionID uuid.UUID) (Session, error)
 	GetSubscriptionPlanByID(ctx context.Context, planID int32) (SubscriptionPlan, error)
 	GetSubscriptionPlanByName(ctx context.Context, planName string) (SubscriptionPlan, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByID(ctx context.Context, userID int32) (User, error)
 	GetUserByUsername(ctx context.Context, username string) (User, error)
+	// The plan behind the user's current active subscription, as GetActiveUserSubscriptionByUserID picks it.
+	GetUserPlanLimits(ctx context.Context, userID int32) (GetUserPlanLimitsRow, error)
 	GetUserSubscriptionByID(ctx context.Context, subscriptionID int32) (UserSubscription, error)
 	GetUserSubscriptionsByStatus(ctx context.Context, status sql.NullString) ([]UserSubscription, error)
 	GetUserSubscriptionsByUserID(ctx context.Context, userID int32) ([]UserSubscription, error)
+	GetUserTOTP(ctx context.Context, userID int32) (UserTotp, error)
 	GetVerifyEmail(ctx context.Context, id int64) (VerifyEmail, error)
-	ListActiveRateSourceFeeRulesBySource(ctx context.Context, arg ListActiveRateSourceFeeRulesBySourceParams) ([]RateSourceFeeRule, error)
+	// Counts one request unless the day's count already reached daily_limit; no row comes back
+	// when the quota is exhausted, so the check and the increment cannot race.
+	IncrementDailyUsage(ctx context.Context, arg IncrementDailyUsageParams) (int32, error)
+	// Inserts every rate of a snapshot in a single multi-row statement, skipping a rate when its
+	// source already published the pair and type within the same time bucket. Rejected rates do
+	// not count, so a corrected replay of the bucket is accepted. Run it after LockExchangeRateSeries
+	// in the same transaction; on its own two concurrent inserts can both miss each other's row.
+	InsertExchangeRatesSnapshot(ctx context.Context, arg InsertExchangeRatesSnapshotParams) ([]ExchangeRate, error)
+	ListAPIKeysByUser(ctx context.Context, userID int32) ([]ApiKey, error)
+	ListActiveAdminEmails(ctx context.Context) ([]string, error)
+	ListActiveRateAlerts(ctx context.Context) ([]RateAlert, error)
+	// Returns the rule GetActiveRateSourceFeeRule would pick for each of the given sources, in one query.
+	ListActiveRateSourceFeeRulesForSources(ctx context.Context, arg ListActiveRateSourceFeeRulesForSourcesParams) ([]RateSourceFeeRule, error)
+	ListActiveRateSources(ctx context.Context) ([]ListActiveRateSourcesRow, error)
+	ListActiveSessionsByUser(ctx context.Context, userID int32) ([]Session, error)
+	// Returns the GetExchangeRateAnomalyBaseline of every distinct (source, pair, type) series in one query.
+	ListExchangeRateAnomalyBaselines(ctx context.Context, arg ListExchangeRateAnomalyBaselinesParams) ([]ListExchangeRateAnomalyBaselinesRow, error)
+	// Returns the last rate per source, type and calendar bucket for a currency pair, so buy and
+	// sell types can be paired bucket by bucket; start_time is widened to its bucket start.
+	ListExchangeRateSpreadSamples(ctx context.Context, arg ListExchangeRateSpreadSamplesParams) ([]ListExchangeRateSpreadSamplesRow, error)
 	ListExchangeRateTypes(ctx context.Context) ([]ExchangeRateType, error)
-	ListRateSourceFeeRules(ctx context.Context) ([]RateSourceFeeRule, error)
-	ListRateSourceFeeRulesBySource(ctx context.Context, sourceID int32) ([]RateSourceFeeRule, error)
+	// Returns, for each of the given sources, the most recent rate of every pair that includes
+	// currency_id, once per quoted direction. Lets a quote comparison load every source's rates at once.
+	ListLatestExchangeRatesAgainstCurrency(ctx context.Context, arg ListLatestExchangeRatesAgainstCurrencyParams) ([]ExchangeRate, error)
+	// Returns each source's most recent rate for a currency pair for each of the given types.
+	ListLatestExchangeRatesForPair(ctx context.Context, arg ListLatestExchangeRatesForPairParams) ([]ExchangeRate, error)
+	// Returns the most recent rate for every currency pair a source publishes for one type.
+	ListLatestExchangeRatesForSource(ctx context.Context, arg ListLatestExchangeRatesForSourceParams) ([]ExchangeRate, error)
+	// Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+	// both the WHERE and the ORDER BY. after_id/after_time are the last row of the previous page.
+	ListPaymentsByDate(ctx context.Context, arg ListPaymentsByDateParams) ([]Payment, error)
+	ListPaymentsByDateDesc(ctx context.Context, arg ListPaymentsByDateDescParams) ([]Payment, error)
+	ListPaymentsByID(ctx context.Context, arg ListPaymentsByIDParams) ([]Payment, error)
+	ListPaymentsByIDDesc(ctx context.Context, arg ListPaymentsByIDDescParams) ([]Payment, error)
+	// Lists rates held back by anomaly detection, newest first, for admin review.
+	ListQuarantinedExchangeRates(ctx context.Context, arg ListQuarantinedExchangeRatesParams) ([]ExchangeRate, error)
+	ListRateAlertsByUser(ctx context.Context, arg ListRateAlertsByUserParams) ([]RateAlert, error)
+	ListRateSourceFeeRules(ctx context.Context, arg ListRateSourceFeeRulesParams) ([]RateSourceFeeRule, error)
+	// Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+	// both the WHERE and the ORDER BY. after_id/after_date are the last row of the previous page.
+	ListRateSourceFeeRulesByEffectiveFrom(ctx context.Context, arg ListRateSourceFeeRulesByEffectiveFromParams) ([]RateSourceFeeRule, error)
+	ListRateSourceFeeRulesByEffectiveFromDesc(ctx context.Context, arg ListRateSourceFeeRulesByEffectiveFromDescParams) ([]RateSourceFeeRule, error)
+	ListRateSourceFeeRulesDesc(ctx context.Context, arg ListRateSourceFeeRulesDescParams) ([]RateSourceFeeRule, error)
+	// A source owes a new rate every expected_interval_minutes and turns stale once
+	// another half interval has passed without one, counted from its last ingested
+	// rate or, if it never produced one, from when the source was created.
+	ListRateSourceFreshness(ctx context.Context) ([]ListRateSourceFreshnessRow, error)
 	ListRateSourceMetadata(ctx context.Context) ([]ListRateSourceMetadataRow, error)
 	ListRateSources(ctx context.Context) ([]ListRateSourcesRow, error)
-	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	ListRateSourcesPage(ctx context.Context, arg ListRateSourcesPageParams) ([]ListRateSourcesPageRow, error)
+	// Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+	// both the WHERE and the ORDER BY. after_id/after_name are the last row of the previous page.
+	ListRateSourcesPageByName(ctx context.Context, arg ListRateSourcesPageByNameParams) ([]ListRateSourcesPageByNameRow, error)
+	ListRateSourcesPageByNameDesc(ctx context.Context, arg ListRateSourcesPageByNameDescParams) ([]ListRateSourcesPageByNameDescRow, error)
+	ListRateSourcesPageDesc(ctx context.Context, arg ListRateSourcesPageDescParams) ([]ListRateSourcesPageDescRow, error)
+	ListUserSubscriptionsByID(ctx context.Context, arg ListUserSubscriptionsByIDParams) ([]UserSubscription, error)
+	ListUserSubscriptionsByIDDesc(ctx context.Context, arg ListUserSubscriptionsByIDDescParams) ([]UserSubscription, error)
+	// Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+	// both the WHERE and the ORDER BY. after_id/after_time are the last row of the previous page.
+	ListUserSubscriptionsByStartDate(ctx context.Context, arg ListUserSubscriptionsByStartDateParams) ([]UserSubscription, error)
+	ListUserSubscriptionsByStartDateDesc(ctx context.Context, arg ListUserSubscriptionsByStartDateDescParams) ([]UserSubscription, error)
+	// Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+	// both the WHERE and the ORDER BY. after_id/after_time are the last row of the previous page.
+	ListUsersByCreatedAt(ctx context.Context, arg ListUsersByCreatedAtParams) ([]User, error)
+	ListUsersByCreatedAtDesc(ctx context.Context, arg ListUsersByCreatedAtDescParams) ([]User, error)
+	ListUsersByID(ctx context.Context, arg ListUsersByIDParams) ([]User, error)
+	ListUsersByIDDesc(ctx context.Context, arg ListUsersByIDDescParams) ([]User, error)
+	// Takes one transaction-scoped advisory lock per distinct (source, pair, type) series, in a
+	// fixed order so two snapshots sharing series cannot deadlock. Concurrent snapshots of the same
+	// series then run InsertExchangeRatesSnapshot and CloseSupersededExchangeRates one at a time.
+	LockExchangeRateSeries(ctx context.Context, arg LockExchangeRateSeriesParams) error
+	MarkRateSourceStaleNotified(ctx context.Context, arg MarkRateSourceStaleNotifiedParams) error
+	// Takes one of the attempts of an open challenge before its code is checked, so parallel
+	// requests on the same token cannot try more than max_attempts codes.
+	ReserveMFAChallengeAttempt(ctx context.Context, arg ReserveMFAChallengeAttemptParams) (MfaChallenge, error)
+	// Releases ('active') or discards ('rejected') a quarantined rate. updated_at is left alone
+	// so an approved rate keeps its place in the history.
+	ReviewQuarantinedExchangeRate(ctx context.Context, arg ReviewQuarantinedExchangeRateParams) (ExchangeRate, error)
+	RevokeAPIKey(ctx context.Context, arg RevokeAPIKeyParams) (ApiKey, error)
+	// Returns no row when the token was already rotated, so two concurrent renews with the
+	// same token cannot both succeed.
+	RotateRefreshToken(ctx context.Context, tokenID uuid.UUID) (RefreshToken, error)
+	// Writes at most once a minute per key so busy ETL jobs do not turn every request into an UPDATE.
+	TouchAPIKeyLastUsed(ctx context.Context, apiKeyID int32) error
 	UpdateCountry(ctx context.Context, arg UpdateCountryParams) (Country, error)
 	UpdateCurrency(ctx context.Context, arg UpdateCurrencyParams) (Currency, error)
 	UpdateCurrencyPreference(ctx context.Context, arg UpdateCurrencyPreferenceParams) (UserCurrencyPreference, error)
 	UpdateExchangeRate(ctx context.Context, arg UpdateExchangeRateParams) (ExchangeRate, error)
 	UpdateExchangeRateType(ctx context.Context, arg UpdateExchangeRateTypeParams) (ExchangeRateType, error)
+	UpdatePasswordReset(ctx context.Context, arg UpdatePasswordResetParams) (PasswordReset, error)
 	UpdatePayment(ctx context.Context, arg UpdatePaymentParams) (Payment, error)
+	UpdateRateAlert(ctx context.Context, arg UpdateRateAlertParams) (RateAlert, error)
+	UpdateRateAlertEvaluation(ctx context.Context, arg UpdateRateAlertEvaluationParams) error
 	UpdateRateSource(ctx context.Context, arg UpdateRateSourceParams) (RateSource, error)
+	UpdateRateSourceExpectedInterval(ctx context.Context, arg UpdateRateSourceExpectedIntervalParams) (RateSource, error)
 	UpdateRateSourceFeeRule(ctx context.Context, arg UpdateRateSourceFeeRuleParams) (RateSourceFeeRule, error)
 	UpdateRateSourcePreference(ctx context.Context, arg UpdateRateSourcePreferenceParams) (UserRateSourcePreference, error)
+	UpdateSessionRefreshToken(ctx context.Context, arg UpdateSessionRefreshTokenParams) (Session, error)
 	UpdateSubscriptionPlan(ctx context.Context, arg UpdateSubscriptionPlanParams) (SubscriptionPlan, error)
+	// A new email is unverified unless the caller sets email_verified itself.
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
-	UpdateUserEmailVerified(ctx context.Context, userID int32) (User, error)
+	// Only verifies the address the link was sent to, not one the user has since changed to.
+	UpdateUserEmailVerified(ctx context.Context, arg UpdateUserEmailVerifiedParams) (User, error)
+	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) (User, error)
 	UpdateUserSubscription(ctx context.Context, arg UpdateUserSubscriptionParams) (UserSubscription, error)
+	// Records the step of an accepted code; a step at or before the last one is a replay.
+	UpdateUserTOTPStep(ctx context.Context, arg UpdateUserTOTPStepParams) (int64, error)
 	UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEmailParams) (VerifyEmail, error)
+	// Starts or restarts an enrolment; a confirmed secret is only replaced by disabling it first.
+	UpsertUserTOTP(ctx context.Context, arg UpsertUserTOTPParams) (UserTotp, error)
+	UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,307 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: rate_alert.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createRateAlert = `-- name: CreateRateAlert :one
+INSERT INTO rate_alerts (
+    user_id,
+    source_id,
+    type_id,
+    from_currency_id,
+    to_currency_id,
+    condition,
+    threshold,
+    change_percent,
+    window_hours
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+)
+RETURNING alert_id, user_id, source_id, type_id, from_currency_id, to_currency_id, condition, threshold, change_percent, window_hours, is_active, last_rate_id, last_rate_value, last_triggered_at, created_at, updated_at
+`
+
+type CreateRateAlertParams struct {
+	UserID         int32
+	SourceID       int32
+	TypeID         int32
+	FromCurrencyID int32
+	ToCurrencyID   int32
+	Condition      string
+	Threshold      sql.NullString
+	ChangePercent  sql.NullString
+	WindowHours    int32
+}
+
+func (q *Queries) CreateRateAlert(ctx context.Context, arg CreateRateAlertParams) (RateAlert, error) {
+	row := q.db.QueryRowContext(ctx, createRateAlert,
+		arg.UserID,
+		arg.SourceID,
+		arg.TypeID,
+		arg.FromCurrencyID,
+		arg.ToCurrencyID,
+		arg.Condition,
+		arg.Threshold,
+		arg.ChangePercent,
+		arg.WindowHours,
+	)
+	var i RateAlert
+	err := row.Scan(
+		&i.AlertID,
+		&i.UserID,
+		&i.SourceID,
+		&i.TypeID,
+		&i.FromCurrencyID,
+		&i.ToCurrencyID,
+		&i.Condition,
+		&i.Threshold,
+		&i.ChangePercent,
+		&i.WindowHours,
+		&i.IsActive,
+		&i.LastRateID,
+		&i.LastRateValue,
+		&i.LastTriggeredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteRateAlert = `-- name: DeleteRateAlert :execrows
+DELETE FROM rate_alerts
+WHERE alert_id = $1 AND user_id = $2
+`
+
+type DeleteRateAlertParams struct {
+	AlertID int32
+	UserID  int32
+}
+
+func (q *Queries) DeleteRateAlert(ctx context.Context, arg DeleteRateAlertParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteRateAlert, arg.AlertID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getRateAlertForUser = `-- name: GetRateAlertForUser :one
+SELECT alert_id, user_id, source_id, type_id, from_currency_id, to_currency_id, condition, threshold, change_percent, window_hours, is_active, last_rate_id, last_rate_value, last_triggered_at, created_at, updated_at FROM rate_alerts
+WHERE alert_id = $1 AND user_id = $2
+LIMIT 1
+`
+
+type GetRateAlertForUserParams struct {
+	AlertID int32
+	UserID  int32
+}
+
+func (q *Queries) GetRateAlertForUser(ctx context.Context, arg GetRateAlertForUserParams) (RateAlert, error) {
+	row := q.db.QueryRowContext(ctx, getRateAlertForUser, arg.AlertID, arg.UserID)
+	var i RateAlert
+	err := row.Scan(
+		&i.AlertID,
+		&i.UserID,
+		&i.SourceID,
+		&i.TypeID,
+		&i.FromCurrencyID,
+		&i.ToCurrencyID,
+		&i.Condition,
+		&i.Threshold,
+		&i.ChangePercent,
+		&i.WindowHours,
+		&i.IsActive,
+		&i.LastRateID,
+		&i.LastRateValue,
+		&i.LastTriggeredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listActiveRateAlerts = `-- name: ListActiveRateAlerts :many
+SELECT alert_id, user_id, source_id, type_id, from_currency_id, to_currency_id, condition, threshold, change_percent, window_hours, is_active, last_rate_id, last_rate_value, last_triggered_at, created_at, updated_at FROM rate_alerts
+WHERE is_active = TRUE
+ORDER BY alert_id
+`
+
+func (q *Queries) ListActiveRateAlerts(ctx context.Context) ([]RateAlert, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveRateAlerts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RateAlert
+	for rows.Next() {
+		var i RateAlert
+		if err := rows.Scan(
+			&i.AlertID,
+			&i.UserID,
+			&i.SourceID,
+			&i.TypeID,
+			&i.FromCurrencyID,
+			&i.ToCurrencyID,
+			&i.Condition,
+			&i.Threshold,
+			&i.ChangePercent,
+			&i.WindowHours,
+			&i.IsActive,
+			&i.LastRateID,
+			&i.LastRateValue,
+			&i.LastTriggeredAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRateAlertsByUser = `-- name: ListRateAlertsByUser :many
+SELECT alert_id, user_id, source_id, type_id, from_currency_id, to_currency_id, condition, threshold, change_percent, window_hours, is_active, last_rate_id, last_rate_value, last_triggered_at, created_at, updated_at FROM rate_alerts
+WHERE user_id = $1
+ORDER BY alert_id
+LIMIT $2 OFFSET $3
+`
+
+type ListRateAlertsByUserParams struct {
+	UserID int32
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) ListRateAlertsByUser(ctx context.Context, arg ListRateAlertsByUserParams) ([]RateAlert, error) {
+	rows, err := q.db.QueryContext(ctx, listRateAlertsByUser, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RateAlert
+	for rows.Next() {
+		var i RateAlert
+		if err := rows.Scan(
+			&i.AlertID,
+			&i.UserID,
+			&i.SourceID,
+			&i.TypeID,
+			&i.FromCurrencyID,
+			&i.ToCurrencyID,
+			&i.Condition,
+			&i.Threshold,
+			&i.ChangePercent,
+			&i.WindowHours,
+			&i.IsActive,
+			&i.LastRateID,
+			&i.LastRateValue,
+			&i.LastTriggeredAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateRateAlert = `-- name: UpdateRateAlert :one
+UPDATE rate_alerts
+SET
+    condition = COALESCE($1, condition),
+    threshold = COALESCE($2, threshold),
+    change_percent = COALESCE($3, change_percent),
+    window_hours = COALESCE($4, window_hours),
+    is_active = COALESCE($5, is_active),
+    updated_at = CURRENT_TIMESTAMP
+WHERE alert_id = $6 AND user_id = $7
+RETURNING alert_id, user_id, source_id, type_id, from_currency_id, to_currency_id, condition, threshold, change_percent, window_hours, is_active, last_rate_id, last_rate_value, last_triggered_at, created_at, updated_at
+`
+
+type UpdateRateAlertParams struct {
+	Condition     sql.NullString
+	Threshold     sql.NullString
+	ChangePercent sql.NullString
+	WindowHours   sql.NullInt32
+	IsActive      sql.NullBool
+	AlertID       int32
+	UserID        int32
+}
+
+func (q *Queries) UpdateRateAlert(ctx context.Context, arg UpdateRateAlertParams) (RateAlert, error) {
+	row := q.db.QueryRowContext(ctx, updateRateAlert,
+		arg.Condition,
+		arg.Threshold,
+		arg.ChangePercent,
+		arg.WindowHours,
+		arg.IsActive,
+		arg.AlertID,
+		arg.UserID,
+	)
+	var i RateAlert
+	err := row.Scan(
+		&i.AlertID,
+		&i.UserID,
+		&i.SourceID,
+		&i.TypeID,
+		&i.FromCurrencyID,
+		&i.ToCurrencyID,
+		&i.Condition,
+		&i.Threshold,
+		&i.ChangePercent,
+		&i.WindowHours,
+		&i.IsActive,
+		&i.LastRateID,
+		&i.LastRateValue,
+		&i.LastTriggeredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateRateAlertEvaluation = `-- name: UpdateRateAlertEvaluation :exec
+UPDATE rate_alerts
+SET
+    last_rate_id = $1,
+    last_rate_value = $2,
+    last_triggered_at = COALESCE($3, last_triggered_at)
+WHERE alert_id = $4
+`
+
+type UpdateRateAlertEvaluationParams struct {
+	LastRateID      sql.NullInt32
+	LastRateValue   sql.NullString
+	LastTriggeredAt sql.NullTime
+	AlertID         int32
+}
+
+func (q *Queries) UpdateRateAlertEvaluation(ctx context.Context, arg UpdateRateAlertEvaluationParams) error {
+	_, err := q.db.ExecContext(ctx, updateRateAlertEvaluation,
+		arg.LastRateID,
+		arg.LastRateValue,
+		arg.LastTriggeredAt,
+		arg.AlertID,
+	)
+	return err
+}
@@ -8,12 +8,13 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 const createRateSource = `-- name: CreateRateSource :one
 INSERT INTO rate_sources (source_name, source_link, source_country, source_status, source_code)
 VALUES ($1, $2, $3, $4, $5)
-RETURNING source_id, source_name, source_link, source_country, source_status, updated_at, created_at, source_code, currency_id
+RETURNING source_id, source_name, source_link, source_country, source_status, updated_at, created_at, source_code, currency_id, expected_interval_minutes, stale_notified_at
 `
 
 type CreateRateSourceParams struct {
@@ -43,6 +44,8 @@ func (q *Queries) CreateRateSource(ctx context.Context, arg CreateRateSourcePara
 		&i.CreatedAt,
 		&i.SourceCode,
 		&i.CurrencyID,
+		&i.ExpectedIntervalMinutes,
+		&i.StaleNotifiedAt,
 	)
 	return i, err
 }
@@ -113,6 +116,107 @@ func (q *Queries) GetRateSourceByID(ctx context.Context, sourceID int32) (GetRat
 	return i, err
 }
 
+const listActiveRateSources = `-- name: ListActiveRateSources :many
+SELECT source_id, source_name, source_code FROM rate_sources
+WHERE source_status = 'active'
+ORDER BY source_id
+`
+
+type ListActiveRateSourcesRow struct {
+	SourceID   int32
+	SourceName string
+	SourceCode sql.NullString
+}
+
+func (q *Queries) ListActiveRateSources(ctx context.Context) ([]ListActiveRateSourcesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveRateSources)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListActiveRateSourcesRow
+	for rows.Next() {
+		var i ListActiveRateSourcesRow
+		if err := rows.Scan(&i.SourceID, &i.SourceName, &i.SourceCode); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRateSourceFreshness = `-- name: ListRateSourceFreshness :many
+SELECT
+  rs.source_id,
+  rs.source_name,
+  rs.source_code,
+  rs.source_status,
+  rs.expected_interval_minutes,
+  rs.stale_notified_at,
+  latest.last_ingested_at,
+  (COALESCE(latest.last_ingested_at, rs.created_at, CURRENT_TIMESTAMP)
+    + make_interval(mins => rs.expected_interval_minutes * 3 / 2))::TIMESTAMPTZ AS stale_at
+FROM rate_sources rs
+LEFT JOIN LATERAL (
+  SELECT MAX(er.created_at) AS last_ingested_at
+  FROM exchange_rates er
+  WHERE er.source_id = rs.source_id
+) latest ON TRUE
+ORDER BY rs.source_id
+`
+
+type ListRateSourceFreshnessRow struct {
+	SourceID                int32
+	SourceName              string
+	SourceCode              sql.NullString
+	SourceStatus            sql.NullString
+	ExpectedIntervalMinutes int32
+	StaleNotifiedAt         sql.NullTime
+	LastIngestedAt          sql.NullTime
+	StaleAt                 time.Time
+}
+
+// A source owes a new rate every expected_interval_minutes and turns stale once
+// another half interval has passed without one, counted from its last ingested
+// rate or, if it never produced one, from when the source was created.
+func (q *Queries) ListRateSourceFreshness(ctx context.Context) ([]ListRateSourceFreshnessRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRateSourceFreshness)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRateSourceFreshnessRow
+	for rows.Next() {
+		var i ListRateSourceFreshnessRow
+		if err := rows.Scan(
+			&i.SourceID,
+			&i.SourceName,
+			&i.SourceCode,
+			&i.SourceStatus,
+			&i.ExpectedIntervalMinutes,
+			&i.StaleNotifiedAt,
+			&i.LastIngestedAt,
+			&i.StaleAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRateSourceMetadata = `-- name: ListRateSourceMetadata :many
 SELECT source_id, source_name, source_code, source_link, currency_id FROM rate_sources
 ORDER BY source_id
@@ -199,6 +303,274 @@ func (q *Queries) ListRateSources(ctx context.Context) ([]ListRateSourcesRow, er
 	return items, nil
 }
 
+const listRateSourcesPage = `-- name: ListRateSourcesPage :many
+SELECT source_id, source_name, source_link, source_country, source_status, source_code FROM rate_sources
+WHERE ($1::varchar IS NULL OR source_status = $1)
+  AND ($2::varchar IS NULL OR source_country = $2)
+  AND ($3::integer IS NULL OR source_id > $3)
+ORDER BY source_id
+LIMIT $4
+`
+
+type ListRateSourcesPageParams struct {
+	SourceStatus  sql.NullString
+	SourceCountry sql.NullString
+	AfterID       sql.NullInt32
+	PageLimit     int32
+}
+
+type ListRateSourcesPageRow struct {
+	SourceID      int32
+	SourceName    string
+	SourceLink    sql.NullString
+	SourceCountry sql.NullString
+	SourceStatus  sql.NullString
+	SourceCode    sql.NullString
+}
+
+func (q *Queries) ListRateSourcesPage(ctx context.Context, arg ListRateSourcesPageParams) ([]ListRateSourcesPageRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRateSourcesPage,
+		arg.SourceStatus,
+		arg.SourceCountry,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRateSourcesPageRow
+	for rows.Next() {
+		var i ListRateSourcesPageRow
+		if err := rows.Scan(
+			&i.SourceID,
+			&i.SourceName,
+			&i.SourceLink,
+			&i.SourceCountry,
+			&i.SourceStatus,
+			&i.SourceCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRateSourcesPageByName = `-- name: ListRateSourcesPageByName :many
+SELECT source_id, source_name, source_link, source_country, source_status, source_code FROM rate_sources
+WHERE ($1::varchar IS NULL OR source_status = $1)
+  AND ($2::varchar IS NULL OR source_country = $2)
+  AND (
+    $3::integer IS NULL
+    OR (source_name, source_id) > ($4::varchar, $3)
+  )
+ORDER BY source_name, source_id
+LIMIT $5
+`
+
+type ListRateSourcesPageByNameParams struct {
+	SourceStatus  sql.NullString
+	SourceCountry sql.NullString
+	AfterID       sql.NullInt32
+	AfterName     sql.NullString
+	PageLimit     int32
+}
+
+type ListRateSourcesPageByNameRow struct {
+	SourceID      int32
+	SourceName    string
+	SourceLink    sql.NullString
+	SourceCountry sql.NullString
+	SourceStatus  sql.NullString
+	SourceCode    sql.NullString
+}
+
+// Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+// both the WHERE and the ORDER BY. after_id/after_name are the last row of the previous page.
+func (q *Queries) ListRateSourcesPageByName(ctx context.Context, arg ListRateSourcesPageByNameParams) ([]ListRateSourcesPageByNameRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRateSourcesPageByName,
+		arg.SourceStatus,
+		arg.SourceCountry,
+		arg.AfterID,
+		arg.AfterName,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRateSourcesPageByNameRow
+	for rows.Next() {
+		var i ListRateSourcesPageByNameRow
+		if err := rows.Scan(
+			&i.SourceID,
+			&i.SourceName,
+			&i.SourceLink,
+			&i.SourceCountry,
+			&i.SourceStatus,
+			&i.SourceCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRateSourcesPageByNameDesc = `-- name: ListRateSourcesPageByNameDesc :many
+SELECT source_id, source_name, source_link, source_country, source_status, source_code FROM rate_sources
+WHERE ($1::varchar IS NULL OR source_status = $1)
+  AND ($2::varchar IS NULL OR source_country = $2)
+  AND (
+    $3::integer IS NULL
+    OR (source_name, source_id) < ($4::varchar, $3)
+  )
+ORDER BY source_name DESC, source_id DESC
+LIMIT $5
+`
+
+type ListRateSourcesPageByNameDescParams struct {
+	SourceStatus  sql.NullString
+	SourceCountry sql.NullString
+	AfterID       sql.NullInt32
+	AfterName     sql.NullString
+	PageLimit     int32
+}
+
+type ListRateSourcesPageByNameDescRow struct {
+	SourceID      int32
+	SourceName    string
+	SourceLink    sql.NullString
+	SourceCountry sql.NullString
+	SourceStatus  sql.NullString
+	SourceCode    sql.NullString
+}
+
+func (q *Queries) ListRateSourcesPageByNameDesc(ctx context.Context, arg ListRateSourcesPageByNameDescParams) ([]ListRateSourcesPageByNameDescRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRateSourcesPageByNameDesc,
+		arg.SourceStatus,
+		arg.SourceCountry,
+		arg.AfterID,
+		arg.AfterName,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRateSourcesPageByNameDescRow
+	for rows.Next() {
+		var i ListRateSourcesPageByNameDescRow
+		if err := rows.Scan(
+			&i.SourceID,
+			&i.SourceName,
+			&i.SourceLink,
+			&i.SourceCountry,
+			&i.SourceStatus,
+			&i.SourceCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRateSourcesPageDesc = `-- name: ListRateSourcesPageDesc :many
+SELECT source_id, source_name, source_link, source_country, source_status, source_code FROM rate_sources
+WHERE ($1::varchar IS NULL OR source_status = $1)
+  AND ($2::varchar IS NULL OR source_country = $2)
+  AND ($3::integer IS NULL OR source_id < $3)
+ORDER BY source_id DESC
+LIMIT $4
+`
+
+type ListRateSourcesPageDescParams struct {
+	SourceStatus  sql.NullString
+	SourceCountry sql.NullString
+	AfterID       sql.NullInt32
+	PageLimit     int32
+}
+
+type ListRateSourcesPageDescRow struct {
+	SourceID      int32
+	SourceName    string
+	SourceLink    sql.NullString
+	SourceCountry sql.NullString
+	SourceStatus  sql.NullString
+	SourceCode    sql.NullString
+}
+
+func (q *Queries) ListRateSourcesPageDesc(ctx context.Context, arg ListRateSourcesPageDescParams) ([]ListRateSourcesPageDescRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRateSourcesPageDesc,
+		arg.SourceStatus,
+		arg.SourceCountry,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRateSourcesPageDescRow
+	for rows.Next() {
+		var i ListRateSourcesPageDescRow
+		if err := rows.Scan(
+			&i.SourceID,
+			&i.SourceName,
+			&i.SourceLink,
+			&i.SourceCountry,
+			&i.SourceStatus,
+			&i.SourceCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markRateSourceStaleNotified = `-- name: MarkRateSourceStaleNotified :exec
+UPDATE rate_sources
+SET stale_notified_at = $2
+WHERE source_id = $1
+`
+
+type MarkRateSourceStaleNotifiedParams struct {
+	SourceID        int32
+	StaleNotifiedAt sql.NullTime
+}
+
+func (q *Queries) MarkRateSourceStaleNotified(ctx context.Context, arg MarkRateSourceStaleNotifiedParams) error {
+	_, err := q.db.ExecContext(ctx, markRateSourceStaleNotified, arg.SourceID, arg.StaleNotifiedAt)
+	return err
+}
+
 const updateRateSource = `-- name: UpdateRateSource :one
 UPDATE rate_sources
 SET 
@@ -208,7 +580,7 @@ SET
     source_status = COALESCE($5, source_status),
     source_code = COALESCE($6, source_code)
 WHERE source_id = $1
-RETURNING source_id, source_name, source_link, source_country, source_status, updated_at, created_at, source_code, currency_id
+RETURNING source_id, source_name, source_link, source_country, source_status, updated_at, created_at, source_code, currency_id, expected_interval_minutes, stale_notified_at
 `
 
 type UpdateRateSourceParams struct {
@@ -240,6 +612,39 @@ func (q *Queries) UpdateRateSource(ctx context.Context, arg UpdateRateSourcePara
 		&i.CreatedAt,
 		&i.SourceCode,
 		&i.CurrencyID,
+		&i.ExpectedIntervalMinutes,
+		&i.StaleNotifiedAt,
+	)
+	return i, err
+}
+
+const updateRateSourceExpectedInterval = `-- name: UpdateRateSourceExpectedInterval :one
+UPDATE rate_sources
+SET expected_interval_minutes = $2
+WHERE source_id = $1
+RETURNING source_id, source_name, source_link, source_country, source_status, updated_at, created_at, source_code, currency_id, expected_interval_minutes, stale_notified_at
+`
+
+type UpdateRateSourceExpectedIntervalParams struct {
+	SourceID                int32
+	ExpectedIntervalMinutes int32
+}
+
+func (q *Queries) UpdateRateSourceExpectedInterval(ctx context.Context, arg UpdateRateSourceExpectedIntervalParams) (RateSource, error) {
+	row := q.db.QueryRowContext(ctx, updateRateSourceExpectedInterval, arg.SourceID, arg.ExpectedIntervalMinutes)
+	var i RateSource
+	err := row.Scan(
+		&i.SourceID,
+		&i.SourceName,
+		&i.SourceLink,
+		&i.SourceCountry,
+		&i.SourceStatus,
+		&i.UpdatedAt,
+		&i.CreatedAt,
+		&i.SourceCode,
+		&i.CurrencyID,
+		&i.ExpectedIntervalMinutes,
+		&i.StaleNotifiedAt,
 	)
 	return i, err
 }
@@ -9,6 +9,8 @@ import (
 	"context"
 	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 const createRateSourceFeeRule = `-- name: CreateRateSourceFeeRule :one
@@ -225,21 +227,34 @@ func (q *Queries) GetRateSourceFeeRuleByID(ctx context.Context, feeRuleID int32)
 	return i, err
 }
 
-const listActiveRateSourceFeeRulesBySource = `-- name: ListActiveRateSourceFeeRulesBySource :many
-SELECT fee_rule_id, source_id, type_id, fee_rate, vat_rate, vat_applies, fee_includes_vat, swift_fee, swift_fee_currency_id, source_url, source_note, effective_from, effective_to, updated_at, created_at, transaction_type, channel, fee_currency_id, fixed_fee, min_fee, max_fee, fee_rate_min, fee_rate_max, swift_fee_included FROM rate_source_fee_rules
-WHERE source_id = $1
-  AND effective_from <= $2
-  AND (effective_to IS NULL OR effective_to >= $2)
-ORDER BY type_id, transaction_type, channel, effective_from DESC, fee_rule_id DESC
+const listActiveRateSourceFeeRulesForSources = `-- name: ListActiveRateSourceFeeRulesForSources :many
+SELECT DISTINCT ON (source_id) fee_rule_id, source_id, type_id, fee_rate, vat_rate, vat_applies, fee_includes_vat, swift_fee, swift_fee_currency_id, source_url, source_note, effective_from, effective_to, updated_at, created_at, transaction_type, channel, fee_currency_id, fixed_fee, min_fee, max_fee, fee_rate_min, fee_rate_max, swift_fee_included FROM rate_source_fee_rules
+WHERE source_id = ANY($1::INT[])
+  AND type_id = $2
+  AND transaction_type = $3
+  AND channel = $4
+  AND effective_from <= $5
+  AND (effective_to IS NULL OR effective_to >= $5)
+ORDER BY source_id, effective_from DESC, fee_rule_id DESC
 `
 
-type ListActiveRateSourceFeeRulesBySourceParams struct {
-	SourceID      int32
-	EffectiveFrom time.Time
+type ListActiveRateSourceFeeRulesForSourcesParams struct {
+	SourceIds       []int32
+	TypeID          int32
+	TransactionType string
+	Channel         string
+	EffectiveOn     time.Time
 }
 
-func (q *Queries) ListActiveRateSourceFeeRulesBySource(ctx context.Context, arg ListActiveRateSourceFeeRulesBySourceParams) ([]RateSourceFeeRule, error) {
-	rows, err := q.db.QueryContext(ctx, listActiveRateSourceFeeRulesBySource, arg.SourceID, arg.EffectiveFrom)
+// Returns the rule GetActiveRateSourceFeeRule would pick for each of the given sources, in one query.
+func (q *Queries) ListActiveRateSourceFeeRulesForSources(ctx context.Context, arg ListActiveRateSourceFeeRulesForSourcesParams) ([]RateSourceFeeRule, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveRateSourceFeeRulesForSources,
+		pq.Array(arg.SourceIds),
+		arg.TypeID,
+		arg.TransactionType,
+		arg.Channel,
+		arg.EffectiveOn,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -288,11 +303,33 @@ func (q *Queries) ListActiveRateSourceFeeRulesBySource(ctx context.Context, arg
 
 const listRateSourceFeeRules = `-- name: ListRateSourceFeeRules :many
 SELECT fee_rule_id, source_id, type_id, fee_rate, vat_rate, vat_applies, fee_includes_vat, swift_fee, swift_fee_currency_id, source_url, source_note, effective_from, effective_to, updated_at, created_at, transaction_type, channel, fee_currency_id, fixed_fee, min_fee, max_fee, fee_rate_min, fee_rate_max, swift_fee_included FROM rate_source_fee_rules
-ORDER BY source_id, type_id, transaction_type, channel, effective_from DESC, fee_rule_id DESC
+WHERE ($1::integer IS NULL OR source_id = $1)
+  AND ($2::integer IS NULL OR type_id = $2)
+  AND (
+    $3::date IS NULL
+    OR (effective_from <= $3 AND (effective_to IS NULL OR effective_to >= $3))
+  )
+  AND ($4::integer IS NULL OR fee_rule_id > $4)
+ORDER BY fee_rule_id
+LIMIT $5
 `
 
-func (q *Queries) ListRateSourceFeeRules(ctx context.Context) ([]RateSourceFeeRule, error) {
-	rows, err := q.db.QueryContext(ctx, listRateSourceFeeRules)
+type ListRateSourceFeeRulesParams struct {
+	SourceID  sql.NullInt32
+	TypeID    sql.NullInt32
+	ActiveOn  sql.NullTime
+	AfterID   sql.NullInt32
+	PageLimit int32
+}
+
+func (q *Queries) ListRateSourceFeeRules(ctx context.Context, arg ListRateSourceFeeRulesParams) ([]RateSourceFeeRule, error) {
+	rows, err := q.db.QueryContext(ctx, listRateSourceFeeRules,
+		arg.SourceID,
+		arg.TypeID,
+		arg.ActiveOn,
+		arg.AfterID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -339,14 +376,197 @@ func (q *Queries) ListRateSourceFeeRules(ctx context.Context) ([]RateSourceFeeRu
 	return items, nil
 }
 
-const listRateSourceFeeRulesBySource = `-- name: ListRateSourceFeeRulesBySource :many
+const listRateSourceFeeRulesByEffectiveFrom = `-- name: ListRateSourceFeeRulesByEffectiveFrom :many
 SELECT fee_rule_id, source_id, type_id, fee_rate, vat_rate, vat_applies, fee_includes_vat, swift_fee, swift_fee_currency_id, source_url, source_note, effective_from, effective_to, updated_at, created_at, transaction_type, channel, fee_currency_id, fixed_fee, min_fee, max_fee, fee_rate_min, fee_rate_max, swift_fee_included FROM rate_source_fee_rules
-WHERE source_id = $1
-ORDER BY type_id, transaction_type, channel, effective_from DESC, fee_rule_id DESC
+WHERE ($1::integer IS NULL OR source_id = $1)
+  AND ($2::integer IS NULL OR type_id = $2)
+  AND (
+    $3::date IS NULL
+    OR (effective_from <= $3 AND (effective_to IS NULL OR effective_to >= $3))
+  )
+  AND (
+    $4::integer IS NULL
+    OR (effective_from, fee_rule_id) > ($5::date, $4)
+  )
+ORDER BY effective_from, fee_rule_id
+LIMIT $6
 `
 
-func (q *Queries) ListRateSourceFeeRulesBySource(ctx context.Context, sourceID int32) ([]RateSourceFeeRule, error) {
-	rows, err := q.db.QueryContext(ctx, listRateSourceFeeRulesBySource, sourceID)
+type ListRateSourceFeeRulesByEffectiveFromParams struct {
+	SourceID  sql.NullInt32
+	TypeID    sql.NullInt32
+	ActiveOn  sql.NullTime
+	AfterID   sql.NullInt32
+	AfterDate sql.NullTime
+	PageLimit int32
+}
+
+// Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+// both the WHERE and the ORDER BY. after_id/after_date are the last row of the previous page.
+func (q *Queries) ListRateSourceFeeRulesByEffectiveFrom(ctx context.Context, arg ListRateSourceFeeRulesByEffectiveFromParams) ([]RateSourceFeeRule, error) {
+	rows, err := q.db.QueryContext(ctx, listRateSourceFeeRulesByEffectiveFrom,
+		arg.SourceID,
+		arg.TypeID,
+		arg.ActiveOn,
+		arg.AfterID,
+		arg.AfterDate,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RateSourceFeeRule
+	for rows.Next() {
+		var i RateSourceFeeRule
+		if err := rows.Scan(
+			&i.FeeRuleID,
+			&i.SourceID,
+			&i.TypeID,
+			&i.FeeRate,
+			&i.VatRate,
+			&i.VatApplies,
+			&i.FeeIncludesVat,
+			&i.SwiftFee,
+			&i.SwiftFeeCurrencyID,
+			&i.SourceUrl,
+			&i.SourceNote,
+			&i.EffectiveFrom,
+			&i.EffectiveTo,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+			&i.TransactionType,
+			&i.Channel,
+			&i.FeeCurrencyID,
+			&i.FixedFee,
+			&i.MinFee,
+			&i.MaxFee,
+			&i.FeeRateMin,
+			&i.FeeRateMax,
+			&i.SwiftFeeIncluded,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRateSourceFeeRulesByEffectiveFromDesc = `-- name: ListRateSourceFeeRulesByEffectiveFromDesc :many
+SELECT fee_rule_id, source_id, type_id, fee_rate, vat_rate, vat_applies, fee_includes_vat, swift_fee, swift_fee_currency_id, source_url, source_note, effective_from, effective_to, updated_at, created_at, transaction_type, channel, fee_currency_id, fixed_fee, min_fee, max_fee, fee_rate_min, fee_rate_max, swift_fee_included FROM rate_source_fee_rules
+WHERE ($1::integer IS NULL OR source_id = $1)
+  AND ($2::integer IS NULL OR type_id = $2)
+  AND (
+    $3::date IS NULL
+    OR (effective_from <= $3 AND (effective_to IS NULL OR effective_to >= $3))
+  )
+  AND (
+    $4::integer IS NULL
+    OR (effective_from, fee_rule_id) < ($5::date, $4)
+  )
+ORDER BY effective_from DESC, fee_rule_id DESC
+LIMIT $6
+`
+
+type ListRateSourceFeeRulesByEffectiveFromDescParams struct {
+	SourceID  sql.NullInt32
+	TypeID    sql.NullInt32
+	ActiveOn  sql.NullTime
+	AfterID   sql.NullInt32
+	AfterDate sql.NullTime
+	PageLimit int32
+}
+
+func (q *Queries) ListRateSourceFeeRulesByEffectiveFromDesc(ctx context.Context, arg ListRateSourceFeeRulesByEffectiveFromDescParams) ([]RateSourceFeeRule, error) {
+	rows, err := q.db.QueryContext(ctx, listRateSourceFeeRulesByEffectiveFromDesc,
+		arg.SourceID,
+		arg.TypeID,
+		arg.ActiveOn,
+		arg.AfterID,
+		arg.AfterDate,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RateSourceFeeRule
+	for rows.Next() {
+		var i RateSourceFeeRule
+		if err := rows.Scan(
+			&i.FeeRuleID,
+			&i.SourceID,
+			&i.TypeID,
+			&i.FeeRate,
+			&i.VatRate,
+			&i.VatApplies,
+			&i.FeeIncludesVat,
+			&i.SwiftFee,
+			&i.SwiftFeeCurrencyID,
+			&i.SourceUrl,
+			&i.SourceNote,
+			&i.EffectiveFrom,
+			&i.EffectiveTo,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+			&i.TransactionType,
+			&i.Channel,
+			&i.FeeCurrencyID,
+			&i.FixedFee,
+			&i.MinFee,
+			&i.MaxFee,
+			&i.FeeRateMin,
+			&i.FeeRateMax,
+			&i.SwiftFeeIncluded,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRateSourceFeeRulesDesc = `-- name: ListRateSourceFeeRulesDesc :many
+SELECT fee_rule_id, source_id, type_id, fee_rate, vat_rate, vat_applies, fee_includes_vat, swift_fee, swift_fee_currency_id, source_url, source_note, effective_from, effective_to, updated_at, created_at, transaction_type, channel, fee_currency_id, fixed_fee, min_fee, max_fee, fee_rate_min, fee_rate_max, swift_fee_included FROM rate_source_fee_rules
+WHERE ($1::integer IS NULL OR source_id = $1)
+  AND ($2::integer IS NULL OR type_id = $2)
+  AND (
+    $3::date IS NULL
+    OR (effective_from <= $3 AND (effective_to IS NULL OR effective_to >= $3))
+  )
+  AND ($4::integer IS NULL OR fee_rule_id < $4)
+ORDER BY fee_rule_id DESC
+LIMIT $5
+`
+
+type ListRateSourceFeeRulesDescParams struct {
+	SourceID  sql.NullInt32
+	TypeID    sql.NullInt32
+	ActiveOn  sql.NullTime
+	AfterID   sql.NullInt32
+	PageLimit int32
+}
+
+func (q *Queries) ListRateSourceFeeRulesDesc(ctx context.Context, arg ListRateSourceFeeRulesDescParams) ([]RateSourceFeeRule, error) {
+	rows, err := q.db.QueryContext(ctx, listRateSourceFeeRulesDesc,
+		arg.SourceID,
+		arg.TypeID,
+		arg.ActiveOn,
+		arg.AfterID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
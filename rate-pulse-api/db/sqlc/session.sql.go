@@ -13,6 +13,72 @@ import (
 	"github.com/google/uuid"
 )
 
+const blockSession = `-- name: BlockSession :execrows
+UPDATE sessions
+SET is_blocked = TRUE,
+    updated_at = now()
+WHERE session_id = $1
+  AND user_id = $2
+  AND is_blocked IS NOT TRUE
+`
+
+type BlockSessionParams struct {
+	SessionID uuid.UUID
+	UserID    int32
+}
+
+func (q *Queries) BlockSession(ctx context.Context, arg BlockSessionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, blockSession, arg.SessionID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const blockUserSessions = `-- name: BlockUserSessions :execrows
+UPDATE sessions
+SET is_blocked = TRUE,
+    updated_at = now()
+WHERE user_id = $1
+  AND is_blocked IS NOT TRUE
+  AND expires_at > now()
+`
+
+func (q *Queries) BlockUserSessions(ctx context.Context, userID int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, blockUserSessions, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createRefreshToken = `-- name: CreateRefreshToken :one
+INSERT INTO refresh_tokens (
+    token_id,
+    session_id,
+    expires_at
+) VALUES ($1, $2, $3) RETURNING token_id, session_id, expires_at, rotated_at, created_at
+`
+
+type CreateRefreshTokenParams struct {
+	TokenID   uuid.UUID
+	SessionID uuid.UUID
+	ExpiresAt time.Time
+}
+
+func (q *Queries) CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (RefreshToken, error) {
+	row := q.db.QueryRowContext(ctx, createRefreshToken, arg.TokenID, arg.SessionID, arg.ExpiresAt)
+	var i RefreshToken
+	err := row.Scan(
+		&i.TokenID,
+		&i.SessionID,
+		&i.ExpiresAt,
+		&i.RotatedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const createSession = `-- name: CreateSession :one
 INSERT INTO sessions (
     session_id,
@@ -22,7 +88,7 @@ INSERT INTO sessions (
     client_ip,
     is_blocked,
     expires_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING session_id, user_id, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at, updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING session_id, user_id, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at, updated_at, last_used_at
 `
 
 type CreateSessionParams struct {
@@ -56,12 +122,55 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (S
 		&i.ExpiresAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LastUsedAt,
+	)
+	return i, err
+}
+
+const getRefreshTokenSession = `-- name: GetRefreshTokenSession :one
+SELECT
+    rt.token_id,
+    rt.rotated_at,
+    s.session_id,
+    s.user_id,
+    s.refresh_token,
+    s.is_blocked,
+    s.expires_at
+FROM refresh_tokens rt
+JOIN sessions s ON s.session_id = rt.session_id
+WHERE rt.token_id = $1
+LIMIT 1
+`
+
+type GetRefreshTokenSessionRow struct {
+	TokenID      uuid.UUID
+	RotatedAt    sql.NullTime
+	SessionID    uuid.UUID
+	UserID       int32
+	RefreshToken string
+	IsBlocked    sql.NullBool
+	ExpiresAt    time.Time
+}
+
+// Resolves a presented refresh token to its session; rotated tokens are still returned
+// so the caller can detect reuse.
+func (q *Queries) GetRefreshTokenSession(ctx context.Context, tokenID uuid.UUID) (GetRefreshTokenSessionRow, error) {
+	row := q.db.QueryRowContext(ctx, getRefreshTokenSession, tokenID)
+	var i GetRefreshTokenSessionRow
+	err := row.Scan(
+		&i.TokenID,
+		&i.RotatedAt,
+		&i.SessionID,
+		&i.UserID,
+		&i.RefreshToken,
+		&i.IsBlocked,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
 
 const getSessionByID = `-- name: GetSessionByID :one
-SELECT session_id, user_id, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at, updated_at FROM sessions
+SELECT session_id, user_id, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at, updated_at, last_used_at FROM sessions
 WHERE session_id = $1 LIMIT 1
 `
 
@@ -78,6 +187,114 @@ func (q *Queries) GetSessionByID(ctx context.Context, sessionID uuid.UUID) (Sess
 		&i.ExpiresAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LastUsedAt,
+	)
+	return i, err
+}
+
+const listActiveSessionsByUser = `-- name: ListActiveSessionsByUser :many
+SELECT session_id, user_id, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at, updated_at, last_used_at FROM sessions
+WHERE user_id = $1
+  AND is_blocked IS NOT TRUE
+  AND expires_at > now()
+ORDER BY last_used_at DESC, created_at DESC
+`
+
+func (q *Queries) ListActiveSessionsByUser(ctx context.Context, userID int32) ([]Session, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveSessionsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Session
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.SessionID,
+			&i.UserID,
+			&i.RefreshToken,
+			&i.UserAgent,
+			&i.ClientIp,
+			&i.IsBlocked,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LastUsedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rotateRefreshToken = `-- name: RotateRefreshToken :one
+UPDATE refresh_tokens
+SET rotated_at = now()
+WHERE token_id = $1
+  AND rotated_at IS NULL
+RETURNING token_id, session_id, expires_at, rotated_at, created_at
+`
+
+// Returns no row when the token was already rotated, so two concurrent renews with the
+// same token cannot both succeed.
+func (q *Queries) RotateRefreshToken(ctx context.Context, tokenID uuid.UUID) (RefreshToken, error) {
+	row := q.db.QueryRowContext(ctx, rotateRefreshToken, tokenID)
+	var i RefreshToken
+	err := row.Scan(
+		&i.TokenID,
+		&i.SessionID,
+		&i.ExpiresAt,
+		&i.RotatedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateSessionRefreshToken = `-- name: UpdateSessionRefreshToken :one
+UPDATE sessions
+SET refresh_token = $2,
+    user_agent = $3,
+    client_ip = $4,
+    last_used_at = now(),
+    updated_at = now()
+WHERE session_id = $1
+  AND is_blocked IS NOT TRUE
+RETURNING session_id, user_id, refresh_token, user_agent, client_ip, is_blocked, expires_at, created_at, updated_at, last_used_at
+`
+
+type UpdateSessionRefreshTokenParams struct {
+	SessionID    uuid.UUID
+	RefreshToken string
+	UserAgent    string
+	ClientIp     string
+}
+
+func (q *Queries) UpdateSessionRefreshToken(ctx context.Context, arg UpdateSessionRefreshTokenParams) (Session, error) {
+	row := q.db.QueryRowContext(ctx, updateSessionRefreshToken,
+		arg.SessionID,
+		arg.RefreshToken,
+		arg.UserAgent,
+		arg.ClientIp,
+	)
+	var i Session
+	err := row.Scan(
+		&i.SessionID,
+		&i.UserID,
+		&i.RefreshToken,
+		&i.UserAgent,
+		&i.ClientIp,
+		&i.IsBlocked,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastUsedAt,
 	)
 	return i, err
 }
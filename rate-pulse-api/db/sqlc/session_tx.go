@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// CreateSessionTx creates a session together with the record of its first refresh
+// token, which shares the session's ID.
+func (store *SQLStore) CreateSessionTx(ctx context.Context, arg CreateSessionParams) (Session, error) {
+	var session Session
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		session, err = q.CreateSession(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.CreateRefreshToken(ctx, CreateRefreshTokenParams{
+			TokenID:   arg.SessionID,
+			SessionID: arg.SessionID,
+			ExpiresAt: arg.ExpiresAt,
+		})
+		return err
+	})
+	if err != nil {
+		return Session{}, err
+	}
+
+	return session, nil
+}
+
+// RotateSessionTxParams defines the refresh token being replaced and its successor.
+type RotateSessionTxParams struct {
+	TokenID      uuid.UUID
+	SessionID    uuid.UUID
+	NewTokenID   uuid.UUID
+	RefreshToken string
+	UserAgent    string
+	ClientIp     string
+}
+
+// RotateSessionTx marks the presented refresh token as rotated, records its successor
+// and makes the successor the session's current token in one transaction. It returns
+// sql.ErrNoRows when the presented token was already rotated or the session is blocked.
+func (store *SQLStore) RotateSessionTx(ctx context.Context, arg RotateSessionTxParams) (Session, error) {
+	var session Session
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if _, err := q.RotateRefreshToken(ctx, arg.TokenID); err != nil {
+			return err
+		}
+
+		var err error
+		session, err = q.UpdateSessionRefreshToken(ctx, UpdateSessionRefreshTokenParams{
+			SessionID:    arg.SessionID,
+			RefreshToken: arg.RefreshToken,
+			UserAgent:    arg.UserAgent,
+			ClientIp:     arg.ClientIp,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.CreateRefreshToken(ctx, CreateRefreshTokenParams{
+			TokenID:   arg.NewTokenID,
+			SessionID: arg.SessionID,
+			ExpiresAt: session.ExpiresAt,
+		})
+		return err
+	})
+	if err != nil {
+		return Session{}, err
+	}
+
+	return session, nil
+}
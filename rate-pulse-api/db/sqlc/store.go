@@ -12,6 +12,12 @@ type Store interface {
 	PingContext(ctx context.Context) error
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
 	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
+	CreateSessionTx(ctx context.Context, arg CreateSessionParams) (Session, error)
+	RotateSessionTx(ctx context.Context, arg RotateSessionTxParams) (Session, error)
+	ResetPasswordTx(ctx context.Context, arg ResetPasswordTxParams) (ResetPasswordTxResult, error)
+	ConfirmTOTPTx(ctx context.Context, arg ConfirmTOTPTxParams) (UserTotp, error)
+	ReplaceRecoveryCodesTx(ctx context.Context, arg ReplaceRecoveryCodesTxParams) error
+	DisableTOTPTx(ctx context.Context, userID int32) error
 	RefreshExchangeRatesTx(ctx context.Context, arg RefreshExchangeRatesParams) (RefreshExchangeRatesResult, error)
 }
 
@@ -61,7 +67,10 @@ func (store *SQLStore) VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParam
 			return err
 		}
 
-		user, err := q.UpdateUserEmailVerified(ctx, verifyEmail.UserID)
+		user, err := q.UpdateUserEmailVerified(ctx, UpdateUserEmailVerifiedParams{
+			UserID: verifyEmail.UserID,
+			Email:  verifyEmail.Email,
+		})
 		if err != nil {
 			return err
 		}
@@ -95,44 +104,3 @@ func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) erro
 
 	return tx.Commit()
 }
-
-// RefreshExchangeRatesParams defines the input for refreshing exchange rates
-// in a single, atomic database transaction.
-type RefreshExchangeRatesParams struct {
-	Rates []CreateExchangeRateParams
-}
-
-// RefreshExchangeRatesResult contains the exchange rates created by the refresh.
-type RefreshExchangeRatesResult struct {
-	Rates []ExchangeRate
-}
-
-// RefreshExchangeRatesTx clears existing exchange rates and inserts the provided
-// list of rates in a single transaction. If any insert fails, the whole
-// operation is rolled back.
-func (store *SQLStore) RefreshExchangeRatesTx(ctx context.Context, arg RefreshExchangeRatesParams) (RefreshExchangeRatesResult, error) {
-	var result RefreshExchangeRatesResult
-
-	err := store.execTx(ctx, func(q *Queries) error {
-		// Clear all existing exchange rates. You can change this to delete by source
-		// or use a softer "invalidate" strategy if needed.
-		if err := q.DeleteAllExchangeRates(ctx); err != nil {
-			return err
-		}
-
-		for _, r := range arg.Rates {
-			rate, err := q.CreateExchangeRate(ctx, r)
-			if err != nil {
-				return err
-			}
-			result.Rates = append(result.Rates, rate)
-		}
-
-		return nil
-	})
-	if err != nil {
-		return RefreshExchangeRatesResult{}, err
-	}
-
-	return result, nil
-}
@@ -184,20 +184,274 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User,
 	return i, err
 }
 
-const listUsers = `-- name: ListUsers :many
+const listActiveAdminEmails = `-- name: ListActiveAdminEmails :many
+SELECT email FROM users
+WHERE user_type = 'admin' AND is_active IS NOT FALSE
+ORDER BY user_id
+`
+
+func (q *Queries) ListActiveAdminEmails(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveAdminEmails)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		items = append(items, email)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersByCreatedAt = `-- name: ListUsersByCreatedAt :many
 SELECT user_id, username, email, password, user_type, email_verified, time_zone, language_preference, country_of_residence, country_of_birth, is_active, created_at, updated_at, first_name, last_name FROM users
-ORDER BY created_at 
-LIMIT $1
-OFFSET $2
+WHERE ($1::varchar IS NULL OR user_type = $1)
+  AND ($2::boolean IS NULL OR (is_active IS NOT FALSE) = $2)
+  AND ($3::timestamptz IS NULL OR created_at >= $3)
+  AND ($4::timestamptz IS NULL OR created_at < $4)
+  AND (
+    $5::integer IS NULL
+    OR (COALESCE(created_at, 'epoch'), user_id) > ($6::timestamptz, $5)
+  )
+ORDER BY COALESCE(created_at, 'epoch'), user_id
+LIMIT $7
 `
 
-type ListUsersParams struct {
-	Limit  int32
-	Offset int32
+type ListUsersByCreatedAtParams struct {
+	UserType    sql.NullString
+	IsActive    sql.NullBool
+	CreatedFrom sql.NullTime
+	CreatedTo   sql.NullTime
+	AfterID     sql.NullInt32
+	AfterTime   sql.NullTime
+	PageLimit   int32
 }
 
-func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error) {
-	rows, err := q.db.QueryContext(ctx, listUsers, arg.Limit, arg.Offset)
+// Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+// both the WHERE and the ORDER BY. after_id/after_time are the last row of the previous page.
+func (q *Queries) ListUsersByCreatedAt(ctx context.Context, arg ListUsersByCreatedAtParams) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, listUsersByCreatedAt,
+		arg.UserType,
+		arg.IsActive,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.AfterID,
+		arg.AfterTime,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.Email,
+			&i.Password,
+			&i.UserType,
+			&i.EmailVerified,
+			&i.TimeZone,
+			&i.LanguagePreference,
+			&i.CountryOfResidence,
+			&i.CountryOfBirth,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FirstName,
+			&i.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersByCreatedAtDesc = `-- name: ListUsersByCreatedAtDesc :many
+SELECT user_id, username, email, password, user_type, email_verified, time_zone, language_preference, country_of_residence, country_of_birth, is_active, created_at, updated_at, first_name, last_name FROM users
+WHERE ($1::varchar IS NULL OR user_type = $1)
+  AND ($2::boolean IS NULL OR (is_active IS NOT FALSE) = $2)
+  AND ($3::timestamptz IS NULL OR created_at >= $3)
+  AND ($4::timestamptz IS NULL OR created_at < $4)
+  AND (
+    $5::integer IS NULL
+    OR (COALESCE(created_at, 'epoch'), user_id) < ($6::timestamptz, $5)
+  )
+ORDER BY COALESCE(created_at, 'epoch') DESC, user_id DESC
+LIMIT $7
+`
+
+type ListUsersByCreatedAtDescParams struct {
+	UserType    sql.NullString
+	IsActive    sql.NullBool
+	CreatedFrom sql.NullTime
+	CreatedTo   sql.NullTime
+	AfterID     sql.NullInt32
+	AfterTime   sql.NullTime
+	PageLimit   int32
+}
+
+func (q *Queries) ListUsersByCreatedAtDesc(ctx context.Context, arg ListUsersByCreatedAtDescParams) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, listUsersByCreatedAtDesc,
+		arg.UserType,
+		arg.IsActive,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.AfterID,
+		arg.AfterTime,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.Email,
+			&i.Password,
+			&i.UserType,
+			&i.EmailVerified,
+			&i.TimeZone,
+			&i.LanguagePreference,
+			&i.CountryOfResidence,
+			&i.CountryOfBirth,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FirstName,
+			&i.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersByID = `-- name: ListUsersByID :many
+SELECT user_id, username, email, password, user_type, email_verified, time_zone, language_preference, country_of_residence, country_of_birth, is_active, created_at, updated_at, first_name, last_name FROM users
+WHERE ($1::varchar IS NULL OR user_type = $1)
+  AND ($2::boolean IS NULL OR (is_active IS NOT FALSE) = $2)
+  AND ($3::timestamptz IS NULL OR created_at >= $3)
+  AND ($4::timestamptz IS NULL OR created_at < $4)
+  AND ($5::integer IS NULL OR user_id > $5)
+ORDER BY user_id
+LIMIT $6
+`
+
+type ListUsersByIDParams struct {
+	UserType    sql.NullString
+	IsActive    sql.NullBool
+	CreatedFrom sql.NullTime
+	CreatedTo   sql.NullTime
+	AfterID     sql.NullInt32
+	PageLimit   int32
+}
+
+func (q *Queries) ListUsersByID(ctx context.Context, arg ListUsersByIDParams) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, listUsersByID,
+		arg.UserType,
+		arg.IsActive,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.Email,
+			&i.Password,
+			&i.UserType,
+			&i.EmailVerified,
+			&i.TimeZone,
+			&i.LanguagePreference,
+			&i.CountryOfResidence,
+			&i.CountryOfBirth,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FirstName,
+			&i.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersByIDDesc = `-- name: ListUsersByIDDesc :many
+SELECT user_id, username, email, password, user_type, email_verified, time_zone, language_preference, country_of_residence, country_of_birth, is_active, created_at, updated_at, first_name, last_name FROM users
+WHERE ($1::varchar IS NULL OR user_type = $1)
+  AND ($2::boolean IS NULL OR (is_active IS NOT FALSE) = $2)
+  AND ($3::timestamptz IS NULL OR created_at >= $3)
+  AND ($4::timestamptz IS NULL OR created_at < $4)
+  AND ($5::integer IS NULL OR user_id < $5)
+ORDER BY user_id DESC
+LIMIT $6
+`
+
+type ListUsersByIDDescParams struct {
+	UserType    sql.NullString
+	IsActive    sql.NullBool
+	CreatedFrom sql.NullTime
+	CreatedTo   sql.NullTime
+	AfterID     sql.NullInt32
+	PageLimit   int32
+}
+
+func (q *Queries) ListUsersByIDDesc(ctx context.Context, arg ListUsersByIDDescParams) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, listUsersByIDDesc,
+		arg.UserType,
+		arg.IsActive,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.AfterID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -242,7 +496,7 @@ SET
     email = COALESCE($2, email),
     password = COALESCE($3, password),
     user_type = COALESCE($4, user_type),
-    email_verified = COALESCE($5, email_verified),
+    email_verified = COALESCE($5, email_verified AND COALESCE($2, email) = email),
     time_zone = COALESCE($6, time_zone),
     language_preference = COALESCE($7, language_preference),
     country_of_residence = COALESCE($8, country_of_residence),
@@ -271,6 +525,7 @@ type UpdateUserParams struct {
 	UserID             int32
 }
 
+// A new email is unverified unless the caller sets email_verified itself.
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
 	row := q.db.QueryRowContext(ctx, updateUser,
 		arg.Username,
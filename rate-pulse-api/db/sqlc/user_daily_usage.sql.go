@@ -0,0 +1,94 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: user_daily_usage.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const getDailyUsage = `-- name: GetDailyUsage :one
+SELECT COALESCE(
+    (SELECT request_count FROM user_daily_usage WHERE user_id = $1 AND usage_date = $2),
+    0
+)::INT AS request_count
+`
+
+type GetDailyUsageParams struct {
+	UserID    int32
+	UsageDate time.Time
+}
+
+func (q *Queries) GetDailyUsage(ctx context.Context, arg GetDailyUsageParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getDailyUsage, arg.UserID, arg.UsageDate)
+	var request_count int32
+	err := row.Scan(&request_count)
+	return request_count, err
+}
+
+const getUserPlanLimits = `-- name: GetUserPlanLimits :one
+SELECT
+    sp.plan_id,
+    sp.plan_name,
+    sp.historical_days,
+    sp.rate_limit_per_day
+FROM user_subscriptions us
+JOIN subscription_plans sp ON sp.plan_id = us.plan_id
+WHERE us.user_id = $1 AND us.status = 'active'
+ORDER BY us.start_date DESC
+LIMIT 1
+`
+
+type GetUserPlanLimitsRow struct {
+	PlanID          int32
+	PlanName        string
+	HistoricalDays  int32
+	RateLimitPerDay int32
+}
+
+// The plan behind the user's current active subscription, as GetActiveUserSubscriptionByUserID picks it.
+func (q *Queries) GetUserPlanLimits(ctx context.Context, userID int32) (GetUserPlanLimitsRow, error) {
+	row := q.db.QueryRowContext(ctx, getUserPlanLimits, userID)
+	var i GetUserPlanLimitsRow
+	err := row.Scan(
+		&i.PlanID,
+		&i.PlanName,
+		&i.HistoricalDays,
+		&i.RateLimitPerDay,
+	)
+	return i, err
+}
+
+const incrementDailyUsage = `-- name: IncrementDailyUsage :one
+INSERT INTO user_daily_usage (
+    user_id,
+    usage_date,
+    request_count
+) VALUES (
+    $1, $2, 1
+)
+ON CONFLICT (user_id, usage_date) DO UPDATE
+SET
+    request_count = user_daily_usage.request_count + 1,
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_daily_usage.request_count < $3::INT
+RETURNING request_count
+`
+
+type IncrementDailyUsageParams struct {
+	UserID     int32
+	UsageDate  time.Time
+	DailyLimit int32
+}
+
+// Counts one request unless the day's count already reached daily_limit; no row comes back
+// when the quota is exhausted, so the check and the increment cannot race.
+func (q *Queries) IncrementDailyUsage(ctx context.Context, arg IncrementDailyUsageParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, incrementDailyUsage, arg.UserID, arg.UsageDate, arg.DailyLimit)
+	var request_count int32
+	err := row.Scan(&request_count)
+	return request_count, err
+}
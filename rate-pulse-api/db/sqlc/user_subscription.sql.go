@@ -91,13 +91,36 @@ func (q *Queries) GetActiveUserSubscriptionByUserID(ctx context.Context, userID
 	return i, err
 }
 
-const getAllUserSubscriptions = `-- name: GetAllUserSubscriptions :many
+const getUserSubscriptionByID = `-- name: GetUserSubscriptionByID :one
+SELECT subscription_id, user_id, plan_id, status, start_date, end_date, auto_renew, created_at, updated_at FROM user_subscriptions
+WHERE subscription_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserSubscriptionByID(ctx context.Context, subscriptionID int32) (UserSubscription, error) {
+	row := q.db.QueryRowContext(ctx, getUserSubscriptionByID, subscriptionID)
+	var i UserSubscription
+	err := row.Scan(
+		&i.SubscriptionID,
+		&i.UserID,
+		&i.PlanID,
+		&i.Status,
+		&i.StartDate,
+		&i.EndDate,
+		&i.AutoRenew,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getUserSubscriptionsByStatus = `-- name: GetUserSubscriptionsByStatus :many
 SELECT subscription_id, user_id, plan_id, status, start_date, end_date, auto_renew, created_at, updated_at FROM user_subscriptions
+WHERE status = $1
 ORDER BY start_date DESC
 `
 
-func (q *Queries) GetAllUserSubscriptions(ctx context.Context) ([]UserSubscription, error) {
-	rows, err := q.db.QueryContext(ctx, getAllUserSubscriptions)
+func (q *Queries) GetUserSubscriptionsByStatus(ctx context.Context, status sql.NullString) ([]UserSubscription, error) {
+	rows, err := q.db.QueryContext(ctx, getUserSubscriptionsByStatus, status)
 	if err != nil {
 		return nil, err
 	}
@@ -129,36 +152,134 @@ func (q *Queries) GetAllUserSubscriptions(ctx context.Context) ([]UserSubscripti
 	return items, nil
 }
 
-const getUserSubscriptionByID = `-- name: GetUserSubscriptionByID :one
+const getUserSubscriptionsByUserID = `-- name: GetUserSubscriptionsByUserID :many
 SELECT subscription_id, user_id, plan_id, status, start_date, end_date, auto_renew, created_at, updated_at FROM user_subscriptions
-WHERE subscription_id = $1 LIMIT 1
+WHERE user_id = $1
+ORDER BY start_date DESC
 `
 
-func (q *Queries) GetUserSubscriptionByID(ctx context.Context, subscriptionID int32) (UserSubscription, error) {
-	row := q.db.QueryRowContext(ctx, getUserSubscriptionByID, subscriptionID)
-	var i UserSubscription
-	err := row.Scan(
-		&i.SubscriptionID,
-		&i.UserID,
-		&i.PlanID,
-		&i.Status,
-		&i.StartDate,
-		&i.EndDate,
-		&i.AutoRenew,
-		&i.CreatedAt,
-		&i.UpdatedAt,
+func (q *Queries) GetUserSubscriptionsByUserID(ctx context.Context, userID int32) ([]UserSubscription, error) {
+	rows, err := q.db.QueryContext(ctx, getUserSubscriptionsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserSubscription
+	for rows.Next() {
+		var i UserSubscription
+		if err := rows.Scan(
+			&i.SubscriptionID,
+			&i.UserID,
+			&i.PlanID,
+			&i.Status,
+			&i.StartDate,
+			&i.EndDate,
+			&i.AutoRenew,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserSubscriptionsByID = `-- name: ListUserSubscriptionsByID :many
+SELECT subscription_id, user_id, plan_id, status, start_date, end_date, auto_renew, created_at, updated_at FROM user_subscriptions
+WHERE ($1::varchar IS NULL OR status = $1)
+  AND ($2::integer IS NULL OR plan_id = $2)
+  AND ($3::timestamptz IS NULL OR start_date >= $3)
+  AND ($4::timestamptz IS NULL OR start_date < $4)
+  AND ($5::integer IS NULL OR subscription_id > $5)
+ORDER BY subscription_id
+LIMIT $6
+`
+
+type ListUserSubscriptionsByIDParams struct {
+	Status    sql.NullString
+	PlanID    sql.NullInt32
+	DateFrom  sql.NullTime
+	DateTo    sql.NullTime
+	AfterID   sql.NullInt32
+	PageLimit int32
+}
+
+func (q *Queries) ListUserSubscriptionsByID(ctx context.Context, arg ListUserSubscriptionsByIDParams) ([]UserSubscription, error) {
+	rows, err := q.db.QueryContext(ctx, listUserSubscriptionsByID,
+		arg.Status,
+		arg.PlanID,
+		arg.DateFrom,
+		arg.DateTo,
+		arg.AfterID,
+		arg.PageLimit,
 	)
-	return i, err
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserSubscription
+	for rows.Next() {
+		var i UserSubscription
+		if err := rows.Scan(
+			&i.SubscriptionID,
+			&i.UserID,
+			&i.PlanID,
+			&i.Status,
+			&i.StartDate,
+			&i.EndDate,
+			&i.AutoRenew,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getUserSubscriptionsByStatus = `-- name: GetUserSubscriptionsByStatus :many
+const listUserSubscriptionsByIDDesc = `-- name: ListUserSubscriptionsByIDDesc :many
 SELECT subscription_id, user_id, plan_id, status, start_date, end_date, auto_renew, created_at, updated_at FROM user_subscriptions
-WHERE status = $1
-ORDER BY start_date DESC
+WHERE ($1::varchar IS NULL OR status = $1)
+  AND ($2::integer IS NULL OR plan_id = $2)
+  AND ($3::timestamptz IS NULL OR start_date >= $3)
+  AND ($4::timestamptz IS NULL OR start_date < $4)
+  AND ($5::integer IS NULL OR subscription_id < $5)
+ORDER BY subscription_id DESC
+LIMIT $6
 `
 
-func (q *Queries) GetUserSubscriptionsByStatus(ctx context.Context, status sql.NullString) ([]UserSubscription, error) {
-	rows, err := q.db.QueryContext(ctx, getUserSubscriptionsByStatus, status)
+type ListUserSubscriptionsByIDDescParams struct {
+	Status    sql.NullString
+	PlanID    sql.NullInt32
+	DateFrom  sql.NullTime
+	DateTo    sql.NullTime
+	AfterID   sql.NullInt32
+	PageLimit int32
+}
+
+func (q *Queries) ListUserSubscriptionsByIDDesc(ctx context.Context, arg ListUserSubscriptionsByIDDescParams) ([]UserSubscription, error) {
+	rows, err := q.db.QueryContext(ctx, listUserSubscriptionsByIDDesc,
+		arg.Status,
+		arg.PlanID,
+		arg.DateFrom,
+		arg.DateTo,
+		arg.AfterID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -190,14 +311,107 @@ func (q *Queries) GetUserSubscriptionsByStatus(ctx context.Context, status sql.N
 	return items, nil
 }
 
-const getUserSubscriptionsByUserID = `-- name: GetUserSubscriptionsByUserID :many
+const listUserSubscriptionsByStartDate = `-- name: ListUserSubscriptionsByStartDate :many
 SELECT subscription_id, user_id, plan_id, status, start_date, end_date, auto_renew, created_at, updated_at FROM user_subscriptions
-WHERE user_id = $1
-ORDER BY start_date DESC
+WHERE ($1::varchar IS NULL OR status = $1)
+  AND ($2::integer IS NULL OR plan_id = $2)
+  AND ($3::timestamptz IS NULL OR start_date >= $3)
+  AND ($4::timestamptz IS NULL OR start_date < $4)
+  AND (
+    $5::integer IS NULL
+    OR (start_date, subscription_id) > ($6::timestamptz, $5)
+  )
+ORDER BY start_date, subscription_id
+LIMIT $7
 `
 
-func (q *Queries) GetUserSubscriptionsByUserID(ctx context.Context, userID int32) ([]UserSubscription, error) {
-	rows, err := q.db.QueryContext(ctx, getUserSubscriptionsByUserID, userID)
+type ListUserSubscriptionsByStartDateParams struct {
+	Status    sql.NullString
+	PlanID    sql.NullInt32
+	DateFrom  sql.NullTime
+	DateTo    sql.NullTime
+	AfterID   sql.NullInt32
+	AfterTime sql.NullTime
+	PageLimit int32
+}
+
+// Keyset pagination: there is one query per sort order so the (sort key, id) index serves
+// both the WHERE and the ORDER BY. after_id/after_time are the last row of the previous page.
+func (q *Queries) ListUserSubscriptionsByStartDate(ctx context.Context, arg ListUserSubscriptionsByStartDateParams) ([]UserSubscription, error) {
+	rows, err := q.db.QueryContext(ctx, listUserSubscriptionsByStartDate,
+		arg.Status,
+		arg.PlanID,
+		arg.DateFrom,
+		arg.DateTo,
+		arg.AfterID,
+		arg.AfterTime,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserSubscription
+	for rows.Next() {
+		var i UserSubscription
+		if err := rows.Scan(
+			&i.SubscriptionID,
+			&i.UserID,
+			&i.PlanID,
+			&i.Status,
+			&i.StartDate,
+			&i.EndDate,
+			&i.AutoRenew,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserSubscriptionsByStartDateDesc = `-- name: ListUserSubscriptionsByStartDateDesc :many
+SELECT subscription_id, user_id, plan_id, status, start_date, end_date, auto_renew, created_at, updated_at FROM user_subscriptions
+WHERE ($1::varchar IS NULL OR status = $1)
+  AND ($2::integer IS NULL OR plan_id = $2)
+  AND ($3::timestamptz IS NULL OR start_date >= $3)
+  AND ($4::timestamptz IS NULL OR start_date < $4)
+  AND (
+    $5::integer IS NULL
+    OR (start_date, subscription_id) < ($6::timestamptz, $5)
+  )
+ORDER BY start_date DESC, subscription_id DESC
+LIMIT $7
+`
+
+type ListUserSubscriptionsByStartDateDescParams struct {
+	Status    sql.NullString
+	PlanID    sql.NullInt32
+	DateFrom  sql.NullTime
+	DateTo    sql.NullTime
+	AfterID   sql.NullInt32
+	AfterTime sql.NullTime
+	PageLimit int32
+}
+
+func (q *Queries) ListUserSubscriptionsByStartDateDesc(ctx context.Context, arg ListUserSubscriptionsByStartDateDescParams) ([]UserSubscription, error) {
+	rows, err := q.db.QueryContext(ctx, listUserSubscriptionsByStartDateDesc,
+		arg.Status,
+		arg.PlanID,
+		arg.DateFrom,
+		arg.DateTo,
+		arg.AfterID,
+		arg.AfterTime,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
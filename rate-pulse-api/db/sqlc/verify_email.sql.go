@@ -84,6 +84,7 @@ SET
     updated_at = CURRENT_TIMESTAMP
 WHERE
     user_id = $1
+    AND email = $2
 RETURNING
     user_id,
     username,
@@ -102,8 +103,14 @@ RETURNING
     last_name
 `
 
-func (q *Queries) UpdateUserEmailVerified(ctx context.Context, userID int32) (User, error) {
-	row := q.db.QueryRowContext(ctx, updateUserEmailVerified, userID)
+type UpdateUserEmailVerifiedParams struct {
+	UserID int32
+	Email  string
+}
+
+// Only verifies the address the link was sent to, not one the user has since changed to.
+func (q *Queries) UpdateUserEmailVerified(ctx context.Context, arg UpdateUserEmailVerifiedParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, updateUserEmailVerified, arg.UserID, arg.Email)
 	var i User
 	err := row.Scan(
 		&i.UserID,
@@ -2,7 +2,7 @@ package docs
 
 import _ "embed"
 
-// SwaggerJSON is generated from the protobuf definitions by `make swagger`.
+// SwaggerJSON is generated from the protobuf definitions by `make protoc`.
 //
 //go:embed api.swagger.json
 var SwaggerJSON []byte
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/ThanhVinhTong/rate-pulse/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type contextKey string
@@ -30,3 +32,24 @@ func authorizationPayloadFromContext(ctx context.Context) (*token.Payload, bool)
 	payload, ok := ctx.Value(authorizationPayloadContextKey).(*token.Payload)
 	return payload, ok
 }
+
+// requireAuthorizationPayload returns the payload authInterceptor stored for protected methods.
+func requireAuthorizationPayload(ctx context.Context) (*token.Payload, error) {
+	payload, ok := authorizationPayloadFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "access token is required")
+	}
+	return payload, nil
+}
+
+// requireOwnUser lets callers reach only their own user, or any user when adminAllowed and the caller is an admin.
+func requireOwnUser(ctx context.Context, userID int32, adminAllowed bool) error {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return err
+	}
+	if payload.UserID == userID || (adminAllowed && payload.UserType == userTypeAdmin) {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "cannot access another user")
+}
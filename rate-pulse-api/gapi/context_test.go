@@ -0,0 +1,42 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequireOwnUser(t *testing.T) {
+	user := &token.Payload{UserID: 7, UserType: "free"}
+	admin := &token.Payload{UserID: 1, UserType: userTypeAdmin}
+
+	testCases := []struct {
+		name         string
+		payload      *token.Payload
+		userID       int32
+		adminAllowed bool
+		code         codes.Code
+	}{
+		{name: "NoPayload", userID: 7, code: codes.Unauthenticated},
+		{name: "OwnUser", payload: user, userID: 7, code: codes.OK},
+		{name: "OtherUser", payload: user, userID: 8, adminAllowed: true, code: codes.PermissionDenied},
+		{name: "AdminAllowed", payload: admin, userID: 7, adminAllowed: true, code: codes.OK},
+		{name: "AdminNotAllowed", payload: admin, userID: 7, code: codes.PermissionDenied},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.payload != nil {
+				ctx = contextWithAuthorizationPayload(ctx, tc.payload)
+			}
+
+			err := requireOwnUser(ctx, tc.userID, tc.adminAllowed)
+			require.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package gapi
 
 import (
+	"time"
+
 	pb "github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
 	"github.com/ThanhVinhTong/rate-pulse/service"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -21,6 +24,39 @@ func convertLatestExchangeRate(exchangeRate service.LatestExchangeRate) *pb.Late
 	}
 }
 
+func convertExchangeRateUpdate(update pubsub.RateUpdate) *pb.ExchangeRateUpdate {
+	return &pb.ExchangeRateUpdate{
+		RateId:                update.RateID,
+		RateValue:             update.RateValue,
+		SourceCurrencyId:      update.SourceCurrencyID,
+		DestinationCurrencyId: update.DestinationCurrencyID,
+		SourceId:              update.SourceID,
+		TypeId:                update.TypeID,
+		ValidFromDate:         timestamppb.New(update.ValidFromDate),
+		UpdatedAt:             timestamppb.New(update.UpdatedAt),
+	}
+}
+
+func convertIngestExchangeRatesResult(result service.IngestExchangeRatesResult) *pb.IngestExchangeRatesResponse {
+	rows := make([]*pb.IngestExchangeRateResult, len(result.Rows))
+	for i, row := range result.Rows {
+		rows[i] = &pb.IngestExchangeRateResult{
+			Index:  row.Index,
+			Status: row.Status,
+			RateId: row.RateID,
+			Reason: row.Reason,
+		}
+	}
+
+	return &pb.IngestExchangeRatesResponse{
+		Inserted:    result.Inserted,
+		Quarantined: result.Quarantined,
+		Duplicates:  result.Duplicates,
+		Rejected:    result.Rejected,
+		Rows:        rows,
+	}
+}
+
 func convertUser(user service.User) *pb.User {
 	return &pb.User{
 		UserId:             user.UserID,
@@ -39,3 +75,375 @@ func convertUser(user service.User) *pb.User {
 		UpdatedAt:          timestamppb.New(user.UpdatedAt),
 	}
 }
+
+func convertExchangeRate(exchangeRate service.ExchangeRate) *pb.ExchangeRate {
+	return &pb.ExchangeRate{
+		RateId:                exchangeRate.RateID,
+		RateValue:             exchangeRate.RateValue,
+		SourceCurrencyId:      exchangeRate.SourceCurrencyID,
+		DestinationCurrencyId: exchangeRate.DestinationCurrencyID,
+		ValidFromDate:         timestamppb.New(exchangeRate.ValidFromDate),
+		ValidToDate:           optionalTimestamp(exchangeRate.ValidToDate),
+		SourceId:              exchangeRate.SourceID,
+		TypeId:                exchangeRate.TypeID,
+		CreatedAt:             optionalTimestamp(exchangeRate.CreatedAt),
+		UpdatedAt:             optionalTimestamp(exchangeRate.UpdatedAt),
+		Status:                exchangeRate.Status,
+		QuarantineReason:      exchangeRate.QuarantineReason,
+	}
+}
+
+func convertExchangeRates(exchangeRates []service.ExchangeRate) []*pb.ExchangeRate {
+	pbExchangeRates := make([]*pb.ExchangeRate, len(exchangeRates))
+	for i, exchangeRate := range exchangeRates {
+		pbExchangeRates[i] = convertExchangeRate(exchangeRate)
+	}
+	return pbExchangeRates
+}
+
+func convertHistoricalDataPoint(point service.HistoricalDataPoint) *pb.HistoricalDataPoint {
+	return &pb.HistoricalDataPoint{
+		RateValue: point.RateValue,
+		UpdatedAt: timestamppb.New(point.UpdatedAt),
+		TypeId:    point.TypeID,
+	}
+}
+
+func convertCandle(candle service.Candle) *pb.Candle {
+	return &pb.Candle{
+		BucketStart: timestamppb.New(candle.BucketStart),
+		Open:        candle.Open,
+		High:        candle.High,
+		Low:         candle.Low,
+		Close:       candle.Close,
+		SampleCount: candle.SampleCount,
+	}
+}
+
+func convertQuote(quote service.Quote) *pb.Quote {
+	return &pb.Quote{
+		Amount:           quote.Amount,
+		FromCurrencyId:   quote.FromCurrencyID,
+		ToCurrencyId:     quote.ToCurrencyID,
+		SourceId:         quote.SourceID,
+		TypeId:           quote.TypeID,
+		TransactionType:  quote.TransactionType,
+		Channel:          quote.Channel,
+		RateId:           quote.RateID,
+		AppliedRate:      quote.AppliedRate,
+		GrossAmount:      quote.GrossAmount,
+		FeeRuleId:        quote.FeeRuleID,
+		FeeRate:          quote.FeeRate,
+		PercentageFee:    quote.PercentageFee,
+		FixedFee:         quote.FixedFee,
+		VatAmount:        quote.VatAmount,
+		VatIncluded:      quote.VatIncluded,
+		SwiftFee:         quote.SwiftFee,
+		SwiftFeeIncluded: quote.SwiftFeeIncluded,
+		TotalFees:        quote.TotalFees,
+		NetAmount:        quote.NetAmount,
+		EffectiveRate:    quote.EffectiveRate,
+		RateUpdatedAt:    timestampFromPointer(quote.RateUpdatedAt),
+	}
+}
+
+func convertRankedQuote(quote service.RankedQuote) *pb.RankedQuote {
+	return &pb.RankedQuote{
+		Rank:       quote.Rank,
+		SourceName: quote.SourceName,
+		SourceCode: quote.SourceCode,
+		Quote:      convertQuote(quote.Quote),
+	}
+}
+
+func convertCrossRate(crossRate service.CrossRate) *pb.GetCrossRateResponse {
+	legs := make([]*pb.CrossRateLeg, len(crossRate.Legs))
+	for i, leg := range crossRate.Legs {
+		legs[i] = &pb.CrossRateLeg{
+			RateId:         leg.RateID,
+			FromCurrencyId: leg.FromCurrencyID,
+			ToCurrencyId:   leg.ToCurrencyID,
+			Rate:           leg.Rate,
+			Inverted:       leg.Inverted,
+			RateUpdatedAt:  timestampFromPointer(leg.RateUpdatedAt),
+		}
+	}
+
+	return &pb.GetCrossRateResponse{
+		FromCurrencyId:  crossRate.FromCurrencyID,
+		ToCurrencyId:    crossRate.ToCurrencyID,
+		SourceId:        crossRate.SourceID,
+		TypeId:          crossRate.TypeID,
+		Rate:            crossRate.Rate,
+		Direct:          crossRate.Direct,
+		PivotCurrencyId: crossRate.PivotCurrencyID,
+		Path:            crossRate.Path,
+		Legs:            legs,
+	}
+}
+
+func convertSpreadValues(values service.SpreadValues) *pb.SpreadValues {
+	return &pb.SpreadValues{
+		BuyRate:   values.BuyRate,
+		SellRate:  values.SellRate,
+		MidRate:   values.MidRate,
+		Spread:    values.Spread,
+		SpreadBps: values.SpreadBps,
+	}
+}
+
+func convertExchangeRateSpreads(spreads service.ExchangeRateSpreads) *pb.GetSpreadsResponse {
+	pbSpreads := make([]*pb.RateSpread, len(spreads.Spreads))
+	for i, spread := range spreads.Spreads {
+		pbSpreads[i] = &pb.RateSpread{
+			Rank:       spread.Rank,
+			SourceId:   spread.SourceID,
+			SourceName: spread.SourceName,
+			SourceCode: spread.SourceCode,
+			Channel:    spread.Channel,
+			BuyTypeId:  spread.BuyTypeID,
+			SellTypeId: spread.SellTypeID,
+			BuyRateId:  spread.BuyRateID,
+			SellRateId: spread.SellRateID,
+			Values:     convertSpreadValues(spread.SpreadValues),
+			UpdatedAt:  timestampFromPointer(spread.UpdatedAt),
+		}
+	}
+
+	series := make([]*pb.SpreadSeries, len(spreads.Series))
+	for i, s := range spreads.Series {
+		points := make([]*pb.SpreadPoint, len(s.Points))
+		for j, point := range s.Points {
+			points[j] = &pb.SpreadPoint{
+				BucketStart: timestamppb.New(point.BucketStart),
+				Values:      convertSpreadValues(point.SpreadValues),
+			}
+		}
+		series[i] = &pb.SpreadSeries{
+			SourceId: s.SourceID,
+			Channel:  s.Channel,
+			Points:   points,
+		}
+	}
+
+	return &pb.GetSpreadsResponse{
+		SourceCurrencyId:      spreads.SourceCurrencyID,
+		DestinationCurrencyId: spreads.DestinationCurrencyID,
+		Spreads:               pbSpreads,
+		Series:                series,
+	}
+}
+
+func convertRateSourceFeeRule(rule service.RateSourceFeeRule) *pb.RateSourceFeeRule {
+	return &pb.RateSourceFeeRule{
+		FeeRuleId:          rule.FeeRuleID,
+		SourceId:           rule.SourceID,
+		TypeId:             rule.TypeID,
+		TransactionType:    rule.TransactionType,
+		Channel:            rule.Channel,
+		FeeRate:            rule.FeeRate,
+		FeeRateMin:         rule.FeeRateMin,
+		FeeRateMax:         rule.FeeRateMax,
+		FeeCurrencyId:      rule.FeeCurrencyID,
+		FixedFee:           rule.FixedFee,
+		MinFee:             rule.MinFee,
+		MaxFee:             rule.MaxFee,
+		VatRate:            rule.VatRate,
+		VatApplies:         rule.VatApplies,
+		FeeIncludesVat:     rule.FeeIncludesVat,
+		SwiftFee:           rule.SwiftFee,
+		SwiftFeeCurrencyId: rule.SwiftFeeCurrencyID,
+		SwiftFeeIncluded:   rule.SwiftFeeIncluded,
+		SourceUrl:          rule.SourceURL,
+		SourceNote:         rule.SourceNote,
+		EffectiveFrom:      timestamppb.New(rule.EffectiveFrom),
+		EffectiveTo:        timestampFromPointer(rule.EffectiveTo),
+		UpdatedAt:          timestampFromPointer(rule.UpdatedAt),
+		CreatedAt:          timestampFromPointer(rule.CreatedAt),
+	}
+}
+
+func convertRateAlert(alert service.RateAlert) *pb.RateAlert {
+	return &pb.RateAlert{
+		AlertId:         alert.AlertID,
+		UserId:          alert.UserID,
+		SourceId:        alert.SourceID,
+		TypeId:          alert.TypeID,
+		FromCurrencyId:  alert.FromCurrencyID,
+		ToCurrencyId:    alert.ToCurrencyID,
+		Condition:       alert.Condition,
+		Threshold:       alert.Threshold,
+		ChangePercent:   alert.ChangePercent,
+		WindowHours:     alert.WindowHours,
+		IsActive:        alert.IsActive,
+		LastRateValue:   alert.LastRateValue,
+		LastTriggeredAt: timestampFromPointer(alert.LastTriggeredAt),
+		CreatedAt:       timestamppb.New(alert.CreatedAt),
+		UpdatedAt:       timestamppb.New(alert.UpdatedAt),
+	}
+}
+
+func convertRateSourceFreshnessReport(report service.RateSourceFreshnessReport) *pb.GetRateSourceFreshnessResponse {
+	sources := make([]*pb.RateSourceFreshness, len(report.Sources))
+	for i, source := range report.Sources {
+		sources[i] = &pb.RateSourceFreshness{
+			SourceId:                source.SourceID,
+			SourceName:              source.SourceName,
+			SourceCode:              source.SourceCode,
+			SourceStatus:            source.SourceStatus,
+			ExpectedIntervalMinutes: source.ExpectedIntervalMinutes,
+			LastIngestedAt:          timestampFromPointer(source.LastIngestedAt),
+			StaleAt:                 timestamppb.New(source.StaleAt),
+			Stale:                   source.Stale,
+		}
+	}
+
+	return &pb.GetRateSourceFreshnessResponse{
+		Status:     report.Status,
+		CheckedAt:  timestamppb.New(report.CheckedAt),
+		StaleCount: report.StaleCount,
+		Sources:    sources,
+	}
+}
+
+func convertCurrency(currency service.Currency) *pb.Currency {
+	return &pb.Currency{
+		CurrencyId:     currency.CurrencyID,
+		CurrencyCode:   currency.CurrencyCode,
+		CurrencyName:   currency.CurrencyName,
+		CurrencySymbol: currency.CurrencySymbol,
+	}
+}
+
+func convertCountry(country service.Country) *pb.Country {
+	return &pb.Country{
+		CountryId:   country.CountryID,
+		CountryName: country.CountryName,
+		CountryCode: country.CountryCode,
+		CurrencyId:  country.CurrencyID,
+		UpdatedAt:   timestampFromPointer(country.UpdatedAt),
+		CreatedAt:   timestampFromPointer(country.CreatedAt),
+	}
+}
+
+func convertRateSource(source service.RateSource) *pb.RateSource {
+	return &pb.RateSource{
+		SourceId:      source.SourceID,
+		SourceName:    source.SourceName,
+		SourceLink:    source.SourceLink,
+		SourceCountry: source.SourceCountry,
+		SourceStatus:  source.SourceStatus,
+		SourceCode:    source.SourceCode,
+	}
+}
+
+func convertSubscriptionPlan(plan service.SubscriptionPlan) *pb.SubscriptionPlan {
+	return &pb.SubscriptionPlan{
+		PlanId:          plan.PlanID,
+		PlanName:        plan.PlanName,
+		PlanPrice:       plan.PlanPrice,
+		HistoricalDays:  plan.HistoricalDays,
+		RateLimitPerDay: plan.RateLimitPerDay,
+		Features:        plan.Features,
+		IsActive:        plan.IsActive,
+		CreatedAt:       timestampFromPointer(plan.CreatedAt),
+		UpdatedAt:       timestampFromPointer(plan.UpdatedAt),
+	}
+}
+
+func convertSubscriptionPlans(plans []service.SubscriptionPlan) []*pb.SubscriptionPlan {
+	res := make([]*pb.SubscriptionPlan, len(plans))
+	for i, plan := range plans {
+		res[i] = convertSubscriptionPlan(plan)
+	}
+	return res
+}
+
+func convertUserSubscription(subscription service.UserSubscription) *pb.UserSubscription {
+	return &pb.UserSubscription{
+		SubscriptionId: subscription.SubscriptionID,
+		UserId:         subscription.UserID,
+		PlanId:         subscription.PlanID,
+		Status:         subscription.Status,
+		StartDate:      timestamppb.New(subscription.StartDate),
+		EndDate:        timestampFromPointer(subscription.EndDate),
+		AutoRenew:      subscription.AutoRenew,
+		CreatedAt:      timestampFromPointer(subscription.CreatedAt),
+		UpdatedAt:      timestampFromPointer(subscription.UpdatedAt),
+	}
+}
+
+func convertUserSubscriptions(subscriptions []service.UserSubscription) []*pb.UserSubscription {
+	res := make([]*pb.UserSubscription, len(subscriptions))
+	for i, subscription := range subscriptions {
+		res[i] = convertUserSubscription(subscription)
+	}
+	return res
+}
+
+func convertPayment(payment service.Payment) *pb.Payment {
+	return &pb.Payment{
+		PaymentId:      payment.PaymentID,
+		SubscriptionId: payment.SubscriptionID,
+		TransactionId:  payment.TransactionID,
+		Amount:         payment.Amount,
+		CurrencyCode:   payment.CurrencyCode,
+		PaymentMethod:  payment.PaymentMethod,
+		PaymentStatus:  payment.PaymentStatus,
+		PaymentDate:    timestampFromPointer(payment.PaymentDate),
+		CreatedAt:      timestampFromPointer(payment.CreatedAt),
+		UpdatedAt:      timestampFromPointer(payment.UpdatedAt),
+	}
+}
+
+func convertPayments(payments []service.Payment) []*pb.Payment {
+	res := make([]*pb.Payment, len(payments))
+	for i, payment := range payments {
+		res[i] = convertPayment(payment)
+	}
+	return res
+}
+
+func convertCurrencyPreference(preference service.CurrencyPreference) *pb.CurrencyPreference {
+	return &pb.CurrencyPreference{
+		CurrencyId:   preference.CurrencyID,
+		UserId:       preference.UserID,
+		IsFavorite:   preference.IsFavorite,
+		DisplayOrder: preference.DisplayOrder,
+		UpdatedAt:    timestampFromPointer(preference.UpdatedAt),
+		CreatedAt:    timestampFromPointer(preference.CreatedAt),
+	}
+}
+
+func convertRateSourcePreference(preference service.RateSourcePreference) *pb.RateSourcePreference {
+	return &pb.RateSourcePreference{
+		SourceId:  preference.SourceID,
+		UserId:    preference.UserID,
+		IsPrimary: preference.IsPrimary,
+		UpdatedAt: timestampFromPointer(preference.UpdatedAt),
+		CreatedAt: timestampFromPointer(preference.CreatedAt),
+	}
+}
+
+// optionalTimestamp leaves unset times out of the response instead of sending year 1.
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func timestampFromPointer(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func timeFromTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
@@ -15,13 +15,19 @@ func statusFromServiceError(err error) error {
 		service.ErrInactiveUser.Code,
 		service.ErrSessionNotFound.Code,
 		service.ErrSessionBlocked.Code,
-		service.ErrSessionExpired.Code:
+		service.ErrSessionExpired.Code,
+		service.ErrRefreshTokenReused.Code:
 		return status.Error(codes.Unauthenticated, service.ServiceErrorMessage(err))
+	case service.ErrEmailNotVerified.Code,
+		service.ErrForbidden.Code:
+		return status.Error(codes.PermissionDenied, service.ServiceErrorMessage(err))
 	case service.ErrNotFound.Code:
 		return status.Error(codes.NotFound, service.ServiceErrorMessage(err))
 	case service.ErrDuplicateEmail.Code,
 		service.ErrDuplicateExchangeRate.Code:
 		return status.Error(codes.AlreadyExists, service.ServiceErrorMessage(err))
+	case service.ErrQuotaExceeded.Code:
+		return status.Error(codes.ResourceExhausted, service.ServiceErrorMessage(err))
 	default:
 		return status.Error(codes.Internal, service.ErrInternal.Message)
 	}
@@ -0,0 +1,134 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/textproto"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const gatewayListenerBufferSize = 1 << 20
+
+// GatewayListener is the in-memory listener the grpc-gateway dials. The gRPC server serves it
+// next to its TCP listener; only this process can connect to it.
+type GatewayListener struct {
+	*bufconn.Listener
+}
+
+func NewGatewayListener() *GatewayListener {
+	return &GatewayListener{Listener: bufconn.Listen(gatewayListenerBufferSize)}
+}
+
+// Accept marks every connection as coming from the gateway, see fromGateway.
+func (l *GatewayListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return gatewayConn{Conn: conn}, nil
+}
+
+func (l *GatewayListener) dial(ctx context.Context, _ string) (net.Conn, error) {
+	return l.Listener.DialContext(ctx)
+}
+
+// gatewayAddr is the peer address of connections accepted on a GatewayListener.
+type gatewayAddr struct{}
+
+func (gatewayAddr) Network() string { return "gateway" }
+func (gatewayAddr) String() string  { return "gateway" }
+
+type gatewayConn struct {
+	net.Conn
+}
+
+func (gatewayConn) RemoteAddr() net.Addr { return gatewayAddr{} }
+
+// fromGateway reports whether the call came through the GatewayListener. It checks the type of
+// the peer address, which a remote caller cannot choose, rather than its value.
+func fromGateway(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	_, ok = p.Addr.(gatewayAddr)
+	return ok
+}
+
+// gatewayRegistrations lists every service that has google.api.http bindings.
+// Routes registered later are matched first, so the rate source service, whose
+// /v2/rate-sources/{source_id} would swallow /v2/rate-sources/freshness, comes before health.
+var gatewayRegistrations = []func(context.Context, *runtime.ServeMux, string, []grpc.DialOption) error{
+	pb.RegisterRatePulseAuthenticationServiceHandlerFromEndpoint,
+	pb.RegisterRatePulseUserServiceHandlerFromEndpoint,
+	pb.RegisterRatePulseExchangeRateServiceHandlerFromEndpoint,
+	pb.RegisterRatePulseRateSourceFeeRuleServiceHandlerFromEndpoint,
+	pb.RegisterRatePulseRateAlertServiceHandlerFromEndpoint,
+	pb.RegisterRatePulseCurrencyServiceHandlerFromEndpoint,
+	pb.RegisterRatePulseCountryServiceHandlerFromEndpoint,
+	pb.RegisterRatePulseRateSourceServiceHandlerFromEndpoint,
+	pb.RegisterRatePulseSubscriptionServiceHandlerFromEndpoint,
+	pb.RegisterRatePulsePaymentServiceHandlerFromEndpoint,
+	pb.RegisterRatePulsePreferenceServiceHandlerFromEndpoint,
+	pb.RegisterRatePulseInternalHealthServiceHandlerFromEndpoint,
+}
+
+/*
+NewGatewayMux transcodes the google.api.http routes into calls on the gRPC server serving listener.
+- Requests go through the gRPC server so the interceptor chain (auth, admin checks, logging) applies
+- JSON uses the proto field names, matching the snake_case bodies of the Gin API
+- Authorization, x-api-key and x-request-id are forwarded as incoming metadata
+*/
+func NewGatewayMux(ctx context.Context, listener *GatewayListener) (*runtime.ServeMux, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+		runtime.WithIncomingHeaderMatcher(gatewayIncomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(gatewayOutgoingHeaderMatcher),
+	)
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(listener.dial),
+	}
+	for _, register := range gatewayRegistrations {
+		if err := register(ctx, mux, "passthrough:///gateway", opts); err != nil {
+			return nil, fmt.Errorf("failed to register gateway handler: %w", err)
+		}
+	}
+
+	return mux, nil
+}
+
+func gatewayIncomingHeaderMatcher(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case textproto.CanonicalMIMEHeaderKey(requestIDHeaderKey):
+		return requestIDHeaderKey, true
+	case textproto.CanonicalMIMEHeaderKey(apiKeyHeaderKey):
+		return apiKeyHeaderKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// gatewayOutgoingHeaderMatcher drops x-request-id because the HTTP front already echoes it.
+func gatewayOutgoingHeaderMatcher(key string) (string, bool) {
+	if key == requestIDHeaderKey {
+		return "", false
+	}
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
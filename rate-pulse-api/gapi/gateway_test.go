@@ -0,0 +1,128 @@
+package gapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/ratelimit"
+	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// gatewayTestUserServer echoes the requested user and records the metadata it was called with
+// and whether the call came through the gateway listener.
+type gatewayTestUserServer struct {
+	pb.UnimplementedRatePulseUserServiceServer
+	md      metadata.MD
+	gateway bool
+}
+
+func (server *gatewayTestUserServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	server.md, _ = metadata.FromIncomingContext(ctx)
+	server.gateway = fromGateway(ctx)
+	return &pb.GetUserResponse{User: &pb.User{UserId: req.GetUserId()}}, nil
+}
+
+func TestGatewayMuxTranscodesThroughInterceptors(t *testing.T) {
+	tokenMaker := newTestTokenMaker(t)
+	accessToken, _, err := tokenMaker.CreateToken(7, "user", "user@email.com", "free", time.Minute, token.TokenTypeAccess)
+	require.NoError(t, err)
+
+	listener := NewGatewayListener()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(UnaryServerInterceptor(tokenMaker, token.NewMemoryRevocationList(time.Minute), newTestAPIKeys(), newTestQuotas(), fakeUsers{}, nil, ratelimit.Policies{})))
+	userServer := &gatewayTestUserServer{}
+	pb.RegisterRatePulseUserServiceServer(grpcServer, userServer)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mux, err := NewGatewayMux(ctx, listener)
+	require.NoError(t, err)
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v2/users/7", nil))
+
+		require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/v2/users/7", nil)
+		request.Header.Set("Authorization", "Bearer "+accessToken)
+		request.Header.Set("X-Request-Id", "req-123")
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, request)
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		var body struct {
+			User struct {
+				UserID int32 `json:"user_id"`
+			} `json:"user"`
+		}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+		require.Equal(t, int32(7), body.User.UserID)
+		require.Equal(t, []string{"req-123"}, userServer.md.Get(requestIDHeaderKey))
+		require.True(t, userServer.gateway)
+		require.Empty(t, recorder.Header().Values("Grpc-Metadata-X-Request-Id"))
+	})
+}
+
+type gatewayTestRateSourceServer struct {
+	pb.UnimplementedRatePulseRateSourceServiceServer
+}
+
+func (server *gatewayTestRateSourceServer) GetRateSource(ctx context.Context, req *pb.GetRateSourceRequest) (*pb.GetRateSourceResponse, error) {
+	return &pb.GetRateSourceResponse{RateSource: &pb.RateSource{SourceId: req.GetSourceId()}}, nil
+}
+
+type gatewayTestHealthServer struct {
+	pb.UnimplementedRatePulseInternalHealthServiceServer
+}
+
+func (server *gatewayTestHealthServer) GetRateSourceFreshness(ctx context.Context, req *pb.GetRateSourceFreshnessRequest) (*pb.GetRateSourceFreshnessResponse, error) {
+	return &pb.GetRateSourceFreshnessResponse{Status: "ok"}, nil
+}
+
+func TestGatewayMuxRoutesRateSourceFreshnessBeforeSourceID(t *testing.T) {
+	tokenMaker := newTestTokenMaker(t)
+
+	listener := NewGatewayListener()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(UnaryServerInterceptor(tokenMaker, token.NewMemoryRevocationList(time.Minute), newTestAPIKeys(), newTestQuotas(), fakeUsers{}, nil, ratelimit.Policies{})))
+	pb.RegisterRatePulseRateSourceServiceServer(grpcServer, &gatewayTestRateSourceServer{})
+	pb.RegisterRatePulseInternalHealthServiceServer(grpcServer, &gatewayTestHealthServer{})
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mux, err := NewGatewayMux(ctx, listener)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v2/rate-sources/freshness", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var freshness struct {
+		Status string `json:"status"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &freshness))
+	require.Equal(t, "ok", freshness.Status)
+
+	recorder = httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v2/rate-sources/3", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var source struct {
+		RateSource struct {
+			SourceID int32 `json:"source_id"`
+		} `json:"rate_source"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &source))
+	require.Equal(t, int32(3), source.RateSource.SourceID)
+}
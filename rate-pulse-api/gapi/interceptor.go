@@ -1,9 +1,12 @@
 /*
-gRPC's middleware for:
+gRPC's middleware (unary and streaming) for:
 - Panic recovery.
 - Request ID extraction/generation.
 - Logging.
-- Bearer token verification.
+- Per-address rate limiting of the sign-up/sign-in methods.
+- Bearer token and API key verification.
+- Daily quota metering of rate reads.
+- Verified email gating of creating methods.
 - Public/protected RPC routing.
 - Auth payload in context is the right pattern.
 */
@@ -11,44 +14,162 @@ package gapi
 
 import (
 	"context"
+	"errors"
+	"math"
+	"net"
 	"runtime/debug"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/ratelimit"
+	"github.com/ThanhVinhTong/rate-pulse/service"
 	"github.com/ThanhVinhTong/rate-pulse/token"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
 const (
 	authorizationHeaderKey  = "authorization"
 	authorizationTypeBearer = "bearer"
+	apiKeyHeaderKey         = "x-api-key"
 	requestIDHeaderKey      = "x-request-id"
 	userTypeAdmin           = "admin"
 )
 
 var publicMethods = map[string]bool{
-	pb.RatePulseAuthenticationService_CreateUser_FullMethodName:           true,
-	pb.RatePulseAuthenticationService_SignInUser_FullMethodName:           true,
-	pb.RatePulseAuthenticationService_RenewAccessToken_FullMethodName:     true,
-	pb.RatePulseExchangeRateService_GetLatestExchangeRates_FullMethodName: true,
+	pb.RatePulseAuthenticationService_CreateUser_FullMethodName:                    true,
+	pb.RatePulseAuthenticationService_SignInUser_FullMethodName:                    true,
+	pb.RatePulseAuthenticationService_CompleteSignInMFA_FullMethodName:             true,
+	pb.RatePulseAuthenticationService_EnrollSignInTOTP_FullMethodName:              true,
+	pb.RatePulseAuthenticationService_RenewAccessToken_FullMethodName:              true,
+	pb.RatePulseAuthenticationService_VerifyEmail_FullMethodName:                   true,
+	pb.RatePulseAuthenticationService_SignOutUser_FullMethodName:                   true,
+	pb.RatePulseExchangeRateService_GetLatestExchangeRates_FullMethodName:          true,
+	pb.RatePulseExchangeRateService_GetExchangeRate_FullMethodName:                 true,
+	pb.RatePulseExchangeRateService_GetCandles_FullMethodName:                      true,
+	pb.RatePulseExchangeRateService_GetCrossRate_FullMethodName:                    true,
+	pb.RatePulseExchangeRateService_GetSpreads_FullMethodName:                      true,
+	pb.RatePulseExchangeRateService_CreateQuote_FullMethodName:                     true,
+	pb.RatePulseExchangeRateService_CompareQuotes_FullMethodName:                   true,
+	pb.RatePulseRateSourceFeeRuleService_GetRateSourceFeeRule_FullMethodName:       true,
+	pb.RatePulseRateSourceFeeRuleService_ListRateSourceFeeRules_FullMethodName:     true,
+	pb.RatePulseRateSourceFeeRuleService_GetActiveRateSourceFeeRule_FullMethodName: true,
+	pb.RatePulseInternalHealthService_GetRateSourceFreshness_FullMethodName:        true,
+	pb.RatePulseCurrencyService_ListCurrencies_FullMethodName:                      true,
+	pb.RatePulseCurrencyService_GetCurrency_FullMethodName:                         true,
+	pb.RatePulseCountryService_ListCountries_FullMethodName:                        true,
+	pb.RatePulseCountryService_GetCountry_FullMethodName:                           true,
+	pb.RatePulseCountryService_GetCountryByCode_FullMethodName:                     true,
+	pb.RatePulseRateSourceService_ListRateSources_FullMethodName:                   true,
+	pb.RatePulseRateSourceService_GetRateSource_FullMethodName:                     true,
+	pb.RatePulseSubscriptionService_ListSubscriptionPlans_FullMethodName:           true,
+	pb.RatePulseSubscriptionService_GetSubscriptionPlan_FullMethodName:             true,
+}
+
+// optionalAuthMethods are public, but a caller that sends a token must send a valid one.
+var optionalAuthMethods = map[string]bool{
+	pb.RatePulseExchangeRateService_GetHistoricalData_FullMethodName: true,
 }
 
 var adminMethods = map[string]bool{
-	pb.RatePulseInternalHealthService_CheckHealth_FullMethodName: true,
+	pb.RatePulseInternalHealthService_CheckHealth_FullMethodName:                 true,
+	pb.RatePulseExchangeRateService_IngestExchangeRates_FullMethodName:           true,
+	pb.RatePulseExchangeRateService_CreateExchangeRate_FullMethodName:            true,
+	pb.RatePulseExchangeRateService_UpdateExchangeRate_FullMethodName:            true,
+	pb.RatePulseExchangeRateService_DeleteExchangeRate_FullMethodName:            true,
+	pb.RatePulseExchangeRateService_ListQuarantinedExchangeRates_FullMethodName:  true,
+	pb.RatePulseExchangeRateService_ReviewQuarantinedExchangeRate_FullMethodName: true,
+	pb.RatePulseUserService_ListUsers_FullMethodName:                             true,
+	pb.RatePulseUserService_AdminUpdateUser_FullMethodName:                       true,
+	pb.RatePulseUserService_DeleteUser_FullMethodName:                            true,
+	pb.RatePulseRateSourceFeeRuleService_CreateRateSourceFeeRule_FullMethodName:  true,
+	pb.RatePulseRateSourceFeeRuleService_UpdateRateSourceFeeRule_FullMethodName:  true,
+	pb.RatePulseRateSourceFeeRuleService_DeleteRateSourceFeeRule_FullMethodName:  true,
+	pb.RatePulseCurrencyService_CreateCurrency_FullMethodName:                    true,
+	pb.RatePulseCurrencyService_UpdateCurrency_FullMethodName:                    true,
+	pb.RatePulseCurrencyService_DeleteCurrency_FullMethodName:                    true,
+	pb.RatePulseCountryService_CreateCountry_FullMethodName:                      true,
+	pb.RatePulseCountryService_UpdateCountry_FullMethodName:                      true,
+	pb.RatePulseCountryService_DeleteCountry_FullMethodName:                      true,
+	pb.RatePulseRateSourceService_CreateRateSource_FullMethodName:                true,
+	pb.RatePulseRateSourceService_UpdateRateSource_FullMethodName:                true,
+	pb.RatePulseRateSourceService_DeleteRateSource_FullMethodName:                true,
+	pb.RatePulseSubscriptionService_ListAllSubscriptionPlans_FullMethodName:      true,
+	pb.RatePulseSubscriptionService_CreateSubscriptionPlan_FullMethodName:        true,
+	pb.RatePulseSubscriptionService_UpdateSubscriptionPlan_FullMethodName:        true,
+	pb.RatePulseSubscriptionService_DeleteSubscriptionPlan_FullMethodName:        true,
+	pb.RatePulseSubscriptionService_AdminListUserSubscriptions_FullMethodName:    true,
+	pb.RatePulseSubscriptionService_UpdateUserSubscription_FullMethodName:        true,
+	pb.RatePulseSubscriptionService_DeleteUserSubscription_FullMethodName:        true,
+	pb.RatePulsePaymentService_CreatePayment_FullMethodName:                      true,
+	pb.RatePulsePaymentService_AdminListPayments_FullMethodName:                  true,
+	pb.RatePulsePaymentService_AdminGetPayment_FullMethodName:                    true,
+	pb.RatePulsePaymentService_UpdatePayment_FullMethodName:                      true,
+	pb.RatePulsePaymentService_DeletePayment_FullMethodName:                      true,
+}
+
+// rateReadMethods maps each rate read to the scope an API key needs to call it. Signed-in callers
+// of these methods are metered against their plan's daily quota. Admin methods need the admin
+// scope; every other protected method rejects API keys.
+var rateReadMethods = map[string]string{
+	pb.RatePulseExchangeRateService_GetLatestExchangeRates_FullMethodName:          service.APIKeyScopeReadRates,
+	pb.RatePulseExchangeRateService_GetExchangeRate_FullMethodName:                 service.APIKeyScopeReadRates,
+	pb.RatePulseExchangeRateService_GetCandles_FullMethodName:                      service.APIKeyScopeReadRates,
+	pb.RatePulseExchangeRateService_GetCrossRate_FullMethodName:                    service.APIKeyScopeReadRates,
+	pb.RatePulseExchangeRateService_GetSpreads_FullMethodName:                      service.APIKeyScopeReadRates,
+	pb.RatePulseExchangeRateService_CreateQuote_FullMethodName:                     service.APIKeyScopeReadRates,
+	pb.RatePulseExchangeRateService_CompareQuotes_FullMethodName:                   service.APIKeyScopeReadRates,
+	pb.RatePulseExchangeRateService_WatchExchangeRates_FullMethodName:              service.APIKeyScopeReadRates,
+	pb.RatePulseRateSourceFeeRuleService_GetRateSourceFeeRule_FullMethodName:       service.APIKeyScopeReadRates,
+	pb.RatePulseRateSourceFeeRuleService_ListRateSourceFeeRules_FullMethodName:     service.APIKeyScopeReadRates,
+	pb.RatePulseRateSourceFeeRuleService_GetActiveRateSourceFeeRule_FullMethodName: service.APIKeyScopeReadRates,
+	pb.RatePulseInternalHealthService_GetRateSourceFreshness_FullMethodName:        service.APIKeyScopeReadRates,
+	pb.RatePulseExchangeRateService_GetHistoricalData_FullMethodName:               service.APIKeyScopeReadHistorical,
+}
+
+// verifiedEmailMethods need a caller whose users.email_verified is true, like the REST routes
+// behind verifiedEmailMiddleware.
+var verifiedEmailMethods = map[string]bool{
+	pb.RatePulseRateAlertService_CreateRateAlert_FullMethodName:           true,
+	pb.RatePulseSubscriptionService_CreateUserSubscription_FullMethodName: true,
 }
 
-func UnaryServerInterceptor(tokenMaker token.Maker) grpc.UnaryServerInterceptor {
+// rateLimitedMethods maps the methods that guess credentials or send email to the REST route
+// whose strict policy they share, so a caller has one budget across REST, /v2 and gRPC.
+var rateLimitedMethods = map[string]string{
+	pb.RatePulseAuthenticationService_CreateUser_FullMethodName:        "POST /users/signup",
+	pb.RatePulseAuthenticationService_SignInUser_FullMethodName:        "POST /users/signin",
+	pb.RatePulseAuthenticationService_CompleteSignInMFA_FullMethodName: "POST /users/signin/mfa",
+	pb.RatePulseAuthenticationService_EnrollSignInTOTP_FullMethodName:  "POST /users/signin/mfa/enroll",
+	pb.RatePulseAuthenticationService_RenewAccessToken_FullMethodName:  "POST /users/renew-access-token",
+	pb.RatePulseAuthenticationService_VerifyEmail_FullMethodName:       "POST /users/verify-email",
+}
+
+func UnaryServerInterceptor(
+	tokenMaker token.Maker,
+	revocations token.RevocationList,
+	apiKeys service.APIKeyUseCase,
+	quotas service.QuotaUseCase,
+	users service.UserUseCase,
+	limiter ratelimit.Limiter,
+	limits ratelimit.Policies,
+) grpc.UnaryServerInterceptor {
 	return chainUnaryInterceptors(
 		recoveryInterceptor(),
 		requestIDInterceptor(),
 		loggingInterceptor(),
-		authInterceptor(tokenMaker),
+		rateLimitInterceptor(limiter, limits),
+		authInterceptor(tokenMaker, revocations, apiKeys),
+		verifiedEmailInterceptor(users),
+		quotaInterceptor(quotas),
 	)
 }
 
@@ -85,12 +206,7 @@ func recoveryInterceptor() grpc.UnaryServerInterceptor {
 
 func requestIDInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		requestID := requestIDFromMetadata(ctx)
-		if requestID == "" {
-			requestID = uuid.NewString()
-		}
-
-		ctx = contextWithRequestID(ctx, requestID)
+		ctx, requestID := ensureRequestID(ctx)
 		grpc.SetHeader(ctx, metadata.Pairs(requestIDHeaderKey, requestID))
 		return handler(ctx, req)
 	}
@@ -100,50 +216,225 @@ func loggingInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		start := time.Now()
 		resp, err := handler(ctx, req)
-		code := status.Code(err)
+		logCompletedRPC(ctx, info.FullMethod, start, err, "grpc request completed")
+		return resp, err
+	}
+}
 
-		event := log.Info()
-		if err != nil {
-			event = log.Error().Err(err)
-		}
+func logCompletedRPC(ctx context.Context, fullMethod string, start time.Time, err error, msg string) {
+	code := status.Code(err)
 
-		event = event.
-			Str("method", info.FullMethod).
-			Str("request_id", requestIDFromContext(ctx)).
-			Str("status_code", code.String()).
-			Int64("latency_ms", time.Since(start).Milliseconds())
+	event := log.Info()
+	if err != nil {
+		event = log.Error().Err(err)
+	}
 
-		if payload, ok := authorizationPayloadFromContext(ctx); ok {
-			event = event.Int32("user_id", payload.UserID)
-		}
+	event = event.
+		Str("method", fullMethod).
+		Str("request_id", requestIDFromContext(ctx)).
+		Str("status_code", code.String()).
+		Int64("latency_ms", time.Since(start).Milliseconds())
 
-		event.Msg("grpc request completed")
-		return resp, nil
+	if payload, ok := authorizationPayloadFromContext(ctx); ok {
+		event = event.Int32("user_id", payload.UserID)
 	}
+
+	event.Msg(msg)
 }
 
-func authInterceptor(tokenMaker token.Maker) grpc.UnaryServerInterceptor {
+// rateLimitInterceptor applies the strict route policies to rateLimitedMethods, keyed by the
+// caller's address like anonymous REST requests. Calls through the GatewayListener come from the
+// in-process grpc-gateway, which the Gin rate limit middleware has already counted.
+func rateLimitInterceptor(limiter ratelimit.Limiter, limits ratelimit.Policies) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		if publicMethods[info.FullMethod] {
+		route, ok := rateLimitedMethods[info.FullMethod]
+		if !ok || limiter == nil || fromGateway(ctx) {
+			return handler(ctx, req)
+		}
+		clientIP, ok := peerIP(ctx)
+		if !ok {
 			return handler(ctx, req)
 		}
 
-		accessToken, err := accessTokenFromMetadata(ctx)
+		policy := limits.Select(route, "")
+		result, err := limiter.Allow(ctx, "ip:"+clientIP.String(), policy)
 		if err != nil {
-			return nil, err
+			log.Error().Err(err).Str("policy", policy.Name).Msg("rate limiter failed, allowing request")
+			return handler(ctx, req)
+		}
+		if !result.Allowed {
+			retryAfter := max(int64(math.Ceil(result.RetryAfter.Seconds())), 1)
+			grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.FormatInt(retryAfter, 10)))
+			return nil, status.Errorf(codes.ResourceExhausted, "too many requests, please retry after %d seconds", retryAfter)
 		}
+		return handler(ctx, req)
+	}
+}
+
+func peerIP(ctx context.Context) (net.IP, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil, false
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	ip := net.ParseIP(host)
+	return ip, ip != nil
+}
 
-		payload, err := tokenMaker.VerifyToken(accessToken)
+func authInterceptor(tokenMaker token.Maker, revocations token.RevocationList, apiKeys service.APIKeyUseCase) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authorize(ctx, tokenMaker, revocations, apiKeys, info.FullMethod)
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "invalid access token")
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		if adminMethods[info.FullMethod] && payload.UserType != userTypeAdmin {
-			return nil, status.Error(codes.PermissionDenied, "admin access required")
+func verifiedEmailInterceptor(users service.UserUseCase) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !verifiedEmailMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		payload, ok := authorizationPayloadFromContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing authorization payload")
+		}
+		if err := users.RequireVerifiedEmail(ctx, payload.UserID); err != nil {
+			return nil, statusFromServiceError(err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func quotaInterceptor(quotas service.QuotaUseCase) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, err := meterRequest(ctx, quotas, info.FullMethod)
+		if len(md) > 0 {
+			grpc.SetHeader(ctx, md)
+		}
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
+
+// meterRequest counts a signed-in call of a rate read against the daily quota and returns the
+// quota as x-quota-* metadata. Anonymous calls are not metered, and a failure to count fails
+// open like the REST quota middleware.
+func meterRequest(ctx context.Context, quotas service.QuotaUseCase, fullMethod string) (metadata.MD, error) {
+	if _, ok := rateReadMethods[fullMethod]; !ok {
+		return nil, nil
+	}
+	payload, ok := authorizationPayloadFromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	usage, err := quotas.ConsumeDailyQuota(ctx, service.QuotaInput{UserID: payload.UserID, UserType: payload.UserType})
+	exhausted := service.ServiceErrorCode(err) == service.ErrQuotaExceeded.Code
+	if err != nil && !exhausted {
+		log.Error().
+			Err(err).
+			Str("method", fullMethod).
+			Str("request_id", requestIDFromContext(ctx)).
+			Int32("user_id", payload.UserID).
+			Msg("failed to meter request against daily quota")
+		return nil, nil
+	}
 
-		return handler(contextWithAuthorizationPayload(ctx, payload), req)
+	var md metadata.MD
+	if usage.DailyLimit != nil {
+		md = metadata.Pairs(
+			"x-quota-limit", strconv.Itoa(int(*usage.DailyLimit)),
+			"x-quota-remaining", strconv.Itoa(int(*usage.RequestsRemaining)),
+			"x-quota-reset", strconv.FormatInt(usage.ResetsAt.Unix(), 10),
+		)
+	}
+	if exhausted {
+		return md, statusFromServiceError(err)
 	}
+	return md, nil
+}
+
+// authorize verifies the bearer token for protected methods, rejecting revoked ones, and stores
+// its payload in ctx. Without an authorization header, an x-api-key is verified instead, even on
+// public methods.
+func authorize(
+	ctx context.Context,
+	tokenMaker token.Maker,
+	revocations token.RevocationList,
+	apiKeys service.APIKeyUseCase,
+	fullMethod string,
+) (context.Context, error) {
+	if rawKey := apiKeyFromMetadata(ctx); rawKey != "" && !hasAuthorizationMetadata(ctx) && !isAuthenticationMethod(fullMethod) {
+		return authorizeAPIKey(ctx, apiKeys, rawKey, fullMethod)
+	}
+	if publicMethods[fullMethod] {
+		return ctx, nil
+	}
+	if optionalAuthMethods[fullMethod] && !hasAuthorizationMetadata(ctx) {
+		return ctx, nil
+	}
+
+	accessToken, err := accessTokenFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := token.VerifyAccessToken(ctx, tokenMaker, revocations, accessToken)
+	if errors.Is(err, token.ErrRevokedToken) {
+		return nil, status.Error(codes.Unauthenticated, "access token has been revoked")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+
+	if adminMethods[fullMethod] && payload.UserType != userTypeAdmin {
+		return nil, status.Error(codes.PermissionDenied, "admin access required")
+	}
+
+	return contextWithAuthorizationPayload(ctx, payload), nil
+}
+
+// authorizeAPIKey resolves an API key and checks it holds the scope the method needs.
+func authorizeAPIKey(ctx context.Context, apiKeys service.APIKeyUseCase, rawKey string, fullMethod string) (context.Context, error) {
+	principal, err := apiKeys.AuthenticateAPIKey(ctx, rawKey)
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	scope := rateReadMethods[fullMethod]
+	if adminMethods[fullMethod] {
+		scope = service.APIKeyScopeAdmin
+	}
+	if scope == "" {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot be used for this method")
+	}
+	if !slices.Contains(principal.Scopes, scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "api key does not have the %s scope", scope)
+	}
+
+	return contextWithAuthorizationPayload(ctx, principal.Payload), nil
+}
+
+// isAuthenticationMethod reports whether the method belongs to the sign-up/sign-in service,
+// which ignores API keys the same way it ignores bearer tokens.
+func isAuthenticationMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+pb.RatePulseAuthenticationService_ServiceDesc.ServiceName+"/")
+}
+
+// ensureRequestID reuses the caller's x-request-id or generates one.
+func ensureRequestID(ctx context.Context) (context.Context, string) {
+	requestID := requestIDFromMetadata(ctx)
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	return contextWithRequestID(ctx, requestID), requestID
 }
 
 func requestIDFromMetadata(ctx context.Context) string {
@@ -155,6 +446,20 @@ func requestIDFromMetadata(ctx context.Context) string {
 	return ""
 }
 
+func hasAuthorizationMetadata(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(authorizationHeaderKey)) > 0
+}
+
+func apiKeyFromMetadata(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(apiKeyHeaderKey); len(values) > 0 {
+			return strings.TrimSpace(values[0])
+		}
+	}
+	return ""
+}
+
 func accessTokenFromMetadata(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
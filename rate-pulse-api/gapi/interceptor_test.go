@@ -0,0 +1,390 @@
+package gapi
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/ratelimit"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// fakeAPIKeys authenticates the keys it was built with and rejects every other key.
+type fakeAPIKeys struct {
+	service.APIKeyUseCase
+	scopes map[string][]string
+}
+
+func (apiKeys fakeAPIKeys) AuthenticateAPIKey(ctx context.Context, rawKey string) (service.APIKeyPrincipal, error) {
+	scopes, ok := apiKeys.scopes[rawKey]
+	if !ok {
+		return service.APIKeyPrincipal{}, service.Wrap(nil, service.ErrUnauthorized.Code, "invalid api key")
+	}
+	payload := &token.Payload{UserID: 7, Username: "user", UserType: "free"}
+	return service.APIKeyPrincipal{APIKeyID: 1, Payload: payload, Scopes: scopes}, nil
+}
+
+func newTestAPIKeys() fakeAPIKeys {
+	return fakeAPIKeys{scopes: map[string][]string{
+		"rp_rates":      {service.APIKeyScopeReadRates},
+		"rp_historical": {service.APIKeyScopeReadRates, service.APIKeyScopeReadHistorical},
+		"rp_admin":      {service.APIKeyScopeAdmin},
+	}}
+}
+
+// fakeQuotas lets user 7 through and reports user 8's daily quota as exhausted.
+type fakeQuotas struct {
+	service.QuotaUseCase
+	calls *int
+}
+
+func (quotas fakeQuotas) ConsumeDailyQuota(ctx context.Context, input service.QuotaInput) (service.Usage, error) {
+	if quotas.calls != nil {
+		*quotas.calls++
+	}
+	limit, remaining := int32(100), int32(99)
+	usage := service.Usage{DailyLimit: &limit, RequestsRemaining: &remaining, ResetsAt: time.Now().Add(time.Hour)}
+	if input.UserID == 8 {
+		remaining = 0
+		return usage, service.Wrap(nil, service.ErrQuotaExceeded.Code, "daily request quota of your plan is exhausted")
+	}
+	return usage, nil
+}
+
+func newTestQuotas() fakeQuotas {
+	return fakeQuotas{}
+}
+
+func TestQuotaInterceptor(t *testing.T) {
+	testCases := []struct {
+		name    string
+		method  string
+		payload *token.Payload
+		code    codes.Code
+		metered bool
+	}{
+		{
+			name:   "AnonymousNotMetered",
+			method: pb.RatePulseExchangeRateService_GetCandles_FullMethodName,
+			code:   codes.OK,
+		},
+		{
+			name:    "NonRateMethodNotMetered",
+			method:  pb.RatePulseRateAlertService_ListRateAlerts_FullMethodName,
+			payload: &token.Payload{UserID: 8},
+			code:    codes.OK,
+		},
+		{
+			name:    "WithinQuota",
+			method:  pb.RatePulseExchangeRateService_GetCandles_FullMethodName,
+			payload: &token.Payload{UserID: 7},
+			code:    codes.OK,
+			metered: true,
+		},
+		{
+			name:    "QuotaExhausted",
+			method:  pb.RatePulseExchangeRateService_GetHistoricalData_FullMethodName,
+			payload: &token.Payload{UserID: 8},
+			code:    codes.ResourceExhausted,
+			metered: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			ctx := context.Background()
+			if tc.payload != nil {
+				ctx = contextWithAuthorizationPayload(ctx, tc.payload)
+			}
+
+			md, err := meterRequest(ctx, fakeQuotas{calls: &calls}, tc.method)
+
+			require.Equal(t, tc.code, status.Code(err))
+			require.Equal(t, tc.metered, calls == 1)
+			if tc.metered {
+				require.Equal(t, []string{"100"}, md.Get("x-quota-limit"))
+			}
+		})
+	}
+}
+
+// fakeUsers reports user 9's email as unverified and every other user's as verified.
+type fakeUsers struct {
+	service.UserUseCase
+}
+
+func (fakeUsers) RequireVerifiedEmail(ctx context.Context, userID int32) error {
+	if userID == 9 {
+		return service.Wrap(nil, service.ErrEmailNotVerified.Code, "please verify your email address first")
+	}
+	return nil
+}
+
+func TestVerifiedEmailInterceptor(t *testing.T) {
+	testCases := []struct {
+		name    string
+		method  string
+		payload *token.Payload
+		code    codes.Code
+	}{
+		{
+			name:    "VerifiedCreatesAlert",
+			method:  pb.RatePulseRateAlertService_CreateRateAlert_FullMethodName,
+			payload: &token.Payload{UserID: 7},
+			code:    codes.OK,
+		},
+		{
+			name:    "UnverifiedCannotCreateAlert",
+			method:  pb.RatePulseRateAlertService_CreateRateAlert_FullMethodName,
+			payload: &token.Payload{UserID: 9},
+			code:    codes.PermissionDenied,
+		},
+		{
+			name:    "UnverifiedListsAlerts",
+			method:  pb.RatePulseRateAlertService_ListRateAlerts_FullMethodName,
+			payload: &token.Payload{UserID: 9},
+			code:    codes.OK,
+		},
+	}
+
+	interceptor := verifiedEmailInterceptor(fakeUsers{})
+	handler := func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := contextWithAuthorizationPayload(context.Background(), tc.payload)
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+			require.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
+
+func TestRateLimitInterceptor(t *testing.T) {
+	limits := ratelimit.Policies{
+		Default: ratelimit.Policy{Name: "default", Limit: 100, Period: time.Minute},
+		Routes: map[string]ratelimit.Policy{
+			"POST /users/signin":     {Name: "POST /users/signin", Limit: 2, Period: time.Minute, Burst: 2},
+			"POST /users/signin/mfa": {Name: "POST /users/signin/mfa", Limit: 2, Period: time.Minute, Burst: 2},
+		},
+	}
+	interceptor := rateLimitInterceptor(ratelimit.NewMemoryLimiter(ratelimit.AlgorithmGCRA), limits)
+	handler := func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	}
+	callFrom := func(addr net.Addr, method string) codes.Code {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return status.Code(err)
+	}
+	call := func(ip string, method string) codes.Code {
+		return callFrom(&net.TCPAddr{IP: net.ParseIP(ip), Port: 50000}, method)
+	}
+
+	signIn := pb.RatePulseAuthenticationService_SignInUser_FullMethodName
+	require.Equal(t, codes.OK, call("203.0.113.7", signIn))
+	require.Equal(t, codes.OK, call("203.0.113.7", signIn))
+	require.Equal(t, codes.ResourceExhausted, call("203.0.113.7", signIn))
+
+	// Each address and each method has its own budget.
+	require.Equal(t, codes.OK, call("203.0.113.8", signIn))
+	require.Equal(t, codes.OK, call("203.0.113.7", pb.RatePulseAuthenticationService_CompleteSignInMFA_FullMethodName))
+
+	// Methods without a strict policy and calls from the in-process gateway are not limited here.
+	for i := 0; i < 5; i++ {
+		require.Equal(t, codes.OK, call("203.0.113.7", pb.RatePulseExchangeRateService_GetCandles_FullMethodName))
+		require.Equal(t, codes.OK, callFrom(gatewayAddr{}, signIn))
+	}
+
+	// A loopback peer, such as a proxy on the same host, is limited like any other address.
+	require.Equal(t, codes.OK, call("127.0.0.1", signIn))
+	require.Equal(t, codes.OK, call("127.0.0.1", signIn))
+	require.Equal(t, codes.ResourceExhausted, call("127.0.0.1", signIn))
+}
+
+func TestAuthorize(t *testing.T) {
+	tokenMaker := newTestTokenMaker(t)
+	userToken, _, err := tokenMaker.CreateToken(7, "user", "user@email.com", "free", time.Minute, token.TokenTypeAccess)
+	require.NoError(t, err)
+	adminToken, _, err := tokenMaker.CreateToken(1, "admin", "admin@email.com", userTypeAdmin, time.Minute, token.TokenTypeAccess)
+	require.NoError(t, err)
+	revokedToken, revokedPayload, err := tokenMaker.CreateToken(7, "user", "user@email.com", "free", time.Minute, token.TokenTypeAccess)
+	require.NoError(t, err)
+
+	revocations := token.NewMemoryRevocationList(time.Minute)
+	require.NoError(t, revocations.RevokeToken(context.Background(), revokedPayload))
+
+	testCases := []struct {
+		name       string
+		method     string
+		pairs      []string
+		code       codes.Code
+		authorized bool
+	}{
+		{
+			name:   "PublicWithoutToken",
+			method: pb.RatePulseExchangeRateService_GetCandles_FullMethodName,
+			code:   codes.OK,
+		},
+		{
+			// The second sign-in step runs before the caller has an access token.
+			name:   "CompleteSignInMFAWithoutToken",
+			method: pb.RatePulseAuthenticationService_CompleteSignInMFA_FullMethodName,
+			code:   codes.OK,
+		},
+		{
+			name:   "OptionalWithoutToken",
+			method: pb.RatePulseExchangeRateService_GetHistoricalData_FullMethodName,
+			code:   codes.OK,
+		},
+		{
+			name:       "OptionalWithToken",
+			method:     pb.RatePulseExchangeRateService_GetHistoricalData_FullMethodName,
+			pairs:      []string{authorizationHeaderKey, "Bearer " + userToken},
+			code:       codes.OK,
+			authorized: true,
+		},
+		{
+			name:   "OptionalWithInvalidToken",
+			method: pb.RatePulseExchangeRateService_GetHistoricalData_FullMethodName,
+			pairs:  []string{authorizationHeaderKey, "Bearer invalid"},
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "ProtectedWithRevokedToken",
+			method: pb.RatePulseRateAlertService_ListRateAlerts_FullMethodName,
+			pairs:  []string{authorizationHeaderKey, "Bearer " + revokedToken},
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "ProtectedWithoutToken",
+			method: pb.RatePulseRateAlertService_ListRateAlerts_FullMethodName,
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:       "ProtectedWithToken",
+			method:     pb.RatePulseRateAlertService_ListRateAlerts_FullMethodName,
+			pairs:      []string{authorizationHeaderKey, "Bearer " + userToken},
+			code:       codes.OK,
+			authorized: true,
+		},
+		{
+			name:   "AdminWithUserToken",
+			method: pb.RatePulseUserService_DeleteUser_FullMethodName,
+			pairs:  []string{authorizationHeaderKey, "Bearer " + userToken},
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "ListUsersWithUserToken",
+			method: pb.RatePulseUserService_ListUsers_FullMethodName,
+			pairs:  []string{authorizationHeaderKey, "Bearer " + userToken},
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "ListCurrenciesWithoutToken",
+			method: pb.RatePulseCurrencyService_ListCurrencies_FullMethodName,
+			code:   codes.OK,
+		},
+		{
+			name:   "CreateCurrencyWithUserToken",
+			method: pb.RatePulseCurrencyService_CreateCurrency_FullMethodName,
+			pairs:  []string{authorizationHeaderKey, "Bearer " + userToken},
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "AdminListPaymentsWithUserToken",
+			method: pb.RatePulsePaymentService_AdminListPayments_FullMethodName,
+			pairs:  []string{authorizationHeaderKey, "Bearer " + userToken},
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:       "ListPaymentsWithUserToken",
+			method:     pb.RatePulsePaymentService_ListPayments_FullMethodName,
+			pairs:      []string{authorizationHeaderKey, "Bearer " + userToken},
+			code:       codes.OK,
+			authorized: true,
+		},
+		{
+			name:       "AdminWithAdminToken",
+			method:     pb.RatePulseUserService_DeleteUser_FullMethodName,
+			pairs:      []string{authorizationHeaderKey, "Bearer " + adminToken},
+			code:       codes.OK,
+			authorized: true,
+		},
+		{
+			name:       "PublicWithScopedAPIKey",
+			method:     pb.RatePulseExchangeRateService_GetCandles_FullMethodName,
+			pairs:      []string{apiKeyHeaderKey, "rp_rates"},
+			code:       codes.OK,
+			authorized: true,
+		},
+		{
+			name:   "PublicWithInvalidAPIKey",
+			method: pb.RatePulseExchangeRateService_GetCandles_FullMethodName,
+			pairs:  []string{apiKeyHeaderKey, "rp_unknown"},
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "HistoricalWithoutScope",
+			method: pb.RatePulseExchangeRateService_GetHistoricalData_FullMethodName,
+			pairs:  []string{apiKeyHeaderKey, "rp_rates"},
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:       "HistoricalWithScope",
+			method:     pb.RatePulseExchangeRateService_GetHistoricalData_FullMethodName,
+			pairs:      []string{apiKeyHeaderKey, "rp_historical"},
+			code:       codes.OK,
+			authorized: true,
+		},
+		{
+			name:   "ProtectedWithAPIKey",
+			method: pb.RatePulseRateAlertService_ListRateAlerts_FullMethodName,
+			pairs:  []string{apiKeyHeaderKey, "rp_admin"},
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "AdminWithReadAPIKey",
+			method: pb.RatePulseUserService_DeleteUser_FullMethodName,
+			pairs:  []string{apiKeyHeaderKey, "rp_rates"},
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:       "AdminWithAdminAPIKey",
+			method:     pb.RatePulseUserService_DeleteUser_FullMethodName,
+			pairs:      []string{apiKeyHeaderKey, "rp_admin"},
+			code:       codes.OK,
+			authorized: true,
+		},
+		{
+			name:   "AuthenticationIgnoresAPIKey",
+			method: pb.RatePulseAuthenticationService_SignInUser_FullMethodName,
+			pairs:  []string{apiKeyHeaderKey, "rp_unknown"},
+			code:   codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, err := authorize(incomingContext(tc.pairs...), tokenMaker, revocations, newTestAPIKeys(), tc.method)
+			require.Equal(t, tc.code, status.Code(err))
+			if err != nil {
+				return
+			}
+
+			_, ok := authorizationPayloadFromContext(ctx)
+			require.Equal(t, tc.authorized, ok)
+		})
+	}
+}
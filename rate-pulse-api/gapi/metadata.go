@@ -7,8 +7,9 @@ import (
 )
 
 const (
-	grpcUserAgentHeader = "user-agent"
-	xForwardedForHeader = "x-forwarded-for"
+	grpcGatewayUserAgentHeader = "grpcgateway-user-agent"
+	grpcUserAgentHeader        = "user-agent"
+	xForwardedForHeader        = "x-forwarded-for"
 )
 
 type Metadata struct {
@@ -21,7 +22,9 @@ func (server *Server) GetMetadata(ctx context.Context) *Metadata {
 
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
 
-		if userAgents := md.Get(grpcUserAgentHeader); len(userAgents) > 0 {
+		if userAgents := md.Get(grpcGatewayUserAgentHeader); len(userAgents) > 0 {
+			mtdata.UserAgent = userAgents[0]
+		} else if userAgents := md.Get(grpcUserAgentHeader); len(userAgents) > 0 {
 			mtdata.UserAgent = userAgents[0]
 		}
 		if clientIps := md.Get(xForwardedForHeader); len(clientIps) > 0 {
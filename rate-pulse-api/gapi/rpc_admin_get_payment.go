@@ -0,0 +1,23 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) AdminGetPayment(
+	ctx context.Context,
+	req *pb.AdminGetPaymentRequest,
+) (*pb.AdminGetPaymentResponse, error) {
+	if err := validateIDRequest("payment_id", req.GetPaymentId()); err != nil {
+		return nil, err
+	}
+
+	payment, err := server.services.Payments.GetPayment(ctx, req.GetPaymentId())
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.AdminGetPaymentResponse{Payment: convertPayment(payment)}, nil
+}
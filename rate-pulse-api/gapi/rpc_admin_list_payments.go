@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) AdminListPayments(
+	ctx context.Context,
+	req *pb.AdminListPaymentsRequest,
+) (*pb.AdminListPaymentsResponse, error) {
+	if err := validateAdminListPaymentsRequest(req); err != nil {
+		return nil, err
+	}
+
+	page, err := server.services.Payments.ListPayments(ctx, service.ListPaymentsInput{
+		Page: service.PageInput{
+			Cursor:   req.GetCursor(),
+			PageSize: req.GetPageSize(),
+			Sort:     req.GetSort(),
+		},
+		Status:         req.Status,
+		SubscriptionID: req.SubscriptionId,
+		From:           timeFromTimestamp(req.GetFrom()),
+		To:             timeFromTimestamp(req.GetTo()),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.AdminListPaymentsResponse{
+		Payments:   convertPayments(service.NewPayments(page.Payments)),
+		NextCursor: page.NextCursor,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) AdminListUserSubscriptions(
+	ctx context.Context,
+	req *pb.AdminListUserSubscriptionsRequest,
+) (*pb.AdminListUserSubscriptionsResponse, error) {
+	if err := validateAdminListUserSubscriptionsRequest(req); err != nil {
+		return nil, err
+	}
+
+	page, err := server.services.Subscriptions.ListUserSubscriptions(ctx, service.ListUserSubscriptionsInput{
+		Page: service.PageInput{
+			Cursor:   req.GetCursor(),
+			PageSize: req.GetPageSize(),
+			Sort:     req.GetSort(),
+		},
+		Status: req.Status,
+		PlanID: req.PlanId,
+		From:   timeFromTimestamp(req.GetFrom()),
+		To:     timeFromTimestamp(req.GetTo()),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.AdminListUserSubscriptionsResponse{
+		Subscriptions: convertUserSubscriptions(service.NewUserSubscriptions(page.Subscriptions)),
+		NextCursor:    page.NextCursor,
+	}, nil
+}
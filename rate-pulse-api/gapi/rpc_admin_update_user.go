@@ -0,0 +1,38 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) AdminUpdateUser(
+	ctx context.Context,
+	req *pb.AdminUpdateUserRequest,
+) (*pb.AdminUpdateUserResponse, error) {
+	if err := validateAdminUpdateUserRequest(req); err != nil {
+		return nil, err
+	}
+
+	user, err := server.services.Users.AdminUpdateUser(ctx, service.AdminUpdateUserInput{
+		UserID:             req.GetUserId(),
+		Username:           req.Username,
+		Email:              req.Email,
+		Password:           req.Password,
+		UserType:           req.UserType,
+		EmailVerified:      req.EmailVerified,
+		TimeZone:           req.TimeZone,
+		LanguagePreference: req.LanguagePreference,
+		CountryOfResidence: req.CountryOfResidence,
+		CountryOfBirth:     req.CountryOfBirth,
+		FirstName:          req.FirstName,
+		LastName:           req.LastName,
+		IsActive:           req.IsActive,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.AdminUpdateUserResponse{User: convertUser(user)}, nil
+}
@@ -0,0 +1,41 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) CompareQuotes(
+	ctx context.Context,
+	req *pb.CompareQuotesRequest,
+) (*pb.CompareQuotesResponse, error) {
+	if err := validateCompareQuotesRequest(req); err != nil {
+		return nil, err
+	}
+
+	input := service.CompareQuotesInput{
+		Amount:          req.GetAmount(),
+		FromCurrencyID:  req.GetFromCurrencyId(),
+		ToCurrencyID:    req.GetToCurrencyId(),
+		TypeID:          req.GetTypeId(),
+		TransactionType: req.GetTransactionType(),
+		Channel:         req.GetChannel(),
+	}
+	if req.GetEffectiveDate() != nil {
+		input.EffectiveDate = req.GetEffectiveDate().AsTime()
+	}
+
+	quotes, err := server.services.FX.CompareQuotes(ctx, input)
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	pbQuotes := make([]*pb.RankedQuote, len(quotes))
+	for i, quote := range quotes {
+		pbQuotes[i] = convertRankedQuote(quote)
+	}
+
+	return &pb.CompareQuotesResponse{Quotes: pbQuotes}, nil
+}
@@ -0,0 +1,40 @@
+package gapi
+
+import (
+	"context"
+
+	pb "github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) CompleteSignInMFA(
+	ctx context.Context,
+	req *pb.CompleteSignInMFARequest,
+) (*pb.CompleteSignInMFAResponse, error) {
+	if err := validateCompleteSignInMFARequest(req); err != nil {
+		return nil, err
+	}
+
+	metadata := server.GetMetadata(ctx)
+	result, err := server.services.Auth.CompleteSignInMFA(ctx, service.CompleteSignInMFAInput{
+		MFAToken:  req.GetMfaToken(),
+		Code:      req.GetCode(),
+		UserAgent: metadata.UserAgent,
+		ClientIP:  metadata.ClientIp,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	response := &pb.CompleteSignInMFAResponse{
+		User:                  convertUser(result.User),
+		SessionId:             result.SessionID.String(),
+		AccessToken:           result.AccessToken,
+		RefreshToken:          result.RefreshToken,
+		AccessTokenExpiresAt:  timestamppb.New(result.AccessTokenExpiresAt),
+		RefreshTokenExpiresAt: timestamppb.New(result.RefreshTokenExpiresAt),
+		RecoveryCodes:         result.RecoveryCodes,
+	}
+	return response, nil
+}
@@ -0,0 +1,29 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) CreateCountry(
+	ctx context.Context,
+	req *pb.CreateCountryRequest,
+) (*pb.CreateCountryResponse, error) {
+	if err := validateCreateCountryRequest(req); err != nil {
+		return nil, err
+	}
+
+	country, err := server.services.Countries.CreateCountry(ctx, service.CreateCountryInput{
+		CountryName: req.GetCountryName(),
+		CountryCode: req.GetCountryCode(),
+		CurrencyID:  req.GetCurrencyId(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	server.deleteCountryCaches(ctx)
+	return &pb.CreateCountryResponse{Country: convertCountry(country)}, nil
+}
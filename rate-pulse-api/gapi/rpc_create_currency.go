@@ -0,0 +1,29 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) CreateCurrency(
+	ctx context.Context,
+	req *pb.CreateCurrencyRequest,
+) (*pb.CreateCurrencyResponse, error) {
+	if err := validateCreateCurrencyRequest(req); err != nil {
+		return nil, err
+	}
+
+	currency, err := server.services.Currencies.CreateCurrency(ctx, service.CreateCurrencyInput{
+		CurrencyCode:   req.GetCurrencyCode(),
+		CurrencyName:   req.GetCurrencyName(),
+		CurrencySymbol: req.GetCurrencySymbol(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	server.deleteCurrencyCaches(ctx)
+	return &pb.CreateCurrencyResponse{Currency: convertCurrency(currency)}, nil
+}
@@ -0,0 +1,36 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) CreateExchangeRate(
+	ctx context.Context,
+	req *pb.CreateExchangeRateRequest,
+) (*pb.CreateExchangeRateResponse, error) {
+	if err := validateCreateExchangeRateRequest(req); err != nil {
+		return nil, err
+	}
+
+	input := service.CreateExchangeRateInput{
+		RateValue:             req.GetRateValue(),
+		SourceCurrencyID:      req.GetSourceCurrencyId(),
+		DestinationCurrencyID: req.GetDestinationCurrencyId(),
+		ValidFromDate:         req.GetValidFromDate().AsTime(),
+		SourceID:              req.GetSourceId(),
+		TypeID:                req.GetTypeId(),
+	}
+	if req.GetValidToDate() != nil {
+		input.ValidToDate = req.GetValidToDate().AsTime()
+	}
+
+	exchangeRate, err := server.services.FX.CreateExchangeRate(ctx, input)
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.CreateExchangeRateResponse{ExchangeRate: convertExchangeRate(exchangeRate)}, nil
+}
@@ -0,0 +1,32 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) CreatePayment(
+	ctx context.Context,
+	req *pb.CreatePaymentRequest,
+) (*pb.CreatePaymentResponse, error) {
+	if err := validateCreatePaymentRequest(req); err != nil {
+		return nil, err
+	}
+
+	payment, err := server.services.Payments.CreatePayment(ctx, service.CreatePaymentInput{
+		SubscriptionID: req.GetSubscriptionId(),
+		TransactionID:  req.TransactionId,
+		Amount:         req.GetAmount(),
+		CurrencyCode:   req.GetCurrencyCode(),
+		PaymentMethod:  req.PaymentMethod,
+		PaymentStatus:  req.PaymentStatus,
+		PaymentDate:    timeFromTimestamp(req.GetPaymentDate()),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.CreatePaymentResponse{Payment: convertPayment(payment)}, nil
+}
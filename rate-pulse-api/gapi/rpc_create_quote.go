@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) CreateQuote(
+	ctx context.Context,
+	req *pb.CreateQuoteRequest,
+) (*pb.CreateQuoteResponse, error) {
+	if err := validateCreateQuoteRequest(req); err != nil {
+		return nil, err
+	}
+
+	input := service.QuoteInput{
+		Amount:          req.GetAmount(),
+		FromCurrencyID:  req.GetFromCurrencyId(),
+		ToCurrencyID:    req.GetToCurrencyId(),
+		SourceID:        req.GetSourceId(),
+		TypeID:          req.GetTypeId(),
+		TransactionType: req.GetTransactionType(),
+		Channel:         req.GetChannel(),
+	}
+	if req.GetEffectiveDate() != nil {
+		input.EffectiveDate = req.GetEffectiveDate().AsTime()
+	}
+
+	quote, err := server.services.FX.Quote(ctx, input)
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.CreateQuoteResponse{Quote: convertQuote(quote)}, nil
+}
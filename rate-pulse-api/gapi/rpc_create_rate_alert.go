@@ -0,0 +1,38 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) CreateRateAlert(
+	ctx context.Context,
+	req *pb.CreateRateAlertRequest,
+) (*pb.CreateRateAlertResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCreateRateAlertRequest(req); err != nil {
+		return nil, err
+	}
+
+	alert, err := server.services.Alerts.CreateRateAlert(ctx, service.CreateRateAlertInput{
+		UserID:         payload.UserID,
+		SourceID:       req.GetSourceId(),
+		TypeID:         req.GetTypeId(),
+		FromCurrencyID: req.GetFromCurrencyId(),
+		ToCurrencyID:   req.GetToCurrencyId(),
+		Condition:      req.GetCondition(),
+		Threshold:      req.Threshold,
+		ChangePercent:  req.ChangePercent,
+		WindowHours:    req.GetWindowHours(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.CreateRateAlertResponse{Alert: convertRateAlert(alert)}, nil
+}
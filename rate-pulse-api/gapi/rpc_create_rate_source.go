@@ -0,0 +1,31 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) CreateRateSource(
+	ctx context.Context,
+	req *pb.CreateRateSourceRequest,
+) (*pb.CreateRateSourceResponse, error) {
+	if err := validateCreateRateSourceRequest(req); err != nil {
+		return nil, err
+	}
+
+	source, err := server.services.RateSources.CreateRateSource(ctx, service.CreateRateSourceInput{
+		SourceName:    req.GetSourceName(),
+		SourceLink:    req.GetSourceLink(),
+		SourceCountry: req.GetSourceCountry(),
+		SourceStatus:  req.GetSourceStatus(),
+		SourceCode:    req.GetSourceCode(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	server.deleteRateSourceCaches(ctx)
+	return &pb.CreateRateSourceResponse{RateSource: convertRateSource(source)}, nil
+}
@@ -0,0 +1,47 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) CreateRateSourceFeeRule(
+	ctx context.Context,
+	req *pb.CreateRateSourceFeeRuleRequest,
+) (*pb.CreateRateSourceFeeRuleResponse, error) {
+	if err := validateCreateRateSourceFeeRuleRequest(req); err != nil {
+		return nil, err
+	}
+
+	rule, err := server.services.FeeRules.CreateRateSourceFeeRule(ctx, service.CreateRateSourceFeeRuleInput{
+		SourceID:           req.GetSourceId(),
+		TypeID:             req.GetTypeId(),
+		TransactionType:    req.GetTransactionType(),
+		Channel:            req.GetChannel(),
+		FeeRate:            req.FeeRate,
+		FeeRateMin:         req.FeeRateMin,
+		FeeRateMax:         req.FeeRateMax,
+		FeeCurrencyID:      req.FeeCurrencyId,
+		FixedFee:           req.FixedFee,
+		MinFee:             req.MinFee,
+		MaxFee:             req.MaxFee,
+		VatRate:            req.VatRate,
+		VatApplies:         req.GetVatApplies(),
+		FeeIncludesVat:     req.GetFeeIncludesVat(),
+		SwiftFee:           req.SwiftFee,
+		SwiftFeeCurrencyID: req.SwiftFeeCurrencyId,
+		SwiftFeeIncluded:   req.GetSwiftFeeIncluded(),
+		SourceURL:          req.SourceUrl,
+		SourceNote:         req.SourceNote,
+		EffectiveFrom:      req.GetEffectiveFrom().AsTime(),
+		EffectiveTo:        timeFromTimestamp(req.GetEffectiveTo()),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	server.deleteRateSourceFeeRuleCaches(ctx)
+	return &pb.CreateRateSourceFeeRuleResponse{FeeRule: convertRateSourceFeeRule(rule)}, nil
+}
@@ -0,0 +1,32 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) CreateRateSourcePreference(
+	ctx context.Context,
+	req *pb.CreateRateSourcePreferenceRequest,
+) (*pb.CreateRateSourcePreferenceResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateIDRequest("source_id", req.GetSourceId()); err != nil {
+		return nil, err
+	}
+
+	preference, err := server.services.Preferences.CreateRateSourcePreference(ctx, service.RateSourcePreferenceInput{
+		UserID:    payload.UserID,
+		SourceID:  req.GetSourceId(),
+		IsPrimary: req.IsPrimary,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.CreateRateSourcePreferenceResponse{Preference: convertRateSourcePreference(preference)}, nil
+}
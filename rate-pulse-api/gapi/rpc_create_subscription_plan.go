@@ -0,0 +1,31 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) CreateSubscriptionPlan(
+	ctx context.Context,
+	req *pb.CreateSubscriptionPlanRequest,
+) (*pb.CreateSubscriptionPlanResponse, error) {
+	if err := validateCreateSubscriptionPlanRequest(req); err != nil {
+		return nil, err
+	}
+
+	plan, err := server.services.Plans.CreateSubscriptionPlan(ctx, service.CreateSubscriptionPlanInput{
+		PlanName:        req.GetPlanName(),
+		PlanPrice:       req.GetPlanPrice(),
+		HistoricalDays:  req.GetHistoricalDays(),
+		RateLimitPerDay: req.GetRateLimitPerDay(),
+		Features:        req.Features,
+		IsActive:        req.IsActive,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.CreateSubscriptionPlanResponse{Plan: convertSubscriptionPlan(plan)}, nil
+}
@@ -0,0 +1,35 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) CreateUserSubscription(
+	ctx context.Context,
+	req *pb.CreateUserSubscriptionRequest,
+) (*pb.CreateUserSubscriptionResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCreateUserSubscriptionRequest(req); err != nil {
+		return nil, err
+	}
+
+	subscription, err := server.services.Subscriptions.CreateUserSubscription(ctx, service.CreateUserSubscriptionInput{
+		UserID:    payload.UserID,
+		PlanID:    req.GetPlanId(),
+		Status:    req.Status,
+		StartDate: timeFromTimestamp(req.GetStartDate()),
+		EndDate:   timeFromTimestamp(req.GetEndDate()),
+		AutoRenew: req.AutoRenew,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.CreateUserSubscriptionResponse{Subscription: convertUserSubscription(subscription)}, nil
+}
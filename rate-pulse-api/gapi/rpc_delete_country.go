@@ -0,0 +1,23 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) DeleteCountry(
+	ctx context.Context,
+	req *pb.DeleteCountryRequest,
+) (*pb.DeleteCountryResponse, error) {
+	if err := validateIDRequest("country_id", req.GetCountryId()); err != nil {
+		return nil, err
+	}
+
+	if err := server.services.Countries.DeleteCountry(ctx, req.GetCountryId()); err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	server.deleteCountryCaches(ctx)
+	return &pb.DeleteCountryResponse{}, nil
+}
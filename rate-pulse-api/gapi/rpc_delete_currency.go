@@ -0,0 +1,23 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) DeleteCurrency(
+	ctx context.Context,
+	req *pb.DeleteCurrencyRequest,
+) (*pb.DeleteCurrencyResponse, error) {
+	if err := validateIDRequest("currency_id", req.GetCurrencyId()); err != nil {
+		return nil, err
+	}
+
+	if err := server.services.Currencies.DeleteCurrency(ctx, req.GetCurrencyId()); err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	server.deleteCurrencyCaches(ctx)
+	return &pb.DeleteCurrencyResponse{}, nil
+}
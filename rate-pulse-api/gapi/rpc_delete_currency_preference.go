@@ -0,0 +1,26 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) DeleteCurrencyPreference(
+	ctx context.Context,
+	req *pb.DeleteCurrencyPreferenceRequest,
+) (*pb.DeleteCurrencyPreferenceResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateIDRequest("currency_id", req.GetCurrencyId()); err != nil {
+		return nil, err
+	}
+
+	if err := server.services.Preferences.DeleteCurrencyPreference(ctx, payload.UserID, req.GetCurrencyId()); err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.DeleteCurrencyPreferenceResponse{}, nil
+}
@@ -0,0 +1,23 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) DeleteExchangeRate(
+	ctx context.Context,
+	req *pb.DeleteExchangeRateRequest,
+) (*pb.DeleteExchangeRateResponse, error) {
+	if err := validateIDRequest("rate_id", req.GetRateId()); err != nil {
+		return nil, err
+	}
+
+	if err := server.services.FX.DeleteExchangeRate(ctx, service.DeleteExchangeRateInput{RateID: req.GetRateId()}); err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.DeleteExchangeRateResponse{}, nil
+}
@@ -0,0 +1,22 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) DeletePayment(
+	ctx context.Context,
+	req *pb.DeletePaymentRequest,
+) (*pb.DeletePaymentResponse, error) {
+	if err := validateIDRequest("payment_id", req.GetPaymentId()); err != nil {
+		return nil, err
+	}
+
+	if err := server.services.Payments.DeletePayment(ctx, req.GetPaymentId()); err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.DeletePaymentResponse{}, nil
+}
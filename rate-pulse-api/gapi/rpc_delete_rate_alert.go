@@ -0,0 +1,31 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) DeleteRateAlert(
+	ctx context.Context,
+	req *pb.DeleteRateAlertRequest,
+) (*pb.DeleteRateAlertResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateIDRequest("alert_id", req.GetAlertId()); err != nil {
+		return nil, err
+	}
+
+	err = server.services.Alerts.DeleteRateAlert(ctx, service.DeleteRateAlertInput{
+		UserID:  payload.UserID,
+		AlertID: req.GetAlertId(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.DeleteRateAlertResponse{}, nil
+}
@@ -0,0 +1,23 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) DeleteRateSource(
+	ctx context.Context,
+	req *pb.DeleteRateSourceRequest,
+) (*pb.DeleteRateSourceResponse, error) {
+	if err := validateIDRequest("source_id", req.GetSourceId()); err != nil {
+		return nil, err
+	}
+
+	if err := server.services.RateSources.DeleteRateSource(ctx, req.GetSourceId()); err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	server.deleteRateSourceCaches(ctx)
+	return &pb.DeleteRateSourceResponse{}, nil
+}
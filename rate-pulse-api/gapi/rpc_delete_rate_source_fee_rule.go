@@ -0,0 +1,27 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) DeleteRateSourceFeeRule(
+	ctx context.Context,
+	req *pb.DeleteRateSourceFeeRuleRequest,
+) (*pb.DeleteRateSourceFeeRuleResponse, error) {
+	if err := validateIDRequest("fee_rule_id", req.GetFeeRuleId()); err != nil {
+		return nil, err
+	}
+
+	err := server.services.FeeRules.DeleteRateSourceFeeRule(ctx, service.DeleteRateSourceFeeRuleInput{
+		FeeRuleID: req.GetFeeRuleId(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	server.deleteRateSourceFeeRuleCaches(ctx)
+	return &pb.DeleteRateSourceFeeRuleResponse{}, nil
+}
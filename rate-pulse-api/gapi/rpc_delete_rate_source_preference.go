@@ -0,0 +1,26 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) DeleteRateSourcePreference(
+	ctx context.Context,
+	req *pb.DeleteRateSourcePreferenceRequest,
+) (*pb.DeleteRateSourcePreferenceResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateIDRequest("source_id", req.GetSourceId()); err != nil {
+		return nil, err
+	}
+
+	if err := server.services.Preferences.DeleteRateSourcePreference(ctx, payload.UserID, req.GetSourceId()); err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.DeleteRateSourcePreferenceResponse{}, nil
+}
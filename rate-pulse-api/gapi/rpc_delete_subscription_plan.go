@@ -0,0 +1,22 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) DeleteSubscriptionPlan(
+	ctx context.Context,
+	req *pb.DeleteSubscriptionPlanRequest,
+) (*pb.DeleteSubscriptionPlanResponse, error) {
+	if err := validateIDRequest("plan_id", req.GetPlanId()); err != nil {
+		return nil, err
+	}
+
+	if err := server.services.Plans.DeleteSubscriptionPlan(ctx, req.GetPlanId()); err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.DeleteSubscriptionPlanResponse{}, nil
+}
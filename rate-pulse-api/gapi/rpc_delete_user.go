@@ -0,0 +1,23 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) DeleteUser(
+	ctx context.Context,
+	req *pb.DeleteUserRequest,
+) (*pb.DeleteUserResponse, error) {
+	if err := validateIDRequest("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	if err := server.services.Users.DeleteUser(ctx, service.DeleteUserInput{UserID: req.GetUserId()}); err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.DeleteUserResponse{}, nil
+}
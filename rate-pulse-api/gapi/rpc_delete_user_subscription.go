@@ -0,0 +1,22 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) DeleteUserSubscription(
+	ctx context.Context,
+	req *pb.DeleteUserSubscriptionRequest,
+) (*pb.DeleteUserSubscriptionResponse, error) {
+	if err := validateIDRequest("subscription_id", req.GetSubscriptionId()); err != nil {
+		return nil, err
+	}
+
+	if err := server.services.Subscriptions.DeleteUserSubscription(ctx, req.GetSubscriptionId()); err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.DeleteUserSubscriptionResponse{}, nil
+}
@@ -0,0 +1,29 @@
+package gapi
+
+import (
+	"context"
+
+	pb "github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) EnrollSignInTOTP(
+	ctx context.Context,
+	req *pb.EnrollSignInTOTPRequest,
+) (*pb.EnrollSignInTOTPResponse, error) {
+	if err := validateEnrollSignInTOTPRequest(req); err != nil {
+		return nil, err
+	}
+
+	enrollment, err := server.services.Auth.EnrollSignInTOTP(ctx, service.EnrollSignInTOTPInput{
+		MFAToken: req.GetMfaToken(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.EnrollSignInTOTPResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningUri: enrollment.ProvisioningURI,
+	}, nil
+}
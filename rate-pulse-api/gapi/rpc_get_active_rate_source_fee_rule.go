@@ -0,0 +1,36 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) GetActiveRateSourceFeeRule(
+	ctx context.Context,
+	req *pb.GetActiveRateSourceFeeRuleRequest,
+) (*pb.GetActiveRateSourceFeeRuleResponse, error) {
+	if err := validateGetActiveRateSourceFeeRuleRequest(req); err != nil {
+		return nil, err
+	}
+
+	effectiveDate := time.Now()
+	if req.GetEffectiveDate() != nil {
+		effectiveDate = req.GetEffectiveDate().AsTime()
+	}
+
+	rule, err := server.services.FeeRules.GetActiveRateSourceFeeRule(ctx, service.GetActiveRateSourceFeeRuleInput{
+		SourceID:        req.GetSourceId(),
+		TypeID:          req.GetTypeId(),
+		TransactionType: req.GetTransactionType(),
+		Channel:         req.GetChannel(),
+		EffectiveDate:   effectiveDate,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.GetActiveRateSourceFeeRuleResponse{FeeRule: convertRateSourceFeeRule(rule)}, nil
+}
@@ -0,0 +1,24 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) GetActiveUserSubscription(
+	ctx context.Context,
+	req *pb.GetActiveUserSubscriptionRequest,
+) (*pb.GetActiveUserSubscriptionResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := server.services.Subscriptions.GetActiveUserSubscription(ctx, payload.UserID)
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.GetActiveUserSubscriptionResponse{Subscription: convertUserSubscription(subscription)}, nil
+}
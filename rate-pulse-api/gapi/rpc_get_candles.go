@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) GetCandles(
+	ctx context.Context,
+	req *pb.GetCandlesRequest,
+) (*pb.GetCandlesResponse, error) {
+	if err := validateGetCandlesRequest(req); err != nil {
+		return nil, err
+	}
+
+	candles, err := server.services.FX.GetCandles(ctx, service.GetCandlesInput{
+		SourceCurrencyID:      req.GetSourceCurrencyId(),
+		DestinationCurrencyID: req.GetDestinationCurrencyId(),
+		SourceID:              req.GetSourceId(),
+		TypeID:                req.GetTypeId(),
+		Interval:              req.GetInterval(),
+		TimeRange:             req.GetTimeRange(),
+		Limit:                 req.GetLimit(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	pbCandles := make([]*pb.Candle, len(candles))
+	for i, candle := range candles {
+		pbCandles[i] = convertCandle(candle)
+	}
+
+	return &pb.GetCandlesResponse{Candles: pbCandles}, nil
+}
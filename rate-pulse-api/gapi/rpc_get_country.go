@@ -0,0 +1,23 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) GetCountry(
+	ctx context.Context,
+	req *pb.GetCountryRequest,
+) (*pb.GetCountryResponse, error) {
+	if err := validateIDRequest("country_id", req.GetCountryId()); err != nil {
+		return nil, err
+	}
+
+	country, err := server.services.Countries.GetCountry(ctx, req.GetCountryId())
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.GetCountryResponse{Country: convertCountry(country)}, nil
+}
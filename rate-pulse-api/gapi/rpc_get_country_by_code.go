@@ -0,0 +1,23 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) GetCountryByCode(
+	ctx context.Context,
+	req *pb.GetCountryByCodeRequest,
+) (*pb.GetCountryByCodeResponse, error) {
+	if violation := validateRequired("country_code", req.GetCountryCode()); violation != nil {
+		return nil, invalidArgumentError(*violation)
+	}
+
+	country, err := server.services.Countries.GetCountryByCode(ctx, req.GetCountryCode())
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.GetCountryByCodeResponse{Country: convertCountry(country)}, nil
+}
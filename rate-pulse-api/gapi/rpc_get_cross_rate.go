@@ -0,0 +1,30 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) GetCrossRate(
+	ctx context.Context,
+	req *pb.GetCrossRateRequest,
+) (*pb.GetCrossRateResponse, error) {
+	if err := validateGetCrossRateRequest(req); err != nil {
+		return nil, err
+	}
+
+	crossRate, err := server.services.FX.GetCrossRate(ctx, service.CrossRateInput{
+		FromCurrencyID:  req.GetFromCurrencyId(),
+		ToCurrencyID:    req.GetToCurrencyId(),
+		SourceID:        req.GetSourceId(),
+		TypeID:          req.GetTypeId(),
+		PivotCurrencyID: req.PivotCurrencyId,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return convertCrossRate(crossRate), nil
+}
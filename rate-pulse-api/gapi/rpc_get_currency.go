@@ -0,0 +1,23 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) GetCurrency(
+	ctx context.Context,
+	req *pb.GetCurrencyRequest,
+) (*pb.GetCurrencyResponse, error) {
+	if err := validateIDRequest("currency_id", req.GetCurrencyId()); err != nil {
+		return nil, err
+	}
+
+	currency, err := server.services.Currencies.GetCurrency(ctx, req.GetCurrencyId())
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.GetCurrencyResponse{Currency: convertCurrency(currency)}, nil
+}
@@ -0,0 +1,24 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) GetExchangeRate(
+	ctx context.Context,
+	req *pb.GetExchangeRateRequest,
+) (*pb.GetExchangeRateResponse, error) {
+	if err := validateIDRequest("rate_id", req.GetRateId()); err != nil {
+		return nil, err
+	}
+
+	exchangeRate, err := server.services.FX.GetExchangeRate(ctx, service.GetExchangeRateInput{RateID: req.GetRateId()})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.GetExchangeRateResponse{ExchangeRate: convertExchangeRate(exchangeRate)}, nil
+}
@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+// GetHistoricalData works without a token; signed-in callers default to their saved time zone
+// and get the history window of their plan instead of the free one.
+func (server *Server) GetHistoricalData(
+	ctx context.Context,
+	req *pb.GetHistoricalDataRequest,
+) (*pb.GetHistoricalDataResponse, error) {
+	if err := validateGetHistoricalDataRequest(req); err != nil {
+		return nil, err
+	}
+
+	var userID int32
+	var userType string
+	if payload, ok := authorizationPayloadFromContext(ctx); ok {
+		userID = payload.UserID
+		userType = payload.UserType
+	}
+
+	points, err := server.services.FX.GetHistoricalData(ctx, service.GetHistoricalDataInput{
+		SourceCurrencyID:      req.GetSourceCurrencyId(),
+		DestinationCurrencyID: req.GetDestinationCurrencyId(),
+		SourceID:              req.GetSourceId(),
+		TypeID:                req.GetTypeId(),
+		TimeRange:             req.GetTimeRange(),
+		DataPoints:            req.GetDataPoints(),
+		From:                  req.GetFrom(),
+		To:                    req.GetTo(),
+		TimeZone:              req.GetTz(),
+		Interval:              req.GetInterval(),
+		UserID:                userID,
+		UserType:              userType,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	pbPoints := make([]*pb.HistoricalDataPoint, len(points))
+	for i, point := range points {
+		pbPoints[i] = convertHistoricalDataPoint(point)
+	}
+
+	return &pb.GetHistoricalDataResponse{DataPoints: pbPoints}, nil
+}
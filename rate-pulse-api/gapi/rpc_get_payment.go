@@ -0,0 +1,27 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) GetPayment(
+	ctx context.Context,
+	req *pb.GetPaymentRequest,
+) (*pb.GetPaymentResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateIDRequest("payment_id", req.GetPaymentId()); err != nil {
+		return nil, err
+	}
+
+	payment, err := server.services.Payments.GetUserPayment(ctx, payload.UserID, req.GetPaymentId())
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.GetPaymentResponse{Payment: convertPayment(payment)}, nil
+}
@@ -0,0 +1,31 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) GetRateAlert(
+	ctx context.Context,
+	req *pb.GetRateAlertRequest,
+) (*pb.GetRateAlertResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateIDRequest("alert_id", req.GetAlertId()); err != nil {
+		return nil, err
+	}
+
+	alert, err := server.services.Alerts.GetRateAlert(ctx, service.GetRateAlertInput{
+		UserID:  payload.UserID,
+		AlertID: req.GetAlertId(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.GetRateAlertResponse{Alert: convertRateAlert(alert)}, nil
+}
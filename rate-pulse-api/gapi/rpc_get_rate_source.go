@@ -0,0 +1,23 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) GetRateSource(
+	ctx context.Context,
+	req *pb.GetRateSourceRequest,
+) (*pb.GetRateSourceResponse, error) {
+	if err := validateIDRequest("source_id", req.GetSourceId()); err != nil {
+		return nil, err
+	}
+
+	source, err := server.services.RateSources.GetRateSource(ctx, req.GetSourceId())
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.GetRateSourceResponse{RateSource: convertRateSource(source)}, nil
+}
@@ -0,0 +1,26 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) GetRateSourceFeeRule(
+	ctx context.Context,
+	req *pb.GetRateSourceFeeRuleRequest,
+) (*pb.GetRateSourceFeeRuleResponse, error) {
+	if err := validateIDRequest("fee_rule_id", req.GetFeeRuleId()); err != nil {
+		return nil, err
+	}
+
+	rule, err := server.services.FeeRules.GetRateSourceFeeRule(ctx, service.GetRateSourceFeeRuleInput{
+		FeeRuleID: req.GetFeeRuleId(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.GetRateSourceFeeRuleResponse{FeeRule: convertRateSourceFeeRule(rule)}, nil
+}
@@ -0,0 +1,19 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) GetRateSourceFreshness(
+	ctx context.Context,
+	req *pb.GetRateSourceFreshnessRequest,
+) (*pb.GetRateSourceFreshnessResponse, error) {
+	report, err := server.services.Health.GetRateSourceFreshness(ctx)
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return convertRateSourceFreshnessReport(report), nil
+}
@@ -0,0 +1,31 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) GetSpreads(
+	ctx context.Context,
+	req *pb.GetSpreadsRequest,
+) (*pb.GetSpreadsResponse, error) {
+	if err := validateGetSpreadsRequest(req); err != nil {
+		return nil, err
+	}
+
+	spreads, err := server.services.FX.GetSpreads(ctx, service.GetSpreadsInput{
+		SourceCurrencyID:      req.GetSourceCurrencyId(),
+		DestinationCurrencyID: req.GetDestinationCurrencyId(),
+		SourceID:              req.GetSourceId(),
+		Channel:               req.GetChannel(),
+		TimeRange:             req.GetTimeRange(),
+		Interval:              req.GetInterval(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return convertExchangeRateSpreads(spreads), nil
+}
@@ -0,0 +1,23 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) GetSubscriptionPlan(
+	ctx context.Context,
+	req *pb.GetSubscriptionPlanRequest,
+) (*pb.GetSubscriptionPlanResponse, error) {
+	if err := validateIDRequest("plan_id", req.GetPlanId()); err != nil {
+		return nil, err
+	}
+
+	plan, err := server.services.Plans.GetSubscriptionPlan(ctx, req.GetPlanId())
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.GetSubscriptionPlanResponse{Plan: convertSubscriptionPlan(plan)}, nil
+}
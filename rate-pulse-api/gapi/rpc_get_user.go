@@ -0,0 +1,27 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) GetUser(
+	ctx context.Context,
+	req *pb.GetUserRequest,
+) (*pb.GetUserResponse, error) {
+	if err := validateIDRequest("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+	if err := requireOwnUser(ctx, req.GetUserId(), true); err != nil {
+		return nil, err
+	}
+
+	user, err := server.services.Users.GetUser(ctx, service.GetUserInput{UserID: req.GetUserId()})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.GetUserResponse{User: convertUser(user)}, nil
+}
@@ -0,0 +1,42 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) IngestExchangeRates(
+	ctx context.Context,
+	req *pb.IngestExchangeRatesRequest,
+) (*pb.IngestExchangeRatesResponse, error) {
+	if err := validateIngestExchangeRatesRequest(req); err != nil {
+		return nil, err
+	}
+
+	rows := make([]service.IngestExchangeRateRow, len(req.GetRates()))
+	for i, rate := range req.GetRates() {
+		rows[i] = service.IngestExchangeRateRow{
+			SourceCode:              rate.GetSourceCode(),
+			SourceCurrencyCode:      rate.GetSourceCurrencyCode(),
+			DestinationCurrencyCode: rate.GetDestinationCurrencyCode(),
+			TypeName:                rate.GetTypeName(),
+			RateValue:               rate.GetRateValue(),
+		}
+		if rate.GetValidFromDate() != nil {
+			rows[i].ValidFromDate = rate.GetValidFromDate().AsTime()
+		}
+	}
+
+	result, err := server.services.FX.IngestExchangeRates(ctx, service.IngestExchangeRatesInput{Rates: rows})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	if result.Inserted > 0 {
+		server.deleteExchangeRateCaches(ctx)
+	}
+
+	return convertIngestExchangeRatesResult(result), nil
+}
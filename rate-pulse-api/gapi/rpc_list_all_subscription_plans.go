@@ -0,0 +1,19 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) ListAllSubscriptionPlans(
+	ctx context.Context,
+	req *pb.ListAllSubscriptionPlansRequest,
+) (*pb.ListAllSubscriptionPlansResponse, error) {
+	plans, err := server.services.Plans.ListSubscriptionPlans(ctx, true)
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.ListAllSubscriptionPlansResponse{Plans: convertSubscriptionPlans(plans)}, nil
+}
@@ -0,0 +1,24 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) ListCountries(
+	ctx context.Context,
+	req *pb.ListCountriesRequest,
+) (*pb.ListCountriesResponse, error) {
+	countries, err := server.services.Countries.ListCountries(ctx)
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	pbCountries := make([]*pb.Country, len(countries))
+	for i, country := range countries {
+		pbCountries[i] = convertCountry(country)
+	}
+
+	return &pb.ListCountriesResponse{Countries: pbCountries}, nil
+}
@@ -0,0 +1,24 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) ListCurrencies(
+	ctx context.Context,
+	req *pb.ListCurrenciesRequest,
+) (*pb.ListCurrenciesResponse, error) {
+	currencies, err := server.services.Currencies.ListCurrencies(ctx)
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	pbCurrencies := make([]*pb.Currency, len(currencies))
+	for i, currency := range currencies {
+		pbCurrencies[i] = convertCurrency(currency)
+	}
+
+	return &pb.ListCurrenciesResponse{Currencies: pbCurrencies}, nil
+}
@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) ListCurrencyPreferences(
+	ctx context.Context,
+	req *pb.ListCurrencyPreferencesRequest,
+) (*pb.ListCurrencyPreferencesResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePageRequest(req.GetPageId(), req.GetPageSize(), 5, 10); err != nil {
+		return nil, err
+	}
+
+	preferences, err := server.services.Preferences.ListCurrencyPreferences(ctx, service.ListPreferencesInput{
+		UserID:   payload.UserID,
+		PageID:   req.GetPageId(),
+		PageSize: req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	pbPreferences := make([]*pb.CurrencyPreference, len(preferences))
+	for i, preference := range preferences {
+		pbPreferences[i] = convertCurrencyPreference(preference)
+	}
+
+	return &pb.ListCurrencyPreferencesResponse{Preferences: pbPreferences}, nil
+}
@@ -0,0 +1,24 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) ListPayments(
+	ctx context.Context,
+	req *pb.ListPaymentsRequest,
+) (*pb.ListPaymentsResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := server.services.Payments.ListUserPayments(ctx, payload.UserID)
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.ListPaymentsResponse{Payments: convertPayments(payments)}, nil
+}
@@ -0,0 +1,27 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) ListQuarantinedExchangeRates(
+	ctx context.Context,
+	req *pb.ListQuarantinedExchangeRatesRequest,
+) (*pb.ListQuarantinedExchangeRatesResponse, error) {
+	if err := validateListQuarantinedExchangeRatesRequest(req); err != nil {
+		return nil, err
+	}
+
+	rates, err := server.services.FX.ListQuarantinedExchangeRates(ctx, service.ListQuarantinedExchangeRatesInput{
+		PageID:   req.GetPageId(),
+		PageSize: req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.ListQuarantinedExchangeRatesResponse{ExchangeRates: convertExchangeRates(rates)}, nil
+}
@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) ListRateAlerts(
+	ctx context.Context,
+	req *pb.ListRateAlertsRequest,
+) (*pb.ListRateAlertsResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateListRateAlertsRequest(req); err != nil {
+		return nil, err
+	}
+
+	alerts, err := server.services.Alerts.ListRateAlerts(ctx, service.ListRateAlertsInput{
+		UserID:   payload.UserID,
+		PageID:   req.GetPageId(),
+		PageSize: req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	pbAlerts := make([]*pb.RateAlert, len(alerts))
+	for i, alert := range alerts {
+		pbAlerts[i] = convertRateAlert(alert)
+	}
+
+	return &pb.ListRateAlertsResponse{Alerts: pbAlerts}, nil
+}
@@ -0,0 +1,38 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) ListRateSourceFeeRules(
+	ctx context.Context,
+	req *pb.ListRateSourceFeeRulesRequest,
+) (*pb.ListRateSourceFeeRulesResponse, error) {
+	if err := validateListRateSourceFeeRulesRequest(req); err != nil {
+		return nil, err
+	}
+
+	page, err := server.services.FeeRules.ListRateSourceFeeRules(ctx, service.ListRateSourceFeeRulesInput{
+		Page: service.PageInput{
+			Cursor:   req.GetCursor(),
+			PageSize: req.GetPageSize(),
+			Sort:     req.GetSort(),
+		},
+		SourceID: req.SourceId,
+		TypeID:   req.TypeId,
+		ActiveOn: timeFromTimestamp(req.GetActiveOn()),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	pbRules := make([]*pb.RateSourceFeeRule, len(page.FeeRules))
+	for i, rule := range page.FeeRules {
+		pbRules[i] = convertRateSourceFeeRule(rule)
+	}
+
+	return &pb.ListRateSourceFeeRulesResponse{FeeRules: pbRules, NextCursor: page.NextCursor}, nil
+}
@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) ListRateSourcePreferences(
+	ctx context.Context,
+	req *pb.ListRateSourcePreferencesRequest,
+) (*pb.ListRateSourcePreferencesResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePageRequest(req.GetPageId(), req.GetPageSize(), 5, 10); err != nil {
+		return nil, err
+	}
+
+	preferences, err := server.services.Preferences.ListRateSourcePreferences(ctx, service.ListPreferencesInput{
+		UserID:   payload.UserID,
+		PageID:   req.GetPageId(),
+		PageSize: req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	pbPreferences := make([]*pb.RateSourcePreference, len(preferences))
+	for i, preference := range preferences {
+		pbPreferences[i] = convertRateSourcePreference(preference)
+	}
+
+	return &pb.ListRateSourcePreferencesResponse{Preferences: pbPreferences}, nil
+}
@@ -0,0 +1,38 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) ListRateSources(
+	ctx context.Context,
+	req *pb.ListRateSourcesRequest,
+) (*pb.ListRateSourcesResponse, error) {
+	if err := validateListRateSourcesRequest(req); err != nil {
+		return nil, err
+	}
+
+	page, err := server.services.RateSources.ListRateSources(ctx, service.ListRateSourcesInput{
+		Page: service.PageInput{
+			Cursor:   req.GetCursor(),
+			PageSize: req.GetPageSize(),
+			Sort:     req.GetSort(),
+		},
+		Status:  req.Status,
+		Country: req.Country,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	sources := service.NewRateSourcesFromPageRows(page.RateSources)
+	pbSources := make([]*pb.RateSource, len(sources))
+	for i, source := range sources {
+		pbSources[i] = convertRateSource(source)
+	}
+
+	return &pb.ListRateSourcesResponse{RateSources: pbSources, NextCursor: page.NextCursor}, nil
+}
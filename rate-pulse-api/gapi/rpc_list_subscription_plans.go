@@ -0,0 +1,19 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) ListSubscriptionPlans(
+	ctx context.Context,
+	req *pb.ListSubscriptionPlansRequest,
+) (*pb.ListSubscriptionPlansResponse, error) {
+	plans, err := server.services.Plans.ListSubscriptionPlans(ctx, false)
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.ListSubscriptionPlansResponse{Plans: convertSubscriptionPlans(plans)}, nil
+}
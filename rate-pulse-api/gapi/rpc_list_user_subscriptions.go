@@ -0,0 +1,24 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+)
+
+func (server *Server) ListUserSubscriptions(
+	ctx context.Context,
+	req *pb.ListUserSubscriptionsRequest,
+) (*pb.ListUserSubscriptionsResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := server.services.Subscriptions.ListUserSubscriptionsForUser(ctx, payload.UserID)
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.ListUserSubscriptionsResponse{Subscriptions: convertUserSubscriptions(subscriptions)}, nil
+}
@@ -0,0 +1,39 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) ListUsers(
+	ctx context.Context,
+	req *pb.ListUsersRequest,
+) (*pb.ListUsersResponse, error) {
+	if err := validateListUsersRequest(req); err != nil {
+		return nil, err
+	}
+
+	page, err := server.services.Users.ListUsers(ctx, service.ListUsersInput{
+		Page: service.PageInput{
+			Cursor:   req.GetCursor(),
+			PageSize: req.GetPageSize(),
+			Sort:     req.GetSort(),
+		},
+		UserType:    req.UserType,
+		IsActive:    req.IsActive,
+		CreatedFrom: timeFromTimestamp(req.GetCreatedFrom()),
+		CreatedTo:   timeFromTimestamp(req.GetCreatedTo()),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	pbUsers := make([]*pb.User, len(page.Users))
+	for i, user := range page.Users {
+		pbUsers[i] = convertUser(user)
+	}
+
+	return &pb.ListUsersResponse{Users: pbUsers, NextCursor: page.NextCursor}, nil
+}
@@ -16,15 +16,21 @@ func (server *Server) RenewAccessToken(
 		return nil, err
 	}
 
+	metadata := server.GetMetadata(ctx)
 	result, err := server.services.Auth.RenewAccessToken(ctx, service.RenewAccessTokenInput{
 		RefreshToken: req.GetRefreshToken(),
+		UserAgent:    metadata.UserAgent,
+		ClientIP:     metadata.ClientIp,
 	})
 	if err != nil {
 		return nil, statusFromServiceError(err)
 	}
 
 	return &pb.RenewAccessTokenResponse{
-		AccessToken:          result.AccessToken,
-		AccessTokenExpiresAt: timestamppb.New(result.AccessTokenExpiresAt),
+		AccessToken:           result.AccessToken,
+		AccessTokenExpiresAt:  timestamppb.New(result.AccessTokenExpiresAt),
+		RefreshToken:          result.RefreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(result.RefreshTokenExpiresAt),
+		SessionId:             result.SessionID.String(),
 	}, nil
 }
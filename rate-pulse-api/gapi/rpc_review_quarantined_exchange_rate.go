@@ -0,0 +1,31 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) ReviewQuarantinedExchangeRate(
+	ctx context.Context,
+	req *pb.ReviewQuarantinedExchangeRateRequest,
+) (*pb.ReviewQuarantinedExchangeRateResponse, error) {
+	if err := validateReviewQuarantinedExchangeRateRequest(req); err != nil {
+		return nil, err
+	}
+
+	rate, err := server.services.FX.ReviewQuarantinedExchangeRate(ctx, service.ReviewQuarantinedExchangeRateInput{
+		RateID: req.GetRateId(),
+		Action: req.GetAction(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	if rate.Status == service.ExchangeRateStatusActive {
+		server.deleteExchangeRateCaches(ctx)
+	}
+
+	return &pb.ReviewQuarantinedExchangeRateResponse{ExchangeRate: convertExchangeRate(rate)}, nil
+}
@@ -27,6 +27,16 @@ func (server *Server) SignInUser(
 		return nil, statusFromServiceError(err)
 	}
 
+	if result.MFARequired {
+		return &pb.SignInUserResponse{
+			User:                  convertUser(result.User),
+			MfaRequired:           true,
+			MfaEnrollmentRequired: result.MFAEnrollmentRequired,
+			MfaToken:              result.MFAToken,
+			MfaTokenExpiresAt:     timestamppb.New(result.MFATokenExpiresAt),
+		}, nil
+	}
+
 	response := &pb.SignInUserResponse{
 		User:                  convertUser(result.User),
 		SessionId:             result.SessionID.String(),
@@ -0,0 +1,27 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) SignOutUser(
+	ctx context.Context,
+	req *pb.SignOutUserRequest,
+) (*pb.SignOutUserResponse, error) {
+	if err := validateSignOutUserRequest(req); err != nil {
+		return nil, err
+	}
+
+	accessToken, _ := accessTokenFromMetadata(ctx)
+	if err := server.services.Auth.SignOut(ctx, service.SignOutInput{
+		RefreshToken: req.GetRefreshToken(),
+		AccessToken:  accessToken,
+	}); err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.SignOutUserResponse{}, nil
+}
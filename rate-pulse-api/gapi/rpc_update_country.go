@@ -0,0 +1,30 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) UpdateCountry(
+	ctx context.Context,
+	req *pb.UpdateCountryRequest,
+) (*pb.UpdateCountryResponse, error) {
+	if err := validateUpdateCountryRequest(req); err != nil {
+		return nil, err
+	}
+
+	country, err := server.services.Countries.UpdateCountry(ctx, service.UpdateCountryInput{
+		CountryID:   req.GetCountryId(),
+		CountryName: req.CountryName,
+		CountryCode: req.CountryCode,
+		CurrencyID:  req.CurrencyId,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	server.deleteCountryCaches(ctx)
+	return &pb.UpdateCountryResponse{Country: convertCountry(country)}, nil
+}
@@ -0,0 +1,30 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) UpdateCurrency(
+	ctx context.Context,
+	req *pb.UpdateCurrencyRequest,
+) (*pb.UpdateCurrencyResponse, error) {
+	if err := validateIDRequest("currency_id", req.GetCurrencyId()); err != nil {
+		return nil, err
+	}
+
+	currency, err := server.services.Currencies.UpdateCurrency(ctx, service.UpdateCurrencyInput{
+		CurrencyID:     req.GetCurrencyId(),
+		CurrencyCode:   req.CurrencyCode,
+		CurrencyName:   req.CurrencyName,
+		CurrencySymbol: req.CurrencySymbol,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	server.deleteCurrencyCaches(ctx)
+	return &pb.UpdateCurrencyResponse{Currency: convertCurrency(currency)}, nil
+}
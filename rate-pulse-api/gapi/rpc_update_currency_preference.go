@@ -0,0 +1,33 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) UpdateCurrencyPreference(
+	ctx context.Context,
+	req *pb.UpdateCurrencyPreferenceRequest,
+) (*pb.UpdateCurrencyPreferenceResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateIDRequest("currency_id", req.GetCurrencyId()); err != nil {
+		return nil, err
+	}
+
+	preference, err := server.services.Preferences.UpdateCurrencyPreference(ctx, service.CurrencyPreferenceInput{
+		UserID:       payload.UserID,
+		CurrencyID:   req.GetCurrencyId(),
+		IsFavorite:   req.IsFavorite,
+		DisplayOrder: req.DisplayOrder,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.UpdateCurrencyPreferenceResponse{Preference: convertCurrencyPreference(preference)}, nil
+}
@@ -0,0 +1,33 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) UpdateExchangeRate(
+	ctx context.Context,
+	req *pb.UpdateExchangeRateRequest,
+) (*pb.UpdateExchangeRateResponse, error) {
+	if err := validateUpdateExchangeRateRequest(req); err != nil {
+		return nil, err
+	}
+
+	exchangeRate, err := server.services.FX.UpdateExchangeRate(ctx, service.UpdateExchangeRateInput{
+		RateID:                req.GetRateId(),
+		RateValue:             req.RateValue,
+		SourceCurrencyID:      req.SourceCurrencyId,
+		DestinationCurrencyID: req.DestinationCurrencyId,
+		ValidFromDate:         timeFromTimestamp(req.GetValidFromDate()),
+		ValidToDate:           timeFromTimestamp(req.GetValidToDate()),
+		SourceID:              req.SourceId,
+		TypeID:                req.TypeId,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.UpdateExchangeRateResponse{ExchangeRate: convertExchangeRate(exchangeRate)}, nil
+}
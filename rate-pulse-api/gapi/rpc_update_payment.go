@@ -0,0 +1,33 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) UpdatePayment(
+	ctx context.Context,
+	req *pb.UpdatePaymentRequest,
+) (*pb.UpdatePaymentResponse, error) {
+	if err := validateUpdatePaymentRequest(req); err != nil {
+		return nil, err
+	}
+
+	payment, err := server.services.Payments.UpdatePayment(ctx, service.UpdatePaymentInput{
+		PaymentID:      req.GetPaymentId(),
+		SubscriptionID: req.SubscriptionId,
+		TransactionID:  req.TransactionId,
+		Amount:         req.Amount,
+		CurrencyCode:   req.CurrencyCode,
+		PaymentMethod:  req.PaymentMethod,
+		PaymentStatus:  req.PaymentStatus,
+		PaymentDate:    timeFromTimestamp(req.GetPaymentDate()),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.UpdatePaymentResponse{Payment: convertPayment(payment)}, nil
+}
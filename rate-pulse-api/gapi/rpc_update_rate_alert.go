@@ -0,0 +1,36 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) UpdateRateAlert(
+	ctx context.Context,
+	req *pb.UpdateRateAlertRequest,
+) (*pb.UpdateRateAlertResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateUpdateRateAlertRequest(req); err != nil {
+		return nil, err
+	}
+
+	alert, err := server.services.Alerts.UpdateRateAlert(ctx, service.UpdateRateAlertInput{
+		UserID:        payload.UserID,
+		AlertID:       req.GetAlertId(),
+		Condition:     req.Condition,
+		Threshold:     req.Threshold,
+		ChangePercent: req.ChangePercent,
+		WindowHours:   req.WindowHours,
+		IsActive:      req.IsActive,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.UpdateRateAlertResponse{Alert: convertRateAlert(alert)}, nil
+}
@@ -0,0 +1,33 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) UpdateRateSource(
+	ctx context.Context,
+	req *pb.UpdateRateSourceRequest,
+) (*pb.UpdateRateSourceResponse, error) {
+	if err := validateIDRequest("source_id", req.GetSourceId()); err != nil {
+		return nil, err
+	}
+
+	source, err := server.services.RateSources.UpdateRateSource(ctx, service.UpdateRateSourceInput{
+		SourceID:                req.GetSourceId(),
+		SourceName:              req.SourceName,
+		SourceLink:              req.SourceLink,
+		SourceCountry:           req.SourceCountry,
+		SourceStatus:            req.SourceStatus,
+		SourceCode:              req.SourceCode,
+		ExpectedIntervalMinutes: req.ExpectedIntervalMinutes,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	server.deleteRateSourceCaches(ctx)
+	return &pb.UpdateRateSourceResponse{RateSource: convertRateSource(source)}, nil
+}
@@ -0,0 +1,48 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) UpdateRateSourceFeeRule(
+	ctx context.Context,
+	req *pb.UpdateRateSourceFeeRuleRequest,
+) (*pb.UpdateRateSourceFeeRuleResponse, error) {
+	if err := validateUpdateRateSourceFeeRuleRequest(req); err != nil {
+		return nil, err
+	}
+
+	rule, err := server.services.FeeRules.UpdateRateSourceFeeRule(ctx, service.UpdateRateSourceFeeRuleInput{
+		FeeRuleID:          req.GetFeeRuleId(),
+		SourceID:           req.SourceId,
+		TypeID:             req.TypeId,
+		TransactionType:    req.TransactionType,
+		Channel:            req.Channel,
+		FeeRate:            req.FeeRate,
+		FeeRateMin:         req.FeeRateMin,
+		FeeRateMax:         req.FeeRateMax,
+		FeeCurrencyID:      req.FeeCurrencyId,
+		FixedFee:           req.FixedFee,
+		MinFee:             req.MinFee,
+		MaxFee:             req.MaxFee,
+		VatRate:            req.VatRate,
+		VatApplies:         req.VatApplies,
+		FeeIncludesVat:     req.FeeIncludesVat,
+		SwiftFee:           req.SwiftFee,
+		SwiftFeeCurrencyID: req.SwiftFeeCurrencyId,
+		SwiftFeeIncluded:   req.SwiftFeeIncluded,
+		SourceURL:          req.SourceUrl,
+		SourceNote:         req.SourceNote,
+		EffectiveFrom:      timeFromTimestamp(req.GetEffectiveFrom()),
+		EffectiveTo:        timeFromTimestamp(req.GetEffectiveTo()),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	server.deleteRateSourceFeeRuleCaches(ctx)
+	return &pb.UpdateRateSourceFeeRuleResponse{FeeRule: convertRateSourceFeeRule(rule)}, nil
+}
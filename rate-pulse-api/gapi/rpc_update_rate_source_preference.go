@@ -0,0 +1,32 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) UpdateRateSourcePreference(
+	ctx context.Context,
+	req *pb.UpdateRateSourcePreferenceRequest,
+) (*pb.UpdateRateSourcePreferenceResponse, error) {
+	payload, err := requireAuthorizationPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateIDRequest("source_id", req.GetSourceId()); err != nil {
+		return nil, err
+	}
+
+	preference, err := server.services.Preferences.UpdateRateSourcePreference(ctx, service.RateSourcePreferenceInput{
+		UserID:    payload.UserID,
+		SourceID:  req.GetSourceId(),
+		IsPrimary: req.IsPrimary,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.UpdateRateSourcePreferenceResponse{Preference: convertRateSourcePreference(preference)}, nil
+}
@@ -0,0 +1,32 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) UpdateSubscriptionPlan(
+	ctx context.Context,
+	req *pb.UpdateSubscriptionPlanRequest,
+) (*pb.UpdateSubscriptionPlanResponse, error) {
+	if err := validateIDRequest("plan_id", req.GetPlanId()); err != nil {
+		return nil, err
+	}
+
+	plan, err := server.services.Plans.UpdateSubscriptionPlan(ctx, service.UpdateSubscriptionPlanInput{
+		PlanID:          req.GetPlanId(),
+		PlanName:        req.PlanName,
+		PlanPrice:       req.PlanPrice,
+		HistoricalDays:  req.HistoricalDays,
+		RateLimitPerDay: req.RateLimitPerDay,
+		Features:        req.Features,
+		IsActive:        req.IsActive,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.UpdateSubscriptionPlanResponse{Plan: convertSubscriptionPlan(plan)}, nil
+}
@@ -0,0 +1,38 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) UpdateUser(
+	ctx context.Context,
+	req *pb.UpdateUserRequest,
+) (*pb.UpdateUserResponse, error) {
+	if err := validateIDRequest("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+	if err := requireOwnUser(ctx, req.GetUserId(), false); err != nil {
+		return nil, err
+	}
+
+	user, err := server.services.Users.UpdateUser(ctx, service.UpdateUserInput{
+		UserID:             req.GetUserId(),
+		Username:           req.Username,
+		Email:              req.Email,
+		Password:           req.Password,
+		TimeZone:           req.TimeZone,
+		LanguagePreference: req.LanguagePreference,
+		CountryOfResidence: req.CountryOfResidence,
+		CountryOfBirth:     req.CountryOfBirth,
+		FirstName:          req.FirstName,
+		LastName:           req.LastName,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.UpdateUserResponse{User: convertUser(user)}, nil
+}
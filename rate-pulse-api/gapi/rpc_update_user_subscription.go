@@ -0,0 +1,31 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) UpdateUserSubscription(
+	ctx context.Context,
+	req *pb.UpdateUserSubscriptionRequest,
+) (*pb.UpdateUserSubscriptionResponse, error) {
+	if err := validateUpdateUserSubscriptionRequest(req); err != nil {
+		return nil, err
+	}
+
+	subscription, err := server.services.Subscriptions.UpdateUserSubscription(ctx, service.UpdateUserSubscriptionInput{
+		SubscriptionID: req.GetSubscriptionId(),
+		PlanID:         req.PlanId,
+		Status:         req.Status,
+		StartDate:      timeFromTimestamp(req.GetStartDate()),
+		EndDate:        timeFromTimestamp(req.GetEndDate()),
+		AutoRenew:      req.AutoRenew,
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.UpdateUserSubscriptionResponse{Subscription: convertUserSubscription(subscription)}, nil
+}
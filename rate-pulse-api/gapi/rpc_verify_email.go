@@ -0,0 +1,27 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
+)
+
+func (server *Server) VerifyEmail(
+	ctx context.Context,
+	req *pb.VerifyEmailRequest,
+) (*pb.VerifyEmailResponse, error) {
+	if err := validateVerifyEmailRequest(req); err != nil {
+		return nil, err
+	}
+
+	result, err := server.services.Auth.VerifyEmail(ctx, service.VerifyEmailInput{
+		EmailID:    req.GetEmailId(),
+		SecretCode: req.GetSecretCode(),
+	})
+	if err != nil {
+		return nil, statusFromServiceError(err)
+	}
+
+	return &pb.VerifyEmailResponse{User: convertUser(result.User)}, nil
+}
@@ -0,0 +1,45 @@
+package gapi
+
+import (
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchExchangeRates streams active exchange rates as they are written, until the client
+// disconnects. It replaces polling GetLatestExchangeRates for internal consumers.
+func (server *Server) WatchExchangeRates(
+	req *pb.WatchExchangeRatesRequest,
+	stream pb.RatePulseExchangeRateService_WatchExchangeRatesServer,
+) error {
+	if err := validateWatchExchangeRatesRequest(req); err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	updates, err := server.rateUpdates.SubscribeRateUpdates(ctx, pubsub.RateFilter{
+		SourceCurrencyID: req.GetSourceCurrencyId(),
+		SourceID:         req.GetSourceId(),
+		TypeID:           req.GetTypeId(),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("request_id", requestIDFromContext(ctx)).Msg("failed to subscribe to rate updates")
+		return status.Error(codes.Unavailable, "live exchange rate updates are unavailable")
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case update, ok := <-updates:
+			if !ok {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			if err := stream.Send(&pb.WatchExchangeRatesResponse{Rate: convertExchangeRateUpdate(update)}); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeRateUpdates hands out a prepared channel and records the requested filter.
+type fakeRateUpdates struct {
+	updates chan pubsub.RateUpdate
+	filter  pubsub.RateFilter
+	err     error
+}
+
+func (f *fakeRateUpdates) SubscribeRateUpdates(ctx context.Context, filter pubsub.RateFilter) (<-chan pubsub.RateUpdate, error) {
+	f.filter = filter
+	return f.updates, f.err
+}
+
+func TestWatchExchangeRatesStreamsUntilCanceled(t *testing.T) {
+	rateUpdates := &fakeRateUpdates{updates: make(chan pubsub.RateUpdate)}
+	server := &Server{rateUpdates: rateUpdates}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeServerStream{ctx: ctx}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- server.WatchExchangeRates(
+			&pb.WatchExchangeRatesRequest{SourceCurrencyId: 1, SourceId: 10},
+			&grpc.GenericServerStream[pb.WatchExchangeRatesRequest, pb.WatchExchangeRatesResponse]{ServerStream: stream},
+		)
+	}()
+
+	rateUpdates.updates <- pubsub.RateUpdate{RateID: 1, RateValue: "1.1"}
+	rateUpdates.updates <- pubsub.RateUpdate{RateID: 2, RateValue: "1.2"}
+	cancel()
+
+	require.Equal(t, codes.Canceled, status.Code(<-done))
+	require.Equal(t, pubsub.RateFilter{SourceCurrencyID: 1, SourceID: 10}, rateUpdates.filter)
+	require.Len(t, stream.sent, 2)
+	response, ok := stream.sent[1].(*pb.WatchExchangeRatesResponse)
+	require.True(t, ok)
+	require.Equal(t, int32(2), response.GetRate().GetRateId())
+	require.Equal(t, "1.2", response.GetRate().GetRateValue())
+}
+
+func TestWatchExchangeRatesUnavailable(t *testing.T) {
+	server := &Server{rateUpdates: &fakeRateUpdates{err: errors.New("redis down")}}
+	stream := &fakeServerStream{ctx: context.Background()}
+
+	err := server.WatchExchangeRates(
+		&pb.WatchExchangeRatesRequest{},
+		&grpc.GenericServerStream[pb.WatchExchangeRatesRequest, pb.WatchExchangeRatesResponse]{ServerStream: stream},
+	)
+
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestWatchExchangeRatesInvalidFilter(t *testing.T) {
+	server := &Server{rateUpdates: &fakeRateUpdates{}}
+	stream := &fakeServerStream{ctx: context.Background()}
+
+	err := server.WatchExchangeRates(
+		&pb.WatchExchangeRatesRequest{SourceId: -1},
+		&grpc.GenericServerStream[pb.WatchExchangeRatesRequest, pb.WatchExchangeRatesResponse]{ServerStream: stream},
+	)
+
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
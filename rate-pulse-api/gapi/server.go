@@ -1,9 +1,12 @@
 package gapi
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/ThanhVinhTong/rate-pulse/cache"
 	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
 	"github.com/ThanhVinhTong/rate-pulse/service"
 	"github.com/ThanhVinhTong/rate-pulse/token"
 	"github.com/ThanhVinhTong/rate-pulse/util"
@@ -16,9 +19,20 @@ type Server struct {
 	pb.UnimplementedRatePulseAuthenticationServiceServer
 	pb.UnimplementedRatePulseExchangeRateServiceServer
 	pb.UnimplementedRatePulseInternalHealthServiceServer
-	config     util.Config
-	services   *service.Services
-	tokenMaker token.Maker
+	pb.UnimplementedRatePulseUserServiceServer
+	pb.UnimplementedRatePulseRateSourceFeeRuleServiceServer
+	pb.UnimplementedRatePulseRateAlertServiceServer
+	pb.UnimplementedRatePulseCurrencyServiceServer
+	pb.UnimplementedRatePulseCountryServiceServer
+	pb.UnimplementedRatePulseRateSourceServiceServer
+	pb.UnimplementedRatePulseSubscriptionServiceServer
+	pb.UnimplementedRatePulsePaymentServiceServer
+	pb.UnimplementedRatePulsePreferenceServiceServer
+	config        util.Config
+	services      *service.Services
+	tokenMaker    token.Maker
+	responseCache cache.ResponseCache
+	rateUpdates   pubsub.Subscriber
 }
 
 // NewServer creates a gRPC server implementation.
@@ -31,21 +45,95 @@ func NewServer(config util.Config, services *service.Services, tokenMaker token.
 	if services.Auth == nil {
 		return nil, fmt.Errorf("auth service must not be nil")
 	}
+	if services.Users == nil {
+		return nil, fmt.Errorf("user service must not be nil")
+	}
 	if services.FX == nil {
 		return nil, fmt.Errorf("fx service must not be nil")
 	}
+	if services.FeeRules == nil {
+		return nil, fmt.Errorf("fee rule service must not be nil")
+	}
+	if services.Alerts == nil {
+		return nil, fmt.Errorf("alert service must not be nil")
+	}
 	if services.Health == nil {
 		return nil, fmt.Errorf("health service must not be nil")
 	}
+	if services.Currencies == nil {
+		return nil, fmt.Errorf("currency service must not be nil")
+	}
+	if services.Countries == nil {
+		return nil, fmt.Errorf("country service must not be nil")
+	}
+	if services.RateSources == nil {
+		return nil, fmt.Errorf("rate source service must not be nil")
+	}
+	if services.Plans == nil {
+		return nil, fmt.Errorf("subscription plan service must not be nil")
+	}
+	if services.Subscriptions == nil {
+		return nil, fmt.Errorf("user subscription service must not be nil")
+	}
+	if services.Payments == nil {
+		return nil, fmt.Errorf("payment service must not be nil")
+	}
+	if services.Preferences == nil {
+		return nil, fmt.Errorf("preference service must not be nil")
+	}
 	if tokenMaker == nil {
 		return nil, fmt.Errorf("token maker must not be nil")
 	}
 
 	server := &Server{
-		config:     config,
-		services:   services,
-		tokenMaker: tokenMaker,
+		config:        config,
+		services:      services,
+		tokenMaker:    tokenMaker,
+		responseCache: cache.NoopResponseCache{},
+		rateUpdates:   pubsub.NoopBroker{},
 	}
 
 	return server, nil
 }
+
+// SetResponseCache lets RPCs that change data invalidate the REST response cache.
+func (server *Server) SetResponseCache(responseCache cache.ResponseCache) {
+	if responseCache == nil {
+		return
+	}
+
+	server.responseCache = responseCache
+}
+
+// SetRateUpdates lets WatchExchangeRates stream rates written by any API replica.
+func (server *Server) SetRateUpdates(rateUpdates pubsub.Subscriber) {
+	if rateUpdates == nil {
+		return
+	}
+
+	server.rateUpdates = rateUpdates
+}
+
+// deleteExchangeRateCaches drops cached REST responses derived from exchange rates after new rates go live.
+// The latest, historical, candle, cross-rate and spread responses all live under this prefix.
+func (server *Server) deleteExchangeRateCaches(ctx context.Context) {
+	_ = server.responseCache.DeleteByPrefix(ctx, cache.HTTPKeyPrefix+"exchange-rates")
+}
+
+func (server *Server) deleteRateSourceFeeRuleCaches(ctx context.Context) {
+	_ = server.responseCache.DeleteByPrefix(ctx, cache.HTTPKeyPrefix+"rate-source-fee-rules")
+}
+
+// deleteCurrencyCaches also drops the codes-and-names list, which shares the prefix.
+func (server *Server) deleteCurrencyCaches(ctx context.Context) {
+	_ = server.responseCache.DeleteByPrefix(ctx, cache.HTTPKeyPrefix+"currencies")
+}
+
+func (server *Server) deleteCountryCaches(ctx context.Context) {
+	_ = server.responseCache.DeleteByPrefix(ctx, cache.HTTPKeyPrefix+"countries")
+}
+
+// deleteRateSourceCaches drops the paged lists and the metadata list of rate sources.
+func (server *Server) deleteRateSourceCaches(ctx context.Context) {
+	_ = server.responseCache.DeleteByPrefix(ctx, cache.HTTPKeyPrefix+"rate-sources")
+}
@@ -0,0 +1,117 @@
+package gapi
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/ThanhVinhTong/rate-pulse/service"
+	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// StreamServerInterceptor applies the same recovery, request ID, logging, auth and quota
+// chain as UnaryServerInterceptor to streaming RPCs. Opening a stream counts as one request.
+func StreamServerInterceptor(
+	tokenMaker token.Maker,
+	revocations token.RevocationList,
+	apiKeys service.APIKeyUseCase,
+	quotas service.QuotaUseCase,
+) grpc.StreamServerInterceptor {
+	return chainStreamInterceptors(
+		recoveryStreamInterceptor(),
+		requestIDStreamInterceptor(),
+		loggingStreamInterceptor(),
+		authStreamInterceptor(tokenMaker, revocations, apiKeys),
+		quotaStreamInterceptor(quotas),
+	)
+}
+
+// contextServerStream replaces the stream context so interceptors can pass values to the handler.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *contextServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+func withStreamContext(stream grpc.ServerStream, ctx context.Context) grpc.ServerStream {
+	return &contextServerStream{ServerStream: stream, ctx: ctx}
+}
+
+func chainStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		chainedHandler := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			currentInterceptor := interceptors[i]
+			nextHandler := chainedHandler
+			chainedHandler = func(currentSrv any, currentStream grpc.ServerStream) error {
+				return currentInterceptor(currentSrv, currentStream, info, nextHandler)
+			}
+		}
+		return chainedHandler(srv, stream)
+	}
+}
+
+func recoveryStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				log.Error().
+					Str("method", info.FullMethod).
+					Str("request_id", requestIDFromContext(stream.Context())).
+					Interface("panic", recovered).
+					Bytes("stack", debug.Stack()).
+					Msg("grpc stream panic recovered")
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+		return handler(srv, stream)
+	}
+}
+
+func requestIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, requestID := ensureRequestID(stream.Context())
+		stream.SetHeader(metadata.Pairs(requestIDHeaderKey, requestID))
+		return handler(srv, withStreamContext(stream, ctx))
+	}
+}
+
+func loggingStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, stream)
+		logCompletedRPC(stream.Context(), info.FullMethod, start, err, "grpc stream completed")
+		return err
+	}
+}
+
+func authStreamInterceptor(tokenMaker token.Maker, revocations token.RevocationList, apiKeys service.APIKeyUseCase) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(stream.Context(), tokenMaker, revocations, apiKeys, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, withStreamContext(stream, ctx))
+	}
+}
+
+func quotaStreamInterceptor(quotas service.QuotaUseCase) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		md, err := meterRequest(stream.Context(), quotas, info.FullMethod)
+		if len(md) > 0 {
+			stream.SetHeader(md)
+		}
+		if err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
@@ -0,0 +1,105 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/ThanhVinhTong/rate-pulse/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeServerStream records headers and sent messages for stream interceptor and handler tests.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+	sent   []any
+}
+
+func (stream *fakeServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *fakeServerStream) SetHeader(md metadata.MD) error {
+	stream.header = metadata.Join(stream.header, md)
+	return nil
+}
+
+func (stream *fakeServerStream) SendMsg(m any) error {
+	stream.sent = append(stream.sent, m)
+	return nil
+}
+
+func newTestTokenMaker(t *testing.T) token.Maker {
+	tokenMaker, err := token.NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+	return tokenMaker
+}
+
+func incomingContext(pairs ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+}
+
+func watchStreamInfo() *grpc.StreamServerInfo {
+	return &grpc.StreamServerInfo{
+		FullMethod:     pb.RatePulseExchangeRateService_WatchExchangeRates_FullMethodName,
+		IsServerStream: true,
+	}
+}
+
+func TestStreamServerInterceptorRequiresAccessToken(t *testing.T) {
+	interceptor := StreamServerInterceptor(newTestTokenMaker(t), token.NewMemoryRevocationList(time.Minute), newTestAPIKeys(), newTestQuotas())
+	stream := &fakeServerStream{ctx: incomingContext()}
+
+	called := false
+	err := interceptor(nil, stream, watchStreamInfo(), func(srv any, stream grpc.ServerStream) error {
+		called = true
+		return nil
+	})
+
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.False(t, called)
+	require.Len(t, stream.header.Get(requestIDHeaderKey), 1)
+}
+
+func TestStreamServerInterceptorPassesAuthAndRequestID(t *testing.T) {
+	tokenMaker := newTestTokenMaker(t)
+	accessToken, _, err := tokenMaker.CreateToken(7, "pricing", "pricing@email.com", "internal", time.Minute, token.TokenTypeAccess)
+	require.NoError(t, err)
+
+	interceptor := StreamServerInterceptor(tokenMaker, token.NewMemoryRevocationList(time.Minute), newTestAPIKeys(), newTestQuotas())
+	stream := &fakeServerStream{ctx: incomingContext(
+		authorizationHeaderKey, "Bearer "+accessToken,
+		requestIDHeaderKey, "req-123",
+	)}
+
+	err = interceptor(nil, stream, watchStreamInfo(), func(srv any, stream grpc.ServerStream) error {
+		payload, ok := authorizationPayloadFromContext(stream.Context())
+		require.True(t, ok)
+		require.Equal(t, int32(7), payload.UserID)
+		require.Equal(t, "req-123", requestIDFromContext(stream.Context()))
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"req-123"}, stream.header.Get(requestIDHeaderKey))
+}
+
+func TestStreamServerInterceptorRecoversPanic(t *testing.T) {
+	interceptor := StreamServerInterceptor(newTestTokenMaker(t), token.NewMemoryRevocationList(time.Minute), newTestAPIKeys(), newTestQuotas())
+	stream := &fakeServerStream{ctx: incomingContext()}
+	info := &grpc.StreamServerInfo{FullMethod: pb.RatePulseExchangeRateService_GetLatestExchangeRates_FullMethodName}
+
+	err := interceptor(nil, stream, info, func(srv any, stream grpc.ServerStream) error {
+		panic("boom")
+	})
+
+	require.Equal(t, codes.Internal, status.Code(err))
+}
@@ -1,10 +1,12 @@
 package gapi
 
 import (
+	"fmt"
 	"net/mail"
 	"strings"
 
 	"github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/service"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -54,6 +56,24 @@ func validateSignInUserRequest(req *pb.SignInUserRequest) error {
 	return nil
 }
 
+func validateCompleteSignInMFARequest(req *pb.CompleteSignInMFARequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateRequired("mfa_token", req.GetMfaToken()))
+	violations = appendViolation(violations, validateRequired("code", req.GetCode()))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateEnrollSignInTOTPRequest(req *pb.EnrollSignInTOTPRequest) error {
+	if violation := validateRequired("mfa_token", req.GetMfaToken()); violation != nil {
+		return invalidArgumentError(*violation)
+	}
+	return nil
+}
+
 func validateRenewAccessTokenRequest(req *pb.RenewAccessTokenRequest) error {
 	if strings.TrimSpace(req.GetRefreshToken()) == "" {
 		return invalidArgumentError(validationViolation{field: "refresh_token", reason: "refresh_token is required"})
@@ -76,6 +96,585 @@ func validateGetLatestExchangeRatesRequest(req *pb.GetLatestExchangeRatesRequest
 	return nil
 }
 
+func validateIngestExchangeRatesRequest(req *pb.IngestExchangeRatesRequest) error {
+	rates := req.GetRates()
+	if len(rates) == 0 || len(rates) > 1000 {
+		return invalidArgumentError(validationViolation{field: "rates", reason: "rates must contain between 1 and 1000 rows"})
+	}
+
+	var violations []validationViolation
+	for i, rate := range rates {
+		required := []struct {
+			field string
+			value string
+		}{
+			{field: "source_code", value: rate.GetSourceCode()},
+			{field: "source_currency_code", value: rate.GetSourceCurrencyCode()},
+			{field: "destination_currency_code", value: rate.GetDestinationCurrencyCode()},
+			{field: "type_name", value: rate.GetTypeName()},
+			{field: "rate_value", value: rate.GetRateValue()},
+		}
+		for _, r := range required {
+			if strings.TrimSpace(r.value) == "" {
+				violations = append(violations, validationViolation{
+					field:  fmt.Sprintf("rates[%d].%s", i, r.field),
+					reason: r.field + " is required",
+				})
+			}
+		}
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateWatchExchangeRatesRequest(req *pb.WatchExchangeRatesRequest) error {
+	var violations []validationViolation
+
+	filters := []struct {
+		field string
+		value int32
+	}{
+		{field: "source_currency_id", value: req.GetSourceCurrencyId()},
+		{field: "source_id", value: req.GetSourceId()},
+		{field: "type_id", value: req.GetTypeId()},
+	}
+	for _, filter := range filters {
+		if filter.value < 0 {
+			violations = append(violations, validationViolation{field: filter.field, reason: filter.field + " must not be negative"})
+		}
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateVerifyEmailRequest(req *pb.VerifyEmailRequest) error {
+	var violations []validationViolation
+
+	if req.GetEmailId() <= 0 {
+		violations = append(violations, validationViolation{field: "email_id", reason: "email_id must be greater than 0"})
+	}
+	violations = appendViolation(violations, validateRequired("secret_code", req.GetSecretCode()))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateSignOutUserRequest(req *pb.SignOutUserRequest) error {
+	if violation := validateRequired("refresh_token", req.GetRefreshToken()); violation != nil {
+		return invalidArgumentError(*violation)
+	}
+	return nil
+}
+
+func validateIDRequest(field string, id int32) error {
+	if violation := validateID(field, id); violation != nil {
+		return invalidArgumentError(*violation)
+	}
+	return nil
+}
+
+func validateListUsersRequest(req *pb.ListUsersRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateCursorPageSize(req.GetPageSize()))
+	if req.UserType != nil {
+		violations = appendViolation(violations, validateOneOf("user_type", req.GetUserType(), "free", "premium", "enterprise", "admin"))
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateAdminUpdateUserRequest(req *pb.AdminUpdateUserRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("user_id", req.GetUserId()))
+	if req.UserType != nil {
+		violations = appendViolation(violations, validateOneOf("user_type", req.GetUserType(), "free", "premium", "enterprise", "admin"))
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateCreateExchangeRateRequest(req *pb.CreateExchangeRateRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateRequired("rate_value", req.GetRateValue()))
+	violations = appendViolation(violations, validateID("source_currency_id", req.GetSourceCurrencyId()))
+	violations = appendViolation(violations, validateID("destination_currency_id", req.GetDestinationCurrencyId()))
+	if req.GetValidFromDate() == nil {
+		violations = append(violations, validationViolation{field: "valid_from_date", reason: "valid_from_date is required"})
+	}
+	if req.GetSourceId() < 0 {
+		violations = append(violations, validationViolation{field: "source_id", reason: "source_id must not be negative"})
+	}
+	if req.GetTypeId() < 0 {
+		violations = append(violations, validationViolation{field: "type_id", reason: "type_id must not be negative"})
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateUpdateExchangeRateRequest(req *pb.UpdateExchangeRateRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("rate_id", req.GetRateId()))
+	violations = appendViolation(violations, validateOptionalID("source_currency_id", req.SourceCurrencyId))
+	violations = appendViolation(violations, validateOptionalID("destination_currency_id", req.DestinationCurrencyId))
+	violations = appendViolation(violations, validateOptionalID("source_id", req.SourceId))
+	violations = appendViolation(violations, validateOptionalID("type_id", req.TypeId))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateGetHistoricalDataRequest(req *pb.GetHistoricalDataRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("source_currency_id", req.GetSourceCurrencyId()))
+	violations = appendViolation(violations, validateID("destination_currency_id", req.GetDestinationCurrencyId()))
+	violations = appendViolation(violations, validateID("source_id", req.GetSourceId()))
+	violations = appendViolation(violations, validateID("type_id", req.GetTypeId()))
+	if strings.TrimSpace(req.GetTimeRange()) == "" && strings.TrimSpace(req.GetFrom()) == "" {
+		violations = append(violations, validationViolation{field: "time_range", reason: "time_range is required unless from is set"})
+	}
+	if req.GetInterval() != "" {
+		violations = appendViolation(violations, validateOneOf("interval", req.GetInterval(), "1d"))
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateGetCandlesRequest(req *pb.GetCandlesRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("source_currency_id", req.GetSourceCurrencyId()))
+	violations = appendViolation(violations, validateID("destination_currency_id", req.GetDestinationCurrencyId()))
+	violations = appendViolation(violations, validateID("source_id", req.GetSourceId()))
+	violations = appendViolation(violations, validateID("type_id", req.GetTypeId()))
+	violations = appendViolation(violations, validateOneOf("interval", req.GetInterval(), "1h", "1d", "1w", "1M"))
+	violations = appendViolation(violations, validateRequired("time_range", req.GetTimeRange()))
+	if req.GetLimit() < 0 || req.GetLimit() > 1000 {
+		violations = append(violations, validationViolation{field: "limit", reason: "limit must be between 1 and 1000"})
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateGetCrossRateRequest(req *pb.GetCrossRateRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("from_currency_id", req.GetFromCurrencyId()))
+	violations = appendViolation(violations, validateID("to_currency_id", req.GetToCurrencyId()))
+	violations = appendViolation(violations, validateID("source_id", req.GetSourceId()))
+	violations = appendViolation(violations, validateID("type_id", req.GetTypeId()))
+	violations = appendViolation(violations, validateOptionalID("pivot_currency_id", req.PivotCurrencyId))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateGetSpreadsRequest(req *pb.GetSpreadsRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("source_currency_id", req.GetSourceCurrencyId()))
+	violations = appendViolation(violations, validateID("destination_currency_id", req.GetDestinationCurrencyId()))
+	if req.GetSourceId() < 0 {
+		violations = append(violations, validationViolation{field: "source_id", reason: "source_id must not be negative"})
+	}
+	if len(req.GetChannel()) > 50 {
+		violations = append(violations, validationViolation{field: "channel", reason: "channel must be at most 50 characters"})
+	}
+	if req.GetInterval() != "" {
+		violations = appendViolation(violations, validateOneOf("interval", req.GetInterval(), "1h", "1d", "1w", "1M"))
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateCreateQuoteRequest(req *pb.CreateQuoteRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateRequired("amount", req.GetAmount()))
+	violations = appendViolation(violations, validateID("from_currency_id", req.GetFromCurrencyId()))
+	violations = appendViolation(violations, validateID("to_currency_id", req.GetToCurrencyId()))
+	violations = appendViolation(violations, validateID("source_id", req.GetSourceId()))
+	violations = appendViolation(violations, validateID("type_id", req.GetTypeId()))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateCompareQuotesRequest(req *pb.CompareQuotesRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateRequired("amount", req.GetAmount()))
+	violations = appendViolation(violations, validateID("from_currency_id", req.GetFromCurrencyId()))
+	violations = appendViolation(violations, validateID("to_currency_id", req.GetToCurrencyId()))
+	violations = appendViolation(violations, validateID("type_id", req.GetTypeId()))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateListQuarantinedExchangeRatesRequest(req *pb.ListQuarantinedExchangeRatesRequest) error {
+	return validatePageRequest(req.GetPageId(), req.GetPageSize(), 5, 50)
+}
+
+func validateReviewQuarantinedExchangeRateRequest(req *pb.ReviewQuarantinedExchangeRateRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("rate_id", req.GetRateId()))
+	violations = appendViolation(violations, validateOneOf("action", req.GetAction(), "approve", "reject"))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateCreateRateSourceFeeRuleRequest(req *pb.CreateRateSourceFeeRuleRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("source_id", req.GetSourceId()))
+	violations = appendViolation(violations, validateID("type_id", req.GetTypeId()))
+	violations = appendViolation(violations, validateOptionalID("fee_currency_id", req.FeeCurrencyId))
+	violations = appendViolation(violations, validateOptionalID("swift_fee_currency_id", req.SwiftFeeCurrencyId))
+	if req.GetEffectiveFrom() == nil {
+		violations = append(violations, validationViolation{field: "effective_from", reason: "effective_from is required"})
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateListRateSourceFeeRulesRequest(req *pb.ListRateSourceFeeRulesRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateOptionalID("source_id", req.SourceId))
+	violations = appendViolation(violations, validateOptionalID("type_id", req.TypeId))
+	violations = appendViolation(violations, validateCursorPageSize(req.GetPageSize()))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateGetActiveRateSourceFeeRuleRequest(req *pb.GetActiveRateSourceFeeRuleRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("source_id", req.GetSourceId()))
+	violations = appendViolation(violations, validateID("type_id", req.GetTypeId()))
+	violations = appendViolation(violations, validateRequired("transaction_type", req.GetTransactionType()))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateUpdateRateSourceFeeRuleRequest(req *pb.UpdateRateSourceFeeRuleRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("fee_rule_id", req.GetFeeRuleId()))
+	violations = appendViolation(violations, validateOptionalID("source_id", req.SourceId))
+	violations = appendViolation(violations, validateOptionalID("type_id", req.TypeId))
+	violations = appendViolation(violations, validateOptionalID("fee_currency_id", req.FeeCurrencyId))
+	violations = appendViolation(violations, validateOptionalID("swift_fee_currency_id", req.SwiftFeeCurrencyId))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateCreateRateAlertRequest(req *pb.CreateRateAlertRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("source_id", req.GetSourceId()))
+	violations = appendViolation(violations, validateID("type_id", req.GetTypeId()))
+	violations = appendViolation(violations, validateID("from_currency_id", req.GetFromCurrencyId()))
+	violations = appendViolation(violations, validateID("to_currency_id", req.GetToCurrencyId()))
+	violations = appendViolation(violations, validateOneOf("condition", req.GetCondition(), "above", "below", "percent_change"))
+	if req.GetWindowHours() != 0 {
+		violations = appendViolation(violations, validateWindowHours(req.GetWindowHours()))
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateListRateAlertsRequest(req *pb.ListRateAlertsRequest) error {
+	return validatePageRequest(req.GetPageId(), req.GetPageSize(), 5, 50)
+}
+
+func validateUpdateRateAlertRequest(req *pb.UpdateRateAlertRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("alert_id", req.GetAlertId()))
+	if req.Condition != nil {
+		violations = appendViolation(violations, validateOneOf("condition", req.GetCondition(), "above", "below", "percent_change"))
+	}
+	if req.WindowHours != nil {
+		violations = appendViolation(violations, validateWindowHours(req.GetWindowHours()))
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateCreateCurrencyRequest(req *pb.CreateCurrencyRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateRequired("currency_code", req.GetCurrencyCode()))
+	violations = appendViolation(violations, validateRequired("currency_name", req.GetCurrencyName()))
+	violations = appendViolation(violations, validateRequired("currency_symbol", req.GetCurrencySymbol()))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateCreateCountryRequest(req *pb.CreateCountryRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateRequired("country_name", req.GetCountryName()))
+	violations = appendViolation(violations, validateRequired("country_code", req.GetCountryCode()))
+	violations = appendViolation(violations, validateID("currency_id", req.GetCurrencyId()))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateUpdateCountryRequest(req *pb.UpdateCountryRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("country_id", req.GetCountryId()))
+	violations = appendViolation(violations, validateOptionalID("currency_id", req.CurrencyId))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateListRateSourcesRequest(req *pb.ListRateSourcesRequest) error {
+	if violation := validateCursorPageSize(req.GetPageSize()); violation != nil {
+		return invalidArgumentError(*violation)
+	}
+	return nil
+}
+
+func validateCreateRateSourceRequest(req *pb.CreateRateSourceRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateRequired("source_name", req.GetSourceName()))
+	violations = appendViolation(violations, validateRequired("source_link", req.GetSourceLink()))
+	violations = appendViolation(violations, validateRequired("source_country", req.GetSourceCountry()))
+	violations = appendViolation(violations, validateRequired("source_status", req.GetSourceStatus()))
+	violations = appendViolation(violations, validateRequired("source_code", req.GetSourceCode()))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateCreateSubscriptionPlanRequest(req *pb.CreateSubscriptionPlanRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateRequired("plan_name", req.GetPlanName()))
+	violations = appendViolation(violations, validateRequired("plan_price", req.GetPlanPrice()))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateCreateUserSubscriptionRequest(req *pb.CreateUserSubscriptionRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("plan_id", req.GetPlanId()))
+	if req.Status != nil {
+		violations = appendViolation(violations, validateUserSubscriptionStatus(req.GetStatus()))
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateAdminListUserSubscriptionsRequest(req *pb.AdminListUserSubscriptionsRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateOptionalID("plan_id", req.PlanId))
+	if req.Status != nil {
+		violations = appendViolation(violations, validateUserSubscriptionStatus(req.GetStatus()))
+	}
+	violations = appendViolation(violations, validateCursorPageSize(req.GetPageSize()))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateUpdateUserSubscriptionRequest(req *pb.UpdateUserSubscriptionRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("subscription_id", req.GetSubscriptionId()))
+	violations = appendViolation(violations, validateOptionalID("plan_id", req.PlanId))
+	if req.Status != nil {
+		violations = appendViolation(violations, validateUserSubscriptionStatus(req.GetStatus()))
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateCreatePaymentRequest(req *pb.CreatePaymentRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("subscription_id", req.GetSubscriptionId()))
+	violations = appendViolation(violations, validateRequired("amount", req.GetAmount()))
+	violations = appendViolation(violations, validateRequired("currency_code", req.GetCurrencyCode()))
+	if req.PaymentStatus != nil {
+		violations = appendViolation(violations, validatePaymentStatus("payment_status", req.GetPaymentStatus()))
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateAdminListPaymentsRequest(req *pb.AdminListPaymentsRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateOptionalID("subscription_id", req.SubscriptionId))
+	if req.Status != nil {
+		violations = appendViolation(violations, validatePaymentStatus("status", req.GetStatus()))
+	}
+	violations = appendViolation(violations, validateCursorPageSize(req.GetPageSize()))
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateUpdatePaymentRequest(req *pb.UpdatePaymentRequest) error {
+	var violations []validationViolation
+
+	violations = appendViolation(violations, validateID("payment_id", req.GetPaymentId()))
+	violations = appendViolation(violations, validateOptionalID("subscription_id", req.SubscriptionId))
+	if req.PaymentStatus != nil {
+		violations = appendViolation(violations, validatePaymentStatus("payment_status", req.GetPaymentStatus()))
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+func validateUserSubscriptionStatus(status string) *validationViolation {
+	return validateOneOf("status", status, "active", "cancelled", "expired", "suspended", "pending")
+}
+
+func validatePaymentStatus(field string, status string) *validationViolation {
+	return validateOneOf(field, status, "pending", "completed", "failed", "refunded")
+}
+
+func validatePageRequest(pageID int32, pageSize int32, minPageSize int32, maxPageSize int32) error {
+	var violations []validationViolation
+
+	if pageID <= 0 {
+		violations = append(violations, validationViolation{field: "page_id", reason: "page_id must be greater than 0"})
+	}
+	if pageSize < minPageSize || pageSize > maxPageSize {
+		violations = append(violations, validationViolation{
+			field:  "page_size",
+			reason: fmt.Sprintf("page_size must be between %d and %d", minPageSize, maxPageSize),
+		})
+	}
+	if len(violations) > 0 {
+		return invalidArgumentError(violations...)
+	}
+	return nil
+}
+
+// validateCursorPageSize allows 0, which the service replaces with its default page size.
+func validateCursorPageSize(pageSize int32) *validationViolation {
+	if pageSize < 0 || pageSize > service.MaxPageSize {
+		return &validationViolation{
+			field:  "page_size",
+			reason: fmt.Sprintf("page_size must be between 1 and %d", service.MaxPageSize),
+		}
+	}
+	return nil
+}
+
+func validateWindowHours(windowHours int32) *validationViolation {
+	if windowHours < 1 || windowHours > 720 {
+		return &validationViolation{field: "window_hours", reason: "window_hours must be between 1 and 720"}
+	}
+	return nil
+}
+
+func validateID(field string, id int32) *validationViolation {
+	if id <= 0 {
+		return &validationViolation{field: field, reason: field + " must be greater than 0"}
+	}
+	return nil
+}
+
+func validateOptionalID(field string, id *int32) *validationViolation {
+	if id == nil {
+		return nil
+	}
+	return validateID(field, *id)
+}
+
+func validateRequired(field string, value string) *validationViolation {
+	if strings.TrimSpace(value) == "" {
+		return &validationViolation{field: field, reason: field + " is required"}
+	}
+	return nil
+}
+
+func validateOneOf(field string, value string, allowed ...string) *validationViolation {
+	for _, candidate := range allowed {
+		if value == candidate {
+			return nil
+		}
+	}
+	return &validationViolation{field: field, reason: field + " must be one of " + strings.Join(allowed, ", ")}
+}
+
+func appendViolation(violations []validationViolation, violation *validationViolation) []validationViolation {
+	if violation == nil {
+		return violations
+	}
+	return append(violations, *violation)
+}
+
 func validateEmail(field string, email string) *validationViolation {
 	trimmedEmail := strings.TrimSpace(email)
 	if trimmedEmail == "" {
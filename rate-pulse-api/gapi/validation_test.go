@@ -27,3 +27,107 @@ func TestValidateCreateUserRequestReturnsFieldViolation(t *testing.T) {
 	require.Equal(t, "username", badRequest.GetFieldViolations()[0].GetField())
 	require.Equal(t, "username is required", badRequest.GetFieldViolations()[0].GetDescription())
 }
+
+func TestValidateIngestExchangeRatesRequest(t *testing.T) {
+	err := validateIngestExchangeRatesRequest(&pb.IngestExchangeRatesRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = validateIngestExchangeRatesRequest(&pb.IngestExchangeRatesRequest{
+		Rates: []*pb.IngestExchangeRate{
+			{SourceCode: "VCB", SourceCurrencyCode: "VND", DestinationCurrencyCode: "USD", TypeName: "Sell Transfer", RateValue: "25000"},
+			{SourceCode: "VCB", SourceCurrencyCode: "VND", DestinationCurrencyCode: "USD", TypeName: "Sell Transfer"},
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.GetFieldViolations(), 1)
+	require.Equal(t, "rates[1].rate_value", badRequest.GetFieldViolations()[0].GetField())
+}
+
+func requireFieldViolations(t *testing.T, err error, fields ...string) {
+	t.Helper()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+
+	got := make([]string, len(badRequest.GetFieldViolations()))
+	for i, violation := range badRequest.GetFieldViolations() {
+		got[i] = violation.GetField()
+	}
+	require.Equal(t, fields, got)
+}
+
+func TestValidateGetCandlesRequest(t *testing.T) {
+	valid := &pb.GetCandlesRequest{
+		SourceCurrencyId:      1,
+		DestinationCurrencyId: 2,
+		SourceId:              10,
+		TypeId:                4,
+		Interval:              "1d",
+		TimeRange:             "1m",
+	}
+	require.NoError(t, validateGetCandlesRequest(valid))
+
+	err := validateGetCandlesRequest(&pb.GetCandlesRequest{SourceCurrencyId: 1, DestinationCurrencyId: 2, SourceId: 10, TypeId: 4, Interval: "2d", Limit: 5000})
+	requireFieldViolations(t, err, "interval", "time_range", "limit")
+}
+
+func TestValidateGetHistoricalDataRequestAllowsFromInsteadOfTimeRange(t *testing.T) {
+	req := &pb.GetHistoricalDataRequest{SourceCurrencyId: 1, DestinationCurrencyId: 2, SourceId: 10, TypeId: 4}
+	requireFieldViolations(t, validateGetHistoricalDataRequest(req), "time_range")
+
+	req.From = "2026-05-01"
+	require.NoError(t, validateGetHistoricalDataRequest(req))
+}
+
+func TestValidateUpdateRequestsCheckOnlySetFields(t *testing.T) {
+	require.NoError(t, validateUpdateRateAlertRequest(&pb.UpdateRateAlertRequest{AlertId: 3}))
+
+	condition := "sideways"
+	windowHours := int32(0)
+	err := validateUpdateRateAlertRequest(&pb.UpdateRateAlertRequest{AlertId: 3, Condition: &condition, WindowHours: &windowHours})
+	requireFieldViolations(t, err, "condition", "window_hours")
+
+	sourceID := int32(0)
+	err = validateUpdateRateSourceFeeRuleRequest(&pb.UpdateRateSourceFeeRuleRequest{SourceId: &sourceID})
+	requireFieldViolations(t, err, "fee_rule_id", "source_id")
+}
+
+func TestValidatePageRequest(t *testing.T) {
+	require.NoError(t, validateListUsersRequest(&pb.ListUsersRequest{}))
+	require.NoError(t, validateListUsersRequest(&pb.ListUsersRequest{PageSize: 100, Cursor: "abc"}))
+	userType := "guest"
+	requireFieldViolations(t, validateListUsersRequest(&pb.ListUsersRequest{PageSize: 101, UserType: &userType}), "page_size", "user_type")
+	typeID := int32(0)
+	requireFieldViolations(t, validateListRateSourceFeeRulesRequest(&pb.ListRateSourceFeeRulesRequest{TypeId: &typeID, PageSize: -1}), "type_id", "page_size")
+	require.NoError(t, validateListRateAlertsRequest(&pb.ListRateAlertsRequest{PageId: 1, PageSize: 50}))
+}
+
+func TestValidateReviewQuarantinedExchangeRateRequest(t *testing.T) {
+	require.NoError(t, validateReviewQuarantinedExchangeRateRequest(&pb.ReviewQuarantinedExchangeRateRequest{RateId: 7, Action: "approve"}))
+
+	err := validateReviewQuarantinedExchangeRateRequest(&pb.ReviewQuarantinedExchangeRateRequest{RateId: 7, Action: "Approve"})
+	requireFieldViolations(t, err, "action")
+}
+
+func TestValidateCompleteSignInMFARequest(t *testing.T) {
+	err := validateCompleteSignInMFARequest(&pb.CompleteSignInMFARequest{MfaToken: " "})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.GetFieldViolations(), 2)
+	require.Equal(t, "mfa_token", badRequest.GetFieldViolations()[0].GetField())
+	require.Equal(t, "code", badRequest.GetFieldViolations()[1].GetField())
+
+	require.NoError(t, validateCompleteSignInMFARequest(&pb.CompleteSignInMFARequest{MfaToken: "token", Code: "123456"}))
+}
@@ -1,8 +1,9 @@
-//go:generate make swagger
+//go:generate make protoc
 
 package main
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"syscall"
 	"time"
+	_ "time/tzdata" // the runtime image has no zoneinfo; historical buckets load IANA zones
 
 	"github.com/ThanhVinhTong/rate-pulse/api"
 	responsecache "github.com/ThanhVinhTong/rate-pulse/cache"
@@ -18,8 +20,11 @@ import (
 	"github.com/ThanhVinhTong/rate-pulse/email"
 	"github.com/ThanhVinhTong/rate-pulse/gapi"
 	pb "github.com/ThanhVinhTong/rate-pulse/pb"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
+	"github.com/ThanhVinhTong/rate-pulse/ratelimit"
 	"github.com/ThanhVinhTong/rate-pulse/service"
 	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/ThanhVinhTong/rate-pulse/totp"
 	"github.com/ThanhVinhTong/rate-pulse/util"
 	"github.com/ThanhVinhTong/rate-pulse/worker"
 	"github.com/gin-gonic/gin"
@@ -81,15 +86,25 @@ func main() {
 		log.Fatal().Err(err).Msg("Cannot configure Redis client for rate limiting")
 	}
 
+	// Live exchange-rate updates fan out to every replica through Redis pub/sub.
+	rateUpdates := pubsub.NewRedisBroker(redisClient)
+
 	// Create token maker for both gRPC and HTTP servers
 	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Cannot create token maker")
 	}
+	// Revoked access tokens are shared through Redis so every replica rejects them.
+	revocations := token.NewRevocationList(redisClient, config.AccessTokenDuration)
+	// TOTP secrets are encrypted at rest with their own key.
+	totpCipher, err := totp.NewSecretCipher(config.TOTPEncryptionKey)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Cannot create TOTP secret cipher")
+	}
 
 	// Initialize application service layer with dependencies.
 	gin.SetMode(gin.ReleaseMode)
-	services := service.NewServices(config, store, tokenMaker, taskDistributor)
+	services := service.NewServices(config, store, tokenMaker, revocations, totpCipher, taskDistributor, rateUpdates)
 
 	var emailSender email.Sender
 	if config.EnableTaskProcessor {
@@ -103,11 +118,16 @@ func main() {
 	if config.EnableTaskProcessor {
 		go runTaskProcessor(config, redisOpt, store, emailSender)
 	}
+	// The /v2 routes are transcoded by grpc-gateway, so they need the gRPC server in this process.
+	var gatewayListener *gapi.GatewayListener
+	if config.EnableGRPCServer && config.EnableHTTPServer {
+		gatewayListener = gapi.NewGatewayListener()
+	}
 	if config.EnableGRPCServer {
-		go runGrpcServer(config, services, tokenMaker)
+		go runGrpcServer(config, services, tokenMaker, revocations, responseCache, redisClient, rateUpdates, gatewayListener)
 	}
 	if config.EnableHTTPServer {
-		runGinServer(config, store, services, tokenMaker, responseCache, redisClient)
+		runGinServer(config, store, services, tokenMaker, revocations, responseCache, redisClient, rateUpdates, gatewayListener)
 		return
 	}
 	waitForShutdown()
@@ -118,14 +138,26 @@ func runGinServer(
 	store db.Store,
 	services *service.Services,
 	tokenMaker token.Maker,
+	revocations token.RevocationList,
 	responseCache responsecache.ResponseCache,
 	redisClient *redis.Client,
+	rateUpdates pubsub.Subscriber,
+	gatewayListener *gapi.GatewayListener,
 ) {
-	server, err := api.NewServer(config, store, services, tokenMaker, redisClient)
+	server, err := api.NewServer(config, store, services, tokenMaker, revocations, redisClient)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Cannot create server")
 	}
 	server.SetResponseCache(responseCache)
+	server.SetRateUpdates(rateUpdates)
+
+	if gatewayListener != nil {
+		gatewayMux, err := gapi.NewGatewayMux(context.Background(), gatewayListener)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Cannot create gateway mux")
+		}
+		server.SetGatewayHandler(gatewayMux)
+	}
 
 	log.Info().Msgf("HTTP server started on %s", config.HTTPServerAddress)
 	if err := server.Start(config.HTTPServerAddress); err != nil {
@@ -133,17 +165,48 @@ func runGinServer(
 	}
 }
 
-func runGrpcServer(config util.Config, services *service.Services, tokenMaker token.Maker) {
+func runGrpcServer(
+	config util.Config,
+	services *service.Services,
+	tokenMaker token.Maker,
+	revocations token.RevocationList,
+	responseCache responsecache.ResponseCache,
+	redisClient *redis.Client,
+	rateUpdates pubsub.Subscriber,
+	gatewayListener *gapi.GatewayListener,
+) {
 	server, err := gapi.NewServer(config, services, tokenMaker)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Cannot create server")
 	}
+	server.SetResponseCache(responseCache)
+	server.SetRateUpdates(rateUpdates)
+
+	// The sign-in methods share the Redis counters and strict policies of the HTTP server.
+	algorithm, err := ratelimit.ParseAlgorithm(config.RateLimitAlgorithm)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Cannot configure rate limiter")
+	}
+	rateLimiter := ratelimit.New(redisClient, algorithm)
+	rateLimits := api.RateLimitPolicies(config.RateLimitPerMinute)
 
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(gapi.UnaryServerInterceptor(tokenMaker)))
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(gapi.UnaryServerInterceptor(tokenMaker, revocations, services.APIKeys, services.Quotas, services.Users, rateLimiter, rateLimits)),
+		grpc.StreamInterceptor(gapi.StreamServerInterceptor(tokenMaker, revocations, services.APIKeys, services.Quotas)),
+	)
 
 	pb.RegisterRatePulseAuthenticationServiceServer(grpcServer, server)
 	pb.RegisterRatePulseExchangeRateServiceServer(grpcServer, server)
 	pb.RegisterRatePulseInternalHealthServiceServer(grpcServer, server)
+	pb.RegisterRatePulseUserServiceServer(grpcServer, server)
+	pb.RegisterRatePulseRateSourceFeeRuleServiceServer(grpcServer, server)
+	pb.RegisterRatePulseRateAlertServiceServer(grpcServer, server)
+	pb.RegisterRatePulseCurrencyServiceServer(grpcServer, server)
+	pb.RegisterRatePulseCountryServiceServer(grpcServer, server)
+	pb.RegisterRatePulseRateSourceServiceServer(grpcServer, server)
+	pb.RegisterRatePulseSubscriptionServiceServer(grpcServer, server)
+	pb.RegisterRatePulsePaymentServiceServer(grpcServer, server)
+	pb.RegisterRatePulsePreferenceServiceServer(grpcServer, server)
 	reflection.Register(grpcServer) // Freely explore what RPC methods are available
 
 	listener, err := net.Listen("tcp", config.GRPCServerAddress)
@@ -151,6 +214,15 @@ func runGrpcServer(config util.Config, services *service.Services, tokenMaker to
 		log.Fatal().Err(err).Msg("Cannot create listener")
 	}
 
+	// The gateway reaches the server in memory, so the rate limit interceptor can tell its calls apart.
+	if gatewayListener != nil {
+		go func() {
+			if err := grpcServer.Serve(gatewayListener); err != nil {
+				log.Fatal().Err(err).Msg("Cannot serve gRPC gateway listener")
+			}
+		}()
+	}
+
 	log.Info().Msgf("gRPC server started on %s", config.GRPCServerAddress)
 	err = grpcServer.Serve(listener)
 	if err != nil {
@@ -164,6 +236,15 @@ func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store d
 	if err := taskProcessor.Start(); err != nil {
 		log.Fatal().Err(err).Msg("cannot start task processor")
 	}
+
+	scheduler, err := worker.NewTaskScheduler(redisOpt, config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create task scheduler")
+	}
+	if err := scheduler.Start(); err != nil {
+		log.Fatal().Err(err).Msg("cannot start task scheduler")
+	}
+	log.Info().Msg("task scheduler started")
 }
 
 func waitForShutdown() {
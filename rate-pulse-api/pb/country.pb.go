@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: country.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Country struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryId     int32                  `protobuf:"varint,1,opt,name=country_id,json=countryId,proto3" json:"country_id,omitempty"`
+	CountryName   string                 `protobuf:"bytes,2,opt,name=country_name,json=countryName,proto3" json:"country_name,omitempty"`
+	CountryCode   *string                `protobuf:"bytes,3,opt,name=country_code,json=countryCode,proto3,oneof" json:"country_code,omitempty"`
+	CurrencyId    int32                  `protobuf:"varint,4,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Country) Reset() {
+	*x = Country{}
+	mi := &file_country_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Country) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Country) ProtoMessage() {}
+
+func (x *Country) ProtoReflect() protoreflect.Message {
+	mi := &file_country_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Country.ProtoReflect.Descriptor instead.
+func (*Country) Descriptor() ([]byte, []int) {
+	return file_country_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Country) GetCountryId() int32 {
+	if x != nil {
+		return x.CountryId
+	}
+	return 0
+}
+
+func (x *Country) GetCountryName() string {
+	if x != nil {
+		return x.CountryName
+	}
+	return ""
+}
+
+func (x *Country) GetCountryCode() string {
+	if x != nil && x.CountryCode != nil {
+		return *x.CountryCode
+	}
+	return ""
+}
+
+func (x *Country) GetCurrencyId() int32 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *Country) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Country) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_country_proto protoreflect.FileDescriptor
+
+const file_country_proto_rawDesc = "" +
+	"\n" +
+	"\rcountry.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9b\x02\n" +
+	"\aCountry\x12\x1d\n" +
+	"\n" +
+	"country_id\x18\x01 \x01(\x05R\tcountryId\x12!\n" +
+	"\fcountry_name\x18\x02 \x01(\tR\vcountryName\x12&\n" +
+	"\fcountry_code\x18\x03 \x01(\tH\x00R\vcountryCode\x88\x01\x01\x12\x1f\n" +
+	"\vcurrency_id\x18\x04 \x01(\x05R\n" +
+	"currencyId\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0f\n" +
+	"\r_country_codeB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_country_proto_rawDescOnce sync.Once
+	file_country_proto_rawDescData []byte
+)
+
+func file_country_proto_rawDescGZIP() []byte {
+	file_country_proto_rawDescOnce.Do(func() {
+		file_country_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_country_proto_rawDesc), len(file_country_proto_rawDesc)))
+	})
+	return file_country_proto_rawDescData
+}
+
+var file_country_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_country_proto_goTypes = []any{
+	(*Country)(nil),               // 0: pb.Country
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_country_proto_depIdxs = []int32{
+	1, // 0: pb.Country.updated_at:type_name -> google.protobuf.Timestamp
+	1, // 1: pb.Country.created_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_country_proto_init() }
+func file_country_proto_init() {
+	if File_country_proto != nil {
+		return
+	}
+	file_country_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_country_proto_rawDesc), len(file_country_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_country_proto_goTypes,
+		DependencyIndexes: file_country_proto_depIdxs,
+		MessageInfos:      file_country_proto_msgTypes,
+	}.Build()
+	File_country_proto = out.File
+	file_country_proto_goTypes = nil
+	file_country_proto_depIdxs = nil
+}
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: currency_preference.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CurrencyPreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyId    int32                  `protobuf:"varint,1,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsFavorite    bool                   `protobuf:"varint,3,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	DisplayOrder  *int32                 `protobuf:"varint,4,opt,name=display_order,json=displayOrder,proto3,oneof" json:"display_order,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyPreference) Reset() {
+	*x = CurrencyPreference{}
+	mi := &file_currency_preference_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyPreference) ProtoMessage() {}
+
+func (x *CurrencyPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_currency_preference_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyPreference.ProtoReflect.Descriptor instead.
+func (*CurrencyPreference) Descriptor() ([]byte, []int) {
+	return file_currency_preference_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CurrencyPreference) GetCurrencyId() int32 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *CurrencyPreference) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CurrencyPreference) GetIsFavorite() bool {
+	if x != nil {
+		return x.IsFavorite
+	}
+	return false
+}
+
+func (x *CurrencyPreference) GetDisplayOrder() int32 {
+	if x != nil && x.DisplayOrder != nil {
+		return *x.DisplayOrder
+	}
+	return 0
+}
+
+func (x *CurrencyPreference) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CurrencyPreference) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_currency_preference_proto protoreflect.FileDescriptor
+
+const file_currency_preference_proto_rawDesc = "" +
+	"\n" +
+	"\x19currency_preference.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa1\x02\n" +
+	"\x12CurrencyPreference\x12\x1f\n" +
+	"\vcurrency_id\x18\x01 \x01(\x05R\n" +
+	"currencyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1f\n" +
+	"\vis_favorite\x18\x03 \x01(\bR\n" +
+	"isFavorite\x12(\n" +
+	"\rdisplay_order\x18\x04 \x01(\x05H\x00R\fdisplayOrder\x88\x01\x01\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x10\n" +
+	"\x0e_display_orderB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_currency_preference_proto_rawDescOnce sync.Once
+	file_currency_preference_proto_rawDescData []byte
+)
+
+func file_currency_preference_proto_rawDescGZIP() []byte {
+	file_currency_preference_proto_rawDescOnce.Do(func() {
+		file_currency_preference_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_currency_preference_proto_rawDesc), len(file_currency_preference_proto_rawDesc)))
+	})
+	return file_currency_preference_proto_rawDescData
+}
+
+var file_currency_preference_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_currency_preference_proto_goTypes = []any{
+	(*CurrencyPreference)(nil),    // 0: pb.CurrencyPreference
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_currency_preference_proto_depIdxs = []int32{
+	1, // 0: pb.CurrencyPreference.updated_at:type_name -> google.protobuf.Timestamp
+	1, // 1: pb.CurrencyPreference.created_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_currency_preference_proto_init() }
+func file_currency_preference_proto_init() {
+	if File_currency_preference_proto != nil {
+		return
+	}
+	file_currency_preference_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_currency_preference_proto_rawDesc), len(file_currency_preference_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_currency_preference_proto_goTypes,
+		DependencyIndexes: file_currency_preference_proto_depIdxs,
+		MessageInfos:      file_currency_preference_proto_msgTypes,
+	}.Build()
+	File_currency_preference_proto = out.File
+	file_currency_preference_proto_goTypes = nil
+	file_currency_preference_proto_depIdxs = nil
+}
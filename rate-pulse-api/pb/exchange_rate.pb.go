@@ -122,6 +122,382 @@ func (x *LatestExchangeRate) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+type ExchangeRateUpdate struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RateId                int32                  `protobuf:"varint,1,opt,name=rate_id,json=rateId,proto3" json:"rate_id,omitempty"`
+	RateValue             string                 `protobuf:"bytes,2,opt,name=rate_value,json=rateValue,proto3" json:"rate_value,omitempty"`
+	SourceCurrencyId      int32                  `protobuf:"varint,3,opt,name=source_currency_id,json=sourceCurrencyId,proto3" json:"source_currency_id,omitempty"`
+	DestinationCurrencyId int32                  `protobuf:"varint,4,opt,name=destination_currency_id,json=destinationCurrencyId,proto3" json:"destination_currency_id,omitempty"`
+	SourceId              int32                  `protobuf:"varint,5,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TypeId                int32                  `protobuf:"varint,6,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	ValidFromDate         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=valid_from_date,json=validFromDate,proto3" json:"valid_from_date,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ExchangeRateUpdate) Reset() {
+	*x = ExchangeRateUpdate{}
+	mi := &file_exchange_rate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRateUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateUpdate) ProtoMessage() {}
+
+func (x *ExchangeRateUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_rate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateUpdate.ProtoReflect.Descriptor instead.
+func (*ExchangeRateUpdate) Descriptor() ([]byte, []int) {
+	return file_exchange_rate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExchangeRateUpdate) GetRateId() int32 {
+	if x != nil {
+		return x.RateId
+	}
+	return 0
+}
+
+func (x *ExchangeRateUpdate) GetRateValue() string {
+	if x != nil {
+		return x.RateValue
+	}
+	return ""
+}
+
+func (x *ExchangeRateUpdate) GetSourceCurrencyId() int32 {
+	if x != nil {
+		return x.SourceCurrencyId
+	}
+	return 0
+}
+
+func (x *ExchangeRateUpdate) GetDestinationCurrencyId() int32 {
+	if x != nil {
+		return x.DestinationCurrencyId
+	}
+	return 0
+}
+
+func (x *ExchangeRateUpdate) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *ExchangeRateUpdate) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *ExchangeRateUpdate) GetValidFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFromDate
+	}
+	return nil
+}
+
+func (x *ExchangeRateUpdate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ExchangeRate struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RateId                int32                  `protobuf:"varint,1,opt,name=rate_id,json=rateId,proto3" json:"rate_id,omitempty"`
+	RateValue             string                 `protobuf:"bytes,2,opt,name=rate_value,json=rateValue,proto3" json:"rate_value,omitempty"`
+	SourceCurrencyId      int32                  `protobuf:"varint,3,opt,name=source_currency_id,json=sourceCurrencyId,proto3" json:"source_currency_id,omitempty"`
+	DestinationCurrencyId int32                  `protobuf:"varint,4,opt,name=destination_currency_id,json=destinationCurrencyId,proto3" json:"destination_currency_id,omitempty"`
+	ValidFromDate         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_from_date,json=validFromDate,proto3" json:"valid_from_date,omitempty"`
+	ValidToDate           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_to_date,json=validToDate,proto3" json:"valid_to_date,omitempty"`
+	SourceId              int32                  `protobuf:"varint,7,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TypeId                int32                  `protobuf:"varint,8,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status                string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	QuarantineReason      string                 `protobuf:"bytes,12,opt,name=quarantine_reason,json=quarantineReason,proto3" json:"quarantine_reason,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_exchange_rate_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_rate_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_exchange_rate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExchangeRate) GetRateId() int32 {
+	if x != nil {
+		return x.RateId
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetRateValue() string {
+	if x != nil {
+		return x.RateValue
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetSourceCurrencyId() int32 {
+	if x != nil {
+		return x.SourceCurrencyId
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetDestinationCurrencyId() int32 {
+	if x != nil {
+		return x.DestinationCurrencyId
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetValidFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFromDate
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetValidToDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidToDate
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetQuarantineReason() string {
+	if x != nil {
+		return x.QuarantineReason
+	}
+	return ""
+}
+
+type HistoricalDataPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RateValue     string                 `protobuf:"bytes,1,opt,name=rate_value,json=rateValue,proto3" json:"rate_value,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TypeId        int32                  `protobuf:"varint,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoricalDataPoint) Reset() {
+	*x = HistoricalDataPoint{}
+	mi := &file_exchange_rate_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoricalDataPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoricalDataPoint) ProtoMessage() {}
+
+func (x *HistoricalDataPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_rate_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoricalDataPoint.ProtoReflect.Descriptor instead.
+func (*HistoricalDataPoint) Descriptor() ([]byte, []int) {
+	return file_exchange_rate_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HistoricalDataPoint) GetRateValue() string {
+	if x != nil {
+		return x.RateValue
+	}
+	return ""
+}
+
+func (x *HistoricalDataPoint) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *HistoricalDataPoint) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+type Candle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"`
+	Open          string                 `protobuf:"bytes,2,opt,name=open,proto3" json:"open,omitempty"`
+	High          string                 `protobuf:"bytes,3,opt,name=high,proto3" json:"high,omitempty"`
+	Low           string                 `protobuf:"bytes,4,opt,name=low,proto3" json:"low,omitempty"`
+	Close         string                 `protobuf:"bytes,5,opt,name=close,proto3" json:"close,omitempty"`
+	SampleCount   int64                  `protobuf:"varint,6,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Candle) Reset() {
+	*x = Candle{}
+	mi := &file_exchange_rate_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Candle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Candle) ProtoMessage() {}
+
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_rate_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_exchange_rate_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Candle) GetBucketStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BucketStart
+	}
+	return nil
+}
+
+func (x *Candle) GetOpen() string {
+	if x != nil {
+		return x.Open
+	}
+	return ""
+}
+
+func (x *Candle) GetHigh() string {
+	if x != nil {
+		return x.High
+	}
+	return ""
+}
+
+func (x *Candle) GetLow() string {
+	if x != nil {
+		return x.Low
+	}
+	return ""
+}
+
+func (x *Candle) GetClose() string {
+	if x != nil {
+		return x.Close
+	}
+	return ""
+}
+
+func (x *Candle) GetSampleCount() int64 {
+	if x != nil {
+		return x.SampleCount
+	}
+	return 0
+}
+
 var File_exchange_rate_proto protoreflect.FileDescriptor
 
 const file_exchange_rate_proto_rawDesc = "" +
@@ -137,7 +513,48 @@ const file_exchange_rate_proto_rawDesc = "" +
 	"\x10rate_source_code\x18\x06 \x01(\tR\x0erateSourceCode\x12\x1b\n" +
 	"\ttype_name\x18\a \x01(\tR\btypeName\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe7\x02\n" +
+	"\x12ExchangeRateUpdate\x12\x17\n" +
+	"\arate_id\x18\x01 \x01(\x05R\x06rateId\x12\x1d\n" +
+	"\n" +
+	"rate_value\x18\x02 \x01(\tR\trateValue\x12,\n" +
+	"\x12source_currency_id\x18\x03 \x01(\x05R\x10sourceCurrencyId\x126\n" +
+	"\x17destination_currency_id\x18\x04 \x01(\x05R\x15destinationCurrencyId\x12\x1b\n" +
+	"\tsource_id\x18\x05 \x01(\x05R\bsourceId\x12\x17\n" +
+	"\atype_id\x18\x06 \x01(\x05R\x06typeId\x12B\n" +
+	"\x0fvalid_from_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rvalidFromDate\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa1\x04\n" +
+	"\fExchangeRate\x12\x17\n" +
+	"\arate_id\x18\x01 \x01(\x05R\x06rateId\x12\x1d\n" +
+	"\n" +
+	"rate_value\x18\x02 \x01(\tR\trateValue\x12,\n" +
+	"\x12source_currency_id\x18\x03 \x01(\x05R\x10sourceCurrencyId\x126\n" +
+	"\x17destination_currency_id\x18\x04 \x01(\x05R\x15destinationCurrencyId\x12B\n" +
+	"\x0fvalid_from_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rvalidFromDate\x12>\n" +
+	"\rvalid_to_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vvalidToDate\x12\x1b\n" +
+	"\tsource_id\x18\a \x01(\x05R\bsourceId\x12\x17\n" +
+	"\atype_id\x18\b \x01(\x05R\x06typeId\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12+\n" +
+	"\x11quarantine_reason\x18\f \x01(\tR\x10quarantineReason\"\x88\x01\n" +
+	"\x13HistoricalDataPoint\x12\x1d\n" +
+	"\n" +
+	"rate_value\x18\x01 \x01(\tR\trateValue\x129\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n" +
+	"\atype_id\x18\x03 \x01(\x05R\x06typeId\"\xba\x01\n" +
+	"\x06Candle\x12=\n" +
+	"\fbucket_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vbucketStart\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\tR\x04open\x12\x12\n" +
+	"\x04high\x18\x03 \x01(\tR\x04high\x12\x10\n" +
+	"\x03low\x18\x04 \x01(\tR\x03low\x12\x14\n" +
+	"\x05close\x18\x05 \x01(\tR\x05close\x12!\n" +
+	"\fsample_count\x18\x06 \x01(\x03R\vsampleCountB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
 
 var (
 	file_exchange_rate_proto_rawDescOnce sync.Once
@@ -151,19 +568,31 @@ func file_exchange_rate_proto_rawDescGZIP() []byte {
 	return file_exchange_rate_proto_rawDescData
 }
 
-var file_exchange_rate_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_exchange_rate_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_exchange_rate_proto_goTypes = []any{
 	(*LatestExchangeRate)(nil),    // 0: pb.LatestExchangeRate
-	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+	(*ExchangeRateUpdate)(nil),    // 1: pb.ExchangeRateUpdate
+	(*ExchangeRate)(nil),          // 2: pb.ExchangeRate
+	(*HistoricalDataPoint)(nil),   // 3: pb.HistoricalDataPoint
+	(*Candle)(nil),                // 4: pb.Candle
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
 }
 var file_exchange_rate_proto_depIdxs = []int32{
-	1, // 0: pb.LatestExchangeRate.valid_from_date:type_name -> google.protobuf.Timestamp
-	1, // 1: pb.LatestExchangeRate.updated_at:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5,  // 0: pb.LatestExchangeRate.valid_from_date:type_name -> google.protobuf.Timestamp
+	5,  // 1: pb.LatestExchangeRate.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 2: pb.ExchangeRateUpdate.valid_from_date:type_name -> google.protobuf.Timestamp
+	5,  // 3: pb.ExchangeRateUpdate.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 4: pb.ExchangeRate.valid_from_date:type_name -> google.protobuf.Timestamp
+	5,  // 5: pb.ExchangeRate.valid_to_date:type_name -> google.protobuf.Timestamp
+	5,  // 6: pb.ExchangeRate.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 7: pb.ExchangeRate.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 8: pb.HistoricalDataPoint.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 9: pb.Candle.bucket_start:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_exchange_rate_proto_init() }
@@ -177,7 +606,7 @@ func file_exchange_rate_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_exchange_rate_proto_rawDesc), len(file_exchange_rate_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,322 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: quote.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Quote prices a conversion with a source's latest rate and its active fee rule.
+// Amounts are decimals in the destination currency unless noted otherwise.
+type Quote struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Amount           string                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	FromCurrencyId   int32                  `protobuf:"varint,2,opt,name=from_currency_id,json=fromCurrencyId,proto3" json:"from_currency_id,omitempty"`
+	ToCurrencyId     int32                  `protobuf:"varint,3,opt,name=to_currency_id,json=toCurrencyId,proto3" json:"to_currency_id,omitempty"`
+	SourceId         int32                  `protobuf:"varint,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TypeId           int32                  `protobuf:"varint,5,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	TransactionType  string                 `protobuf:"bytes,6,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	Channel          string                 `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	RateId           int32                  `protobuf:"varint,8,opt,name=rate_id,json=rateId,proto3" json:"rate_id,omitempty"`
+	AppliedRate      string                 `protobuf:"bytes,9,opt,name=applied_rate,json=appliedRate,proto3" json:"applied_rate,omitempty"`
+	GrossAmount      string                 `protobuf:"bytes,10,opt,name=gross_amount,json=grossAmount,proto3" json:"gross_amount,omitempty"`
+	FeeRuleId        *int32                 `protobuf:"varint,11,opt,name=fee_rule_id,json=feeRuleId,proto3,oneof" json:"fee_rule_id,omitempty"`
+	FeeRate          string                 `protobuf:"bytes,12,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	PercentageFee    string                 `protobuf:"bytes,13,opt,name=percentage_fee,json=percentageFee,proto3" json:"percentage_fee,omitempty"`
+	FixedFee         string                 `protobuf:"bytes,14,opt,name=fixed_fee,json=fixedFee,proto3" json:"fixed_fee,omitempty"`
+	VatAmount        string                 `protobuf:"bytes,15,opt,name=vat_amount,json=vatAmount,proto3" json:"vat_amount,omitempty"`
+	VatIncluded      bool                   `protobuf:"varint,16,opt,name=vat_included,json=vatIncluded,proto3" json:"vat_included,omitempty"`
+	SwiftFee         string                 `protobuf:"bytes,17,opt,name=swift_fee,json=swiftFee,proto3" json:"swift_fee,omitempty"`
+	SwiftFeeIncluded bool                   `protobuf:"varint,18,opt,name=swift_fee_included,json=swiftFeeIncluded,proto3" json:"swift_fee_included,omitempty"`
+	TotalFees        string                 `protobuf:"bytes,19,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+	NetAmount        string                 `protobuf:"bytes,20,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	EffectiveRate    string                 `protobuf:"bytes,21,opt,name=effective_rate,json=effectiveRate,proto3" json:"effective_rate,omitempty"`
+	RateUpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=rate_updated_at,json=rateUpdatedAt,proto3" json:"rate_updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	mi := &file_quote_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_quote_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_quote_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Quote) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Quote) GetFromCurrencyId() int32 {
+	if x != nil {
+		return x.FromCurrencyId
+	}
+	return 0
+}
+
+func (x *Quote) GetToCurrencyId() int32 {
+	if x != nil {
+		return x.ToCurrencyId
+	}
+	return 0
+}
+
+func (x *Quote) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *Quote) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *Quote) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *Quote) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *Quote) GetRateId() int32 {
+	if x != nil {
+		return x.RateId
+	}
+	return 0
+}
+
+func (x *Quote) GetAppliedRate() string {
+	if x != nil {
+		return x.AppliedRate
+	}
+	return ""
+}
+
+func (x *Quote) GetGrossAmount() string {
+	if x != nil {
+		return x.GrossAmount
+	}
+	return ""
+}
+
+func (x *Quote) GetFeeRuleId() int32 {
+	if x != nil && x.FeeRuleId != nil {
+		return *x.FeeRuleId
+	}
+	return 0
+}
+
+func (x *Quote) GetFeeRate() string {
+	if x != nil {
+		return x.FeeRate
+	}
+	return ""
+}
+
+func (x *Quote) GetPercentageFee() string {
+	if x != nil {
+		return x.PercentageFee
+	}
+	return ""
+}
+
+func (x *Quote) GetFixedFee() string {
+	if x != nil {
+		return x.FixedFee
+	}
+	return ""
+}
+
+func (x *Quote) GetVatAmount() string {
+	if x != nil {
+		return x.VatAmount
+	}
+	return ""
+}
+
+func (x *Quote) GetVatIncluded() bool {
+	if x != nil {
+		return x.VatIncluded
+	}
+	return false
+}
+
+func (x *Quote) GetSwiftFee() string {
+	if x != nil {
+		return x.SwiftFee
+	}
+	return ""
+}
+
+func (x *Quote) GetSwiftFeeIncluded() bool {
+	if x != nil {
+		return x.SwiftFeeIncluded
+	}
+	return false
+}
+
+func (x *Quote) GetTotalFees() string {
+	if x != nil {
+		return x.TotalFees
+	}
+	return ""
+}
+
+func (x *Quote) GetNetAmount() string {
+	if x != nil {
+		return x.NetAmount
+	}
+	return ""
+}
+
+func (x *Quote) GetEffectiveRate() string {
+	if x != nil {
+		return x.EffectiveRate
+	}
+	return ""
+}
+
+func (x *Quote) GetRateUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RateUpdatedAt
+	}
+	return nil
+}
+
+var File_quote_proto protoreflect.FileDescriptor
+
+const file_quote_proto_rawDesc = "" +
+	"\n" +
+	"\vquote.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\x93\x06\n" +
+	"\x05Quote\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\tR\x06amount\x12(\n" +
+	"\x10from_currency_id\x18\x02 \x01(\x05R\x0efromCurrencyId\x12$\n" +
+	"\x0eto_currency_id\x18\x03 \x01(\x05R\ftoCurrencyId\x12\x1b\n" +
+	"\tsource_id\x18\x04 \x01(\x05R\bsourceId\x12\x17\n" +
+	"\atype_id\x18\x05 \x01(\x05R\x06typeId\x12)\n" +
+	"\x10transaction_type\x18\x06 \x01(\tR\x0ftransactionType\x12\x18\n" +
+	"\achannel\x18\a \x01(\tR\achannel\x12\x17\n" +
+	"\arate_id\x18\b \x01(\x05R\x06rateId\x12!\n" +
+	"\fapplied_rate\x18\t \x01(\tR\vappliedRate\x12!\n" +
+	"\fgross_amount\x18\n" +
+	" \x01(\tR\vgrossAmount\x12#\n" +
+	"\vfee_rule_id\x18\v \x01(\x05H\x00R\tfeeRuleId\x88\x01\x01\x12\x19\n" +
+	"\bfee_rate\x18\f \x01(\tR\afeeRate\x12%\n" +
+	"\x0epercentage_fee\x18\r \x01(\tR\rpercentageFee\x12\x1b\n" +
+	"\tfixed_fee\x18\x0e \x01(\tR\bfixedFee\x12\x1d\n" +
+	"\n" +
+	"vat_amount\x18\x0f \x01(\tR\tvatAmount\x12!\n" +
+	"\fvat_included\x18\x10 \x01(\bR\vvatIncluded\x12\x1b\n" +
+	"\tswift_fee\x18\x11 \x01(\tR\bswiftFee\x12,\n" +
+	"\x12swift_fee_included\x18\x12 \x01(\bR\x10swiftFeeIncluded\x12\x1d\n" +
+	"\n" +
+	"total_fees\x18\x13 \x01(\tR\ttotalFees\x12\x1d\n" +
+	"\n" +
+	"net_amount\x18\x14 \x01(\tR\tnetAmount\x12%\n" +
+	"\x0eeffective_rate\x18\x15 \x01(\tR\reffectiveRate\x12B\n" +
+	"\x0frate_updated_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\rrateUpdatedAtB\x0e\n" +
+	"\f_fee_rule_idB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_quote_proto_rawDescOnce sync.Once
+	file_quote_proto_rawDescData []byte
+)
+
+func file_quote_proto_rawDescGZIP() []byte {
+	file_quote_proto_rawDescOnce.Do(func() {
+		file_quote_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_quote_proto_rawDesc), len(file_quote_proto_rawDesc)))
+	})
+	return file_quote_proto_rawDescData
+}
+
+var file_quote_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_quote_proto_goTypes = []any{
+	(*Quote)(nil),                 // 0: pb.Quote
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_quote_proto_depIdxs = []int32{
+	1, // 0: pb.Quote.rate_updated_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_quote_proto_init() }
+func file_quote_proto_init() {
+	if File_quote_proto != nil {
+		return
+	}
+	file_quote_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_quote_proto_rawDesc), len(file_quote_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_quote_proto_goTypes,
+		DependencyIndexes: file_quote_proto_depIdxs,
+		MessageInfos:      file_quote_proto_msgTypes,
+	}.Build()
+	File_quote_proto = out.File
+	file_quote_proto_goTypes = nil
+	file_quote_proto_depIdxs = nil
+}
@@ -0,0 +1,262 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rate_alert.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RateAlert watches to_currency units per 1 from_currency unit at one source.
+type RateAlert struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AlertId         int32                  `protobuf:"varint,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	UserId          int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SourceId        int32                  `protobuf:"varint,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TypeId          int32                  `protobuf:"varint,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	FromCurrencyId  int32                  `protobuf:"varint,5,opt,name=from_currency_id,json=fromCurrencyId,proto3" json:"from_currency_id,omitempty"`
+	ToCurrencyId    int32                  `protobuf:"varint,6,opt,name=to_currency_id,json=toCurrencyId,proto3" json:"to_currency_id,omitempty"`
+	Condition       string                 `protobuf:"bytes,7,opt,name=condition,proto3" json:"condition,omitempty"`
+	Threshold       *string                `protobuf:"bytes,8,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
+	ChangePercent   *string                `protobuf:"bytes,9,opt,name=change_percent,json=changePercent,proto3,oneof" json:"change_percent,omitempty"`
+	WindowHours     int32                  `protobuf:"varint,10,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
+	IsActive        bool                   `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	LastRateValue   *string                `protobuf:"bytes,12,opt,name=last_rate_value,json=lastRateValue,proto3,oneof" json:"last_rate_value,omitempty"`
+	LastTriggeredAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_triggered_at,json=lastTriggeredAt,proto3" json:"last_triggered_at,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RateAlert) Reset() {
+	*x = RateAlert{}
+	mi := &file_rate_alert_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateAlert) ProtoMessage() {}
+
+func (x *RateAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_rate_alert_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateAlert.ProtoReflect.Descriptor instead.
+func (*RateAlert) Descriptor() ([]byte, []int) {
+	return file_rate_alert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RateAlert) GetAlertId() int32 {
+	if x != nil {
+		return x.AlertId
+	}
+	return 0
+}
+
+func (x *RateAlert) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RateAlert) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *RateAlert) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *RateAlert) GetFromCurrencyId() int32 {
+	if x != nil {
+		return x.FromCurrencyId
+	}
+	return 0
+}
+
+func (x *RateAlert) GetToCurrencyId() int32 {
+	if x != nil {
+		return x.ToCurrencyId
+	}
+	return 0
+}
+
+func (x *RateAlert) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *RateAlert) GetThreshold() string {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return ""
+}
+
+func (x *RateAlert) GetChangePercent() string {
+	if x != nil && x.ChangePercent != nil {
+		return *x.ChangePercent
+	}
+	return ""
+}
+
+func (x *RateAlert) GetWindowHours() int32 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+func (x *RateAlert) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *RateAlert) GetLastRateValue() string {
+	if x != nil && x.LastRateValue != nil {
+		return *x.LastRateValue
+	}
+	return ""
+}
+
+func (x *RateAlert) GetLastTriggeredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTriggeredAt
+	}
+	return nil
+}
+
+func (x *RateAlert) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RateAlert) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_rate_alert_proto protoreflect.FileDescriptor
+
+const file_rate_alert_proto_rawDesc = "" +
+	"\n" +
+	"\x10rate_alert.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\x05\n" +
+	"\tRateAlert\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\x05R\aalertId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tsource_id\x18\x03 \x01(\x05R\bsourceId\x12\x17\n" +
+	"\atype_id\x18\x04 \x01(\x05R\x06typeId\x12(\n" +
+	"\x10from_currency_id\x18\x05 \x01(\x05R\x0efromCurrencyId\x12$\n" +
+	"\x0eto_currency_id\x18\x06 \x01(\x05R\ftoCurrencyId\x12\x1c\n" +
+	"\tcondition\x18\a \x01(\tR\tcondition\x12!\n" +
+	"\tthreshold\x18\b \x01(\tH\x00R\tthreshold\x88\x01\x01\x12*\n" +
+	"\x0echange_percent\x18\t \x01(\tH\x01R\rchangePercent\x88\x01\x01\x12!\n" +
+	"\fwindow_hours\x18\n" +
+	" \x01(\x05R\vwindowHours\x12\x1b\n" +
+	"\tis_active\x18\v \x01(\bR\bisActive\x12+\n" +
+	"\x0flast_rate_value\x18\f \x01(\tH\x02R\rlastRateValue\x88\x01\x01\x12F\n" +
+	"\x11last_triggered_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x0flastTriggeredAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\f\n" +
+	"\n" +
+	"_thresholdB\x11\n" +
+	"\x0f_change_percentB\x12\n" +
+	"\x10_last_rate_valueB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rate_alert_proto_rawDescOnce sync.Once
+	file_rate_alert_proto_rawDescData []byte
+)
+
+func file_rate_alert_proto_rawDescGZIP() []byte {
+	file_rate_alert_proto_rawDescOnce.Do(func() {
+		file_rate_alert_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rate_alert_proto_rawDesc), len(file_rate_alert_proto_rawDesc)))
+	})
+	return file_rate_alert_proto_rawDescData
+}
+
+var file_rate_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rate_alert_proto_goTypes = []any{
+	(*RateAlert)(nil),             // 0: pb.RateAlert
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_rate_alert_proto_depIdxs = []int32{
+	1, // 0: pb.RateAlert.last_triggered_at:type_name -> google.protobuf.Timestamp
+	1, // 1: pb.RateAlert.created_at:type_name -> google.protobuf.Timestamp
+	1, // 2: pb.RateAlert.updated_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rate_alert_proto_init() }
+func file_rate_alert_proto_init() {
+	if File_rate_alert_proto != nil {
+		return
+	}
+	file_rate_alert_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rate_alert_proto_rawDesc), len(file_rate_alert_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rate_alert_proto_goTypes,
+		DependencyIndexes: file_rate_alert_proto_depIdxs,
+		MessageInfos:      file_rate_alert_proto_msgTypes,
+	}.Build()
+	File_rate_alert_proto = out.File
+	file_rate_alert_proto_goTypes = nil
+	file_rate_alert_proto_depIdxs = nil
+}
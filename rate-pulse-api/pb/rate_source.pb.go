@@ -0,0 +1,176 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rate_source.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RateSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      int32                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	SourceName    string                 `protobuf:"bytes,2,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	SourceLink    *string                `protobuf:"bytes,3,opt,name=source_link,json=sourceLink,proto3,oneof" json:"source_link,omitempty"`
+	SourceCountry *string                `protobuf:"bytes,4,opt,name=source_country,json=sourceCountry,proto3,oneof" json:"source_country,omitempty"`
+	SourceStatus  *string                `protobuf:"bytes,5,opt,name=source_status,json=sourceStatus,proto3,oneof" json:"source_status,omitempty"`
+	SourceCode    *string                `protobuf:"bytes,6,opt,name=source_code,json=sourceCode,proto3,oneof" json:"source_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateSource) Reset() {
+	*x = RateSource{}
+	mi := &file_rate_source_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateSource) ProtoMessage() {}
+
+func (x *RateSource) ProtoReflect() protoreflect.Message {
+	mi := &file_rate_source_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateSource.ProtoReflect.Descriptor instead.
+func (*RateSource) Descriptor() ([]byte, []int) {
+	return file_rate_source_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RateSource) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *RateSource) GetSourceName() string {
+	if x != nil {
+		return x.SourceName
+	}
+	return ""
+}
+
+func (x *RateSource) GetSourceLink() string {
+	if x != nil && x.SourceLink != nil {
+		return *x.SourceLink
+	}
+	return ""
+}
+
+func (x *RateSource) GetSourceCountry() string {
+	if x != nil && x.SourceCountry != nil {
+		return *x.SourceCountry
+	}
+	return ""
+}
+
+func (x *RateSource) GetSourceStatus() string {
+	if x != nil && x.SourceStatus != nil {
+		return *x.SourceStatus
+	}
+	return ""
+}
+
+func (x *RateSource) GetSourceCode() string {
+	if x != nil && x.SourceCode != nil {
+		return *x.SourceCode
+	}
+	return ""
+}
+
+var File_rate_source_proto protoreflect.FileDescriptor
+
+const file_rate_source_proto_rawDesc = "" +
+	"\n" +
+	"\x11rate_source.proto\x12\x02pb\"\xb1\x02\n" +
+	"\n" +
+	"RateSource\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x05R\bsourceId\x12\x1f\n" +
+	"\vsource_name\x18\x02 \x01(\tR\n" +
+	"sourceName\x12$\n" +
+	"\vsource_link\x18\x03 \x01(\tH\x00R\n" +
+	"sourceLink\x88\x01\x01\x12*\n" +
+	"\x0esource_country\x18\x04 \x01(\tH\x01R\rsourceCountry\x88\x01\x01\x12(\n" +
+	"\rsource_status\x18\x05 \x01(\tH\x02R\fsourceStatus\x88\x01\x01\x12$\n" +
+	"\vsource_code\x18\x06 \x01(\tH\x03R\n" +
+	"sourceCode\x88\x01\x01B\x0e\n" +
+	"\f_source_linkB\x11\n" +
+	"\x0f_source_countryB\x10\n" +
+	"\x0e_source_statusB\x0e\n" +
+	"\f_source_codeB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rate_source_proto_rawDescOnce sync.Once
+	file_rate_source_proto_rawDescData []byte
+)
+
+func file_rate_source_proto_rawDescGZIP() []byte {
+	file_rate_source_proto_rawDescOnce.Do(func() {
+		file_rate_source_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rate_source_proto_rawDesc), len(file_rate_source_proto_rawDesc)))
+	})
+	return file_rate_source_proto_rawDescData
+}
+
+var file_rate_source_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rate_source_proto_goTypes = []any{
+	(*RateSource)(nil), // 0: pb.RateSource
+}
+var file_rate_source_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rate_source_proto_init() }
+func file_rate_source_proto_init() {
+	if File_rate_source_proto != nil {
+		return
+	}
+	file_rate_source_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rate_source_proto_rawDesc), len(file_rate_source_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rate_source_proto_goTypes,
+		DependencyIndexes: file_rate_source_proto_depIdxs,
+		MessageInfos:      file_rate_source_proto_msgTypes,
+	}.Build()
+	File_rate_source_proto = out.File
+	file_rate_source_proto_goTypes = nil
+	file_rate_source_proto_depIdxs = nil
+}
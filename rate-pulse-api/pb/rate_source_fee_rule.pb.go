@@ -0,0 +1,360 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rate_source_fee_rule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RateSourceFeeRule struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FeeRuleId          int32                  `protobuf:"varint,1,opt,name=fee_rule_id,json=feeRuleId,proto3" json:"fee_rule_id,omitempty"`
+	SourceId           int32                  `protobuf:"varint,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TypeId             int32                  `protobuf:"varint,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	TransactionType    string                 `protobuf:"bytes,4,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	Channel            string                 `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"`
+	FeeRate            *string                `protobuf:"bytes,6,opt,name=fee_rate,json=feeRate,proto3,oneof" json:"fee_rate,omitempty"`
+	FeeRateMin         *string                `protobuf:"bytes,7,opt,name=fee_rate_min,json=feeRateMin,proto3,oneof" json:"fee_rate_min,omitempty"`
+	FeeRateMax         *string                `protobuf:"bytes,8,opt,name=fee_rate_max,json=feeRateMax,proto3,oneof" json:"fee_rate_max,omitempty"`
+	FeeCurrencyId      *int32                 `protobuf:"varint,9,opt,name=fee_currency_id,json=feeCurrencyId,proto3,oneof" json:"fee_currency_id,omitempty"`
+	FixedFee           *string                `protobuf:"bytes,10,opt,name=fixed_fee,json=fixedFee,proto3,oneof" json:"fixed_fee,omitempty"`
+	MinFee             *string                `protobuf:"bytes,11,opt,name=min_fee,json=minFee,proto3,oneof" json:"min_fee,omitempty"`
+	MaxFee             *string                `protobuf:"bytes,12,opt,name=max_fee,json=maxFee,proto3,oneof" json:"max_fee,omitempty"`
+	VatRate            string                 `protobuf:"bytes,13,opt,name=vat_rate,json=vatRate,proto3" json:"vat_rate,omitempty"`
+	VatApplies         string                 `protobuf:"bytes,14,opt,name=vat_applies,json=vatApplies,proto3" json:"vat_applies,omitempty"`
+	FeeIncludesVat     bool                   `protobuf:"varint,15,opt,name=fee_includes_vat,json=feeIncludesVat,proto3" json:"fee_includes_vat,omitempty"`
+	SwiftFee           *string                `protobuf:"bytes,16,opt,name=swift_fee,json=swiftFee,proto3,oneof" json:"swift_fee,omitempty"`
+	SwiftFeeCurrencyId *int32                 `protobuf:"varint,17,opt,name=swift_fee_currency_id,json=swiftFeeCurrencyId,proto3,oneof" json:"swift_fee_currency_id,omitempty"`
+	SwiftFeeIncluded   bool                   `protobuf:"varint,18,opt,name=swift_fee_included,json=swiftFeeIncluded,proto3" json:"swift_fee_included,omitempty"`
+	SourceUrl          *string                `protobuf:"bytes,19,opt,name=source_url,json=sourceUrl,proto3,oneof" json:"source_url,omitempty"`
+	SourceNote         *string                `protobuf:"bytes,20,opt,name=source_note,json=sourceNote,proto3,oneof" json:"source_note,omitempty"`
+	EffectiveFrom      *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveTo        *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RateSourceFeeRule) Reset() {
+	*x = RateSourceFeeRule{}
+	mi := &file_rate_source_fee_rule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateSourceFeeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateSourceFeeRule) ProtoMessage() {}
+
+func (x *RateSourceFeeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_rate_source_fee_rule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateSourceFeeRule.ProtoReflect.Descriptor instead.
+func (*RateSourceFeeRule) Descriptor() ([]byte, []int) {
+	return file_rate_source_fee_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RateSourceFeeRule) GetFeeRuleId() int32 {
+	if x != nil {
+		return x.FeeRuleId
+	}
+	return 0
+}
+
+func (x *RateSourceFeeRule) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *RateSourceFeeRule) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *RateSourceFeeRule) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *RateSourceFeeRule) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *RateSourceFeeRule) GetFeeRate() string {
+	if x != nil && x.FeeRate != nil {
+		return *x.FeeRate
+	}
+	return ""
+}
+
+func (x *RateSourceFeeRule) GetFeeRateMin() string {
+	if x != nil && x.FeeRateMin != nil {
+		return *x.FeeRateMin
+	}
+	return ""
+}
+
+func (x *RateSourceFeeRule) GetFeeRateMax() string {
+	if x != nil && x.FeeRateMax != nil {
+		return *x.FeeRateMax
+	}
+	return ""
+}
+
+func (x *RateSourceFeeRule) GetFeeCurrencyId() int32 {
+	if x != nil && x.FeeCurrencyId != nil {
+		return *x.FeeCurrencyId
+	}
+	return 0
+}
+
+func (x *RateSourceFeeRule) GetFixedFee() string {
+	if x != nil && x.FixedFee != nil {
+		return *x.FixedFee
+	}
+	return ""
+}
+
+func (x *RateSourceFeeRule) GetMinFee() string {
+	if x != nil && x.MinFee != nil {
+		return *x.MinFee
+	}
+	return ""
+}
+
+func (x *RateSourceFeeRule) GetMaxFee() string {
+	if x != nil && x.MaxFee != nil {
+		return *x.MaxFee
+	}
+	return ""
+}
+
+func (x *RateSourceFeeRule) GetVatRate() string {
+	if x != nil {
+		return x.VatRate
+	}
+	return ""
+}
+
+func (x *RateSourceFeeRule) GetVatApplies() string {
+	if x != nil {
+		return x.VatApplies
+	}
+	return ""
+}
+
+func (x *RateSourceFeeRule) GetFeeIncludesVat() bool {
+	if x != nil {
+		return x.FeeIncludesVat
+	}
+	return false
+}
+
+func (x *RateSourceFeeRule) GetSwiftFee() string {
+	if x != nil && x.SwiftFee != nil {
+		return *x.SwiftFee
+	}
+	return ""
+}
+
+func (x *RateSourceFeeRule) GetSwiftFeeCurrencyId() int32 {
+	if x != nil && x.SwiftFeeCurrencyId != nil {
+		return *x.SwiftFeeCurrencyId
+	}
+	return 0
+}
+
+func (x *RateSourceFeeRule) GetSwiftFeeIncluded() bool {
+	if x != nil {
+		return x.SwiftFeeIncluded
+	}
+	return false
+}
+
+func (x *RateSourceFeeRule) GetSourceUrl() string {
+	if x != nil && x.SourceUrl != nil {
+		return *x.SourceUrl
+	}
+	return ""
+}
+
+func (x *RateSourceFeeRule) GetSourceNote() string {
+	if x != nil && x.SourceNote != nil {
+		return *x.SourceNote
+	}
+	return ""
+}
+
+func (x *RateSourceFeeRule) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *RateSourceFeeRule) GetEffectiveTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return nil
+}
+
+func (x *RateSourceFeeRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RateSourceFeeRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_rate_source_fee_rule_proto protoreflect.FileDescriptor
+
+const file_rate_source_fee_rule_proto_rawDesc = "" +
+	"\n" +
+	"\x1arate_source_fee_rule.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\x87\t\n" +
+	"\x11RateSourceFeeRule\x12\x1e\n" +
+	"\vfee_rule_id\x18\x01 \x01(\x05R\tfeeRuleId\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\x05R\bsourceId\x12\x17\n" +
+	"\atype_id\x18\x03 \x01(\x05R\x06typeId\x12)\n" +
+	"\x10transaction_type\x18\x04 \x01(\tR\x0ftransactionType\x12\x18\n" +
+	"\achannel\x18\x05 \x01(\tR\achannel\x12\x1e\n" +
+	"\bfee_rate\x18\x06 \x01(\tH\x00R\afeeRate\x88\x01\x01\x12%\n" +
+	"\ffee_rate_min\x18\a \x01(\tH\x01R\n" +
+	"feeRateMin\x88\x01\x01\x12%\n" +
+	"\ffee_rate_max\x18\b \x01(\tH\x02R\n" +
+	"feeRateMax\x88\x01\x01\x12+\n" +
+	"\x0ffee_currency_id\x18\t \x01(\x05H\x03R\rfeeCurrencyId\x88\x01\x01\x12 \n" +
+	"\tfixed_fee\x18\n" +
+	" \x01(\tH\x04R\bfixedFee\x88\x01\x01\x12\x1c\n" +
+	"\amin_fee\x18\v \x01(\tH\x05R\x06minFee\x88\x01\x01\x12\x1c\n" +
+	"\amax_fee\x18\f \x01(\tH\x06R\x06maxFee\x88\x01\x01\x12\x19\n" +
+	"\bvat_rate\x18\r \x01(\tR\avatRate\x12\x1f\n" +
+	"\vvat_applies\x18\x0e \x01(\tR\n" +
+	"vatApplies\x12(\n" +
+	"\x10fee_includes_vat\x18\x0f \x01(\bR\x0efeeIncludesVat\x12 \n" +
+	"\tswift_fee\x18\x10 \x01(\tH\aR\bswiftFee\x88\x01\x01\x126\n" +
+	"\x15swift_fee_currency_id\x18\x11 \x01(\x05H\bR\x12swiftFeeCurrencyId\x88\x01\x01\x12,\n" +
+	"\x12swift_fee_included\x18\x12 \x01(\bR\x10swiftFeeIncluded\x12\"\n" +
+	"\n" +
+	"source_url\x18\x13 \x01(\tH\tR\tsourceUrl\x88\x01\x01\x12$\n" +
+	"\vsource_note\x18\x14 \x01(\tH\n" +
+	"R\n" +
+	"sourceNote\x88\x01\x01\x12A\n" +
+	"\x0eeffective_from\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12=\n" +
+	"\feffective_to\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveTo\x129\n" +
+	"\n" +
+	"updated_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\v\n" +
+	"\t_fee_rateB\x0f\n" +
+	"\r_fee_rate_minB\x0f\n" +
+	"\r_fee_rate_maxB\x12\n" +
+	"\x10_fee_currency_idB\f\n" +
+	"\n" +
+	"_fixed_feeB\n" +
+	"\n" +
+	"\b_min_feeB\n" +
+	"\n" +
+	"\b_max_feeB\f\n" +
+	"\n" +
+	"_swift_feeB\x18\n" +
+	"\x16_swift_fee_currency_idB\r\n" +
+	"\v_source_urlB\x0e\n" +
+	"\f_source_noteB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rate_source_fee_rule_proto_rawDescOnce sync.Once
+	file_rate_source_fee_rule_proto_rawDescData []byte
+)
+
+func file_rate_source_fee_rule_proto_rawDescGZIP() []byte {
+	file_rate_source_fee_rule_proto_rawDescOnce.Do(func() {
+		file_rate_source_fee_rule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rate_source_fee_rule_proto_rawDesc), len(file_rate_source_fee_rule_proto_rawDesc)))
+	})
+	return file_rate_source_fee_rule_proto_rawDescData
+}
+
+var file_rate_source_fee_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rate_source_fee_rule_proto_goTypes = []any{
+	(*RateSourceFeeRule)(nil),     // 0: pb.RateSourceFeeRule
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_rate_source_fee_rule_proto_depIdxs = []int32{
+	1, // 0: pb.RateSourceFeeRule.effective_from:type_name -> google.protobuf.Timestamp
+	1, // 1: pb.RateSourceFeeRule.effective_to:type_name -> google.protobuf.Timestamp
+	1, // 2: pb.RateSourceFeeRule.updated_at:type_name -> google.protobuf.Timestamp
+	1, // 3: pb.RateSourceFeeRule.created_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rate_source_fee_rule_proto_init() }
+func file_rate_source_fee_rule_proto_init() {
+	if File_rate_source_fee_rule_proto != nil {
+		return
+	}
+	file_rate_source_fee_rule_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rate_source_fee_rule_proto_rawDesc), len(file_rate_source_fee_rule_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rate_source_fee_rule_proto_goTypes,
+		DependencyIndexes: file_rate_source_fee_rule_proto_depIdxs,
+		MessageInfos:      file_rate_source_fee_rule_proto_msgTypes,
+	}.Build()
+	File_rate_source_fee_rule_proto = out.File
+	file_rate_source_fee_rule_proto_goTypes = nil
+	file_rate_source_fee_rule_proto_depIdxs = nil
+}
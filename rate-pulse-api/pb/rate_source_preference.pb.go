@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rate_source_preference.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RateSourcePreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      int32                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,3,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateSourcePreference) Reset() {
+	*x = RateSourcePreference{}
+	mi := &file_rate_source_preference_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateSourcePreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateSourcePreference) ProtoMessage() {}
+
+func (x *RateSourcePreference) ProtoReflect() protoreflect.Message {
+	mi := &file_rate_source_preference_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateSourcePreference.ProtoReflect.Descriptor instead.
+func (*RateSourcePreference) Descriptor() ([]byte, []int) {
+	return file_rate_source_preference_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RateSourcePreference) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *RateSourcePreference) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RateSourcePreference) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+func (x *RateSourcePreference) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RateSourcePreference) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_rate_source_preference_proto protoreflect.FileDescriptor
+
+const file_rate_source_preference_proto_rawDesc = "" +
+	"\n" +
+	"\x1crate_source_preference.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe1\x01\n" +
+	"\x14RateSourcePreference\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x05R\bsourceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x03 \x01(\bR\tisPrimary\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rate_source_preference_proto_rawDescOnce sync.Once
+	file_rate_source_preference_proto_rawDescData []byte
+)
+
+func file_rate_source_preference_proto_rawDescGZIP() []byte {
+	file_rate_source_preference_proto_rawDescOnce.Do(func() {
+		file_rate_source_preference_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rate_source_preference_proto_rawDesc), len(file_rate_source_preference_proto_rawDesc)))
+	})
+	return file_rate_source_preference_proto_rawDescData
+}
+
+var file_rate_source_preference_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rate_source_preference_proto_goTypes = []any{
+	(*RateSourcePreference)(nil),  // 0: pb.RateSourcePreference
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_rate_source_preference_proto_depIdxs = []int32{
+	1, // 0: pb.RateSourcePreference.updated_at:type_name -> google.protobuf.Timestamp
+	1, // 1: pb.RateSourcePreference.created_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rate_source_preference_proto_init() }
+func file_rate_source_preference_proto_init() {
+	if File_rate_source_preference_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rate_source_preference_proto_rawDesc), len(file_rate_source_preference_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rate_source_preference_proto_goTypes,
+		DependencyIndexes: file_rate_source_preference_proto_depIdxs,
+		MessageInfos:      file_rate_source_preference_proto_msgTypes,
+	}.Build()
+	File_rate_source_preference_proto = out.File
+	file_rate_source_preference_proto_goTypes = nil
+	file_rate_source_preference_proto_depIdxs = nil
+}
@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_admin_get_payment.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminGetPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     int32                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetPaymentRequest) Reset() {
+	*x = AdminGetPaymentRequest{}
+	mi := &file_rpc_admin_get_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetPaymentRequest) ProtoMessage() {}
+
+func (x *AdminGetPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_get_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetPaymentRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_get_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminGetPaymentRequest) GetPaymentId() int32 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+type AdminGetPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetPaymentResponse) Reset() {
+	*x = AdminGetPaymentResponse{}
+	mi := &file_rpc_admin_get_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetPaymentResponse) ProtoMessage() {}
+
+func (x *AdminGetPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_get_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetPaymentResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_get_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminGetPaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+var File_rpc_admin_get_payment_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_get_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x1brpc_admin_get_payment.proto\x12\x02pb\x1a\rpayment.proto\"7\n" +
+	"\x16AdminGetPaymentRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x05R\tpaymentId\"@\n" +
+	"\x17AdminGetPaymentResponse\x12%\n" +
+	"\apayment\x18\x01 \x01(\v2\v.pb.PaymentR\apaymentB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_admin_get_payment_proto_rawDescOnce sync.Once
+	file_rpc_admin_get_payment_proto_rawDescData []byte
+)
+
+func file_rpc_admin_get_payment_proto_rawDescGZIP() []byte {
+	file_rpc_admin_get_payment_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_get_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_get_payment_proto_rawDesc), len(file_rpc_admin_get_payment_proto_rawDesc)))
+	})
+	return file_rpc_admin_get_payment_proto_rawDescData
+}
+
+var file_rpc_admin_get_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_admin_get_payment_proto_goTypes = []any{
+	(*AdminGetPaymentRequest)(nil),  // 0: pb.AdminGetPaymentRequest
+	(*AdminGetPaymentResponse)(nil), // 1: pb.AdminGetPaymentResponse
+	(*Payment)(nil),                 // 2: pb.Payment
+}
+var file_rpc_admin_get_payment_proto_depIdxs = []int32{
+	2, // 0: pb.AdminGetPaymentResponse.payment:type_name -> pb.Payment
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_get_payment_proto_init() }
+func file_rpc_admin_get_payment_proto_init() {
+	if File_rpc_admin_get_payment_proto != nil {
+		return
+	}
+	file_payment_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_get_payment_proto_rawDesc), len(file_rpc_admin_get_payment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_get_payment_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_get_payment_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_get_payment_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_get_payment_proto = out.File
+	file_rpc_admin_get_payment_proto_goTypes = nil
+	file_rpc_admin_get_payment_proto_depIdxs = nil
+}
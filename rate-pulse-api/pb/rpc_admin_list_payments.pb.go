@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_admin_list_payments.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminListPaymentsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         *string                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	SubscriptionId *int32                 `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty"`
+	From           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Cursor         string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize       int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Sort           string                 `protobuf:"bytes,7,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminListPaymentsRequest) Reset() {
+	*x = AdminListPaymentsRequest{}
+	mi := &file_rpc_admin_list_payments_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListPaymentsRequest) ProtoMessage() {}
+
+func (x *AdminListPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_list_payments_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*AdminListPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_list_payments_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminListPaymentsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *AdminListPaymentsRequest) GetSubscriptionId() int32 {
+	if x != nil && x.SubscriptionId != nil {
+		return *x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *AdminListPaymentsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AdminListPaymentsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *AdminListPaymentsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AdminListPaymentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AdminListPaymentsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type AdminListPaymentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payments      []*Payment             `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListPaymentsResponse) Reset() {
+	*x = AdminListPaymentsResponse{}
+	mi := &file_rpc_admin_list_payments_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListPaymentsResponse) ProtoMessage() {}
+
+func (x *AdminListPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_list_payments_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*AdminListPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_list_payments_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminListPaymentsResponse) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *AdminListPaymentsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+var File_rpc_admin_list_payments_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_list_payments_proto_rawDesc = "" +
+	"\n" +
+	"\x1drpc_admin_list_payments.proto\x12\x02pb\x1a\rpayment.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa9\x02\n" +
+	"\x18AdminListPaymentsRequest\x12\x1b\n" +
+	"\x06status\x18\x01 \x01(\tH\x00R\x06status\x88\x01\x01\x12,\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\x05H\x01R\x0esubscriptionId\x88\x01\x01\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x12\n" +
+	"\x04sort\x18\a \x01(\tR\x04sortB\t\n" +
+	"\a_statusB\x12\n" +
+	"\x10_subscription_id\"e\n" +
+	"\x19AdminListPaymentsResponse\x12'\n" +
+	"\bpayments\x18\x01 \x03(\v2\v.pb.PaymentR\bpayments\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursorB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_admin_list_payments_proto_rawDescOnce sync.Once
+	file_rpc_admin_list_payments_proto_rawDescData []byte
+)
+
+func file_rpc_admin_list_payments_proto_rawDescGZIP() []byte {
+	file_rpc_admin_list_payments_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_list_payments_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_list_payments_proto_rawDesc), len(file_rpc_admin_list_payments_proto_rawDesc)))
+	})
+	return file_rpc_admin_list_payments_proto_rawDescData
+}
+
+var file_rpc_admin_list_payments_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_admin_list_payments_proto_goTypes = []any{
+	(*AdminListPaymentsRequest)(nil),  // 0: pb.AdminListPaymentsRequest
+	(*AdminListPaymentsResponse)(nil), // 1: pb.AdminListPaymentsResponse
+	(*timestamppb.Timestamp)(nil),     // 2: google.protobuf.Timestamp
+	(*Payment)(nil),                   // 3: pb.Payment
+}
+var file_rpc_admin_list_payments_proto_depIdxs = []int32{
+	2, // 0: pb.AdminListPaymentsRequest.from:type_name -> google.protobuf.Timestamp
+	2, // 1: pb.AdminListPaymentsRequest.to:type_name -> google.protobuf.Timestamp
+	3, // 2: pb.AdminListPaymentsResponse.payments:type_name -> pb.Payment
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_list_payments_proto_init() }
+func file_rpc_admin_list_payments_proto_init() {
+	if File_rpc_admin_list_payments_proto != nil {
+		return
+	}
+	file_payment_proto_init()
+	file_rpc_admin_list_payments_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_list_payments_proto_rawDesc), len(file_rpc_admin_list_payments_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_list_payments_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_list_payments_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_list_payments_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_list_payments_proto = out.File
+	file_rpc_admin_list_payments_proto_goTypes = nil
+	file_rpc_admin_list_payments_proto_depIdxs = nil
+}
@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_admin_list_user_subscriptions.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminListUserSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *string                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	PlanId        *int32                 `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3,oneof" json:"plan_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Cursor        string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Sort          string                 `protobuf:"bytes,7,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListUserSubscriptionsRequest) Reset() {
+	*x = AdminListUserSubscriptionsRequest{}
+	mi := &file_rpc_admin_list_user_subscriptions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListUserSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListUserSubscriptionsRequest) ProtoMessage() {}
+
+func (x *AdminListUserSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_list_user_subscriptions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListUserSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*AdminListUserSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_list_user_subscriptions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminListUserSubscriptionsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *AdminListUserSubscriptionsRequest) GetPlanId() int32 {
+	if x != nil && x.PlanId != nil {
+		return *x.PlanId
+	}
+	return 0
+}
+
+func (x *AdminListUserSubscriptionsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AdminListUserSubscriptionsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *AdminListUserSubscriptionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AdminListUserSubscriptionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AdminListUserSubscriptionsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type AdminListUserSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*UserSubscription    `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListUserSubscriptionsResponse) Reset() {
+	*x = AdminListUserSubscriptionsResponse{}
+	mi := &file_rpc_admin_list_user_subscriptions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListUserSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListUserSubscriptionsResponse) ProtoMessage() {}
+
+func (x *AdminListUserSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_list_user_subscriptions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListUserSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*AdminListUserSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_list_user_subscriptions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminListUserSubscriptionsResponse) GetSubscriptions() []*UserSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *AdminListUserSubscriptionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+var File_rpc_admin_list_user_subscriptions_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_list_user_subscriptions_proto_rawDesc = "" +
+	"\n" +
+	"'rpc_admin_list_user_subscriptions.proto\x12\x02pb\x1a\x17user_subscription.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9a\x02\n" +
+	"!AdminListUserSubscriptionsRequest\x12\x1b\n" +
+	"\x06status\x18\x01 \x01(\tH\x00R\x06status\x88\x01\x01\x12\x1c\n" +
+	"\aplan_id\x18\x02 \x01(\x05H\x01R\x06planId\x88\x01\x01\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x12\n" +
+	"\x04sort\x18\a \x01(\tR\x04sortB\t\n" +
+	"\a_statusB\n" +
+	"\n" +
+	"\b_plan_id\"\x81\x01\n" +
+	"\"AdminListUserSubscriptionsResponse\x12:\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x14.pb.UserSubscriptionR\rsubscriptions\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursorB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_admin_list_user_subscriptions_proto_rawDescOnce sync.Once
+	file_rpc_admin_list_user_subscriptions_proto_rawDescData []byte
+)
+
+func file_rpc_admin_list_user_subscriptions_proto_rawDescGZIP() []byte {
+	file_rpc_admin_list_user_subscriptions_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_list_user_subscriptions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_list_user_subscriptions_proto_rawDesc), len(file_rpc_admin_list_user_subscriptions_proto_rawDesc)))
+	})
+	return file_rpc_admin_list_user_subscriptions_proto_rawDescData
+}
+
+var file_rpc_admin_list_user_subscriptions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_admin_list_user_subscriptions_proto_goTypes = []any{
+	(*AdminListUserSubscriptionsRequest)(nil),  // 0: pb.AdminListUserSubscriptionsRequest
+	(*AdminListUserSubscriptionsResponse)(nil), // 1: pb.AdminListUserSubscriptionsResponse
+	(*timestamppb.Timestamp)(nil),              // 2: google.protobuf.Timestamp
+	(*UserSubscription)(nil),                   // 3: pb.UserSubscription
+}
+var file_rpc_admin_list_user_subscriptions_proto_depIdxs = []int32{
+	2, // 0: pb.AdminListUserSubscriptionsRequest.from:type_name -> google.protobuf.Timestamp
+	2, // 1: pb.AdminListUserSubscriptionsRequest.to:type_name -> google.protobuf.Timestamp
+	3, // 2: pb.AdminListUserSubscriptionsResponse.subscriptions:type_name -> pb.UserSubscription
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_list_user_subscriptions_proto_init() }
+func file_rpc_admin_list_user_subscriptions_proto_init() {
+	if File_rpc_admin_list_user_subscriptions_proto != nil {
+		return
+	}
+	file_user_subscription_proto_init()
+	file_rpc_admin_list_user_subscriptions_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_list_user_subscriptions_proto_rawDesc), len(file_rpc_admin_list_user_subscriptions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_list_user_subscriptions_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_list_user_subscriptions_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_list_user_subscriptions_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_list_user_subscriptions_proto = out.File
+	file_rpc_admin_list_user_subscriptions_proto_goTypes = nil
+	file_rpc_admin_list_user_subscriptions_proto_depIdxs = nil
+}
@@ -0,0 +1,302 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_admin_update_user.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Only the fields that are set are changed. user_type is one of free, premium, enterprise or admin.
+type AdminUpdateUserRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username           *string                `protobuf:"bytes,2,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	Email              *string                `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Password           *string                `protobuf:"bytes,4,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	UserType           *string                `protobuf:"bytes,5,opt,name=user_type,json=userType,proto3,oneof" json:"user_type,omitempty"`
+	EmailVerified      *bool                  `protobuf:"varint,6,opt,name=email_verified,json=emailVerified,proto3,oneof" json:"email_verified,omitempty"`
+	TimeZone           *string                `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`
+	LanguagePreference *string                `protobuf:"bytes,8,opt,name=language_preference,json=languagePreference,proto3,oneof" json:"language_preference,omitempty"`
+	CountryOfResidence *string                `protobuf:"bytes,9,opt,name=country_of_residence,json=countryOfResidence,proto3,oneof" json:"country_of_residence,omitempty"`
+	CountryOfBirth     *string                `protobuf:"bytes,10,opt,name=country_of_birth,json=countryOfBirth,proto3,oneof" json:"country_of_birth,omitempty"`
+	FirstName          *string                `protobuf:"bytes,11,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
+	LastName           *string                `protobuf:"bytes,12,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
+	IsActive           *bool                  `protobuf:"varint,13,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AdminUpdateUserRequest) Reset() {
+	*x = AdminUpdateUserRequest{}
+	mi := &file_rpc_admin_update_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateUserRequest) ProtoMessage() {}
+
+func (x *AdminUpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_update_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*AdminUpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_update_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminUpdateUserRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUpdateUserRequest) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetUserType() string {
+	if x != nil && x.UserType != nil {
+		return *x.UserType
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetEmailVerified() bool {
+	if x != nil && x.EmailVerified != nil {
+		return *x.EmailVerified
+	}
+	return false
+}
+
+func (x *AdminUpdateUserRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetLanguagePreference() string {
+	if x != nil && x.LanguagePreference != nil {
+		return *x.LanguagePreference
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetCountryOfResidence() string {
+	if x != nil && x.CountryOfResidence != nil {
+		return *x.CountryOfResidence
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetCountryOfBirth() string {
+	if x != nil && x.CountryOfBirth != nil {
+		return *x.CountryOfBirth
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+func (x *AdminUpdateUserRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type AdminUpdateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUpdateUserResponse) Reset() {
+	*x = AdminUpdateUserResponse{}
+	mi := &file_rpc_admin_update_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateUserResponse) ProtoMessage() {}
+
+func (x *AdminUpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_admin_update_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*AdminUpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_admin_update_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminUpdateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+var File_rpc_admin_update_user_proto protoreflect.FileDescriptor
+
+const file_rpc_admin_update_user_proto_rawDesc = "" +
+	"\n" +
+	"\x1brpc_admin_update_user.proto\x12\x02pb\x1a\n" +
+	"user.proto\"\xc6\x05\n" +
+	"\x16AdminUpdateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1f\n" +
+	"\busername\x18\x02 \x01(\tH\x00R\busername\x88\x01\x01\x12\x19\n" +
+	"\x05email\x18\x03 \x01(\tH\x01R\x05email\x88\x01\x01\x12\x1f\n" +
+	"\bpassword\x18\x04 \x01(\tH\x02R\bpassword\x88\x01\x01\x12 \n" +
+	"\tuser_type\x18\x05 \x01(\tH\x03R\buserType\x88\x01\x01\x12*\n" +
+	"\x0eemail_verified\x18\x06 \x01(\bH\x04R\remailVerified\x88\x01\x01\x12 \n" +
+	"\ttime_zone\x18\a \x01(\tH\x05R\btimeZone\x88\x01\x01\x124\n" +
+	"\x13language_preference\x18\b \x01(\tH\x06R\x12languagePreference\x88\x01\x01\x125\n" +
+	"\x14country_of_residence\x18\t \x01(\tH\aR\x12countryOfResidence\x88\x01\x01\x12-\n" +
+	"\x10country_of_birth\x18\n" +
+	" \x01(\tH\bR\x0ecountryOfBirth\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"first_name\x18\v \x01(\tH\tR\tfirstName\x88\x01\x01\x12 \n" +
+	"\tlast_name\x18\f \x01(\tH\n" +
+	"R\blastName\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\r \x01(\bH\vR\bisActive\x88\x01\x01B\v\n" +
+	"\t_usernameB\b\n" +
+	"\x06_emailB\v\n" +
+	"\t_passwordB\f\n" +
+	"\n" +
+	"_user_typeB\x11\n" +
+	"\x0f_email_verifiedB\f\n" +
+	"\n" +
+	"_time_zoneB\x16\n" +
+	"\x14_language_preferenceB\x17\n" +
+	"\x15_country_of_residenceB\x13\n" +
+	"\x11_country_of_birthB\r\n" +
+	"\v_first_nameB\f\n" +
+	"\n" +
+	"_last_nameB\f\n" +
+	"\n" +
+	"_is_active\"7\n" +
+	"\x17AdminUpdateUserResponse\x12\x1c\n" +
+	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04userB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_admin_update_user_proto_rawDescOnce sync.Once
+	file_rpc_admin_update_user_proto_rawDescData []byte
+)
+
+func file_rpc_admin_update_user_proto_rawDescGZIP() []byte {
+	file_rpc_admin_update_user_proto_rawDescOnce.Do(func() {
+		file_rpc_admin_update_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_admin_update_user_proto_rawDesc), len(file_rpc_admin_update_user_proto_rawDesc)))
+	})
+	return file_rpc_admin_update_user_proto_rawDescData
+}
+
+var file_rpc_admin_update_user_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_admin_update_user_proto_goTypes = []any{
+	(*AdminUpdateUserRequest)(nil),  // 0: pb.AdminUpdateUserRequest
+	(*AdminUpdateUserResponse)(nil), // 1: pb.AdminUpdateUserResponse
+	(*User)(nil),                    // 2: pb.User
+}
+var file_rpc_admin_update_user_proto_depIdxs = []int32{
+	2, // 0: pb.AdminUpdateUserResponse.user:type_name -> pb.User
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_admin_update_user_proto_init() }
+func file_rpc_admin_update_user_proto_init() {
+	if File_rpc_admin_update_user_proto != nil {
+		return
+	}
+	file_user_proto_init()
+	file_rpc_admin_update_user_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_admin_update_user_proto_rawDesc), len(file_rpc_admin_update_user_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_admin_update_user_proto_goTypes,
+		DependencyIndexes: file_rpc_admin_update_user_proto_depIdxs,
+		MessageInfos:      file_rpc_admin_update_user_proto_msgTypes,
+	}.Build()
+	File_rpc_admin_update_user_proto = out.File
+	file_rpc_admin_update_user_proto_goTypes = nil
+	file_rpc_admin_update_user_proto_depIdxs = nil
+}
@@ -0,0 +1,307 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_compare_quotes.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CompareQuotesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Amount          string                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	FromCurrencyId  int32                  `protobuf:"varint,2,opt,name=from_currency_id,json=fromCurrencyId,proto3" json:"from_currency_id,omitempty"`
+	ToCurrencyId    int32                  `protobuf:"varint,3,opt,name=to_currency_id,json=toCurrencyId,proto3" json:"to_currency_id,omitempty"`
+	TypeId          int32                  `protobuf:"varint,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	TransactionType string                 `protobuf:"bytes,5,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	Channel         string                 `protobuf:"bytes,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	EffectiveDate   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompareQuotesRequest) Reset() {
+	*x = CompareQuotesRequest{}
+	mi := &file_rpc_compare_quotes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareQuotesRequest) ProtoMessage() {}
+
+func (x *CompareQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_compare_quotes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareQuotesRequest.ProtoReflect.Descriptor instead.
+func (*CompareQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_compare_quotes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CompareQuotesRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CompareQuotesRequest) GetFromCurrencyId() int32 {
+	if x != nil {
+		return x.FromCurrencyId
+	}
+	return 0
+}
+
+func (x *CompareQuotesRequest) GetToCurrencyId() int32 {
+	if x != nil {
+		return x.ToCurrencyId
+	}
+	return 0
+}
+
+func (x *CompareQuotesRequest) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *CompareQuotesRequest) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *CompareQuotesRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CompareQuotesRequest) GetEffectiveDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return nil
+}
+
+type RankedQuote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	SourceName    string                 `protobuf:"bytes,2,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	SourceCode    string                 `protobuf:"bytes,3,opt,name=source_code,json=sourceCode,proto3" json:"source_code,omitempty"`
+	Quote         *Quote                 `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankedQuote) Reset() {
+	*x = RankedQuote{}
+	mi := &file_rpc_compare_quotes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankedQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankedQuote) ProtoMessage() {}
+
+func (x *RankedQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_compare_quotes_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankedQuote.ProtoReflect.Descriptor instead.
+func (*RankedQuote) Descriptor() ([]byte, []int) {
+	return file_rpc_compare_quotes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RankedQuote) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *RankedQuote) GetSourceName() string {
+	if x != nil {
+		return x.SourceName
+	}
+	return ""
+}
+
+func (x *RankedQuote) GetSourceCode() string {
+	if x != nil {
+		return x.SourceCode
+	}
+	return ""
+}
+
+func (x *RankedQuote) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+// Quotes are ordered by net amount received, best first.
+type CompareQuotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quotes        []*RankedQuote         `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareQuotesResponse) Reset() {
+	*x = CompareQuotesResponse{}
+	mi := &file_rpc_compare_quotes_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareQuotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareQuotesResponse) ProtoMessage() {}
+
+func (x *CompareQuotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_compare_quotes_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareQuotesResponse.ProtoReflect.Descriptor instead.
+func (*CompareQuotesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_compare_quotes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CompareQuotesResponse) GetQuotes() []*RankedQuote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+var File_rpc_compare_quotes_proto protoreflect.FileDescriptor
+
+const file_rpc_compare_quotes_proto_rawDesc = "" +
+	"\n" +
+	"\x18rpc_compare_quotes.proto\x12\x02pb\x1a\vquote.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9f\x02\n" +
+	"\x14CompareQuotesRequest\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\tR\x06amount\x12(\n" +
+	"\x10from_currency_id\x18\x02 \x01(\x05R\x0efromCurrencyId\x12$\n" +
+	"\x0eto_currency_id\x18\x03 \x01(\x05R\ftoCurrencyId\x12\x17\n" +
+	"\atype_id\x18\x04 \x01(\x05R\x06typeId\x12)\n" +
+	"\x10transaction_type\x18\x05 \x01(\tR\x0ftransactionType\x12\x18\n" +
+	"\achannel\x18\x06 \x01(\tR\achannel\x12A\n" +
+	"\x0eeffective_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveDate\"\x84\x01\n" +
+	"\vRankedQuote\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x1f\n" +
+	"\vsource_name\x18\x02 \x01(\tR\n" +
+	"sourceName\x12\x1f\n" +
+	"\vsource_code\x18\x03 \x01(\tR\n" +
+	"sourceCode\x12\x1f\n" +
+	"\x05quote\x18\x04 \x01(\v2\t.pb.QuoteR\x05quote\"@\n" +
+	"\x15CompareQuotesResponse\x12'\n" +
+	"\x06quotes\x18\x01 \x03(\v2\x0f.pb.RankedQuoteR\x06quotesB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_compare_quotes_proto_rawDescOnce sync.Once
+	file_rpc_compare_quotes_proto_rawDescData []byte
+)
+
+func file_rpc_compare_quotes_proto_rawDescGZIP() []byte {
+	file_rpc_compare_quotes_proto_rawDescOnce.Do(func() {
+		file_rpc_compare_quotes_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_compare_quotes_proto_rawDesc), len(file_rpc_compare_quotes_proto_rawDesc)))
+	})
+	return file_rpc_compare_quotes_proto_rawDescData
+}
+
+var file_rpc_compare_quotes_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_rpc_compare_quotes_proto_goTypes = []any{
+	(*CompareQuotesRequest)(nil),  // 0: pb.CompareQuotesRequest
+	(*RankedQuote)(nil),           // 1: pb.RankedQuote
+	(*CompareQuotesResponse)(nil), // 2: pb.CompareQuotesResponse
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*Quote)(nil),                 // 4: pb.Quote
+}
+var file_rpc_compare_quotes_proto_depIdxs = []int32{
+	3, // 0: pb.CompareQuotesRequest.effective_date:type_name -> google.protobuf.Timestamp
+	4, // 1: pb.RankedQuote.quote:type_name -> pb.Quote
+	1, // 2: pb.CompareQuotesResponse.quotes:type_name -> pb.RankedQuote
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_compare_quotes_proto_init() }
+func file_rpc_compare_quotes_proto_init() {
+	if File_rpc_compare_quotes_proto != nil {
+		return
+	}
+	file_quote_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_compare_quotes_proto_rawDesc), len(file_rpc_compare_quotes_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_compare_quotes_proto_goTypes,
+		DependencyIndexes: file_rpc_compare_quotes_proto_depIdxs,
+		MessageInfos:      file_rpc_compare_quotes_proto_msgTypes,
+	}.Build()
+	File_rpc_compare_quotes_proto = out.File
+	file_rpc_compare_quotes_proto_goTypes = nil
+	file_rpc_compare_quotes_proto_depIdxs = nil
+}
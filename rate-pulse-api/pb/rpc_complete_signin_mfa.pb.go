@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_complete_signin_mfa.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CompleteSignInMFARequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	// A TOTP code, or one of the recovery codes once TOTP is enabled.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteSignInMFARequest) Reset() {
+	*x = CompleteSignInMFARequest{}
+	mi := &file_rpc_complete_signin_mfa_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteSignInMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteSignInMFARequest) ProtoMessage() {}
+
+func (x *CompleteSignInMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_complete_signin_mfa_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteSignInMFARequest.ProtoReflect.Descriptor instead.
+func (*CompleteSignInMFARequest) Descriptor() ([]byte, []int) {
+	return file_rpc_complete_signin_mfa_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CompleteSignInMFARequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *CompleteSignInMFARequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CompleteSignInMFAResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	User                  *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	SessionId             string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AccessToken           string                 `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken          string                 `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	AccessTokenExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=access_token_expires_at,json=accessTokenExpiresAt,proto3" json:"access_token_expires_at,omitempty"`
+	RefreshTokenExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=refresh_token_expires_at,json=refreshTokenExpiresAt,proto3" json:"refresh_token_expires_at,omitempty"`
+	// Only set, once, when the code confirmed an authenticator app enrolment.
+	RecoveryCodes []string `protobuf:"bytes,7,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteSignInMFAResponse) Reset() {
+	*x = CompleteSignInMFAResponse{}
+	mi := &file_rpc_complete_signin_mfa_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteSignInMFAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteSignInMFAResponse) ProtoMessage() {}
+
+func (x *CompleteSignInMFAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_complete_signin_mfa_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteSignInMFAResponse.ProtoReflect.Descriptor instead.
+func (*CompleteSignInMFAResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_complete_signin_mfa_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CompleteSignInMFAResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *CompleteSignInMFAResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CompleteSignInMFAResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *CompleteSignInMFAResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *CompleteSignInMFAResponse) GetAccessTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AccessTokenExpiresAt
+	}
+	return nil
+}
+
+func (x *CompleteSignInMFAResponse) GetRefreshTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshTokenExpiresAt
+	}
+	return nil
+}
+
+func (x *CompleteSignInMFAResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+var File_rpc_complete_signin_mfa_proto protoreflect.FileDescriptor
+
+const file_rpc_complete_signin_mfa_proto_rawDesc = "" +
+	"\n" +
+	"\x1drpc_complete_signin_mfa.proto\x12\x02pb\x1a\n" +
+	"user.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"K\n" +
+	"\x18CompleteSignInMFARequest\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\xef\x02\n" +
+	"\x19CompleteSignInMFAResponse\x12\x1c\n" +
+	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12!\n" +
+	"\faccess_token\x18\x03 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12Q\n" +
+	"\x17access_token_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x14accessTokenExpiresAt\x12S\n" +
+	"\x18refresh_token_expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x15refreshTokenExpiresAt\x12%\n" +
+	"\x0erecovery_codes\x18\a \x03(\tR\rrecoveryCodesB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_complete_signin_mfa_proto_rawDescOnce sync.Once
+	file_rpc_complete_signin_mfa_proto_rawDescData []byte
+)
+
+func file_rpc_complete_signin_mfa_proto_rawDescGZIP() []byte {
+	file_rpc_complete_signin_mfa_proto_rawDescOnce.Do(func() {
+		file_rpc_complete_signin_mfa_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_complete_signin_mfa_proto_rawDesc), len(file_rpc_complete_signin_mfa_proto_rawDesc)))
+	})
+	return file_rpc_complete_signin_mfa_proto_rawDescData
+}
+
+var file_rpc_complete_signin_mfa_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_complete_signin_mfa_proto_goTypes = []any{
+	(*CompleteSignInMFARequest)(nil),  // 0: pb.CompleteSignInMFARequest
+	(*CompleteSignInMFAResponse)(nil), // 1: pb.CompleteSignInMFAResponse
+	(*User)(nil),                      // 2: pb.User
+	(*timestamppb.Timestamp)(nil),     // 3: google.protobuf.Timestamp
+}
+var file_rpc_complete_signin_mfa_proto_depIdxs = []int32{
+	2, // 0: pb.CompleteSignInMFAResponse.user:type_name -> pb.User
+	3, // 1: pb.CompleteSignInMFAResponse.access_token_expires_at:type_name -> google.protobuf.Timestamp
+	3, // 2: pb.CompleteSignInMFAResponse.refresh_token_expires_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_complete_signin_mfa_proto_init() }
+func file_rpc_complete_signin_mfa_proto_init() {
+	if File_rpc_complete_signin_mfa_proto != nil {
+		return
+	}
+	file_user_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_complete_signin_mfa_proto_rawDesc), len(file_rpc_complete_signin_mfa_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_complete_signin_mfa_proto_goTypes,
+		DependencyIndexes: file_rpc_complete_signin_mfa_proto_depIdxs,
+		MessageInfos:      file_rpc_complete_signin_mfa_proto_msgTypes,
+	}.Build()
+	File_rpc_complete_signin_mfa_proto = out.File
+	file_rpc_complete_signin_mfa_proto_goTypes = nil
+	file_rpc_complete_signin_mfa_proto_depIdxs = nil
+}
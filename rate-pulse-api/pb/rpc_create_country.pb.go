@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_create_country.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateCountryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryName   string                 `protobuf:"bytes,1,opt,name=country_name,json=countryName,proto3" json:"country_name,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,2,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	CurrencyId    int32                  `protobuf:"varint,3,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCountryRequest) Reset() {
+	*x = CreateCountryRequest{}
+	mi := &file_rpc_create_country_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCountryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCountryRequest) ProtoMessage() {}
+
+func (x *CreateCountryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_country_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCountryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCountryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_country_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateCountryRequest) GetCountryName() string {
+	if x != nil {
+		return x.CountryName
+	}
+	return ""
+}
+
+func (x *CreateCountryRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *CreateCountryRequest) GetCurrencyId() int32 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+type CreateCountryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Country       *Country               `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCountryResponse) Reset() {
+	*x = CreateCountryResponse{}
+	mi := &file_rpc_create_country_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCountryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCountryResponse) ProtoMessage() {}
+
+func (x *CreateCountryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_country_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCountryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCountryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_country_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCountryResponse) GetCountry() *Country {
+	if x != nil {
+		return x.Country
+	}
+	return nil
+}
+
+var File_rpc_create_country_proto protoreflect.FileDescriptor
+
+const file_rpc_create_country_proto_rawDesc = "" +
+	"\n" +
+	"\x18rpc_create_country.proto\x12\x02pb\x1a\rcountry.proto\"}\n" +
+	"\x14CreateCountryRequest\x12!\n" +
+	"\fcountry_name\x18\x01 \x01(\tR\vcountryName\x12!\n" +
+	"\fcountry_code\x18\x02 \x01(\tR\vcountryCode\x12\x1f\n" +
+	"\vcurrency_id\x18\x03 \x01(\x05R\n" +
+	"currencyId\">\n" +
+	"\x15CreateCountryResponse\x12%\n" +
+	"\acountry\x18\x01 \x01(\v2\v.pb.CountryR\acountryB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_create_country_proto_rawDescOnce sync.Once
+	file_rpc_create_country_proto_rawDescData []byte
+)
+
+func file_rpc_create_country_proto_rawDescGZIP() []byte {
+	file_rpc_create_country_proto_rawDescOnce.Do(func() {
+		file_rpc_create_country_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_create_country_proto_rawDesc), len(file_rpc_create_country_proto_rawDesc)))
+	})
+	return file_rpc_create_country_proto_rawDescData
+}
+
+var file_rpc_create_country_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_country_proto_goTypes = []any{
+	(*CreateCountryRequest)(nil),  // 0: pb.CreateCountryRequest
+	(*CreateCountryResponse)(nil), // 1: pb.CreateCountryResponse
+	(*Country)(nil),               // 2: pb.Country
+}
+var file_rpc_create_country_proto_depIdxs = []int32{
+	2, // 0: pb.CreateCountryResponse.country:type_name -> pb.Country
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_country_proto_init() }
+func file_rpc_create_country_proto_init() {
+	if File_rpc_create_country_proto != nil {
+		return
+	}
+	file_country_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_create_country_proto_rawDesc), len(file_rpc_create_country_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_country_proto_goTypes,
+		DependencyIndexes: file_rpc_create_country_proto_depIdxs,
+		MessageInfos:      file_rpc_create_country_proto_msgTypes,
+	}.Build()
+	File_rpc_create_country_proto = out.File
+	file_rpc_create_country_proto_goTypes = nil
+	file_rpc_create_country_proto_depIdxs = nil
+}
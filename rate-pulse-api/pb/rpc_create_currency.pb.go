@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_create_currency.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateCurrencyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode   string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	CurrencyName   string                 `protobuf:"bytes,2,opt,name=currency_name,json=currencyName,proto3" json:"currency_name,omitempty"`
+	CurrencySymbol string                 `protobuf:"bytes,3,opt,name=currency_symbol,json=currencySymbol,proto3" json:"currency_symbol,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateCurrencyRequest) Reset() {
+	*x = CreateCurrencyRequest{}
+	mi := &file_rpc_create_currency_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCurrencyRequest) ProtoMessage() {}
+
+func (x *CreateCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_currency_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_currency_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateCurrencyRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *CreateCurrencyRequest) GetCurrencyName() string {
+	if x != nil {
+		return x.CurrencyName
+	}
+	return ""
+}
+
+func (x *CreateCurrencyRequest) GetCurrencySymbol() string {
+	if x != nil {
+		return x.CurrencySymbol
+	}
+	return ""
+}
+
+type CreateCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      *Currency              `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCurrencyResponse) Reset() {
+	*x = CreateCurrencyResponse{}
+	mi := &file_rpc_create_currency_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCurrencyResponse) ProtoMessage() {}
+
+func (x *CreateCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_currency_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*CreateCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_currency_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCurrencyResponse) GetCurrency() *Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+var File_rpc_create_currency_proto protoreflect.FileDescriptor
+
+const file_rpc_create_currency_proto_rawDesc = "" +
+	"\n" +
+	"\x19rpc_create_currency.proto\x12\x02pb\x1a\x0ecurrency.proto\"\x8a\x01\n" +
+	"\x15CreateCurrencyRequest\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12#\n" +
+	"\rcurrency_name\x18\x02 \x01(\tR\fcurrencyName\x12'\n" +
+	"\x0fcurrency_symbol\x18\x03 \x01(\tR\x0ecurrencySymbol\"B\n" +
+	"\x16CreateCurrencyResponse\x12(\n" +
+	"\bcurrency\x18\x01 \x01(\v2\f.pb.CurrencyR\bcurrencyB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_create_currency_proto_rawDescOnce sync.Once
+	file_rpc_create_currency_proto_rawDescData []byte
+)
+
+func file_rpc_create_currency_proto_rawDescGZIP() []byte {
+	file_rpc_create_currency_proto_rawDescOnce.Do(func() {
+		file_rpc_create_currency_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_create_currency_proto_rawDesc), len(file_rpc_create_currency_proto_rawDesc)))
+	})
+	return file_rpc_create_currency_proto_rawDescData
+}
+
+var file_rpc_create_currency_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_currency_proto_goTypes = []any{
+	(*CreateCurrencyRequest)(nil),  // 0: pb.CreateCurrencyRequest
+	(*CreateCurrencyResponse)(nil), // 1: pb.CreateCurrencyResponse
+	(*Currency)(nil),               // 2: pb.Currency
+}
+var file_rpc_create_currency_proto_depIdxs = []int32{
+	2, // 0: pb.CreateCurrencyResponse.currency:type_name -> pb.Currency
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_currency_proto_init() }
+func file_rpc_create_currency_proto_init() {
+	if File_rpc_create_currency_proto != nil {
+		return
+	}
+	file_currency_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_create_currency_proto_rawDesc), len(file_rpc_create_currency_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_currency_proto_goTypes,
+		DependencyIndexes: file_rpc_create_currency_proto_depIdxs,
+		MessageInfos:      file_rpc_create_currency_proto_msgTypes,
+	}.Build()
+	File_rpc_create_currency_proto = out.File
+	file_rpc_create_currency_proto_goTypes = nil
+	file_rpc_create_currency_proto_depIdxs = nil
+}
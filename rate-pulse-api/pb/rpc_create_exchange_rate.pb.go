@@ -0,0 +1,231 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_create_exchange_rate.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateExchangeRateRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RateValue             string                 `protobuf:"bytes,1,opt,name=rate_value,json=rateValue,proto3" json:"rate_value,omitempty"`
+	SourceCurrencyId      int32                  `protobuf:"varint,2,opt,name=source_currency_id,json=sourceCurrencyId,proto3" json:"source_currency_id,omitempty"`
+	DestinationCurrencyId int32                  `protobuf:"varint,3,opt,name=destination_currency_id,json=destinationCurrencyId,proto3" json:"destination_currency_id,omitempty"`
+	ValidFromDate         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_from_date,json=validFromDate,proto3" json:"valid_from_date,omitempty"`
+	ValidToDate           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_to_date,json=validToDate,proto3" json:"valid_to_date,omitempty"`
+	SourceId              int32                  `protobuf:"varint,6,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TypeId                int32                  `protobuf:"varint,7,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateExchangeRateRequest) Reset() {
+	*x = CreateExchangeRateRequest{}
+	mi := &file_rpc_create_exchange_rate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExchangeRateRequest) ProtoMessage() {}
+
+func (x *CreateExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_exchange_rate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*CreateExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_exchange_rate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateExchangeRateRequest) GetRateValue() string {
+	if x != nil {
+		return x.RateValue
+	}
+	return ""
+}
+
+func (x *CreateExchangeRateRequest) GetSourceCurrencyId() int32 {
+	if x != nil {
+		return x.SourceCurrencyId
+	}
+	return 0
+}
+
+func (x *CreateExchangeRateRequest) GetDestinationCurrencyId() int32 {
+	if x != nil {
+		return x.DestinationCurrencyId
+	}
+	return 0
+}
+
+func (x *CreateExchangeRateRequest) GetValidFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFromDate
+	}
+	return nil
+}
+
+func (x *CreateExchangeRateRequest) GetValidToDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidToDate
+	}
+	return nil
+}
+
+func (x *CreateExchangeRateRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *CreateExchangeRateRequest) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+type CreateExchangeRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeRate  *ExchangeRate          `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExchangeRateResponse) Reset() {
+	*x = CreateExchangeRateResponse{}
+	mi := &file_rpc_create_exchange_rate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExchangeRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExchangeRateResponse) ProtoMessage() {}
+
+func (x *CreateExchangeRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_exchange_rate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExchangeRateResponse.ProtoReflect.Descriptor instead.
+func (*CreateExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_exchange_rate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateExchangeRateResponse) GetExchangeRate() *ExchangeRate {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return nil
+}
+
+var File_rpc_create_exchange_rate_proto protoreflect.FileDescriptor
+
+const file_rpc_create_exchange_rate_proto_rawDesc = "" +
+	"\n" +
+	"\x1erpc_create_exchange_rate.proto\x12\x02pb\x1a\x13exchange_rate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xda\x02\n" +
+	"\x19CreateExchangeRateRequest\x12\x1d\n" +
+	"\n" +
+	"rate_value\x18\x01 \x01(\tR\trateValue\x12,\n" +
+	"\x12source_currency_id\x18\x02 \x01(\x05R\x10sourceCurrencyId\x126\n" +
+	"\x17destination_currency_id\x18\x03 \x01(\x05R\x15destinationCurrencyId\x12B\n" +
+	"\x0fvalid_from_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rvalidFromDate\x12>\n" +
+	"\rvalid_to_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vvalidToDate\x12\x1b\n" +
+	"\tsource_id\x18\x06 \x01(\x05R\bsourceId\x12\x17\n" +
+	"\atype_id\x18\a \x01(\x05R\x06typeId\"S\n" +
+	"\x1aCreateExchangeRateResponse\x125\n" +
+	"\rexchange_rate\x18\x01 \x01(\v2\x10.pb.ExchangeRateR\fexchangeRateB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_create_exchange_rate_proto_rawDescOnce sync.Once
+	file_rpc_create_exchange_rate_proto_rawDescData []byte
+)
+
+func file_rpc_create_exchange_rate_proto_rawDescGZIP() []byte {
+	file_rpc_create_exchange_rate_proto_rawDescOnce.Do(func() {
+		file_rpc_create_exchange_rate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_create_exchange_rate_proto_rawDesc), len(file_rpc_create_exchange_rate_proto_rawDesc)))
+	})
+	return file_rpc_create_exchange_rate_proto_rawDescData
+}
+
+var file_rpc_create_exchange_rate_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_exchange_rate_proto_goTypes = []any{
+	(*CreateExchangeRateRequest)(nil),  // 0: pb.CreateExchangeRateRequest
+	(*CreateExchangeRateResponse)(nil), // 1: pb.CreateExchangeRateResponse
+	(*timestamppb.Timestamp)(nil),      // 2: google.protobuf.Timestamp
+	(*ExchangeRate)(nil),               // 3: pb.ExchangeRate
+}
+var file_rpc_create_exchange_rate_proto_depIdxs = []int32{
+	2, // 0: pb.CreateExchangeRateRequest.valid_from_date:type_name -> google.protobuf.Timestamp
+	2, // 1: pb.CreateExchangeRateRequest.valid_to_date:type_name -> google.protobuf.Timestamp
+	3, // 2: pb.CreateExchangeRateResponse.exchange_rate:type_name -> pb.ExchangeRate
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_exchange_rate_proto_init() }
+func file_rpc_create_exchange_rate_proto_init() {
+	if File_rpc_create_exchange_rate_proto != nil {
+		return
+	}
+	file_exchange_rate_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_create_exchange_rate_proto_rawDesc), len(file_rpc_create_exchange_rate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_exchange_rate_proto_goTypes,
+		DependencyIndexes: file_rpc_create_exchange_rate_proto_depIdxs,
+		MessageInfos:      file_rpc_create_exchange_rate_proto_msgTypes,
+	}.Build()
+	File_rpc_create_exchange_rate_proto = out.File
+	file_rpc_create_exchange_rate_proto_goTypes = nil
+	file_rpc_create_exchange_rate_proto_depIdxs = nil
+}
@@ -0,0 +1,234 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_create_payment.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// payment_status defaults to pending and payment_date to now.
+type CreatePaymentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId int32                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	TransactionId  *string                `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	Amount         string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	CurrencyCode   string                 `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	PaymentMethod  *string                `protobuf:"bytes,5,opt,name=payment_method,json=paymentMethod,proto3,oneof" json:"payment_method,omitempty"`
+	PaymentStatus  *string                `protobuf:"bytes,6,opt,name=payment_status,json=paymentStatus,proto3,oneof" json:"payment_status,omitempty"`
+	PaymentDate    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=payment_date,json=paymentDate,proto3" json:"payment_date,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePaymentRequest) Reset() {
+	*x = CreatePaymentRequest{}
+	mi := &file_rpc_create_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentRequest) ProtoMessage() {}
+
+func (x *CreatePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentRequest.ProtoReflect.Descriptor instead.
+func (*CreatePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatePaymentRequest) GetSubscriptionId() int32 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *CreatePaymentRequest) GetTransactionId() string {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequest) GetPaymentMethod() string {
+	if x != nil && x.PaymentMethod != nil {
+		return *x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequest) GetPaymentStatus() string {
+	if x != nil && x.PaymentStatus != nil {
+		return *x.PaymentStatus
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequest) GetPaymentDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaymentDate
+	}
+	return nil
+}
+
+type CreatePaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePaymentResponse) Reset() {
+	*x = CreatePaymentResponse{}
+	mi := &file_rpc_create_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentResponse) ProtoMessage() {}
+
+func (x *CreatePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentResponse.ProtoReflect.Descriptor instead.
+func (*CreatePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+var File_rpc_create_payment_proto protoreflect.FileDescriptor
+
+const file_rpc_create_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x18rpc_create_payment.proto\x12\x02pb\x1a\rpayment.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf8\x02\n" +
+	"\x14CreatePaymentRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x05R\x0esubscriptionId\x12*\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tH\x00R\rtransactionId\x88\x01\x01\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12#\n" +
+	"\rcurrency_code\x18\x04 \x01(\tR\fcurrencyCode\x12*\n" +
+	"\x0epayment_method\x18\x05 \x01(\tH\x01R\rpaymentMethod\x88\x01\x01\x12*\n" +
+	"\x0epayment_status\x18\x06 \x01(\tH\x02R\rpaymentStatus\x88\x01\x01\x12=\n" +
+	"\fpayment_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vpaymentDateB\x11\n" +
+	"\x0f_transaction_idB\x11\n" +
+	"\x0f_payment_methodB\x11\n" +
+	"\x0f_payment_status\">\n" +
+	"\x15CreatePaymentResponse\x12%\n" +
+	"\apayment\x18\x01 \x01(\v2\v.pb.PaymentR\apaymentB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_create_payment_proto_rawDescOnce sync.Once
+	file_rpc_create_payment_proto_rawDescData []byte
+)
+
+func file_rpc_create_payment_proto_rawDescGZIP() []byte {
+	file_rpc_create_payment_proto_rawDescOnce.Do(func() {
+		file_rpc_create_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_create_payment_proto_rawDesc), len(file_rpc_create_payment_proto_rawDesc)))
+	})
+	return file_rpc_create_payment_proto_rawDescData
+}
+
+var file_rpc_create_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_payment_proto_goTypes = []any{
+	(*CreatePaymentRequest)(nil),  // 0: pb.CreatePaymentRequest
+	(*CreatePaymentResponse)(nil), // 1: pb.CreatePaymentResponse
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*Payment)(nil),               // 3: pb.Payment
+}
+var file_rpc_create_payment_proto_depIdxs = []int32{
+	2, // 0: pb.CreatePaymentRequest.payment_date:type_name -> google.protobuf.Timestamp
+	3, // 1: pb.CreatePaymentResponse.payment:type_name -> pb.Payment
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_payment_proto_init() }
+func file_rpc_create_payment_proto_init() {
+	if File_rpc_create_payment_proto != nil {
+		return
+	}
+	file_payment_proto_init()
+	file_rpc_create_payment_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_create_payment_proto_rawDesc), len(file_rpc_create_payment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_payment_proto_goTypes,
+		DependencyIndexes: file_rpc_create_payment_proto_depIdxs,
+		MessageInfos:      file_rpc_create_payment_proto_msgTypes,
+	}.Build()
+	File_rpc_create_payment_proto = out.File
+	file_rpc_create_payment_proto_goTypes = nil
+	file_rpc_create_payment_proto_depIdxs = nil
+}
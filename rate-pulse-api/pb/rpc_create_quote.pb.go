@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_create_quote.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateQuoteRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Amount          string                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	FromCurrencyId  int32                  `protobuf:"varint,2,opt,name=from_currency_id,json=fromCurrencyId,proto3" json:"from_currency_id,omitempty"`
+	ToCurrencyId    int32                  `protobuf:"varint,3,opt,name=to_currency_id,json=toCurrencyId,proto3" json:"to_currency_id,omitempty"`
+	SourceId        int32                  `protobuf:"varint,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TypeId          int32                  `protobuf:"varint,5,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	TransactionType string                 `protobuf:"bytes,6,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	Channel         string                 `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	EffectiveDate   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateQuoteRequest) Reset() {
+	*x = CreateQuoteRequest{}
+	mi := &file_rpc_create_quote_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuoteRequest) ProtoMessage() {}
+
+func (x *CreateQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_quote_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_quote_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateQuoteRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreateQuoteRequest) GetFromCurrencyId() int32 {
+	if x != nil {
+		return x.FromCurrencyId
+	}
+	return 0
+}
+
+func (x *CreateQuoteRequest) GetToCurrencyId() int32 {
+	if x != nil {
+		return x.ToCurrencyId
+	}
+	return 0
+}
+
+func (x *CreateQuoteRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *CreateQuoteRequest) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *CreateQuoteRequest) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *CreateQuoteRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CreateQuoteRequest) GetEffectiveDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return nil
+}
+
+type CreateQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *Quote                 `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQuoteResponse) Reset() {
+	*x = CreateQuoteResponse{}
+	mi := &file_rpc_create_quote_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuoteResponse) ProtoMessage() {}
+
+func (x *CreateQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_quote_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuoteResponse.ProtoReflect.Descriptor instead.
+func (*CreateQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_quote_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateQuoteResponse) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+var File_rpc_create_quote_proto protoreflect.FileDescriptor
+
+const file_rpc_create_quote_proto_rawDesc = "" +
+	"\n" +
+	"\x16rpc_create_quote.proto\x12\x02pb\x1a\vquote.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xba\x02\n" +
+	"\x12CreateQuoteRequest\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\tR\x06amount\x12(\n" +
+	"\x10from_currency_id\x18\x02 \x01(\x05R\x0efromCurrencyId\x12$\n" +
+	"\x0eto_currency_id\x18\x03 \x01(\x05R\ftoCurrencyId\x12\x1b\n" +
+	"\tsource_id\x18\x04 \x01(\x05R\bsourceId\x12\x17\n" +
+	"\atype_id\x18\x05 \x01(\x05R\x06typeId\x12)\n" +
+	"\x10transaction_type\x18\x06 \x01(\tR\x0ftransactionType\x12\x18\n" +
+	"\achannel\x18\a \x01(\tR\achannel\x12A\n" +
+	"\x0eeffective_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveDate\"6\n" +
+	"\x13CreateQuoteResponse\x12\x1f\n" +
+	"\x05quote\x18\x01 \x01(\v2\t.pb.QuoteR\x05quoteB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_create_quote_proto_rawDescOnce sync.Once
+	file_rpc_create_quote_proto_rawDescData []byte
+)
+
+func file_rpc_create_quote_proto_rawDescGZIP() []byte {
+	file_rpc_create_quote_proto_rawDescOnce.Do(func() {
+		file_rpc_create_quote_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_create_quote_proto_rawDesc), len(file_rpc_create_quote_proto_rawDesc)))
+	})
+	return file_rpc_create_quote_proto_rawDescData
+}
+
+var file_rpc_create_quote_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_quote_proto_goTypes = []any{
+	(*CreateQuoteRequest)(nil),    // 0: pb.CreateQuoteRequest
+	(*CreateQuoteResponse)(nil),   // 1: pb.CreateQuoteResponse
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*Quote)(nil),                 // 3: pb.Quote
+}
+var file_rpc_create_quote_proto_depIdxs = []int32{
+	2, // 0: pb.CreateQuoteRequest.effective_date:type_name -> google.protobuf.Timestamp
+	3, // 1: pb.CreateQuoteResponse.quote:type_name -> pb.Quote
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_quote_proto_init() }
+func file_rpc_create_quote_proto_init() {
+	if File_rpc_create_quote_proto != nil {
+		return
+	}
+	file_quote_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_create_quote_proto_rawDesc), len(file_rpc_create_quote_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_quote_proto_goTypes,
+		DependencyIndexes: file_rpc_create_quote_proto_depIdxs,
+		MessageInfos:      file_rpc_create_quote_proto_msgTypes,
+	}.Build()
+	File_rpc_create_quote_proto = out.File
+	file_rpc_create_quote_proto_goTypes = nil
+	file_rpc_create_quote_proto_depIdxs = nil
+}
@@ -0,0 +1,240 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_create_rate_alert.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// condition is above or below (threshold required) or percent_change (change_percent required).
+type CreateRateAlertRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SourceId       int32                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TypeId         int32                  `protobuf:"varint,2,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	FromCurrencyId int32                  `protobuf:"varint,3,opt,name=from_currency_id,json=fromCurrencyId,proto3" json:"from_currency_id,omitempty"`
+	ToCurrencyId   int32                  `protobuf:"varint,4,opt,name=to_currency_id,json=toCurrencyId,proto3" json:"to_currency_id,omitempty"`
+	Condition      string                 `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
+	Threshold      *string                `protobuf:"bytes,6,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
+	ChangePercent  *string                `protobuf:"bytes,7,opt,name=change_percent,json=changePercent,proto3,oneof" json:"change_percent,omitempty"`
+	WindowHours    int32                  `protobuf:"varint,8,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateRateAlertRequest) Reset() {
+	*x = CreateRateAlertRequest{}
+	mi := &file_rpc_create_rate_alert_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRateAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRateAlertRequest) ProtoMessage() {}
+
+func (x *CreateRateAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_rate_alert_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRateAlertRequest.ProtoReflect.Descriptor instead.
+func (*CreateRateAlertRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_rate_alert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRateAlertRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *CreateRateAlertRequest) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *CreateRateAlertRequest) GetFromCurrencyId() int32 {
+	if x != nil {
+		return x.FromCurrencyId
+	}
+	return 0
+}
+
+func (x *CreateRateAlertRequest) GetToCurrencyId() int32 {
+	if x != nil {
+		return x.ToCurrencyId
+	}
+	return 0
+}
+
+func (x *CreateRateAlertRequest) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *CreateRateAlertRequest) GetThreshold() string {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return ""
+}
+
+func (x *CreateRateAlertRequest) GetChangePercent() string {
+	if x != nil && x.ChangePercent != nil {
+		return *x.ChangePercent
+	}
+	return ""
+}
+
+func (x *CreateRateAlertRequest) GetWindowHours() int32 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+type CreateRateAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alert         *RateAlert             `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRateAlertResponse) Reset() {
+	*x = CreateRateAlertResponse{}
+	mi := &file_rpc_create_rate_alert_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRateAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRateAlertResponse) ProtoMessage() {}
+
+func (x *CreateRateAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_rate_alert_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRateAlertResponse.ProtoReflect.Descriptor instead.
+func (*CreateRateAlertResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_rate_alert_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRateAlertResponse) GetAlert() *RateAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+var File_rpc_create_rate_alert_proto protoreflect.FileDescriptor
+
+const file_rpc_create_rate_alert_proto_rawDesc = "" +
+	"\n" +
+	"\x1brpc_create_rate_alert.proto\x12\x02pb\x1a\x10rate_alert.proto\"\xcf\x02\n" +
+	"\x16CreateRateAlertRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x05R\bsourceId\x12\x17\n" +
+	"\atype_id\x18\x02 \x01(\x05R\x06typeId\x12(\n" +
+	"\x10from_currency_id\x18\x03 \x01(\x05R\x0efromCurrencyId\x12$\n" +
+	"\x0eto_currency_id\x18\x04 \x01(\x05R\ftoCurrencyId\x12\x1c\n" +
+	"\tcondition\x18\x05 \x01(\tR\tcondition\x12!\n" +
+	"\tthreshold\x18\x06 \x01(\tH\x00R\tthreshold\x88\x01\x01\x12*\n" +
+	"\x0echange_percent\x18\a \x01(\tH\x01R\rchangePercent\x88\x01\x01\x12!\n" +
+	"\fwindow_hours\x18\b \x01(\x05R\vwindowHoursB\f\n" +
+	"\n" +
+	"_thresholdB\x11\n" +
+	"\x0f_change_percent\">\n" +
+	"\x17CreateRateAlertResponse\x12#\n" +
+	"\x05alert\x18\x01 \x01(\v2\r.pb.RateAlertR\x05alertB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_create_rate_alert_proto_rawDescOnce sync.Once
+	file_rpc_create_rate_alert_proto_rawDescData []byte
+)
+
+func file_rpc_create_rate_alert_proto_rawDescGZIP() []byte {
+	file_rpc_create_rate_alert_proto_rawDescOnce.Do(func() {
+		file_rpc_create_rate_alert_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_create_rate_alert_proto_rawDesc), len(file_rpc_create_rate_alert_proto_rawDesc)))
+	})
+	return file_rpc_create_rate_alert_proto_rawDescData
+}
+
+var file_rpc_create_rate_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_rate_alert_proto_goTypes = []any{
+	(*CreateRateAlertRequest)(nil),  // 0: pb.CreateRateAlertRequest
+	(*CreateRateAlertResponse)(nil), // 1: pb.CreateRateAlertResponse
+	(*RateAlert)(nil),               // 2: pb.RateAlert
+}
+var file_rpc_create_rate_alert_proto_depIdxs = []int32{
+	2, // 0: pb.CreateRateAlertResponse.alert:type_name -> pb.RateAlert
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_rate_alert_proto_init() }
+func file_rpc_create_rate_alert_proto_init() {
+	if File_rpc_create_rate_alert_proto != nil {
+		return
+	}
+	file_rate_alert_proto_init()
+	file_rpc_create_rate_alert_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_create_rate_alert_proto_rawDesc), len(file_rpc_create_rate_alert_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_rate_alert_proto_goTypes,
+		DependencyIndexes: file_rpc_create_rate_alert_proto_depIdxs,
+		MessageInfos:      file_rpc_create_rate_alert_proto_msgTypes,
+	}.Build()
+	File_rpc_create_rate_alert_proto = out.File
+	file_rpc_create_rate_alert_proto_goTypes = nil
+	file_rpc_create_rate_alert_proto_depIdxs = nil
+}
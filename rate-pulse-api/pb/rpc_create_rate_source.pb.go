@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_create_rate_source.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateRateSourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceName    string                 `protobuf:"bytes,1,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	SourceLink    string                 `protobuf:"bytes,2,opt,name=source_link,json=sourceLink,proto3" json:"source_link,omitempty"`
+	SourceCountry string                 `protobuf:"bytes,3,opt,name=source_country,json=sourceCountry,proto3" json:"source_country,omitempty"`
+	SourceStatus  string                 `protobuf:"bytes,4,opt,name=source_status,json=sourceStatus,proto3" json:"source_status,omitempty"`
+	SourceCode    string                 `protobuf:"bytes,5,opt,name=source_code,json=sourceCode,proto3" json:"source_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRateSourceRequest) Reset() {
+	*x = CreateRateSourceRequest{}
+	mi := &file_rpc_create_rate_source_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRateSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRateSourceRequest) ProtoMessage() {}
+
+func (x *CreateRateSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_rate_source_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRateSourceRequest.ProtoReflect.Descriptor instead.
+func (*CreateRateSourceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_rate_source_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRateSourceRequest) GetSourceName() string {
+	if x != nil {
+		return x.SourceName
+	}
+	return ""
+}
+
+func (x *CreateRateSourceRequest) GetSourceLink() string {
+	if x != nil {
+		return x.SourceLink
+	}
+	return ""
+}
+
+func (x *CreateRateSourceRequest) GetSourceCountry() string {
+	if x != nil {
+		return x.SourceCountry
+	}
+	return ""
+}
+
+func (x *CreateRateSourceRequest) GetSourceStatus() string {
+	if x != nil {
+		return x.SourceStatus
+	}
+	return ""
+}
+
+func (x *CreateRateSourceRequest) GetSourceCode() string {
+	if x != nil {
+		return x.SourceCode
+	}
+	return ""
+}
+
+type CreateRateSourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RateSource    *RateSource            `protobuf:"bytes,1,opt,name=rate_source,json=rateSource,proto3" json:"rate_source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRateSourceResponse) Reset() {
+	*x = CreateRateSourceResponse{}
+	mi := &file_rpc_create_rate_source_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRateSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRateSourceResponse) ProtoMessage() {}
+
+func (x *CreateRateSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_rate_source_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRateSourceResponse.ProtoReflect.Descriptor instead.
+func (*CreateRateSourceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_rate_source_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRateSourceResponse) GetRateSource() *RateSource {
+	if x != nil {
+		return x.RateSource
+	}
+	return nil
+}
+
+var File_rpc_create_rate_source_proto protoreflect.FileDescriptor
+
+const file_rpc_create_rate_source_proto_rawDesc = "" +
+	"\n" +
+	"\x1crpc_create_rate_source.proto\x12\x02pb\x1a\x11rate_source.proto\"\xc8\x01\n" +
+	"\x17CreateRateSourceRequest\x12\x1f\n" +
+	"\vsource_name\x18\x01 \x01(\tR\n" +
+	"sourceName\x12\x1f\n" +
+	"\vsource_link\x18\x02 \x01(\tR\n" +
+	"sourceLink\x12%\n" +
+	"\x0esource_country\x18\x03 \x01(\tR\rsourceCountry\x12#\n" +
+	"\rsource_status\x18\x04 \x01(\tR\fsourceStatus\x12\x1f\n" +
+	"\vsource_code\x18\x05 \x01(\tR\n" +
+	"sourceCode\"K\n" +
+	"\x18CreateRateSourceResponse\x12/\n" +
+	"\vrate_source\x18\x01 \x01(\v2\x0e.pb.RateSourceR\n" +
+	"rateSourceB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_create_rate_source_proto_rawDescOnce sync.Once
+	file_rpc_create_rate_source_proto_rawDescData []byte
+)
+
+func file_rpc_create_rate_source_proto_rawDescGZIP() []byte {
+	file_rpc_create_rate_source_proto_rawDescOnce.Do(func() {
+		file_rpc_create_rate_source_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_create_rate_source_proto_rawDesc), len(file_rpc_create_rate_source_proto_rawDesc)))
+	})
+	return file_rpc_create_rate_source_proto_rawDescData
+}
+
+var file_rpc_create_rate_source_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_rate_source_proto_goTypes = []any{
+	(*CreateRateSourceRequest)(nil),  // 0: pb.CreateRateSourceRequest
+	(*CreateRateSourceResponse)(nil), // 1: pb.CreateRateSourceResponse
+	(*RateSource)(nil),               // 2: pb.RateSource
+}
+var file_rpc_create_rate_source_proto_depIdxs = []int32{
+	2, // 0: pb.CreateRateSourceResponse.rate_source:type_name -> pb.RateSource
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_rate_source_proto_init() }
+func file_rpc_create_rate_source_proto_init() {
+	if File_rpc_create_rate_source_proto != nil {
+		return
+	}
+	file_rate_source_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_create_rate_source_proto_rawDesc), len(file_rpc_create_rate_source_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_rate_source_proto_goTypes,
+		DependencyIndexes: file_rpc_create_rate_source_proto_depIdxs,
+		MessageInfos:      file_rpc_create_rate_source_proto_msgTypes,
+	}.Build()
+	File_rpc_create_rate_source_proto = out.File
+	file_rpc_create_rate_source_proto_goTypes = nil
+	file_rpc_create_rate_source_proto_depIdxs = nil
+}
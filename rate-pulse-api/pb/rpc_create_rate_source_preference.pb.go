@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_create_rate_source_preference.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateRateSourcePreferenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      int32                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	IsPrimary     *bool                  `protobuf:"varint,2,opt,name=is_primary,json=isPrimary,proto3,oneof" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRateSourcePreferenceRequest) Reset() {
+	*x = CreateRateSourcePreferenceRequest{}
+	mi := &file_rpc_create_rate_source_preference_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRateSourcePreferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRateSourcePreferenceRequest) ProtoMessage() {}
+
+func (x *CreateRateSourcePreferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_rate_source_preference_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRateSourcePreferenceRequest.ProtoReflect.Descriptor instead.
+func (*CreateRateSourcePreferenceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_rate_source_preference_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRateSourcePreferenceRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *CreateRateSourcePreferenceRequest) GetIsPrimary() bool {
+	if x != nil && x.IsPrimary != nil {
+		return *x.IsPrimary
+	}
+	return false
+}
+
+type CreateRateSourcePreferenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preference    *RateSourcePreference  `protobuf:"bytes,1,opt,name=preference,proto3" json:"preference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRateSourcePreferenceResponse) Reset() {
+	*x = CreateRateSourcePreferenceResponse{}
+	mi := &file_rpc_create_rate_source_preference_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRateSourcePreferenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRateSourcePreferenceResponse) ProtoMessage() {}
+
+func (x *CreateRateSourcePreferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_rate_source_preference_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRateSourcePreferenceResponse.ProtoReflect.Descriptor instead.
+func (*CreateRateSourcePreferenceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_rate_source_preference_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRateSourcePreferenceResponse) GetPreference() *RateSourcePreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+var File_rpc_create_rate_source_preference_proto protoreflect.FileDescriptor
+
+const file_rpc_create_rate_source_preference_proto_rawDesc = "" +
+	"\n" +
+	"'rpc_create_rate_source_preference.proto\x12\x02pb\x1a\x1crate_source_preference.proto\"s\n" +
+	"!CreateRateSourcePreferenceRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x05R\bsourceId\x12\"\n" +
+	"\n" +
+	"is_primary\x18\x02 \x01(\bH\x00R\tisPrimary\x88\x01\x01B\r\n" +
+	"\v_is_primary\"^\n" +
+	"\"CreateRateSourcePreferenceResponse\x128\n" +
+	"\n" +
+	"preference\x18\x01 \x01(\v2\x18.pb.RateSourcePreferenceR\n" +
+	"preferenceB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_create_rate_source_preference_proto_rawDescOnce sync.Once
+	file_rpc_create_rate_source_preference_proto_rawDescData []byte
+)
+
+func file_rpc_create_rate_source_preference_proto_rawDescGZIP() []byte {
+	file_rpc_create_rate_source_preference_proto_rawDescOnce.Do(func() {
+		file_rpc_create_rate_source_preference_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_create_rate_source_preference_proto_rawDesc), len(file_rpc_create_rate_source_preference_proto_rawDesc)))
+	})
+	return file_rpc_create_rate_source_preference_proto_rawDescData
+}
+
+var file_rpc_create_rate_source_preference_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_rate_source_preference_proto_goTypes = []any{
+	(*CreateRateSourcePreferenceRequest)(nil),  // 0: pb.CreateRateSourcePreferenceRequest
+	(*CreateRateSourcePreferenceResponse)(nil), // 1: pb.CreateRateSourcePreferenceResponse
+	(*RateSourcePreference)(nil),               // 2: pb.RateSourcePreference
+}
+var file_rpc_create_rate_source_preference_proto_depIdxs = []int32{
+	2, // 0: pb.CreateRateSourcePreferenceResponse.preference:type_name -> pb.RateSourcePreference
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_rate_source_preference_proto_init() }
+func file_rpc_create_rate_source_preference_proto_init() {
+	if File_rpc_create_rate_source_preference_proto != nil {
+		return
+	}
+	file_rate_source_preference_proto_init()
+	file_rpc_create_rate_source_preference_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_create_rate_source_preference_proto_rawDesc), len(file_rpc_create_rate_source_preference_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_rate_source_preference_proto_goTypes,
+		DependencyIndexes: file_rpc_create_rate_source_preference_proto_depIdxs,
+		MessageInfos:      file_rpc_create_rate_source_preference_proto_msgTypes,
+	}.Build()
+	File_rpc_create_rate_source_preference_proto = out.File
+	file_rpc_create_rate_source_preference_proto_goTypes = nil
+	file_rpc_create_rate_source_preference_proto_depIdxs = nil
+}
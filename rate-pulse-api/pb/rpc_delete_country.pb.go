@@ -0,0 +1,161 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_delete_country.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeleteCountryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryId     int32                  `protobuf:"varint,1,opt,name=country_id,json=countryId,proto3" json:"country_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCountryRequest) Reset() {
+	*x = DeleteCountryRequest{}
+	mi := &file_rpc_delete_country_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCountryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCountryRequest) ProtoMessage() {}
+
+func (x *DeleteCountryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_country_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCountryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCountryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_country_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteCountryRequest) GetCountryId() int32 {
+	if x != nil {
+		return x.CountryId
+	}
+	return 0
+}
+
+type DeleteCountryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCountryResponse) Reset() {
+	*x = DeleteCountryResponse{}
+	mi := &file_rpc_delete_country_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCountryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCountryResponse) ProtoMessage() {}
+
+func (x *DeleteCountryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_country_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCountryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCountryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_country_proto_rawDescGZIP(), []int{1}
+}
+
+var File_rpc_delete_country_proto protoreflect.FileDescriptor
+
+const file_rpc_delete_country_proto_rawDesc = "" +
+	"\n" +
+	"\x18rpc_delete_country.proto\x12\x02pb\"5\n" +
+	"\x14DeleteCountryRequest\x12\x1d\n" +
+	"\n" +
+	"country_id\x18\x01 \x01(\x05R\tcountryId\"\x17\n" +
+	"\x15DeleteCountryResponseB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_delete_country_proto_rawDescOnce sync.Once
+	file_rpc_delete_country_proto_rawDescData []byte
+)
+
+func file_rpc_delete_country_proto_rawDescGZIP() []byte {
+	file_rpc_delete_country_proto_rawDescOnce.Do(func() {
+		file_rpc_delete_country_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_delete_country_proto_rawDesc), len(file_rpc_delete_country_proto_rawDesc)))
+	})
+	return file_rpc_delete_country_proto_rawDescData
+}
+
+var file_rpc_delete_country_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_delete_country_proto_goTypes = []any{
+	(*DeleteCountryRequest)(nil),  // 0: pb.DeleteCountryRequest
+	(*DeleteCountryResponse)(nil), // 1: pb.DeleteCountryResponse
+}
+var file_rpc_delete_country_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_delete_country_proto_init() }
+func file_rpc_delete_country_proto_init() {
+	if File_rpc_delete_country_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_delete_country_proto_rawDesc), len(file_rpc_delete_country_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_delete_country_proto_goTypes,
+		DependencyIndexes: file_rpc_delete_country_proto_depIdxs,
+		MessageInfos:      file_rpc_delete_country_proto_msgTypes,
+	}.Build()
+	File_rpc_delete_country_proto = out.File
+	file_rpc_delete_country_proto_goTypes = nil
+	file_rpc_delete_country_proto_depIdxs = nil
+}
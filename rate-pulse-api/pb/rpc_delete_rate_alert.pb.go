@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_delete_rate_alert.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeleteRateAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlertId       int32                  `protobuf:"varint,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRateAlertRequest) Reset() {
+	*x = DeleteRateAlertRequest{}
+	mi := &file_rpc_delete_rate_alert_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRateAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRateAlertRequest) ProtoMessage() {}
+
+func (x *DeleteRateAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_rate_alert_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRateAlertRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRateAlertRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_rate_alert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteRateAlertRequest) GetAlertId() int32 {
+	if x != nil {
+		return x.AlertId
+	}
+	return 0
+}
+
+type DeleteRateAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRateAlertResponse) Reset() {
+	*x = DeleteRateAlertResponse{}
+	mi := &file_rpc_delete_rate_alert_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRateAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRateAlertResponse) ProtoMessage() {}
+
+func (x *DeleteRateAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_rate_alert_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRateAlertResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRateAlertResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_rate_alert_proto_rawDescGZIP(), []int{1}
+}
+
+var File_rpc_delete_rate_alert_proto protoreflect.FileDescriptor
+
+const file_rpc_delete_rate_alert_proto_rawDesc = "" +
+	"\n" +
+	"\x1brpc_delete_rate_alert.proto\x12\x02pb\"3\n" +
+	"\x16DeleteRateAlertRequest\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\x05R\aalertId\"\x19\n" +
+	"\x17DeleteRateAlertResponseB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_delete_rate_alert_proto_rawDescOnce sync.Once
+	file_rpc_delete_rate_alert_proto_rawDescData []byte
+)
+
+func file_rpc_delete_rate_alert_proto_rawDescGZIP() []byte {
+	file_rpc_delete_rate_alert_proto_rawDescOnce.Do(func() {
+		file_rpc_delete_rate_alert_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_delete_rate_alert_proto_rawDesc), len(file_rpc_delete_rate_alert_proto_rawDesc)))
+	})
+	return file_rpc_delete_rate_alert_proto_rawDescData
+}
+
+var file_rpc_delete_rate_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_delete_rate_alert_proto_goTypes = []any{
+	(*DeleteRateAlertRequest)(nil),  // 0: pb.DeleteRateAlertRequest
+	(*DeleteRateAlertResponse)(nil), // 1: pb.DeleteRateAlertResponse
+}
+var file_rpc_delete_rate_alert_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_delete_rate_alert_proto_init() }
+func file_rpc_delete_rate_alert_proto_init() {
+	if File_rpc_delete_rate_alert_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_delete_rate_alert_proto_rawDesc), len(file_rpc_delete_rate_alert_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_delete_rate_alert_proto_goTypes,
+		DependencyIndexes: file_rpc_delete_rate_alert_proto_depIdxs,
+		MessageInfos:      file_rpc_delete_rate_alert_proto_msgTypes,
+	}.Build()
+	File_rpc_delete_rate_alert_proto = out.File
+	file_rpc_delete_rate_alert_proto_goTypes = nil
+	file_rpc_delete_rate_alert_proto_depIdxs = nil
+}
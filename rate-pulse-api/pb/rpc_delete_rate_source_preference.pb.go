@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_delete_rate_source_preference.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeleteRateSourcePreferenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      int32                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRateSourcePreferenceRequest) Reset() {
+	*x = DeleteRateSourcePreferenceRequest{}
+	mi := &file_rpc_delete_rate_source_preference_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRateSourcePreferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRateSourcePreferenceRequest) ProtoMessage() {}
+
+func (x *DeleteRateSourcePreferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_rate_source_preference_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRateSourcePreferenceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRateSourcePreferenceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_rate_source_preference_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteRateSourcePreferenceRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+type DeleteRateSourcePreferenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRateSourcePreferenceResponse) Reset() {
+	*x = DeleteRateSourcePreferenceResponse{}
+	mi := &file_rpc_delete_rate_source_preference_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRateSourcePreferenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRateSourcePreferenceResponse) ProtoMessage() {}
+
+func (x *DeleteRateSourcePreferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_rate_source_preference_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRateSourcePreferenceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRateSourcePreferenceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_rate_source_preference_proto_rawDescGZIP(), []int{1}
+}
+
+var File_rpc_delete_rate_source_preference_proto protoreflect.FileDescriptor
+
+const file_rpc_delete_rate_source_preference_proto_rawDesc = "" +
+	"\n" +
+	"'rpc_delete_rate_source_preference.proto\x12\x02pb\"@\n" +
+	"!DeleteRateSourcePreferenceRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x05R\bsourceId\"$\n" +
+	"\"DeleteRateSourcePreferenceResponseB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_delete_rate_source_preference_proto_rawDescOnce sync.Once
+	file_rpc_delete_rate_source_preference_proto_rawDescData []byte
+)
+
+func file_rpc_delete_rate_source_preference_proto_rawDescGZIP() []byte {
+	file_rpc_delete_rate_source_preference_proto_rawDescOnce.Do(func() {
+		file_rpc_delete_rate_source_preference_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_delete_rate_source_preference_proto_rawDesc), len(file_rpc_delete_rate_source_preference_proto_rawDesc)))
+	})
+	return file_rpc_delete_rate_source_preference_proto_rawDescData
+}
+
+var file_rpc_delete_rate_source_preference_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_delete_rate_source_preference_proto_goTypes = []any{
+	(*DeleteRateSourcePreferenceRequest)(nil),  // 0: pb.DeleteRateSourcePreferenceRequest
+	(*DeleteRateSourcePreferenceResponse)(nil), // 1: pb.DeleteRateSourcePreferenceResponse
+}
+var file_rpc_delete_rate_source_preference_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_delete_rate_source_preference_proto_init() }
+func file_rpc_delete_rate_source_preference_proto_init() {
+	if File_rpc_delete_rate_source_preference_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_delete_rate_source_preference_proto_rawDesc), len(file_rpc_delete_rate_source_preference_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_delete_rate_source_preference_proto_goTypes,
+		DependencyIndexes: file_rpc_delete_rate_source_preference_proto_depIdxs,
+		MessageInfos:      file_rpc_delete_rate_source_preference_proto_msgTypes,
+	}.Build()
+	File_rpc_delete_rate_source_preference_proto = out.File
+	file_rpc_delete_rate_source_preference_proto_goTypes = nil
+	file_rpc_delete_rate_source_preference_proto_depIdxs = nil
+}
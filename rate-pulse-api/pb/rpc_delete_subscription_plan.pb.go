@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_delete_subscription_plan.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeleteSubscriptionPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        int32                  `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubscriptionPlanRequest) Reset() {
+	*x = DeleteSubscriptionPlanRequest{}
+	mi := &file_rpc_delete_subscription_plan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubscriptionPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionPlanRequest) ProtoMessage() {}
+
+func (x *DeleteSubscriptionPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_subscription_plan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionPlanRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionPlanRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_subscription_plan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteSubscriptionPlanRequest) GetPlanId() int32 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+type DeleteSubscriptionPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubscriptionPlanResponse) Reset() {
+	*x = DeleteSubscriptionPlanResponse{}
+	mi := &file_rpc_delete_subscription_plan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubscriptionPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionPlanResponse) ProtoMessage() {}
+
+func (x *DeleteSubscriptionPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_subscription_plan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionPlanResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionPlanResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_subscription_plan_proto_rawDescGZIP(), []int{1}
+}
+
+var File_rpc_delete_subscription_plan_proto protoreflect.FileDescriptor
+
+const file_rpc_delete_subscription_plan_proto_rawDesc = "" +
+	"\n" +
+	"\"rpc_delete_subscription_plan.proto\x12\x02pb\"8\n" +
+	"\x1dDeleteSubscriptionPlanRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\x05R\x06planId\" \n" +
+	"\x1eDeleteSubscriptionPlanResponseB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_delete_subscription_plan_proto_rawDescOnce sync.Once
+	file_rpc_delete_subscription_plan_proto_rawDescData []byte
+)
+
+func file_rpc_delete_subscription_plan_proto_rawDescGZIP() []byte {
+	file_rpc_delete_subscription_plan_proto_rawDescOnce.Do(func() {
+		file_rpc_delete_subscription_plan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_delete_subscription_plan_proto_rawDesc), len(file_rpc_delete_subscription_plan_proto_rawDesc)))
+	})
+	return file_rpc_delete_subscription_plan_proto_rawDescData
+}
+
+var file_rpc_delete_subscription_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_delete_subscription_plan_proto_goTypes = []any{
+	(*DeleteSubscriptionPlanRequest)(nil),  // 0: pb.DeleteSubscriptionPlanRequest
+	(*DeleteSubscriptionPlanResponse)(nil), // 1: pb.DeleteSubscriptionPlanResponse
+}
+var file_rpc_delete_subscription_plan_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_delete_subscription_plan_proto_init() }
+func file_rpc_delete_subscription_plan_proto_init() {
+	if File_rpc_delete_subscription_plan_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_delete_subscription_plan_proto_rawDesc), len(file_rpc_delete_subscription_plan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_delete_subscription_plan_proto_goTypes,
+		DependencyIndexes: file_rpc_delete_subscription_plan_proto_depIdxs,
+		MessageInfos:      file_rpc_delete_subscription_plan_proto_msgTypes,
+	}.Build()
+	File_rpc_delete_subscription_plan_proto = out.File
+	file_rpc_delete_subscription_plan_proto_goTypes = nil
+	file_rpc_delete_subscription_plan_proto_depIdxs = nil
+}
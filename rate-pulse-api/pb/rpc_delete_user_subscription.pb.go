@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_delete_user_subscription.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeleteUserSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId int32                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteUserSubscriptionRequest) Reset() {
+	*x = DeleteUserSubscriptionRequest{}
+	mi := &file_rpc_delete_user_subscription_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteUserSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_user_subscription_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_user_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteUserSubscriptionRequest) GetSubscriptionId() int32 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+type DeleteUserSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserSubscriptionResponse) Reset() {
+	*x = DeleteUserSubscriptionResponse{}
+	mi := &file_rpc_delete_user_subscription_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteUserSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_user_subscription_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_user_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+var File_rpc_delete_user_subscription_proto protoreflect.FileDescriptor
+
+const file_rpc_delete_user_subscription_proto_rawDesc = "" +
+	"\n" +
+	"\"rpc_delete_user_subscription.proto\x12\x02pb\"H\n" +
+	"\x1dDeleteUserSubscriptionRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x05R\x0esubscriptionId\" \n" +
+	"\x1eDeleteUserSubscriptionResponseB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_delete_user_subscription_proto_rawDescOnce sync.Once
+	file_rpc_delete_user_subscription_proto_rawDescData []byte
+)
+
+func file_rpc_delete_user_subscription_proto_rawDescGZIP() []byte {
+	file_rpc_delete_user_subscription_proto_rawDescOnce.Do(func() {
+		file_rpc_delete_user_subscription_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_delete_user_subscription_proto_rawDesc), len(file_rpc_delete_user_subscription_proto_rawDesc)))
+	})
+	return file_rpc_delete_user_subscription_proto_rawDescData
+}
+
+var file_rpc_delete_user_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_delete_user_subscription_proto_goTypes = []any{
+	(*DeleteUserSubscriptionRequest)(nil),  // 0: pb.DeleteUserSubscriptionRequest
+	(*DeleteUserSubscriptionResponse)(nil), // 1: pb.DeleteUserSubscriptionResponse
+}
+var file_rpc_delete_user_subscription_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_delete_user_subscription_proto_init() }
+func file_rpc_delete_user_subscription_proto_init() {
+	if File_rpc_delete_user_subscription_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_delete_user_subscription_proto_rawDesc), len(file_rpc_delete_user_subscription_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_delete_user_subscription_proto_goTypes,
+		DependencyIndexes: file_rpc_delete_user_subscription_proto_depIdxs,
+		MessageInfos:      file_rpc_delete_user_subscription_proto_msgTypes,
+	}.Build()
+	File_rpc_delete_user_subscription_proto = out.File
+	file_rpc_delete_user_subscription_proto_goTypes = nil
+	file_rpc_delete_user_subscription_proto_depIdxs = nil
+}
@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_enroll_signin_totp.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EnrollSignInTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken      string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollSignInTOTPRequest) Reset() {
+	*x = EnrollSignInTOTPRequest{}
+	mi := &file_rpc_enroll_signin_totp_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollSignInTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollSignInTOTPRequest) ProtoMessage() {}
+
+func (x *EnrollSignInTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_enroll_signin_totp_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollSignInTOTPRequest.ProtoReflect.Descriptor instead.
+func (*EnrollSignInTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_enroll_signin_totp_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EnrollSignInTOTPRequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+type EnrollSignInTOTPResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Secret string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// otpauth:// URI to render as a QR code for the authenticator app.
+	ProvisioningUri string `protobuf:"bytes,2,opt,name=provisioning_uri,json=provisioningUri,proto3" json:"provisioning_uri,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EnrollSignInTOTPResponse) Reset() {
+	*x = EnrollSignInTOTPResponse{}
+	mi := &file_rpc_enroll_signin_totp_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollSignInTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollSignInTOTPResponse) ProtoMessage() {}
+
+func (x *EnrollSignInTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_enroll_signin_totp_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollSignInTOTPResponse.ProtoReflect.Descriptor instead.
+func (*EnrollSignInTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_enroll_signin_totp_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnrollSignInTOTPResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollSignInTOTPResponse) GetProvisioningUri() string {
+	if x != nil {
+		return x.ProvisioningUri
+	}
+	return ""
+}
+
+var File_rpc_enroll_signin_totp_proto protoreflect.FileDescriptor
+
+const file_rpc_enroll_signin_totp_proto_rawDesc = "" +
+	"\n" +
+	"\x1crpc_enroll_signin_totp.proto\x12\x02pb\"6\n" +
+	"\x17EnrollSignInTOTPRequest\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\"]\n" +
+	"\x18EnrollSignInTOTPResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12)\n" +
+	"\x10provisioning_uri\x18\x02 \x01(\tR\x0fprovisioningUriB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_enroll_signin_totp_proto_rawDescOnce sync.Once
+	file_rpc_enroll_signin_totp_proto_rawDescData []byte
+)
+
+func file_rpc_enroll_signin_totp_proto_rawDescGZIP() []byte {
+	file_rpc_enroll_signin_totp_proto_rawDescOnce.Do(func() {
+		file_rpc_enroll_signin_totp_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_enroll_signin_totp_proto_rawDesc), len(file_rpc_enroll_signin_totp_proto_rawDesc)))
+	})
+	return file_rpc_enroll_signin_totp_proto_rawDescData
+}
+
+var file_rpc_enroll_signin_totp_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_enroll_signin_totp_proto_goTypes = []any{
+	(*EnrollSignInTOTPRequest)(nil),  // 0: pb.EnrollSignInTOTPRequest
+	(*EnrollSignInTOTPResponse)(nil), // 1: pb.EnrollSignInTOTPResponse
+}
+var file_rpc_enroll_signin_totp_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_enroll_signin_totp_proto_init() }
+func file_rpc_enroll_signin_totp_proto_init() {
+	if File_rpc_enroll_signin_totp_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_enroll_signin_totp_proto_rawDesc), len(file_rpc_enroll_signin_totp_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_enroll_signin_totp_proto_goTypes,
+		DependencyIndexes: file_rpc_enroll_signin_totp_proto_depIdxs,
+		MessageInfos:      file_rpc_enroll_signin_totp_proto_msgTypes,
+	}.Build()
+	File_rpc_enroll_signin_totp_proto = out.File
+	file_rpc_enroll_signin_totp_proto_goTypes = nil
+	file_rpc_enroll_signin_totp_proto_depIdxs = nil
+}
@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_get_active_rate_source_fee_rule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// effective_date defaults to now.
+type GetActiveRateSourceFeeRuleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SourceId        int32                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TypeId          int32                  `protobuf:"varint,2,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	TransactionType string                 `protobuf:"bytes,3,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	Channel         string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	EffectiveDate   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetActiveRateSourceFeeRuleRequest) Reset() {
+	*x = GetActiveRateSourceFeeRuleRequest{}
+	mi := &file_rpc_get_active_rate_source_fee_rule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveRateSourceFeeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveRateSourceFeeRuleRequest) ProtoMessage() {}
+
+func (x *GetActiveRateSourceFeeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_active_rate_source_fee_rule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveRateSourceFeeRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetActiveRateSourceFeeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_active_rate_source_fee_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetActiveRateSourceFeeRuleRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *GetActiveRateSourceFeeRuleRequest) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *GetActiveRateSourceFeeRuleRequest) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *GetActiveRateSourceFeeRuleRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *GetActiveRateSourceFeeRuleRequest) GetEffectiveDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return nil
+}
+
+type GetActiveRateSourceFeeRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeeRule       *RateSourceFeeRule     `protobuf:"bytes,1,opt,name=fee_rule,json=feeRule,proto3" json:"fee_rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveRateSourceFeeRuleResponse) Reset() {
+	*x = GetActiveRateSourceFeeRuleResponse{}
+	mi := &file_rpc_get_active_rate_source_fee_rule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveRateSourceFeeRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveRateSourceFeeRuleResponse) ProtoMessage() {}
+
+func (x *GetActiveRateSourceFeeRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_active_rate_source_fee_rule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveRateSourceFeeRuleResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveRateSourceFeeRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_active_rate_source_fee_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetActiveRateSourceFeeRuleResponse) GetFeeRule() *RateSourceFeeRule {
+	if x != nil {
+		return x.FeeRule
+	}
+	return nil
+}
+
+var File_rpc_get_active_rate_source_fee_rule_proto protoreflect.FileDescriptor
+
+const file_rpc_get_active_rate_source_fee_rule_proto_rawDesc = "" +
+	"\n" +
+	")rpc_get_active_rate_source_fee_rule.proto\x12\x02pb\x1a\x1arate_source_fee_rule.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe1\x01\n" +
+	"!GetActiveRateSourceFeeRuleRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x05R\bsourceId\x12\x17\n" +
+	"\atype_id\x18\x02 \x01(\x05R\x06typeId\x12)\n" +
+	"\x10transaction_type\x18\x03 \x01(\tR\x0ftransactionType\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x12A\n" +
+	"\x0eeffective_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveDate\"V\n" +
+	"\"GetActiveRateSourceFeeRuleResponse\x120\n" +
+	"\bfee_rule\x18\x01 \x01(\v2\x15.pb.RateSourceFeeRuleR\afeeRuleB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_get_active_rate_source_fee_rule_proto_rawDescOnce sync.Once
+	file_rpc_get_active_rate_source_fee_rule_proto_rawDescData []byte
+)
+
+func file_rpc_get_active_rate_source_fee_rule_proto_rawDescGZIP() []byte {
+	file_rpc_get_active_rate_source_fee_rule_proto_rawDescOnce.Do(func() {
+		file_rpc_get_active_rate_source_fee_rule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_active_rate_source_fee_rule_proto_rawDesc), len(file_rpc_get_active_rate_source_fee_rule_proto_rawDesc)))
+	})
+	return file_rpc_get_active_rate_source_fee_rule_proto_rawDescData
+}
+
+var file_rpc_get_active_rate_source_fee_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_active_rate_source_fee_rule_proto_goTypes = []any{
+	(*GetActiveRateSourceFeeRuleRequest)(nil),  // 0: pb.GetActiveRateSourceFeeRuleRequest
+	(*GetActiveRateSourceFeeRuleResponse)(nil), // 1: pb.GetActiveRateSourceFeeRuleResponse
+	(*timestamppb.Timestamp)(nil),              // 2: google.protobuf.Timestamp
+	(*RateSourceFeeRule)(nil),                  // 3: pb.RateSourceFeeRule
+}
+var file_rpc_get_active_rate_source_fee_rule_proto_depIdxs = []int32{
+	2, // 0: pb.GetActiveRateSourceFeeRuleRequest.effective_date:type_name -> google.protobuf.Timestamp
+	3, // 1: pb.GetActiveRateSourceFeeRuleResponse.fee_rule:type_name -> pb.RateSourceFeeRule
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_active_rate_source_fee_rule_proto_init() }
+func file_rpc_get_active_rate_source_fee_rule_proto_init() {
+	if File_rpc_get_active_rate_source_fee_rule_proto != nil {
+		return
+	}
+	file_rate_source_fee_rule_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_active_rate_source_fee_rule_proto_rawDesc), len(file_rpc_get_active_rate_source_fee_rule_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_active_rate_source_fee_rule_proto_goTypes,
+		DependencyIndexes: file_rpc_get_active_rate_source_fee_rule_proto_depIdxs,
+		MessageInfos:      file_rpc_get_active_rate_source_fee_rule_proto_msgTypes,
+	}.Build()
+	File_rpc_get_active_rate_source_fee_rule_proto = out.File
+	file_rpc_get_active_rate_source_fee_rule_proto_goTypes = nil
+	file_rpc_get_active_rate_source_fee_rule_proto_depIdxs = nil
+}
@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_get_active_user_subscription.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetActiveUserSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveUserSubscriptionRequest) Reset() {
+	*x = GetActiveUserSubscriptionRequest{}
+	mi := &file_rpc_get_active_user_subscription_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveUserSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveUserSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetActiveUserSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_active_user_subscription_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveUserSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetActiveUserSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_active_user_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+type GetActiveUserSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *UserSubscription      `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveUserSubscriptionResponse) Reset() {
+	*x = GetActiveUserSubscriptionResponse{}
+	mi := &file_rpc_get_active_user_subscription_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveUserSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveUserSubscriptionResponse) ProtoMessage() {}
+
+func (x *GetActiveUserSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_active_user_subscription_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveUserSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveUserSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_active_user_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetActiveUserSubscriptionResponse) GetSubscription() *UserSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+var File_rpc_get_active_user_subscription_proto protoreflect.FileDescriptor
+
+const file_rpc_get_active_user_subscription_proto_rawDesc = "" +
+	"\n" +
+	"&rpc_get_active_user_subscription.proto\x12\x02pb\x1a\x17user_subscription.proto\"\"\n" +
+	" GetActiveUserSubscriptionRequest\"]\n" +
+	"!GetActiveUserSubscriptionResponse\x128\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x14.pb.UserSubscriptionR\fsubscriptionB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_get_active_user_subscription_proto_rawDescOnce sync.Once
+	file_rpc_get_active_user_subscription_proto_rawDescData []byte
+)
+
+func file_rpc_get_active_user_subscription_proto_rawDescGZIP() []byte {
+	file_rpc_get_active_user_subscription_proto_rawDescOnce.Do(func() {
+		file_rpc_get_active_user_subscription_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_active_user_subscription_proto_rawDesc), len(file_rpc_get_active_user_subscription_proto_rawDesc)))
+	})
+	return file_rpc_get_active_user_subscription_proto_rawDescData
+}
+
+var file_rpc_get_active_user_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_active_user_subscription_proto_goTypes = []any{
+	(*GetActiveUserSubscriptionRequest)(nil),  // 0: pb.GetActiveUserSubscriptionRequest
+	(*GetActiveUserSubscriptionResponse)(nil), // 1: pb.GetActiveUserSubscriptionResponse
+	(*UserSubscription)(nil),                  // 2: pb.UserSubscription
+}
+var file_rpc_get_active_user_subscription_proto_depIdxs = []int32{
+	2, // 0: pb.GetActiveUserSubscriptionResponse.subscription:type_name -> pb.UserSubscription
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_active_user_subscription_proto_init() }
+func file_rpc_get_active_user_subscription_proto_init() {
+	if File_rpc_get_active_user_subscription_proto != nil {
+		return
+	}
+	file_user_subscription_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_active_user_subscription_proto_rawDesc), len(file_rpc_get_active_user_subscription_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_active_user_subscription_proto_goTypes,
+		DependencyIndexes: file_rpc_get_active_user_subscription_proto_depIdxs,
+		MessageInfos:      file_rpc_get_active_user_subscription_proto_msgTypes,
+	}.Build()
+	File_rpc_get_active_user_subscription_proto = out.File
+	file_rpc_get_active_user_subscription_proto_goTypes = nil
+	file_rpc_get_active_user_subscription_proto_depIdxs = nil
+}
@@ -0,0 +1,229 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_get_candles.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// interval is one of 1h, 1d, 1w or 1M.
+type GetCandlesRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	SourceCurrencyId      int32                  `protobuf:"varint,1,opt,name=source_currency_id,json=sourceCurrencyId,proto3" json:"source_currency_id,omitempty"`
+	DestinationCurrencyId int32                  `protobuf:"varint,2,opt,name=destination_currency_id,json=destinationCurrencyId,proto3" json:"destination_currency_id,omitempty"`
+	SourceId              int32                  `protobuf:"varint,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TypeId                int32                  `protobuf:"varint,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	Interval              string                 `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	TimeRange             string                 `protobuf:"bytes,6,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Limit                 int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetCandlesRequest) Reset() {
+	*x = GetCandlesRequest{}
+	mi := &file_rpc_get_candles_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCandlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCandlesRequest) ProtoMessage() {}
+
+func (x *GetCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_candles_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCandlesRequest.ProtoReflect.Descriptor instead.
+func (*GetCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_candles_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetCandlesRequest) GetSourceCurrencyId() int32 {
+	if x != nil {
+		return x.SourceCurrencyId
+	}
+	return 0
+}
+
+func (x *GetCandlesRequest) GetDestinationCurrencyId() int32 {
+	if x != nil {
+		return x.DestinationCurrencyId
+	}
+	return 0
+}
+
+func (x *GetCandlesRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *GetCandlesRequest) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *GetCandlesRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetCandlesRequest) GetTimeRange() string {
+	if x != nil {
+		return x.TimeRange
+	}
+	return ""
+}
+
+func (x *GetCandlesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetCandlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candles       []*Candle              `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCandlesResponse) Reset() {
+	*x = GetCandlesResponse{}
+	mi := &file_rpc_get_candles_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCandlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCandlesResponse) ProtoMessage() {}
+
+func (x *GetCandlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_candles_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCandlesResponse.ProtoReflect.Descriptor instead.
+func (*GetCandlesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_candles_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCandlesResponse) GetCandles() []*Candle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+var File_rpc_get_candles_proto protoreflect.FileDescriptor
+
+const file_rpc_get_candles_proto_rawDesc = "" +
+	"\n" +
+	"\x15rpc_get_candles.proto\x12\x02pb\x1a\x13exchange_rate.proto\"\x80\x02\n" +
+	"\x11GetCandlesRequest\x12,\n" +
+	"\x12source_currency_id\x18\x01 \x01(\x05R\x10sourceCurrencyId\x126\n" +
+	"\x17destination_currency_id\x18\x02 \x01(\x05R\x15destinationCurrencyId\x12\x1b\n" +
+	"\tsource_id\x18\x03 \x01(\x05R\bsourceId\x12\x17\n" +
+	"\atype_id\x18\x04 \x01(\x05R\x06typeId\x12\x1a\n" +
+	"\binterval\x18\x05 \x01(\tR\binterval\x12\x1d\n" +
+	"\n" +
+	"time_range\x18\x06 \x01(\tR\ttimeRange\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\":\n" +
+	"\x12GetCandlesResponse\x12$\n" +
+	"\acandles\x18\x01 \x03(\v2\n" +
+	".pb.CandleR\acandlesB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_get_candles_proto_rawDescOnce sync.Once
+	file_rpc_get_candles_proto_rawDescData []byte
+)
+
+func file_rpc_get_candles_proto_rawDescGZIP() []byte {
+	file_rpc_get_candles_proto_rawDescOnce.Do(func() {
+		file_rpc_get_candles_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_candles_proto_rawDesc), len(file_rpc_get_candles_proto_rawDesc)))
+	})
+	return file_rpc_get_candles_proto_rawDescData
+}
+
+var file_rpc_get_candles_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_candles_proto_goTypes = []any{
+	(*GetCandlesRequest)(nil),  // 0: pb.GetCandlesRequest
+	(*GetCandlesResponse)(nil), // 1: pb.GetCandlesResponse
+	(*Candle)(nil),             // 2: pb.Candle
+}
+var file_rpc_get_candles_proto_depIdxs = []int32{
+	2, // 0: pb.GetCandlesResponse.candles:type_name -> pb.Candle
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_candles_proto_init() }
+func file_rpc_get_candles_proto_init() {
+	if File_rpc_get_candles_proto != nil {
+		return
+	}
+	file_exchange_rate_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_candles_proto_rawDesc), len(file_rpc_get_candles_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_candles_proto_goTypes,
+		DependencyIndexes: file_rpc_get_candles_proto_depIdxs,
+		MessageInfos:      file_rpc_get_candles_proto_msgTypes,
+	}.Build()
+	File_rpc_get_candles_proto = out.File
+	file_rpc_get_candles_proto_goTypes = nil
+	file_rpc_get_candles_proto_depIdxs = nil
+}
@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_get_country_by_code.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetCountryByCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCountryByCodeRequest) Reset() {
+	*x = GetCountryByCodeRequest{}
+	mi := &file_rpc_get_country_by_code_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCountryByCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCountryByCodeRequest) ProtoMessage() {}
+
+func (x *GetCountryByCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_country_by_code_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCountryByCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetCountryByCodeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_country_by_code_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetCountryByCodeRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+type GetCountryByCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Country       *Country               `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCountryByCodeResponse) Reset() {
+	*x = GetCountryByCodeResponse{}
+	mi := &file_rpc_get_country_by_code_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCountryByCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCountryByCodeResponse) ProtoMessage() {}
+
+func (x *GetCountryByCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_country_by_code_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCountryByCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetCountryByCodeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_country_by_code_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCountryByCodeResponse) GetCountry() *Country {
+	if x != nil {
+		return x.Country
+	}
+	return nil
+}
+
+var File_rpc_get_country_by_code_proto protoreflect.FileDescriptor
+
+const file_rpc_get_country_by_code_proto_rawDesc = "" +
+	"\n" +
+	"\x1drpc_get_country_by_code.proto\x12\x02pb\x1a\rcountry.proto\"<\n" +
+	"\x17GetCountryByCodeRequest\x12!\n" +
+	"\fcountry_code\x18\x01 \x01(\tR\vcountryCode\"A\n" +
+	"\x18GetCountryByCodeResponse\x12%\n" +
+	"\acountry\x18\x01 \x01(\v2\v.pb.CountryR\acountryB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_get_country_by_code_proto_rawDescOnce sync.Once
+	file_rpc_get_country_by_code_proto_rawDescData []byte
+)
+
+func file_rpc_get_country_by_code_proto_rawDescGZIP() []byte {
+	file_rpc_get_country_by_code_proto_rawDescOnce.Do(func() {
+		file_rpc_get_country_by_code_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_country_by_code_proto_rawDesc), len(file_rpc_get_country_by_code_proto_rawDesc)))
+	})
+	return file_rpc_get_country_by_code_proto_rawDescData
+}
+
+var file_rpc_get_country_by_code_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_country_by_code_proto_goTypes = []any{
+	(*GetCountryByCodeRequest)(nil),  // 0: pb.GetCountryByCodeRequest
+	(*GetCountryByCodeResponse)(nil), // 1: pb.GetCountryByCodeResponse
+	(*Country)(nil),                  // 2: pb.Country
+}
+var file_rpc_get_country_by_code_proto_depIdxs = []int32{
+	2, // 0: pb.GetCountryByCodeResponse.country:type_name -> pb.Country
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_country_by_code_proto_init() }
+func file_rpc_get_country_by_code_proto_init() {
+	if File_rpc_get_country_by_code_proto != nil {
+		return
+	}
+	file_country_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_country_by_code_proto_rawDesc), len(file_rpc_get_country_by_code_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_country_by_code_proto_goTypes,
+		DependencyIndexes: file_rpc_get_country_by_code_proto_depIdxs,
+		MessageInfos:      file_rpc_get_country_by_code_proto_msgTypes,
+	}.Build()
+	File_rpc_get_country_by_code_proto = out.File
+	file_rpc_get_country_by_code_proto_goTypes = nil
+	file_rpc_get_country_by_code_proto_depIdxs = nil
+}
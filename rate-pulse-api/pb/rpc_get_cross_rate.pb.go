@@ -0,0 +1,378 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_get_cross_rate.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetCrossRateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FromCurrencyId  int32                  `protobuf:"varint,1,opt,name=from_currency_id,json=fromCurrencyId,proto3" json:"from_currency_id,omitempty"`
+	ToCurrencyId    int32                  `protobuf:"varint,2,opt,name=to_currency_id,json=toCurrencyId,proto3" json:"to_currency_id,omitempty"`
+	SourceId        int32                  `protobuf:"varint,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TypeId          int32                  `protobuf:"varint,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	PivotCurrencyId *int32                 `protobuf:"varint,5,opt,name=pivot_currency_id,json=pivotCurrencyId,proto3,oneof" json:"pivot_currency_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCrossRateRequest) Reset() {
+	*x = GetCrossRateRequest{}
+	mi := &file_rpc_get_cross_rate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCrossRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCrossRateRequest) ProtoMessage() {}
+
+func (x *GetCrossRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_cross_rate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCrossRateRequest.ProtoReflect.Descriptor instead.
+func (*GetCrossRateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_cross_rate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetCrossRateRequest) GetFromCurrencyId() int32 {
+	if x != nil {
+		return x.FromCurrencyId
+	}
+	return 0
+}
+
+func (x *GetCrossRateRequest) GetToCurrencyId() int32 {
+	if x != nil {
+		return x.ToCurrencyId
+	}
+	return 0
+}
+
+func (x *GetCrossRateRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *GetCrossRateRequest) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *GetCrossRateRequest) GetPivotCurrencyId() int32 {
+	if x != nil && x.PivotCurrencyId != nil {
+		return *x.PivotCurrencyId
+	}
+	return 0
+}
+
+// CrossRateLeg is one stored rate on the path, expressed as to_currency units per 1 from_currency.
+type CrossRateLeg struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RateId         int32                  `protobuf:"varint,1,opt,name=rate_id,json=rateId,proto3" json:"rate_id,omitempty"`
+	FromCurrencyId int32                  `protobuf:"varint,2,opt,name=from_currency_id,json=fromCurrencyId,proto3" json:"from_currency_id,omitempty"`
+	ToCurrencyId   int32                  `protobuf:"varint,3,opt,name=to_currency_id,json=toCurrencyId,proto3" json:"to_currency_id,omitempty"`
+	Rate           string                 `protobuf:"bytes,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	Inverted       bool                   `protobuf:"varint,5,opt,name=inverted,proto3" json:"inverted,omitempty"`
+	RateUpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=rate_updated_at,json=rateUpdatedAt,proto3" json:"rate_updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CrossRateLeg) Reset() {
+	*x = CrossRateLeg{}
+	mi := &file_rpc_get_cross_rate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrossRateLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossRateLeg) ProtoMessage() {}
+
+func (x *CrossRateLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_cross_rate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrossRateLeg.ProtoReflect.Descriptor instead.
+func (*CrossRateLeg) Descriptor() ([]byte, []int) {
+	return file_rpc_get_cross_rate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CrossRateLeg) GetRateId() int32 {
+	if x != nil {
+		return x.RateId
+	}
+	return 0
+}
+
+func (x *CrossRateLeg) GetFromCurrencyId() int32 {
+	if x != nil {
+		return x.FromCurrencyId
+	}
+	return 0
+}
+
+func (x *CrossRateLeg) GetToCurrencyId() int32 {
+	if x != nil {
+		return x.ToCurrencyId
+	}
+	return 0
+}
+
+func (x *CrossRateLeg) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *CrossRateLeg) GetInverted() bool {
+	if x != nil {
+		return x.Inverted
+	}
+	return false
+}
+
+func (x *CrossRateLeg) GetRateUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RateUpdatedAt
+	}
+	return nil
+}
+
+type GetCrossRateResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FromCurrencyId  int32                  `protobuf:"varint,1,opt,name=from_currency_id,json=fromCurrencyId,proto3" json:"from_currency_id,omitempty"`
+	ToCurrencyId    int32                  `protobuf:"varint,2,opt,name=to_currency_id,json=toCurrencyId,proto3" json:"to_currency_id,omitempty"`
+	SourceId        int32                  `protobuf:"varint,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TypeId          int32                  `protobuf:"varint,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	Rate            string                 `protobuf:"bytes,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	Direct          bool                   `protobuf:"varint,6,opt,name=direct,proto3" json:"direct,omitempty"`
+	PivotCurrencyId *int32                 `protobuf:"varint,7,opt,name=pivot_currency_id,json=pivotCurrencyId,proto3,oneof" json:"pivot_currency_id,omitempty"`
+	Path            []int32                `protobuf:"varint,8,rep,packed,name=path,proto3" json:"path,omitempty"`
+	Legs            []*CrossRateLeg        `protobuf:"bytes,9,rep,name=legs,proto3" json:"legs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCrossRateResponse) Reset() {
+	*x = GetCrossRateResponse{}
+	mi := &file_rpc_get_cross_rate_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCrossRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCrossRateResponse) ProtoMessage() {}
+
+func (x *GetCrossRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_cross_rate_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCrossRateResponse.ProtoReflect.Descriptor instead.
+func (*GetCrossRateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_cross_rate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetCrossRateResponse) GetFromCurrencyId() int32 {
+	if x != nil {
+		return x.FromCurrencyId
+	}
+	return 0
+}
+
+func (x *GetCrossRateResponse) GetToCurrencyId() int32 {
+	if x != nil {
+		return x.ToCurrencyId
+	}
+	return 0
+}
+
+func (x *GetCrossRateResponse) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *GetCrossRateResponse) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *GetCrossRateResponse) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *GetCrossRateResponse) GetDirect() bool {
+	if x != nil {
+		return x.Direct
+	}
+	return false
+}
+
+func (x *GetCrossRateResponse) GetPivotCurrencyId() int32 {
+	if x != nil && x.PivotCurrencyId != nil {
+		return *x.PivotCurrencyId
+	}
+	return 0
+}
+
+func (x *GetCrossRateResponse) GetPath() []int32 {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *GetCrossRateResponse) GetLegs() []*CrossRateLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+var File_rpc_get_cross_rate_proto protoreflect.FileDescriptor
+
+const file_rpc_get_cross_rate_proto_rawDesc = "" +
+	"\n" +
+	"\x18rpc_get_cross_rate.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe2\x01\n" +
+	"\x13GetCrossRateRequest\x12(\n" +
+	"\x10from_currency_id\x18\x01 \x01(\x05R\x0efromCurrencyId\x12$\n" +
+	"\x0eto_currency_id\x18\x02 \x01(\x05R\ftoCurrencyId\x12\x1b\n" +
+	"\tsource_id\x18\x03 \x01(\x05R\bsourceId\x12\x17\n" +
+	"\atype_id\x18\x04 \x01(\x05R\x06typeId\x12/\n" +
+	"\x11pivot_currency_id\x18\x05 \x01(\x05H\x00R\x0fpivotCurrencyId\x88\x01\x01B\x14\n" +
+	"\x12_pivot_currency_id\"\xeb\x01\n" +
+	"\fCrossRateLeg\x12\x17\n" +
+	"\arate_id\x18\x01 \x01(\x05R\x06rateId\x12(\n" +
+	"\x10from_currency_id\x18\x02 \x01(\x05R\x0efromCurrencyId\x12$\n" +
+	"\x0eto_currency_id\x18\x03 \x01(\x05R\ftoCurrencyId\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\tR\x04rate\x12\x1a\n" +
+	"\binverted\x18\x05 \x01(\bR\binverted\x12B\n" +
+	"\x0frate_updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rrateUpdatedAt\"\xc9\x02\n" +
+	"\x14GetCrossRateResponse\x12(\n" +
+	"\x10from_currency_id\x18\x01 \x01(\x05R\x0efromCurrencyId\x12$\n" +
+	"\x0eto_currency_id\x18\x02 \x01(\x05R\ftoCurrencyId\x12\x1b\n" +
+	"\tsource_id\x18\x03 \x01(\x05R\bsourceId\x12\x17\n" +
+	"\atype_id\x18\x04 \x01(\x05R\x06typeId\x12\x12\n" +
+	"\x04rate\x18\x05 \x01(\tR\x04rate\x12\x16\n" +
+	"\x06direct\x18\x06 \x01(\bR\x06direct\x12/\n" +
+	"\x11pivot_currency_id\x18\a \x01(\x05H\x00R\x0fpivotCurrencyId\x88\x01\x01\x12\x12\n" +
+	"\x04path\x18\b \x03(\x05R\x04path\x12$\n" +
+	"\x04legs\x18\t \x03(\v2\x10.pb.CrossRateLegR\x04legsB\x14\n" +
+	"\x12_pivot_currency_idB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_get_cross_rate_proto_rawDescOnce sync.Once
+	file_rpc_get_cross_rate_proto_rawDescData []byte
+)
+
+func file_rpc_get_cross_rate_proto_rawDescGZIP() []byte {
+	file_rpc_get_cross_rate_proto_rawDescOnce.Do(func() {
+		file_rpc_get_cross_rate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_cross_rate_proto_rawDesc), len(file_rpc_get_cross_rate_proto_rawDesc)))
+	})
+	return file_rpc_get_cross_rate_proto_rawDescData
+}
+
+var file_rpc_get_cross_rate_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_rpc_get_cross_rate_proto_goTypes = []any{
+	(*GetCrossRateRequest)(nil),   // 0: pb.GetCrossRateRequest
+	(*CrossRateLeg)(nil),          // 1: pb.CrossRateLeg
+	(*GetCrossRateResponse)(nil),  // 2: pb.GetCrossRateResponse
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_rpc_get_cross_rate_proto_depIdxs = []int32{
+	3, // 0: pb.CrossRateLeg.rate_updated_at:type_name -> google.protobuf.Timestamp
+	1, // 1: pb.GetCrossRateResponse.legs:type_name -> pb.CrossRateLeg
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_cross_rate_proto_init() }
+func file_rpc_get_cross_rate_proto_init() {
+	if File_rpc_get_cross_rate_proto != nil {
+		return
+	}
+	file_rpc_get_cross_rate_proto_msgTypes[0].OneofWrappers = []any{}
+	file_rpc_get_cross_rate_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_cross_rate_proto_rawDesc), len(file_rpc_get_cross_rate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_cross_rate_proto_goTypes,
+		DependencyIndexes: file_rpc_get_cross_rate_proto_depIdxs,
+		MessageInfos:      file_rpc_get_cross_rate_proto_msgTypes,
+	}.Build()
+	File_rpc_get_cross_rate_proto = out.File
+	file_rpc_get_cross_rate_proto_goTypes = nil
+	file_rpc_get_cross_rate_proto_depIdxs = nil
+}
@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_get_currency.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetCurrencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyId    int32                  `protobuf:"varint,1,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyRequest) Reset() {
+	*x = GetCurrencyRequest{}
+	mi := &file_rpc_get_currency_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyRequest) ProtoMessage() {}
+
+func (x *GetCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_currency_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_currency_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetCurrencyRequest) GetCurrencyId() int32 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+type GetCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      *Currency              `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyResponse) Reset() {
+	*x = GetCurrencyResponse{}
+	mi := &file_rpc_get_currency_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyResponse) ProtoMessage() {}
+
+func (x *GetCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_currency_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_currency_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCurrencyResponse) GetCurrency() *Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+var File_rpc_get_currency_proto protoreflect.FileDescriptor
+
+const file_rpc_get_currency_proto_rawDesc = "" +
+	"\n" +
+	"\x16rpc_get_currency.proto\x12\x02pb\x1a\x0ecurrency.proto\"5\n" +
+	"\x12GetCurrencyRequest\x12\x1f\n" +
+	"\vcurrency_id\x18\x01 \x01(\x05R\n" +
+	"currencyId\"?\n" +
+	"\x13GetCurrencyResponse\x12(\n" +
+	"\bcurrency\x18\x01 \x01(\v2\f.pb.CurrencyR\bcurrencyB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_get_currency_proto_rawDescOnce sync.Once
+	file_rpc_get_currency_proto_rawDescData []byte
+)
+
+func file_rpc_get_currency_proto_rawDescGZIP() []byte {
+	file_rpc_get_currency_proto_rawDescOnce.Do(func() {
+		file_rpc_get_currency_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_currency_proto_rawDesc), len(file_rpc_get_currency_proto_rawDesc)))
+	})
+	return file_rpc_get_currency_proto_rawDescData
+}
+
+var file_rpc_get_currency_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_currency_proto_goTypes = []any{
+	(*GetCurrencyRequest)(nil),  // 0: pb.GetCurrencyRequest
+	(*GetCurrencyResponse)(nil), // 1: pb.GetCurrencyResponse
+	(*Currency)(nil),            // 2: pb.Currency
+}
+var file_rpc_get_currency_proto_depIdxs = []int32{
+	2, // 0: pb.GetCurrencyResponse.currency:type_name -> pb.Currency
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_currency_proto_init() }
+func file_rpc_get_currency_proto_init() {
+	if File_rpc_get_currency_proto != nil {
+		return
+	}
+	file_currency_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_currency_proto_rawDesc), len(file_rpc_get_currency_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_currency_proto_goTypes,
+		DependencyIndexes: file_rpc_get_currency_proto_depIdxs,
+		MessageInfos:      file_rpc_get_currency_proto_msgTypes,
+	}.Build()
+	File_rpc_get_currency_proto = out.File
+	file_rpc_get_currency_proto_goTypes = nil
+	file_rpc_get_currency_proto_depIdxs = nil
+}
@@ -0,0 +1,259 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_get_historical_data.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// time_range (e.g. 24h, 7d, 1y, all) is required unless from is set. from and to are RFC3339
+// or YYYY-MM-DD in tz; interval 1d returns one end-of-day rate per calendar day.
+type GetHistoricalDataRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	SourceCurrencyId      int32                  `protobuf:"varint,1,opt,name=source_currency_id,json=sourceCurrencyId,proto3" json:"source_currency_id,omitempty"`
+	DestinationCurrencyId int32                  `protobuf:"varint,2,opt,name=destination_currency_id,json=destinationCurrencyId,proto3" json:"destination_currency_id,omitempty"`
+	SourceId              int32                  `protobuf:"varint,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TypeId                int32                  `protobuf:"varint,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	TimeRange             string                 `protobuf:"bytes,5,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	DataPoints            int32                  `protobuf:"varint,6,opt,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+	From                  string                 `protobuf:"bytes,7,opt,name=from,proto3" json:"from,omitempty"`
+	To                    string                 `protobuf:"bytes,8,opt,name=to,proto3" json:"to,omitempty"`
+	Tz                    string                 `protobuf:"bytes,9,opt,name=tz,proto3" json:"tz,omitempty"`
+	Interval              string                 `protobuf:"bytes,10,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetHistoricalDataRequest) Reset() {
+	*x = GetHistoricalDataRequest{}
+	mi := &file_rpc_get_historical_data_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoricalDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoricalDataRequest) ProtoMessage() {}
+
+func (x *GetHistoricalDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_historical_data_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoricalDataRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoricalDataRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_historical_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetHistoricalDataRequest) GetSourceCurrencyId() int32 {
+	if x != nil {
+		return x.SourceCurrencyId
+	}
+	return 0
+}
+
+func (x *GetHistoricalDataRequest) GetDestinationCurrencyId() int32 {
+	if x != nil {
+		return x.DestinationCurrencyId
+	}
+	return 0
+}
+
+func (x *GetHistoricalDataRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *GetHistoricalDataRequest) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *GetHistoricalDataRequest) GetTimeRange() string {
+	if x != nil {
+		return x.TimeRange
+	}
+	return ""
+}
+
+func (x *GetHistoricalDataRequest) GetDataPoints() int32 {
+	if x != nil {
+		return x.DataPoints
+	}
+	return 0
+}
+
+func (x *GetHistoricalDataRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetHistoricalDataRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetHistoricalDataRequest) GetTz() string {
+	if x != nil {
+		return x.Tz
+	}
+	return ""
+}
+
+func (x *GetHistoricalDataRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+type GetHistoricalDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DataPoints    []*HistoricalDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoricalDataResponse) Reset() {
+	*x = GetHistoricalDataResponse{}
+	mi := &file_rpc_get_historical_data_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoricalDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoricalDataResponse) ProtoMessage() {}
+
+func (x *GetHistoricalDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_historical_data_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoricalDataResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoricalDataResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_historical_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetHistoricalDataResponse) GetDataPoints() []*HistoricalDataPoint {
+	if x != nil {
+		return x.DataPoints
+	}
+	return nil
+}
+
+var File_rpc_get_historical_data_proto protoreflect.FileDescriptor
+
+const file_rpc_get_historical_data_proto_rawDesc = "" +
+	"\n" +
+	"\x1drpc_get_historical_data.proto\x12\x02pb\x1a\x13exchange_rate.proto\"\xc6\x02\n" +
+	"\x18GetHistoricalDataRequest\x12,\n" +
+	"\x12source_currency_id\x18\x01 \x01(\x05R\x10sourceCurrencyId\x126\n" +
+	"\x17destination_currency_id\x18\x02 \x01(\x05R\x15destinationCurrencyId\x12\x1b\n" +
+	"\tsource_id\x18\x03 \x01(\x05R\bsourceId\x12\x17\n" +
+	"\atype_id\x18\x04 \x01(\x05R\x06typeId\x12\x1d\n" +
+	"\n" +
+	"time_range\x18\x05 \x01(\tR\ttimeRange\x12\x1f\n" +
+	"\vdata_points\x18\x06 \x01(\x05R\n" +
+	"dataPoints\x12\x12\n" +
+	"\x04from\x18\a \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\b \x01(\tR\x02to\x12\x0e\n" +
+	"\x02tz\x18\t \x01(\tR\x02tz\x12\x1a\n" +
+	"\binterval\x18\n" +
+	" \x01(\tR\binterval\"U\n" +
+	"\x19GetHistoricalDataResponse\x128\n" +
+	"\vdata_points\x18\x01 \x03(\v2\x17.pb.HistoricalDataPointR\n" +
+	"dataPointsB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_get_historical_data_proto_rawDescOnce sync.Once
+	file_rpc_get_historical_data_proto_rawDescData []byte
+)
+
+func file_rpc_get_historical_data_proto_rawDescGZIP() []byte {
+	file_rpc_get_historical_data_proto_rawDescOnce.Do(func() {
+		file_rpc_get_historical_data_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_historical_data_proto_rawDesc), len(file_rpc_get_historical_data_proto_rawDesc)))
+	})
+	return file_rpc_get_historical_data_proto_rawDescData
+}
+
+var file_rpc_get_historical_data_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_historical_data_proto_goTypes = []any{
+	(*GetHistoricalDataRequest)(nil),  // 0: pb.GetHistoricalDataRequest
+	(*GetHistoricalDataResponse)(nil), // 1: pb.GetHistoricalDataResponse
+	(*HistoricalDataPoint)(nil),       // 2: pb.HistoricalDataPoint
+}
+var file_rpc_get_historical_data_proto_depIdxs = []int32{
+	2, // 0: pb.GetHistoricalDataResponse.data_points:type_name -> pb.HistoricalDataPoint
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_historical_data_proto_init() }
+func file_rpc_get_historical_data_proto_init() {
+	if File_rpc_get_historical_data_proto != nil {
+		return
+	}
+	file_exchange_rate_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_historical_data_proto_rawDesc), len(file_rpc_get_historical_data_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_historical_data_proto_goTypes,
+		DependencyIndexes: file_rpc_get_historical_data_proto_depIdxs,
+		MessageInfos:      file_rpc_get_historical_data_proto_msgTypes,
+	}.Build()
+	File_rpc_get_historical_data_proto = out.File
+	file_rpc_get_historical_data_proto_goTypes = nil
+	file_rpc_get_historical_data_proto_depIdxs = nil
+}
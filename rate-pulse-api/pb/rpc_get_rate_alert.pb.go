@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_get_rate_alert.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetRateAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlertId       int32                  `protobuf:"varint,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateAlertRequest) Reset() {
+	*x = GetRateAlertRequest{}
+	mi := &file_rpc_get_rate_alert_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateAlertRequest) ProtoMessage() {}
+
+func (x *GetRateAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_rate_alert_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateAlertRequest.ProtoReflect.Descriptor instead.
+func (*GetRateAlertRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_rate_alert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRateAlertRequest) GetAlertId() int32 {
+	if x != nil {
+		return x.AlertId
+	}
+	return 0
+}
+
+type GetRateAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alert         *RateAlert             `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateAlertResponse) Reset() {
+	*x = GetRateAlertResponse{}
+	mi := &file_rpc_get_rate_alert_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateAlertResponse) ProtoMessage() {}
+
+func (x *GetRateAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_rate_alert_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateAlertResponse.ProtoReflect.Descriptor instead.
+func (*GetRateAlertResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_rate_alert_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRateAlertResponse) GetAlert() *RateAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+var File_rpc_get_rate_alert_proto protoreflect.FileDescriptor
+
+const file_rpc_get_rate_alert_proto_rawDesc = "" +
+	"\n" +
+	"\x18rpc_get_rate_alert.proto\x12\x02pb\x1a\x10rate_alert.proto\"0\n" +
+	"\x13GetRateAlertRequest\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\x05R\aalertId\";\n" +
+	"\x14GetRateAlertResponse\x12#\n" +
+	"\x05alert\x18\x01 \x01(\v2\r.pb.RateAlertR\x05alertB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_get_rate_alert_proto_rawDescOnce sync.Once
+	file_rpc_get_rate_alert_proto_rawDescData []byte
+)
+
+func file_rpc_get_rate_alert_proto_rawDescGZIP() []byte {
+	file_rpc_get_rate_alert_proto_rawDescOnce.Do(func() {
+		file_rpc_get_rate_alert_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_rate_alert_proto_rawDesc), len(file_rpc_get_rate_alert_proto_rawDesc)))
+	})
+	return file_rpc_get_rate_alert_proto_rawDescData
+}
+
+var file_rpc_get_rate_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_rate_alert_proto_goTypes = []any{
+	(*GetRateAlertRequest)(nil),  // 0: pb.GetRateAlertRequest
+	(*GetRateAlertResponse)(nil), // 1: pb.GetRateAlertResponse
+	(*RateAlert)(nil),            // 2: pb.RateAlert
+}
+var file_rpc_get_rate_alert_proto_depIdxs = []int32{
+	2, // 0: pb.GetRateAlertResponse.alert:type_name -> pb.RateAlert
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_rate_alert_proto_init() }
+func file_rpc_get_rate_alert_proto_init() {
+	if File_rpc_get_rate_alert_proto != nil {
+		return
+	}
+	file_rate_alert_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_rate_alert_proto_rawDesc), len(file_rpc_get_rate_alert_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_rate_alert_proto_goTypes,
+		DependencyIndexes: file_rpc_get_rate_alert_proto_depIdxs,
+		MessageInfos:      file_rpc_get_rate_alert_proto_msgTypes,
+	}.Build()
+	File_rpc_get_rate_alert_proto = out.File
+	file_rpc_get_rate_alert_proto_goTypes = nil
+	file_rpc_get_rate_alert_proto_depIdxs = nil
+}
@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_get_rate_source.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetRateSourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      int32                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateSourceRequest) Reset() {
+	*x = GetRateSourceRequest{}
+	mi := &file_rpc_get_rate_source_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateSourceRequest) ProtoMessage() {}
+
+func (x *GetRateSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_rate_source_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateSourceRequest.ProtoReflect.Descriptor instead.
+func (*GetRateSourceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_rate_source_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRateSourceRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+type GetRateSourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RateSource    *RateSource            `protobuf:"bytes,1,opt,name=rate_source,json=rateSource,proto3" json:"rate_source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateSourceResponse) Reset() {
+	*x = GetRateSourceResponse{}
+	mi := &file_rpc_get_rate_source_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateSourceResponse) ProtoMessage() {}
+
+func (x *GetRateSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_rate_source_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateSourceResponse.ProtoReflect.Descriptor instead.
+func (*GetRateSourceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_rate_source_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRateSourceResponse) GetRateSource() *RateSource {
+	if x != nil {
+		return x.RateSource
+	}
+	return nil
+}
+
+var File_rpc_get_rate_source_proto protoreflect.FileDescriptor
+
+const file_rpc_get_rate_source_proto_rawDesc = "" +
+	"\n" +
+	"\x19rpc_get_rate_source.proto\x12\x02pb\x1a\x11rate_source.proto\"3\n" +
+	"\x14GetRateSourceRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x05R\bsourceId\"H\n" +
+	"\x15GetRateSourceResponse\x12/\n" +
+	"\vrate_source\x18\x01 \x01(\v2\x0e.pb.RateSourceR\n" +
+	"rateSourceB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_get_rate_source_proto_rawDescOnce sync.Once
+	file_rpc_get_rate_source_proto_rawDescData []byte
+)
+
+func file_rpc_get_rate_source_proto_rawDescGZIP() []byte {
+	file_rpc_get_rate_source_proto_rawDescOnce.Do(func() {
+		file_rpc_get_rate_source_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_rate_source_proto_rawDesc), len(file_rpc_get_rate_source_proto_rawDesc)))
+	})
+	return file_rpc_get_rate_source_proto_rawDescData
+}
+
+var file_rpc_get_rate_source_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_rate_source_proto_goTypes = []any{
+	(*GetRateSourceRequest)(nil),  // 0: pb.GetRateSourceRequest
+	(*GetRateSourceResponse)(nil), // 1: pb.GetRateSourceResponse
+	(*RateSource)(nil),            // 2: pb.RateSource
+}
+var file_rpc_get_rate_source_proto_depIdxs = []int32{
+	2, // 0: pb.GetRateSourceResponse.rate_source:type_name -> pb.RateSource
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_rate_source_proto_init() }
+func file_rpc_get_rate_source_proto_init() {
+	if File_rpc_get_rate_source_proto != nil {
+		return
+	}
+	file_rate_source_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_rate_source_proto_rawDesc), len(file_rpc_get_rate_source_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_rate_source_proto_goTypes,
+		DependencyIndexes: file_rpc_get_rate_source_proto_depIdxs,
+		MessageInfos:      file_rpc_get_rate_source_proto_msgTypes,
+	}.Build()
+	File_rpc_get_rate_source_proto = out.File
+	file_rpc_get_rate_source_proto_goTypes = nil
+	file_rpc_get_rate_source_proto_depIdxs = nil
+}
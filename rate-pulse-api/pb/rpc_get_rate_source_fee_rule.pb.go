@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_get_rate_source_fee_rule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetRateSourceFeeRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeeRuleId     int32                  `protobuf:"varint,1,opt,name=fee_rule_id,json=feeRuleId,proto3" json:"fee_rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateSourceFeeRuleRequest) Reset() {
+	*x = GetRateSourceFeeRuleRequest{}
+	mi := &file_rpc_get_rate_source_fee_rule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateSourceFeeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateSourceFeeRuleRequest) ProtoMessage() {}
+
+func (x *GetRateSourceFeeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_rate_source_fee_rule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateSourceFeeRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetRateSourceFeeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_rate_source_fee_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRateSourceFeeRuleRequest) GetFeeRuleId() int32 {
+	if x != nil {
+		return x.FeeRuleId
+	}
+	return 0
+}
+
+type GetRateSourceFeeRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeeRule       *RateSourceFeeRule     `protobuf:"bytes,1,opt,name=fee_rule,json=feeRule,proto3" json:"fee_rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateSourceFeeRuleResponse) Reset() {
+	*x = GetRateSourceFeeRuleResponse{}
+	mi := &file_rpc_get_rate_source_fee_rule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateSourceFeeRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateSourceFeeRuleResponse) ProtoMessage() {}
+
+func (x *GetRateSourceFeeRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_rate_source_fee_rule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateSourceFeeRuleResponse.ProtoReflect.Descriptor instead.
+func (*GetRateSourceFeeRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_rate_source_fee_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRateSourceFeeRuleResponse) GetFeeRule() *RateSourceFeeRule {
+	if x != nil {
+		return x.FeeRule
+	}
+	return nil
+}
+
+var File_rpc_get_rate_source_fee_rule_proto protoreflect.FileDescriptor
+
+const file_rpc_get_rate_source_fee_rule_proto_rawDesc = "" +
+	"\n" +
+	"\"rpc_get_rate_source_fee_rule.proto\x12\x02pb\x1a\x1arate_source_fee_rule.proto\"=\n" +
+	"\x1bGetRateSourceFeeRuleRequest\x12\x1e\n" +
+	"\vfee_rule_id\x18\x01 \x01(\x05R\tfeeRuleId\"P\n" +
+	"\x1cGetRateSourceFeeRuleResponse\x120\n" +
+	"\bfee_rule\x18\x01 \x01(\v2\x15.pb.RateSourceFeeRuleR\afeeRuleB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_get_rate_source_fee_rule_proto_rawDescOnce sync.Once
+	file_rpc_get_rate_source_fee_rule_proto_rawDescData []byte
+)
+
+func file_rpc_get_rate_source_fee_rule_proto_rawDescGZIP() []byte {
+	file_rpc_get_rate_source_fee_rule_proto_rawDescOnce.Do(func() {
+		file_rpc_get_rate_source_fee_rule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_rate_source_fee_rule_proto_rawDesc), len(file_rpc_get_rate_source_fee_rule_proto_rawDesc)))
+	})
+	return file_rpc_get_rate_source_fee_rule_proto_rawDescData
+}
+
+var file_rpc_get_rate_source_fee_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_rate_source_fee_rule_proto_goTypes = []any{
+	(*GetRateSourceFeeRuleRequest)(nil),  // 0: pb.GetRateSourceFeeRuleRequest
+	(*GetRateSourceFeeRuleResponse)(nil), // 1: pb.GetRateSourceFeeRuleResponse
+	(*RateSourceFeeRule)(nil),            // 2: pb.RateSourceFeeRule
+}
+var file_rpc_get_rate_source_fee_rule_proto_depIdxs = []int32{
+	2, // 0: pb.GetRateSourceFeeRuleResponse.fee_rule:type_name -> pb.RateSourceFeeRule
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_rate_source_fee_rule_proto_init() }
+func file_rpc_get_rate_source_fee_rule_proto_init() {
+	if File_rpc_get_rate_source_fee_rule_proto != nil {
+		return
+	}
+	file_rate_source_fee_rule_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_rate_source_fee_rule_proto_rawDesc), len(file_rpc_get_rate_source_fee_rule_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_rate_source_fee_rule_proto_goTypes,
+		DependencyIndexes: file_rpc_get_rate_source_fee_rule_proto_depIdxs,
+		MessageInfos:      file_rpc_get_rate_source_fee_rule_proto_msgTypes,
+	}.Build()
+	File_rpc_get_rate_source_fee_rule_proto = out.File
+	file_rpc_get_rate_source_fee_rule_proto_goTypes = nil
+	file_rpc_get_rate_source_fee_rule_proto_depIdxs = nil
+}
@@ -0,0 +1,308 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_get_rate_source_freshness.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetRateSourceFreshnessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateSourceFreshnessRequest) Reset() {
+	*x = GetRateSourceFreshnessRequest{}
+	mi := &file_rpc_get_rate_source_freshness_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateSourceFreshnessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateSourceFreshnessRequest) ProtoMessage() {}
+
+func (x *GetRateSourceFreshnessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_rate_source_freshness_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateSourceFreshnessRequest.ProtoReflect.Descriptor instead.
+func (*GetRateSourceFreshnessRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_rate_source_freshness_proto_rawDescGZIP(), []int{0}
+}
+
+// RateSourceFreshness reports when a source last produced a rate and whether it is overdue.
+type RateSourceFreshness struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	SourceId                int32                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	SourceName              string                 `protobuf:"bytes,2,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	SourceCode              string                 `protobuf:"bytes,3,opt,name=source_code,json=sourceCode,proto3" json:"source_code,omitempty"`
+	SourceStatus            string                 `protobuf:"bytes,4,opt,name=source_status,json=sourceStatus,proto3" json:"source_status,omitempty"`
+	ExpectedIntervalMinutes int32                  `protobuf:"varint,5,opt,name=expected_interval_minutes,json=expectedIntervalMinutes,proto3" json:"expected_interval_minutes,omitempty"`
+	LastIngestedAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_ingested_at,json=lastIngestedAt,proto3" json:"last_ingested_at,omitempty"`
+	StaleAt                 *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=stale_at,json=staleAt,proto3" json:"stale_at,omitempty"`
+	Stale                   bool                   `protobuf:"varint,8,opt,name=stale,proto3" json:"stale,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RateSourceFreshness) Reset() {
+	*x = RateSourceFreshness{}
+	mi := &file_rpc_get_rate_source_freshness_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateSourceFreshness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateSourceFreshness) ProtoMessage() {}
+
+func (x *RateSourceFreshness) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_rate_source_freshness_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateSourceFreshness.ProtoReflect.Descriptor instead.
+func (*RateSourceFreshness) Descriptor() ([]byte, []int) {
+	return file_rpc_get_rate_source_freshness_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RateSourceFreshness) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *RateSourceFreshness) GetSourceName() string {
+	if x != nil {
+		return x.SourceName
+	}
+	return ""
+}
+
+func (x *RateSourceFreshness) GetSourceCode() string {
+	if x != nil {
+		return x.SourceCode
+	}
+	return ""
+}
+
+func (x *RateSourceFreshness) GetSourceStatus() string {
+	if x != nil {
+		return x.SourceStatus
+	}
+	return ""
+}
+
+func (x *RateSourceFreshness) GetExpectedIntervalMinutes() int32 {
+	if x != nil {
+		return x.ExpectedIntervalMinutes
+	}
+	return 0
+}
+
+func (x *RateSourceFreshness) GetLastIngestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastIngestedAt
+	}
+	return nil
+}
+
+func (x *RateSourceFreshness) GetStaleAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StaleAt
+	}
+	return nil
+}
+
+func (x *RateSourceFreshness) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type GetRateSourceFreshnessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	StaleCount    int32                  `protobuf:"varint,3,opt,name=stale_count,json=staleCount,proto3" json:"stale_count,omitempty"`
+	Sources       []*RateSourceFreshness `protobuf:"bytes,4,rep,name=sources,proto3" json:"sources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateSourceFreshnessResponse) Reset() {
+	*x = GetRateSourceFreshnessResponse{}
+	mi := &file_rpc_get_rate_source_freshness_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateSourceFreshnessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateSourceFreshnessResponse) ProtoMessage() {}
+
+func (x *GetRateSourceFreshnessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_rate_source_freshness_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateSourceFreshnessResponse.ProtoReflect.Descriptor instead.
+func (*GetRateSourceFreshnessResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_rate_source_freshness_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetRateSourceFreshnessResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetRateSourceFreshnessResponse) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *GetRateSourceFreshnessResponse) GetStaleCount() int32 {
+	if x != nil {
+		return x.StaleCount
+	}
+	return 0
+}
+
+func (x *GetRateSourceFreshnessResponse) GetSources() []*RateSourceFreshness {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+var File_rpc_get_rate_source_freshness_proto protoreflect.FileDescriptor
+
+const file_rpc_get_rate_source_freshness_proto_rawDesc = "" +
+	"\n" +
+	"#rpc_get_rate_source_freshness.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1f\n" +
+	"\x1dGetRateSourceFreshnessRequest\"\xe8\x02\n" +
+	"\x13RateSourceFreshness\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x05R\bsourceId\x12\x1f\n" +
+	"\vsource_name\x18\x02 \x01(\tR\n" +
+	"sourceName\x12\x1f\n" +
+	"\vsource_code\x18\x03 \x01(\tR\n" +
+	"sourceCode\x12#\n" +
+	"\rsource_status\x18\x04 \x01(\tR\fsourceStatus\x12:\n" +
+	"\x19expected_interval_minutes\x18\x05 \x01(\x05R\x17expectedIntervalMinutes\x12D\n" +
+	"\x10last_ingested_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastIngestedAt\x125\n" +
+	"\bstale_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\astaleAt\x12\x14\n" +
+	"\x05stale\x18\b \x01(\bR\x05stale\"\xc7\x01\n" +
+	"\x1eGetRateSourceFreshnessResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"checked_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\x12\x1f\n" +
+	"\vstale_count\x18\x03 \x01(\x05R\n" +
+	"staleCount\x121\n" +
+	"\asources\x18\x04 \x03(\v2\x17.pb.RateSourceFreshnessR\asourcesB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_get_rate_source_freshness_proto_rawDescOnce sync.Once
+	file_rpc_get_rate_source_freshness_proto_rawDescData []byte
+)
+
+func file_rpc_get_rate_source_freshness_proto_rawDescGZIP() []byte {
+	file_rpc_get_rate_source_freshness_proto_rawDescOnce.Do(func() {
+		file_rpc_get_rate_source_freshness_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_rate_source_freshness_proto_rawDesc), len(file_rpc_get_rate_source_freshness_proto_rawDesc)))
+	})
+	return file_rpc_get_rate_source_freshness_proto_rawDescData
+}
+
+var file_rpc_get_rate_source_freshness_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_rpc_get_rate_source_freshness_proto_goTypes = []any{
+	(*GetRateSourceFreshnessRequest)(nil),  // 0: pb.GetRateSourceFreshnessRequest
+	(*RateSourceFreshness)(nil),            // 1: pb.RateSourceFreshness
+	(*GetRateSourceFreshnessResponse)(nil), // 2: pb.GetRateSourceFreshnessResponse
+	(*timestamppb.Timestamp)(nil),          // 3: google.protobuf.Timestamp
+}
+var file_rpc_get_rate_source_freshness_proto_depIdxs = []int32{
+	3, // 0: pb.RateSourceFreshness.last_ingested_at:type_name -> google.protobuf.Timestamp
+	3, // 1: pb.RateSourceFreshness.stale_at:type_name -> google.protobuf.Timestamp
+	3, // 2: pb.GetRateSourceFreshnessResponse.checked_at:type_name -> google.protobuf.Timestamp
+	1, // 3: pb.GetRateSourceFreshnessResponse.sources:type_name -> pb.RateSourceFreshness
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_rate_source_freshness_proto_init() }
+func file_rpc_get_rate_source_freshness_proto_init() {
+	if File_rpc_get_rate_source_freshness_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_rate_source_freshness_proto_rawDesc), len(file_rpc_get_rate_source_freshness_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_rate_source_freshness_proto_goTypes,
+		DependencyIndexes: file_rpc_get_rate_source_freshness_proto_depIdxs,
+		MessageInfos:      file_rpc_get_rate_source_freshness_proto_msgTypes,
+	}.Build()
+	File_rpc_get_rate_source_freshness_proto = out.File
+	file_rpc_get_rate_source_freshness_proto_goTypes = nil
+	file_rpc_get_rate_source_freshness_proto_depIdxs = nil
+}
@@ -0,0 +1,602 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_get_spreads.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// time_range also returns a spread series; interval is one of 1h, 1d, 1w or 1M.
+type GetSpreadsRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	SourceCurrencyId      int32                  `protobuf:"varint,1,opt,name=source_currency_id,json=sourceCurrencyId,proto3" json:"source_currency_id,omitempty"`
+	DestinationCurrencyId int32                  `protobuf:"varint,2,opt,name=destination_currency_id,json=destinationCurrencyId,proto3" json:"destination_currency_id,omitempty"`
+	SourceId              int32                  `protobuf:"varint,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Channel               string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	TimeRange             string                 `protobuf:"bytes,5,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Interval              string                 `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetSpreadsRequest) Reset() {
+	*x = GetSpreadsRequest{}
+	mi := &file_rpc_get_spreads_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpreadsRequest) ProtoMessage() {}
+
+func (x *GetSpreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_spreads_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpreadsRequest.ProtoReflect.Descriptor instead.
+func (*GetSpreadsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_spreads_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetSpreadsRequest) GetSourceCurrencyId() int32 {
+	if x != nil {
+		return x.SourceCurrencyId
+	}
+	return 0
+}
+
+func (x *GetSpreadsRequest) GetDestinationCurrencyId() int32 {
+	if x != nil {
+		return x.DestinationCurrencyId
+	}
+	return 0
+}
+
+func (x *GetSpreadsRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *GetSpreadsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *GetSpreadsRequest) GetTimeRange() string {
+	if x != nil {
+		return x.TimeRange
+	}
+	return ""
+}
+
+func (x *GetSpreadsRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+// SpreadValues relates a buy and sell rate; spread_bps is the spread relative to the mid rate.
+type SpreadValues struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuyRate       string                 `protobuf:"bytes,1,opt,name=buy_rate,json=buyRate,proto3" json:"buy_rate,omitempty"`
+	SellRate      string                 `protobuf:"bytes,2,opt,name=sell_rate,json=sellRate,proto3" json:"sell_rate,omitempty"`
+	MidRate       string                 `protobuf:"bytes,3,opt,name=mid_rate,json=midRate,proto3" json:"mid_rate,omitempty"`
+	Spread        string                 `protobuf:"bytes,4,opt,name=spread,proto3" json:"spread,omitempty"`
+	SpreadBps     string                 `protobuf:"bytes,5,opt,name=spread_bps,json=spreadBps,proto3" json:"spread_bps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpreadValues) Reset() {
+	*x = SpreadValues{}
+	mi := &file_rpc_get_spreads_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpreadValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpreadValues) ProtoMessage() {}
+
+func (x *SpreadValues) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_spreads_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpreadValues.ProtoReflect.Descriptor instead.
+func (*SpreadValues) Descriptor() ([]byte, []int) {
+	return file_rpc_get_spreads_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SpreadValues) GetBuyRate() string {
+	if x != nil {
+		return x.BuyRate
+	}
+	return ""
+}
+
+func (x *SpreadValues) GetSellRate() string {
+	if x != nil {
+		return x.SellRate
+	}
+	return ""
+}
+
+func (x *SpreadValues) GetMidRate() string {
+	if x != nil {
+		return x.MidRate
+	}
+	return ""
+}
+
+func (x *SpreadValues) GetSpread() string {
+	if x != nil {
+		return x.Spread
+	}
+	return ""
+}
+
+func (x *SpreadValues) GetSpreadBps() string {
+	if x != nil {
+		return x.SpreadBps
+	}
+	return ""
+}
+
+type RateSpread struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	SourceId      int32                  `protobuf:"varint,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	SourceName    string                 `protobuf:"bytes,3,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	SourceCode    string                 `protobuf:"bytes,4,opt,name=source_code,json=sourceCode,proto3" json:"source_code,omitempty"`
+	Channel       string                 `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"`
+	BuyTypeId     int32                  `protobuf:"varint,6,opt,name=buy_type_id,json=buyTypeId,proto3" json:"buy_type_id,omitempty"`
+	SellTypeId    int32                  `protobuf:"varint,7,opt,name=sell_type_id,json=sellTypeId,proto3" json:"sell_type_id,omitempty"`
+	BuyRateId     int32                  `protobuf:"varint,8,opt,name=buy_rate_id,json=buyRateId,proto3" json:"buy_rate_id,omitempty"`
+	SellRateId    int32                  `protobuf:"varint,9,opt,name=sell_rate_id,json=sellRateId,proto3" json:"sell_rate_id,omitempty"`
+	Values        *SpreadValues          `protobuf:"bytes,10,opt,name=values,proto3" json:"values,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateSpread) Reset() {
+	*x = RateSpread{}
+	mi := &file_rpc_get_spreads_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateSpread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateSpread) ProtoMessage() {}
+
+func (x *RateSpread) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_spreads_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateSpread.ProtoReflect.Descriptor instead.
+func (*RateSpread) Descriptor() ([]byte, []int) {
+	return file_rpc_get_spreads_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RateSpread) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *RateSpread) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *RateSpread) GetSourceName() string {
+	if x != nil {
+		return x.SourceName
+	}
+	return ""
+}
+
+func (x *RateSpread) GetSourceCode() string {
+	if x != nil {
+		return x.SourceCode
+	}
+	return ""
+}
+
+func (x *RateSpread) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *RateSpread) GetBuyTypeId() int32 {
+	if x != nil {
+		return x.BuyTypeId
+	}
+	return 0
+}
+
+func (x *RateSpread) GetSellTypeId() int32 {
+	if x != nil {
+		return x.SellTypeId
+	}
+	return 0
+}
+
+func (x *RateSpread) GetBuyRateId() int32 {
+	if x != nil {
+		return x.BuyRateId
+	}
+	return 0
+}
+
+func (x *RateSpread) GetSellRateId() int32 {
+	if x != nil {
+		return x.SellRateId
+	}
+	return 0
+}
+
+func (x *RateSpread) GetValues() *SpreadValues {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *RateSpread) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SpreadPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"`
+	Values        *SpreadValues          `protobuf:"bytes,2,opt,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpreadPoint) Reset() {
+	*x = SpreadPoint{}
+	mi := &file_rpc_get_spreads_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpreadPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpreadPoint) ProtoMessage() {}
+
+func (x *SpreadPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_spreads_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpreadPoint.ProtoReflect.Descriptor instead.
+func (*SpreadPoint) Descriptor() ([]byte, []int) {
+	return file_rpc_get_spreads_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SpreadPoint) GetBucketStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BucketStart
+	}
+	return nil
+}
+
+func (x *SpreadPoint) GetValues() *SpreadValues {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type SpreadSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      int32                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Points        []*SpreadPoint         `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpreadSeries) Reset() {
+	*x = SpreadSeries{}
+	mi := &file_rpc_get_spreads_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpreadSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpreadSeries) ProtoMessage() {}
+
+func (x *SpreadSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_spreads_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpreadSeries.ProtoReflect.Descriptor instead.
+func (*SpreadSeries) Descriptor() ([]byte, []int) {
+	return file_rpc_get_spreads_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SpreadSeries) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *SpreadSeries) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SpreadSeries) GetPoints() []*SpreadPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type GetSpreadsResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	SourceCurrencyId      int32                  `protobuf:"varint,1,opt,name=source_currency_id,json=sourceCurrencyId,proto3" json:"source_currency_id,omitempty"`
+	DestinationCurrencyId int32                  `protobuf:"varint,2,opt,name=destination_currency_id,json=destinationCurrencyId,proto3" json:"destination_currency_id,omitempty"`
+	Spreads               []*RateSpread          `protobuf:"bytes,3,rep,name=spreads,proto3" json:"spreads,omitempty"`
+	Series                []*SpreadSeries        `protobuf:"bytes,4,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetSpreadsResponse) Reset() {
+	*x = GetSpreadsResponse{}
+	mi := &file_rpc_get_spreads_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpreadsResponse) ProtoMessage() {}
+
+func (x *GetSpreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_spreads_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpreadsResponse.ProtoReflect.Descriptor instead.
+func (*GetSpreadsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_spreads_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSpreadsResponse) GetSourceCurrencyId() int32 {
+	if x != nil {
+		return x.SourceCurrencyId
+	}
+	return 0
+}
+
+func (x *GetSpreadsResponse) GetDestinationCurrencyId() int32 {
+	if x != nil {
+		return x.DestinationCurrencyId
+	}
+	return 0
+}
+
+func (x *GetSpreadsResponse) GetSpreads() []*RateSpread {
+	if x != nil {
+		return x.Spreads
+	}
+	return nil
+}
+
+func (x *GetSpreadsResponse) GetSeries() []*SpreadSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+var File_rpc_get_spreads_proto protoreflect.FileDescriptor
+
+const file_rpc_get_spreads_proto_rawDesc = "" +
+	"\n" +
+	"\x15rpc_get_spreads.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xeb\x01\n" +
+	"\x11GetSpreadsRequest\x12,\n" +
+	"\x12source_currency_id\x18\x01 \x01(\x05R\x10sourceCurrencyId\x126\n" +
+	"\x17destination_currency_id\x18\x02 \x01(\x05R\x15destinationCurrencyId\x12\x1b\n" +
+	"\tsource_id\x18\x03 \x01(\x05R\bsourceId\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x12\x1d\n" +
+	"\n" +
+	"time_range\x18\x05 \x01(\tR\ttimeRange\x12\x1a\n" +
+	"\binterval\x18\x06 \x01(\tR\binterval\"\x98\x01\n" +
+	"\fSpreadValues\x12\x19\n" +
+	"\bbuy_rate\x18\x01 \x01(\tR\abuyRate\x12\x1b\n" +
+	"\tsell_rate\x18\x02 \x01(\tR\bsellRate\x12\x19\n" +
+	"\bmid_rate\x18\x03 \x01(\tR\amidRate\x12\x16\n" +
+	"\x06spread\x18\x04 \x01(\tR\x06spread\x12\x1d\n" +
+	"\n" +
+	"spread_bps\x18\x05 \x01(\tR\tspreadBps\"\x82\x03\n" +
+	"\n" +
+	"RateSpread\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\x05R\bsourceId\x12\x1f\n" +
+	"\vsource_name\x18\x03 \x01(\tR\n" +
+	"sourceName\x12\x1f\n" +
+	"\vsource_code\x18\x04 \x01(\tR\n" +
+	"sourceCode\x12\x18\n" +
+	"\achannel\x18\x05 \x01(\tR\achannel\x12\x1e\n" +
+	"\vbuy_type_id\x18\x06 \x01(\x05R\tbuyTypeId\x12 \n" +
+	"\fsell_type_id\x18\a \x01(\x05R\n" +
+	"sellTypeId\x12\x1e\n" +
+	"\vbuy_rate_id\x18\b \x01(\x05R\tbuyRateId\x12 \n" +
+	"\fsell_rate_id\x18\t \x01(\x05R\n" +
+	"sellRateId\x12(\n" +
+	"\x06values\x18\n" +
+	" \x01(\v2\x10.pb.SpreadValuesR\x06values\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"v\n" +
+	"\vSpreadPoint\x12=\n" +
+	"\fbucket_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vbucketStart\x12(\n" +
+	"\x06values\x18\x02 \x01(\v2\x10.pb.SpreadValuesR\x06values\"n\n" +
+	"\fSpreadSeries\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x05R\bsourceId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12'\n" +
+	"\x06points\x18\x03 \x03(\v2\x0f.pb.SpreadPointR\x06points\"\xce\x01\n" +
+	"\x12GetSpreadsResponse\x12,\n" +
+	"\x12source_currency_id\x18\x01 \x01(\x05R\x10sourceCurrencyId\x126\n" +
+	"\x17destination_currency_id\x18\x02 \x01(\x05R\x15destinationCurrencyId\x12(\n" +
+	"\aspreads\x18\x03 \x03(\v2\x0e.pb.RateSpreadR\aspreads\x12(\n" +
+	"\x06series\x18\x04 \x03(\v2\x10.pb.SpreadSeriesR\x06seriesB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_get_spreads_proto_rawDescOnce sync.Once
+	file_rpc_get_spreads_proto_rawDescData []byte
+)
+
+func file_rpc_get_spreads_proto_rawDescGZIP() []byte {
+	file_rpc_get_spreads_proto_rawDescOnce.Do(func() {
+		file_rpc_get_spreads_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_spreads_proto_rawDesc), len(file_rpc_get_spreads_proto_rawDesc)))
+	})
+	return file_rpc_get_spreads_proto_rawDescData
+}
+
+var file_rpc_get_spreads_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_rpc_get_spreads_proto_goTypes = []any{
+	(*GetSpreadsRequest)(nil),     // 0: pb.GetSpreadsRequest
+	(*SpreadValues)(nil),          // 1: pb.SpreadValues
+	(*RateSpread)(nil),            // 2: pb.RateSpread
+	(*SpreadPoint)(nil),           // 3: pb.SpreadPoint
+	(*SpreadSeries)(nil),          // 4: pb.SpreadSeries
+	(*GetSpreadsResponse)(nil),    // 5: pb.GetSpreadsResponse
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+}
+var file_rpc_get_spreads_proto_depIdxs = []int32{
+	1, // 0: pb.RateSpread.values:type_name -> pb.SpreadValues
+	6, // 1: pb.RateSpread.updated_at:type_name -> google.protobuf.Timestamp
+	6, // 2: pb.SpreadPoint.bucket_start:type_name -> google.protobuf.Timestamp
+	1, // 3: pb.SpreadPoint.values:type_name -> pb.SpreadValues
+	3, // 4: pb.SpreadSeries.points:type_name -> pb.SpreadPoint
+	2, // 5: pb.GetSpreadsResponse.spreads:type_name -> pb.RateSpread
+	4, // 6: pb.GetSpreadsResponse.series:type_name -> pb.SpreadSeries
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_spreads_proto_init() }
+func file_rpc_get_spreads_proto_init() {
+	if File_rpc_get_spreads_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_spreads_proto_rawDesc), len(file_rpc_get_spreads_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_spreads_proto_goTypes,
+		DependencyIndexes: file_rpc_get_spreads_proto_depIdxs,
+		MessageInfos:      file_rpc_get_spreads_proto_msgTypes,
+	}.Build()
+	File_rpc_get_spreads_proto = out.File
+	file_rpc_get_spreads_proto_goTypes = nil
+	file_rpc_get_spreads_proto_depIdxs = nil
+}
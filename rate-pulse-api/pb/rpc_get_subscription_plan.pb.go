@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_get_subscription_plan.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetSubscriptionPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        int32                  `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionPlanRequest) Reset() {
+	*x = GetSubscriptionPlanRequest{}
+	mi := &file_rpc_get_subscription_plan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionPlanRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_subscription_plan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionPlanRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_subscription_plan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetSubscriptionPlanRequest) GetPlanId() int32 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+type GetSubscriptionPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *SubscriptionPlan      `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionPlanResponse) Reset() {
+	*x = GetSubscriptionPlanResponse{}
+	mi := &file_rpc_get_subscription_plan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionPlanResponse) ProtoMessage() {}
+
+func (x *GetSubscriptionPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_subscription_plan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionPlanResponse.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionPlanResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_subscription_plan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSubscriptionPlanResponse) GetPlan() *SubscriptionPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+var File_rpc_get_subscription_plan_proto protoreflect.FileDescriptor
+
+const file_rpc_get_subscription_plan_proto_rawDesc = "" +
+	"\n" +
+	"\x1frpc_get_subscription_plan.proto\x12\x02pb\x1a\x17subscription_plan.proto\"5\n" +
+	"\x1aGetSubscriptionPlanRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\x05R\x06planId\"G\n" +
+	"\x1bGetSubscriptionPlanResponse\x12(\n" +
+	"\x04plan\x18\x01 \x01(\v2\x14.pb.SubscriptionPlanR\x04planB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_get_subscription_plan_proto_rawDescOnce sync.Once
+	file_rpc_get_subscription_plan_proto_rawDescData []byte
+)
+
+func file_rpc_get_subscription_plan_proto_rawDescGZIP() []byte {
+	file_rpc_get_subscription_plan_proto_rawDescOnce.Do(func() {
+		file_rpc_get_subscription_plan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_subscription_plan_proto_rawDesc), len(file_rpc_get_subscription_plan_proto_rawDesc)))
+	})
+	return file_rpc_get_subscription_plan_proto_rawDescData
+}
+
+var file_rpc_get_subscription_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_subscription_plan_proto_goTypes = []any{
+	(*GetSubscriptionPlanRequest)(nil),  // 0: pb.GetSubscriptionPlanRequest
+	(*GetSubscriptionPlanResponse)(nil), // 1: pb.GetSubscriptionPlanResponse
+	(*SubscriptionPlan)(nil),            // 2: pb.SubscriptionPlan
+}
+var file_rpc_get_subscription_plan_proto_depIdxs = []int32{
+	2, // 0: pb.GetSubscriptionPlanResponse.plan:type_name -> pb.SubscriptionPlan
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_subscription_plan_proto_init() }
+func file_rpc_get_subscription_plan_proto_init() {
+	if File_rpc_get_subscription_plan_proto != nil {
+		return
+	}
+	file_subscription_plan_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_subscription_plan_proto_rawDesc), len(file_rpc_get_subscription_plan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_subscription_plan_proto_goTypes,
+		DependencyIndexes: file_rpc_get_subscription_plan_proto_depIdxs,
+		MessageInfos:      file_rpc_get_subscription_plan_proto_msgTypes,
+	}.Build()
+	File_rpc_get_subscription_plan_proto = out.File
+	file_rpc_get_subscription_plan_proto_goTypes = nil
+	file_rpc_get_subscription_plan_proto_depIdxs = nil
+}
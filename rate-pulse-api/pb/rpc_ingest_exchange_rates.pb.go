@@ -0,0 +1,383 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_ingest_exchange_rates.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IngestExchangeRate struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	SourceCode              string                 `protobuf:"bytes,1,opt,name=source_code,json=sourceCode,proto3" json:"source_code,omitempty"`
+	SourceCurrencyCode      string                 `protobuf:"bytes,2,opt,name=source_currency_code,json=sourceCurrencyCode,proto3" json:"source_currency_code,omitempty"`
+	DestinationCurrencyCode string                 `protobuf:"bytes,3,opt,name=destination_currency_code,json=destinationCurrencyCode,proto3" json:"destination_currency_code,omitempty"`
+	TypeName                string                 `protobuf:"bytes,4,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	RateValue               string                 `protobuf:"bytes,5,opt,name=rate_value,json=rateValue,proto3" json:"rate_value,omitempty"`
+	ValidFromDate           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_from_date,json=validFromDate,proto3" json:"valid_from_date,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *IngestExchangeRate) Reset() {
+	*x = IngestExchangeRate{}
+	mi := &file_rpc_ingest_exchange_rates_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestExchangeRate) ProtoMessage() {}
+
+func (x *IngestExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_ingest_exchange_rates_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestExchangeRate.ProtoReflect.Descriptor instead.
+func (*IngestExchangeRate) Descriptor() ([]byte, []int) {
+	return file_rpc_ingest_exchange_rates_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IngestExchangeRate) GetSourceCode() string {
+	if x != nil {
+		return x.SourceCode
+	}
+	return ""
+}
+
+func (x *IngestExchangeRate) GetSourceCurrencyCode() string {
+	if x != nil {
+		return x.SourceCurrencyCode
+	}
+	return ""
+}
+
+func (x *IngestExchangeRate) GetDestinationCurrencyCode() string {
+	if x != nil {
+		return x.DestinationCurrencyCode
+	}
+	return ""
+}
+
+func (x *IngestExchangeRate) GetTypeName() string {
+	if x != nil {
+		return x.TypeName
+	}
+	return ""
+}
+
+func (x *IngestExchangeRate) GetRateValue() string {
+	if x != nil {
+		return x.RateValue
+	}
+	return ""
+}
+
+func (x *IngestExchangeRate) GetValidFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFromDate
+	}
+	return nil
+}
+
+type IngestExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*IngestExchangeRate  `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestExchangeRatesRequest) Reset() {
+	*x = IngestExchangeRatesRequest{}
+	mi := &file_rpc_ingest_exchange_rates_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestExchangeRatesRequest) ProtoMessage() {}
+
+func (x *IngestExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_ingest_exchange_rates_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*IngestExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_ingest_exchange_rates_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IngestExchangeRatesRequest) GetRates() []*IngestExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type IngestExchangeRateResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	RateId        *int32                 `protobuf:"varint,3,opt,name=rate_id,json=rateId,proto3,oneof" json:"rate_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestExchangeRateResult) Reset() {
+	*x = IngestExchangeRateResult{}
+	mi := &file_rpc_ingest_exchange_rates_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestExchangeRateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestExchangeRateResult) ProtoMessage() {}
+
+func (x *IngestExchangeRateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_ingest_exchange_rates_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestExchangeRateResult.ProtoReflect.Descriptor instead.
+func (*IngestExchangeRateResult) Descriptor() ([]byte, []int) {
+	return file_rpc_ingest_exchange_rates_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IngestExchangeRateResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *IngestExchangeRateResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *IngestExchangeRateResult) GetRateId() int32 {
+	if x != nil && x.RateId != nil {
+		return *x.RateId
+	}
+	return 0
+}
+
+func (x *IngestExchangeRateResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type IngestExchangeRatesResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Inserted      int32                       `protobuf:"varint,1,opt,name=inserted,proto3" json:"inserted,omitempty"`
+	Duplicates    int32                       `protobuf:"varint,2,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Rejected      int32                       `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Rows          []*IngestExchangeRateResult `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	Quarantined   int32                       `protobuf:"varint,5,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestExchangeRatesResponse) Reset() {
+	*x = IngestExchangeRatesResponse{}
+	mi := &file_rpc_ingest_exchange_rates_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestExchangeRatesResponse) ProtoMessage() {}
+
+func (x *IngestExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_ingest_exchange_rates_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*IngestExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_ingest_exchange_rates_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IngestExchangeRatesResponse) GetInserted() int32 {
+	if x != nil {
+		return x.Inserted
+	}
+	return 0
+}
+
+func (x *IngestExchangeRatesResponse) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+func (x *IngestExchangeRatesResponse) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *IngestExchangeRatesResponse) GetRows() []*IngestExchangeRateResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *IngestExchangeRatesResponse) GetQuarantined() int32 {
+	if x != nil {
+		return x.Quarantined
+	}
+	return 0
+}
+
+var File_rpc_ingest_exchange_rates_proto protoreflect.FileDescriptor
+
+const file_rpc_ingest_exchange_rates_proto_rawDesc = "" +
+	"\n" +
+	"\x1frpc_ingest_exchange_rates.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa3\x02\n" +
+	"\x12IngestExchangeRate\x12\x1f\n" +
+	"\vsource_code\x18\x01 \x01(\tR\n" +
+	"sourceCode\x120\n" +
+	"\x14source_currency_code\x18\x02 \x01(\tR\x12sourceCurrencyCode\x12:\n" +
+	"\x19destination_currency_code\x18\x03 \x01(\tR\x17destinationCurrencyCode\x12\x1b\n" +
+	"\ttype_name\x18\x04 \x01(\tR\btypeName\x12\x1d\n" +
+	"\n" +
+	"rate_value\x18\x05 \x01(\tR\trateValue\x12B\n" +
+	"\x0fvalid_from_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rvalidFromDate\"J\n" +
+	"\x1aIngestExchangeRatesRequest\x12,\n" +
+	"\x05rates\x18\x01 \x03(\v2\x16.pb.IngestExchangeRateR\x05rates\"\x8a\x01\n" +
+	"\x18IngestExchangeRateResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1c\n" +
+	"\arate_id\x18\x03 \x01(\x05H\x00R\x06rateId\x88\x01\x01\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reasonB\n" +
+	"\n" +
+	"\b_rate_id\"\xc9\x01\n" +
+	"\x1bIngestExchangeRatesResponse\x12\x1a\n" +
+	"\binserted\x18\x01 \x01(\x05R\binserted\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x02 \x01(\x05R\n" +
+	"duplicates\x12\x1a\n" +
+	"\brejected\x18\x03 \x01(\x05R\brejected\x120\n" +
+	"\x04rows\x18\x04 \x03(\v2\x1c.pb.IngestExchangeRateResultR\x04rows\x12 \n" +
+	"\vquarantined\x18\x05 \x01(\x05R\vquarantinedB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_ingest_exchange_rates_proto_rawDescOnce sync.Once
+	file_rpc_ingest_exchange_rates_proto_rawDescData []byte
+)
+
+func file_rpc_ingest_exchange_rates_proto_rawDescGZIP() []byte {
+	file_rpc_ingest_exchange_rates_proto_rawDescOnce.Do(func() {
+		file_rpc_ingest_exchange_rates_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_ingest_exchange_rates_proto_rawDesc), len(file_rpc_ingest_exchange_rates_proto_rawDesc)))
+	})
+	return file_rpc_ingest_exchange_rates_proto_rawDescData
+}
+
+var file_rpc_ingest_exchange_rates_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpc_ingest_exchange_rates_proto_goTypes = []any{
+	(*IngestExchangeRate)(nil),          // 0: pb.IngestExchangeRate
+	(*IngestExchangeRatesRequest)(nil),  // 1: pb.IngestExchangeRatesRequest
+	(*IngestExchangeRateResult)(nil),    // 2: pb.IngestExchangeRateResult
+	(*IngestExchangeRatesResponse)(nil), // 3: pb.IngestExchangeRatesResponse
+	(*timestamppb.Timestamp)(nil),       // 4: google.protobuf.Timestamp
+}
+var file_rpc_ingest_exchange_rates_proto_depIdxs = []int32{
+	4, // 0: pb.IngestExchangeRate.valid_from_date:type_name -> google.protobuf.Timestamp
+	0, // 1: pb.IngestExchangeRatesRequest.rates:type_name -> pb.IngestExchangeRate
+	2, // 2: pb.IngestExchangeRatesResponse.rows:type_name -> pb.IngestExchangeRateResult
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_ingest_exchange_rates_proto_init() }
+func file_rpc_ingest_exchange_rates_proto_init() {
+	if File_rpc_ingest_exchange_rates_proto != nil {
+		return
+	}
+	file_rpc_ingest_exchange_rates_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_ingest_exchange_rates_proto_rawDesc), len(file_rpc_ingest_exchange_rates_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_ingest_exchange_rates_proto_goTypes,
+		DependencyIndexes: file_rpc_ingest_exchange_rates_proto_depIdxs,
+		MessageInfos:      file_rpc_ingest_exchange_rates_proto_msgTypes,
+	}.Build()
+	File_rpc_ingest_exchange_rates_proto = out.File
+	file_rpc_ingest_exchange_rates_proto_goTypes = nil
+	file_rpc_ingest_exchange_rates_proto_depIdxs = nil
+}
@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_list_currencies.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListCurrenciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCurrenciesRequest) Reset() {
+	*x = ListCurrenciesRequest{}
+	mi := &file_rpc_list_currencies_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCurrenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCurrenciesRequest) ProtoMessage() {}
+
+func (x *ListCurrenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_currencies_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCurrenciesRequest.ProtoReflect.Descriptor instead.
+func (*ListCurrenciesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_currencies_proto_rawDescGZIP(), []int{0}
+}
+
+type ListCurrenciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currencies    []*Currency            `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCurrenciesResponse) Reset() {
+	*x = ListCurrenciesResponse{}
+	mi := &file_rpc_list_currencies_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCurrenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCurrenciesResponse) ProtoMessage() {}
+
+func (x *ListCurrenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_currencies_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCurrenciesResponse.ProtoReflect.Descriptor instead.
+func (*ListCurrenciesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_currencies_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListCurrenciesResponse) GetCurrencies() []*Currency {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+var File_rpc_list_currencies_proto protoreflect.FileDescriptor
+
+const file_rpc_list_currencies_proto_rawDesc = "" +
+	"\n" +
+	"\x19rpc_list_currencies.proto\x12\x02pb\x1a\x0ecurrency.proto\"\x17\n" +
+	"\x15ListCurrenciesRequest\"F\n" +
+	"\x16ListCurrenciesResponse\x12,\n" +
+	"\n" +
+	"currencies\x18\x01 \x03(\v2\f.pb.CurrencyR\n" +
+	"currenciesB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_list_currencies_proto_rawDescOnce sync.Once
+	file_rpc_list_currencies_proto_rawDescData []byte
+)
+
+func file_rpc_list_currencies_proto_rawDescGZIP() []byte {
+	file_rpc_list_currencies_proto_rawDescOnce.Do(func() {
+		file_rpc_list_currencies_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_currencies_proto_rawDesc), len(file_rpc_list_currencies_proto_rawDesc)))
+	})
+	return file_rpc_list_currencies_proto_rawDescData
+}
+
+var file_rpc_list_currencies_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_currencies_proto_goTypes = []any{
+	(*ListCurrenciesRequest)(nil),  // 0: pb.ListCurrenciesRequest
+	(*ListCurrenciesResponse)(nil), // 1: pb.ListCurrenciesResponse
+	(*Currency)(nil),               // 2: pb.Currency
+}
+var file_rpc_list_currencies_proto_depIdxs = []int32{
+	2, // 0: pb.ListCurrenciesResponse.currencies:type_name -> pb.Currency
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_currencies_proto_init() }
+func file_rpc_list_currencies_proto_init() {
+	if File_rpc_list_currencies_proto != nil {
+		return
+	}
+	file_currency_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_currencies_proto_rawDesc), len(file_rpc_list_currencies_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_currencies_proto_goTypes,
+		DependencyIndexes: file_rpc_list_currencies_proto_depIdxs,
+		MessageInfos:      file_rpc_list_currencies_proto_msgTypes,
+	}.Build()
+	File_rpc_list_currencies_proto = out.File
+	file_rpc_list_currencies_proto_goTypes = nil
+	file_rpc_list_currencies_proto_depIdxs = nil
+}
@@ -0,0 +1,181 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_list_currency_preferences.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListCurrencyPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        int32                  `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCurrencyPreferencesRequest) Reset() {
+	*x = ListCurrencyPreferencesRequest{}
+	mi := &file_rpc_list_currency_preferences_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCurrencyPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCurrencyPreferencesRequest) ProtoMessage() {}
+
+func (x *ListCurrencyPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_currency_preferences_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCurrencyPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*ListCurrencyPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_currency_preferences_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListCurrencyPreferencesRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListCurrencyPreferencesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCurrencyPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   []*CurrencyPreference  `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCurrencyPreferencesResponse) Reset() {
+	*x = ListCurrencyPreferencesResponse{}
+	mi := &file_rpc_list_currency_preferences_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCurrencyPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCurrencyPreferencesResponse) ProtoMessage() {}
+
+func (x *ListCurrencyPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_currency_preferences_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCurrencyPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*ListCurrencyPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_currency_preferences_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListCurrencyPreferencesResponse) GetPreferences() []*CurrencyPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+var File_rpc_list_currency_preferences_proto protoreflect.FileDescriptor
+
+const file_rpc_list_currency_preferences_proto_rawDesc = "" +
+	"\n" +
+	"#rpc_list_currency_preferences.proto\x12\x02pb\x1a\x19currency_preference.proto\"V\n" +
+	"\x1eListCurrencyPreferencesRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\x05R\x06pageId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"[\n" +
+	"\x1fListCurrencyPreferencesResponse\x128\n" +
+	"\vpreferences\x18\x01 \x03(\v2\x16.pb.CurrencyPreferenceR\vpreferencesB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_list_currency_preferences_proto_rawDescOnce sync.Once
+	file_rpc_list_currency_preferences_proto_rawDescData []byte
+)
+
+func file_rpc_list_currency_preferences_proto_rawDescGZIP() []byte {
+	file_rpc_list_currency_preferences_proto_rawDescOnce.Do(func() {
+		file_rpc_list_currency_preferences_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_currency_preferences_proto_rawDesc), len(file_rpc_list_currency_preferences_proto_rawDesc)))
+	})
+	return file_rpc_list_currency_preferences_proto_rawDescData
+}
+
+var file_rpc_list_currency_preferences_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_currency_preferences_proto_goTypes = []any{
+	(*ListCurrencyPreferencesRequest)(nil),  // 0: pb.ListCurrencyPreferencesRequest
+	(*ListCurrencyPreferencesResponse)(nil), // 1: pb.ListCurrencyPreferencesResponse
+	(*CurrencyPreference)(nil),              // 2: pb.CurrencyPreference
+}
+var file_rpc_list_currency_preferences_proto_depIdxs = []int32{
+	2, // 0: pb.ListCurrencyPreferencesResponse.preferences:type_name -> pb.CurrencyPreference
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_currency_preferences_proto_init() }
+func file_rpc_list_currency_preferences_proto_init() {
+	if File_rpc_list_currency_preferences_proto != nil {
+		return
+	}
+	file_currency_preference_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_currency_preferences_proto_rawDesc), len(file_rpc_list_currency_preferences_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_currency_preferences_proto_goTypes,
+		DependencyIndexes: file_rpc_list_currency_preferences_proto_depIdxs,
+		MessageInfos:      file_rpc_list_currency_preferences_proto_msgTypes,
+	}.Build()
+	File_rpc_list_currency_preferences_proto = out.File
+	file_rpc_list_currency_preferences_proto_goTypes = nil
+	file_rpc_list_currency_preferences_proto_depIdxs = nil
+}
@@ -0,0 +1,164 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_list_payments.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Lists the payments on the caller's subscriptions.
+type ListPaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentsRequest) Reset() {
+	*x = ListPaymentsRequest{}
+	mi := &file_rpc_list_payments_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentsRequest) ProtoMessage() {}
+
+func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_payments_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_payments_proto_rawDescGZIP(), []int{0}
+}
+
+type ListPaymentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payments      []*Payment             `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentsResponse) Reset() {
+	*x = ListPaymentsResponse{}
+	mi := &file_rpc_list_payments_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentsResponse) ProtoMessage() {}
+
+func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_payments_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_payments_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPaymentsResponse) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+var File_rpc_list_payments_proto protoreflect.FileDescriptor
+
+const file_rpc_list_payments_proto_rawDesc = "" +
+	"\n" +
+	"\x17rpc_list_payments.proto\x12\x02pb\x1a\rpayment.proto\"\x15\n" +
+	"\x13ListPaymentsRequest\"?\n" +
+	"\x14ListPaymentsResponse\x12'\n" +
+	"\bpayments\x18\x01 \x03(\v2\v.pb.PaymentR\bpaymentsB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_list_payments_proto_rawDescOnce sync.Once
+	file_rpc_list_payments_proto_rawDescData []byte
+)
+
+func file_rpc_list_payments_proto_rawDescGZIP() []byte {
+	file_rpc_list_payments_proto_rawDescOnce.Do(func() {
+		file_rpc_list_payments_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_payments_proto_rawDesc), len(file_rpc_list_payments_proto_rawDesc)))
+	})
+	return file_rpc_list_payments_proto_rawDescData
+}
+
+var file_rpc_list_payments_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_payments_proto_goTypes = []any{
+	(*ListPaymentsRequest)(nil),  // 0: pb.ListPaymentsRequest
+	(*ListPaymentsResponse)(nil), // 1: pb.ListPaymentsResponse
+	(*Payment)(nil),              // 2: pb.Payment
+}
+var file_rpc_list_payments_proto_depIdxs = []int32{
+	2, // 0: pb.ListPaymentsResponse.payments:type_name -> pb.Payment
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_payments_proto_init() }
+func file_rpc_list_payments_proto_init() {
+	if File_rpc_list_payments_proto != nil {
+		return
+	}
+	file_payment_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_payments_proto_rawDesc), len(file_rpc_list_payments_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_payments_proto_goTypes,
+		DependencyIndexes: file_rpc_list_payments_proto_depIdxs,
+		MessageInfos:      file_rpc_list_payments_proto_msgTypes,
+	}.Build()
+	File_rpc_list_payments_proto = out.File
+	file_rpc_list_payments_proto_goTypes = nil
+	file_rpc_list_payments_proto_depIdxs = nil
+}
@@ -0,0 +1,181 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_list_rate_alerts.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListRateAlertsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageId        int32                  `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRateAlertsRequest) Reset() {
+	*x = ListRateAlertsRequest{}
+	mi := &file_rpc_list_rate_alerts_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRateAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateAlertsRequest) ProtoMessage() {}
+
+func (x *ListRateAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_rate_alerts_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListRateAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_rate_alerts_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListRateAlertsRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListRateAlertsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListRateAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*RateAlert           `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRateAlertsResponse) Reset() {
+	*x = ListRateAlertsResponse{}
+	mi := &file_rpc_list_rate_alerts_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRateAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateAlertsResponse) ProtoMessage() {}
+
+func (x *ListRateAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_rate_alerts_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListRateAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_rate_alerts_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRateAlertsResponse) GetAlerts() []*RateAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+var File_rpc_list_rate_alerts_proto protoreflect.FileDescriptor
+
+const file_rpc_list_rate_alerts_proto_rawDesc = "" +
+	"\n" +
+	"\x1arpc_list_rate_alerts.proto\x12\x02pb\x1a\x10rate_alert.proto\"M\n" +
+	"\x15ListRateAlertsRequest\x12\x17\n" +
+	"\apage_id\x18\x01 \x01(\x05R\x06pageId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"?\n" +
+	"\x16ListRateAlertsResponse\x12%\n" +
+	"\x06alerts\x18\x01 \x03(\v2\r.pb.RateAlertR\x06alertsB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_list_rate_alerts_proto_rawDescOnce sync.Once
+	file_rpc_list_rate_alerts_proto_rawDescData []byte
+)
+
+func file_rpc_list_rate_alerts_proto_rawDescGZIP() []byte {
+	file_rpc_list_rate_alerts_proto_rawDescOnce.Do(func() {
+		file_rpc_list_rate_alerts_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_rate_alerts_proto_rawDesc), len(file_rpc_list_rate_alerts_proto_rawDesc)))
+	})
+	return file_rpc_list_rate_alerts_proto_rawDescData
+}
+
+var file_rpc_list_rate_alerts_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_rate_alerts_proto_goTypes = []any{
+	(*ListRateAlertsRequest)(nil),  // 0: pb.ListRateAlertsRequest
+	(*ListRateAlertsResponse)(nil), // 1: pb.ListRateAlertsResponse
+	(*RateAlert)(nil),              // 2: pb.RateAlert
+}
+var file_rpc_list_rate_alerts_proto_depIdxs = []int32{
+	2, // 0: pb.ListRateAlertsResponse.alerts:type_name -> pb.RateAlert
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_rate_alerts_proto_init() }
+func file_rpc_list_rate_alerts_proto_init() {
+	if File_rpc_list_rate_alerts_proto != nil {
+		return
+	}
+	file_rate_alert_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_rate_alerts_proto_rawDesc), len(file_rpc_list_rate_alerts_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_rate_alerts_proto_goTypes,
+		DependencyIndexes: file_rpc_list_rate_alerts_proto_depIdxs,
+		MessageInfos:      file_rpc_list_rate_alerts_proto_msgTypes,
+	}.Build()
+	File_rpc_list_rate_alerts_proto = out.File
+	file_rpc_list_rate_alerts_proto_goTypes = nil
+	file_rpc_list_rate_alerts_proto_depIdxs = nil
+}
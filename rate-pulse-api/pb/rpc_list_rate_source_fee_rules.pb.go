@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_list_rate_source_fee_rules.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListRateSourceFeeRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      *int32                 `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3,oneof" json:"source_id,omitempty"`
+	ActiveOn      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=active_on,json=activeOn,proto3" json:"active_on,omitempty"`
+	TypeId        *int32                 `protobuf:"varint,3,opt,name=type_id,json=typeId,proto3,oneof" json:"type_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Sort          string                 `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRateSourceFeeRulesRequest) Reset() {
+	*x = ListRateSourceFeeRulesRequest{}
+	mi := &file_rpc_list_rate_source_fee_rules_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRateSourceFeeRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateSourceFeeRulesRequest) ProtoMessage() {}
+
+func (x *ListRateSourceFeeRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_rate_source_fee_rules_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateSourceFeeRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListRateSourceFeeRulesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_rate_source_fee_rules_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListRateSourceFeeRulesRequest) GetSourceId() int32 {
+	if x != nil && x.SourceId != nil {
+		return *x.SourceId
+	}
+	return 0
+}
+
+func (x *ListRateSourceFeeRulesRequest) GetActiveOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveOn
+	}
+	return nil
+}
+
+func (x *ListRateSourceFeeRulesRequest) GetTypeId() int32 {
+	if x != nil && x.TypeId != nil {
+		return *x.TypeId
+	}
+	return 0
+}
+
+func (x *ListRateSourceFeeRulesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRateSourceFeeRulesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRateSourceFeeRulesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListRateSourceFeeRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeeRules      []*RateSourceFeeRule   `protobuf:"bytes,1,rep,name=fee_rules,json=feeRules,proto3" json:"fee_rules,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRateSourceFeeRulesResponse) Reset() {
+	*x = ListRateSourceFeeRulesResponse{}
+	mi := &file_rpc_list_rate_source_fee_rules_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRateSourceFeeRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateSourceFeeRulesResponse) ProtoMessage() {}
+
+func (x *ListRateSourceFeeRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_rate_source_fee_rules_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateSourceFeeRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListRateSourceFeeRulesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_rate_source_fee_rules_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRateSourceFeeRulesResponse) GetFeeRules() []*RateSourceFeeRule {
+	if x != nil {
+		return x.FeeRules
+	}
+	return nil
+}
+
+func (x *ListRateSourceFeeRulesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+var File_rpc_list_rate_source_fee_rules_proto protoreflect.FileDescriptor
+
+const file_rpc_list_rate_source_fee_rules_proto_rawDesc = "" +
+	"\n" +
+	"$rpc_list_rate_source_fee_rules.proto\x12\x02pb\x1a\x1arate_source_fee_rule.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfb\x01\n" +
+	"\x1dListRateSourceFeeRulesRequest\x12 \n" +
+	"\tsource_id\x18\x01 \x01(\x05H\x00R\bsourceId\x88\x01\x01\x127\n" +
+	"\tactive_on\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bactiveOn\x12\x1c\n" +
+	"\atype_id\x18\x03 \x01(\x05H\x01R\x06typeId\x88\x01\x01\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\tR\x04sortB\f\n" +
+	"\n" +
+	"_source_idB\n" +
+	"\n" +
+	"\b_type_id\"u\n" +
+	"\x1eListRateSourceFeeRulesResponse\x122\n" +
+	"\tfee_rules\x18\x01 \x03(\v2\x15.pb.RateSourceFeeRuleR\bfeeRules\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursorB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_list_rate_source_fee_rules_proto_rawDescOnce sync.Once
+	file_rpc_list_rate_source_fee_rules_proto_rawDescData []byte
+)
+
+func file_rpc_list_rate_source_fee_rules_proto_rawDescGZIP() []byte {
+	file_rpc_list_rate_source_fee_rules_proto_rawDescOnce.Do(func() {
+		file_rpc_list_rate_source_fee_rules_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_rate_source_fee_rules_proto_rawDesc), len(file_rpc_list_rate_source_fee_rules_proto_rawDesc)))
+	})
+	return file_rpc_list_rate_source_fee_rules_proto_rawDescData
+}
+
+var file_rpc_list_rate_source_fee_rules_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_rate_source_fee_rules_proto_goTypes = []any{
+	(*ListRateSourceFeeRulesRequest)(nil),  // 0: pb.ListRateSourceFeeRulesRequest
+	(*ListRateSourceFeeRulesResponse)(nil), // 1: pb.ListRateSourceFeeRulesResponse
+	(*timestamppb.Timestamp)(nil),          // 2: google.protobuf.Timestamp
+	(*RateSourceFeeRule)(nil),              // 3: pb.RateSourceFeeRule
+}
+var file_rpc_list_rate_source_fee_rules_proto_depIdxs = []int32{
+	2, // 0: pb.ListRateSourceFeeRulesRequest.active_on:type_name -> google.protobuf.Timestamp
+	3, // 1: pb.ListRateSourceFeeRulesResponse.fee_rules:type_name -> pb.RateSourceFeeRule
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_rate_source_fee_rules_proto_init() }
+func file_rpc_list_rate_source_fee_rules_proto_init() {
+	if File_rpc_list_rate_source_fee_rules_proto != nil {
+		return
+	}
+	file_rate_source_fee_rule_proto_init()
+	file_rpc_list_rate_source_fee_rules_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_rate_source_fee_rules_proto_rawDesc), len(file_rpc_list_rate_source_fee_rules_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_rate_source_fee_rules_proto_goTypes,
+		DependencyIndexes: file_rpc_list_rate_source_fee_rules_proto_depIdxs,
+		MessageInfos:      file_rpc_list_rate_source_fee_rules_proto_msgTypes,
+	}.Build()
+	File_rpc_list_rate_source_fee_rules_proto = out.File
+	file_rpc_list_rate_source_fee_rules_proto_goTypes = nil
+	file_rpc_list_rate_source_fee_rules_proto_depIdxs = nil
+}
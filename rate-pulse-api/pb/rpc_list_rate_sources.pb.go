@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_list_rate_sources.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListRateSourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *string                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Country       *string                `protobuf:"bytes,2,opt,name=country,proto3,oneof" json:"country,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Sort          string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRateSourcesRequest) Reset() {
+	*x = ListRateSourcesRequest{}
+	mi := &file_rpc_list_rate_sources_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRateSourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateSourcesRequest) ProtoMessage() {}
+
+func (x *ListRateSourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_rate_sources_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateSourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListRateSourcesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_rate_sources_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListRateSourcesRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListRateSourcesRequest) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+func (x *ListRateSourcesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRateSourcesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRateSourcesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListRateSourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RateSources   []*RateSource          `protobuf:"bytes,1,rep,name=rate_sources,json=rateSources,proto3" json:"rate_sources,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRateSourcesResponse) Reset() {
+	*x = ListRateSourcesResponse{}
+	mi := &file_rpc_list_rate_sources_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRateSourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRateSourcesResponse) ProtoMessage() {}
+
+func (x *ListRateSourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_rate_sources_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRateSourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListRateSourcesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_rate_sources_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRateSourcesResponse) GetRateSources() []*RateSource {
+	if x != nil {
+		return x.RateSources
+	}
+	return nil
+}
+
+func (x *ListRateSourcesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+var File_rpc_list_rate_sources_proto protoreflect.FileDescriptor
+
+const file_rpc_list_rate_sources_proto_rawDesc = "" +
+	"\n" +
+	"\x1brpc_list_rate_sources.proto\x12\x02pb\x1a\x11rate_source.proto\"\xb4\x01\n" +
+	"\x16ListRateSourcesRequest\x12\x1b\n" +
+	"\x06status\x18\x01 \x01(\tH\x00R\x06status\x88\x01\x01\x12\x1d\n" +
+	"\acountry\x18\x02 \x01(\tH\x01R\acountry\x88\x01\x01\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x12\n" +
+	"\x04sort\x18\x05 \x01(\tR\x04sortB\t\n" +
+	"\a_statusB\n" +
+	"\n" +
+	"\b_country\"m\n" +
+	"\x17ListRateSourcesResponse\x121\n" +
+	"\frate_sources\x18\x01 \x03(\v2\x0e.pb.RateSourceR\vrateSources\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursorB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_list_rate_sources_proto_rawDescOnce sync.Once
+	file_rpc_list_rate_sources_proto_rawDescData []byte
+)
+
+func file_rpc_list_rate_sources_proto_rawDescGZIP() []byte {
+	file_rpc_list_rate_sources_proto_rawDescOnce.Do(func() {
+		file_rpc_list_rate_sources_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_rate_sources_proto_rawDesc), len(file_rpc_list_rate_sources_proto_rawDesc)))
+	})
+	return file_rpc_list_rate_sources_proto_rawDescData
+}
+
+var file_rpc_list_rate_sources_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_rate_sources_proto_goTypes = []any{
+	(*ListRateSourcesRequest)(nil),  // 0: pb.ListRateSourcesRequest
+	(*ListRateSourcesResponse)(nil), // 1: pb.ListRateSourcesResponse
+	(*RateSource)(nil),              // 2: pb.RateSource
+}
+var file_rpc_list_rate_sources_proto_depIdxs = []int32{
+	2, // 0: pb.ListRateSourcesResponse.rate_sources:type_name -> pb.RateSource
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_rate_sources_proto_init() }
+func file_rpc_list_rate_sources_proto_init() {
+	if File_rpc_list_rate_sources_proto != nil {
+		return
+	}
+	file_rate_source_proto_init()
+	file_rpc_list_rate_sources_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_rate_sources_proto_rawDesc), len(file_rpc_list_rate_sources_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_rate_sources_proto_goTypes,
+		DependencyIndexes: file_rpc_list_rate_sources_proto_depIdxs,
+		MessageInfos:      file_rpc_list_rate_sources_proto_msgTypes,
+	}.Build()
+	File_rpc_list_rate_sources_proto = out.File
+	file_rpc_list_rate_sources_proto_goTypes = nil
+	file_rpc_list_rate_sources_proto_depIdxs = nil
+}
@@ -0,0 +1,164 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_list_subscription_plans.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Lists the plans on sale.
+type ListSubscriptionPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionPlansRequest) Reset() {
+	*x = ListSubscriptionPlansRequest{}
+	mi := &file_rpc_list_subscription_plans_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionPlansRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_subscription_plans_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionPlansRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_subscription_plans_proto_rawDescGZIP(), []int{0}
+}
+
+type ListSubscriptionPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*SubscriptionPlan    `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionPlansResponse) Reset() {
+	*x = ListSubscriptionPlansResponse{}
+	mi := &file_rpc_list_subscription_plans_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionPlansResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_subscription_plans_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionPlansResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_subscription_plans_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListSubscriptionPlansResponse) GetPlans() []*SubscriptionPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+var File_rpc_list_subscription_plans_proto protoreflect.FileDescriptor
+
+const file_rpc_list_subscription_plans_proto_rawDesc = "" +
+	"\n" +
+	"!rpc_list_subscription_plans.proto\x12\x02pb\x1a\x17subscription_plan.proto\"\x1e\n" +
+	"\x1cListSubscriptionPlansRequest\"K\n" +
+	"\x1dListSubscriptionPlansResponse\x12*\n" +
+	"\x05plans\x18\x01 \x03(\v2\x14.pb.SubscriptionPlanR\x05plansB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_list_subscription_plans_proto_rawDescOnce sync.Once
+	file_rpc_list_subscription_plans_proto_rawDescData []byte
+)
+
+func file_rpc_list_subscription_plans_proto_rawDescGZIP() []byte {
+	file_rpc_list_subscription_plans_proto_rawDescOnce.Do(func() {
+		file_rpc_list_subscription_plans_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_subscription_plans_proto_rawDesc), len(file_rpc_list_subscription_plans_proto_rawDesc)))
+	})
+	return file_rpc_list_subscription_plans_proto_rawDescData
+}
+
+var file_rpc_list_subscription_plans_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_subscription_plans_proto_goTypes = []any{
+	(*ListSubscriptionPlansRequest)(nil),  // 0: pb.ListSubscriptionPlansRequest
+	(*ListSubscriptionPlansResponse)(nil), // 1: pb.ListSubscriptionPlansResponse
+	(*SubscriptionPlan)(nil),              // 2: pb.SubscriptionPlan
+}
+var file_rpc_list_subscription_plans_proto_depIdxs = []int32{
+	2, // 0: pb.ListSubscriptionPlansResponse.plans:type_name -> pb.SubscriptionPlan
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_subscription_plans_proto_init() }
+func file_rpc_list_subscription_plans_proto_init() {
+	if File_rpc_list_subscription_plans_proto != nil {
+		return
+	}
+	file_subscription_plan_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_subscription_plans_proto_rawDesc), len(file_rpc_list_subscription_plans_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_subscription_plans_proto_goTypes,
+		DependencyIndexes: file_rpc_list_subscription_plans_proto_depIdxs,
+		MessageInfos:      file_rpc_list_subscription_plans_proto_msgTypes,
+	}.Build()
+	File_rpc_list_subscription_plans_proto = out.File
+	file_rpc_list_subscription_plans_proto_goTypes = nil
+	file_rpc_list_subscription_plans_proto_depIdxs = nil
+}
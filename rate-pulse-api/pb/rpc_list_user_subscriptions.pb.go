@@ -0,0 +1,164 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_list_user_subscriptions.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Lists the caller's subscriptions.
+type ListUserSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserSubscriptionsRequest) Reset() {
+	*x = ListUserSubscriptionsRequest{}
+	mi := &file_rpc_list_user_subscriptions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListUserSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_user_subscriptions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_user_subscriptions_proto_rawDescGZIP(), []int{0}
+}
+
+type ListUserSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*UserSubscription    `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserSubscriptionsResponse) Reset() {
+	*x = ListUserSubscriptionsResponse{}
+	mi := &file_rpc_list_user_subscriptions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListUserSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_user_subscriptions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_user_subscriptions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListUserSubscriptionsResponse) GetSubscriptions() []*UserSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+var File_rpc_list_user_subscriptions_proto protoreflect.FileDescriptor
+
+const file_rpc_list_user_subscriptions_proto_rawDesc = "" +
+	"\n" +
+	"!rpc_list_user_subscriptions.proto\x12\x02pb\x1a\x17user_subscription.proto\"\x1e\n" +
+	"\x1cListUserSubscriptionsRequest\"[\n" +
+	"\x1dListUserSubscriptionsResponse\x12:\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x14.pb.UserSubscriptionR\rsubscriptionsB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_list_user_subscriptions_proto_rawDescOnce sync.Once
+	file_rpc_list_user_subscriptions_proto_rawDescData []byte
+)
+
+func file_rpc_list_user_subscriptions_proto_rawDescGZIP() []byte {
+	file_rpc_list_user_subscriptions_proto_rawDescOnce.Do(func() {
+		file_rpc_list_user_subscriptions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_user_subscriptions_proto_rawDesc), len(file_rpc_list_user_subscriptions_proto_rawDesc)))
+	})
+	return file_rpc_list_user_subscriptions_proto_rawDescData
+}
+
+var file_rpc_list_user_subscriptions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_user_subscriptions_proto_goTypes = []any{
+	(*ListUserSubscriptionsRequest)(nil),  // 0: pb.ListUserSubscriptionsRequest
+	(*ListUserSubscriptionsResponse)(nil), // 1: pb.ListUserSubscriptionsResponse
+	(*UserSubscription)(nil),              // 2: pb.UserSubscription
+}
+var file_rpc_list_user_subscriptions_proto_depIdxs = []int32{
+	2, // 0: pb.ListUserSubscriptionsResponse.subscriptions:type_name -> pb.UserSubscription
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_user_subscriptions_proto_init() }
+func file_rpc_list_user_subscriptions_proto_init() {
+	if File_rpc_list_user_subscriptions_proto != nil {
+		return
+	}
+	file_user_subscription_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_user_subscriptions_proto_rawDesc), len(file_rpc_list_user_subscriptions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_user_subscriptions_proto_goTypes,
+		DependencyIndexes: file_rpc_list_user_subscriptions_proto_depIdxs,
+		MessageInfos:      file_rpc_list_user_subscriptions_proto_msgTypes,
+	}.Build()
+	File_rpc_list_user_subscriptions_proto = out.File
+	file_rpc_list_user_subscriptions_proto_goTypes = nil
+	file_rpc_list_user_subscriptions_proto_depIdxs = nil
+}
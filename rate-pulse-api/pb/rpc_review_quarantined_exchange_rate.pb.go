@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_review_quarantined_exchange_rate.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// action is approve or reject.
+type ReviewQuarantinedExchangeRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RateId        int32                  `protobuf:"varint,1,opt,name=rate_id,json=rateId,proto3" json:"rate_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewQuarantinedExchangeRateRequest) Reset() {
+	*x = ReviewQuarantinedExchangeRateRequest{}
+	mi := &file_rpc_review_quarantined_exchange_rate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewQuarantinedExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewQuarantinedExchangeRateRequest) ProtoMessage() {}
+
+func (x *ReviewQuarantinedExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_review_quarantined_exchange_rate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewQuarantinedExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*ReviewQuarantinedExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_review_quarantined_exchange_rate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReviewQuarantinedExchangeRateRequest) GetRateId() int32 {
+	if x != nil {
+		return x.RateId
+	}
+	return 0
+}
+
+func (x *ReviewQuarantinedExchangeRateRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type ReviewQuarantinedExchangeRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeRate  *ExchangeRate          `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewQuarantinedExchangeRateResponse) Reset() {
+	*x = ReviewQuarantinedExchangeRateResponse{}
+	mi := &file_rpc_review_quarantined_exchange_rate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewQuarantinedExchangeRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewQuarantinedExchangeRateResponse) ProtoMessage() {}
+
+func (x *ReviewQuarantinedExchangeRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_review_quarantined_exchange_rate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewQuarantinedExchangeRateResponse.ProtoReflect.Descriptor instead.
+func (*ReviewQuarantinedExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_review_quarantined_exchange_rate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReviewQuarantinedExchangeRateResponse) GetExchangeRate() *ExchangeRate {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return nil
+}
+
+var File_rpc_review_quarantined_exchange_rate_proto protoreflect.FileDescriptor
+
+const file_rpc_review_quarantined_exchange_rate_proto_rawDesc = "" +
+	"\n" +
+	"*rpc_review_quarantined_exchange_rate.proto\x12\x02pb\x1a\x13exchange_rate.proto\"W\n" +
+	"$ReviewQuarantinedExchangeRateRequest\x12\x17\n" +
+	"\arate_id\x18\x01 \x01(\x05R\x06rateId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\"^\n" +
+	"%ReviewQuarantinedExchangeRateResponse\x125\n" +
+	"\rexchange_rate\x18\x01 \x01(\v2\x10.pb.ExchangeRateR\fexchangeRateB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_review_quarantined_exchange_rate_proto_rawDescOnce sync.Once
+	file_rpc_review_quarantined_exchange_rate_proto_rawDescData []byte
+)
+
+func file_rpc_review_quarantined_exchange_rate_proto_rawDescGZIP() []byte {
+	file_rpc_review_quarantined_exchange_rate_proto_rawDescOnce.Do(func() {
+		file_rpc_review_quarantined_exchange_rate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_review_quarantined_exchange_rate_proto_rawDesc), len(file_rpc_review_quarantined_exchange_rate_proto_rawDesc)))
+	})
+	return file_rpc_review_quarantined_exchange_rate_proto_rawDescData
+}
+
+var file_rpc_review_quarantined_exchange_rate_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_review_quarantined_exchange_rate_proto_goTypes = []any{
+	(*ReviewQuarantinedExchangeRateRequest)(nil),  // 0: pb.ReviewQuarantinedExchangeRateRequest
+	(*ReviewQuarantinedExchangeRateResponse)(nil), // 1: pb.ReviewQuarantinedExchangeRateResponse
+	(*ExchangeRate)(nil),                          // 2: pb.ExchangeRate
+}
+var file_rpc_review_quarantined_exchange_rate_proto_depIdxs = []int32{
+	2, // 0: pb.ReviewQuarantinedExchangeRateResponse.exchange_rate:type_name -> pb.ExchangeRate
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_review_quarantined_exchange_rate_proto_init() }
+func file_rpc_review_quarantined_exchange_rate_proto_init() {
+	if File_rpc_review_quarantined_exchange_rate_proto != nil {
+		return
+	}
+	file_exchange_rate_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_review_quarantined_exchange_rate_proto_rawDesc), len(file_rpc_review_quarantined_exchange_rate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_review_quarantined_exchange_rate_proto_goTypes,
+		DependencyIndexes: file_rpc_review_quarantined_exchange_rate_proto_depIdxs,
+		MessageInfos:      file_rpc_review_quarantined_exchange_rate_proto_msgTypes,
+	}.Build()
+	File_rpc_review_quarantined_exchange_rate_proto = out.File
+	file_rpc_review_quarantined_exchange_rate_proto_goTypes = nil
+	file_rpc_review_quarantined_exchange_rate_proto_depIdxs = nil
+}
@@ -82,6 +82,14 @@ type SignInUserResponse struct {
 	RefreshToken          string                 `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	AccessTokenExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=access_token_expires_at,json=accessTokenExpiresAt,proto3" json:"access_token_expires_at,omitempty"`
 	RefreshTokenExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=refresh_token_expires_at,json=refreshTokenExpiresAt,proto3" json:"refresh_token_expires_at,omitempty"`
+	// When mfa_required is set no session is created: the sign-in is completed by
+	// CompleteSignInMFA with mfa_token, and the token fields above are empty.
+	MfaRequired bool `protobuf:"varint,7,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	// mfa_enrollment_required is set for admins without an authenticator app, who enrol
+	// one with EnrollSignInTOTP first.
+	MfaEnrollmentRequired bool                   `protobuf:"varint,8,opt,name=mfa_enrollment_required,json=mfaEnrollmentRequired,proto3" json:"mfa_enrollment_required,omitempty"`
+	MfaToken              string                 `protobuf:"bytes,9,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	MfaTokenExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=mfa_token_expires_at,json=mfaTokenExpiresAt,proto3" json:"mfa_token_expires_at,omitempty"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -158,6 +166,34 @@ func (x *SignInUserResponse) GetRefreshTokenExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SignInUserResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *SignInUserResponse) GetMfaEnrollmentRequired() bool {
+	if x != nil {
+		return x.MfaEnrollmentRequired
+	}
+	return false
+}
+
+func (x *SignInUserResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *SignInUserResponse) GetMfaTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MfaTokenExpiresAt
+	}
+	return nil
+}
+
 var File_rpc_signin_user_proto protoreflect.FileDescriptor
 
 const file_rpc_signin_user_proto_rawDesc = "" +
@@ -166,7 +202,7 @@ const file_rpc_signin_user_proto_rawDesc = "" +
 	"user.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"E\n" +
 	"\x11SignInUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xc1\x02\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x86\x04\n" +
 	"\x12SignInUserResponse\x12\x1c\n" +
 	"\x04user\x18\x01 \x01(\v2\b.pb.UserR\x04user\x12\x1d\n" +
 	"\n" +
@@ -174,7 +210,12 @@ const file_rpc_signin_user_proto_rawDesc = "" +
 	"\faccess_token\x18\x03 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12Q\n" +
 	"\x17access_token_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x14accessTokenExpiresAt\x12S\n" +
-	"\x18refresh_token_expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x15refreshTokenExpiresAtB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+	"\x18refresh_token_expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x15refreshTokenExpiresAt\x12!\n" +
+	"\fmfa_required\x18\a \x01(\bR\vmfaRequired\x126\n" +
+	"\x17mfa_enrollment_required\x18\b \x01(\bR\x15mfaEnrollmentRequired\x12\x1b\n" +
+	"\tmfa_token\x18\t \x01(\tR\bmfaToken\x12K\n" +
+	"\x14mfa_token_expires_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x11mfaTokenExpiresAtB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
 
 var (
 	file_rpc_signin_user_proto_rawDescOnce sync.Once
@@ -199,11 +240,12 @@ var file_rpc_signin_user_proto_depIdxs = []int32{
 	2, // 0: pb.SignInUserResponse.user:type_name -> pb.User
 	3, // 1: pb.SignInUserResponse.access_token_expires_at:type_name -> google.protobuf.Timestamp
 	3, // 2: pb.SignInUserResponse.refresh_token_expires_at:type_name -> google.protobuf.Timestamp
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 3: pb.SignInUserResponse.mfa_token_expires_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_rpc_signin_user_proto_init() }
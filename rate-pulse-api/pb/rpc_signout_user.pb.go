@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_signout_user.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SignOutUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignOutUserRequest) Reset() {
+	*x = SignOutUserRequest{}
+	mi := &file_rpc_signout_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignOutUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignOutUserRequest) ProtoMessage() {}
+
+func (x *SignOutUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_signout_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignOutUserRequest.ProtoReflect.Descriptor instead.
+func (*SignOutUserRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_signout_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SignOutUserRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type SignOutUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignOutUserResponse) Reset() {
+	*x = SignOutUserResponse{}
+	mi := &file_rpc_signout_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignOutUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignOutUserResponse) ProtoMessage() {}
+
+func (x *SignOutUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_signout_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignOutUserResponse.ProtoReflect.Descriptor instead.
+func (*SignOutUserResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_signout_user_proto_rawDescGZIP(), []int{1}
+}
+
+var File_rpc_signout_user_proto protoreflect.FileDescriptor
+
+const file_rpc_signout_user_proto_rawDesc = "" +
+	"\n" +
+	"\x16rpc_signout_user.proto\x12\x02pb\"9\n" +
+	"\x12SignOutUserRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\x15\n" +
+	"\x13SignOutUserResponseB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_signout_user_proto_rawDescOnce sync.Once
+	file_rpc_signout_user_proto_rawDescData []byte
+)
+
+func file_rpc_signout_user_proto_rawDescGZIP() []byte {
+	file_rpc_signout_user_proto_rawDescOnce.Do(func() {
+		file_rpc_signout_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_signout_user_proto_rawDesc), len(file_rpc_signout_user_proto_rawDesc)))
+	})
+	return file_rpc_signout_user_proto_rawDescData
+}
+
+var file_rpc_signout_user_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_signout_user_proto_goTypes = []any{
+	(*SignOutUserRequest)(nil),  // 0: pb.SignOutUserRequest
+	(*SignOutUserResponse)(nil), // 1: pb.SignOutUserResponse
+}
+var file_rpc_signout_user_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_signout_user_proto_init() }
+func file_rpc_signout_user_proto_init() {
+	if File_rpc_signout_user_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_signout_user_proto_rawDesc), len(file_rpc_signout_user_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_signout_user_proto_goTypes,
+		DependencyIndexes: file_rpc_signout_user_proto_depIdxs,
+		MessageInfos:      file_rpc_signout_user_proto_msgTypes,
+	}.Build()
+	File_rpc_signout_user_proto = out.File
+	file_rpc_signout_user_proto_goTypes = nil
+	file_rpc_signout_user_proto_depIdxs = nil
+}
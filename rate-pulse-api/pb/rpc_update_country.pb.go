@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_update_country.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Only the fields that are set are changed.
+type UpdateCountryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryId     int32                  `protobuf:"varint,1,opt,name=country_id,json=countryId,proto3" json:"country_id,omitempty"`
+	CountryName   *string                `protobuf:"bytes,2,opt,name=country_name,json=countryName,proto3,oneof" json:"country_name,omitempty"`
+	CountryCode   *string                `protobuf:"bytes,3,opt,name=country_code,json=countryCode,proto3,oneof" json:"country_code,omitempty"`
+	CurrencyId    *int32                 `protobuf:"varint,4,opt,name=currency_id,json=currencyId,proto3,oneof" json:"currency_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCountryRequest) Reset() {
+	*x = UpdateCountryRequest{}
+	mi := &file_rpc_update_country_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCountryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCountryRequest) ProtoMessage() {}
+
+func (x *UpdateCountryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_country_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCountryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCountryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_update_country_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateCountryRequest) GetCountryId() int32 {
+	if x != nil {
+		return x.CountryId
+	}
+	return 0
+}
+
+func (x *UpdateCountryRequest) GetCountryName() string {
+	if x != nil && x.CountryName != nil {
+		return *x.CountryName
+	}
+	return ""
+}
+
+func (x *UpdateCountryRequest) GetCountryCode() string {
+	if x != nil && x.CountryCode != nil {
+		return *x.CountryCode
+	}
+	return ""
+}
+
+func (x *UpdateCountryRequest) GetCurrencyId() int32 {
+	if x != nil && x.CurrencyId != nil {
+		return *x.CurrencyId
+	}
+	return 0
+}
+
+type UpdateCountryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Country       *Country               `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCountryResponse) Reset() {
+	*x = UpdateCountryResponse{}
+	mi := &file_rpc_update_country_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCountryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCountryResponse) ProtoMessage() {}
+
+func (x *UpdateCountryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_country_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCountryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCountryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_update_country_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateCountryResponse) GetCountry() *Country {
+	if x != nil {
+		return x.Country
+	}
+	return nil
+}
+
+var File_rpc_update_country_proto protoreflect.FileDescriptor
+
+const file_rpc_update_country_proto_rawDesc = "" +
+	"\n" +
+	"\x18rpc_update_country.proto\x12\x02pb\x1a\rcountry.proto\"\xdd\x01\n" +
+	"\x14UpdateCountryRequest\x12\x1d\n" +
+	"\n" +
+	"country_id\x18\x01 \x01(\x05R\tcountryId\x12&\n" +
+	"\fcountry_name\x18\x02 \x01(\tH\x00R\vcountryName\x88\x01\x01\x12&\n" +
+	"\fcountry_code\x18\x03 \x01(\tH\x01R\vcountryCode\x88\x01\x01\x12$\n" +
+	"\vcurrency_id\x18\x04 \x01(\x05H\x02R\n" +
+	"currencyId\x88\x01\x01B\x0f\n" +
+	"\r_country_nameB\x0f\n" +
+	"\r_country_codeB\x0e\n" +
+	"\f_currency_id\">\n" +
+	"\x15UpdateCountryResponse\x12%\n" +
+	"\acountry\x18\x01 \x01(\v2\v.pb.CountryR\acountryB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_update_country_proto_rawDescOnce sync.Once
+	file_rpc_update_country_proto_rawDescData []byte
+)
+
+func file_rpc_update_country_proto_rawDescGZIP() []byte {
+	file_rpc_update_country_proto_rawDescOnce.Do(func() {
+		file_rpc_update_country_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_update_country_proto_rawDesc), len(file_rpc_update_country_proto_rawDesc)))
+	})
+	return file_rpc_update_country_proto_rawDescData
+}
+
+var file_rpc_update_country_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_update_country_proto_goTypes = []any{
+	(*UpdateCountryRequest)(nil),  // 0: pb.UpdateCountryRequest
+	(*UpdateCountryResponse)(nil), // 1: pb.UpdateCountryResponse
+	(*Country)(nil),               // 2: pb.Country
+}
+var file_rpc_update_country_proto_depIdxs = []int32{
+	2, // 0: pb.UpdateCountryResponse.country:type_name -> pb.Country
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_update_country_proto_init() }
+func file_rpc_update_country_proto_init() {
+	if File_rpc_update_country_proto != nil {
+		return
+	}
+	file_country_proto_init()
+	file_rpc_update_country_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_update_country_proto_rawDesc), len(file_rpc_update_country_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_update_country_proto_goTypes,
+		DependencyIndexes: file_rpc_update_country_proto_depIdxs,
+		MessageInfos:      file_rpc_update_country_proto_msgTypes,
+	}.Build()
+	File_rpc_update_country_proto = out.File
+	file_rpc_update_country_proto_goTypes = nil
+	file_rpc_update_country_proto_depIdxs = nil
+}
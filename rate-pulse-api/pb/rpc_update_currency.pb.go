@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_update_currency.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Only the fields that are set are changed.
+type UpdateCurrencyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyId     int32                  `protobuf:"varint,1,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	CurrencyCode   *string                `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3,oneof" json:"currency_code,omitempty"`
+	CurrencyName   *string                `protobuf:"bytes,3,opt,name=currency_name,json=currencyName,proto3,oneof" json:"currency_name,omitempty"`
+	CurrencySymbol *string                `protobuf:"bytes,4,opt,name=currency_symbol,json=currencySymbol,proto3,oneof" json:"currency_symbol,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateCurrencyRequest) Reset() {
+	*x = UpdateCurrencyRequest{}
+	mi := &file_rpc_update_currency_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCurrencyRequest) ProtoMessage() {}
+
+func (x *UpdateCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_currency_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_update_currency_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateCurrencyRequest) GetCurrencyId() int32 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *UpdateCurrencyRequest) GetCurrencyCode() string {
+	if x != nil && x.CurrencyCode != nil {
+		return *x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *UpdateCurrencyRequest) GetCurrencyName() string {
+	if x != nil && x.CurrencyName != nil {
+		return *x.CurrencyName
+	}
+	return ""
+}
+
+func (x *UpdateCurrencyRequest) GetCurrencySymbol() string {
+	if x != nil && x.CurrencySymbol != nil {
+		return *x.CurrencySymbol
+	}
+	return ""
+}
+
+type UpdateCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      *Currency              `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCurrencyResponse) Reset() {
+	*x = UpdateCurrencyResponse{}
+	mi := &file_rpc_update_currency_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCurrencyResponse) ProtoMessage() {}
+
+func (x *UpdateCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_currency_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_update_currency_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateCurrencyResponse) GetCurrency() *Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+var File_rpc_update_currency_proto protoreflect.FileDescriptor
+
+const file_rpc_update_currency_proto_rawDesc = "" +
+	"\n" +
+	"\x19rpc_update_currency.proto\x12\x02pb\x1a\x0ecurrency.proto\"\xf2\x01\n" +
+	"\x15UpdateCurrencyRequest\x12\x1f\n" +
+	"\vcurrency_id\x18\x01 \x01(\x05R\n" +
+	"currencyId\x12(\n" +
+	"\rcurrency_code\x18\x02 \x01(\tH\x00R\fcurrencyCode\x88\x01\x01\x12(\n" +
+	"\rcurrency_name\x18\x03 \x01(\tH\x01R\fcurrencyName\x88\x01\x01\x12,\n" +
+	"\x0fcurrency_symbol\x18\x04 \x01(\tH\x02R\x0ecurrencySymbol\x88\x01\x01B\x10\n" +
+	"\x0e_currency_codeB\x10\n" +
+	"\x0e_currency_nameB\x12\n" +
+	"\x10_currency_symbol\"B\n" +
+	"\x16UpdateCurrencyResponse\x12(\n" +
+	"\bcurrency\x18\x01 \x01(\v2\f.pb.CurrencyR\bcurrencyB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_update_currency_proto_rawDescOnce sync.Once
+	file_rpc_update_currency_proto_rawDescData []byte
+)
+
+func file_rpc_update_currency_proto_rawDescGZIP() []byte {
+	file_rpc_update_currency_proto_rawDescOnce.Do(func() {
+		file_rpc_update_currency_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_update_currency_proto_rawDesc), len(file_rpc_update_currency_proto_rawDesc)))
+	})
+	return file_rpc_update_currency_proto_rawDescData
+}
+
+var file_rpc_update_currency_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_update_currency_proto_goTypes = []any{
+	(*UpdateCurrencyRequest)(nil),  // 0: pb.UpdateCurrencyRequest
+	(*UpdateCurrencyResponse)(nil), // 1: pb.UpdateCurrencyResponse
+	(*Currency)(nil),               // 2: pb.Currency
+}
+var file_rpc_update_currency_proto_depIdxs = []int32{
+	2, // 0: pb.UpdateCurrencyResponse.currency:type_name -> pb.Currency
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_update_currency_proto_init() }
+func file_rpc_update_currency_proto_init() {
+	if File_rpc_update_currency_proto != nil {
+		return
+	}
+	file_currency_proto_init()
+	file_rpc_update_currency_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_update_currency_proto_rawDesc), len(file_rpc_update_currency_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_update_currency_proto_goTypes,
+		DependencyIndexes: file_rpc_update_currency_proto_depIdxs,
+		MessageInfos:      file_rpc_update_currency_proto_msgTypes,
+	}.Build()
+	File_rpc_update_currency_proto = out.File
+	file_rpc_update_currency_proto_goTypes = nil
+	file_rpc_update_currency_proto_depIdxs = nil
+}
@@ -0,0 +1,198 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_update_currency_preference.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Only the fields that are set are changed.
+type UpdateCurrencyPreferenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyId    int32                  `protobuf:"varint,1,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	IsFavorite    *bool                  `protobuf:"varint,2,opt,name=is_favorite,json=isFavorite,proto3,oneof" json:"is_favorite,omitempty"`
+	DisplayOrder  *int32                 `protobuf:"varint,3,opt,name=display_order,json=displayOrder,proto3,oneof" json:"display_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCurrencyPreferenceRequest) Reset() {
+	*x = UpdateCurrencyPreferenceRequest{}
+	mi := &file_rpc_update_currency_preference_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCurrencyPreferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCurrencyPreferenceRequest) ProtoMessage() {}
+
+func (x *UpdateCurrencyPreferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_currency_preference_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCurrencyPreferenceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCurrencyPreferenceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_update_currency_preference_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateCurrencyPreferenceRequest) GetCurrencyId() int32 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *UpdateCurrencyPreferenceRequest) GetIsFavorite() bool {
+	if x != nil && x.IsFavorite != nil {
+		return *x.IsFavorite
+	}
+	return false
+}
+
+func (x *UpdateCurrencyPreferenceRequest) GetDisplayOrder() int32 {
+	if x != nil && x.DisplayOrder != nil {
+		return *x.DisplayOrder
+	}
+	return 0
+}
+
+type UpdateCurrencyPreferenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preference    *CurrencyPreference    `protobuf:"bytes,1,opt,name=preference,proto3" json:"preference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCurrencyPreferenceResponse) Reset() {
+	*x = UpdateCurrencyPreferenceResponse{}
+	mi := &file_rpc_update_currency_preference_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCurrencyPreferenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCurrencyPreferenceResponse) ProtoMessage() {}
+
+func (x *UpdateCurrencyPreferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_currency_preference_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCurrencyPreferenceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCurrencyPreferenceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_update_currency_preference_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateCurrencyPreferenceResponse) GetPreference() *CurrencyPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+var File_rpc_update_currency_preference_proto protoreflect.FileDescriptor
+
+const file_rpc_update_currency_preference_proto_rawDesc = "" +
+	"\n" +
+	"$rpc_update_currency_preference.proto\x12\x02pb\x1a\x19currency_preference.proto\"\xb4\x01\n" +
+	"\x1fUpdateCurrencyPreferenceRequest\x12\x1f\n" +
+	"\vcurrency_id\x18\x01 \x01(\x05R\n" +
+	"currencyId\x12$\n" +
+	"\vis_favorite\x18\x02 \x01(\bH\x00R\n" +
+	"isFavorite\x88\x01\x01\x12(\n" +
+	"\rdisplay_order\x18\x03 \x01(\x05H\x01R\fdisplayOrder\x88\x01\x01B\x0e\n" +
+	"\f_is_favoriteB\x10\n" +
+	"\x0e_display_order\"Z\n" +
+	" UpdateCurrencyPreferenceResponse\x126\n" +
+	"\n" +
+	"preference\x18\x01 \x01(\v2\x16.pb.CurrencyPreferenceR\n" +
+	"preferenceB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_update_currency_preference_proto_rawDescOnce sync.Once
+	file_rpc_update_currency_preference_proto_rawDescData []byte
+)
+
+func file_rpc_update_currency_preference_proto_rawDescGZIP() []byte {
+	file_rpc_update_currency_preference_proto_rawDescOnce.Do(func() {
+		file_rpc_update_currency_preference_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_update_currency_preference_proto_rawDesc), len(file_rpc_update_currency_preference_proto_rawDesc)))
+	})
+	return file_rpc_update_currency_preference_proto_rawDescData
+}
+
+var file_rpc_update_currency_preference_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_update_currency_preference_proto_goTypes = []any{
+	(*UpdateCurrencyPreferenceRequest)(nil),  // 0: pb.UpdateCurrencyPreferenceRequest
+	(*UpdateCurrencyPreferenceResponse)(nil), // 1: pb.UpdateCurrencyPreferenceResponse
+	(*CurrencyPreference)(nil),               // 2: pb.CurrencyPreference
+}
+var file_rpc_update_currency_preference_proto_depIdxs = []int32{
+	2, // 0: pb.UpdateCurrencyPreferenceResponse.preference:type_name -> pb.CurrencyPreference
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_update_currency_preference_proto_init() }
+func file_rpc_update_currency_preference_proto_init() {
+	if File_rpc_update_currency_preference_proto != nil {
+		return
+	}
+	file_currency_preference_proto_init()
+	file_rpc_update_currency_preference_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_update_currency_preference_proto_rawDesc), len(file_rpc_update_currency_preference_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_update_currency_preference_proto_goTypes,
+		DependencyIndexes: file_rpc_update_currency_preference_proto_depIdxs,
+		MessageInfos:      file_rpc_update_currency_preference_proto_msgTypes,
+	}.Build()
+	File_rpc_update_currency_preference_proto = out.File
+	file_rpc_update_currency_preference_proto_goTypes = nil
+	file_rpc_update_currency_preference_proto_depIdxs = nil
+}
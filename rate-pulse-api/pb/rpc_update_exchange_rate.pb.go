@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_update_exchange_rate.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Only the fields that are set are changed.
+type UpdateExchangeRateRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RateId                int32                  `protobuf:"varint,1,opt,name=rate_id,json=rateId,proto3" json:"rate_id,omitempty"`
+	RateValue             *string                `protobuf:"bytes,2,opt,name=rate_value,json=rateValue,proto3,oneof" json:"rate_value,omitempty"`
+	SourceCurrencyId      *int32                 `protobuf:"varint,3,opt,name=source_currency_id,json=sourceCurrencyId,proto3,oneof" json:"source_currency_id,omitempty"`
+	DestinationCurrencyId *int32                 `protobuf:"varint,4,opt,name=destination_currency_id,json=destinationCurrencyId,proto3,oneof" json:"destination_currency_id,omitempty"`
+	ValidFromDate         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_from_date,json=validFromDate,proto3" json:"valid_from_date,omitempty"`
+	ValidToDate           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_to_date,json=validToDate,proto3" json:"valid_to_date,omitempty"`
+	SourceId              *int32                 `protobuf:"varint,7,opt,name=source_id,json=sourceId,proto3,oneof" json:"source_id,omitempty"`
+	TypeId                *int32                 `protobuf:"varint,8,opt,name=type_id,json=typeId,proto3,oneof" json:"type_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateExchangeRateRequest) Reset() {
+	*x = UpdateExchangeRateRequest{}
+	mi := &file_rpc_update_exchange_rate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExchangeRateRequest) ProtoMessage() {}
+
+func (x *UpdateExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_exchange_rate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_update_exchange_rate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateExchangeRateRequest) GetRateId() int32 {
+	if x != nil {
+		return x.RateId
+	}
+	return 0
+}
+
+func (x *UpdateExchangeRateRequest) GetRateValue() string {
+	if x != nil && x.RateValue != nil {
+		return *x.RateValue
+	}
+	return ""
+}
+
+func (x *UpdateExchangeRateRequest) GetSourceCurrencyId() int32 {
+	if x != nil && x.SourceCurrencyId != nil {
+		return *x.SourceCurrencyId
+	}
+	return 0
+}
+
+func (x *UpdateExchangeRateRequest) GetDestinationCurrencyId() int32 {
+	if x != nil && x.DestinationCurrencyId != nil {
+		return *x.DestinationCurrencyId
+	}
+	return 0
+}
+
+func (x *UpdateExchangeRateRequest) GetValidFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFromDate
+	}
+	return nil
+}
+
+func (x *UpdateExchangeRateRequest) GetValidToDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidToDate
+	}
+	return nil
+}
+
+func (x *UpdateExchangeRateRequest) GetSourceId() int32 {
+	if x != nil && x.SourceId != nil {
+		return *x.SourceId
+	}
+	return 0
+}
+
+func (x *UpdateExchangeRateRequest) GetTypeId() int32 {
+	if x != nil && x.TypeId != nil {
+		return *x.TypeId
+	}
+	return 0
+}
+
+type UpdateExchangeRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeRate  *ExchangeRate          `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExchangeRateResponse) Reset() {
+	*x = UpdateExchangeRateResponse{}
+	mi := &file_rpc_update_exchange_rate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExchangeRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExchangeRateResponse) ProtoMessage() {}
+
+func (x *UpdateExchangeRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_exchange_rate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExchangeRateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_update_exchange_rate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateExchangeRateResponse) GetExchangeRate() *ExchangeRate {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return nil
+}
+
+var File_rpc_update_exchange_rate_proto protoreflect.FileDescriptor
+
+const file_rpc_update_exchange_rate_proto_rawDesc = "" +
+	"\n" +
+	"\x1erpc_update_exchange_rate.proto\x12\x02pb\x1a\x13exchange_rate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe8\x03\n" +
+	"\x19UpdateExchangeRateRequest\x12\x17\n" +
+	"\arate_id\x18\x01 \x01(\x05R\x06rateId\x12\"\n" +
+	"\n" +
+	"rate_value\x18\x02 \x01(\tH\x00R\trateValue\x88\x01\x01\x121\n" +
+	"\x12source_currency_id\x18\x03 \x01(\x05H\x01R\x10sourceCurrencyId\x88\x01\x01\x12;\n" +
+	"\x17destination_currency_id\x18\x04 \x01(\x05H\x02R\x15destinationCurrencyId\x88\x01\x01\x12B\n" +
+	"\x0fvalid_from_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rvalidFromDate\x12>\n" +
+	"\rvalid_to_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vvalidToDate\x12 \n" +
+	"\tsource_id\x18\a \x01(\x05H\x03R\bsourceId\x88\x01\x01\x12\x1c\n" +
+	"\atype_id\x18\b \x01(\x05H\x04R\x06typeId\x88\x01\x01B\r\n" +
+	"\v_rate_valueB\x15\n" +
+	"\x13_source_currency_idB\x1a\n" +
+	"\x18_destination_currency_idB\f\n" +
+	"\n" +
+	"_source_idB\n" +
+	"\n" +
+	"\b_type_id\"S\n" +
+	"\x1aUpdateExchangeRateResponse\x125\n" +
+	"\rexchange_rate\x18\x01 \x01(\v2\x10.pb.ExchangeRateR\fexchangeRateB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_update_exchange_rate_proto_rawDescOnce sync.Once
+	file_rpc_update_exchange_rate_proto_rawDescData []byte
+)
+
+func file_rpc_update_exchange_rate_proto_rawDescGZIP() []byte {
+	file_rpc_update_exchange_rate_proto_rawDescOnce.Do(func() {
+		file_rpc_update_exchange_rate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_update_exchange_rate_proto_rawDesc), len(file_rpc_update_exchange_rate_proto_rawDesc)))
+	})
+	return file_rpc_update_exchange_rate_proto_rawDescData
+}
+
+var file_rpc_update_exchange_rate_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_update_exchange_rate_proto_goTypes = []any{
+	(*UpdateExchangeRateRequest)(nil),  // 0: pb.UpdateExchangeRateRequest
+	(*UpdateExchangeRateResponse)(nil), // 1: pb.UpdateExchangeRateResponse
+	(*timestamppb.Timestamp)(nil),      // 2: google.protobuf.Timestamp
+	(*ExchangeRate)(nil),               // 3: pb.ExchangeRate
+}
+var file_rpc_update_exchange_rate_proto_depIdxs = []int32{
+	2, // 0: pb.UpdateExchangeRateRequest.valid_from_date:type_name -> google.protobuf.Timestamp
+	2, // 1: pb.UpdateExchangeRateRequest.valid_to_date:type_name -> google.protobuf.Timestamp
+	3, // 2: pb.UpdateExchangeRateResponse.exchange_rate:type_name -> pb.ExchangeRate
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_update_exchange_rate_proto_init() }
+func file_rpc_update_exchange_rate_proto_init() {
+	if File_rpc_update_exchange_rate_proto != nil {
+		return
+	}
+	file_exchange_rate_proto_init()
+	file_rpc_update_exchange_rate_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_update_exchange_rate_proto_rawDesc), len(file_rpc_update_exchange_rate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_update_exchange_rate_proto_goTypes,
+		DependencyIndexes: file_rpc_update_exchange_rate_proto_depIdxs,
+		MessageInfos:      file_rpc_update_exchange_rate_proto_msgTypes,
+	}.Build()
+	File_rpc_update_exchange_rate_proto = out.File
+	file_rpc_update_exchange_rate_proto_goTypes = nil
+	file_rpc_update_exchange_rate_proto_depIdxs = nil
+}
@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_update_payment.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Only the fields that are set are changed.
+type UpdatePaymentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId      int32                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	SubscriptionId *int32                 `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty"`
+	TransactionId  *string                `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	Amount         *string                `protobuf:"bytes,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	CurrencyCode   *string                `protobuf:"bytes,5,opt,name=currency_code,json=currencyCode,proto3,oneof" json:"currency_code,omitempty"`
+	PaymentMethod  *string                `protobuf:"bytes,6,opt,name=payment_method,json=paymentMethod,proto3,oneof" json:"payment_method,omitempty"`
+	PaymentStatus  *string                `protobuf:"bytes,7,opt,name=payment_status,json=paymentStatus,proto3,oneof" json:"payment_status,omitempty"`
+	PaymentDate    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=payment_date,json=paymentDate,proto3" json:"payment_date,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdatePaymentRequest) Reset() {
+	*x = UpdatePaymentRequest{}
+	mi := &file_rpc_update_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePaymentRequest) ProtoMessage() {}
+
+func (x *UpdatePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePaymentRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_update_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdatePaymentRequest) GetPaymentId() int32 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *UpdatePaymentRequest) GetSubscriptionId() int32 {
+	if x != nil && x.SubscriptionId != nil {
+		return *x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *UpdatePaymentRequest) GetTransactionId() string {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return ""
+}
+
+func (x *UpdatePaymentRequest) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return ""
+}
+
+func (x *UpdatePaymentRequest) GetCurrencyCode() string {
+	if x != nil && x.CurrencyCode != nil {
+		return *x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *UpdatePaymentRequest) GetPaymentMethod() string {
+	if x != nil && x.PaymentMethod != nil {
+		return *x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *UpdatePaymentRequest) GetPaymentStatus() string {
+	if x != nil && x.PaymentStatus != nil {
+		return *x.PaymentStatus
+	}
+	return ""
+}
+
+func (x *UpdatePaymentRequest) GetPaymentDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaymentDate
+	}
+	return nil
+}
+
+type UpdatePaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePaymentResponse) Reset() {
+	*x = UpdatePaymentResponse{}
+	mi := &file_rpc_update_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePaymentResponse) ProtoMessage() {}
+
+func (x *UpdatePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePaymentResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_update_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdatePaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+var File_rpc_update_payment_proto protoreflect.FileDescriptor
+
+const file_rpc_update_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x18rpc_update_payment.proto\x12\x02pb\x1a\rpayment.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd7\x03\n" +
+	"\x14UpdatePaymentRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x05R\tpaymentId\x12,\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\x05H\x00R\x0esubscriptionId\x88\x01\x01\x12*\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tH\x01R\rtransactionId\x88\x01\x01\x12\x1b\n" +
+	"\x06amount\x18\x04 \x01(\tH\x02R\x06amount\x88\x01\x01\x12(\n" +
+	"\rcurrency_code\x18\x05 \x01(\tH\x03R\fcurrencyCode\x88\x01\x01\x12*\n" +
+	"\x0epayment_method\x18\x06 \x01(\tH\x04R\rpaymentMethod\x88\x01\x01\x12*\n" +
+	"\x0epayment_status\x18\a \x01(\tH\x05R\rpaymentStatus\x88\x01\x01\x12=\n" +
+	"\fpayment_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vpaymentDateB\x12\n" +
+	"\x10_subscription_idB\x11\n" +
+	"\x0f_transaction_idB\t\n" +
+	"\a_amountB\x10\n" +
+	"\x0e_currency_codeB\x11\n" +
+	"\x0f_payment_methodB\x11\n" +
+	"\x0f_payment_status\">\n" +
+	"\x15UpdatePaymentResponse\x12%\n" +
+	"\apayment\x18\x01 \x01(\v2\v.pb.PaymentR\apaymentB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_update_payment_proto_rawDescOnce sync.Once
+	file_rpc_update_payment_proto_rawDescData []byte
+)
+
+func file_rpc_update_payment_proto_rawDescGZIP() []byte {
+	file_rpc_update_payment_proto_rawDescOnce.Do(func() {
+		file_rpc_update_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_update_payment_proto_rawDesc), len(file_rpc_update_payment_proto_rawDesc)))
+	})
+	return file_rpc_update_payment_proto_rawDescData
+}
+
+var file_rpc_update_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_update_payment_proto_goTypes = []any{
+	(*UpdatePaymentRequest)(nil),  // 0: pb.UpdatePaymentRequest
+	(*UpdatePaymentResponse)(nil), // 1: pb.UpdatePaymentResponse
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*Payment)(nil),               // 3: pb.Payment
+}
+var file_rpc_update_payment_proto_depIdxs = []int32{
+	2, // 0: pb.UpdatePaymentRequest.payment_date:type_name -> google.protobuf.Timestamp
+	3, // 1: pb.UpdatePaymentResponse.payment:type_name -> pb.Payment
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_update_payment_proto_init() }
+func file_rpc_update_payment_proto_init() {
+	if File_rpc_update_payment_proto != nil {
+		return
+	}
+	file_payment_proto_init()
+	file_rpc_update_payment_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_update_payment_proto_rawDesc), len(file_rpc_update_payment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_update_payment_proto_goTypes,
+		DependencyIndexes: file_rpc_update_payment_proto_depIdxs,
+		MessageInfos:      file_rpc_update_payment_proto_msgTypes,
+	}.Build()
+	File_rpc_update_payment_proto = out.File
+	file_rpc_update_payment_proto_goTypes = nil
+	file_rpc_update_payment_proto_depIdxs = nil
+}
@@ -0,0 +1,227 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_update_rate_alert.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Only the fields that are set are changed.
+type UpdateRateAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlertId       int32                  `protobuf:"varint,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	Condition     *string                `protobuf:"bytes,2,opt,name=condition,proto3,oneof" json:"condition,omitempty"`
+	Threshold     *string                `protobuf:"bytes,3,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
+	ChangePercent *string                `protobuf:"bytes,4,opt,name=change_percent,json=changePercent,proto3,oneof" json:"change_percent,omitempty"`
+	WindowHours   *int32                 `protobuf:"varint,5,opt,name=window_hours,json=windowHours,proto3,oneof" json:"window_hours,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRateAlertRequest) Reset() {
+	*x = UpdateRateAlertRequest{}
+	mi := &file_rpc_update_rate_alert_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRateAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRateAlertRequest) ProtoMessage() {}
+
+func (x *UpdateRateAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_rate_alert_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRateAlertRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRateAlertRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_update_rate_alert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateRateAlertRequest) GetAlertId() int32 {
+	if x != nil {
+		return x.AlertId
+	}
+	return 0
+}
+
+func (x *UpdateRateAlertRequest) GetCondition() string {
+	if x != nil && x.Condition != nil {
+		return *x.Condition
+	}
+	return ""
+}
+
+func (x *UpdateRateAlertRequest) GetThreshold() string {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return ""
+}
+
+func (x *UpdateRateAlertRequest) GetChangePercent() string {
+	if x != nil && x.ChangePercent != nil {
+		return *x.ChangePercent
+	}
+	return ""
+}
+
+func (x *UpdateRateAlertRequest) GetWindowHours() int32 {
+	if x != nil && x.WindowHours != nil {
+		return *x.WindowHours
+	}
+	return 0
+}
+
+func (x *UpdateRateAlertRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type UpdateRateAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alert         *RateAlert             `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRateAlertResponse) Reset() {
+	*x = UpdateRateAlertResponse{}
+	mi := &file_rpc_update_rate_alert_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRateAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRateAlertResponse) ProtoMessage() {}
+
+func (x *UpdateRateAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_rate_alert_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRateAlertResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRateAlertResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_update_rate_alert_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateRateAlertResponse) GetAlert() *RateAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+var File_rpc_update_rate_alert_proto protoreflect.FileDescriptor
+
+const file_rpc_update_rate_alert_proto_rawDesc = "" +
+	"\n" +
+	"\x1brpc_update_rate_alert.proto\x12\x02pb\x1a\x10rate_alert.proto\"\xbd\x02\n" +
+	"\x16UpdateRateAlertRequest\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\x05R\aalertId\x12!\n" +
+	"\tcondition\x18\x02 \x01(\tH\x00R\tcondition\x88\x01\x01\x12!\n" +
+	"\tthreshold\x18\x03 \x01(\tH\x01R\tthreshold\x88\x01\x01\x12*\n" +
+	"\x0echange_percent\x18\x04 \x01(\tH\x02R\rchangePercent\x88\x01\x01\x12&\n" +
+	"\fwindow_hours\x18\x05 \x01(\x05H\x03R\vwindowHours\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x06 \x01(\bH\x04R\bisActive\x88\x01\x01B\f\n" +
+	"\n" +
+	"_conditionB\f\n" +
+	"\n" +
+	"_thresholdB\x11\n" +
+	"\x0f_change_percentB\x0f\n" +
+	"\r_window_hoursB\f\n" +
+	"\n" +
+	"_is_active\">\n" +
+	"\x17UpdateRateAlertResponse\x12#\n" +
+	"\x05alert\x18\x01 \x01(\v2\r.pb.RateAlertR\x05alertB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_update_rate_alert_proto_rawDescOnce sync.Once
+	file_rpc_update_rate_alert_proto_rawDescData []byte
+)
+
+func file_rpc_update_rate_alert_proto_rawDescGZIP() []byte {
+	file_rpc_update_rate_alert_proto_rawDescOnce.Do(func() {
+		file_rpc_update_rate_alert_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_update_rate_alert_proto_rawDesc), len(file_rpc_update_rate_alert_proto_rawDesc)))
+	})
+	return file_rpc_update_rate_alert_proto_rawDescData
+}
+
+var file_rpc_update_rate_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_update_rate_alert_proto_goTypes = []any{
+	(*UpdateRateAlertRequest)(nil),  // 0: pb.UpdateRateAlertRequest
+	(*UpdateRateAlertResponse)(nil), // 1: pb.UpdateRateAlertResponse
+	(*RateAlert)(nil),               // 2: pb.RateAlert
+}
+var file_rpc_update_rate_alert_proto_depIdxs = []int32{
+	2, // 0: pb.UpdateRateAlertResponse.alert:type_name -> pb.RateAlert
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_update_rate_alert_proto_init() }
+func file_rpc_update_rate_alert_proto_init() {
+	if File_rpc_update_rate_alert_proto != nil {
+		return
+	}
+	file_rate_alert_proto_init()
+	file_rpc_update_rate_alert_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_update_rate_alert_proto_rawDesc), len(file_rpc_update_rate_alert_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_update_rate_alert_proto_goTypes,
+		DependencyIndexes: file_rpc_update_rate_alert_proto_depIdxs,
+		MessageInfos:      file_rpc_update_rate_alert_proto_msgTypes,
+	}.Build()
+	File_rpc_update_rate_alert_proto = out.File
+	file_rpc_update_rate_alert_proto_goTypes = nil
+	file_rpc_update_rate_alert_proto_depIdxs = nil
+}
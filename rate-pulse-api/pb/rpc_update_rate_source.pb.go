@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_update_rate_source.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Only the fields that are set are changed.
+type UpdateRateSourceRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	SourceId                int32                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	SourceName              *string                `protobuf:"bytes,2,opt,name=source_name,json=sourceName,proto3,oneof" json:"source_name,omitempty"`
+	SourceLink              *string                `protobuf:"bytes,3,opt,name=source_link,json=sourceLink,proto3,oneof" json:"source_link,omitempty"`
+	SourceCountry           *string                `protobuf:"bytes,4,opt,name=source_country,json=sourceCountry,proto3,oneof" json:"source_country,omitempty"`
+	SourceStatus            *string                `protobuf:"bytes,5,opt,name=source_status,json=sourceStatus,proto3,oneof" json:"source_status,omitempty"`
+	SourceCode              *string                `protobuf:"bytes,6,opt,name=source_code,json=sourceCode,proto3,oneof" json:"source_code,omitempty"`
+	ExpectedIntervalMinutes *int32                 `protobuf:"varint,7,opt,name=expected_interval_minutes,json=expectedIntervalMinutes,proto3,oneof" json:"expected_interval_minutes,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UpdateRateSourceRequest) Reset() {
+	*x = UpdateRateSourceRequest{}
+	mi := &file_rpc_update_rate_source_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRateSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRateSourceRequest) ProtoMessage() {}
+
+func (x *UpdateRateSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_rate_source_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRateSourceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRateSourceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_update_rate_source_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateRateSourceRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *UpdateRateSourceRequest) GetSourceName() string {
+	if x != nil && x.SourceName != nil {
+		return *x.SourceName
+	}
+	return ""
+}
+
+func (x *UpdateRateSourceRequest) GetSourceLink() string {
+	if x != nil && x.SourceLink != nil {
+		return *x.SourceLink
+	}
+	return ""
+}
+
+func (x *UpdateRateSourceRequest) GetSourceCountry() string {
+	if x != nil && x.SourceCountry != nil {
+		return *x.SourceCountry
+	}
+	return ""
+}
+
+func (x *UpdateRateSourceRequest) GetSourceStatus() string {
+	if x != nil && x.SourceStatus != nil {
+		return *x.SourceStatus
+	}
+	return ""
+}
+
+func (x *UpdateRateSourceRequest) GetSourceCode() string {
+	if x != nil && x.SourceCode != nil {
+		return *x.SourceCode
+	}
+	return ""
+}
+
+func (x *UpdateRateSourceRequest) GetExpectedIntervalMinutes() int32 {
+	if x != nil && x.ExpectedIntervalMinutes != nil {
+		return *x.ExpectedIntervalMinutes
+	}
+	return 0
+}
+
+type UpdateRateSourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RateSource    *RateSource            `protobuf:"bytes,1,opt,name=rate_source,json=rateSource,proto3" json:"rate_source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRateSourceResponse) Reset() {
+	*x = UpdateRateSourceResponse{}
+	mi := &file_rpc_update_rate_source_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRateSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRateSourceResponse) ProtoMessage() {}
+
+func (x *UpdateRateSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_rate_source_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRateSourceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRateSourceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_update_rate_source_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateRateSourceResponse) GetRateSource() *RateSource {
+	if x != nil {
+		return x.RateSource
+	}
+	return nil
+}
+
+var File_rpc_update_rate_source_proto protoreflect.FileDescriptor
+
+const file_rpc_update_rate_source_proto_rawDesc = "" +
+	"\n" +
+	"\x1crpc_update_rate_source.proto\x12\x02pb\x1a\x11rate_source.proto\"\xb2\x03\n" +
+	"\x17UpdateRateSourceRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x05R\bsourceId\x12$\n" +
+	"\vsource_name\x18\x02 \x01(\tH\x00R\n" +
+	"sourceName\x88\x01\x01\x12$\n" +
+	"\vsource_link\x18\x03 \x01(\tH\x01R\n" +
+	"sourceLink\x88\x01\x01\x12*\n" +
+	"\x0esource_country\x18\x04 \x01(\tH\x02R\rsourceCountry\x88\x01\x01\x12(\n" +
+	"\rsource_status\x18\x05 \x01(\tH\x03R\fsourceStatus\x88\x01\x01\x12$\n" +
+	"\vsource_code\x18\x06 \x01(\tH\x04R\n" +
+	"sourceCode\x88\x01\x01\x12?\n" +
+	"\x19expected_interval_minutes\x18\a \x01(\x05H\x05R\x17expectedIntervalMinutes\x88\x01\x01B\x0e\n" +
+	"\f_source_nameB\x0e\n" +
+	"\f_source_linkB\x11\n" +
+	"\x0f_source_countryB\x10\n" +
+	"\x0e_source_statusB\x0e\n" +
+	"\f_source_codeB\x1c\n" +
+	"\x1a_expected_interval_minutes\"K\n" +
+	"\x18UpdateRateSourceResponse\x12/\n" +
+	"\vrate_source\x18\x01 \x01(\v2\x0e.pb.RateSourceR\n" +
+	"rateSourceB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_update_rate_source_proto_rawDescOnce sync.Once
+	file_rpc_update_rate_source_proto_rawDescData []byte
+)
+
+func file_rpc_update_rate_source_proto_rawDescGZIP() []byte {
+	file_rpc_update_rate_source_proto_rawDescOnce.Do(func() {
+		file_rpc_update_rate_source_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_update_rate_source_proto_rawDesc), len(file_rpc_update_rate_source_proto_rawDesc)))
+	})
+	return file_rpc_update_rate_source_proto_rawDescData
+}
+
+var file_rpc_update_rate_source_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_update_rate_source_proto_goTypes = []any{
+	(*UpdateRateSourceRequest)(nil),  // 0: pb.UpdateRateSourceRequest
+	(*UpdateRateSourceResponse)(nil), // 1: pb.UpdateRateSourceResponse
+	(*RateSource)(nil),               // 2: pb.RateSource
+}
+var file_rpc_update_rate_source_proto_depIdxs = []int32{
+	2, // 0: pb.UpdateRateSourceResponse.rate_source:type_name -> pb.RateSource
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_update_rate_source_proto_init() }
+func file_rpc_update_rate_source_proto_init() {
+	if File_rpc_update_rate_source_proto != nil {
+		return
+	}
+	file_rate_source_proto_init()
+	file_rpc_update_rate_source_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_update_rate_source_proto_rawDesc), len(file_rpc_update_rate_source_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_update_rate_source_proto_goTypes,
+		DependencyIndexes: file_rpc_update_rate_source_proto_depIdxs,
+		MessageInfos:      file_rpc_update_rate_source_proto_msgTypes,
+	}.Build()
+	File_rpc_update_rate_source_proto = out.File
+	file_rpc_update_rate_source_proto_goTypes = nil
+	file_rpc_update_rate_source_proto_depIdxs = nil
+}
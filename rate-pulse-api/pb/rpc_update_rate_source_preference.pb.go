@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_update_rate_source_preference.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Only the fields that are set are changed.
+type UpdateRateSourcePreferenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      int32                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	IsPrimary     *bool                  `protobuf:"varint,2,opt,name=is_primary,json=isPrimary,proto3,oneof" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRateSourcePreferenceRequest) Reset() {
+	*x = UpdateRateSourcePreferenceRequest{}
+	mi := &file_rpc_update_rate_source_preference_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRateSourcePreferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRateSourcePreferenceRequest) ProtoMessage() {}
+
+func (x *UpdateRateSourcePreferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_rate_source_preference_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRateSourcePreferenceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRateSourcePreferenceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_update_rate_source_preference_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateRateSourcePreferenceRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *UpdateRateSourcePreferenceRequest) GetIsPrimary() bool {
+	if x != nil && x.IsPrimary != nil {
+		return *x.IsPrimary
+	}
+	return false
+}
+
+type UpdateRateSourcePreferenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preference    *RateSourcePreference  `protobuf:"bytes,1,opt,name=preference,proto3" json:"preference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRateSourcePreferenceResponse) Reset() {
+	*x = UpdateRateSourcePreferenceResponse{}
+	mi := &file_rpc_update_rate_source_preference_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRateSourcePreferenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRateSourcePreferenceResponse) ProtoMessage() {}
+
+func (x *UpdateRateSourcePreferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_rate_source_preference_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRateSourcePreferenceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRateSourcePreferenceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_update_rate_source_preference_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateRateSourcePreferenceResponse) GetPreference() *RateSourcePreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+var File_rpc_update_rate_source_preference_proto protoreflect.FileDescriptor
+
+const file_rpc_update_rate_source_preference_proto_rawDesc = "" +
+	"\n" +
+	"'rpc_update_rate_source_preference.proto\x12\x02pb\x1a\x1crate_source_preference.proto\"s\n" +
+	"!UpdateRateSourcePreferenceRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x05R\bsourceId\x12\"\n" +
+	"\n" +
+	"is_primary\x18\x02 \x01(\bH\x00R\tisPrimary\x88\x01\x01B\r\n" +
+	"\v_is_primary\"^\n" +
+	"\"UpdateRateSourcePreferenceResponse\x128\n" +
+	"\n" +
+	"preference\x18\x01 \x01(\v2\x18.pb.RateSourcePreferenceR\n" +
+	"preferenceB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_update_rate_source_preference_proto_rawDescOnce sync.Once
+	file_rpc_update_rate_source_preference_proto_rawDescData []byte
+)
+
+func file_rpc_update_rate_source_preference_proto_rawDescGZIP() []byte {
+	file_rpc_update_rate_source_preference_proto_rawDescOnce.Do(func() {
+		file_rpc_update_rate_source_preference_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_update_rate_source_preference_proto_rawDesc), len(file_rpc_update_rate_source_preference_proto_rawDesc)))
+	})
+	return file_rpc_update_rate_source_preference_proto_rawDescData
+}
+
+var file_rpc_update_rate_source_preference_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_update_rate_source_preference_proto_goTypes = []any{
+	(*UpdateRateSourcePreferenceRequest)(nil),  // 0: pb.UpdateRateSourcePreferenceRequest
+	(*UpdateRateSourcePreferenceResponse)(nil), // 1: pb.UpdateRateSourcePreferenceResponse
+	(*RateSourcePreference)(nil),               // 2: pb.RateSourcePreference
+}
+var file_rpc_update_rate_source_preference_proto_depIdxs = []int32{
+	2, // 0: pb.UpdateRateSourcePreferenceResponse.preference:type_name -> pb.RateSourcePreference
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_update_rate_source_preference_proto_init() }
+func file_rpc_update_rate_source_preference_proto_init() {
+	if File_rpc_update_rate_source_preference_proto != nil {
+		return
+	}
+	file_rate_source_preference_proto_init()
+	file_rpc_update_rate_source_preference_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_update_rate_source_preference_proto_rawDesc), len(file_rpc_update_rate_source_preference_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_update_rate_source_preference_proto_goTypes,
+		DependencyIndexes: file_rpc_update_rate_source_preference_proto_depIdxs,
+		MessageInfos:      file_rpc_update_rate_source_preference_proto_msgTypes,
+	}.Build()
+	File_rpc_update_rate_source_preference_proto = out.File
+	file_rpc_update_rate_source_preference_proto_goTypes = nil
+	file_rpc_update_rate_source_preference_proto_depIdxs = nil
+}
@@ -0,0 +1,237 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_update_subscription_plan.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Only the fields that are set are changed.
+type UpdateSubscriptionPlanRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PlanId          int32                  `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	PlanName        *string                `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3,oneof" json:"plan_name,omitempty"`
+	PlanPrice       *string                `protobuf:"bytes,3,opt,name=plan_price,json=planPrice,proto3,oneof" json:"plan_price,omitempty"`
+	HistoricalDays  *int32                 `protobuf:"varint,4,opt,name=historical_days,json=historicalDays,proto3,oneof" json:"historical_days,omitempty"`
+	RateLimitPerDay *int32                 `protobuf:"varint,5,opt,name=rate_limit_per_day,json=rateLimitPerDay,proto3,oneof" json:"rate_limit_per_day,omitempty"`
+	Features        *string                `protobuf:"bytes,6,opt,name=features,proto3,oneof" json:"features,omitempty"`
+	IsActive        *bool                  `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateSubscriptionPlanRequest) Reset() {
+	*x = UpdateSubscriptionPlanRequest{}
+	mi := &file_rpc_update_subscription_plan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubscriptionPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubscriptionPlanRequest) ProtoMessage() {}
+
+func (x *UpdateSubscriptionPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_subscription_plan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubscriptionPlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubscriptionPlanRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_update_subscription_plan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateSubscriptionPlanRequest) GetPlanId() int32 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *UpdateSubscriptionPlanRequest) GetPlanName() string {
+	if x != nil && x.PlanName != nil {
+		return *x.PlanName
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionPlanRequest) GetPlanPrice() string {
+	if x != nil && x.PlanPrice != nil {
+		return *x.PlanPrice
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionPlanRequest) GetHistoricalDays() int32 {
+	if x != nil && x.HistoricalDays != nil {
+		return *x.HistoricalDays
+	}
+	return 0
+}
+
+func (x *UpdateSubscriptionPlanRequest) GetRateLimitPerDay() int32 {
+	if x != nil && x.RateLimitPerDay != nil {
+		return *x.RateLimitPerDay
+	}
+	return 0
+}
+
+func (x *UpdateSubscriptionPlanRequest) GetFeatures() string {
+	if x != nil && x.Features != nil {
+		return *x.Features
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionPlanRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type UpdateSubscriptionPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *SubscriptionPlan      `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSubscriptionPlanResponse) Reset() {
+	*x = UpdateSubscriptionPlanResponse{}
+	mi := &file_rpc_update_subscription_plan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubscriptionPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubscriptionPlanResponse) ProtoMessage() {}
+
+func (x *UpdateSubscriptionPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_subscription_plan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubscriptionPlanResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSubscriptionPlanResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_update_subscription_plan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateSubscriptionPlanResponse) GetPlan() *SubscriptionPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+var File_rpc_update_subscription_plan_proto protoreflect.FileDescriptor
+
+const file_rpc_update_subscription_plan_proto_rawDesc = "" +
+	"\n" +
+	"\"rpc_update_subscription_plan.proto\x12\x02pb\x1a\x17subscription_plan.proto\"\x84\x03\n" +
+	"\x1dUpdateSubscriptionPlanRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\x05R\x06planId\x12 \n" +
+	"\tplan_name\x18\x02 \x01(\tH\x00R\bplanName\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"plan_price\x18\x03 \x01(\tH\x01R\tplanPrice\x88\x01\x01\x12,\n" +
+	"\x0fhistorical_days\x18\x04 \x01(\x05H\x02R\x0ehistoricalDays\x88\x01\x01\x120\n" +
+	"\x12rate_limit_per_day\x18\x05 \x01(\x05H\x03R\x0frateLimitPerDay\x88\x01\x01\x12\x1f\n" +
+	"\bfeatures\x18\x06 \x01(\tH\x04R\bfeatures\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\a \x01(\bH\x05R\bisActive\x88\x01\x01B\f\n" +
+	"\n" +
+	"_plan_nameB\r\n" +
+	"\v_plan_priceB\x12\n" +
+	"\x10_historical_daysB\x15\n" +
+	"\x13_rate_limit_per_dayB\v\n" +
+	"\t_featuresB\f\n" +
+	"\n" +
+	"_is_active\"J\n" +
+	"\x1eUpdateSubscriptionPlanResponse\x12(\n" +
+	"\x04plan\x18\x01 \x01(\v2\x14.pb.SubscriptionPlanR\x04planB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_update_subscription_plan_proto_rawDescOnce sync.Once
+	file_rpc_update_subscription_plan_proto_rawDescData []byte
+)
+
+func file_rpc_update_subscription_plan_proto_rawDescGZIP() []byte {
+	file_rpc_update_subscription_plan_proto_rawDescOnce.Do(func() {
+		file_rpc_update_subscription_plan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_update_subscription_plan_proto_rawDesc), len(file_rpc_update_subscription_plan_proto_rawDesc)))
+	})
+	return file_rpc_update_subscription_plan_proto_rawDescData
+}
+
+var file_rpc_update_subscription_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_update_subscription_plan_proto_goTypes = []any{
+	(*UpdateSubscriptionPlanRequest)(nil),  // 0: pb.UpdateSubscriptionPlanRequest
+	(*UpdateSubscriptionPlanResponse)(nil), // 1: pb.UpdateSubscriptionPlanResponse
+	(*SubscriptionPlan)(nil),               // 2: pb.SubscriptionPlan
+}
+var file_rpc_update_subscription_plan_proto_depIdxs = []int32{
+	2, // 0: pb.UpdateSubscriptionPlanResponse.plan:type_name -> pb.SubscriptionPlan
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_update_subscription_plan_proto_init() }
+func file_rpc_update_subscription_plan_proto_init() {
+	if File_rpc_update_subscription_plan_proto != nil {
+		return
+	}
+	file_subscription_plan_proto_init()
+	file_rpc_update_subscription_plan_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_update_subscription_plan_proto_rawDesc), len(file_rpc_update_subscription_plan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_update_subscription_plan_proto_goTypes,
+		DependencyIndexes: file_rpc_update_subscription_plan_proto_depIdxs,
+		MessageInfos:      file_rpc_update_subscription_plan_proto_msgTypes,
+	}.Build()
+	File_rpc_update_subscription_plan_proto = out.File
+	file_rpc_update_subscription_plan_proto_goTypes = nil
+	file_rpc_update_subscription_plan_proto_depIdxs = nil
+}
@@ -0,0 +1,229 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_update_user_subscription.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Only the fields that are set are changed.
+type UpdateUserSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId int32                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	PlanId         *int32                 `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3,oneof" json:"plan_id,omitempty"`
+	Status         *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	StartDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	AutoRenew      *bool                  `protobuf:"varint,6,opt,name=auto_renew,json=autoRenew,proto3,oneof" json:"auto_renew,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateUserSubscriptionRequest) Reset() {
+	*x = UpdateUserSubscriptionRequest{}
+	mi := &file_rpc_update_user_subscription_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserSubscriptionRequest) ProtoMessage() {}
+
+func (x *UpdateUserSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_user_subscription_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_update_user_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateUserSubscriptionRequest) GetSubscriptionId() int32 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *UpdateUserSubscriptionRequest) GetPlanId() int32 {
+	if x != nil && x.PlanId != nil {
+		return *x.PlanId
+	}
+	return 0
+}
+
+func (x *UpdateUserSubscriptionRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdateUserSubscriptionRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *UpdateUserSubscriptionRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *UpdateUserSubscriptionRequest) GetAutoRenew() bool {
+	if x != nil && x.AutoRenew != nil {
+		return *x.AutoRenew
+	}
+	return false
+}
+
+type UpdateUserSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *UserSubscription      `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserSubscriptionResponse) Reset() {
+	*x = UpdateUserSubscriptionResponse{}
+	mi := &file_rpc_update_user_subscription_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserSubscriptionResponse) ProtoMessage() {}
+
+func (x *UpdateUserSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_user_subscription_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_update_user_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateUserSubscriptionResponse) GetSubscription() *UserSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+var File_rpc_update_user_subscription_proto protoreflect.FileDescriptor
+
+const file_rpc_update_user_subscription_proto_rawDesc = "" +
+	"\n" +
+	"\"rpc_update_user_subscription.proto\x12\x02pb\x1a\x17user_subscription.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbf\x02\n" +
+	"\x1dUpdateUserSubscriptionRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x05R\x0esubscriptionId\x12\x1c\n" +
+	"\aplan_id\x18\x02 \x01(\x05H\x00R\x06planId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x03 \x01(\tH\x01R\x06status\x88\x01\x01\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\"\n" +
+	"\n" +
+	"auto_renew\x18\x06 \x01(\bH\x02R\tautoRenew\x88\x01\x01B\n" +
+	"\n" +
+	"\b_plan_idB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_auto_renew\"Z\n" +
+	"\x1eUpdateUserSubscriptionResponse\x128\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x14.pb.UserSubscriptionR\fsubscriptionB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_update_user_subscription_proto_rawDescOnce sync.Once
+	file_rpc_update_user_subscription_proto_rawDescData []byte
+)
+
+func file_rpc_update_user_subscription_proto_rawDescGZIP() []byte {
+	file_rpc_update_user_subscription_proto_rawDescOnce.Do(func() {
+		file_rpc_update_user_subscription_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_update_user_subscription_proto_rawDesc), len(file_rpc_update_user_subscription_proto_rawDesc)))
+	})
+	return file_rpc_update_user_subscription_proto_rawDescData
+}
+
+var file_rpc_update_user_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_update_user_subscription_proto_goTypes = []any{
+	(*UpdateUserSubscriptionRequest)(nil),  // 0: pb.UpdateUserSubscriptionRequest
+	(*UpdateUserSubscriptionResponse)(nil), // 1: pb.UpdateUserSubscriptionResponse
+	(*timestamppb.Timestamp)(nil),          // 2: google.protobuf.Timestamp
+	(*UserSubscription)(nil),               // 3: pb.UserSubscription
+}
+var file_rpc_update_user_subscription_proto_depIdxs = []int32{
+	2, // 0: pb.UpdateUserSubscriptionRequest.start_date:type_name -> google.protobuf.Timestamp
+	2, // 1: pb.UpdateUserSubscriptionRequest.end_date:type_name -> google.protobuf.Timestamp
+	3, // 2: pb.UpdateUserSubscriptionResponse.subscription:type_name -> pb.UserSubscription
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_update_user_subscription_proto_init() }
+func file_rpc_update_user_subscription_proto_init() {
+	if File_rpc_update_user_subscription_proto != nil {
+		return
+	}
+	file_user_subscription_proto_init()
+	file_rpc_update_user_subscription_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_update_user_subscription_proto_rawDesc), len(file_rpc_update_user_subscription_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_update_user_subscription_proto_goTypes,
+		DependencyIndexes: file_rpc_update_user_subscription_proto_depIdxs,
+		MessageInfos:      file_rpc_update_user_subscription_proto_msgTypes,
+	}.Build()
+	File_rpc_update_user_subscription_proto = out.File
+	file_rpc_update_user_subscription_proto_goTypes = nil
+	file_rpc_update_user_subscription_proto_depIdxs = nil
+}
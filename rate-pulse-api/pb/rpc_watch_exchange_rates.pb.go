@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: rpc_watch_exchange_rates.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Omitted (zero) filters match every rate.
+type WatchExchangeRatesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SourceCurrencyId int32                  `protobuf:"varint,1,opt,name=source_currency_id,json=sourceCurrencyId,proto3" json:"source_currency_id,omitempty"`
+	SourceId         int32                  `protobuf:"varint,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TypeId           int32                  `protobuf:"varint,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WatchExchangeRatesRequest) Reset() {
+	*x = WatchExchangeRatesRequest{}
+	mi := &file_rpc_watch_exchange_rates_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchExchangeRatesRequest) ProtoMessage() {}
+
+func (x *WatchExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_watch_exchange_rates_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*WatchExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_watch_exchange_rates_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchExchangeRatesRequest) GetSourceCurrencyId() int32 {
+	if x != nil {
+		return x.SourceCurrencyId
+	}
+	return 0
+}
+
+func (x *WatchExchangeRatesRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *WatchExchangeRatesRequest) GetTypeId() int32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+type WatchExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          *ExchangeRateUpdate    `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchExchangeRatesResponse) Reset() {
+	*x = WatchExchangeRatesResponse{}
+	mi := &file_rpc_watch_exchange_rates_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchExchangeRatesResponse) ProtoMessage() {}
+
+func (x *WatchExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_watch_exchange_rates_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*WatchExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_watch_exchange_rates_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WatchExchangeRatesResponse) GetRate() *ExchangeRateUpdate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+var File_rpc_watch_exchange_rates_proto protoreflect.FileDescriptor
+
+const file_rpc_watch_exchange_rates_proto_rawDesc = "" +
+	"\n" +
+	"\x1erpc_watch_exchange_rates.proto\x12\x02pb\x1a\x13exchange_rate.proto\"\x7f\n" +
+	"\x19WatchExchangeRatesRequest\x12,\n" +
+	"\x12source_currency_id\x18\x01 \x01(\x05R\x10sourceCurrencyId\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\x05R\bsourceId\x12\x17\n" +
+	"\atype_id\x18\x03 \x01(\x05R\x06typeId\"H\n" +
+	"\x1aWatchExchangeRatesResponse\x12*\n" +
+	"\x04rate\x18\x01 \x01(\v2\x16.pb.ExchangeRateUpdateR\x04rateB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_rpc_watch_exchange_rates_proto_rawDescOnce sync.Once
+	file_rpc_watch_exchange_rates_proto_rawDescData []byte
+)
+
+func file_rpc_watch_exchange_rates_proto_rawDescGZIP() []byte {
+	file_rpc_watch_exchange_rates_proto_rawDescOnce.Do(func() {
+		file_rpc_watch_exchange_rates_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_watch_exchange_rates_proto_rawDesc), len(file_rpc_watch_exchange_rates_proto_rawDesc)))
+	})
+	return file_rpc_watch_exchange_rates_proto_rawDescData
+}
+
+var file_rpc_watch_exchange_rates_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_watch_exchange_rates_proto_goTypes = []any{
+	(*WatchExchangeRatesRequest)(nil),  // 0: pb.WatchExchangeRatesRequest
+	(*WatchExchangeRatesResponse)(nil), // 1: pb.WatchExchangeRatesResponse
+	(*ExchangeRateUpdate)(nil),         // 2: pb.ExchangeRateUpdate
+}
+var file_rpc_watch_exchange_rates_proto_depIdxs = []int32{
+	2, // 0: pb.WatchExchangeRatesResponse.rate:type_name -> pb.ExchangeRateUpdate
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_watch_exchange_rates_proto_init() }
+func file_rpc_watch_exchange_rates_proto_init() {
+	if File_rpc_watch_exchange_rates_proto != nil {
+		return
+	}
+	file_exchange_rate_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_watch_exchange_rates_proto_rawDesc), len(file_rpc_watch_exchange_rates_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_watch_exchange_rates_proto_goTypes,
+		DependencyIndexes: file_rpc_watch_exchange_rates_proto_depIdxs,
+		MessageInfos:      file_rpc_watch_exchange_rates_proto_msgTypes,
+	}.Build()
+	File_rpc_watch_exchange_rates_proto = out.File
+	file_rpc_watch_exchange_rates_proto_goTypes = nil
+	file_rpc_watch_exchange_rates_proto_depIdxs = nil
+}
@@ -8,6 +8,7 @@ package pb
 
 import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -25,48 +26,463 @@ var File_service_rate_pulse_proto protoreflect.FileDescriptor
 
 const file_service_rate_pulse_proto_rawDesc = "" +
 	"\n" +
-	"\x18service_rate_pulse.proto\x12\x02pb\x1a\x15rpc_create_user.proto\x1a\x15rpc_signin_user.proto\x1a\x1crpc_renew_access_token.proto\x1a#rpc_get_latest_exchange_rates.proto\x1a\x16rpc_check_health.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xef\x01\n" +
-	"\x1eRatePulseAuthenticationService\x12=\n" +
+	"\x18service_rate_pulse.proto\x12\x02pb\x1a\x15rpc_create_user.proto\x1a\x15rpc_signin_user.proto\x1a\x1drpc_complete_signin_mfa.proto\x1a\x1crpc_enroll_signin_totp.proto\x1a\x1crpc_renew_access_token.proto\x1a#rpc_get_latest_exchange_rates.proto\x1a\x1frpc_ingest_exchange_rates.proto\x1a\x1erpc_watch_exchange_rates.proto\x1a\x16rpc_check_health.proto\x1a\x16rpc_verify_email.proto\x1a\x16rpc_signout_user.proto\x1a\x12rpc_get_user.proto\x1a\x14rpc_list_users.proto\x1a\x15rpc_update_user.proto\x1a\x1brpc_admin_update_user.proto\x1a\x15rpc_delete_user.proto\x1a\x1erpc_create_exchange_rate.proto\x1a\x1brpc_get_exchange_rate.proto\x1a\x1erpc_update_exchange_rate.proto\x1a\x1erpc_delete_exchange_rate.proto\x1a\x1drpc_get_historical_data.proto\x1a\x15rpc_get_candles.proto\x1a\x18rpc_get_cross_rate.proto\x1a\x15rpc_get_spreads.proto\x1a\x16rpc_create_quote.proto\x1a\x18rpc_compare_quotes.proto\x1a)rpc_list_quarantined_exchange_rates.proto\x1a*rpc_review_quarantined_exchange_rate.proto\x1a%rpc_create_rate_source_fee_rule.proto\x1a\"rpc_get_rate_source_fee_rule.proto\x1a$rpc_list_rate_source_fee_rules.proto\x1a)rpc_get_active_rate_source_fee_rule.proto\x1a%rpc_update_rate_source_fee_rule.proto\x1a%rpc_delete_rate_source_fee_rule.proto\x1a\x1brpc_create_rate_alert.proto\x1a\x18rpc_get_rate_alert.proto\x1a\x1arpc_list_rate_alerts.proto\x1a\x1brpc_update_rate_alert.proto\x1a\x1brpc_delete_rate_alert.proto\x1a#rpc_get_rate_source_freshness.proto\x1a\x1brpc_admin_get_payment.proto\x1a\x1drpc_admin_list_payments.proto\x1a'rpc_admin_list_user_subscriptions.proto\x1a\x18rpc_create_country.proto\x1a\x19rpc_create_currency.proto\x1a$rpc_create_currency_preference.proto\x1a\x18rpc_create_payment.proto\x1a\x1crpc_create_rate_source.proto\x1a'rpc_create_rate_source_preference.proto\x1a\"rpc_create_subscription_plan.proto\x1a\"rpc_create_user_subscription.proto\x1a\x18rpc_delete_country.proto\x1a\x19rpc_delete_currency.proto\x1a$rpc_delete_currency_preference.proto\x1a\x18rpc_delete_payment.proto\x1a\x1crpc_delete_rate_source.proto\x1a'rpc_delete_rate_source_preference.proto\x1a\"rpc_delete_subscription_plan.proto\x1a\"rpc_delete_user_subscription.proto\x1a&rpc_get_active_user_subscription.proto\x1a\x15rpc_get_country.proto\x1a\x1drpc_get_country_by_code.proto\x1a\x16rpc_get_currency.proto\x1a\x15rpc_get_payment.proto\x1a\x19rpc_get_rate_source.proto\x1a\x1frpc_get_subscription_plan.proto\x1a%rpc_list_all_subscription_plans.proto\x1a\x18rpc_list_countries.proto\x1a\x19rpc_list_currencies.proto\x1a#rpc_list_currency_preferences.proto\x1a\x17rpc_list_payments.proto\x1a&rpc_list_rate_source_preferences.proto\x1a\x1brpc_list_rate_sources.proto\x1a!rpc_list_subscription_plans.proto\x1a!rpc_list_user_subscriptions.proto\x1a\x18rpc_update_country.proto\x1a\x19rpc_update_currency.proto\x1a$rpc_update_currency_preference.proto\x1a\x18rpc_update_payment.proto\x1a\x1crpc_update_rate_source.proto\x1a'rpc_update_rate_source_preference.proto\x1a\"rpc_update_subscription_plan.proto\x1a\"rpc_update_user_subscription.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xf7\x05\n" +
+	"\x1eRatePulseAuthenticationService\x12X\n" +
 	"\n" +
-	"CreateUser\x12\x15.pb.CreateUserRequest\x1a\x16.pb.CreateUserResponse\"\x00\x12=\n" +
+	"CreateUser\x12\x15.pb.CreateUserRequest\x1a\x16.pb.CreateUserResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v2/users/signup\x12X\n" +
 	"\n" +
-	"SignInUser\x12\x15.pb.SignInUserRequest\x1a\x16.pb.SignInUserResponse\"\x00\x12O\n" +
-	"\x10RenewAccessToken\x12\x1b.pb.RenewAccessTokenRequest\x1a\x1c.pb.RenewAccessTokenResponse\"\x002\x81\x01\n" +
-	"\x1cRatePulseExchangeRateService\x12a\n" +
-	"\x16GetLatestExchangeRates\x12!.pb.GetLatestExchangeRatesRequest\x1a\".pb.GetLatestExchangeRatesResponse\"\x002b\n" +
-	"\x1eRatePulseInternalHealthService\x12@\n" +
-	"\vCheckHealth\x12\x16.pb.CheckHealthRequest\x1a\x17.pb.CheckHealthResponse\"\x00B\x95\x01\x92Aj\x12h\n" +
+	"SignInUser\x12\x15.pb.SignInUserRequest\x1a\x16.pb.SignInUserResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v2/users/signin\x12q\n" +
+	"\x11CompleteSignInMFA\x12\x1c.pb.CompleteSignInMFARequest\x1a\x1d.pb.CompleteSignInMFAResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v2/users/signin/mfa\x12u\n" +
+	"\x10EnrollSignInTOTP\x12\x1b.pb.EnrollSignInTOTPRequest\x1a\x1c.pb.EnrollSignInTOTPResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v2/users/signin/mfa/enroll\x12v\n" +
+	"\x10RenewAccessToken\x12\x1b.pb.RenewAccessTokenRequest\x1a\x1c.pb.RenewAccessTokenResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v2/users/renew-access-token\x12a\n" +
+	"\vVerifyEmail\x12\x16.pb.VerifyEmailRequest\x1a\x17.pb.VerifyEmailResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v2/users/verify-email\x12\\\n" +
+	"\vSignOutUser\x12\x16.pb.SignOutUserRequest\x1a\x17.pb.SignOutUserResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v2/users/signout2\xe3\x03\n" +
+	"\x14RatePulseUserService\x12O\n" +
+	"\aGetUser\x12\x12.pb.GetUserRequest\x1a\x13.pb.GetUserResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v2/users/{user_id}\x12K\n" +
+	"\tListUsers\x12\x14.pb.ListUsersRequest\x1a\x15.pb.ListUsersResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v2/users\x12[\n" +
+	"\n" +
+	"UpdateUser\x12\x15.pb.UpdateUserRequest\x1a\x16.pb.UpdateUserResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v2/users/{user_id}\x12p\n" +
+	"\x0fAdminUpdateUser\x12\x1a.pb.AdminUpdateUserRequest\x1a\x1b.pb.AdminUpdateUserResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/v2/admin/users/{user_id}\x12^\n" +
+	"\n" +
+	"DeleteUser\x12\x15.pb.DeleteUserRequest\x1a\x16.pb.DeleteUserResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v2/admin/users/{user_id}2\x9b\x0e\n" +
+	"\x1cRatePulseExchangeRateService\x12\x82\x01\n" +
+	"\x16GetLatestExchangeRates\x12!.pb.GetLatestExchangeRatesRequest\x1a\".pb.GetLatestExchangeRatesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v2/exchange-rates-latest\x12\x82\x01\n" +
+	"\x13IngestExchangeRates\x12\x1e.pb.IngestExchangeRatesRequest\x1a\x1f.pb.IngestExchangeRatesResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v2/admin/ingest/exchange-rates\x12W\n" +
+	"\x12WatchExchangeRates\x12\x1d.pb.WatchExchangeRatesRequest\x1a\x1e.pb.WatchExchangeRatesResponse\"\x000\x01\x12p\n" +
+	"\x0fGetExchangeRate\x12\x1a.pb.GetExchangeRateRequest\x1a\x1b.pb.GetExchangeRateResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v2/exchange-rates/{rate_id}\x12x\n" +
+	"\x12CreateExchangeRate\x12\x1d.pb.CreateExchangeRateRequest\x1a\x1e.pb.CreateExchangeRateResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v2/admin/exchange-rates\x12\x82\x01\n" +
+	"\x12UpdateExchangeRate\x12\x1d.pb.UpdateExchangeRateRequest\x1a\x1e.pb.UpdateExchangeRateResponse\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/v2/admin/exchange-rates/{rate_id}\x12\x7f\n" +
+	"\x12DeleteExchangeRate\x12\x1d.pb.DeleteExchangeRateRequest\x1a\x1e.pb.DeleteExchangeRateResponse\"*\x82\xd3\xe4\x93\x02$*\"/v2/admin/exchange-rates/{rate_id}\x12w\n" +
+	"\x11GetHistoricalData\x12\x1c.pb.GetHistoricalDataRequest\x1a\x1d.pb.GetHistoricalDataResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v2/exchange-rates/historical\x12_\n" +
+	"\n" +
+	"GetCandles\x12\x15.pb.GetCandlesRequest\x1a\x16.pb.GetCandlesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v2/exchange-rates/candles\x12c\n" +
+	"\fGetCrossRate\x12\x17.pb.GetCrossRateRequest\x1a\x18.pb.GetCrossRateResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v2/exchange-rates/cross\x12_\n" +
+	"\n" +
+	"GetSpreads\x12\x15.pb.GetSpreadsRequest\x1a\x16.pb.GetSpreadsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v2/exchange-rates/spreads\x12U\n" +
+	"\vCreateQuote\x12\x16.pb.CreateQuoteRequest\x1a\x17.pb.CreateQuoteResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v2/quotes\x12`\n" +
+	"\rCompareQuotes\x12\x18.pb.CompareQuotesRequest\x1a\x19.pb.CompareQuotesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v2/quotes/compare\x12\x9f\x01\n" +
+	"\x1cListQuarantinedExchangeRates\x12'.pb.ListQuarantinedExchangeRatesRequest\x1a(.pb.ListQuarantinedExchangeRatesResponse\",\x82\xd3\xe4\x93\x02&\x12$/v2/admin/exchange-rates/quarantined\x12\xaa\x01\n" +
+	"\x1dReviewQuarantinedExchangeRate\x12(.pb.ReviewQuarantinedExchangeRateRequest\x1a).pb.ReviewQuarantinedExchangeRateResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\x1a)/v2/admin/exchange-rates/{rate_id}/review2\x99\a\n" +
+	"!RatePulseRateSourceFeeRuleService\x12\x8e\x01\n" +
+	"\x17CreateRateSourceFeeRule\x12\".pb.CreateRateSourceFeeRuleRequest\x1a#.pb.CreateRateSourceFeeRuleResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v2/admin/rate-source-fee-rules\x12\x8a\x01\n" +
+	"\x14GetRateSourceFeeRule\x12\x1f.pb.GetRateSourceFeeRuleRequest\x1a .pb.GetRateSourceFeeRuleResponse\"/\x82\xd3\xe4\x93\x02)\x12'/v2/rate-source-fee-rules/{fee_rule_id}\x12\x82\x01\n" +
+	"\x16ListRateSourceFeeRules\x12!.pb.ListRateSourceFeeRulesRequest\x1a\".pb.ListRateSourceFeeRulesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v2/rate-source-fee-rules\x12\x95\x01\n" +
+	"\x1aGetActiveRateSourceFeeRule\x12%.pb.GetActiveRateSourceFeeRuleRequest\x1a&.pb.GetActiveRateSourceFeeRuleResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v2/rate-source-fee-rules/active\x12\x9c\x01\n" +
+	"\x17UpdateRateSourceFeeRule\x12\".pb.UpdateRateSourceFeeRuleRequest\x1a#.pb.UpdateRateSourceFeeRuleResponse\"8\x82\xd3\xe4\x93\x022:\x01*\x1a-/v2/admin/rate-source-fee-rules/{fee_rule_id}\x12\x99\x01\n" +
+	"\x17DeleteRateSourceFeeRule\x12\".pb.DeleteRateSourceFeeRuleRequest\x1a#.pb.DeleteRateSourceFeeRuleResponse\"5\x82\xd3\xe4\x93\x02/*-/v2/admin/rate-source-fee-rules/{fee_rule_id}2\x96\x04\n" +
+	"\x19RatePulseRateAlertService\x12a\n" +
+	"\x0fCreateRateAlert\x12\x1a.pb.CreateRateAlertRequest\x1a\x1b.pb.CreateRateAlertResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v2/alerts\x12`\n" +
+	"\fGetRateAlert\x12\x17.pb.GetRateAlertRequest\x1a\x18.pb.GetRateAlertResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v2/alerts/{alert_id}\x12[\n" +
+	"\x0eListRateAlerts\x12\x19.pb.ListRateAlertsRequest\x1a\x1a.pb.ListRateAlertsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v2/alerts\x12l\n" +
+	"\x0fUpdateRateAlert\x12\x1a.pb.UpdateRateAlertRequest\x1a\x1b.pb.UpdateRateAlertResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v2/alerts/{alert_id}\x12i\n" +
+	"\x0fDeleteRateAlert\x12\x1a.pb.DeleteRateAlertRequest\x1a\x1b.pb.DeleteRateAlertResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v2/alerts/{alert_id}2\xb8\x04\n" +
+	"\x18RatePulseCurrencyService\x12_\n" +
+	"\x0eListCurrencies\x12\x19.pb.ListCurrenciesRequest\x1a\x1a.pb.ListCurrenciesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v2/currencies\x12d\n" +
+	"\vGetCurrency\x12\x16.pb.GetCurrencyRequest\x1a\x17.pb.GetCurrencyResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v2/currencies/{currency_id}\x12h\n" +
+	"\x0eCreateCurrency\x12\x19.pb.CreateCurrencyRequest\x1a\x1a.pb.CreateCurrencyResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v2/admin/currencies\x12v\n" +
+	"\x0eUpdateCurrency\x12\x19.pb.UpdateCurrencyRequest\x1a\x1a.pb.UpdateCurrencyResponse\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/v2/admin/currencies/{currency_id}\x12s\n" +
+	"\x0eDeleteCurrency\x12\x19.pb.DeleteCurrencyRequest\x1a\x1a.pb.DeleteCurrencyResponse\"*\x82\xd3\xe4\x93\x02$*\"/v2/admin/currencies/{currency_id}2\x9a\x05\n" +
+	"\x17RatePulseCountryService\x12[\n" +
+	"\rListCountries\x12\x18.pb.ListCountriesRequest\x1a\x19.pb.ListCountriesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v2/countries\x12_\n" +
+	"\n" +
+	"GetCountry\x12\x15.pb.GetCountryRequest\x1a\x16.pb.GetCountryResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v2/countries/{country_id}\x12x\n" +
+	"\x10GetCountryByCode\x12\x1b.pb.GetCountryByCodeRequest\x1a\x1c.pb.GetCountryByCodeResponse\")\x82\xd3\xe4\x93\x02#\x12!/v2/countries/code/{country_code}\x12d\n" +
+	"\rCreateCountry\x12\x18.pb.CreateCountryRequest\x1a\x19.pb.CreateCountryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v2/admin/countries\x12q\n" +
+	"\rUpdateCountry\x12\x18.pb.UpdateCountryRequest\x1a\x19.pb.UpdateCountryResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\x1a /v2/admin/countries/{country_id}\x12n\n" +
+	"\rDeleteCountry\x12\x18.pb.DeleteCountryRequest\x1a\x19.pb.DeleteCountryResponse\"(\x82\xd3\xe4\x93\x02\"* /v2/admin/countries/{country_id}2\xd9\x04\n" +
+	"\x1aRatePulseRateSourceService\x12d\n" +
+	"\x0fListRateSources\x12\x1a.pb.ListRateSourcesRequest\x1a\x1b.pb.ListRateSourcesResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v2/rate-sources\x12j\n" +
+	"\rGetRateSource\x12\x18.pb.GetRateSourceRequest\x1a\x19.pb.GetRateSourceResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v2/rate-sources/{source_id}\x12p\n" +
+	"\x10CreateRateSource\x12\x1b.pb.CreateRateSourceRequest\x1a\x1c.pb.CreateRateSourceResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v2/admin/rate-sources\x12|\n" +
+	"\x10UpdateRateSource\x12\x1b.pb.UpdateRateSourceRequest\x1a\x1c.pb.UpdateRateSourceResponse\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/v2/admin/rate-sources/{source_id}\x12y\n" +
+	"\x10DeleteRateSource\x12\x1b.pb.DeleteRateSourceRequest\x1a\x1c.pb.DeleteRateSourceResponse\"*\x82\xd3\xe4\x93\x02$*\"/v2/admin/rate-sources/{source_id}2\xa0\r\n" +
+	"\x1cRatePulseSubscriptionService\x12|\n" +
+	"\x15ListSubscriptionPlans\x12 .pb.ListSubscriptionPlansRequest\x1a!.pb.ListSubscriptionPlansResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v2/subscription-plans\x12\x80\x01\n" +
+	"\x13GetSubscriptionPlan\x12\x1e.pb.GetSubscriptionPlanRequest\x1a\x1f.pb.GetSubscriptionPlanResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v2/subscription-plans/{plan_id}\x12\x8b\x01\n" +
+	"\x18ListAllSubscriptionPlans\x12#.pb.ListAllSubscriptionPlansRequest\x1a$.pb.ListAllSubscriptionPlansResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v2/admin/subscription-plans\x12\x88\x01\n" +
+	"\x16CreateSubscriptionPlan\x12!.pb.CreateSubscriptionPlanRequest\x1a\".pb.CreateSubscriptionPlanResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v2/admin/subscription-plans\x12\x92\x01\n" +
+	"\x16UpdateSubscriptionPlan\x12!.pb.UpdateSubscriptionPlanRequest\x1a\".pb.UpdateSubscriptionPlanResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\x1a&/v2/admin/subscription-plans/{plan_id}\x12\x8f\x01\n" +
+	"\x16DeleteSubscriptionPlan\x12!.pb.DeleteSubscriptionPlanRequest\x1a\".pb.DeleteSubscriptionPlanResponse\".\x82\xd3\xe4\x93\x02(*&/v2/admin/subscription-plans/{plan_id}\x12}\n" +
+	"\x16CreateUserSubscription\x12!.pb.CreateUserSubscriptionRequest\x1a\".pb.CreateUserSubscriptionResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v2/subscriptions\x12w\n" +
+	"\x15ListUserSubscriptions\x12 .pb.ListUserSubscriptionsRequest\x1a!.pb.ListUserSubscriptionsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v2/subscriptions\x12\x8a\x01\n" +
+	"\x19GetActiveUserSubscription\x12$.pb.GetActiveUserSubscriptionRequest\x1a%.pb.GetActiveUserSubscriptionResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v2/subscriptions/active\x12\x8c\x01\n" +
+	"\x1aAdminListUserSubscriptions\x12%.pb.AdminListUserSubscriptionsRequest\x1a&.pb.AdminListUserSubscriptionsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v2/admin/subscriptions\x12\x95\x01\n" +
+	"\x16UpdateUserSubscription\x12!.pb.UpdateUserSubscriptionRequest\x1a\".pb.UpdateUserSubscriptionResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\x1a)/v2/admin/subscriptions/{subscription_id}\x12\x92\x01\n" +
+	"\x16DeleteUserSubscription\x12!.pb.DeleteUserSubscriptionRequest\x1a\".pb.DeleteUserSubscriptionResponse\"1\x82\xd3\xe4\x93\x02+*)/v2/admin/subscriptions/{subscription_id}2\xfb\x05\n" +
+	"\x17RatePulsePaymentService\x12W\n" +
+	"\fListPayments\x12\x17.pb.ListPaymentsRequest\x1a\x18.pb.ListPaymentsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v2/payments\x12^\n" +
+	"\n" +
+	"GetPayment\x12\x15.pb.GetPaymentRequest\x1a\x16.pb.GetPaymentResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v2/payments/{payment_id}\x12c\n" +
+	"\rCreatePayment\x12\x18.pb.CreatePaymentRequest\x1a\x19.pb.CreatePaymentResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v2/admin/payments\x12l\n" +
+	"\x11AdminListPayments\x12\x1c.pb.AdminListPaymentsRequest\x1a\x1d.pb.AdminListPaymentsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v2/admin/payments\x12s\n" +
+	"\x0fAdminGetPayment\x12\x1a.pb.AdminGetPaymentRequest\x1a\x1b.pb.AdminGetPaymentResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v2/admin/payments/{payment_id}\x12p\n" +
+	"\rUpdatePayment\x12\x18.pb.UpdatePaymentRequest\x1a\x19.pb.UpdatePaymentResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/v2/admin/payments/{payment_id}\x12m\n" +
+	"\rDeletePayment\x12\x18.pb.DeletePaymentRequest\x1a\x19.pb.DeletePaymentResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/v2/admin/payments/{payment_id}2\xca\t\n" +
+	"\x1aRatePulsePreferenceService\x12\x8a\x01\n" +
+	"\x18CreateCurrencyPreference\x12#.pb.CreateCurrencyPreferenceRequest\x1a$.pb.CreateCurrencyPreferenceResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v2/currency-preferences\x12\x84\x01\n" +
+	"\x17ListCurrencyPreferences\x12\".pb.ListCurrencyPreferencesRequest\x1a#.pb.ListCurrencyPreferencesResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v2/currency-preferences\x12\x98\x01\n" +
+	"\x18UpdateCurrencyPreference\x12#.pb.UpdateCurrencyPreferenceRequest\x1a$.pb.UpdateCurrencyPreferenceResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\x1a&/v2/currency-preferences/{currency_id}\x12\x95\x01\n" +
+	"\x18DeleteCurrencyPreference\x12#.pb.DeleteCurrencyPreferenceRequest\x1a$.pb.DeleteCurrencyPreferenceResponse\".\x82\xd3\xe4\x93\x02(*&/v2/currency-preferences/{currency_id}\x12\x93\x01\n" +
+	"\x1aCreateRateSourcePreference\x12%.pb.CreateRateSourcePreferenceRequest\x1a&.pb.CreateRateSourcePreferenceResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v2/rate-source-preferences\x12\x8d\x01\n" +
+	"\x19ListRateSourcePreferences\x12$.pb.ListRateSourcePreferencesRequest\x1a%.pb.ListRateSourcePreferencesResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v2/rate-source-preferences\x12\x9f\x01\n" +
+	"\x1aUpdateRateSourcePreference\x12%.pb.UpdateRateSourcePreferenceRequest\x1a&.pb.UpdateRateSourcePreferenceResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\x1a'/v2/rate-source-preferences/{source_id}\x12\x9c\x01\n" +
+	"\x1aDeleteRateSourcePreference\x12%.pb.DeleteRateSourcePreferenceRequest\x1a&.pb.DeleteRateSourcePreferenceResponse\"/\x82\xd3\xe4\x93\x02)*'/v2/rate-source-preferences/{source_id}2\xfa\x01\n" +
+	"\x1eRatePulseInternalHealthService\x12R\n" +
+	"\vCheckHealth\x12\x16.pb.CheckHealthRequest\x1a\x17.pb.CheckHealthResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v2/health\x12\x83\x01\n" +
+	"\x16GetRateSourceFreshness\x12!.pb.GetRateSourceFreshnessRequest\x1a\".pb.GetRateSourceFreshnessResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v2/rate-sources/freshnessB\xeb\x01\x92A\xbf\x01\x12h\n" +
 	"\x15Rate Pulse API - GRPC\"J\n" +
-	"\x12Rate Pulse project\x12\x19https://www.rate-pulse.me\x1a\x19vinhtongthanh57@gmail.com2\x031.0Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+	"\x12Rate Pulse project\x12\x19https://www.rate-pulse.me\x1a\x19vinhtongthanh57@gmail.com2\x031.0ZA\n" +
+	"?\n" +
+	"\n" +
+	"BearerAuth\x121\b\x02\x12\x1cUse \"Bearer <access_token>\".\x1a\rAuthorization \x02b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
 
 var file_service_rate_pulse_proto_goTypes = []any{
-	(*CreateUserRequest)(nil),              // 0: pb.CreateUserRequest
-	(*SignInUserRequest)(nil),              // 1: pb.SignInUserRequest
-	(*RenewAccessTokenRequest)(nil),        // 2: pb.RenewAccessTokenRequest
-	(*GetLatestExchangeRatesRequest)(nil),  // 3: pb.GetLatestExchangeRatesRequest
-	(*CheckHealthRequest)(nil),             // 4: pb.CheckHealthRequest
-	(*CreateUserResponse)(nil),             // 5: pb.CreateUserResponse
-	(*SignInUserResponse)(nil),             // 6: pb.SignInUserResponse
-	(*RenewAccessTokenResponse)(nil),       // 7: pb.RenewAccessTokenResponse
-	(*GetLatestExchangeRatesResponse)(nil), // 8: pb.GetLatestExchangeRatesResponse
-	(*CheckHealthResponse)(nil),            // 9: pb.CheckHealthResponse
+	(*CreateUserRequest)(nil),                     // 0: pb.CreateUserRequest
+	(*SignInUserRequest)(nil),                     // 1: pb.SignInUserRequest
+	(*CompleteSignInMFARequest)(nil),              // 2: pb.CompleteSignInMFARequest
+	(*EnrollSignInTOTPRequest)(nil),               // 3: pb.EnrollSignInTOTPRequest
+	(*RenewAccessTokenRequest)(nil),               // 4: pb.RenewAccessTokenRequest
+	(*VerifyEmailRequest)(nil),                    // 5: pb.VerifyEmailRequest
+	(*SignOutUserRequest)(nil),                    // 6: pb.SignOutUserRequest
+	(*GetUserRequest)(nil),                        // 7: pb.GetUserRequest
+	(*ListUsersRequest)(nil),                      // 8: pb.ListUsersRequest
+	(*UpdateUserRequest)(nil),                     // 9: pb.UpdateUserRequest
+	(*AdminUpdateUserRequest)(nil),                // 10: pb.AdminUpdateUserRequest
+	(*DeleteUserRequest)(nil),                     // 11: pb.DeleteUserRequest
+	(*GetLatestExchangeRatesRequest)(nil),         // 12: pb.GetLatestExchangeRatesRequest
+	(*IngestExchangeRatesRequest)(nil),            // 13: pb.IngestExchangeRatesRequest
+	(*WatchExchangeRatesRequest)(nil),             // 14: pb.WatchExchangeRatesRequest
+	(*GetExchangeRateRequest)(nil),                // 15: pb.GetExchangeRateRequest
+	(*CreateExchangeRateRequest)(nil),             // 16: pb.CreateExchangeRateRequest
+	(*UpdateExchangeRateRequest)(nil),             // 17: pb.UpdateExchangeRateRequest
+	(*DeleteExchangeRateRequest)(nil),             // 18: pb.DeleteExchangeRateRequest
+	(*GetHistoricalDataRequest)(nil),              // 19: pb.GetHistoricalDataRequest
+	(*GetCandlesRequest)(nil),                     // 20: pb.GetCandlesRequest
+	(*GetCrossRateRequest)(nil),                   // 21: pb.GetCrossRateRequest
+	(*GetSpreadsRequest)(nil),                     // 22: pb.GetSpreadsRequest
+	(*CreateQuoteRequest)(nil),                    // 23: pb.CreateQuoteRequest
+	(*CompareQuotesRequest)(nil),                  // 24: pb.CompareQuotesRequest
+	(*ListQuarantinedExchangeRatesRequest)(nil),   // 25: pb.ListQuarantinedExchangeRatesRequest
+	(*ReviewQuarantinedExchangeRateRequest)(nil),  // 26: pb.ReviewQuarantinedExchangeRateRequest
+	(*CreateRateSourceFeeRuleRequest)(nil),        // 27: pb.CreateRateSourceFeeRuleRequest
+	(*GetRateSourceFeeRuleRequest)(nil),           // 28: pb.GetRateSourceFeeRuleRequest
+	(*ListRateSourceFeeRulesRequest)(nil),         // 29: pb.ListRateSourceFeeRulesRequest
+	(*GetActiveRateSourceFeeRuleRequest)(nil),     // 30: pb.GetActiveRateSourceFeeRuleRequest
+	(*UpdateRateSourceFeeRuleRequest)(nil),        // 31: pb.UpdateRateSourceFeeRuleRequest
+	(*DeleteRateSourceFeeRuleRequest)(nil),        // 32: pb.DeleteRateSourceFeeRuleRequest
+	(*CreateRateAlertRequest)(nil),                // 33: pb.CreateRateAlertRequest
+	(*GetRateAlertRequest)(nil),                   // 34: pb.GetRateAlertRequest
+	(*ListRateAlertsRequest)(nil),                 // 35: pb.ListRateAlertsRequest
+	(*UpdateRateAlertRequest)(nil),                // 36: pb.UpdateRateAlertRequest
+	(*DeleteRateAlertRequest)(nil),                // 37: pb.DeleteRateAlertRequest
+	(*ListCurrenciesRequest)(nil),                 // 38: pb.ListCurrenciesRequest
+	(*GetCurrencyRequest)(nil),                    // 39: pb.GetCurrencyRequest
+	(*CreateCurrencyRequest)(nil),                 // 40: pb.CreateCurrencyRequest
+	(*UpdateCurrencyRequest)(nil),                 // 41: pb.UpdateCurrencyRequest
+	(*DeleteCurrencyRequest)(nil),                 // 42: pb.DeleteCurrencyRequest
+	(*ListCountriesRequest)(nil),                  // 43: pb.ListCountriesRequest
+	(*GetCountryRequest)(nil),                     // 44: pb.GetCountryRequest
+	(*GetCountryByCodeRequest)(nil),               // 45: pb.GetCountryByCodeRequest
+	(*CreateCountryRequest)(nil),                  // 46: pb.CreateCountryRequest
+	(*UpdateCountryRequest)(nil),                  // 47: pb.UpdateCountryRequest
+	(*DeleteCountryRequest)(nil),                  // 48: pb.DeleteCountryRequest
+	(*ListRateSourcesRequest)(nil),                // 49: pb.ListRateSourcesRequest
+	(*GetRateSourceRequest)(nil),                  // 50: pb.GetRateSourceRequest
+	(*CreateRateSourceRequest)(nil),               // 51: pb.CreateRateSourceRequest
+	(*UpdateRateSourceRequest)(nil),               // 52: pb.UpdateRateSourceRequest
+	(*DeleteRateSourceRequest)(nil),               // 53: pb.DeleteRateSourceRequest
+	(*ListSubscriptionPlansRequest)(nil),          // 54: pb.ListSubscriptionPlansRequest
+	(*GetSubscriptionPlanRequest)(nil),            // 55: pb.GetSubscriptionPlanRequest
+	(*ListAllSubscriptionPlansRequest)(nil),       // 56: pb.ListAllSubscriptionPlansRequest
+	(*CreateSubscriptionPlanRequest)(nil),         // 57: pb.CreateSubscriptionPlanRequest
+	(*UpdateSubscriptionPlanRequest)(nil),         // 58: pb.UpdateSubscriptionPlanRequest
+	(*DeleteSubscriptionPlanRequest)(nil),         // 59: pb.DeleteSubscriptionPlanRequest
+	(*CreateUserSubscriptionRequest)(nil),         // 60: pb.CreateUserSubscriptionRequest
+	(*ListUserSubscriptionsRequest)(nil),          // 61: pb.ListUserSubscriptionsRequest
+	(*GetActiveUserSubscriptionRequest)(nil),      // 62: pb.GetActiveUserSubscriptionRequest
+	(*AdminListUserSubscriptionsRequest)(nil),     // 63: pb.AdminListUserSubscriptionsRequest
+	(*UpdateUserSubscriptionRequest)(nil),         // 64: pb.UpdateUserSubscriptionRequest
+	(*DeleteUserSubscriptionRequest)(nil),         // 65: pb.DeleteUserSubscriptionRequest
+	(*ListPaymentsRequest)(nil),                   // 66: pb.ListPaymentsRequest
+	(*GetPaymentRequest)(nil),                     // 67: pb.GetPaymentRequest
+	(*CreatePaymentRequest)(nil),                  // 68: pb.CreatePaymentRequest
+	(*AdminListPaymentsRequest)(nil),              // 69: pb.AdminListPaymentsRequest
+	(*AdminGetPaymentRequest)(nil),                // 70: pb.AdminGetPaymentRequest
+	(*UpdatePaymentRequest)(nil),                  // 71: pb.UpdatePaymentRequest
+	(*DeletePaymentRequest)(nil),                  // 72: pb.DeletePaymentRequest
+	(*CreateCurrencyPreferenceRequest)(nil),       // 73: pb.CreateCurrencyPreferenceRequest
+	(*ListCurrencyPreferencesRequest)(nil),        // 74: pb.ListCurrencyPreferencesRequest
+	(*UpdateCurrencyPreferenceRequest)(nil),       // 75: pb.UpdateCurrencyPreferenceRequest
+	(*DeleteCurrencyPreferenceRequest)(nil),       // 76: pb.DeleteCurrencyPreferenceRequest
+	(*CreateRateSourcePreferenceRequest)(nil),     // 77: pb.CreateRateSourcePreferenceRequest
+	(*ListRateSourcePreferencesRequest)(nil),      // 78: pb.ListRateSourcePreferencesRequest
+	(*UpdateRateSourcePreferenceRequest)(nil),     // 79: pb.UpdateRateSourcePreferenceRequest
+	(*DeleteRateSourcePreferenceRequest)(nil),     // 80: pb.DeleteRateSourcePreferenceRequest
+	(*CheckHealthRequest)(nil),                    // 81: pb.CheckHealthRequest
+	(*GetRateSourceFreshnessRequest)(nil),         // 82: pb.GetRateSourceFreshnessRequest
+	(*CreateUserResponse)(nil),                    // 83: pb.CreateUserResponse
+	(*SignInUserResponse)(nil),                    // 84: pb.SignInUserResponse
+	(*CompleteSignInMFAResponse)(nil),             // 85: pb.CompleteSignInMFAResponse
+	(*EnrollSignInTOTPResponse)(nil),              // 86: pb.EnrollSignInTOTPResponse
+	(*RenewAccessTokenResponse)(nil),              // 87: pb.RenewAccessTokenResponse
+	(*VerifyEmailResponse)(nil),                   // 88: pb.VerifyEmailResponse
+	(*SignOutUserResponse)(nil),                   // 89: pb.SignOutUserResponse
+	(*GetUserResponse)(nil),                       // 90: pb.GetUserResponse
+	(*ListUsersResponse)(nil),                     // 91: pb.ListUsersResponse
+	(*UpdateUserResponse)(nil),                    // 92: pb.UpdateUserResponse
+	(*AdminUpdateUserResponse)(nil),               // 93: pb.AdminUpdateUserResponse
+	(*DeleteUserResponse)(nil),                    // 94: pb.DeleteUserResponse
+	(*GetLatestExchangeRatesResponse)(nil),        // 95: pb.GetLatestExchangeRatesResponse
+	(*IngestExchangeRatesResponse)(nil),           // 96: pb.IngestExchangeRatesResponse
+	(*WatchExchangeRatesResponse)(nil),            // 97: pb.WatchExchangeRatesResponse
+	(*GetExchangeRateResponse)(nil),               // 98: pb.GetExchangeRateResponse
+	(*CreateExchangeRateResponse)(nil),            // 99: pb.CreateExchangeRateResponse
+	(*UpdateExchangeRateResponse)(nil),            // 100: pb.UpdateExchangeRateResponse
+	(*DeleteExchangeRateResponse)(nil),            // 101: pb.DeleteExchangeRateResponse
+	(*GetHistoricalDataResponse)(nil),             // 102: pb.GetHistoricalDataResponse
+	(*GetCandlesResponse)(nil),                    // 103: pb.GetCandlesResponse
+	(*GetCrossRateResponse)(nil),                  // 104: pb.GetCrossRateResponse
+	(*GetSpreadsResponse)(nil),                    // 105: pb.GetSpreadsResponse
+	(*CreateQuoteResponse)(nil),                   // 106: pb.CreateQuoteResponse
+	(*CompareQuotesResponse)(nil),                 // 107: pb.CompareQuotesResponse
+	(*ListQuarantinedExchangeRatesResponse)(nil),  // 108: pb.ListQuarantinedExchangeRatesResponse
+	(*ReviewQuarantinedExchangeRateResponse)(nil), // 109: pb.ReviewQuarantinedExchangeRateResponse
+	(*CreateRateSourceFeeRuleResponse)(nil),       // 110: pb.CreateRateSourceFeeRuleResponse
+	(*GetRateSourceFeeRuleResponse)(nil),          // 111: pb.GetRateSourceFeeRuleResponse
+	(*ListRateSourceFeeRulesResponse)(nil),        // 112: pb.ListRateSourceFeeRulesResponse
+	(*GetActiveRateSourceFeeRuleResponse)(nil),    // 113: pb.GetActiveRateSourceFeeRuleResponse
+	(*UpdateRateSourceFeeRuleResponse)(nil),       // 114: pb.UpdateRateSourceFeeRuleResponse
+	(*DeleteRateSourceFeeRuleResponse)(nil),       // 115: pb.DeleteRateSourceFeeRuleResponse
+	(*CreateRateAlertResponse)(nil),               // 116: pb.CreateRateAlertResponse
+	(*GetRateAlertResponse)(nil),                  // 117: pb.GetRateAlertResponse
+	(*ListRateAlertsResponse)(nil),                // 118: pb.ListRateAlertsResponse
+	(*UpdateRateAlertResponse)(nil),               // 119: pb.UpdateRateAlertResponse
+	(*DeleteRateAlertResponse)(nil),               // 120: pb.DeleteRateAlertResponse
+	(*ListCurrenciesResponse)(nil),                // 121: pb.ListCurrenciesResponse
+	(*GetCurrencyResponse)(nil),                   // 122: pb.GetCurrencyResponse
+	(*CreateCurrencyResponse)(nil),                // 123: pb.CreateCurrencyResponse
+	(*UpdateCurrencyResponse)(nil),                // 124: pb.UpdateCurrencyResponse
+	(*DeleteCurrencyResponse)(nil),                // 125: pb.DeleteCurrencyResponse
+	(*ListCountriesResponse)(nil),                 // 126: pb.ListCountriesResponse
+	(*GetCountryResponse)(nil),                    // 127: pb.GetCountryResponse
+	(*GetCountryByCodeResponse)(nil),              // 128: pb.GetCountryByCodeResponse
+	(*CreateCountryResponse)(nil),                 // 129: pb.CreateCountryResponse
+	(*UpdateCountryResponse)(nil),                 // 130: pb.UpdateCountryResponse
+	(*DeleteCountryResponse)(nil),                 // 131: pb.DeleteCountryResponse
+	(*ListRateSourcesResponse)(nil),               // 132: pb.ListRateSourcesResponse
+	(*GetRateSourceResponse)(nil),                 // 133: pb.GetRateSourceResponse
+	(*CreateRateSourceResponse)(nil),              // 134: pb.CreateRateSourceResponse
+	(*UpdateRateSourceResponse)(nil),              // 135: pb.UpdateRateSourceResponse
+	(*DeleteRateSourceResponse)(nil),              // 136: pb.DeleteRateSourceResponse
+	(*ListSubscriptionPlansResponse)(nil),         // 137: pb.ListSubscriptionPlansResponse
+	(*GetSubscriptionPlanResponse)(nil),           // 138: pb.GetSubscriptionPlanResponse
+	(*ListAllSubscriptionPlansResponse)(nil),      // 139: pb.ListAllSubscriptionPlansResponse
+	(*CreateSubscriptionPlanResponse)(nil),        // 140: pb.CreateSubscriptionPlanResponse
+	(*UpdateSubscriptionPlanResponse)(nil),        // 141: pb.UpdateSubscriptionPlanResponse
+	(*DeleteSubscriptionPlanResponse)(nil),        // 142: pb.DeleteSubscriptionPlanResponse
+	(*CreateUserSubscriptionResponse)(nil),        // 143: pb.CreateUserSubscriptionResponse
+	(*ListUserSubscriptionsResponse)(nil),         // 144: pb.ListUserSubscriptionsResponse
+	(*GetActiveUserSubscriptionResponse)(nil),     // 145: pb.GetActiveUserSubscriptionResponse
+	(*AdminListUserSubscriptionsResponse)(nil),    // 146: pb.AdminListUserSubscriptionsResponse
+	(*UpdateUserSubscriptionResponse)(nil),        // 147: pb.UpdateUserSubscriptionResponse
+	(*DeleteUserSubscriptionResponse)(nil),        // 148: pb.DeleteUserSubscriptionResponse
+	(*ListPaymentsResponse)(nil),                  // 149: pb.ListPaymentsResponse
+	(*GetPaymentResponse)(nil),                    // 150: pb.GetPaymentResponse
+	(*CreatePaymentResponse)(nil),                 // 151: pb.CreatePaymentResponse
+	(*AdminListPaymentsResponse)(nil),             // 152: pb.AdminListPaymentsResponse
+	(*AdminGetPaymentResponse)(nil),               // 153: pb.AdminGetPaymentResponse
+	(*UpdatePaymentResponse)(nil),                 // 154: pb.UpdatePaymentResponse
+	(*DeletePaymentResponse)(nil),                 // 155: pb.DeletePaymentResponse
+	(*CreateCurrencyPreferenceResponse)(nil),      // 156: pb.CreateCurrencyPreferenceResponse
+	(*ListCurrencyPreferencesResponse)(nil),       // 157: pb.ListCurrencyPreferencesResponse
+	(*UpdateCurrencyPreferenceResponse)(nil),      // 158: pb.UpdateCurrencyPreferenceResponse
+	(*DeleteCurrencyPreferenceResponse)(nil),      // 159: pb.DeleteCurrencyPreferenceResponse
+	(*CreateRateSourcePreferenceResponse)(nil),    // 160: pb.CreateRateSourcePreferenceResponse
+	(*ListRateSourcePreferencesResponse)(nil),     // 161: pb.ListRateSourcePreferencesResponse
+	(*UpdateRateSourcePreferenceResponse)(nil),    // 162: pb.UpdateRateSourcePreferenceResponse
+	(*DeleteRateSourcePreferenceResponse)(nil),    // 163: pb.DeleteRateSourcePreferenceResponse
+	(*CheckHealthResponse)(nil),                   // 164: pb.CheckHealthResponse
+	(*GetRateSourceFreshnessResponse)(nil),        // 165: pb.GetRateSourceFreshnessResponse
 }
 var file_service_rate_pulse_proto_depIdxs = []int32{
-	0, // 0: pb.RatePulseAuthenticationService.CreateUser:input_type -> pb.CreateUserRequest
-	1, // 1: pb.RatePulseAuthenticationService.SignInUser:input_type -> pb.SignInUserRequest
-	2, // 2: pb.RatePulseAuthenticationService.RenewAccessToken:input_type -> pb.RenewAccessTokenRequest
-	3, // 3: pb.RatePulseExchangeRateService.GetLatestExchangeRates:input_type -> pb.GetLatestExchangeRatesRequest
-	4, // 4: pb.RatePulseInternalHealthService.CheckHealth:input_type -> pb.CheckHealthRequest
-	5, // 5: pb.RatePulseAuthenticationService.CreateUser:output_type -> pb.CreateUserResponse
-	6, // 6: pb.RatePulseAuthenticationService.SignInUser:output_type -> pb.SignInUserResponse
-	7, // 7: pb.RatePulseAuthenticationService.RenewAccessToken:output_type -> pb.RenewAccessTokenResponse
-	8, // 8: pb.RatePulseExchangeRateService.GetLatestExchangeRates:output_type -> pb.GetLatestExchangeRatesResponse
-	9, // 9: pb.RatePulseInternalHealthService.CheckHealth:output_type -> pb.CheckHealthResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,   // 0: pb.RatePulseAuthenticationService.CreateUser:input_type -> pb.CreateUserRequest
+	1,   // 1: pb.RatePulseAuthenticationService.SignInUser:input_type -> pb.SignInUserRequest
+	2,   // 2: pb.RatePulseAuthenticationService.CompleteSignInMFA:input_type -> pb.CompleteSignInMFARequest
+	3,   // 3: pb.RatePulseAuthenticationService.EnrollSignInTOTP:input_type -> pb.EnrollSignInTOTPRequest
+	4,   // 4: pb.RatePulseAuthenticationService.RenewAccessToken:input_type -> pb.RenewAccessTokenRequest
+	5,   // 5: pb.RatePulseAuthenticationService.VerifyEmail:input_type -> pb.VerifyEmailRequest
+	6,   // 6: pb.RatePulseAuthenticationService.SignOutUser:input_type -> pb.SignOutUserRequest
+	7,   // 7: pb.RatePulseUserService.GetUser:input_type -> pb.GetUserRequest
+	8,   // 8: pb.RatePulseUserService.ListUsers:input_type -> pb.ListUsersRequest
+	9,   // 9: pb.RatePulseUserService.UpdateUser:input_type -> pb.UpdateUserRequest
+	10,  // 10: pb.RatePulseUserService.AdminUpdateUser:input_type -> pb.AdminUpdateUserRequest
+	11,  // 11: pb.RatePulseUserService.DeleteUser:input_type -> pb.DeleteUserRequest
+	12,  // 12: pb.RatePulseExchangeRateService.GetLatestExchangeRates:input_type -> pb.GetLatestExchangeRatesRequest
+	13,  // 13: pb.RatePulseExchangeRateService.IngestExchangeRates:input_type -> pb.IngestExchangeRatesRequest
+	14,  // 14: pb.RatePulseExchangeRateService.WatchExchangeRates:input_type -> pb.WatchExchangeRatesRequest
+	15,  // 15: pb.RatePulseExchangeRateService.GetExchangeRate:input_type -> pb.GetExchangeRateRequest
+	16,  // 16: pb.RatePulseExchangeRateService.CreateExchangeRate:input_type -> pb.CreateExchangeRateRequest
+	17,  // 17: pb.RatePulseExchangeRateService.UpdateExchangeRate:input_type -> pb.UpdateExchangeRateRequest
+	18,  // 18: pb.RatePulseExchangeRateService.DeleteExchangeRate:input_type -> pb.DeleteExchangeRateRequest
+	19,  // 19: pb.RatePulseExchangeRateService.GetHistoricalData:input_type -> pb.GetHistoricalDataRequest
+	20,  // 20: pb.RatePulseExchangeRateService.GetCandles:input_type -> pb.GetCandlesRequest
+	21,  // 21: pb.RatePulseExchangeRateService.GetCrossRate:input_type -> pb.GetCrossRateRequest
+	22,  // 22: pb.RatePulseExchangeRateService.GetSpreads:input_type -> pb.GetSpreadsRequest
+	23,  // 23: pb.RatePulseExchangeRateService.CreateQuote:input_type -> pb.CreateQuoteRequest
+	24,  // 24: pb.RatePulseExchangeRateService.CompareQuotes:input_type -> pb.CompareQuotesRequest
+	25,  // 25: pb.RatePulseExchangeRateService.ListQuarantinedExchangeRates:input_type -> pb.ListQuarantinedExchangeRatesRequest
+	26,  // 26: pb.RatePulseExchangeRateService.ReviewQuarantinedExchangeRate:input_type -> pb.ReviewQuarantinedExchangeRateRequest
+	27,  // 27: pb.RatePulseRateSourceFeeRuleService.CreateRateSourceFeeRule:input_type -> pb.CreateRateSourceFeeRuleRequest
+	28,  // 28: pb.RatePulseRateSourceFeeRuleService.GetRateSourceFeeRule:input_type -> pb.GetRateSourceFeeRuleRequest
+	29,  // 29: pb.RatePulseRateSourceFeeRuleService.ListRateSourceFeeRules:input_type -> pb.ListRateSourceFeeRulesRequest
+	30,  // 30: pb.RatePulseRateSourceFeeRuleService.GetActiveRateSourceFeeRule:input_type -> pb.GetActiveRateSourceFeeRuleRequest
+	31,  // 31: pb.RatePulseRateSourceFeeRuleService.UpdateRateSourceFeeRule:input_type -> pb.UpdateRateSourceFeeRuleRequest
+	32,  // 32: pb.RatePulseRateSourceFeeRuleService.DeleteRateSourceFeeRule:input_type -> pb.DeleteRateSourceFeeRuleRequest
+	33,  // 33: pb.RatePulseRateAlertService.CreateRateAlert:input_type -> pb.CreateRateAlertRequest
+	34,  // 34: pb.RatePulseRateAlertService.GetRateAlert:input_type -> pb.GetRateAlertRequest
+	35,  // 35: pb.RatePulseRateAlertService.ListRateAlerts:input_type -> pb.ListRateAlertsRequest
+	36,  // 36: pb.RatePulseRateAlertService.UpdateRateAlert:input_type -> pb.UpdateRateAlertRequest
+	37,  // 37: pb.RatePulseRateAlertService.DeleteRateAlert:input_type -> pb.DeleteRateAlertRequest
+	38,  // 38: pb.RatePulseCurrencyService.ListCurrencies:input_type -> pb.ListCurrenciesRequest
+	39,  // 39: pb.RatePulseCurrencyService.GetCurrency:input_type -> pb.GetCurrencyRequest
+	40,  // 40: pb.RatePulseCurrencyService.CreateCurrency:input_type -> pb.CreateCurrencyRequest
+	41,  // 41: pb.RatePulseCurrencyService.UpdateCurrency:input_type -> pb.UpdateCurrencyRequest
+	42,  // 42: pb.RatePulseCurrencyService.DeleteCurrency:input_type -> pb.DeleteCurrencyRequest
+	43,  // 43: pb.RatePulseCountryService.ListCountries:input_type -> pb.ListCountriesRequest
+	44,  // 44: pb.RatePulseCountryService.GetCountry:input_type -> pb.GetCountryRequest
+	45,  // 45: pb.RatePulseCountryService.GetCountryByCode:input_type -> pb.GetCountryByCodeRequest
+	46,  // 46: pb.RatePulseCountryService.CreateCountry:input_type -> pb.CreateCountryRequest
+	47,  // 47: pb.RatePulseCountryService.UpdateCountry:input_type -> pb.UpdateCountryRequest
+	48,  // 48: pb.RatePulseCountryService.DeleteCountry:input_type -> pb.DeleteCountryRequest
+	49,  // 49: pb.RatePulseRateSourceService.ListRateSources:input_type -> pb.ListRateSourcesRequest
+	50,  // 50: pb.RatePulseRateSourceService.GetRateSource:input_type -> pb.GetRateSourceRequest
+	51,  // 51: pb.RatePulseRateSourceService.CreateRateSource:input_type -> pb.CreateRateSourceRequest
+	52,  // 52: pb.RatePulseRateSourceService.UpdateRateSource:input_type -> pb.UpdateRateSourceRequest
+	53,  // 53: pb.RatePulseRateSourceService.DeleteRateSource:input_type -> pb.DeleteRateSourceRequest
+	54,  // 54: pb.RatePulseSubscriptionService.ListSubscriptionPlans:input_type -> pb.ListSubscriptionPlansRequest
+	55,  // 55: pb.RatePulseSubscriptionService.GetSubscriptionPlan:input_type -> pb.GetSubscriptionPlanRequest
+	56,  // 56: pb.RatePulseSubscriptionService.ListAllSubscriptionPlans:input_type -> pb.ListAllSubscriptionPlansRequest
+	57,  // 57: pb.RatePulseSubscriptionService.CreateSubscriptionPlan:input_type -> pb.CreateSubscriptionPlanRequest
+	58,  // 58: pb.RatePulseSubscriptionService.UpdateSubscriptionPlan:input_type -> pb.UpdateSubscriptionPlanRequest
+	59,  // 59: pb.RatePulseSubscriptionService.DeleteSubscriptionPlan:input_type -> pb.DeleteSubscriptionPlanRequest
+	60,  // 60: pb.RatePulseSubscriptionService.CreateUserSubscription:input_type -> pb.CreateUserSubscriptionRequest
+	61,  // 61: pb.RatePulseSubscriptionService.ListUserSubscriptions:input_type -> pb.ListUserSubscriptionsRequest
+	62,  // 62: pb.RatePulseSubscriptionService.GetActiveUserSubscription:input_type -> pb.GetActiveUserSubscriptionRequest
+	63,  // 63: pb.RatePulseSubscriptionService.AdminListUserSubscriptions:input_type -> pb.AdminListUserSubscriptionsRequest
+	64,  // 64: pb.RatePulseSubscriptionService.UpdateUserSubscription:input_type -> pb.UpdateUserSubscriptionRequest
+	65,  // 65: pb.RatePulseSubscriptionService.DeleteUserSubscription:input_type -> pb.DeleteUserSubscriptionRequest
+	66,  // 66: pb.RatePulsePaymentService.ListPayments:input_type -> pb.ListPaymentsRequest
+	67,  // 67: pb.RatePulsePaymentService.GetPayment:input_type -> pb.GetPaymentRequest
+	68,  // 68: pb.RatePulsePaymentService.CreatePayment:input_type -> pb.CreatePaymentRequest
+	69,  // 69: pb.RatePulsePaymentService.AdminListPayments:input_type -> pb.AdminListPaymentsRequest
+	70,  // 70: pb.RatePulsePaymentService.AdminGetPayment:input_type -> pb.AdminGetPaymentRequest
+	71,  // 71: pb.RatePulsePaymentService.UpdatePayment:input_type -> pb.UpdatePaymentRequest
+	72,  // 72: pb.RatePulsePaymentService.DeletePayment:input_type -> pb.DeletePaymentRequest
+	73,  // 73: pb.RatePulsePreferenceService.CreateCurrencyPreference:input_type -> pb.CreateCurrencyPreferenceRequest
+	74,  // 74: pb.RatePulsePreferenceService.ListCurrencyPreferences:input_type -> pb.ListCurrencyPreferencesRequest
+	75,  // 75: pb.RatePulsePreferenceService.UpdateCurrencyPreference:input_type -> pb.UpdateCurrencyPreferenceRequest
+	76,  // 76: pb.RatePulsePreferenceService.DeleteCurrencyPreference:input_type -> pb.DeleteCurrencyPreferenceRequest
+	77,  // 77: pb.RatePulsePreferenceService.CreateRateSourcePreference:input_type -> pb.CreateRateSourcePreferenceRequest
+	78,  // 78: pb.RatePulsePreferenceService.ListRateSourcePreferences:input_type -> pb.ListRateSourcePreferencesRequest
+	79,  // 79: pb.RatePulsePreferenceService.UpdateRateSourcePreference:input_type -> pb.UpdateRateSourcePreferenceRequest
+	80,  // 80: pb.RatePulsePreferenceService.DeleteRateSourcePreference:input_type -> pb.DeleteRateSourcePreferenceRequest
+	81,  // 81: pb.RatePulseInternalHealthService.CheckHealth:input_type -> pb.CheckHealthRequest
+	82,  // 82: pb.RatePulseInternalHealthService.GetRateSourceFreshness:input_type -> pb.GetRateSourceFreshnessRequest
+	83,  // 83: pb.RatePulseAuthenticationService.CreateUser:output_type -> pb.CreateUserResponse
+	84,  // 84: pb.RatePulseAuthenticationService.SignInUser:output_type -> pb.SignInUserResponse
+	85,  // 85: pb.RatePulseAuthenticationService.CompleteSignInMFA:output_type -> pb.CompleteSignInMFAResponse
+	86,  // 86: pb.RatePulseAuthenticationService.EnrollSignInTOTP:output_type -> pb.EnrollSignInTOTPResponse
+	87,  // 87: pb.RatePulseAuthenticationService.RenewAccessToken:output_type -> pb.RenewAccessTokenResponse
+	88,  // 88: pb.RatePulseAuthenticationService.VerifyEmail:output_type -> pb.VerifyEmailResponse
+	89,  // 89: pb.RatePulseAuthenticationService.SignOutUser:output_type -> pb.SignOutUserResponse
+	90,  // 90: pb.RatePulseUserService.GetUser:output_type -> pb.GetUserResponse
+	91,  // 91: pb.RatePulseUserService.ListUsers:output_type -> pb.ListUsersResponse
+	92,  // 92: pb.RatePulseUserService.UpdateUser:output_type -> pb.UpdateUserResponse
+	93,  // 93: pb.RatePulseUserService.AdminUpdateUser:output_type -> pb.AdminUpdateUserResponse
+	94,  // 94: pb.RatePulseUserService.DeleteUser:output_type -> pb.DeleteUserResponse
+	95,  // 95: pb.RatePulseExchangeRateService.GetLatestExchangeRates:output_type -> pb.GetLatestExchangeRatesResponse
+	96,  // 96: pb.RatePulseExchangeRateService.IngestExchangeRates:output_type -> pb.IngestExchangeRatesResponse
+	97,  // 97: pb.RatePulseExchangeRateService.WatchExchangeRates:output_type -> pb.WatchExchangeRatesResponse
+	98,  // 98: pb.RatePulseExchangeRateService.GetExchangeRate:output_type -> pb.GetExchangeRateResponse
+	99,  // 99: pb.RatePulseExchangeRateService.CreateExchangeRate:output_type -> pb.CreateExchangeRateResponse
+	100, // 100: pb.RatePulseExchangeRateService.UpdateExchangeRate:output_type -> pb.UpdateExchangeRateResponse
+	101, // 101: pb.RatePulseExchangeRateService.DeleteExchangeRate:output_type -> pb.DeleteExchangeRateResponse
+	102, // 102: pb.RatePulseExchangeRateService.GetHistoricalData:output_type -> pb.GetHistoricalDataResponse
+	103, // 103: pb.RatePulseExchangeRateService.GetCandles:output_type -> pb.GetCandlesResponse
+	104, // 104: pb.RatePulseExchangeRateService.GetCrossRate:output_type -> pb.GetCrossRateResponse
+	105, // 105: pb.RatePulseExchangeRateService.GetSpreads:output_type -> pb.GetSpreadsResponse
+	106, // 106: pb.RatePulseExchangeRateService.CreateQuote:output_type -> pb.CreateQuoteResponse
+	107, // 107: pb.RatePulseExchangeRateService.CompareQuotes:output_type -> pb.CompareQuotesResponse
+	108, // 108: pb.RatePulseExchangeRateService.ListQuarantinedExchangeRates:output_type -> pb.ListQuarantinedExchangeRatesResponse
+	109, // 109: pb.RatePulseExchangeRateService.ReviewQuarantinedExchangeRate:output_type -> pb.ReviewQuarantinedExchangeRateResponse
+	110, // 110: pb.RatePulseRateSourceFeeRuleService.CreateRateSourceFeeRule:output_type -> pb.CreateRateSourceFeeRuleResponse
+	111, // 111: pb.RatePulseRateSourceFeeRuleService.GetRateSourceFeeRule:output_type -> pb.GetRateSourceFeeRuleResponse
+	112, // 112: pb.RatePulseRateSourceFeeRuleService.ListRateSourceFeeRules:output_type -> pb.ListRateSourceFeeRulesResponse
+	113, // 113: pb.RatePulseRateSourceFeeRuleService.GetActiveRateSourceFeeRule:output_type -> pb.GetActiveRateSourceFeeRuleResponse
+	114, // 114: pb.RatePulseRateSourceFeeRuleService.UpdateRateSourceFeeRule:output_type -> pb.UpdateRateSourceFeeRuleResponse
+	115, // 115: pb.RatePulseRateSourceFeeRuleService.DeleteRateSourceFeeRule:output_type -> pb.DeleteRateSourceFeeRuleResponse
+	116, // 116: pb.RatePulseRateAlertService.CreateRateAlert:output_type -> pb.CreateRateAlertResponse
+	117, // 117: pb.RatePulseRateAlertService.GetRateAlert:output_type -> pb.GetRateAlertResponse
+	118, // 118: pb.RatePulseRateAlertService.ListRateAlerts:output_type -> pb.ListRateAlertsResponse
+	119, // 119: pb.RatePulseRateAlertService.UpdateRateAlert:output_type -> pb.UpdateRateAlertResponse
+	120, // 120: pb.RatePulseRateAlertService.DeleteRateAlert:output_type -> pb.DeleteRateAlertResponse
+	121, // 121: pb.RatePulseCurrencyService.ListCurrencies:output_type -> pb.ListCurrenciesResponse
+	122, // 122: pb.RatePulseCurrencyService.GetCurrency:output_type -> pb.GetCurrencyResponse
+	123, // 123: pb.RatePulseCurrencyService.CreateCurrency:output_type -> pb.CreateCurrencyResponse
+	124, // 124: pb.RatePulseCurrencyService.UpdateCurrency:output_type -> pb.UpdateCurrencyResponse
+	125, // 125: pb.RatePulseCurrencyService.DeleteCurrency:output_type -> pb.DeleteCurrencyResponse
+	126, // 126: pb.RatePulseCountryService.ListCountries:output_type -> pb.ListCountriesResponse
+	127, // 127: pb.RatePulseCountryService.GetCountry:output_type -> pb.GetCountryResponse
+	128, // 128: pb.RatePulseCountryService.GetCountryByCode:output_type -> pb.GetCountryByCodeResponse
+	129, // 129: pb.RatePulseCountryService.CreateCountry:output_type -> pb.CreateCountryResponse
+	130, // 130: pb.RatePulseCountryService.UpdateCountry:output_type -> pb.UpdateCountryResponse
+	131, // 131: pb.RatePulseCountryService.DeleteCountry:output_type -> pb.DeleteCountryResponse
+	132, // 132: pb.RatePulseRateSourceService.ListRateSources:output_type -> pb.ListRateSourcesResponse
+	133, // 133: pb.RatePulseRateSourceService.GetRateSource:output_type -> pb.GetRateSourceResponse
+	134, // 134: pb.RatePulseRateSourceService.CreateRateSource:output_type -> pb.CreateRateSourceResponse
+	135, // 135: pb.RatePulseRateSourceService.UpdateRateSource:output_type -> pb.UpdateRateSourceResponse
+	136, // 136: pb.RatePulseRateSourceService.DeleteRateSource:output_type -> pb.DeleteRateSourceResponse
+	137, // 137: pb.RatePulseSubscriptionService.ListSubscriptionPlans:output_type -> pb.ListSubscriptionPlansResponse
+	138, // 138: pb.RatePulseSubscriptionService.GetSubscriptionPlan:output_type -> pb.GetSubscriptionPlanResponse
+	139, // 139: pb.RatePulseSubscriptionService.ListAllSubscriptionPlans:output_type -> pb.ListAllSubscriptionPlansResponse
+	140, // 140: pb.RatePulseSubscriptionService.CreateSubscriptionPlan:output_type -> pb.CreateSubscriptionPlanResponse
+	141, // 141: pb.RatePulseSubscriptionService.UpdateSubscriptionPlan:output_type -> pb.UpdateSubscriptionPlanResponse
+	142, // 142: pb.RatePulseSubscriptionService.DeleteSubscriptionPlan:output_type -> pb.DeleteSubscriptionPlanResponse
+	143, // 143: pb.RatePulseSubscriptionService.CreateUserSubscription:output_type -> pb.CreateUserSubscriptionResponse
+	144, // 144: pb.RatePulseSubscriptionService.ListUserSubscriptions:output_type -> pb.ListUserSubscriptionsResponse
+	145, // 145: pb.RatePulseSubscriptionService.GetActiveUserSubscription:output_type -> pb.GetActiveUserSubscriptionResponse
+	146, // 146: pb.RatePulseSubscriptionService.AdminListUserSubscriptions:output_type -> pb.AdminListUserSubscriptionsResponse
+	147, // 147: pb.RatePulseSubscriptionService.UpdateUserSubscription:output_type -> pb.UpdateUserSubscriptionResponse
+	148, // 148: pb.RatePulseSubscriptionService.DeleteUserSubscription:output_type -> pb.DeleteUserSubscriptionResponse
+	149, // 149: pb.RatePulsePaymentService.ListPayments:output_type -> pb.ListPaymentsResponse
+	150, // 150: pb.RatePulsePaymentService.GetPayment:output_type -> pb.GetPaymentResponse
+	151, // 151: pb.RatePulsePaymentService.CreatePayment:output_type -> pb.CreatePaymentResponse
+	152, // 152: pb.RatePulsePaymentService.AdminListPayments:output_type -> pb.AdminListPaymentsResponse
+	153, // 153: pb.RatePulsePaymentService.AdminGetPayment:output_type -> pb.AdminGetPaymentResponse
+	154, // 154: pb.RatePulsePaymentService.UpdatePayment:output_type -> pb.UpdatePaymentResponse
+	155, // 155: pb.RatePulsePaymentService.DeletePayment:output_type -> pb.DeletePaymentResponse
+	156, // 156: pb.RatePulsePreferenceService.CreateCurrencyPreference:output_type -> pb.CreateCurrencyPreferenceResponse
+	157, // 157: pb.RatePulsePreferenceService.ListCurrencyPreferences:output_type -> pb.ListCurrencyPreferencesResponse
+	158, // 158: pb.RatePulsePreferenceService.UpdateCurrencyPreference:output_type -> pb.UpdateCurrencyPreferenceResponse
+	159, // 159: pb.RatePulsePreferenceService.DeleteCurrencyPreference:output_type -> pb.DeleteCurrencyPreferenceResponse
+	160, // 160: pb.RatePulsePreferenceService.CreateRateSourcePreference:output_type -> pb.CreateRateSourcePreferenceResponse
+	161, // 161: pb.RatePulsePreferenceService.ListRateSourcePreferences:output_type -> pb.ListRateSourcePreferencesResponse
+	162, // 162: pb.RatePulsePreferenceService.UpdateRateSourcePreference:output_type -> pb.UpdateRateSourcePreferenceResponse
+	163, // 163: pb.RatePulsePreferenceService.DeleteRateSourcePreference:output_type -> pb.DeleteRateSourcePreferenceResponse
+	164, // 164: pb.RatePulseInternalHealthService.CheckHealth:output_type -> pb.CheckHealthResponse
+	165, // 165: pb.RatePulseInternalHealthService.GetRateSourceFreshness:output_type -> pb.GetRateSourceFreshnessResponse
+	83,  // [83:166] is the sub-list for method output_type
+	0,   // [0:83] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_service_rate_pulse_proto_init() }
@@ -76,9 +492,87 @@ func file_service_rate_pulse_proto_init() {
 	}
 	file_rpc_create_user_proto_init()
 	file_rpc_signin_user_proto_init()
+	file_rpc_complete_signin_mfa_proto_init()
+	file_rpc_enroll_signin_totp_proto_init()
 	file_rpc_renew_access_token_proto_init()
 	file_rpc_get_latest_exchange_rates_proto_init()
+	file_rpc_ingest_exchange_rates_proto_init()
+	file_rpc_watch_exchange_rates_proto_init()
 	file_rpc_check_health_proto_init()
+	file_rpc_verify_email_proto_init()
+	file_rpc_signout_user_proto_init()
+	file_rpc_get_user_proto_init()
+	file_rpc_list_users_proto_init()
+	file_rpc_update_user_proto_init()
+	file_rpc_admin_update_user_proto_init()
+	file_rpc_delete_user_proto_init()
+	file_rpc_create_exchange_rate_proto_init()
+	file_rpc_get_exchange_rate_proto_init()
+	file_rpc_update_exchange_rate_proto_init()
+	file_rpc_delete_exchange_rate_proto_init()
+	file_rpc_get_historical_data_proto_init()
+	file_rpc_get_candles_proto_init()
+	file_rpc_get_cross_rate_proto_init()
+	file_rpc_get_spreads_proto_init()
+	file_rpc_create_quote_proto_init()
+	file_rpc_compare_quotes_proto_init()
+	file_rpc_list_quarantined_exchange_rates_proto_init()
+	file_rpc_review_quarantined_exchange_rate_proto_init()
+	file_rpc_create_rate_source_fee_rule_proto_init()
+	file_rpc_get_rate_source_fee_rule_proto_init()
+	file_rpc_list_rate_source_fee_rules_proto_init()
+	file_rpc_get_active_rate_source_fee_rule_proto_init()
+	file_rpc_update_rate_source_fee_rule_proto_init()
+	file_rpc_delete_rate_source_fee_rule_proto_init()
+	file_rpc_create_rate_alert_proto_init()
+	file_rpc_get_rate_alert_proto_init()
+	file_rpc_list_rate_alerts_proto_init()
+	file_rpc_update_rate_alert_proto_init()
+	file_rpc_delete_rate_alert_proto_init()
+	file_rpc_get_rate_source_freshness_proto_init()
+	file_rpc_admin_get_payment_proto_init()
+	file_rpc_admin_list_payments_proto_init()
+	file_rpc_admin_list_user_subscriptions_proto_init()
+	file_rpc_create_country_proto_init()
+	file_rpc_create_currency_proto_init()
+	file_rpc_create_currency_preference_proto_init()
+	file_rpc_create_payment_proto_init()
+	file_rpc_create_rate_source_proto_init()
+	file_rpc_create_rate_source_preference_proto_init()
+	file_rpc_create_subscription_plan_proto_init()
+	file_rpc_create_user_subscription_proto_init()
+	file_rpc_delete_country_proto_init()
+	file_rpc_delete_currency_proto_init()
+	file_rpc_delete_currency_preference_proto_init()
+	file_rpc_delete_payment_proto_init()
+	file_rpc_delete_rate_source_proto_init()
+	file_rpc_delete_rate_source_preference_proto_init()
+	file_rpc_delete_subscription_plan_proto_init()
+	file_rpc_delete_user_subscription_proto_init()
+	file_rpc_get_active_user_subscription_proto_init()
+	file_rpc_get_country_proto_init()
+	file_rpc_get_country_by_code_proto_init()
+	file_rpc_get_currency_proto_init()
+	file_rpc_get_payment_proto_init()
+	file_rpc_get_rate_source_proto_init()
+	file_rpc_get_subscription_plan_proto_init()
+	file_rpc_list_all_subscription_plans_proto_init()
+	file_rpc_list_countries_proto_init()
+	file_rpc_list_currencies_proto_init()
+	file_rpc_list_currency_preferences_proto_init()
+	file_rpc_list_payments_proto_init()
+	file_rpc_list_rate_source_preferences_proto_init()
+	file_rpc_list_rate_sources_proto_init()
+	file_rpc_list_subscription_plans_proto_init()
+	file_rpc_list_user_subscriptions_proto_init()
+	file_rpc_update_country_proto_init()
+	file_rpc_update_currency_proto_init()
+	file_rpc_update_currency_preference_proto_init()
+	file_rpc_update_payment_proto_init()
+	file_rpc_update_rate_source_proto_init()
+	file_rpc_update_rate_source_preference_proto_init()
+	file_rpc_update_subscription_plan_proto_init()
+	file_rpc_update_user_subscription_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -87,7 +581,7 @@ func file_service_rate_pulse_proto_init() {
 			NumEnums:      0,
 			NumMessages:   0,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   12,
 		},
 		GoTypes:           file_service_rate_pulse_proto_goTypes,
 		DependencyIndexes: file_service_rate_pulse_proto_depIdxs,
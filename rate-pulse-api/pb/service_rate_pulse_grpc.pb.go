@@ -19,9 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RatePulseAuthenticationService_CreateUser_FullMethodName       = "/pb.RatePulseAuthenticationService/CreateUser"
-	RatePulseAuthenticationService_SignInUser_FullMethodName       = "/pb.RatePulseAuthenticationService/SignInUser"
-	RatePulseAuthenticationService_RenewAccessToken_FullMethodName = "/pb.RatePulseAuthenticationService/RenewAccessToken"
+	RatePulseAuthenticationService_CreateUser_FullMethodName        = "/pb.RatePulseAuthenticationService/CreateUser"
+	RatePulseAuthenticationService_SignInUser_FullMethodName        = "/pb.RatePulseAuthenticationService/SignInUser"
+	RatePulseAuthenticationService_CompleteSignInMFA_FullMethodName = "/pb.RatePulseAuthenticationService/CompleteSignInMFA"
+	RatePulseAuthenticationService_EnrollSignInTOTP_FullMethodName  = "/pb.RatePulseAuthenticationService/EnrollSignInTOTP"
+	RatePulseAuthenticationService_RenewAccessToken_FullMethodName  = "/pb.RatePulseAuthenticationService/RenewAccessToken"
+	RatePulseAuthenticationService_VerifyEmail_FullMethodName       = "/pb.RatePulseAuthenticationService/VerifyEmail"
+	RatePulseAuthenticationService_SignOutUser_FullMethodName       = "/pb.RatePulseAuthenticationService/SignOutUser"
 )
 
 // RatePulseAuthenticationServiceClient is the client API for RatePulseAuthenticationService service.
@@ -30,7 +34,11 @@ const (
 type RatePulseAuthenticationServiceClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	SignInUser(ctx context.Context, in *SignInUserRequest, opts ...grpc.CallOption) (*SignInUserResponse, error)
+	CompleteSignInMFA(ctx context.Context, in *CompleteSignInMFARequest, opts ...grpc.CallOption) (*CompleteSignInMFAResponse, error)
+	EnrollSignInTOTP(ctx context.Context, in *EnrollSignInTOTPRequest, opts ...grpc.CallOption) (*EnrollSignInTOTPResponse, error)
 	RenewAccessToken(ctx context.Context, in *RenewAccessTokenRequest, opts ...grpc.CallOption) (*RenewAccessTokenResponse, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	SignOutUser(ctx context.Context, in *SignOutUserRequest, opts ...grpc.CallOption) (*SignOutUserResponse, error)
 }
 
 type ratePulseAuthenticationServiceClient struct {
@@ -61,6 +69,26 @@ func (c *ratePulseAuthenticationServiceClient) SignInUser(ctx context.Context, i
 	return out, nil
 }
 
+func (c *ratePulseAuthenticationServiceClient) CompleteSignInMFA(ctx context.Context, in *CompleteSignInMFARequest, opts ...grpc.CallOption) (*CompleteSignInMFAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteSignInMFAResponse)
+	err := c.cc.Invoke(ctx, RatePulseAuthenticationService_CompleteSignInMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseAuthenticationServiceClient) EnrollSignInTOTP(ctx context.Context, in *EnrollSignInTOTPRequest, opts ...grpc.CallOption) (*EnrollSignInTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollSignInTOTPResponse)
+	err := c.cc.Invoke(ctx, RatePulseAuthenticationService_EnrollSignInTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ratePulseAuthenticationServiceClient) RenewAccessToken(ctx context.Context, in *RenewAccessTokenRequest, opts ...grpc.CallOption) (*RenewAccessTokenResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RenewAccessTokenResponse)
@@ -71,13 +99,37 @@ func (c *ratePulseAuthenticationServiceClient) RenewAccessToken(ctx context.Cont
 	return out, nil
 }
 
+func (c *ratePulseAuthenticationServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, RatePulseAuthenticationService_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseAuthenticationServiceClient) SignOutUser(ctx context.Context, in *SignOutUserRequest, opts ...grpc.CallOption) (*SignOutUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignOutUserResponse)
+	err := c.cc.Invoke(ctx, RatePulseAuthenticationService_SignOutUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RatePulseAuthenticationServiceServer is the server API for RatePulseAuthenticationService service.
 // All implementations must embed UnimplementedRatePulseAuthenticationServiceServer
 // for forward compatibility.
 type RatePulseAuthenticationServiceServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	SignInUser(context.Context, *SignInUserRequest) (*SignInUserResponse, error)
+	CompleteSignInMFA(context.Context, *CompleteSignInMFARequest) (*CompleteSignInMFAResponse, error)
+	EnrollSignInTOTP(context.Context, *EnrollSignInTOTPRequest) (*EnrollSignInTOTPResponse, error)
 	RenewAccessToken(context.Context, *RenewAccessTokenRequest) (*RenewAccessTokenResponse, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	SignOutUser(context.Context, *SignOutUserRequest) (*SignOutUserResponse, error)
 	mustEmbedUnimplementedRatePulseAuthenticationServiceServer()
 }
 
@@ -94,9 +146,21 @@ func (UnimplementedRatePulseAuthenticationServiceServer) CreateUser(context.Cont
 func (UnimplementedRatePulseAuthenticationServiceServer) SignInUser(context.Context, *SignInUserRequest) (*SignInUserResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SignInUser not implemented")
 }
+func (UnimplementedRatePulseAuthenticationServiceServer) CompleteSignInMFA(context.Context, *CompleteSignInMFARequest) (*CompleteSignInMFAResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteSignInMFA not implemented")
+}
+func (UnimplementedRatePulseAuthenticationServiceServer) EnrollSignInTOTP(context.Context, *EnrollSignInTOTPRequest) (*EnrollSignInTOTPResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnrollSignInTOTP not implemented")
+}
 func (UnimplementedRatePulseAuthenticationServiceServer) RenewAccessToken(context.Context, *RenewAccessTokenRequest) (*RenewAccessTokenResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RenewAccessToken not implemented")
 }
+func (UnimplementedRatePulseAuthenticationServiceServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedRatePulseAuthenticationServiceServer) SignOutUser(context.Context, *SignOutUserRequest) (*SignOutUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SignOutUser not implemented")
+}
 func (UnimplementedRatePulseAuthenticationServiceServer) mustEmbedUnimplementedRatePulseAuthenticationServiceServer() {
 }
 func (UnimplementedRatePulseAuthenticationServiceServer) testEmbeddedByValue() {}
@@ -155,6 +219,42 @@ func _RatePulseAuthenticationService_SignInUser_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RatePulseAuthenticationService_CompleteSignInMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteSignInMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseAuthenticationServiceServer).CompleteSignInMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseAuthenticationService_CompleteSignInMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseAuthenticationServiceServer).CompleteSignInMFA(ctx, req.(*CompleteSignInMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseAuthenticationService_EnrollSignInTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollSignInTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseAuthenticationServiceServer).EnrollSignInTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseAuthenticationService_EnrollSignInTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseAuthenticationServiceServer).EnrollSignInTOTP(ctx, req.(*EnrollSignInTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RatePulseAuthenticationService_RenewAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RenewAccessTokenRequest)
 	if err := dec(in); err != nil {
@@ -173,6 +273,42 @@ func _RatePulseAuthenticationService_RenewAccessToken_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RatePulseAuthenticationService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseAuthenticationServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseAuthenticationService_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseAuthenticationServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseAuthenticationService_SignOutUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignOutUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseAuthenticationServiceServer).SignOutUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseAuthenticationService_SignOutUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseAuthenticationServiceServer).SignOutUser(ctx, req.(*SignOutUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RatePulseAuthenticationService_ServiceDesc is the grpc.ServiceDesc for RatePulseAuthenticationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -188,112 +324,279 @@ var RatePulseAuthenticationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignInUser",
 			Handler:    _RatePulseAuthenticationService_SignInUser_Handler,
 		},
+		{
+			MethodName: "CompleteSignInMFA",
+			Handler:    _RatePulseAuthenticationService_CompleteSignInMFA_Handler,
+		},
+		{
+			MethodName: "EnrollSignInTOTP",
+			Handler:    _RatePulseAuthenticationService_EnrollSignInTOTP_Handler,
+		},
 		{
 			MethodName: "RenewAccessToken",
 			Handler:    _RatePulseAuthenticationService_RenewAccessToken_Handler,
 		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _RatePulseAuthenticationService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "SignOutUser",
+			Handler:    _RatePulseAuthenticationService_SignOutUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_rate_pulse.proto",
 }
 
 const (
-	RatePulseExchangeRateService_GetLatestExchangeRates_FullMethodName = "/pb.RatePulseExchangeRateService/GetLatestExchangeRates"
+	RatePulseUserService_GetUser_FullMethodName         = "/pb.RatePulseUserService/GetUser"
+	RatePulseUserService_ListUsers_FullMethodName       = "/pb.RatePulseUserService/ListUsers"
+	RatePulseUserService_UpdateUser_FullMethodName      = "/pb.RatePulseUserService/UpdateUser"
+	RatePulseUserService_AdminUpdateUser_FullMethodName = "/pb.RatePulseUserService/AdminUpdateUser"
+	RatePulseUserService_DeleteUser_FullMethodName      = "/pb.RatePulseUserService/DeleteUser"
 )
 
-// RatePulseExchangeRateServiceClient is the client API for RatePulseExchangeRateService service.
+// RatePulseUserServiceClient is the client API for RatePulseUserService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type RatePulseExchangeRateServiceClient interface {
-	GetLatestExchangeRates(ctx context.Context, in *GetLatestExchangeRatesRequest, opts ...grpc.CallOption) (*GetLatestExchangeRatesResponse, error)
+type RatePulseUserServiceClient interface {
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	AdminUpdateUser(ctx context.Context, in *AdminUpdateUserRequest, opts ...grpc.CallOption) (*AdminUpdateUserResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
 }
 
-type ratePulseExchangeRateServiceClient struct {
+type ratePulseUserServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewRatePulseExchangeRateServiceClient(cc grpc.ClientConnInterface) RatePulseExchangeRateServiceClient {
-	return &ratePulseExchangeRateServiceClient{cc}
+func NewRatePulseUserServiceClient(cc grpc.ClientConnInterface) RatePulseUserServiceClient {
+	return &ratePulseUserServiceClient{cc}
 }
 
-func (c *ratePulseExchangeRateServiceClient) GetLatestExchangeRates(ctx context.Context, in *GetLatestExchangeRatesRequest, opts ...grpc.CallOption) (*GetLatestExchangeRatesResponse, error) {
+func (c *ratePulseUserServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetLatestExchangeRatesResponse)
-	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_GetLatestExchangeRates_FullMethodName, in, out, cOpts...)
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, RatePulseUserService_GetUser_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// RatePulseExchangeRateServiceServer is the server API for RatePulseExchangeRateService service.
-// All implementations must embed UnimplementedRatePulseExchangeRateServiceServer
+func (c *ratePulseUserServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, RatePulseUserService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseUserServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserResponse)
+	err := c.cc.Invoke(ctx, RatePulseUserService_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseUserServiceClient) AdminUpdateUser(ctx context.Context, in *AdminUpdateUserRequest, opts ...grpc.CallOption) (*AdminUpdateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUpdateUserResponse)
+	err := c.cc.Invoke(ctx, RatePulseUserService_AdminUpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseUserServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, RatePulseUserService_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatePulseUserServiceServer is the server API for RatePulseUserService service.
+// All implementations must embed UnimplementedRatePulseUserServiceServer
 // for forward compatibility.
-type RatePulseExchangeRateServiceServer interface {
-	GetLatestExchangeRates(context.Context, *GetLatestExchangeRatesRequest) (*GetLatestExchangeRatesResponse, error)
-	mustEmbedUnimplementedRatePulseExchangeRateServiceServer()
+type RatePulseUserServiceServer interface {
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	AdminUpdateUser(context.Context, *AdminUpdateUserRequest) (*AdminUpdateUserResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	mustEmbedUnimplementedRatePulseUserServiceServer()
 }
 
-// UnimplementedRatePulseExchangeRateServiceServer must be embedded to have
+// UnimplementedRatePulseUserServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedRatePulseExchangeRateServiceServer struct{}
+type UnimplementedRatePulseUserServiceServer struct{}
 
-func (UnimplementedRatePulseExchangeRateServiceServer) GetLatestExchangeRates(context.Context, *GetLatestExchangeRatesRequest) (*GetLatestExchangeRatesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetLatestExchangeRates not implemented")
+func (UnimplementedRatePulseUserServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUser not implemented")
 }
-func (UnimplementedRatePulseExchangeRateServiceServer) mustEmbedUnimplementedRatePulseExchangeRateServiceServer() {
+func (UnimplementedRatePulseUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUsers not implemented")
 }
-func (UnimplementedRatePulseExchangeRateServiceServer) testEmbeddedByValue() {}
+func (UnimplementedRatePulseUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedRatePulseUserServiceServer) AdminUpdateUser(context.Context, *AdminUpdateUserRequest) (*AdminUpdateUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminUpdateUser not implemented")
+}
+func (UnimplementedRatePulseUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedRatePulseUserServiceServer) mustEmbedUnimplementedRatePulseUserServiceServer() {}
+func (UnimplementedRatePulseUserServiceServer) testEmbeddedByValue()                              {}
 
-// UnsafeRatePulseExchangeRateServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RatePulseExchangeRateServiceServer will
+// UnsafeRatePulseUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatePulseUserServiceServer will
 // result in compilation errors.
-type UnsafeRatePulseExchangeRateServiceServer interface {
-	mustEmbedUnimplementedRatePulseExchangeRateServiceServer()
+type UnsafeRatePulseUserServiceServer interface {
+	mustEmbedUnimplementedRatePulseUserServiceServer()
 }
 
-func RegisterRatePulseExchangeRateServiceServer(s grpc.ServiceRegistrar, srv RatePulseExchangeRateServiceServer) {
-	// If the following call panics, it indicates UnimplementedRatePulseExchangeRateServiceServer was
+func RegisterRatePulseUserServiceServer(s grpc.ServiceRegistrar, srv RatePulseUserServiceServer) {
+	// If the following call panics, it indicates UnimplementedRatePulseUserServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&RatePulseExchangeRateService_ServiceDesc, srv)
+	s.RegisterService(&RatePulseUserService_ServiceDesc, srv)
 }
 
-func _RatePulseExchangeRateService_GetLatestExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLatestExchangeRatesRequest)
+func _RatePulseUserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RatePulseExchangeRateServiceServer).GetLatestExchangeRates(ctx, in)
+		return srv.(RatePulseUserServiceServer).GetUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RatePulseExchangeRateService_GetLatestExchangeRates_FullMethodName,
+		FullMethod: RatePulseUserService_GetUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RatePulseExchangeRateServiceServer).GetLatestExchangeRates(ctx, req.(*GetLatestExchangeRatesRequest))
+		return srv.(RatePulseUserServiceServer).GetUser(ctx, req.(*GetUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// RatePulseExchangeRateService_ServiceDesc is the grpc.ServiceDesc for RatePulseExchangeRateService service.
+func _RatePulseUserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseUserServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseUserService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseUserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseUserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseUserServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseUserService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseUserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseUserService_AdminUpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseUserServiceServer).AdminUpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseUserService_AdminUpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseUserServiceServer).AdminUpdateUser(ctx, req.(*AdminUpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseUserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseUserServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseUserService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseUserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatePulseUserService_ServiceDesc is the grpc.ServiceDesc for RatePulseUserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var RatePulseExchangeRateService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.RatePulseExchangeRateService",
-	HandlerType: (*RatePulseExchangeRateServiceServer)(nil),
+var RatePulseUserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.RatePulseUserService",
+	HandlerType: (*RatePulseUserServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetLatestExchangeRates",
-			Handler:    _RatePulseExchangeRateService_GetLatestExchangeRates_Handler,
+			MethodName: "GetUser",
+			Handler:    _RatePulseUserService_GetUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _RatePulseUserService_ListUsers_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _RatePulseUserService_UpdateUser_Handler,
+		},
+		{
+			MethodName: "AdminUpdateUser",
+			Handler:    _RatePulseUserService_AdminUpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _RatePulseUserService_DeleteUser_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -301,52 +604,3279 @@ var RatePulseExchangeRateService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	RatePulseInternalHealthService_CheckHealth_FullMethodName = "/pb.RatePulseInternalHealthService/CheckHealth"
+	RatePulseExchangeRateService_GetLatestExchangeRates_FullMethodName        = "/pb.RatePulseExchangeRateService/GetLatestExchangeRates"
+	RatePulseExchangeRateService_IngestExchangeRates_FullMethodName           = "/pb.RatePulseExchangeRateService/IngestExchangeRates"
+	RatePulseExchangeRateService_WatchExchangeRates_FullMethodName            = "/pb.RatePulseExchangeRateService/WatchExchangeRates"
+	RatePulseExchangeRateService_GetExchangeRate_FullMethodName               = "/pb.RatePulseExchangeRateService/GetExchangeRate"
+	RatePulseExchangeRateService_CreateExchangeRate_FullMethodName            = "/pb.RatePulseExchangeRateService/CreateExchangeRate"
+	RatePulseExchangeRateService_UpdateExchangeRate_FullMethodName            = "/pb.RatePulseExchangeRateService/UpdateExchangeRate"
+	RatePulseExchangeRateService_DeleteExchangeRate_FullMethodName            = "/pb.RatePulseExchangeRateService/DeleteExchangeRate"
+	RatePulseExchangeRateService_GetHistoricalData_FullMethodName             = "/pb.RatePulseExchangeRateService/GetHistoricalData"
+	RatePulseExchangeRateService_GetCandles_FullMethodName                    = "/pb.RatePulseExchangeRateService/GetCandles"
+	RatePulseExchangeRateService_GetCrossRate_FullMethodName                  = "/pb.RatePulseExchangeRateService/GetCrossRate"
+	RatePulseExchangeRateService_GetSpreads_FullMethodName                    = "/pb.RatePulseExchangeRateService/GetSpreads"
+	RatePulseExchangeRateService_CreateQuote_FullMethodName                   = "/pb.RatePulseExchangeRateService/CreateQuote"
+	RatePulseExchangeRateService_CompareQuotes_FullMethodName                 = "/pb.RatePulseExchangeRateService/CompareQuotes"
+	RatePulseExchangeRateService_ListQuarantinedExchangeRates_FullMethodName  = "/pb.RatePulseExchangeRateService/ListQuarantinedExchangeRates"
+	RatePulseExchangeRateService_ReviewQuarantinedExchangeRate_FullMethodName = "/pb.RatePulseExchangeRateService/ReviewQuarantinedExchangeRate"
 )
 
-// RatePulseInternalHealthServiceClient is the client API for RatePulseInternalHealthService service.
+// RatePulseExchangeRateServiceClient is the client API for RatePulseExchangeRateService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type RatePulseInternalHealthServiceClient interface {
-	CheckHealth(ctx context.Context, in *CheckHealthRequest, opts ...grpc.CallOption) (*CheckHealthResponse, error)
+type RatePulseExchangeRateServiceClient interface {
+	GetLatestExchangeRates(ctx context.Context, in *GetLatestExchangeRatesRequest, opts ...grpc.CallOption) (*GetLatestExchangeRatesResponse, error)
+	IngestExchangeRates(ctx context.Context, in *IngestExchangeRatesRequest, opts ...grpc.CallOption) (*IngestExchangeRatesResponse, error)
+	WatchExchangeRates(ctx context.Context, in *WatchExchangeRatesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchExchangeRatesResponse], error)
+	GetExchangeRate(ctx context.Context, in *GetExchangeRateRequest, opts ...grpc.CallOption) (*GetExchangeRateResponse, error)
+	CreateExchangeRate(ctx context.Context, in *CreateExchangeRateRequest, opts ...grpc.CallOption) (*CreateExchangeRateResponse, error)
+	UpdateExchangeRate(ctx context.Context, in *UpdateExchangeRateRequest, opts ...grpc.CallOption) (*UpdateExchangeRateResponse, error)
+	DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*DeleteExchangeRateResponse, error)
+	GetHistoricalData(ctx context.Context, in *GetHistoricalDataRequest, opts ...grpc.CallOption) (*GetHistoricalDataResponse, error)
+	GetCandles(ctx context.Context, in *GetCandlesRequest, opts ...grpc.CallOption) (*GetCandlesResponse, error)
+	GetCrossRate(ctx context.Context, in *GetCrossRateRequest, opts ...grpc.CallOption) (*GetCrossRateResponse, error)
+	GetSpreads(ctx context.Context, in *GetSpreadsRequest, opts ...grpc.CallOption) (*GetSpreadsResponse, error)
+	CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*CreateQuoteResponse, error)
+	CompareQuotes(ctx context.Context, in *CompareQuotesRequest, opts ...grpc.CallOption) (*CompareQuotesResponse, error)
+	ListQuarantinedExchangeRates(ctx context.Context, in *ListQuarantinedExchangeRatesRequest, opts ...grpc.CallOption) (*ListQuarantinedExchangeRatesResponse, error)
+	ReviewQuarantinedExchangeRate(ctx context.Context, in *ReviewQuarantinedExchangeRateRequest, opts ...grpc.CallOption) (*ReviewQuarantinedExchangeRateResponse, error)
 }
 
-type ratePulseInternalHealthServiceClient struct {
+type ratePulseExchangeRateServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewRatePulseInternalHealthServiceClient(cc grpc.ClientConnInterface) RatePulseInternalHealthServiceClient {
-	return &ratePulseInternalHealthServiceClient{cc}
+func NewRatePulseExchangeRateServiceClient(cc grpc.ClientConnInterface) RatePulseExchangeRateServiceClient {
+	return &ratePulseExchangeRateServiceClient{cc}
 }
 
-func (c *ratePulseInternalHealthServiceClient) CheckHealth(ctx context.Context, in *CheckHealthRequest, opts ...grpc.CallOption) (*CheckHealthResponse, error) {
+func (c *ratePulseExchangeRateServiceClient) GetLatestExchangeRates(ctx context.Context, in *GetLatestExchangeRatesRequest, opts ...grpc.CallOption) (*GetLatestExchangeRatesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CheckHealthResponse)
-	err := c.cc.Invoke(ctx, RatePulseInternalHealthService_CheckHealth_FullMethodName, in, out, cOpts...)
+	out := new(GetLatestExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_GetLatestExchangeRates_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// RatePulseInternalHealthServiceServer is the server API for RatePulseInternalHealthService service.
-// All implementations must embed UnimplementedRatePulseInternalHealthServiceServer
-// for forward compatibility.
-type RatePulseInternalHealthServiceServer interface {
-	CheckHealth(context.Context, *CheckHealthRequest) (*CheckHealthResponse, error)
-	mustEmbedUnimplementedRatePulseInternalHealthServiceServer()
+func (c *ratePulseExchangeRateServiceClient) IngestExchangeRates(ctx context.Context, in *IngestExchangeRatesRequest, opts ...grpc.CallOption) (*IngestExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngestExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_IngestExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedRatePulseInternalHealthServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedRatePulseInternalHealthServiceServer struct{}
+func (c *ratePulseExchangeRateServiceClient) WatchExchangeRates(ctx context.Context, in *WatchExchangeRatesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchExchangeRatesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RatePulseExchangeRateService_ServiceDesc.Streams[0], RatePulseExchangeRateService_WatchExchangeRates_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchExchangeRatesRequest, WatchExchangeRatesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RatePulseExchangeRateService_WatchExchangeRatesClient = grpc.ServerStreamingClient[WatchExchangeRatesResponse]
+
+func (c *ratePulseExchangeRateServiceClient) GetExchangeRate(ctx context.Context, in *GetExchangeRateRequest, opts ...grpc.CallOption) (*GetExchangeRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExchangeRateResponse)
+	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_GetExchangeRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseExchangeRateServiceClient) CreateExchangeRate(ctx context.Context, in *CreateExchangeRateRequest, opts ...grpc.CallOption) (*CreateExchangeRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateExchangeRateResponse)
+	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_CreateExchangeRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseExchangeRateServiceClient) UpdateExchangeRate(ctx context.Context, in *UpdateExchangeRateRequest, opts ...grpc.CallOption) (*UpdateExchangeRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateExchangeRateResponse)
+	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_UpdateExchangeRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseExchangeRateServiceClient) DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*DeleteExchangeRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteExchangeRateResponse)
+	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_DeleteExchangeRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseExchangeRateServiceClient) GetHistoricalData(ctx context.Context, in *GetHistoricalDataRequest, opts ...grpc.CallOption) (*GetHistoricalDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHistoricalDataResponse)
+	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_GetHistoricalData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseExchangeRateServiceClient) GetCandles(ctx context.Context, in *GetCandlesRequest, opts ...grpc.CallOption) (*GetCandlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCandlesResponse)
+	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_GetCandles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseExchangeRateServiceClient) GetCrossRate(ctx context.Context, in *GetCrossRateRequest, opts ...grpc.CallOption) (*GetCrossRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCrossRateResponse)
+	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_GetCrossRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseExchangeRateServiceClient) GetSpreads(ctx context.Context, in *GetSpreadsRequest, opts ...grpc.CallOption) (*GetSpreadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSpreadsResponse)
+	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_GetSpreads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseExchangeRateServiceClient) CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*CreateQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateQuoteResponse)
+	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_CreateQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseExchangeRateServiceClient) CompareQuotes(ctx context.Context, in *CompareQuotesRequest, opts ...grpc.CallOption) (*CompareQuotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareQuotesResponse)
+	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_CompareQuotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseExchangeRateServiceClient) ListQuarantinedExchangeRates(ctx context.Context, in *ListQuarantinedExchangeRatesRequest, opts ...grpc.CallOption) (*ListQuarantinedExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuarantinedExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_ListQuarantinedExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseExchangeRateServiceClient) ReviewQuarantinedExchangeRate(ctx context.Context, in *ReviewQuarantinedExchangeRateRequest, opts ...grpc.CallOption) (*ReviewQuarantinedExchangeRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewQuarantinedExchangeRateResponse)
+	err := c.cc.Invoke(ctx, RatePulseExchangeRateService_ReviewQuarantinedExchangeRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatePulseExchangeRateServiceServer is the server API for RatePulseExchangeRateService service.
+// All implementations must embed UnimplementedRatePulseExchangeRateServiceServer
+// for forward compatibility.
+type RatePulseExchangeRateServiceServer interface {
+	GetLatestExchangeRates(context.Context, *GetLatestExchangeRatesRequest) (*GetLatestExchangeRatesResponse, error)
+	IngestExchangeRates(context.Context, *IngestExchangeRatesRequest) (*IngestExchangeRatesResponse, error)
+	WatchExchangeRates(*WatchExchangeRatesRequest, grpc.ServerStreamingServer[WatchExchangeRatesResponse]) error
+	GetExchangeRate(context.Context, *GetExchangeRateRequest) (*GetExchangeRateResponse, error)
+	CreateExchangeRate(context.Context, *CreateExchangeRateRequest) (*CreateExchangeRateResponse, error)
+	UpdateExchangeRate(context.Context, *UpdateExchangeRateRequest) (*UpdateExchangeRateResponse, error)
+	DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*DeleteExchangeRateResponse, error)
+	GetHistoricalData(context.Context, *GetHistoricalDataRequest) (*GetHistoricalDataResponse, error)
+	GetCandles(context.Context, *GetCandlesRequest) (*GetCandlesResponse, error)
+	GetCrossRate(context.Context, *GetCrossRateRequest) (*GetCrossRateResponse, error)
+	GetSpreads(context.Context, *GetSpreadsRequest) (*GetSpreadsResponse, error)
+	CreateQuote(context.Context, *CreateQuoteRequest) (*CreateQuoteResponse, error)
+	CompareQuotes(context.Context, *CompareQuotesRequest) (*CompareQuotesResponse, error)
+	ListQuarantinedExchangeRates(context.Context, *ListQuarantinedExchangeRatesRequest) (*ListQuarantinedExchangeRatesResponse, error)
+	ReviewQuarantinedExchangeRate(context.Context, *ReviewQuarantinedExchangeRateRequest) (*ReviewQuarantinedExchangeRateResponse, error)
+	mustEmbedUnimplementedRatePulseExchangeRateServiceServer()
+}
+
+// UnimplementedRatePulseExchangeRateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatePulseExchangeRateServiceServer struct{}
+
+func (UnimplementedRatePulseExchangeRateServiceServer) GetLatestExchangeRates(context.Context, *GetLatestExchangeRatesRequest) (*GetLatestExchangeRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLatestExchangeRates not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) IngestExchangeRates(context.Context, *IngestExchangeRatesRequest) (*IngestExchangeRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IngestExchangeRates not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) WatchExchangeRates(*WatchExchangeRatesRequest, grpc.ServerStreamingServer[WatchExchangeRatesResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchExchangeRates not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) GetExchangeRate(context.Context, *GetExchangeRateRequest) (*GetExchangeRateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExchangeRate not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) CreateExchangeRate(context.Context, *CreateExchangeRateRequest) (*CreateExchangeRateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateExchangeRate not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) UpdateExchangeRate(context.Context, *UpdateExchangeRateRequest) (*UpdateExchangeRateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateExchangeRate not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*DeleteExchangeRateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteExchangeRate not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) GetHistoricalData(context.Context, *GetHistoricalDataRequest) (*GetHistoricalDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHistoricalData not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) GetCandles(context.Context, *GetCandlesRequest) (*GetCandlesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCandles not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) GetCrossRate(context.Context, *GetCrossRateRequest) (*GetCrossRateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCrossRate not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) GetSpreads(context.Context, *GetSpreadsRequest) (*GetSpreadsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSpreads not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) CreateQuote(context.Context, *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateQuote not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) CompareQuotes(context.Context, *CompareQuotesRequest) (*CompareQuotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompareQuotes not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) ListQuarantinedExchangeRates(context.Context, *ListQuarantinedExchangeRatesRequest) (*ListQuarantinedExchangeRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQuarantinedExchangeRates not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) ReviewQuarantinedExchangeRate(context.Context, *ReviewQuarantinedExchangeRateRequest) (*ReviewQuarantinedExchangeRateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewQuarantinedExchangeRate not implemented")
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) mustEmbedUnimplementedRatePulseExchangeRateServiceServer() {
+}
+func (UnimplementedRatePulseExchangeRateServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRatePulseExchangeRateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatePulseExchangeRateServiceServer will
+// result in compilation errors.
+type UnsafeRatePulseExchangeRateServiceServer interface {
+	mustEmbedUnimplementedRatePulseExchangeRateServiceServer()
+}
+
+func RegisterRatePulseExchangeRateServiceServer(s grpc.ServiceRegistrar, srv RatePulseExchangeRateServiceServer) {
+	// If the following call panics, it indicates UnimplementedRatePulseExchangeRateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatePulseExchangeRateService_ServiceDesc, srv)
+}
+
+func _RatePulseExchangeRateService_GetLatestExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseExchangeRateServiceServer).GetLatestExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseExchangeRateService_GetLatestExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseExchangeRateServiceServer).GetLatestExchangeRates(ctx, req.(*GetLatestExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseExchangeRateService_IngestExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IngestExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseExchangeRateServiceServer).IngestExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseExchangeRateService_IngestExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseExchangeRateServiceServer).IngestExchangeRates(ctx, req.(*IngestExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseExchangeRateService_WatchExchangeRates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchExchangeRatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RatePulseExchangeRateServiceServer).WatchExchangeRates(m, &grpc.GenericServerStream[WatchExchangeRatesRequest, WatchExchangeRatesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RatePulseExchangeRateService_WatchExchangeRatesServer = grpc.ServerStreamingServer[WatchExchangeRatesResponse]
+
+func _RatePulseExchangeRateService_GetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseExchangeRateServiceServer).GetExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseExchangeRateService_GetExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseExchangeRateServiceServer).GetExchangeRate(ctx, req.(*GetExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseExchangeRateService_CreateExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseExchangeRateServiceServer).CreateExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseExchangeRateService_CreateExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseExchangeRateServiceServer).CreateExchangeRate(ctx, req.(*CreateExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseExchangeRateService_UpdateExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseExchangeRateServiceServer).UpdateExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseExchangeRateService_UpdateExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseExchangeRateServiceServer).UpdateExchangeRate(ctx, req.(*UpdateExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseExchangeRateService_DeleteExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseExchangeRateServiceServer).DeleteExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseExchangeRateService_DeleteExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseExchangeRateServiceServer).DeleteExchangeRate(ctx, req.(*DeleteExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseExchangeRateService_GetHistoricalData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoricalDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseExchangeRateServiceServer).GetHistoricalData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseExchangeRateService_GetHistoricalData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseExchangeRateServiceServer).GetHistoricalData(ctx, req.(*GetHistoricalDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseExchangeRateService_GetCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseExchangeRateServiceServer).GetCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseExchangeRateService_GetCandles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseExchangeRateServiceServer).GetCandles(ctx, req.(*GetCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseExchangeRateService_GetCrossRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCrossRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseExchangeRateServiceServer).GetCrossRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseExchangeRateService_GetCrossRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseExchangeRateServiceServer).GetCrossRate(ctx, req.(*GetCrossRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseExchangeRateService_GetSpreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseExchangeRateServiceServer).GetSpreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseExchangeRateService_GetSpreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseExchangeRateServiceServer).GetSpreads(ctx, req.(*GetSpreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseExchangeRateService_CreateQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseExchangeRateServiceServer).CreateQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseExchangeRateService_CreateQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseExchangeRateServiceServer).CreateQuote(ctx, req.(*CreateQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseExchangeRateService_CompareQuotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareQuotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseExchangeRateServiceServer).CompareQuotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseExchangeRateService_CompareQuotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseExchangeRateServiceServer).CompareQuotes(ctx, req.(*CompareQuotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseExchangeRateService_ListQuarantinedExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuarantinedExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseExchangeRateServiceServer).ListQuarantinedExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseExchangeRateService_ListQuarantinedExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseExchangeRateServiceServer).ListQuarantinedExchangeRates(ctx, req.(*ListQuarantinedExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseExchangeRateService_ReviewQuarantinedExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewQuarantinedExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseExchangeRateServiceServer).ReviewQuarantinedExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseExchangeRateService_ReviewQuarantinedExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseExchangeRateServiceServer).ReviewQuarantinedExchangeRate(ctx, req.(*ReviewQuarantinedExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatePulseExchangeRateService_ServiceDesc is the grpc.ServiceDesc for RatePulseExchangeRateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatePulseExchangeRateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.RatePulseExchangeRateService",
+	HandlerType: (*RatePulseExchangeRateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLatestExchangeRates",
+			Handler:    _RatePulseExchangeRateService_GetLatestExchangeRates_Handler,
+		},
+		{
+			MethodName: "IngestExchangeRates",
+			Handler:    _RatePulseExchangeRateService_IngestExchangeRates_Handler,
+		},
+		{
+			MethodName: "GetExchangeRate",
+			Handler:    _RatePulseExchangeRateService_GetExchangeRate_Handler,
+		},
+		{
+			MethodName: "CreateExchangeRate",
+			Handler:    _RatePulseExchangeRateService_CreateExchangeRate_Handler,
+		},
+		{
+			MethodName: "UpdateExchangeRate",
+			Handler:    _RatePulseExchangeRateService_UpdateExchangeRate_Handler,
+		},
+		{
+			MethodName: "DeleteExchangeRate",
+			Handler:    _RatePulseExchangeRateService_DeleteExchangeRate_Handler,
+		},
+		{
+			MethodName: "GetHistoricalData",
+			Handler:    _RatePulseExchangeRateService_GetHistoricalData_Handler,
+		},
+		{
+			MethodName: "GetCandles",
+			Handler:    _RatePulseExchangeRateService_GetCandles_Handler,
+		},
+		{
+			MethodName: "GetCrossRate",
+			Handler:    _RatePulseExchangeRateService_GetCrossRate_Handler,
+		},
+		{
+			MethodName: "GetSpreads",
+			Handler:    _RatePulseExchangeRateService_GetSpreads_Handler,
+		},
+		{
+			MethodName: "CreateQuote",
+			Handler:    _RatePulseExchangeRateService_CreateQuote_Handler,
+		},
+		{
+			MethodName: "CompareQuotes",
+			Handler:    _RatePulseExchangeRateService_CompareQuotes_Handler,
+		},
+		{
+			MethodName: "ListQuarantinedExchangeRates",
+			Handler:    _RatePulseExchangeRateService_ListQuarantinedExchangeRates_Handler,
+		},
+		{
+			MethodName: "ReviewQuarantinedExchangeRate",
+			Handler:    _RatePulseExchangeRateService_ReviewQuarantinedExchangeRate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchExchangeRates",
+			Handler:       _RatePulseExchangeRateService_WatchExchangeRates_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "service_rate_pulse.proto",
+}
+
+const (
+	RatePulseRateSourceFeeRuleService_CreateRateSourceFeeRule_FullMethodName    = "/pb.RatePulseRateSourceFeeRuleService/CreateRateSourceFeeRule"
+	RatePulseRateSourceFeeRuleService_GetRateSourceFeeRule_FullMethodName       = "/pb.RatePulseRateSourceFeeRuleService/GetRateSourceFeeRule"
+	RatePulseRateSourceFeeRuleService_ListRateSourceFeeRules_FullMethodName     = "/pb.RatePulseRateSourceFeeRuleService/ListRateSourceFeeRules"
+	RatePulseRateSourceFeeRuleService_GetActiveRateSourceFeeRule_FullMethodName = "/pb.RatePulseRateSourceFeeRuleService/GetActiveRateSourceFeeRule"
+	RatePulseRateSourceFeeRuleService_UpdateRateSourceFeeRule_FullMethodName    = "/pb.RatePulseRateSourceFeeRuleService/UpdateRateSourceFeeRule"
+	RatePulseRateSourceFeeRuleService_DeleteRateSourceFeeRule_FullMethodName    = "/pb.RatePulseRateSourceFeeRuleService/DeleteRateSourceFeeRule"
+)
+
+// RatePulseRateSourceFeeRuleServiceClient is the client API for RatePulseRateSourceFeeRuleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatePulseRateSourceFeeRuleServiceClient interface {
+	CreateRateSourceFeeRule(ctx context.Context, in *CreateRateSourceFeeRuleRequest, opts ...grpc.CallOption) (*CreateRateSourceFeeRuleResponse, error)
+	GetRateSourceFeeRule(ctx context.Context, in *GetRateSourceFeeRuleRequest, opts ...grpc.CallOption) (*GetRateSourceFeeRuleResponse, error)
+	ListRateSourceFeeRules(ctx context.Context, in *ListRateSourceFeeRulesRequest, opts ...grpc.CallOption) (*ListRateSourceFeeRulesResponse, error)
+	GetActiveRateSourceFeeRule(ctx context.Context, in *GetActiveRateSourceFeeRuleRequest, opts ...grpc.CallOption) (*GetActiveRateSourceFeeRuleResponse, error)
+	UpdateRateSourceFeeRule(ctx context.Context, in *UpdateRateSourceFeeRuleRequest, opts ...grpc.CallOption) (*UpdateRateSourceFeeRuleResponse, error)
+	DeleteRateSourceFeeRule(ctx context.Context, in *DeleteRateSourceFeeRuleRequest, opts ...grpc.CallOption) (*DeleteRateSourceFeeRuleResponse, error)
+}
+
+type ratePulseRateSourceFeeRuleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatePulseRateSourceFeeRuleServiceClient(cc grpc.ClientConnInterface) RatePulseRateSourceFeeRuleServiceClient {
+	return &ratePulseRateSourceFeeRuleServiceClient{cc}
+}
+
+func (c *ratePulseRateSourceFeeRuleServiceClient) CreateRateSourceFeeRule(ctx context.Context, in *CreateRateSourceFeeRuleRequest, opts ...grpc.CallOption) (*CreateRateSourceFeeRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRateSourceFeeRuleResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateSourceFeeRuleService_CreateRateSourceFeeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseRateSourceFeeRuleServiceClient) GetRateSourceFeeRule(ctx context.Context, in *GetRateSourceFeeRuleRequest, opts ...grpc.CallOption) (*GetRateSourceFeeRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRateSourceFeeRuleResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateSourceFeeRuleService_GetRateSourceFeeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseRateSourceFeeRuleServiceClient) ListRateSourceFeeRules(ctx context.Context, in *ListRateSourceFeeRulesRequest, opts ...grpc.CallOption) (*ListRateSourceFeeRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRateSourceFeeRulesResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateSourceFeeRuleService_ListRateSourceFeeRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseRateSourceFeeRuleServiceClient) GetActiveRateSourceFeeRule(ctx context.Context, in *GetActiveRateSourceFeeRuleRequest, opts ...grpc.CallOption) (*GetActiveRateSourceFeeRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActiveRateSourceFeeRuleResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateSourceFeeRuleService_GetActiveRateSourceFeeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseRateSourceFeeRuleServiceClient) UpdateRateSourceFeeRule(ctx context.Context, in *UpdateRateSourceFeeRuleRequest, opts ...grpc.CallOption) (*UpdateRateSourceFeeRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRateSourceFeeRuleResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateSourceFeeRuleService_UpdateRateSourceFeeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseRateSourceFeeRuleServiceClient) DeleteRateSourceFeeRule(ctx context.Context, in *DeleteRateSourceFeeRuleRequest, opts ...grpc.CallOption) (*DeleteRateSourceFeeRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRateSourceFeeRuleResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateSourceFeeRuleService_DeleteRateSourceFeeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatePulseRateSourceFeeRuleServiceServer is the server API for RatePulseRateSourceFeeRuleService service.
+// All implementations must embed UnimplementedRatePulseRateSourceFeeRuleServiceServer
+// for forward compatibility.
+type RatePulseRateSourceFeeRuleServiceServer interface {
+	CreateRateSourceFeeRule(context.Context, *CreateRateSourceFeeRuleRequest) (*CreateRateSourceFeeRuleResponse, error)
+	GetRateSourceFeeRule(context.Context, *GetRateSourceFeeRuleRequest) (*GetRateSourceFeeRuleResponse, error)
+	ListRateSourceFeeRules(context.Context, *ListRateSourceFeeRulesRequest) (*ListRateSourceFeeRulesResponse, error)
+	GetActiveRateSourceFeeRule(context.Context, *GetActiveRateSourceFeeRuleRequest) (*GetActiveRateSourceFeeRuleResponse, error)
+	UpdateRateSourceFeeRule(context.Context, *UpdateRateSourceFeeRuleRequest) (*UpdateRateSourceFeeRuleResponse, error)
+	DeleteRateSourceFeeRule(context.Context, *DeleteRateSourceFeeRuleRequest) (*DeleteRateSourceFeeRuleResponse, error)
+	mustEmbedUnimplementedRatePulseRateSourceFeeRuleServiceServer()
+}
+
+// UnimplementedRatePulseRateSourceFeeRuleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatePulseRateSourceFeeRuleServiceServer struct{}
+
+func (UnimplementedRatePulseRateSourceFeeRuleServiceServer) CreateRateSourceFeeRule(context.Context, *CreateRateSourceFeeRuleRequest) (*CreateRateSourceFeeRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRateSourceFeeRule not implemented")
+}
+func (UnimplementedRatePulseRateSourceFeeRuleServiceServer) GetRateSourceFeeRule(context.Context, *GetRateSourceFeeRuleRequest) (*GetRateSourceFeeRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRateSourceFeeRule not implemented")
+}
+func (UnimplementedRatePulseRateSourceFeeRuleServiceServer) ListRateSourceFeeRules(context.Context, *ListRateSourceFeeRulesRequest) (*ListRateSourceFeeRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRateSourceFeeRules not implemented")
+}
+func (UnimplementedRatePulseRateSourceFeeRuleServiceServer) GetActiveRateSourceFeeRule(context.Context, *GetActiveRateSourceFeeRuleRequest) (*GetActiveRateSourceFeeRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActiveRateSourceFeeRule not implemented")
+}
+func (UnimplementedRatePulseRateSourceFeeRuleServiceServer) UpdateRateSourceFeeRule(context.Context, *UpdateRateSourceFeeRuleRequest) (*UpdateRateSourceFeeRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRateSourceFeeRule not implemented")
+}
+func (UnimplementedRatePulseRateSourceFeeRuleServiceServer) DeleteRateSourceFeeRule(context.Context, *DeleteRateSourceFeeRuleRequest) (*DeleteRateSourceFeeRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRateSourceFeeRule not implemented")
+}
+func (UnimplementedRatePulseRateSourceFeeRuleServiceServer) mustEmbedUnimplementedRatePulseRateSourceFeeRuleServiceServer() {
+}
+func (UnimplementedRatePulseRateSourceFeeRuleServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRatePulseRateSourceFeeRuleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatePulseRateSourceFeeRuleServiceServer will
+// result in compilation errors.
+type UnsafeRatePulseRateSourceFeeRuleServiceServer interface {
+	mustEmbedUnimplementedRatePulseRateSourceFeeRuleServiceServer()
+}
+
+func RegisterRatePulseRateSourceFeeRuleServiceServer(s grpc.ServiceRegistrar, srv RatePulseRateSourceFeeRuleServiceServer) {
+	// If the following call panics, it indicates UnimplementedRatePulseRateSourceFeeRuleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatePulseRateSourceFeeRuleService_ServiceDesc, srv)
+}
+
+func _RatePulseRateSourceFeeRuleService_CreateRateSourceFeeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRateSourceFeeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateSourceFeeRuleServiceServer).CreateRateSourceFeeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateSourceFeeRuleService_CreateRateSourceFeeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateSourceFeeRuleServiceServer).CreateRateSourceFeeRule(ctx, req.(*CreateRateSourceFeeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseRateSourceFeeRuleService_GetRateSourceFeeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateSourceFeeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateSourceFeeRuleServiceServer).GetRateSourceFeeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateSourceFeeRuleService_GetRateSourceFeeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateSourceFeeRuleServiceServer).GetRateSourceFeeRule(ctx, req.(*GetRateSourceFeeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseRateSourceFeeRuleService_ListRateSourceFeeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRateSourceFeeRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateSourceFeeRuleServiceServer).ListRateSourceFeeRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateSourceFeeRuleService_ListRateSourceFeeRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateSourceFeeRuleServiceServer).ListRateSourceFeeRules(ctx, req.(*ListRateSourceFeeRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseRateSourceFeeRuleService_GetActiveRateSourceFeeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveRateSourceFeeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateSourceFeeRuleServiceServer).GetActiveRateSourceFeeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateSourceFeeRuleService_GetActiveRateSourceFeeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateSourceFeeRuleServiceServer).GetActiveRateSourceFeeRule(ctx, req.(*GetActiveRateSourceFeeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseRateSourceFeeRuleService_UpdateRateSourceFeeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRateSourceFeeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateSourceFeeRuleServiceServer).UpdateRateSourceFeeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateSourceFeeRuleService_UpdateRateSourceFeeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateSourceFeeRuleServiceServer).UpdateRateSourceFeeRule(ctx, req.(*UpdateRateSourceFeeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseRateSourceFeeRuleService_DeleteRateSourceFeeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRateSourceFeeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateSourceFeeRuleServiceServer).DeleteRateSourceFeeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateSourceFeeRuleService_DeleteRateSourceFeeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateSourceFeeRuleServiceServer).DeleteRateSourceFeeRule(ctx, req.(*DeleteRateSourceFeeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatePulseRateSourceFeeRuleService_ServiceDesc is the grpc.ServiceDesc for RatePulseRateSourceFeeRuleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatePulseRateSourceFeeRuleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.RatePulseRateSourceFeeRuleService",
+	HandlerType: (*RatePulseRateSourceFeeRuleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRateSourceFeeRule",
+			Handler:    _RatePulseRateSourceFeeRuleService_CreateRateSourceFeeRule_Handler,
+		},
+		{
+			MethodName: "GetRateSourceFeeRule",
+			Handler:    _RatePulseRateSourceFeeRuleService_GetRateSourceFeeRule_Handler,
+		},
+		{
+			MethodName: "ListRateSourceFeeRules",
+			Handler:    _RatePulseRateSourceFeeRuleService_ListRateSourceFeeRules_Handler,
+		},
+		{
+			MethodName: "GetActiveRateSourceFeeRule",
+			Handler:    _RatePulseRateSourceFeeRuleService_GetActiveRateSourceFeeRule_Handler,
+		},
+		{
+			MethodName: "UpdateRateSourceFeeRule",
+			Handler:    _RatePulseRateSourceFeeRuleService_UpdateRateSourceFeeRule_Handler,
+		},
+		{
+			MethodName: "DeleteRateSourceFeeRule",
+			Handler:    _RatePulseRateSourceFeeRuleService_DeleteRateSourceFeeRule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_rate_pulse.proto",
+}
+
+const (
+	RatePulseRateAlertService_CreateRateAlert_FullMethodName = "/pb.RatePulseRateAlertService/CreateRateAlert"
+	RatePulseRateAlertService_GetRateAlert_FullMethodName    = "/pb.RatePulseRateAlertService/GetRateAlert"
+	RatePulseRateAlertService_ListRateAlerts_FullMethodName  = "/pb.RatePulseRateAlertService/ListRateAlerts"
+	RatePulseRateAlertService_UpdateRateAlert_FullMethodName = "/pb.RatePulseRateAlertService/UpdateRateAlert"
+	RatePulseRateAlertService_DeleteRateAlert_FullMethodName = "/pb.RatePulseRateAlertService/DeleteRateAlert"
+)
+
+// RatePulseRateAlertServiceClient is the client API for RatePulseRateAlertService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatePulseRateAlertServiceClient interface {
+	CreateRateAlert(ctx context.Context, in *CreateRateAlertRequest, opts ...grpc.CallOption) (*CreateRateAlertResponse, error)
+	GetRateAlert(ctx context.Context, in *GetRateAlertRequest, opts ...grpc.CallOption) (*GetRateAlertResponse, error)
+	ListRateAlerts(ctx context.Context, in *ListRateAlertsRequest, opts ...grpc.CallOption) (*ListRateAlertsResponse, error)
+	UpdateRateAlert(ctx context.Context, in *UpdateRateAlertRequest, opts ...grpc.CallOption) (*UpdateRateAlertResponse, error)
+	DeleteRateAlert(ctx context.Context, in *DeleteRateAlertRequest, opts ...grpc.CallOption) (*DeleteRateAlertResponse, error)
+}
+
+type ratePulseRateAlertServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatePulseRateAlertServiceClient(cc grpc.ClientConnInterface) RatePulseRateAlertServiceClient {
+	return &ratePulseRateAlertServiceClient{cc}
+}
+
+func (c *ratePulseRateAlertServiceClient) CreateRateAlert(ctx context.Context, in *CreateRateAlertRequest, opts ...grpc.CallOption) (*CreateRateAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRateAlertResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateAlertService_CreateRateAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseRateAlertServiceClient) GetRateAlert(ctx context.Context, in *GetRateAlertRequest, opts ...grpc.CallOption) (*GetRateAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRateAlertResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateAlertService_GetRateAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseRateAlertServiceClient) ListRateAlerts(ctx context.Context, in *ListRateAlertsRequest, opts ...grpc.CallOption) (*ListRateAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRateAlertsResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateAlertService_ListRateAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseRateAlertServiceClient) UpdateRateAlert(ctx context.Context, in *UpdateRateAlertRequest, opts ...grpc.CallOption) (*UpdateRateAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRateAlertResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateAlertService_UpdateRateAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseRateAlertServiceClient) DeleteRateAlert(ctx context.Context, in *DeleteRateAlertRequest, opts ...grpc.CallOption) (*DeleteRateAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRateAlertResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateAlertService_DeleteRateAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatePulseRateAlertServiceServer is the server API for RatePulseRateAlertService service.
+// All implementations must embed UnimplementedRatePulseRateAlertServiceServer
+// for forward compatibility.
+type RatePulseRateAlertServiceServer interface {
+	CreateRateAlert(context.Context, *CreateRateAlertRequest) (*CreateRateAlertResponse, error)
+	GetRateAlert(context.Context, *GetRateAlertRequest) (*GetRateAlertResponse, error)
+	ListRateAlerts(context.Context, *ListRateAlertsRequest) (*ListRateAlertsResponse, error)
+	UpdateRateAlert(context.Context, *UpdateRateAlertRequest) (*UpdateRateAlertResponse, error)
+	DeleteRateAlert(context.Context, *DeleteRateAlertRequest) (*DeleteRateAlertResponse, error)
+	mustEmbedUnimplementedRatePulseRateAlertServiceServer()
+}
+
+// UnimplementedRatePulseRateAlertServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatePulseRateAlertServiceServer struct{}
+
+func (UnimplementedRatePulseRateAlertServiceServer) CreateRateAlert(context.Context, *CreateRateAlertRequest) (*CreateRateAlertResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRateAlert not implemented")
+}
+func (UnimplementedRatePulseRateAlertServiceServer) GetRateAlert(context.Context, *GetRateAlertRequest) (*GetRateAlertResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRateAlert not implemented")
+}
+func (UnimplementedRatePulseRateAlertServiceServer) ListRateAlerts(context.Context, *ListRateAlertsRequest) (*ListRateAlertsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRateAlerts not implemented")
+}
+func (UnimplementedRatePulseRateAlertServiceServer) UpdateRateAlert(context.Context, *UpdateRateAlertRequest) (*UpdateRateAlertResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRateAlert not implemented")
+}
+func (UnimplementedRatePulseRateAlertServiceServer) DeleteRateAlert(context.Context, *DeleteRateAlertRequest) (*DeleteRateAlertResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRateAlert not implemented")
+}
+func (UnimplementedRatePulseRateAlertServiceServer) mustEmbedUnimplementedRatePulseRateAlertServiceServer() {
+}
+func (UnimplementedRatePulseRateAlertServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRatePulseRateAlertServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatePulseRateAlertServiceServer will
+// result in compilation errors.
+type UnsafeRatePulseRateAlertServiceServer interface {
+	mustEmbedUnimplementedRatePulseRateAlertServiceServer()
+}
+
+func RegisterRatePulseRateAlertServiceServer(s grpc.ServiceRegistrar, srv RatePulseRateAlertServiceServer) {
+	// If the following call panics, it indicates UnimplementedRatePulseRateAlertServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatePulseRateAlertService_ServiceDesc, srv)
+}
+
+func _RatePulseRateAlertService_CreateRateAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRateAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateAlertServiceServer).CreateRateAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateAlertService_CreateRateAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateAlertServiceServer).CreateRateAlert(ctx, req.(*CreateRateAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseRateAlertService_GetRateAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateAlertServiceServer).GetRateAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateAlertService_GetRateAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateAlertServiceServer).GetRateAlert(ctx, req.(*GetRateAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseRateAlertService_ListRateAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRateAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateAlertServiceServer).ListRateAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateAlertService_ListRateAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateAlertServiceServer).ListRateAlerts(ctx, req.(*ListRateAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseRateAlertService_UpdateRateAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRateAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateAlertServiceServer).UpdateRateAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateAlertService_UpdateRateAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateAlertServiceServer).UpdateRateAlert(ctx, req.(*UpdateRateAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseRateAlertService_DeleteRateAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRateAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateAlertServiceServer).DeleteRateAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateAlertService_DeleteRateAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateAlertServiceServer).DeleteRateAlert(ctx, req.(*DeleteRateAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatePulseRateAlertService_ServiceDesc is the grpc.ServiceDesc for RatePulseRateAlertService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatePulseRateAlertService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.RatePulseRateAlertService",
+	HandlerType: (*RatePulseRateAlertServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRateAlert",
+			Handler:    _RatePulseRateAlertService_CreateRateAlert_Handler,
+		},
+		{
+			MethodName: "GetRateAlert",
+			Handler:    _RatePulseRateAlertService_GetRateAlert_Handler,
+		},
+		{
+			MethodName: "ListRateAlerts",
+			Handler:    _RatePulseRateAlertService_ListRateAlerts_Handler,
+		},
+		{
+			MethodName: "UpdateRateAlert",
+			Handler:    _RatePulseRateAlertService_UpdateRateAlert_Handler,
+		},
+		{
+			MethodName: "DeleteRateAlert",
+			Handler:    _RatePulseRateAlertService_DeleteRateAlert_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_rate_pulse.proto",
+}
+
+const (
+	RatePulseCurrencyService_ListCurrencies_FullMethodName = "/pb.RatePulseCurrencyService/ListCurrencies"
+	RatePulseCurrencyService_GetCurrency_FullMethodName    = "/pb.RatePulseCurrencyService/GetCurrency"
+	RatePulseCurrencyService_CreateCurrency_FullMethodName = "/pb.RatePulseCurrencyService/CreateCurrency"
+	RatePulseCurrencyService_UpdateCurrency_FullMethodName = "/pb.RatePulseCurrencyService/UpdateCurrency"
+	RatePulseCurrencyService_DeleteCurrency_FullMethodName = "/pb.RatePulseCurrencyService/DeleteCurrency"
+)
+
+// RatePulseCurrencyServiceClient is the client API for RatePulseCurrencyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatePulseCurrencyServiceClient interface {
+	ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error)
+	GetCurrency(ctx context.Context, in *GetCurrencyRequest, opts ...grpc.CallOption) (*GetCurrencyResponse, error)
+	CreateCurrency(ctx context.Context, in *CreateCurrencyRequest, opts ...grpc.CallOption) (*CreateCurrencyResponse, error)
+	UpdateCurrency(ctx context.Context, in *UpdateCurrencyRequest, opts ...grpc.CallOption) (*UpdateCurrencyResponse, error)
+	DeleteCurrency(ctx context.Context, in *DeleteCurrencyRequest, opts ...grpc.CallOption) (*DeleteCurrencyResponse, error)
+}
+
+type ratePulseCurrencyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatePulseCurrencyServiceClient(cc grpc.ClientConnInterface) RatePulseCurrencyServiceClient {
+	return &ratePulseCurrencyServiceClient{cc}
+}
+
+func (c *ratePulseCurrencyServiceClient) ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCurrenciesResponse)
+	err := c.cc.Invoke(ctx, RatePulseCurrencyService_ListCurrencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseCurrencyServiceClient) GetCurrency(ctx context.Context, in *GetCurrencyRequest, opts ...grpc.CallOption) (*GetCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCurrencyResponse)
+	err := c.cc.Invoke(ctx, RatePulseCurrencyService_GetCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseCurrencyServiceClient) CreateCurrency(ctx context.Context, in *CreateCurrencyRequest, opts ...grpc.CallOption) (*CreateCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCurrencyResponse)
+	err := c.cc.Invoke(ctx, RatePulseCurrencyService_CreateCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseCurrencyServiceClient) UpdateCurrency(ctx context.Context, in *UpdateCurrencyRequest, opts ...grpc.CallOption) (*UpdateCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCurrencyResponse)
+	err := c.cc.Invoke(ctx, RatePulseCurrencyService_UpdateCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseCurrencyServiceClient) DeleteCurrency(ctx context.Context, in *DeleteCurrencyRequest, opts ...grpc.CallOption) (*DeleteCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCurrencyResponse)
+	err := c.cc.Invoke(ctx, RatePulseCurrencyService_DeleteCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatePulseCurrencyServiceServer is the server API for RatePulseCurrencyService service.
+// All implementations must embed UnimplementedRatePulseCurrencyServiceServer
+// for forward compatibility.
+type RatePulseCurrencyServiceServer interface {
+	ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error)
+	GetCurrency(context.Context, *GetCurrencyRequest) (*GetCurrencyResponse, error)
+	CreateCurrency(context.Context, *CreateCurrencyRequest) (*CreateCurrencyResponse, error)
+	UpdateCurrency(context.Context, *UpdateCurrencyRequest) (*UpdateCurrencyResponse, error)
+	DeleteCurrency(context.Context, *DeleteCurrencyRequest) (*DeleteCurrencyResponse, error)
+	mustEmbedUnimplementedRatePulseCurrencyServiceServer()
+}
+
+// UnimplementedRatePulseCurrencyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatePulseCurrencyServiceServer struct{}
+
+func (UnimplementedRatePulseCurrencyServiceServer) ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCurrencies not implemented")
+}
+func (UnimplementedRatePulseCurrencyServiceServer) GetCurrency(context.Context, *GetCurrencyRequest) (*GetCurrencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCurrency not implemented")
+}
+func (UnimplementedRatePulseCurrencyServiceServer) CreateCurrency(context.Context, *CreateCurrencyRequest) (*CreateCurrencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCurrency not implemented")
+}
+func (UnimplementedRatePulseCurrencyServiceServer) UpdateCurrency(context.Context, *UpdateCurrencyRequest) (*UpdateCurrencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCurrency not implemented")
+}
+func (UnimplementedRatePulseCurrencyServiceServer) DeleteCurrency(context.Context, *DeleteCurrencyRequest) (*DeleteCurrencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCurrency not implemented")
+}
+func (UnimplementedRatePulseCurrencyServiceServer) mustEmbedUnimplementedRatePulseCurrencyServiceServer() {
+}
+func (UnimplementedRatePulseCurrencyServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRatePulseCurrencyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatePulseCurrencyServiceServer will
+// result in compilation errors.
+type UnsafeRatePulseCurrencyServiceServer interface {
+	mustEmbedUnimplementedRatePulseCurrencyServiceServer()
+}
+
+func RegisterRatePulseCurrencyServiceServer(s grpc.ServiceRegistrar, srv RatePulseCurrencyServiceServer) {
+	// If the following call panics, it indicates UnimplementedRatePulseCurrencyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatePulseCurrencyService_ServiceDesc, srv)
+}
+
+func _RatePulseCurrencyService_ListCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCurrenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseCurrencyServiceServer).ListCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseCurrencyService_ListCurrencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseCurrencyServiceServer).ListCurrencies(ctx, req.(*ListCurrenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseCurrencyService_GetCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseCurrencyServiceServer).GetCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseCurrencyService_GetCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseCurrencyServiceServer).GetCurrency(ctx, req.(*GetCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseCurrencyService_CreateCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseCurrencyServiceServer).CreateCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseCurrencyService_CreateCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseCurrencyServiceServer).CreateCurrency(ctx, req.(*CreateCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseCurrencyService_UpdateCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseCurrencyServiceServer).UpdateCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseCurrencyService_UpdateCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseCurrencyServiceServer).UpdateCurrency(ctx, req.(*UpdateCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseCurrencyService_DeleteCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseCurrencyServiceServer).DeleteCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseCurrencyService_DeleteCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseCurrencyServiceServer).DeleteCurrency(ctx, req.(*DeleteCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatePulseCurrencyService_ServiceDesc is the grpc.ServiceDesc for RatePulseCurrencyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatePulseCurrencyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.RatePulseCurrencyService",
+	HandlerType: (*RatePulseCurrencyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCurrencies",
+			Handler:    _RatePulseCurrencyService_ListCurrencies_Handler,
+		},
+		{
+			MethodName: "GetCurrency",
+			Handler:    _RatePulseCurrencyService_GetCurrency_Handler,
+		},
+		{
+			MethodName: "CreateCurrency",
+			Handler:    _RatePulseCurrencyService_CreateCurrency_Handler,
+		},
+		{
+			MethodName: "UpdateCurrency",
+			Handler:    _RatePulseCurrencyService_UpdateCurrency_Handler,
+		},
+		{
+			MethodName: "DeleteCurrency",
+			Handler:    _RatePulseCurrencyService_DeleteCurrency_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_rate_pulse.proto",
+}
+
+const (
+	RatePulseCountryService_ListCountries_FullMethodName    = "/pb.RatePulseCountryService/ListCountries"
+	RatePulseCountryService_GetCountry_FullMethodName       = "/pb.RatePulseCountryService/GetCountry"
+	RatePulseCountryService_GetCountryByCode_FullMethodName = "/pb.RatePulseCountryService/GetCountryByCode"
+	RatePulseCountryService_CreateCountry_FullMethodName    = "/pb.RatePulseCountryService/CreateCountry"
+	RatePulseCountryService_UpdateCountry_FullMethodName    = "/pb.RatePulseCountryService/UpdateCountry"
+	RatePulseCountryService_DeleteCountry_FullMethodName    = "/pb.RatePulseCountryService/DeleteCountry"
+)
+
+// RatePulseCountryServiceClient is the client API for RatePulseCountryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatePulseCountryServiceClient interface {
+	ListCountries(ctx context.Context, in *ListCountriesRequest, opts ...grpc.CallOption) (*ListCountriesResponse, error)
+	GetCountry(ctx context.Context, in *GetCountryRequest, opts ...grpc.CallOption) (*GetCountryResponse, error)
+	GetCountryByCode(ctx context.Context, in *GetCountryByCodeRequest, opts ...grpc.CallOption) (*GetCountryByCodeResponse, error)
+	CreateCountry(ctx context.Context, in *CreateCountryRequest, opts ...grpc.CallOption) (*CreateCountryResponse, error)
+	UpdateCountry(ctx context.Context, in *UpdateCountryRequest, opts ...grpc.CallOption) (*UpdateCountryResponse, error)
+	DeleteCountry(ctx context.Context, in *DeleteCountryRequest, opts ...grpc.CallOption) (*DeleteCountryResponse, error)
+}
+
+type ratePulseCountryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatePulseCountryServiceClient(cc grpc.ClientConnInterface) RatePulseCountryServiceClient {
+	return &ratePulseCountryServiceClient{cc}
+}
+
+func (c *ratePulseCountryServiceClient) ListCountries(ctx context.Context, in *ListCountriesRequest, opts ...grpc.CallOption) (*ListCountriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCountriesResponse)
+	err := c.cc.Invoke(ctx, RatePulseCountryService_ListCountries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseCountryServiceClient) GetCountry(ctx context.Context, in *GetCountryRequest, opts ...grpc.CallOption) (*GetCountryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCountryResponse)
+	err := c.cc.Invoke(ctx, RatePulseCountryService_GetCountry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseCountryServiceClient) GetCountryByCode(ctx context.Context, in *GetCountryByCodeRequest, opts ...grpc.CallOption) (*GetCountryByCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCountryByCodeResponse)
+	err := c.cc.Invoke(ctx, RatePulseCountryService_GetCountryByCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseCountryServiceClient) CreateCountry(ctx context.Context, in *CreateCountryRequest, opts ...grpc.CallOption) (*CreateCountryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCountryResponse)
+	err := c.cc.Invoke(ctx, RatePulseCountryService_CreateCountry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseCountryServiceClient) UpdateCountry(ctx context.Context, in *UpdateCountryRequest, opts ...grpc.CallOption) (*UpdateCountryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCountryResponse)
+	err := c.cc.Invoke(ctx, RatePulseCountryService_UpdateCountry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseCountryServiceClient) DeleteCountry(ctx context.Context, in *DeleteCountryRequest, opts ...grpc.CallOption) (*DeleteCountryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCountryResponse)
+	err := c.cc.Invoke(ctx, RatePulseCountryService_DeleteCountry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatePulseCountryServiceServer is the server API for RatePulseCountryService service.
+// All implementations must embed UnimplementedRatePulseCountryServiceServer
+// for forward compatibility.
+type RatePulseCountryServiceServer interface {
+	ListCountries(context.Context, *ListCountriesRequest) (*ListCountriesResponse, error)
+	GetCountry(context.Context, *GetCountryRequest) (*GetCountryResponse, error)
+	GetCountryByCode(context.Context, *GetCountryByCodeRequest) (*GetCountryByCodeResponse, error)
+	CreateCountry(context.Context, *CreateCountryRequest) (*CreateCountryResponse, error)
+	UpdateCountry(context.Context, *UpdateCountryRequest) (*UpdateCountryResponse, error)
+	DeleteCountry(context.Context, *DeleteCountryRequest) (*DeleteCountryResponse, error)
+	mustEmbedUnimplementedRatePulseCountryServiceServer()
+}
+
+// UnimplementedRatePulseCountryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatePulseCountryServiceServer struct{}
+
+func (UnimplementedRatePulseCountryServiceServer) ListCountries(context.Context, *ListCountriesRequest) (*ListCountriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCountries not implemented")
+}
+func (UnimplementedRatePulseCountryServiceServer) GetCountry(context.Context, *GetCountryRequest) (*GetCountryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCountry not implemented")
+}
+func (UnimplementedRatePulseCountryServiceServer) GetCountryByCode(context.Context, *GetCountryByCodeRequest) (*GetCountryByCodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCountryByCode not implemented")
+}
+func (UnimplementedRatePulseCountryServiceServer) CreateCountry(context.Context, *CreateCountryRequest) (*CreateCountryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCountry not implemented")
+}
+func (UnimplementedRatePulseCountryServiceServer) UpdateCountry(context.Context, *UpdateCountryRequest) (*UpdateCountryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCountry not implemented")
+}
+func (UnimplementedRatePulseCountryServiceServer) DeleteCountry(context.Context, *DeleteCountryRequest) (*DeleteCountryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCountry not implemented")
+}
+func (UnimplementedRatePulseCountryServiceServer) mustEmbedUnimplementedRatePulseCountryServiceServer() {
+}
+func (UnimplementedRatePulseCountryServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRatePulseCountryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatePulseCountryServiceServer will
+// result in compilation errors.
+type UnsafeRatePulseCountryServiceServer interface {
+	mustEmbedUnimplementedRatePulseCountryServiceServer()
+}
+
+func RegisterRatePulseCountryServiceServer(s grpc.ServiceRegistrar, srv RatePulseCountryServiceServer) {
+	// If the following call panics, it indicates UnimplementedRatePulseCountryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatePulseCountryService_ServiceDesc, srv)
+}
+
+func _RatePulseCountryService_ListCountries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCountriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseCountryServiceServer).ListCountries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseCountryService_ListCountries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseCountryServiceServer).ListCountries(ctx, req.(*ListCountriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseCountryService_GetCountry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCountryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseCountryServiceServer).GetCountry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseCountryService_GetCountry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseCountryServiceServer).GetCountry(ctx, req.(*GetCountryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseCountryService_GetCountryByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCountryByCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseCountryServiceServer).GetCountryByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseCountryService_GetCountryByCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseCountryServiceServer).GetCountryByCode(ctx, req.(*GetCountryByCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseCountryService_CreateCountry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCountryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseCountryServiceServer).CreateCountry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseCountryService_CreateCountry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseCountryServiceServer).CreateCountry(ctx, req.(*CreateCountryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseCountryService_UpdateCountry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCountryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseCountryServiceServer).UpdateCountry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseCountryService_UpdateCountry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseCountryServiceServer).UpdateCountry(ctx, req.(*UpdateCountryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseCountryService_DeleteCountry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCountryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseCountryServiceServer).DeleteCountry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseCountryService_DeleteCountry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseCountryServiceServer).DeleteCountry(ctx, req.(*DeleteCountryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatePulseCountryService_ServiceDesc is the grpc.ServiceDesc for RatePulseCountryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatePulseCountryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.RatePulseCountryService",
+	HandlerType: (*RatePulseCountryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCountries",
+			Handler:    _RatePulseCountryService_ListCountries_Handler,
+		},
+		{
+			MethodName: "GetCountry",
+			Handler:    _RatePulseCountryService_GetCountry_Handler,
+		},
+		{
+			MethodName: "GetCountryByCode",
+			Handler:    _RatePulseCountryService_GetCountryByCode_Handler,
+		},
+		{
+			MethodName: "CreateCountry",
+			Handler:    _RatePulseCountryService_CreateCountry_Handler,
+		},
+		{
+			MethodName: "UpdateCountry",
+			Handler:    _RatePulseCountryService_UpdateCountry_Handler,
+		},
+		{
+			MethodName: "DeleteCountry",
+			Handler:    _RatePulseCountryService_DeleteCountry_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_rate_pulse.proto",
+}
+
+const (
+	RatePulseRateSourceService_ListRateSources_FullMethodName  = "/pb.RatePulseRateSourceService/ListRateSources"
+	RatePulseRateSourceService_GetRateSource_FullMethodName    = "/pb.RatePulseRateSourceService/GetRateSource"
+	RatePulseRateSourceService_CreateRateSource_FullMethodName = "/pb.RatePulseRateSourceService/CreateRateSource"
+	RatePulseRateSourceService_UpdateRateSource_FullMethodName = "/pb.RatePulseRateSourceService/UpdateRateSource"
+	RatePulseRateSourceService_DeleteRateSource_FullMethodName = "/pb.RatePulseRateSourceService/DeleteRateSource"
+)
+
+// RatePulseRateSourceServiceClient is the client API for RatePulseRateSourceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatePulseRateSourceServiceClient interface {
+	ListRateSources(ctx context.Context, in *ListRateSourcesRequest, opts ...grpc.CallOption) (*ListRateSourcesResponse, error)
+	GetRateSource(ctx context.Context, in *GetRateSourceRequest, opts ...grpc.CallOption) (*GetRateSourceResponse, error)
+	CreateRateSource(ctx context.Context, in *CreateRateSourceRequest, opts ...grpc.CallOption) (*CreateRateSourceResponse, error)
+	UpdateRateSource(ctx context.Context, in *UpdateRateSourceRequest, opts ...grpc.CallOption) (*UpdateRateSourceResponse, error)
+	DeleteRateSource(ctx context.Context, in *DeleteRateSourceRequest, opts ...grpc.CallOption) (*DeleteRateSourceResponse, error)
+}
+
+type ratePulseRateSourceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatePulseRateSourceServiceClient(cc grpc.ClientConnInterface) RatePulseRateSourceServiceClient {
+	return &ratePulseRateSourceServiceClient{cc}
+}
+
+func (c *ratePulseRateSourceServiceClient) ListRateSources(ctx context.Context, in *ListRateSourcesRequest, opts ...grpc.CallOption) (*ListRateSourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRateSourcesResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateSourceService_ListRateSources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseRateSourceServiceClient) GetRateSource(ctx context.Context, in *GetRateSourceRequest, opts ...grpc.CallOption) (*GetRateSourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRateSourceResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateSourceService_GetRateSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseRateSourceServiceClient) CreateRateSource(ctx context.Context, in *CreateRateSourceRequest, opts ...grpc.CallOption) (*CreateRateSourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRateSourceResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateSourceService_CreateRateSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseRateSourceServiceClient) UpdateRateSource(ctx context.Context, in *UpdateRateSourceRequest, opts ...grpc.CallOption) (*UpdateRateSourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRateSourceResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateSourceService_UpdateRateSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseRateSourceServiceClient) DeleteRateSource(ctx context.Context, in *DeleteRateSourceRequest, opts ...grpc.CallOption) (*DeleteRateSourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRateSourceResponse)
+	err := c.cc.Invoke(ctx, RatePulseRateSourceService_DeleteRateSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatePulseRateSourceServiceServer is the server API for RatePulseRateSourceService service.
+// All implementations must embed UnimplementedRatePulseRateSourceServiceServer
+// for forward compatibility.
+type RatePulseRateSourceServiceServer interface {
+	ListRateSources(context.Context, *ListRateSourcesRequest) (*ListRateSourcesResponse, error)
+	GetRateSource(context.Context, *GetRateSourceRequest) (*GetRateSourceResponse, error)
+	CreateRateSource(context.Context, *CreateRateSourceRequest) (*CreateRateSourceResponse, error)
+	UpdateRateSource(context.Context, *UpdateRateSourceRequest) (*UpdateRateSourceResponse, error)
+	DeleteRateSource(context.Context, *DeleteRateSourceRequest) (*DeleteRateSourceResponse, error)
+	mustEmbedUnimplementedRatePulseRateSourceServiceServer()
+}
+
+// UnimplementedRatePulseRateSourceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatePulseRateSourceServiceServer struct{}
+
+func (UnimplementedRatePulseRateSourceServiceServer) ListRateSources(context.Context, *ListRateSourcesRequest) (*ListRateSourcesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRateSources not implemented")
+}
+func (UnimplementedRatePulseRateSourceServiceServer) GetRateSource(context.Context, *GetRateSourceRequest) (*GetRateSourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRateSource not implemented")
+}
+func (UnimplementedRatePulseRateSourceServiceServer) CreateRateSource(context.Context, *CreateRateSourceRequest) (*CreateRateSourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRateSource not implemented")
+}
+func (UnimplementedRatePulseRateSourceServiceServer) UpdateRateSource(context.Context, *UpdateRateSourceRequest) (*UpdateRateSourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRateSource not implemented")
+}
+func (UnimplementedRatePulseRateSourceServiceServer) DeleteRateSource(context.Context, *DeleteRateSourceRequest) (*DeleteRateSourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRateSource not implemented")
+}
+func (UnimplementedRatePulseRateSourceServiceServer) mustEmbedUnimplementedRatePulseRateSourceServiceServer() {
+}
+func (UnimplementedRatePulseRateSourceServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRatePulseRateSourceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatePulseRateSourceServiceServer will
+// result in compilation errors.
+type UnsafeRatePulseRateSourceServiceServer interface {
+	mustEmbedUnimplementedRatePulseRateSourceServiceServer()
+}
+
+func RegisterRatePulseRateSourceServiceServer(s grpc.ServiceRegistrar, srv RatePulseRateSourceServiceServer) {
+	// If the following call panics, it indicates UnimplementedRatePulseRateSourceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatePulseRateSourceService_ServiceDesc, srv)
+}
+
+func _RatePulseRateSourceService_ListRateSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRateSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateSourceServiceServer).ListRateSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateSourceService_ListRateSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateSourceServiceServer).ListRateSources(ctx, req.(*ListRateSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseRateSourceService_GetRateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateSourceServiceServer).GetRateSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateSourceService_GetRateSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateSourceServiceServer).GetRateSource(ctx, req.(*GetRateSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseRateSourceService_CreateRateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRateSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateSourceServiceServer).CreateRateSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateSourceService_CreateRateSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateSourceServiceServer).CreateRateSource(ctx, req.(*CreateRateSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseRateSourceService_UpdateRateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRateSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateSourceServiceServer).UpdateRateSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateSourceService_UpdateRateSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateSourceServiceServer).UpdateRateSource(ctx, req.(*UpdateRateSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseRateSourceService_DeleteRateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRateSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseRateSourceServiceServer).DeleteRateSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseRateSourceService_DeleteRateSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseRateSourceServiceServer).DeleteRateSource(ctx, req.(*DeleteRateSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatePulseRateSourceService_ServiceDesc is the grpc.ServiceDesc for RatePulseRateSourceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatePulseRateSourceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.RatePulseRateSourceService",
+	HandlerType: (*RatePulseRateSourceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRateSources",
+			Handler:    _RatePulseRateSourceService_ListRateSources_Handler,
+		},
+		{
+			MethodName: "GetRateSource",
+			Handler:    _RatePulseRateSourceService_GetRateSource_Handler,
+		},
+		{
+			MethodName: "CreateRateSource",
+			Handler:    _RatePulseRateSourceService_CreateRateSource_Handler,
+		},
+		{
+			MethodName: "UpdateRateSource",
+			Handler:    _RatePulseRateSourceService_UpdateRateSource_Handler,
+		},
+		{
+			MethodName: "DeleteRateSource",
+			Handler:    _RatePulseRateSourceService_DeleteRateSource_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_rate_pulse.proto",
+}
+
+const (
+	RatePulseSubscriptionService_ListSubscriptionPlans_FullMethodName      = "/pb.RatePulseSubscriptionService/ListSubscriptionPlans"
+	RatePulseSubscriptionService_GetSubscriptionPlan_FullMethodName        = "/pb.RatePulseSubscriptionService/GetSubscriptionPlan"
+	RatePulseSubscriptionService_ListAllSubscriptionPlans_FullMethodName   = "/pb.RatePulseSubscriptionService/ListAllSubscriptionPlans"
+	RatePulseSubscriptionService_CreateSubscriptionPlan_FullMethodName     = "/pb.RatePulseSubscriptionService/CreateSubscriptionPlan"
+	RatePulseSubscriptionService_UpdateSubscriptionPlan_FullMethodName     = "/pb.RatePulseSubscriptionService/UpdateSubscriptionPlan"
+	RatePulseSubscriptionService_DeleteSubscriptionPlan_FullMethodName     = "/pb.RatePulseSubscriptionService/DeleteSubscriptionPlan"
+	RatePulseSubscriptionService_CreateUserSubscription_FullMethodName     = "/pb.RatePulseSubscriptionService/CreateUserSubscription"
+	RatePulseSubscriptionService_ListUserSubscriptions_FullMethodName      = "/pb.RatePulseSubscriptionService/ListUserSubscriptions"
+	RatePulseSubscriptionService_GetActiveUserSubscription_FullMethodName  = "/pb.RatePulseSubscriptionService/GetActiveUserSubscription"
+	RatePulseSubscriptionService_AdminListUserSubscriptions_FullMethodName = "/pb.RatePulseSubscriptionService/AdminListUserSubscriptions"
+	RatePulseSubscriptionService_UpdateUserSubscription_FullMethodName     = "/pb.RatePulseSubscriptionService/UpdateUserSubscription"
+	RatePulseSubscriptionService_DeleteUserSubscription_FullMethodName     = "/pb.RatePulseSubscriptionService/DeleteUserSubscription"
+)
+
+// RatePulseSubscriptionServiceClient is the client API for RatePulseSubscriptionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatePulseSubscriptionServiceClient interface {
+	ListSubscriptionPlans(ctx context.Context, in *ListSubscriptionPlansRequest, opts ...grpc.CallOption) (*ListSubscriptionPlansResponse, error)
+	GetSubscriptionPlan(ctx context.Context, in *GetSubscriptionPlanRequest, opts ...grpc.CallOption) (*GetSubscriptionPlanResponse, error)
+	ListAllSubscriptionPlans(ctx context.Context, in *ListAllSubscriptionPlansRequest, opts ...grpc.CallOption) (*ListAllSubscriptionPlansResponse, error)
+	CreateSubscriptionPlan(ctx context.Context, in *CreateSubscriptionPlanRequest, opts ...grpc.CallOption) (*CreateSubscriptionPlanResponse, error)
+	UpdateSubscriptionPlan(ctx context.Context, in *UpdateSubscriptionPlanRequest, opts ...grpc.CallOption) (*UpdateSubscriptionPlanResponse, error)
+	DeleteSubscriptionPlan(ctx context.Context, in *DeleteSubscriptionPlanRequest, opts ...grpc.CallOption) (*DeleteSubscriptionPlanResponse, error)
+	CreateUserSubscription(ctx context.Context, in *CreateUserSubscriptionRequest, opts ...grpc.CallOption) (*CreateUserSubscriptionResponse, error)
+	ListUserSubscriptions(ctx context.Context, in *ListUserSubscriptionsRequest, opts ...grpc.CallOption) (*ListUserSubscriptionsResponse, error)
+	GetActiveUserSubscription(ctx context.Context, in *GetActiveUserSubscriptionRequest, opts ...grpc.CallOption) (*GetActiveUserSubscriptionResponse, error)
+	AdminListUserSubscriptions(ctx context.Context, in *AdminListUserSubscriptionsRequest, opts ...grpc.CallOption) (*AdminListUserSubscriptionsResponse, error)
+	UpdateUserSubscription(ctx context.Context, in *UpdateUserSubscriptionRequest, opts ...grpc.CallOption) (*UpdateUserSubscriptionResponse, error)
+	DeleteUserSubscription(ctx context.Context, in *DeleteUserSubscriptionRequest, opts ...grpc.CallOption) (*DeleteUserSubscriptionResponse, error)
+}
+
+type ratePulseSubscriptionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatePulseSubscriptionServiceClient(cc grpc.ClientConnInterface) RatePulseSubscriptionServiceClient {
+	return &ratePulseSubscriptionServiceClient{cc}
+}
+
+func (c *ratePulseSubscriptionServiceClient) ListSubscriptionPlans(ctx context.Context, in *ListSubscriptionPlansRequest, opts ...grpc.CallOption) (*ListSubscriptionPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionPlansResponse)
+	err := c.cc.Invoke(ctx, RatePulseSubscriptionService_ListSubscriptionPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseSubscriptionServiceClient) GetSubscriptionPlan(ctx context.Context, in *GetSubscriptionPlanRequest, opts ...grpc.CallOption) (*GetSubscriptionPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSubscriptionPlanResponse)
+	err := c.cc.Invoke(ctx, RatePulseSubscriptionService_GetSubscriptionPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseSubscriptionServiceClient) ListAllSubscriptionPlans(ctx context.Context, in *ListAllSubscriptionPlansRequest, opts ...grpc.CallOption) (*ListAllSubscriptionPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAllSubscriptionPlansResponse)
+	err := c.cc.Invoke(ctx, RatePulseSubscriptionService_ListAllSubscriptionPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseSubscriptionServiceClient) CreateSubscriptionPlan(ctx context.Context, in *CreateSubscriptionPlanRequest, opts ...grpc.CallOption) (*CreateSubscriptionPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSubscriptionPlanResponse)
+	err := c.cc.Invoke(ctx, RatePulseSubscriptionService_CreateSubscriptionPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseSubscriptionServiceClient) UpdateSubscriptionPlan(ctx context.Context, in *UpdateSubscriptionPlanRequest, opts ...grpc.CallOption) (*UpdateSubscriptionPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSubscriptionPlanResponse)
+	err := c.cc.Invoke(ctx, RatePulseSubscriptionService_UpdateSubscriptionPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseSubscriptionServiceClient) DeleteSubscriptionPlan(ctx context.Context, in *DeleteSubscriptionPlanRequest, opts ...grpc.CallOption) (*DeleteSubscriptionPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSubscriptionPlanResponse)
+	err := c.cc.Invoke(ctx, RatePulseSubscriptionService_DeleteSubscriptionPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseSubscriptionServiceClient) CreateUserSubscription(ctx context.Context, in *CreateUserSubscriptionRequest, opts ...grpc.CallOption) (*CreateUserSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserSubscriptionResponse)
+	err := c.cc.Invoke(ctx, RatePulseSubscriptionService_CreateUserSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseSubscriptionServiceClient) ListUserSubscriptions(ctx context.Context, in *ListUserSubscriptionsRequest, opts ...grpc.CallOption) (*ListUserSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, RatePulseSubscriptionService_ListUserSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseSubscriptionServiceClient) GetActiveUserSubscription(ctx context.Context, in *GetActiveUserSubscriptionRequest, opts ...grpc.CallOption) (*GetActiveUserSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActiveUserSubscriptionResponse)
+	err := c.cc.Invoke(ctx, RatePulseSubscriptionService_GetActiveUserSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseSubscriptionServiceClient) AdminListUserSubscriptions(ctx context.Context, in *AdminListUserSubscriptionsRequest, opts ...grpc.CallOption) (*AdminListUserSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListUserSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, RatePulseSubscriptionService_AdminListUserSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseSubscriptionServiceClient) UpdateUserSubscription(ctx context.Context, in *UpdateUserSubscriptionRequest, opts ...grpc.CallOption) (*UpdateUserSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserSubscriptionResponse)
+	err := c.cc.Invoke(ctx, RatePulseSubscriptionService_UpdateUserSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseSubscriptionServiceClient) DeleteUserSubscription(ctx context.Context, in *DeleteUserSubscriptionRequest, opts ...grpc.CallOption) (*DeleteUserSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserSubscriptionResponse)
+	err := c.cc.Invoke(ctx, RatePulseSubscriptionService_DeleteUserSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatePulseSubscriptionServiceServer is the server API for RatePulseSubscriptionService service.
+// All implementations must embed UnimplementedRatePulseSubscriptionServiceServer
+// for forward compatibility.
+type RatePulseSubscriptionServiceServer interface {
+	ListSubscriptionPlans(context.Context, *ListSubscriptionPlansRequest) (*ListSubscriptionPlansResponse, error)
+	GetSubscriptionPlan(context.Context, *GetSubscriptionPlanRequest) (*GetSubscriptionPlanResponse, error)
+	ListAllSubscriptionPlans(context.Context, *ListAllSubscriptionPlansRequest) (*ListAllSubscriptionPlansResponse, error)
+	CreateSubscriptionPlan(context.Context, *CreateSubscriptionPlanRequest) (*CreateSubscriptionPlanResponse, error)
+	UpdateSubscriptionPlan(context.Context, *UpdateSubscriptionPlanRequest) (*UpdateSubscriptionPlanResponse, error)
+	DeleteSubscriptionPlan(context.Context, *DeleteSubscriptionPlanRequest) (*DeleteSubscriptionPlanResponse, error)
+	CreateUserSubscription(context.Context, *CreateUserSubscriptionRequest) (*CreateUserSubscriptionResponse, error)
+	ListUserSubscriptions(context.Context, *ListUserSubscriptionsRequest) (*ListUserSubscriptionsResponse, error)
+	GetActiveUserSubscription(context.Context, *GetActiveUserSubscriptionRequest) (*GetActiveUserSubscriptionResponse, error)
+	AdminListUserSubscriptions(context.Context, *AdminListUserSubscriptionsRequest) (*AdminListUserSubscriptionsResponse, error)
+	UpdateUserSubscription(context.Context, *UpdateUserSubscriptionRequest) (*UpdateUserSubscriptionResponse, error)
+	DeleteUserSubscription(context.Context, *DeleteUserSubscriptionRequest) (*DeleteUserSubscriptionResponse, error)
+	mustEmbedUnimplementedRatePulseSubscriptionServiceServer()
+}
+
+// UnimplementedRatePulseSubscriptionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatePulseSubscriptionServiceServer struct{}
+
+func (UnimplementedRatePulseSubscriptionServiceServer) ListSubscriptionPlans(context.Context, *ListSubscriptionPlansRequest) (*ListSubscriptionPlansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSubscriptionPlans not implemented")
+}
+func (UnimplementedRatePulseSubscriptionServiceServer) GetSubscriptionPlan(context.Context, *GetSubscriptionPlanRequest) (*GetSubscriptionPlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSubscriptionPlan not implemented")
+}
+func (UnimplementedRatePulseSubscriptionServiceServer) ListAllSubscriptionPlans(context.Context, *ListAllSubscriptionPlansRequest) (*ListAllSubscriptionPlansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAllSubscriptionPlans not implemented")
+}
+func (UnimplementedRatePulseSubscriptionServiceServer) CreateSubscriptionPlan(context.Context, *CreateSubscriptionPlanRequest) (*CreateSubscriptionPlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSubscriptionPlan not implemented")
+}
+func (UnimplementedRatePulseSubscriptionServiceServer) UpdateSubscriptionPlan(context.Context, *UpdateSubscriptionPlanRequest) (*UpdateSubscriptionPlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSubscriptionPlan not implemented")
+}
+func (UnimplementedRatePulseSubscriptionServiceServer) DeleteSubscriptionPlan(context.Context, *DeleteSubscriptionPlanRequest) (*DeleteSubscriptionPlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSubscriptionPlan not implemented")
+}
+func (UnimplementedRatePulseSubscriptionServiceServer) CreateUserSubscription(context.Context, *CreateUserSubscriptionRequest) (*CreateUserSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateUserSubscription not implemented")
+}
+func (UnimplementedRatePulseSubscriptionServiceServer) ListUserSubscriptions(context.Context, *ListUserSubscriptionsRequest) (*ListUserSubscriptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserSubscriptions not implemented")
+}
+func (UnimplementedRatePulseSubscriptionServiceServer) GetActiveUserSubscription(context.Context, *GetActiveUserSubscriptionRequest) (*GetActiveUserSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActiveUserSubscription not implemented")
+}
+func (UnimplementedRatePulseSubscriptionServiceServer) AdminListUserSubscriptions(context.Context, *AdminListUserSubscriptionsRequest) (*AdminListUserSubscriptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListUserSubscriptions not implemented")
+}
+func (UnimplementedRatePulseSubscriptionServiceServer) UpdateUserSubscription(context.Context, *UpdateUserSubscriptionRequest) (*UpdateUserSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateUserSubscription not implemented")
+}
+func (UnimplementedRatePulseSubscriptionServiceServer) DeleteUserSubscription(context.Context, *DeleteUserSubscriptionRequest) (*DeleteUserSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteUserSubscription not implemented")
+}
+func (UnimplementedRatePulseSubscriptionServiceServer) mustEmbedUnimplementedRatePulseSubscriptionServiceServer() {
+}
+func (UnimplementedRatePulseSubscriptionServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRatePulseSubscriptionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatePulseSubscriptionServiceServer will
+// result in compilation errors.
+type UnsafeRatePulseSubscriptionServiceServer interface {
+	mustEmbedUnimplementedRatePulseSubscriptionServiceServer()
+}
+
+func RegisterRatePulseSubscriptionServiceServer(s grpc.ServiceRegistrar, srv RatePulseSubscriptionServiceServer) {
+	// If the following call panics, it indicates UnimplementedRatePulseSubscriptionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatePulseSubscriptionService_ServiceDesc, srv)
+}
+
+func _RatePulseSubscriptionService_ListSubscriptionPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseSubscriptionServiceServer).ListSubscriptionPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseSubscriptionService_ListSubscriptionPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseSubscriptionServiceServer).ListSubscriptionPlans(ctx, req.(*ListSubscriptionPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseSubscriptionService_GetSubscriptionPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseSubscriptionServiceServer).GetSubscriptionPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseSubscriptionService_GetSubscriptionPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseSubscriptionServiceServer).GetSubscriptionPlan(ctx, req.(*GetSubscriptionPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseSubscriptionService_ListAllSubscriptionPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllSubscriptionPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseSubscriptionServiceServer).ListAllSubscriptionPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseSubscriptionService_ListAllSubscriptionPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseSubscriptionServiceServer).ListAllSubscriptionPlans(ctx, req.(*ListAllSubscriptionPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseSubscriptionService_CreateSubscriptionPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubscriptionPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseSubscriptionServiceServer).CreateSubscriptionPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseSubscriptionService_CreateSubscriptionPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseSubscriptionServiceServer).CreateSubscriptionPlan(ctx, req.(*CreateSubscriptionPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseSubscriptionService_UpdateSubscriptionPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubscriptionPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseSubscriptionServiceServer).UpdateSubscriptionPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseSubscriptionService_UpdateSubscriptionPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseSubscriptionServiceServer).UpdateSubscriptionPlan(ctx, req.(*UpdateSubscriptionPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseSubscriptionService_DeleteSubscriptionPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscriptionPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseSubscriptionServiceServer).DeleteSubscriptionPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseSubscriptionService_DeleteSubscriptionPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseSubscriptionServiceServer).DeleteSubscriptionPlan(ctx, req.(*DeleteSubscriptionPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseSubscriptionService_CreateUserSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseSubscriptionServiceServer).CreateUserSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseSubscriptionService_CreateUserSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseSubscriptionServiceServer).CreateUserSubscription(ctx, req.(*CreateUserSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseSubscriptionService_ListUserSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseSubscriptionServiceServer).ListUserSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseSubscriptionService_ListUserSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseSubscriptionServiceServer).ListUserSubscriptions(ctx, req.(*ListUserSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseSubscriptionService_GetActiveUserSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveUserSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseSubscriptionServiceServer).GetActiveUserSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseSubscriptionService_GetActiveUserSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseSubscriptionServiceServer).GetActiveUserSubscription(ctx, req.(*GetActiveUserSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseSubscriptionService_AdminListUserSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListUserSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseSubscriptionServiceServer).AdminListUserSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseSubscriptionService_AdminListUserSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseSubscriptionServiceServer).AdminListUserSubscriptions(ctx, req.(*AdminListUserSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseSubscriptionService_UpdateUserSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseSubscriptionServiceServer).UpdateUserSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseSubscriptionService_UpdateUserSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseSubscriptionServiceServer).UpdateUserSubscription(ctx, req.(*UpdateUserSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulseSubscriptionService_DeleteUserSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseSubscriptionServiceServer).DeleteUserSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseSubscriptionService_DeleteUserSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseSubscriptionServiceServer).DeleteUserSubscription(ctx, req.(*DeleteUserSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatePulseSubscriptionService_ServiceDesc is the grpc.ServiceDesc for RatePulseSubscriptionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatePulseSubscriptionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.RatePulseSubscriptionService",
+	HandlerType: (*RatePulseSubscriptionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSubscriptionPlans",
+			Handler:    _RatePulseSubscriptionService_ListSubscriptionPlans_Handler,
+		},
+		{
+			MethodName: "GetSubscriptionPlan",
+			Handler:    _RatePulseSubscriptionService_GetSubscriptionPlan_Handler,
+		},
+		{
+			MethodName: "ListAllSubscriptionPlans",
+			Handler:    _RatePulseSubscriptionService_ListAllSubscriptionPlans_Handler,
+		},
+		{
+			MethodName: "CreateSubscriptionPlan",
+			Handler:    _RatePulseSubscriptionService_CreateSubscriptionPlan_Handler,
+		},
+		{
+			MethodName: "UpdateSubscriptionPlan",
+			Handler:    _RatePulseSubscriptionService_UpdateSubscriptionPlan_Handler,
+		},
+		{
+			MethodName: "DeleteSubscriptionPlan",
+			Handler:    _RatePulseSubscriptionService_DeleteSubscriptionPlan_Handler,
+		},
+		{
+			MethodName: "CreateUserSubscription",
+			Handler:    _RatePulseSubscriptionService_CreateUserSubscription_Handler,
+		},
+		{
+			MethodName: "ListUserSubscriptions",
+			Handler:    _RatePulseSubscriptionService_ListUserSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetActiveUserSubscription",
+			Handler:    _RatePulseSubscriptionService_GetActiveUserSubscription_Handler,
+		},
+		{
+			MethodName: "AdminListUserSubscriptions",
+			Handler:    _RatePulseSubscriptionService_AdminListUserSubscriptions_Handler,
+		},
+		{
+			MethodName: "UpdateUserSubscription",
+			Handler:    _RatePulseSubscriptionService_UpdateUserSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteUserSubscription",
+			Handler:    _RatePulseSubscriptionService_DeleteUserSubscription_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_rate_pulse.proto",
+}
+
+const (
+	RatePulsePaymentService_ListPayments_FullMethodName      = "/pb.RatePulsePaymentService/ListPayments"
+	RatePulsePaymentService_GetPayment_FullMethodName        = "/pb.RatePulsePaymentService/GetPayment"
+	RatePulsePaymentService_CreatePayment_FullMethodName     = "/pb.RatePulsePaymentService/CreatePayment"
+	RatePulsePaymentService_AdminListPayments_FullMethodName = "/pb.RatePulsePaymentService/AdminListPayments"
+	RatePulsePaymentService_AdminGetPayment_FullMethodName   = "/pb.RatePulsePaymentService/AdminGetPayment"
+	RatePulsePaymentService_UpdatePayment_FullMethodName     = "/pb.RatePulsePaymentService/UpdatePayment"
+	RatePulsePaymentService_DeletePayment_FullMethodName     = "/pb.RatePulsePaymentService/DeletePayment"
+)
+
+// RatePulsePaymentServiceClient is the client API for RatePulsePaymentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatePulsePaymentServiceClient interface {
+	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
+	GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*GetPaymentResponse, error)
+	CreatePayment(ctx context.Context, in *CreatePaymentRequest, opts ...grpc.CallOption) (*CreatePaymentResponse, error)
+	AdminListPayments(ctx context.Context, in *AdminListPaymentsRequest, opts ...grpc.CallOption) (*AdminListPaymentsResponse, error)
+	AdminGetPayment(ctx context.Context, in *AdminGetPaymentRequest, opts ...grpc.CallOption) (*AdminGetPaymentResponse, error)
+	UpdatePayment(ctx context.Context, in *UpdatePaymentRequest, opts ...grpc.CallOption) (*UpdatePaymentResponse, error)
+	DeletePayment(ctx context.Context, in *DeletePaymentRequest, opts ...grpc.CallOption) (*DeletePaymentResponse, error)
+}
+
+type ratePulsePaymentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatePulsePaymentServiceClient(cc grpc.ClientConnInterface) RatePulsePaymentServiceClient {
+	return &ratePulsePaymentServiceClient{cc}
+}
+
+func (c *ratePulsePaymentServiceClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPaymentsResponse)
+	err := c.cc.Invoke(ctx, RatePulsePaymentService_ListPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulsePaymentServiceClient) GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*GetPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPaymentResponse)
+	err := c.cc.Invoke(ctx, RatePulsePaymentService_GetPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulsePaymentServiceClient) CreatePayment(ctx context.Context, in *CreatePaymentRequest, opts ...grpc.CallOption) (*CreatePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePaymentResponse)
+	err := c.cc.Invoke(ctx, RatePulsePaymentService_CreatePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulsePaymentServiceClient) AdminListPayments(ctx context.Context, in *AdminListPaymentsRequest, opts ...grpc.CallOption) (*AdminListPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListPaymentsResponse)
+	err := c.cc.Invoke(ctx, RatePulsePaymentService_AdminListPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulsePaymentServiceClient) AdminGetPayment(ctx context.Context, in *AdminGetPaymentRequest, opts ...grpc.CallOption) (*AdminGetPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetPaymentResponse)
+	err := c.cc.Invoke(ctx, RatePulsePaymentService_AdminGetPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulsePaymentServiceClient) UpdatePayment(ctx context.Context, in *UpdatePaymentRequest, opts ...grpc.CallOption) (*UpdatePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePaymentResponse)
+	err := c.cc.Invoke(ctx, RatePulsePaymentService_UpdatePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulsePaymentServiceClient) DeletePayment(ctx context.Context, in *DeletePaymentRequest, opts ...grpc.CallOption) (*DeletePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePaymentResponse)
+	err := c.cc.Invoke(ctx, RatePulsePaymentService_DeletePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatePulsePaymentServiceServer is the server API for RatePulsePaymentService service.
+// All implementations must embed UnimplementedRatePulsePaymentServiceServer
+// for forward compatibility.
+type RatePulsePaymentServiceServer interface {
+	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
+	GetPayment(context.Context, *GetPaymentRequest) (*GetPaymentResponse, error)
+	CreatePayment(context.Context, *CreatePaymentRequest) (*CreatePaymentResponse, error)
+	AdminListPayments(context.Context, *AdminListPaymentsRequest) (*AdminListPaymentsResponse, error)
+	AdminGetPayment(context.Context, *AdminGetPaymentRequest) (*AdminGetPaymentResponse, error)
+	UpdatePayment(context.Context, *UpdatePaymentRequest) (*UpdatePaymentResponse, error)
+	DeletePayment(context.Context, *DeletePaymentRequest) (*DeletePaymentResponse, error)
+	mustEmbedUnimplementedRatePulsePaymentServiceServer()
+}
+
+// UnimplementedRatePulsePaymentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatePulsePaymentServiceServer struct{}
+
+func (UnimplementedRatePulsePaymentServiceServer) ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPayments not implemented")
+}
+func (UnimplementedRatePulsePaymentServiceServer) GetPayment(context.Context, *GetPaymentRequest) (*GetPaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPayment not implemented")
+}
+func (UnimplementedRatePulsePaymentServiceServer) CreatePayment(context.Context, *CreatePaymentRequest) (*CreatePaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePayment not implemented")
+}
+func (UnimplementedRatePulsePaymentServiceServer) AdminListPayments(context.Context, *AdminListPaymentsRequest) (*AdminListPaymentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListPayments not implemented")
+}
+func (UnimplementedRatePulsePaymentServiceServer) AdminGetPayment(context.Context, *AdminGetPaymentRequest) (*AdminGetPaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminGetPayment not implemented")
+}
+func (UnimplementedRatePulsePaymentServiceServer) UpdatePayment(context.Context, *UpdatePaymentRequest) (*UpdatePaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePayment not implemented")
+}
+func (UnimplementedRatePulsePaymentServiceServer) DeletePayment(context.Context, *DeletePaymentRequest) (*DeletePaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePayment not implemented")
+}
+func (UnimplementedRatePulsePaymentServiceServer) mustEmbedUnimplementedRatePulsePaymentServiceServer() {
+}
+func (UnimplementedRatePulsePaymentServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRatePulsePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatePulsePaymentServiceServer will
+// result in compilation errors.
+type UnsafeRatePulsePaymentServiceServer interface {
+	mustEmbedUnimplementedRatePulsePaymentServiceServer()
+}
+
+func RegisterRatePulsePaymentServiceServer(s grpc.ServiceRegistrar, srv RatePulsePaymentServiceServer) {
+	// If the following call panics, it indicates UnimplementedRatePulsePaymentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatePulsePaymentService_ServiceDesc, srv)
+}
+
+func _RatePulsePaymentService_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePaymentServiceServer).ListPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePaymentService_ListPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePaymentServiceServer).ListPayments(ctx, req.(*ListPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulsePaymentService_GetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePaymentServiceServer).GetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePaymentService_GetPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePaymentServiceServer).GetPayment(ctx, req.(*GetPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulsePaymentService_CreatePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePaymentServiceServer).CreatePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePaymentService_CreatePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePaymentServiceServer).CreatePayment(ctx, req.(*CreatePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulsePaymentService_AdminListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePaymentServiceServer).AdminListPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePaymentService_AdminListPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePaymentServiceServer).AdminListPayments(ctx, req.(*AdminListPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulsePaymentService_AdminGetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePaymentServiceServer).AdminGetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePaymentService_AdminGetPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePaymentServiceServer).AdminGetPayment(ctx, req.(*AdminGetPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulsePaymentService_UpdatePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePaymentServiceServer).UpdatePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePaymentService_UpdatePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePaymentServiceServer).UpdatePayment(ctx, req.(*UpdatePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulsePaymentService_DeletePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePaymentServiceServer).DeletePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePaymentService_DeletePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePaymentServiceServer).DeletePayment(ctx, req.(*DeletePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatePulsePaymentService_ServiceDesc is the grpc.ServiceDesc for RatePulsePaymentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatePulsePaymentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.RatePulsePaymentService",
+	HandlerType: (*RatePulsePaymentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPayments",
+			Handler:    _RatePulsePaymentService_ListPayments_Handler,
+		},
+		{
+			MethodName: "GetPayment",
+			Handler:    _RatePulsePaymentService_GetPayment_Handler,
+		},
+		{
+			MethodName: "CreatePayment",
+			Handler:    _RatePulsePaymentService_CreatePayment_Handler,
+		},
+		{
+			MethodName: "AdminListPayments",
+			Handler:    _RatePulsePaymentService_AdminListPayments_Handler,
+		},
+		{
+			MethodName: "AdminGetPayment",
+			Handler:    _RatePulsePaymentService_AdminGetPayment_Handler,
+		},
+		{
+			MethodName: "UpdatePayment",
+			Handler:    _RatePulsePaymentService_UpdatePayment_Handler,
+		},
+		{
+			MethodName: "DeletePayment",
+			Handler:    _RatePulsePaymentService_DeletePayment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_rate_pulse.proto",
+}
+
+const (
+	RatePulsePreferenceService_CreateCurrencyPreference_FullMethodName   = "/pb.RatePulsePreferenceService/CreateCurrencyPreference"
+	RatePulsePreferenceService_ListCurrencyPreferences_FullMethodName    = "/pb.RatePulsePreferenceService/ListCurrencyPreferences"
+	RatePulsePreferenceService_UpdateCurrencyPreference_FullMethodName   = "/pb.RatePulsePreferenceService/UpdateCurrencyPreference"
+	RatePulsePreferenceService_DeleteCurrencyPreference_FullMethodName   = "/pb.RatePulsePreferenceService/DeleteCurrencyPreference"
+	RatePulsePreferenceService_CreateRateSourcePreference_FullMethodName = "/pb.RatePulsePreferenceService/CreateRateSourcePreference"
+	RatePulsePreferenceService_ListRateSourcePreferences_FullMethodName  = "/pb.RatePulsePreferenceService/ListRateSourcePreferences"
+	RatePulsePreferenceService_UpdateRateSourcePreference_FullMethodName = "/pb.RatePulsePreferenceService/UpdateRateSourcePreference"
+	RatePulsePreferenceService_DeleteRateSourcePreference_FullMethodName = "/pb.RatePulsePreferenceService/DeleteRateSourcePreference"
+)
+
+// RatePulsePreferenceServiceClient is the client API for RatePulsePreferenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatePulsePreferenceServiceClient interface {
+	CreateCurrencyPreference(ctx context.Context, in *CreateCurrencyPreferenceRequest, opts ...grpc.CallOption) (*CreateCurrencyPreferenceResponse, error)
+	ListCurrencyPreferences(ctx context.Context, in *ListCurrencyPreferencesRequest, opts ...grpc.CallOption) (*ListCurrencyPreferencesResponse, error)
+	UpdateCurrencyPreference(ctx context.Context, in *UpdateCurrencyPreferenceRequest, opts ...grpc.CallOption) (*UpdateCurrencyPreferenceResponse, error)
+	DeleteCurrencyPreference(ctx context.Context, in *DeleteCurrencyPreferenceRequest, opts ...grpc.CallOption) (*DeleteCurrencyPreferenceResponse, error)
+	CreateRateSourcePreference(ctx context.Context, in *CreateRateSourcePreferenceRequest, opts ...grpc.CallOption) (*CreateRateSourcePreferenceResponse, error)
+	ListRateSourcePreferences(ctx context.Context, in *ListRateSourcePreferencesRequest, opts ...grpc.CallOption) (*ListRateSourcePreferencesResponse, error)
+	UpdateRateSourcePreference(ctx context.Context, in *UpdateRateSourcePreferenceRequest, opts ...grpc.CallOption) (*UpdateRateSourcePreferenceResponse, error)
+	DeleteRateSourcePreference(ctx context.Context, in *DeleteRateSourcePreferenceRequest, opts ...grpc.CallOption) (*DeleteRateSourcePreferenceResponse, error)
+}
+
+type ratePulsePreferenceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatePulsePreferenceServiceClient(cc grpc.ClientConnInterface) RatePulsePreferenceServiceClient {
+	return &ratePulsePreferenceServiceClient{cc}
+}
+
+func (c *ratePulsePreferenceServiceClient) CreateCurrencyPreference(ctx context.Context, in *CreateCurrencyPreferenceRequest, opts ...grpc.CallOption) (*CreateCurrencyPreferenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCurrencyPreferenceResponse)
+	err := c.cc.Invoke(ctx, RatePulsePreferenceService_CreateCurrencyPreference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulsePreferenceServiceClient) ListCurrencyPreferences(ctx context.Context, in *ListCurrencyPreferencesRequest, opts ...grpc.CallOption) (*ListCurrencyPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCurrencyPreferencesResponse)
+	err := c.cc.Invoke(ctx, RatePulsePreferenceService_ListCurrencyPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulsePreferenceServiceClient) UpdateCurrencyPreference(ctx context.Context, in *UpdateCurrencyPreferenceRequest, opts ...grpc.CallOption) (*UpdateCurrencyPreferenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCurrencyPreferenceResponse)
+	err := c.cc.Invoke(ctx, RatePulsePreferenceService_UpdateCurrencyPreference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulsePreferenceServiceClient) DeleteCurrencyPreference(ctx context.Context, in *DeleteCurrencyPreferenceRequest, opts ...grpc.CallOption) (*DeleteCurrencyPreferenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCurrencyPreferenceResponse)
+	err := c.cc.Invoke(ctx, RatePulsePreferenceService_DeleteCurrencyPreference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulsePreferenceServiceClient) CreateRateSourcePreference(ctx context.Context, in *CreateRateSourcePreferenceRequest, opts ...grpc.CallOption) (*CreateRateSourcePreferenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRateSourcePreferenceResponse)
+	err := c.cc.Invoke(ctx, RatePulsePreferenceService_CreateRateSourcePreference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulsePreferenceServiceClient) ListRateSourcePreferences(ctx context.Context, in *ListRateSourcePreferencesRequest, opts ...grpc.CallOption) (*ListRateSourcePreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRateSourcePreferencesResponse)
+	err := c.cc.Invoke(ctx, RatePulsePreferenceService_ListRateSourcePreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulsePreferenceServiceClient) UpdateRateSourcePreference(ctx context.Context, in *UpdateRateSourcePreferenceRequest, opts ...grpc.CallOption) (*UpdateRateSourcePreferenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRateSourcePreferenceResponse)
+	err := c.cc.Invoke(ctx, RatePulsePreferenceService_UpdateRateSourcePreference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulsePreferenceServiceClient) DeleteRateSourcePreference(ctx context.Context, in *DeleteRateSourcePreferenceRequest, opts ...grpc.CallOption) (*DeleteRateSourcePreferenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRateSourcePreferenceResponse)
+	err := c.cc.Invoke(ctx, RatePulsePreferenceService_DeleteRateSourcePreference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatePulsePreferenceServiceServer is the server API for RatePulsePreferenceService service.
+// All implementations must embed UnimplementedRatePulsePreferenceServiceServer
+// for forward compatibility.
+type RatePulsePreferenceServiceServer interface {
+	CreateCurrencyPreference(context.Context, *CreateCurrencyPreferenceRequest) (*CreateCurrencyPreferenceResponse, error)
+	ListCurrencyPreferences(context.Context, *ListCurrencyPreferencesRequest) (*ListCurrencyPreferencesResponse, error)
+	UpdateCurrencyPreference(context.Context, *UpdateCurrencyPreferenceRequest) (*UpdateCurrencyPreferenceResponse, error)
+	DeleteCurrencyPreference(context.Context, *DeleteCurrencyPreferenceRequest) (*DeleteCurrencyPreferenceResponse, error)
+	CreateRateSourcePreference(context.Context, *CreateRateSourcePreferenceRequest) (*CreateRateSourcePreferenceResponse, error)
+	ListRateSourcePreferences(context.Context, *ListRateSourcePreferencesRequest) (*ListRateSourcePreferencesResponse, error)
+	UpdateRateSourcePreference(context.Context, *UpdateRateSourcePreferenceRequest) (*UpdateRateSourcePreferenceResponse, error)
+	DeleteRateSourcePreference(context.Context, *DeleteRateSourcePreferenceRequest) (*DeleteRateSourcePreferenceResponse, error)
+	mustEmbedUnimplementedRatePulsePreferenceServiceServer()
+}
+
+// UnimplementedRatePulsePreferenceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatePulsePreferenceServiceServer struct{}
+
+func (UnimplementedRatePulsePreferenceServiceServer) CreateCurrencyPreference(context.Context, *CreateCurrencyPreferenceRequest) (*CreateCurrencyPreferenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCurrencyPreference not implemented")
+}
+func (UnimplementedRatePulsePreferenceServiceServer) ListCurrencyPreferences(context.Context, *ListCurrencyPreferencesRequest) (*ListCurrencyPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCurrencyPreferences not implemented")
+}
+func (UnimplementedRatePulsePreferenceServiceServer) UpdateCurrencyPreference(context.Context, *UpdateCurrencyPreferenceRequest) (*UpdateCurrencyPreferenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCurrencyPreference not implemented")
+}
+func (UnimplementedRatePulsePreferenceServiceServer) DeleteCurrencyPreference(context.Context, *DeleteCurrencyPreferenceRequest) (*DeleteCurrencyPreferenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCurrencyPreference not implemented")
+}
+func (UnimplementedRatePulsePreferenceServiceServer) CreateRateSourcePreference(context.Context, *CreateRateSourcePreferenceRequest) (*CreateRateSourcePreferenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRateSourcePreference not implemented")
+}
+func (UnimplementedRatePulsePreferenceServiceServer) ListRateSourcePreferences(context.Context, *ListRateSourcePreferencesRequest) (*ListRateSourcePreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRateSourcePreferences not implemented")
+}
+func (UnimplementedRatePulsePreferenceServiceServer) UpdateRateSourcePreference(context.Context, *UpdateRateSourcePreferenceRequest) (*UpdateRateSourcePreferenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRateSourcePreference not implemented")
+}
+func (UnimplementedRatePulsePreferenceServiceServer) DeleteRateSourcePreference(context.Context, *DeleteRateSourcePreferenceRequest) (*DeleteRateSourcePreferenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRateSourcePreference not implemented")
+}
+func (UnimplementedRatePulsePreferenceServiceServer) mustEmbedUnimplementedRatePulsePreferenceServiceServer() {
+}
+func (UnimplementedRatePulsePreferenceServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRatePulsePreferenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatePulsePreferenceServiceServer will
+// result in compilation errors.
+type UnsafeRatePulsePreferenceServiceServer interface {
+	mustEmbedUnimplementedRatePulsePreferenceServiceServer()
+}
+
+func RegisterRatePulsePreferenceServiceServer(s grpc.ServiceRegistrar, srv RatePulsePreferenceServiceServer) {
+	// If the following call panics, it indicates UnimplementedRatePulsePreferenceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatePulsePreferenceService_ServiceDesc, srv)
+}
+
+func _RatePulsePreferenceService_CreateCurrencyPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCurrencyPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePreferenceServiceServer).CreateCurrencyPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePreferenceService_CreateCurrencyPreference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePreferenceServiceServer).CreateCurrencyPreference(ctx, req.(*CreateCurrencyPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulsePreferenceService_ListCurrencyPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCurrencyPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePreferenceServiceServer).ListCurrencyPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePreferenceService_ListCurrencyPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePreferenceServiceServer).ListCurrencyPreferences(ctx, req.(*ListCurrencyPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulsePreferenceService_UpdateCurrencyPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCurrencyPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePreferenceServiceServer).UpdateCurrencyPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePreferenceService_UpdateCurrencyPreference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePreferenceServiceServer).UpdateCurrencyPreference(ctx, req.(*UpdateCurrencyPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulsePreferenceService_DeleteCurrencyPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCurrencyPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePreferenceServiceServer).DeleteCurrencyPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePreferenceService_DeleteCurrencyPreference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePreferenceServiceServer).DeleteCurrencyPreference(ctx, req.(*DeleteCurrencyPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulsePreferenceService_CreateRateSourcePreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRateSourcePreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePreferenceServiceServer).CreateRateSourcePreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePreferenceService_CreateRateSourcePreference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePreferenceServiceServer).CreateRateSourcePreference(ctx, req.(*CreateRateSourcePreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulsePreferenceService_ListRateSourcePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRateSourcePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePreferenceServiceServer).ListRateSourcePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePreferenceService_ListRateSourcePreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePreferenceServiceServer).ListRateSourcePreferences(ctx, req.(*ListRateSourcePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulsePreferenceService_UpdateRateSourcePreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRateSourcePreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePreferenceServiceServer).UpdateRateSourcePreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePreferenceService_UpdateRateSourcePreference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePreferenceServiceServer).UpdateRateSourcePreference(ctx, req.(*UpdateRateSourcePreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePulsePreferenceService_DeleteRateSourcePreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRateSourcePreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulsePreferenceServiceServer).DeleteRateSourcePreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulsePreferenceService_DeleteRateSourcePreference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulsePreferenceServiceServer).DeleteRateSourcePreference(ctx, req.(*DeleteRateSourcePreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatePulsePreferenceService_ServiceDesc is the grpc.ServiceDesc for RatePulsePreferenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatePulsePreferenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.RatePulsePreferenceService",
+	HandlerType: (*RatePulsePreferenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCurrencyPreference",
+			Handler:    _RatePulsePreferenceService_CreateCurrencyPreference_Handler,
+		},
+		{
+			MethodName: "ListCurrencyPreferences",
+			Handler:    _RatePulsePreferenceService_ListCurrencyPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateCurrencyPreference",
+			Handler:    _RatePulsePreferenceService_UpdateCurrencyPreference_Handler,
+		},
+		{
+			MethodName: "DeleteCurrencyPreference",
+			Handler:    _RatePulsePreferenceService_DeleteCurrencyPreference_Handler,
+		},
+		{
+			MethodName: "CreateRateSourcePreference",
+			Handler:    _RatePulsePreferenceService_CreateRateSourcePreference_Handler,
+		},
+		{
+			MethodName: "ListRateSourcePreferences",
+			Handler:    _RatePulsePreferenceService_ListRateSourcePreferences_Handler,
+		},
+		{
+			MethodName: "UpdateRateSourcePreference",
+			Handler:    _RatePulsePreferenceService_UpdateRateSourcePreference_Handler,
+		},
+		{
+			MethodName: "DeleteRateSourcePreference",
+			Handler:    _RatePulsePreferenceService_DeleteRateSourcePreference_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_rate_pulse.proto",
+}
+
+const (
+	RatePulseInternalHealthService_CheckHealth_FullMethodName            = "/pb.RatePulseInternalHealthService/CheckHealth"
+	RatePulseInternalHealthService_GetRateSourceFreshness_FullMethodName = "/pb.RatePulseInternalHealthService/GetRateSourceFreshness"
+)
+
+// RatePulseInternalHealthServiceClient is the client API for RatePulseInternalHealthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatePulseInternalHealthServiceClient interface {
+	CheckHealth(ctx context.Context, in *CheckHealthRequest, opts ...grpc.CallOption) (*CheckHealthResponse, error)
+	GetRateSourceFreshness(ctx context.Context, in *GetRateSourceFreshnessRequest, opts ...grpc.CallOption) (*GetRateSourceFreshnessResponse, error)
+}
+
+type ratePulseInternalHealthServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatePulseInternalHealthServiceClient(cc grpc.ClientConnInterface) RatePulseInternalHealthServiceClient {
+	return &ratePulseInternalHealthServiceClient{cc}
+}
+
+func (c *ratePulseInternalHealthServiceClient) CheckHealth(ctx context.Context, in *CheckHealthRequest, opts ...grpc.CallOption) (*CheckHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckHealthResponse)
+	err := c.cc.Invoke(ctx, RatePulseInternalHealthService_CheckHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePulseInternalHealthServiceClient) GetRateSourceFreshness(ctx context.Context, in *GetRateSourceFreshnessRequest, opts ...grpc.CallOption) (*GetRateSourceFreshnessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRateSourceFreshnessResponse)
+	err := c.cc.Invoke(ctx, RatePulseInternalHealthService_GetRateSourceFreshness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatePulseInternalHealthServiceServer is the server API for RatePulseInternalHealthService service.
+// All implementations must embed UnimplementedRatePulseInternalHealthServiceServer
+// for forward compatibility.
+type RatePulseInternalHealthServiceServer interface {
+	CheckHealth(context.Context, *CheckHealthRequest) (*CheckHealthResponse, error)
+	GetRateSourceFreshness(context.Context, *GetRateSourceFreshnessRequest) (*GetRateSourceFreshnessResponse, error)
+	mustEmbedUnimplementedRatePulseInternalHealthServiceServer()
+}
+
+// UnimplementedRatePulseInternalHealthServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatePulseInternalHealthServiceServer struct{}
 
 func (UnimplementedRatePulseInternalHealthServiceServer) CheckHealth(context.Context, *CheckHealthRequest) (*CheckHealthResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CheckHealth not implemented")
 }
+func (UnimplementedRatePulseInternalHealthServiceServer) GetRateSourceFreshness(context.Context, *GetRateSourceFreshnessRequest) (*GetRateSourceFreshnessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRateSourceFreshness not implemented")
+}
 func (UnimplementedRatePulseInternalHealthServiceServer) mustEmbedUnimplementedRatePulseInternalHealthServiceServer() {
 }
 func (UnimplementedRatePulseInternalHealthServiceServer) testEmbeddedByValue() {}
@@ -387,6 +3917,24 @@ func _RatePulseInternalHealthService_CheckHealth_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RatePulseInternalHealthService_GetRateSourceFreshness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateSourceFreshnessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePulseInternalHealthServiceServer).GetRateSourceFreshness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePulseInternalHealthService_GetRateSourceFreshness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePulseInternalHealthServiceServer).GetRateSourceFreshness(ctx, req.(*GetRateSourceFreshnessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RatePulseInternalHealthService_ServiceDesc is the grpc.ServiceDesc for RatePulseInternalHealthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -398,6 +3946,10 @@ var RatePulseInternalHealthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckHealth",
 			Handler:    _RatePulseInternalHealthService_CheckHealth_Handler,
 		},
+		{
+			MethodName: "GetRateSourceFreshness",
+			Handler:    _RatePulseInternalHealthService_GetRateSourceFreshness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_rate_pulse.proto",
@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: subscription_plan.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubscriptionPlan struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PlanId          int32                  `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	PlanName        string                 `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	PlanPrice       string                 `protobuf:"bytes,3,opt,name=plan_price,json=planPrice,proto3" json:"plan_price,omitempty"`
+	HistoricalDays  int32                  `protobuf:"varint,4,opt,name=historical_days,json=historicalDays,proto3" json:"historical_days,omitempty"`
+	RateLimitPerDay int32                  `protobuf:"varint,5,opt,name=rate_limit_per_day,json=rateLimitPerDay,proto3" json:"rate_limit_per_day,omitempty"`
+	Features        *string                `protobuf:"bytes,6,opt,name=features,proto3,oneof" json:"features,omitempty"`
+	IsActive        bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SubscriptionPlan) Reset() {
+	*x = SubscriptionPlan{}
+	mi := &file_subscription_plan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionPlan) ProtoMessage() {}
+
+func (x *SubscriptionPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_plan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionPlan.ProtoReflect.Descriptor instead.
+func (*SubscriptionPlan) Descriptor() ([]byte, []int) {
+	return file_subscription_plan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscriptionPlan) GetPlanId() int32 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *SubscriptionPlan) GetPlanName() string {
+	if x != nil {
+		return x.PlanName
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetPlanPrice() string {
+	if x != nil {
+		return x.PlanPrice
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetHistoricalDays() int32 {
+	if x != nil {
+		return x.HistoricalDays
+	}
+	return 0
+}
+
+func (x *SubscriptionPlan) GetRateLimitPerDay() int32 {
+	if x != nil {
+		return x.RateLimitPerDay
+	}
+	return 0
+}
+
+func (x *SubscriptionPlan) GetFeatures() string {
+	if x != nil && x.Features != nil {
+		return *x.Features
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SubscriptionPlan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SubscriptionPlan) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_subscription_plan_proto protoreflect.FileDescriptor
+
+const file_subscription_plan_proto_rawDesc = "" +
+	"\n" +
+	"\x17subscription_plan.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfe\x02\n" +
+	"\x10SubscriptionPlan\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\x05R\x06planId\x12\x1b\n" +
+	"\tplan_name\x18\x02 \x01(\tR\bplanName\x12\x1d\n" +
+	"\n" +
+	"plan_price\x18\x03 \x01(\tR\tplanPrice\x12'\n" +
+	"\x0fhistorical_days\x18\x04 \x01(\x05R\x0ehistoricalDays\x12+\n" +
+	"\x12rate_limit_per_day\x18\x05 \x01(\x05R\x0frateLimitPerDay\x12\x1f\n" +
+	"\bfeatures\x18\x06 \x01(\tH\x00R\bfeatures\x88\x01\x01\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\v\n" +
+	"\t_featuresB(Z&github.com/ThanhVinhTong/rate-pulse/pbb\x06proto3"
+
+var (
+	file_subscription_plan_proto_rawDescOnce sync.Once
+	file_subscription_plan_proto_rawDescData []byte
+)
+
+func file_subscription_plan_proto_rawDescGZIP() []byte {
+	file_subscription_plan_proto_rawDescOnce.Do(func() {
+		file_subscription_plan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_subscription_plan_proto_rawDesc), len(file_subscription_plan_proto_rawDesc)))
+	})
+	return file_subscription_plan_proto_rawDescData
+}
+
+var file_subscription_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_subscription_plan_proto_goTypes = []any{
+	(*SubscriptionPlan)(nil),      // 0: pb.SubscriptionPlan
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_subscription_plan_proto_depIdxs = []int32{
+	1, // 0: pb.SubscriptionPlan.created_at:type_name -> google.protobuf.Timestamp
+	1, // 1: pb.SubscriptionPlan.updated_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_subscription_plan_proto_init() }
+func file_subscription_plan_proto_init() {
+	if File_subscription_plan_proto != nil {
+		return
+	}
+	file_subscription_plan_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_subscription_plan_proto_rawDesc), len(file_subscription_plan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_subscription_plan_proto_goTypes,
+		DependencyIndexes: file_subscription_plan_proto_depIdxs,
+		MessageInfos:      file_subscription_plan_proto_msgTypes,
+	}.Build()
+	File_subscription_plan_proto = out.File
+	file_subscription_plan_proto_goTypes = nil
+	file_subscription_plan_proto_depIdxs = nil
+}
@@ -0,0 +1,70 @@
+package pubsub
+
+import (
+	"context"
+	"time"
+)
+
+// RateUpdatesChannel is the Redis channel every API replica publishes written rates on.
+const RateUpdatesChannel = "rate-pulse:exchange-rates:v1"
+
+// RateUpdate is an active exchange rate that was just inserted, changed or approved.
+type RateUpdate struct {
+	RateID                int32     `json:"rate_id"`
+	RateValue             string    `json:"rate_value"`
+	SourceCurrencyID      int32     `json:"source_currency_id"`
+	DestinationCurrencyID int32     `json:"destination_currency_id"`
+	SourceID              int32     `json:"source_id"`
+	TypeID                int32     `json:"type_id"`
+	ValidFromDate         time.Time `json:"valid_from_date"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+// RateFilter selects the updates a subscriber receives. Zero fields match every rate.
+type RateFilter struct {
+	SourceCurrencyID int32
+	SourceID         int32
+	TypeID           int32
+}
+
+func (filter RateFilter) Matches(update RateUpdate) bool {
+	if filter.SourceCurrencyID != 0 && filter.SourceCurrencyID != update.SourceCurrencyID {
+		return false
+	}
+	if filter.SourceID != 0 && filter.SourceID != update.SourceID {
+		return false
+	}
+	if filter.TypeID != 0 && filter.TypeID != update.TypeID {
+		return false
+	}
+	return true
+}
+
+type Publisher interface {
+	PublishRateUpdates(ctx context.Context, updates []RateUpdate) error
+}
+
+type Subscriber interface {
+	// SubscribeRateUpdates delivers matching updates until ctx is done, then closes the channel.
+	SubscribeRateUpdates(ctx context.Context, filter RateFilter) (<-chan RateUpdate, error)
+}
+
+type Broker interface {
+	Publisher
+	Subscriber
+}
+
+type NoopBroker struct{}
+
+func (NoopBroker) PublishRateUpdates(ctx context.Context, updates []RateUpdate) error {
+	return nil
+}
+
+func (NoopBroker) SubscribeRateUpdates(ctx context.Context, filter RateFilter) (<-chan RateUpdate, error) {
+	updates := make(chan RateUpdate)
+	go func() {
+		<-ctx.Done()
+		close(updates)
+	}()
+	return updates, nil
+}
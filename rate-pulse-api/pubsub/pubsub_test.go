@@ -0,0 +1,136 @@
+package pubsub
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateFilterMatches(t *testing.T) {
+	update := RateUpdate{RateID: 1, SourceCurrencyID: 1, DestinationCurrencyID: 2, SourceID: 10, TypeID: 4}
+
+	require.True(t, RateFilter{}.Matches(update))
+	require.True(t, RateFilter{SourceCurrencyID: 1, SourceID: 10, TypeID: 4}.Matches(update))
+	require.False(t, RateFilter{SourceCurrencyID: 2}.Matches(update))
+	require.False(t, RateFilter{SourceID: 11}.Matches(update))
+	require.False(t, RateFilter{TypeID: 1}.Matches(update))
+}
+
+func TestNoopBrokerClosesWhenDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	updates, err := NoopBroker{}.SubscribeRateUpdates(ctx, RateFilter{})
+	require.NoError(t, err)
+
+	cancel()
+	select {
+	case _, ok := <-updates:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("subscription was not closed")
+	}
+}
+
+func TestRedisBrokerDispatchFiltersAndDropsForSlowSubscribers(t *testing.T) {
+	broker := NewRedisBroker(nil)
+	vnd := &subscriber{filter: RateFilter{SourceCurrencyID: 1}, updates: make(chan RateUpdate, 1)}
+	usd := &subscriber{filter: RateFilter{SourceCurrencyID: 2}, updates: make(chan RateUpdate, 1)}
+	broker.subscribers[vnd] = struct{}{}
+	broker.subscribers[usd] = struct{}{}
+
+	broker.dispatch(RateUpdate{RateID: 1, SourceCurrencyID: 1})
+	// The buffer is full, so the second update is dropped instead of blocking.
+	broker.dispatch(RateUpdate{RateID: 2, SourceCurrencyID: 1})
+
+	require.Len(t, vnd.updates, 1)
+	require.Equal(t, int32(1), (<-vnd.updates).RateID)
+	require.Empty(t, usd.updates)
+}
+
+func TestRedisBrokerUnsubscribeStopsSubscriptionAfterLastSubscriber(t *testing.T) {
+	broker := NewRedisBroker(nil)
+	stopped := false
+	broker.stop = func() { stopped = true }
+	first := &subscriber{updates: make(chan RateUpdate, 1)}
+	second := &subscriber{updates: make(chan RateUpdate, 1)}
+	broker.subscribers[first] = struct{}{}
+	broker.subscribers[second] = struct{}{}
+
+	broker.unsubscribe(first)
+	_, ok := <-first.updates
+	require.False(t, ok)
+	require.False(t, stopped)
+
+	broker.unsubscribe(second)
+	require.True(t, stopped)
+	require.Nil(t, broker.stop)
+}
+
+func TestRedisBrokerSubscribeJoinsOpenSubscription(t *testing.T) {
+	// A nil client would panic if the broker opened a second Redis subscription.
+	broker := NewRedisBroker(nil)
+	broker.stop = func() {}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates, err := broker.SubscribeRateUpdates(ctx, RateFilter{})
+	require.NoError(t, err)
+	require.NotNil(t, updates)
+
+	broker.mu.Lock()
+	require.Len(t, broker.subscribers, 1)
+	broker.mu.Unlock()
+}
+
+func TestRedisBrokerSubscribeDoesNotHoldLockWhileRedisHangs(t *testing.T) {
+	// The listener accepts connections but never answers, like a Redis that hangs.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			accepted <- conn
+		}
+	}()
+	client := redis.NewClient(&redis.Options{Addr: listener.Addr().String(), MaxRetries: -1, ReadTimeout: time.Second})
+	t.Cleanup(func() { _ = client.Close() })
+	broker := NewRedisBroker(client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		_, err := broker.SubscribeRateUpdates(ctx, RateFilter{})
+		done <- err
+	}()
+
+	select {
+	case conn := <-accepted:
+		t.Cleanup(func() { _ = conn.Close() })
+	case <-time.After(time.Second):
+		t.Fatal("broker did not connect to Redis")
+	}
+
+	// Dispatch takes mu, so it must not wait for the subscription attempt.
+	dispatched := make(chan struct{})
+	go func() {
+		broker.dispatch(RateUpdate{RateID: 1})
+		close(dispatched)
+	}()
+	select {
+	case <-dispatched:
+	case <-time.After(300 * time.Millisecond):
+		t.Fatal("dispatch blocked while the Redis subscription was being opened")
+	}
+
+	require.Error(t, <-done)
+	broker.mu.Lock()
+	require.Empty(t, broker.subscribers)
+	require.Nil(t, broker.stop)
+	broker.mu.Unlock()
+}
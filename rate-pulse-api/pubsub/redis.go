@@ -0,0 +1,154 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+// subscriberBuffer is how many updates a slow subscriber may fall behind before updates are dropped for it.
+const subscriberBuffer = 256
+
+type subscriber struct {
+	filter  RateFilter
+	updates chan RateUpdate
+}
+
+// RedisBroker fans rate updates out across API replicas through Redis pub/sub.
+// Each process holds a single Redis subscription, opened for the first local
+// subscriber and closed after the last one leaves, and dispatches to its
+// subscribers in memory.
+type RedisBroker struct {
+	client *redis.Client
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	stop        context.CancelFunc
+}
+
+func NewRedisBroker(client *redis.Client) *RedisBroker {
+	return &RedisBroker{
+		client:      client,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+func (broker *RedisBroker) PublishRateUpdates(ctx context.Context, updates []RateUpdate) error {
+	if len(updates) == 0 {
+		return nil
+	}
+
+	pipe := broker.client.Pipeline()
+	for _, update := range updates {
+		payload, err := json.Marshal(update)
+		if err != nil {
+			return err
+		}
+		pipe.Publish(ctx, RateUpdatesChannel, payload)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (broker *RedisBroker) SubscribeRateUpdates(ctx context.Context, filter RateFilter) (<-chan RateUpdate, error) {
+	sub := &subscriber{
+		filter:  filter,
+		updates: make(chan RateUpdate, subscriberBuffer),
+	}
+
+	// The Redis subscription is opened without holding mu, so a slow or unreachable Redis
+	// does not stall dispatch and unsubscribe for the clients already connected. The state
+	// is checked again once the subscription is confirmed, since another caller may have
+	// opened one, or the last subscriber may have closed it, in the meantime.
+	var redisSub *redis.PubSub
+	for {
+		broker.mu.Lock()
+		if broker.stop != nil {
+			broker.subscribers[sub] = struct{}{}
+			broker.mu.Unlock()
+			break
+		}
+		if redisSub != nil {
+			runCtx, stop := context.WithCancel(context.Background())
+			broker.stop = stop
+			go broker.run(runCtx, redisSub)
+			redisSub = nil
+			broker.subscribers[sub] = struct{}{}
+			broker.mu.Unlock()
+			break
+		}
+		broker.mu.Unlock()
+
+		redisSub = broker.client.Subscribe(context.Background(), RateUpdatesChannel)
+		// Wait for the confirmation so a Redis outage surfaces to the caller.
+		if _, err := redisSub.Receive(ctx); err != nil {
+			_ = redisSub.Close()
+			return nil, err
+		}
+	}
+	if redisSub != nil {
+		// Another caller opened the shared subscription first.
+		_ = redisSub.Close()
+	}
+
+	go func() {
+		<-ctx.Done()
+		broker.unsubscribe(sub)
+	}()
+
+	return sub.updates, nil
+}
+
+func (broker *RedisBroker) run(ctx context.Context, redisSub *redis.PubSub) {
+	defer redisSub.Close()
+
+	messages := redisSub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+			var update RateUpdate
+			if err := json.Unmarshal([]byte(message.Payload), &update); err != nil {
+				log.Error().Err(err).Str("channel", message.Channel).Msg("cannot decode rate update")
+				continue
+			}
+			broker.dispatch(update)
+		}
+	}
+}
+
+func (broker *RedisBroker) dispatch(update RateUpdate) {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	for sub := range broker.subscribers {
+		if !sub.filter.Matches(update) {
+			continue
+		}
+		select {
+		case sub.updates <- update:
+		default:
+			// Never let one slow client hold up the others.
+			log.Warn().Int32("rate_id", update.RateID).Msg("dropped rate update for slow subscriber")
+		}
+	}
+}
+
+func (broker *RedisBroker) unsubscribe(sub *subscriber) {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	delete(broker.subscribers, sub)
+	close(sub.updates)
+	if len(broker.subscribers) == 0 && broker.stop != nil {
+		broker.stop()
+		broker.stop = nil
+	}
+}
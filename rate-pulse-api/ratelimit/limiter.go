@@ -7,93 +7,164 @@ import (
 	"time"
 
 	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
 )
 
-// RateLimiter implements token bucket algorithm for rate limiting using Redis
-type RateLimiter struct {
-	client         *redis.Client
-	requestsPerMin int
+// Algorithm names a rate-limiting algorithm a Limiter can run.
+type Algorithm string
+
+const (
+	// AlgorithmGCRA spaces requests evenly and allows a burst of Policy.Burst at once.
+	AlgorithmGCRA Algorithm = "gcra"
+	// AlgorithmTokenBucket refills Policy.Limit tokens per Policy.Period into a bucket of Policy.Burst.
+	AlgorithmTokenBucket Algorithm = "token_bucket"
+)
+
+// ParseAlgorithm maps a RATE_LIMIT_ALGORITHM value to an Algorithm; empty selects GCRA.
+func ParseAlgorithm(name string) (Algorithm, error) {
+	switch Algorithm(strings.ToLower(strings.TrimSpace(name))) {
+	case "", AlgorithmGCRA:
+		return AlgorithmGCRA, nil
+	case AlgorithmTokenBucket:
+		return AlgorithmTokenBucket, nil
+	}
+	return "", fmt.Errorf("unknown rate limit algorithm %q, use gcra or token_bucket", name)
 }
 
-const defaultRequestsPerMinute = 300
+// Policy allows Limit requests per Period, with up to Burst of them at once.
+// Name keeps the counters of different policies apart for the same caller.
+type Policy struct {
+	Name   string
+	Limit  int
+	Period time.Duration
+	Burst  int
+}
+
+// interval is the time in milliseconds one request costs: Period spread over Limit.
+func (policy Policy) interval() int64 {
+	limit := max(policy.Limit, 1)
+	return max(policy.Period.Milliseconds()/int64(limit), 1)
+}
 
-// NewRateLimiter creates a new rate limiter with Redis backend
-func NewRateLimiter(redisClient *redis.Client, requestsPerMin int) *RateLimiter {
-	if requestsPerMin <= 0 {
-		requestsPerMin = defaultRequestsPerMinute
+func (policy Policy) burst() int64 {
+	if policy.Burst > 0 {
+		return int64(policy.Burst)
 	}
+	return int64(max(policy.Limit, 1))
+}
+
+// Result is a limiter's decision on one request.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// RetryAfter is how long a rejected caller must wait; zero when allowed.
+	RetryAfter time.Duration
+	// ResetAt is when the caller's full burst is available again.
+	ResetAt time.Time
+}
 
-	return &RateLimiter{
-		client:         redisClient,
-		requestsPerMin: requestsPerMin,
+// Limiter decides whether the caller identified by key may make one more request under policy.
+type Limiter interface {
+	Allow(ctx context.Context, key string, policy Policy) (Result, error)
+}
+
+// New builds the limiter the API runs with: Redis-backed so replicas share counters, falling
+// back to an in-process limiter while Redis errors. Without a Redis client it is in-process only.
+func New(client *redis.Client, algorithm Algorithm) Limiter {
+	local := NewMemoryLimiter(algorithm)
+	if client == nil {
+		return local
 	}
+	return NewFallbackLimiter(NewRedisLimiter(client, algorithm), local)
 }
 
-// Allow checks if a request from the given key is allowed
-// Returns (allowed bool, remaining int, resetTime int64)
-func (rl *RateLimiter) Allow(ctx context.Context, key string) (bool, int, int64) {
-	// Ensure key is valid
-	key = strings.TrimSpace(key)
-	if key == "" {
-		return false, 0, 0
+// FallbackLimiter asks primary and switches to fallback for requests primary fails on, so a
+// Redis outage degrades limiting to per-replica counters instead of disabling it.
+type FallbackLimiter struct {
+	primary  Limiter
+	fallback Limiter
+}
+
+func NewFallbackLimiter(primary Limiter, fallback Limiter) *FallbackLimiter {
+	return &FallbackLimiter{primary: primary, fallback: fallback}
+}
+
+func (limiter *FallbackLimiter) Allow(ctx context.Context, key string, policy Policy) (Result, error) {
+	result, err := limiter.primary.Allow(ctx, key, policy)
+	if err == nil {
+		return result, nil
 	}
 
-	now := time.Now().Unix()
-	if rl == nil {
-		return true, defaultRequestsPerMinute, now + 60
+	log.Warn().Err(err).Str("policy", policy.Name).Msg("rate limiter unavailable, using in-process fallback")
+	return limiter.fallback.Allow(ctx, key, policy)
+}
+
+// decision is the outcome of one algorithm step, in milliseconds relative to now.
+type decision struct {
+	allowed      bool
+	remaining    int64
+	retryAfterMs int64
+	resetAfterMs int64
+}
+
+func (d decision) result(policy Policy, now time.Time) Result {
+	return Result{
+		Allowed:    d.allowed,
+		Limit:      int(policy.burst()),
+		Remaining:  int(max(d.remaining, 0)),
+		RetryAfter: time.Duration(d.retryAfterMs) * time.Millisecond,
+		ResetAt:    now.Add(time.Duration(d.resetAfterMs) * time.Millisecond),
 	}
+}
 
-	requestsPerMin := rl.requestsPerMin
-	if requestsPerMin <= 0 {
-		requestsPerMin = defaultRequestsPerMinute
+/*
+gcraStep runs the generic cell rate algorithm for one request at nowMs.
+- tat is the theoretical arrival time of the next request, at or before now when idle
+- A request is allowed unless it arrives more than burst intervals before its tat
+- Returns the new tat, which is also the moment the full burst is available again
+*/
+func gcraStep(tat int64, nowMs int64, interval int64, burst int64) (int64, decision) {
+	tat = max(tat, nowMs)
+	newTAT := tat + interval
+	allowAt := newTAT - interval*burst
+	if nowMs < allowAt {
+		return tat, decision{retryAfterMs: allowAt - nowMs, resetAfterMs: tat - nowMs}
 	}
-	if rl.client == nil {
-		return true, requestsPerMin, now + 60
+	return newTAT, decision{
+		allowed:      true,
+		remaining:    (nowMs - allowAt) / interval,
+		resetAfterMs: newTAT - nowMs,
 	}
+}
 
-	redisKey := fmt.Sprintf("ratelimit:%s", key)
-	windowStart := now - 60 // 1 minute window
-
-	// Lua script to atomically check and increment rate limit counter
-	script := redis.NewScript(`
-		local key = KEYS[1]
-		local limit = tonumber(ARGV[1])
-		local now = tonumber(ARGV[2])
-		local window_start = tonumber(ARGV[3])
-		
-		-- Remove old entries outside the window
-		redis.call('ZREMRANGEBYSCORE', key, '-inf', window_start)
-		
-		-- Count requests in the current window
-		local current = redis.call('ZCARD', key)
-		
-		-- Check if limit exceeded
-		if current < limit then
-			-- Add current request with timestamp as score
-			redis.call('ZADD', key, now, now)
-			-- Set expiration to 2 minutes to clean up old data
-			redis.call('EXPIRE', key, 120)
-			-- Return: allowed, remaining, reset_time
-			return {1, limit - current - 1, now + 60}
-		else
-			-- Get the oldest request timestamp for reset time calculation
-			local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
-			local reset_time = oldest[2] and (tonumber(oldest[2]) + 60) or (now + 60)
-			return {0, 0, reset_time}
-		end
-	`)
-
-	result, err := script.Run(ctx, rl.client, []string{redisKey}, requestsPerMin, now, windowStart).Result()
-	if err != nil {
-		// On error, fail open (allow the request) but log it
-		// In production, you might want to fail closed instead
-		return true, requestsPerMin, now + 60
+/*
+tokenBucketStep refills the bucket for the time since updatedMs and takes one token.
+- The bucket holds up to burst tokens and gains one every interval milliseconds
+- A request is allowed when at least one whole token is left
+- Returns the tokens left after the request
+*/
+func tokenBucketStep(tokens float64, updatedMs int64, nowMs int64, interval int64, burst int64) (float64, decision) {
+	if nowMs > updatedMs {
+		tokens = min(float64(burst), tokens+float64(nowMs-updatedMs)/float64(interval))
 	}
 
-	values := result.([]interface{})
-	allowed := values[0].(int64) == 1
-	remaining := int(values[1].(int64))
-	resetTime := values[2].(int64)
+	d := decision{}
+	if tokens >= 1 {
+		tokens--
+		d.allowed = true
+	} else {
+		d.retryAfterMs = ceilMs((1 - tokens) * float64(interval))
+	}
+	d.remaining = int64(tokens)
+	d.resetAfterMs = ceilMs((float64(burst) - tokens) * float64(interval))
+	return tokens, d
+}
 
-	return allowed, remaining, resetTime
+func ceilMs(ms float64) int64 {
+	whole := int64(ms)
+	if float64(whole) < ms {
+		whole++
+	}
+	return whole
 }
@@ -2,32 +2,176 @@ package ratelimit
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 )
 
-func TestAllowFailsOpenWithoutRedisClient(t *testing.T) {
-	limiter := NewRateLimiter(nil, 42)
+// fakeClock is a controllable time source for the in-process limiter.
+type fakeClock struct {
+	now time.Time
+}
+
+func (clock *fakeClock) Now() time.Time {
+	return clock.now
+}
+
+func (clock *fakeClock) Advance(d time.Duration) {
+	clock.now = clock.now.Add(d)
+}
+
+func newTestMemoryLimiter(algorithm Algorithm) (*MemoryLimiter, *fakeClock) {
+	clock := &fakeClock{now: time.Unix(1_700_000_000, 0)}
+	limiter := NewMemoryLimiter(algorithm)
+	limiter.now = clock.Now
+	return limiter, clock
+}
 
-	allowed, remaining, resetTime := limiter.Allow(context.Background(), "127.0.0.1")
+func allowN(t *testing.T, limiter Limiter, key string, policy Policy, n int) Result {
+	t.Helper()
 
-	if !allowed {
-		t.Fatal("expected request to be allowed without Redis client")
+	var result Result
+	for i := 0; i < n; i++ {
+		var err error
+		result, err = limiter.Allow(context.Background(), key, policy)
+		if err != nil {
+			t.Fatalf("Allow() error = %v", err)
+		}
+		if !result.Allowed {
+			t.Fatalf("request %d rejected, want allowed", i+1)
+		}
 	}
-	if remaining != 42 {
-		t.Fatalf("remaining = %d, want 42", remaining)
+	return result
+}
+
+func TestMemoryLimiterBurstWithinOneSecond(t *testing.T) {
+	policy := Policy{Name: "test", Limit: 10, Period: time.Minute, Burst: 10}
+
+	for _, algorithm := range []Algorithm{AlgorithmGCRA, AlgorithmTokenBucket} {
+		t.Run(string(algorithm), func(t *testing.T) {
+			limiter, _ := newTestMemoryLimiter(algorithm)
+
+			last := allowN(t, limiter, "127.0.0.1", policy, 10)
+			if last.Remaining != 0 {
+				t.Fatalf("remaining = %d, want 0", last.Remaining)
+			}
+
+			result, err := limiter.Allow(context.Background(), "127.0.0.1", policy)
+			if err != nil {
+				t.Fatalf("Allow() error = %v", err)
+			}
+			if result.Allowed {
+				t.Fatal("11th request in the same instant was allowed")
+			}
+			if result.RetryAfter != 6*time.Second {
+				t.Fatalf("retry after = %s, want 6s", result.RetryAfter)
+			}
+		})
 	}
-	if resetTime <= time.Now().Unix() {
-		t.Fatalf("resetTime = %d, want a future unix timestamp", resetTime)
+}
+
+func TestMemoryLimiterRefillsOverTime(t *testing.T) {
+	policy := Policy{Name: "test", Limit: 60, Period: time.Minute, Burst: 5}
+
+	for _, algorithm := range []Algorithm{AlgorithmGCRA, AlgorithmTokenBucket} {
+		t.Run(string(algorithm), func(t *testing.T) {
+			limiter, clock := newTestMemoryLimiter(algorithm)
+
+			allowN(t, limiter, "user:1", policy, 5)
+			if result, _ := limiter.Allow(context.Background(), "user:1", policy); result.Allowed {
+				t.Fatal("request over the burst was allowed")
+			}
+
+			clock.Advance(time.Second)
+			allowN(t, limiter, "user:1", policy, 1)
+
+			clock.Advance(time.Minute)
+			last := allowN(t, limiter, "user:1", policy, 5)
+			if last.Limit != 5 {
+				t.Fatalf("limit = %d, want the burst 5", last.Limit)
+			}
+		})
 	}
 }
 
-func TestNewRateLimiterDefaultsLimit(t *testing.T) {
-	limiter := NewRateLimiter(nil, 0)
+func TestMemoryLimiterSeparatesKeysAndPolicies(t *testing.T) {
+	limiter, _ := newTestMemoryLimiter(AlgorithmGCRA)
+	signin := Policy{Name: "signin", Limit: 1, Period: time.Minute}
+	general := Policy{Name: "default", Limit: 1, Period: time.Minute}
 
-	_, remaining, _ := limiter.Allow(context.Background(), "127.0.0.1")
+	allowN(t, limiter, "10.0.0.1", signin, 1)
+	allowN(t, limiter, "10.0.0.2", signin, 1)
+	allowN(t, limiter, "10.0.0.1", general, 1)
+}
+
+func TestMemoryLimiterRejectsEmptyKey(t *testing.T) {
+	limiter, _ := newTestMemoryLimiter(AlgorithmGCRA)
+
+	if _, err := limiter.Allow(context.Background(), " ", Policy{Limit: 1, Period: time.Minute}); err == nil {
+		t.Fatal("expected an error for an empty key")
+	}
+}
 
-	if remaining != defaultRequestsPerMinute {
-		t.Fatalf("remaining = %d, want %d", remaining, defaultRequestsPerMinute)
+type failingLimiter struct{}
+
+func (failingLimiter) Allow(ctx context.Context, key string, policy Policy) (Result, error) {
+	return Result{}, errors.New("redis: connection refused")
+}
+
+func TestFallbackLimiterUsesFallbackWhenPrimaryFails(t *testing.T) {
+	fallback, _ := newTestMemoryLimiter(AlgorithmGCRA)
+	limiter := NewFallbackLimiter(failingLimiter{}, fallback)
+	policy := Policy{Name: "signin", Limit: 2, Period: time.Minute}
+
+	allowN(t, limiter, "10.0.0.1", policy, 2)
+
+	result, err := limiter.Allow(context.Background(), "10.0.0.1", policy)
+	if err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+	if result.Allowed {
+		t.Fatal("fallback did not enforce the limit")
+	}
+}
+
+func TestNewWithoutRedisClientIsInProcess(t *testing.T) {
+	if _, ok := New(nil, AlgorithmGCRA).(*MemoryLimiter); !ok {
+		t.Fatal("expected the in-process limiter without a Redis client")
+	}
+}
+
+func TestParseAlgorithm(t *testing.T) {
+	testCases := map[string]Algorithm{
+		"":             AlgorithmGCRA,
+		"GCRA":         AlgorithmGCRA,
+		"token_bucket": AlgorithmTokenBucket,
+	}
+	for name, want := range testCases {
+		got, err := ParseAlgorithm(name)
+		if err != nil || got != want {
+			t.Fatalf("ParseAlgorithm(%q) = %q, %v; want %q", name, got, err, want)
+		}
+	}
+
+	if _, err := ParseAlgorithm("sliding_window"); err == nil {
+		t.Fatal("expected an error for an unknown algorithm")
+	}
+}
+
+func TestPoliciesSelect(t *testing.T) {
+	policies := Policies{
+		Default:   Policy{Name: "default"},
+		UserTypes: map[string]Policy{"premium": {Name: "premium"}},
+		Routes:    map[string]Policy{"POST /users/signin": {Name: "signin"}},
+	}
+
+	if got := policies.Select("POST /users/signin", "premium").Name; got != "signin" {
+		t.Fatalf("route policy = %q, want signin", got)
+	}
+	if got := policies.Select("GET /currencies", "premium").Name; got != "premium" {
+		t.Fatalf("user type policy = %q, want premium", got)
+	}
+	if got := policies.Select("GET /currencies", "").Name; got != "default" {
+		t.Fatalf("default policy = %q, want default", got)
 	}
 }
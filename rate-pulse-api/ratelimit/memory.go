@@ -0,0 +1,89 @@
+package ratelimit
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// pruneEvery is how many calls pass between sweeps of idle keys.
+const pruneEvery = 1024
+
+type memoryState struct {
+	// value is the TAT in milliseconds for GCRA and the token count for the token bucket.
+	value     float64
+	updatedMs int64
+	// idleAtMs is when the key holds no state worth keeping.
+	idleAtMs int64
+}
+
+// MemoryLimiter keeps counters in process. It backs the Redis limiter while Redis is
+// unavailable, so its limits apply per replica.
+type MemoryLimiter struct {
+	algorithm Algorithm
+	now       func() time.Time
+
+	mu     sync.Mutex
+	states map[string]*memoryState
+	calls  int
+}
+
+func NewMemoryLimiter(algorithm Algorithm) *MemoryLimiter {
+	return &MemoryLimiter{
+		algorithm: algorithm,
+		now:       time.Now,
+		states:    make(map[string]*memoryState),
+	}
+}
+
+func (limiter *MemoryLimiter) Allow(ctx context.Context, key string, policy Policy) (Result, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return Result{}, errEmptyKey
+	}
+
+	now := limiter.now()
+	nowMs := now.UnixMilli()
+	interval, burst := policy.interval(), policy.burst()
+	storeKey := policy.Name + ":" + key
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	limiter.calls++
+	if limiter.calls%pruneEvery == 0 {
+		limiter.prune(nowMs)
+	}
+
+	state, ok := limiter.states[storeKey]
+	if !ok {
+		state = &memoryState{updatedMs: nowMs}
+		if limiter.algorithm == AlgorithmTokenBucket {
+			state.value = float64(burst)
+		}
+		limiter.states[storeKey] = state
+	}
+
+	var d decision
+	if limiter.algorithm == AlgorithmTokenBucket {
+		state.value, d = tokenBucketStep(state.value, state.updatedMs, nowMs, interval, burst)
+	} else {
+		var tat int64
+		tat, d = gcraStep(int64(state.value), nowMs, interval, burst)
+		state.value = float64(tat)
+	}
+	state.updatedMs = nowMs
+	state.idleAtMs = nowMs + d.resetAfterMs
+
+	return d.result(policy, now), nil
+}
+
+// prune drops keys whose full burst is available again; they behave like new keys.
+func (limiter *MemoryLimiter) prune(nowMs int64) {
+	for key, state := range limiter.states {
+		if state.idleAtMs <= nowMs {
+			delete(limiter.states, key)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package ratelimit
+
+// Policies picks the policy a request is limited by.
+// Route policies are keyed "METHOD /path" and win over user-type policies, so sensitive
+// routes stay strict for everyone; user types without a policy get Default.
+type Policies struct {
+	Default   Policy
+	UserTypes map[string]Policy
+	Routes    map[string]Policy
+}
+
+func (policies Policies) Select(route string, userType string) Policy {
+	if policy, ok := policies.Routes[route]; ok {
+		return policy
+	}
+	if policy, ok := policies.UserTypes[userType]; ok {
+		return policy
+	}
+	return policies.Default
+}
@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var errEmptyKey = errors.New("rate limit key is empty")
+
+// Both scripts read the clock from Redis so replicas with skewed clocks share one timeline,
+// and work in milliseconds so requests within the same second are all counted.
+// They return {allowed, remaining, retry_after_ms, reset_after_ms}.
+
+// gcraScript keeps the theoretical arrival time (TAT) of the next request in one key.
+var gcraScript = redis.NewScript(`
+local key = KEYS[1]
+local interval = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local tat = tonumber(redis.call('GET', key) or now)
+if tat < now then
+	tat = now
+end
+
+local new_tat = tat + interval
+local allow_at = new_tat - interval * burst
+if now < allow_at then
+	return {0, 0, allow_at - now, tat - now}
+end
+
+redis.call('SET', key, new_tat, 'PX', new_tat - now)
+return {1, math.floor((now - allow_at) / interval), 0, new_tat - now}
+`)
+
+// tokenBucketScript keeps the token count and the time it was last refilled in a hash.
+var tokenBucketScript = redis.NewScript(`
+local key = KEYS[1]
+local interval = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local state = redis.call('HMGET', key, 'tokens', 'ts')
+local tokens = tonumber(state[1]) or burst
+local ts = tonumber(state[2]) or now
+if now > ts then
+	tokens = math.min(burst, tokens + (now - ts) / interval)
+end
+
+local allowed = 0
+local retry_after = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry_after = math.ceil((1 - tokens) * interval)
+end
+
+local reset_after = math.ceil((burst - tokens) * interval)
+redis.call('HSET', key, 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', key, math.max(reset_after, 1))
+return {allowed, math.floor(tokens), retry_after, reset_after}
+`)
+
+// RedisLimiter keeps counters in Redis so every replica enforces the same limits.
+type RedisLimiter struct {
+	client    *redis.Client
+	algorithm Algorithm
+}
+
+func NewRedisLimiter(client *redis.Client, algorithm Algorithm) *RedisLimiter {
+	return &RedisLimiter{client: client, algorithm: algorithm}
+}
+
+func (limiter *RedisLimiter) Allow(ctx context.Context, key string, policy Policy) (Result, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return Result{}, errEmptyKey
+	}
+
+	script := gcraScript
+	if limiter.algorithm == AlgorithmTokenBucket {
+		script = tokenBucketScript
+	}
+	redisKey := fmt.Sprintf("ratelimit:%s:%s:%s", limiter.algorithm, policy.Name, key)
+
+	values, err := script.Run(ctx, limiter.client, []string{redisKey}, policy.interval(), policy.burst()).Int64Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("run rate limit script: %w", err)
+	}
+	if len(values) != 4 {
+		return Result{}, fmt.Errorf("rate limit script returned %d values, want 4", len(values))
+	}
+
+	d := decision{
+		allowed:      values[0] == 1,
+		remaining:    values[1],
+		retryAfterMs: values[2],
+		resetAfterMs: values[3],
+	}
+	return d.result(policy, time.Now()), nil
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/util"
+)
+
+const (
+	ExchangeRateStatusActive      = "active"
+	ExchangeRateStatusQuarantined = "quarantined"
+	ExchangeRateStatusRejected    = "rejected"
+
+	ReviewActionApprove = "approve"
+	ReviewActionReject  = "reject"
+
+	defaultAnomalyZScore      = 10
+	defaultAnomalyPercentBand = 20
+
+	// anomalyHistorySize is how many of the source's recent rates form its baseline.
+	anomalyHistorySize = 30
+	// anomalyMinHistory is the fewest samples a z-score is computed from.
+	anomalyMinHistory = 5
+	// anomalyMinPeers is the fewest other sources needed before comparing across banks.
+	anomalyMinPeers = 2
+	// anomalyPeerWindow keeps stale rates of other sources out of the comparison.
+	anomalyPeerWindow = 72 * time.Hour
+
+	maxQuarantineReasonLength = 255
+)
+
+// anomalyThresholds are the deviations beyond which a new rate is quarantined.
+type anomalyThresholds struct {
+	ZScore      float64
+	PercentBand float64
+}
+
+func newAnomalyThresholds(config util.Config) anomalyThresholds {
+	thresholds := anomalyThresholds{
+		ZScore:      config.RateAnomalyZScore,
+		PercentBand: config.RateAnomalyPercentBand,
+	}
+	if thresholds.ZScore <= 0 {
+		thresholds.ZScore = defaultAnomalyZScore
+	}
+	if thresholds.PercentBand <= 0 {
+		thresholds.PercentBand = defaultAnomalyPercentBand
+	}
+	return thresholds
+}
+
+// rateAnomalyCandidate is a rate about to be stored for a source, type and currency pair.
+type rateAnomalyCandidate struct {
+	RateValue             string
+	SourceCurrencyID      int32
+	DestinationCurrencyID int32
+	SourceID              int32
+	TypeID                int32
+}
+
+/*
+detectRateAnomaly decides whether a new rate should be quarantined instead of going live.
+- Compare against the median and z-score of the source's recent active rates for the pair and type
+- Compare against the median of other sources' latest active rates for the same pair and type
+- Rates without a source or type, or without enough history, are accepted
+- Return the quarantine reason, or an empty string when the rate looks normal
+*/
+func (s *FXService) detectRateAnomaly(ctx context.Context, candidate rateAnomalyCandidate, now time.Time) (string, error) {
+	if candidate.SourceID <= 0 || candidate.TypeID <= 0 {
+		return "", nil
+	}
+	value, err := parseDecimal(candidate.RateValue)
+	if err != nil || value <= 0 {
+		return "", nil
+	}
+
+	baseline, err := s.store.GetExchangeRateAnomalyBaseline(ctx, db.GetExchangeRateAnomalyBaselineParams{
+		SourceID:              candidate.SourceID,
+		TypeID:                candidate.TypeID,
+		SourceCurrencyID:      candidate.SourceCurrencyID,
+		DestinationCurrencyID: candidate.DestinationCurrencyID,
+		HistorySize:           anomalyHistorySize,
+		PeerSince:             now.Add(-anomalyPeerWindow),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", Wrap(err, ErrInternal.Code, "failed to load exchange rate anomaly baseline")
+	}
+
+	return evaluateRateAnomaly(value, baseline, s.anomaly), nil
+}
+
+// rateAnomalySeries identifies the (source, pair, type) series a baseline is computed for.
+type rateAnomalySeries struct {
+	SourceID              int32
+	TypeID                int32
+	SourceCurrencyID      int32
+	DestinationCurrencyID int32
+}
+
+/*
+detectRateAnomalies decides like detectRateAnomaly for a batch of new rates.
+- Load the baseline of every distinct (source, pair, type) of the batch in one query
+- Return the quarantine reason of each candidate in order, empty when the rate looks normal
+*/
+func (s *FXService) detectRateAnomalies(ctx context.Context, candidates []rateAnomalyCandidate, now time.Time) ([]string, error) {
+	reasons := make([]string, len(candidates))
+	arg := db.ListExchangeRateAnomalyBaselinesParams{
+		HistorySize: anomalyHistorySize,
+		PeerSince:   now.Add(-anomalyPeerWindow),
+	}
+	for _, candidate := range candidates {
+		if candidate.SourceID <= 0 || candidate.TypeID <= 0 {
+			continue
+		}
+		arg.SourceIds = append(arg.SourceIds, candidate.SourceID)
+		arg.TypeIds = append(arg.TypeIds, candidate.TypeID)
+		arg.SourceCurrencyIds = append(arg.SourceCurrencyIds, candidate.SourceCurrencyID)
+		arg.DestinationCurrencyIds = append(arg.DestinationCurrencyIds, candidate.DestinationCurrencyID)
+	}
+	if len(arg.SourceIds) == 0 {
+		return reasons, nil
+	}
+
+	rows, err := s.store.ListExchangeRateAnomalyBaselines(ctx, arg)
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to load exchange rate anomaly baselines")
+	}
+	baselines := make(map[rateAnomalySeries]db.GetExchangeRateAnomalyBaselineRow, len(rows))
+	for _, row := range rows {
+		baselines[rateAnomalySeries{row.SourceID, row.TypeID, row.SourceCurrencyID, row.DestinationCurrencyID}] = db.GetExchangeRateAnomalyBaselineRow{
+			HistoryCount:  row.HistoryCount,
+			HistoryMean:   row.HistoryMean,
+			HistoryStddev: row.HistoryStddev,
+			HistoryMedian: row.HistoryMedian,
+			PeerCount:     row.PeerCount,
+			PeerMedian:    row.PeerMedian,
+		}
+	}
+
+	for i, candidate := range candidates {
+		baseline, ok := baselines[rateAnomalySeries{candidate.SourceID, candidate.TypeID, candidate.SourceCurrencyID, candidate.DestinationCurrencyID}]
+		if !ok {
+			continue
+		}
+		if value, err := parseDecimal(candidate.RateValue); err == nil && value > 0 {
+			reasons[i] = evaluateRateAnomaly(value, baseline, s.anomaly)
+		}
+	}
+	return reasons, nil
+}
+
+// evaluateRateAnomaly applies the percent band and z-score thresholds to a rate and its baseline.
+func evaluateRateAnomaly(value float64, baseline db.GetExchangeRateAnomalyBaselineRow, thresholds anomalyThresholds) string {
+	var reasons []string
+
+	if baseline.HistoryCount > 0 {
+		median, _ := parseDecimal(baseline.HistoryMedian)
+		if deviation, ok := percentDeviation(value, median); ok && deviation > thresholds.PercentBand {
+			reasons = append(reasons, fmt.Sprintf("%.2f%% from the source's recent median %s", deviation, baseline.HistoryMedian))
+		}
+	}
+	if baseline.HistoryCount >= anomalyMinHistory {
+		mean, _ := parseDecimal(baseline.HistoryMean)
+		stddev, _ := parseDecimal(baseline.HistoryStddev)
+		if stddev > 0 {
+			if z := math.Abs(value-mean) / stddev; z > thresholds.ZScore {
+				reasons = append(reasons, fmt.Sprintf("z-score %.1f over the source's last %d rates", z, baseline.HistoryCount))
+			}
+		}
+	}
+	if baseline.PeerCount >= anomalyMinPeers {
+		median, _ := parseDecimal(baseline.PeerMedian)
+		if deviation, ok := percentDeviation(value, median); ok && deviation > thresholds.PercentBand {
+			reasons = append(reasons, fmt.Sprintf("%.2f%% from the median %s of %d other sources", deviation, baseline.PeerMedian, baseline.PeerCount))
+		}
+	}
+
+	if len(reasons) == 0 {
+		return ""
+	}
+	reason := "deviates " + strings.Join(reasons, "; ")
+	if len(reason) > maxQuarantineReasonLength {
+		reason = reason[:maxQuarantineReasonLength]
+	}
+	return reason
+}
+
+func percentDeviation(value, reference float64) (float64, bool) {
+	if reference <= 0 {
+		return 0, false
+	}
+	return math.Abs(value-reference) / reference * 100, true
+}
+
+// exchangeRateStatusFor maps a quarantine reason to the stored status and reason columns.
+func exchangeRateStatusFor(reason string) (string, sql.NullString) {
+	if reason == "" {
+		return ExchangeRateStatusActive, sql.NullString{}
+	}
+	return ExchangeRateStatusQuarantined, sql.NullString{String: reason, Valid: true}
+}
+
+/*
+ListQuarantinedExchangeRates Service is responsible for listing rates held back for review.
+- Validate pagination
+- Return quarantined rates newest first with the reason they were flagged
+*/
+func (s *FXService) ListQuarantinedExchangeRates(ctx context.Context, input ListQuarantinedExchangeRatesInput) ([]ExchangeRate, error) {
+	if input.PageID <= 0 {
+		return nil, Wrap(nil, ErrInvalidInput.Code, "page_id must be greater than 0")
+	}
+	if input.PageSize < 5 || input.PageSize > 50 {
+		return nil, Wrap(nil, ErrInvalidInput.Code, "page_size must be between 5 and 50")
+	}
+
+	rates, err := s.store.ListQuarantinedExchangeRates(ctx, db.ListQuarantinedExchangeRatesParams{
+		Limit:  input.PageSize,
+		Offset: (input.PageID - 1) * input.PageSize,
+	})
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to list quarantined exchange rates")
+	}
+
+	res := make([]ExchangeRate, len(rates))
+	for i, rate := range rates {
+		res[i] = NewExchangeRate(rate)
+	}
+	return res, nil
+}
+
+/*
+ReviewQuarantinedExchangeRate Service is responsible for resolving a quarantined rate.
+- approve makes the rate active so it is served and published like any other rate
+- reject keeps the rate for audit but never serves it
+- Return ErrNotFound when the rate does not exist or is not quarantined
+*/
+func (s *FXService) ReviewQuarantinedExchangeRate(ctx context.Context, input ReviewQuarantinedExchangeRateInput) (ExchangeRate, error) {
+	if input.RateID <= 0 {
+		return ExchangeRate{}, Wrap(nil, ErrInvalidInput.Code, "rate_id must be greater than 0")
+	}
+	var status string
+	switch strings.ToLower(strings.TrimSpace(input.Action)) {
+	case ReviewActionApprove:
+		status = ExchangeRateStatusActive
+	case ReviewActionReject:
+		status = ExchangeRateStatusRejected
+	default:
+		return ExchangeRate{}, Wrap(nil, ErrInvalidInput.Code, "action must be one of approve, reject")
+	}
+
+	rate, err := s.store.ReviewQuarantinedExchangeRate(ctx, db.ReviewQuarantinedExchangeRateParams{
+		Status: status,
+		RateID: input.RateID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ExchangeRate{}, Wrap(err, ErrNotFound.Code, "quarantined exchange rate not found")
+		}
+		return ExchangeRate{}, Wrap(err, ErrInternal.Code, "failed to review quarantined exchange rate")
+	}
+
+	s.publishRateUpdates(ctx, rate)
+	return NewExchangeRate(rate), nil
+}
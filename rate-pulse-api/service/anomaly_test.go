@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/util"
+	"github.com/stretchr/testify/require"
+)
+
+func anomalyBaselineRows(historyCount int64, mean, stddev, median string, peerCount int64, peerMedian string) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"history_count", "history_mean", "history_stddev", "history_median", "peer_count", "peer_median",
+	}).AddRow(historyCount, mean, stddev, median, peerCount, peerMedian)
+}
+
+func TestEvaluateRateAnomaly(t *testing.T) {
+	thresholds := anomalyThresholds{ZScore: 10, PercentBand: 20}
+
+	testCases := []struct {
+		name     string
+		value    float64
+		baseline db.GetExchangeRateAnomalyBaselineRow
+		flagged  bool
+	}{
+		{
+			name:     "NoBaseline",
+			value:    25000,
+			baseline: db.GetExchangeRateAnomalyBaselineRow{},
+		},
+		{
+			name:  "WithinBand",
+			value: 25100,
+			baseline: db.GetExchangeRateAnomalyBaselineRow{
+				HistoryCount: 10, HistoryMean: "25000", HistoryStddev: "50", HistoryMedian: "25000",
+			},
+		},
+		{
+			name:  "OutsideHistoryBand",
+			value: 250000,
+			baseline: db.GetExchangeRateAnomalyBaselineRow{
+				HistoryCount: 2, HistoryMean: "25000", HistoryStddev: "0", HistoryMedian: "25000",
+			},
+			flagged: true,
+		},
+		{
+			name:  "ZScore",
+			value: 26000,
+			baseline: db.GetExchangeRateAnomalyBaselineRow{
+				HistoryCount: 10, HistoryMean: "25000", HistoryStddev: "50", HistoryMedian: "25000",
+			},
+			flagged: true,
+		},
+		{
+			name:  "ZScoreNeedsEnoughHistory",
+			value: 26000,
+			baseline: db.GetExchangeRateAnomalyBaselineRow{
+				HistoryCount: anomalyMinHistory - 1, HistoryMean: "25000", HistoryStddev: "50", HistoryMedian: "25000",
+			},
+		},
+		{
+			name:  "OutsidePeerBand",
+			value: 2500,
+			baseline: db.GetExchangeRateAnomalyBaselineRow{
+				PeerCount: 3, PeerMedian: "25000",
+			},
+			flagged: true,
+		},
+		{
+			name:  "PeerBandNeedsEnoughPeers",
+			value: 2500,
+			baseline: db.GetExchangeRateAnomalyBaselineRow{
+				PeerCount: anomalyMinPeers - 1, PeerMedian: "25000",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reason := evaluateRateAnomaly(tc.value, tc.baseline, thresholds)
+			if tc.flagged {
+				require.NotEmpty(t, reason)
+				require.LessOrEqual(t, len(reason), maxQuarantineReasonLength)
+			} else {
+				require.Empty(t, reason)
+			}
+		})
+	}
+}
+
+func TestNewAnomalyThresholdsDefaults(t *testing.T) {
+	thresholds := newAnomalyThresholds(testAnomalyConfig(0, 0))
+	require.Equal(t, float64(defaultAnomalyZScore), thresholds.ZScore)
+	require.Equal(t, float64(defaultAnomalyPercentBand), thresholds.PercentBand)
+
+	thresholds = newAnomalyThresholds(testAnomalyConfig(4, 5))
+	require.Equal(t, 4.0, thresholds.ZScore)
+	require.Equal(t, 5.0, thresholds.PercentBand)
+}
+
+func TestFXServiceCreateExchangeRateQuarantined(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	dbRate := testExchangeRateForFXService()
+	dbRate.RateValue = "250000"
+	dbRate.Status = ExchangeRateStatusQuarantined
+	dbRate.QuarantineReason = sql.NullString{String: "deviates", Valid: true}
+
+	mock.ExpectQuery("WITH history AS").
+		WillReturnRows(anomalyBaselineRows(10, "25000", "50", "25000", 3, "25010"))
+	mock.ExpectQuery("INSERT INTO exchange_rates").
+		WithArgs(
+			dbRate.RateValue,
+			dbRate.SourceCurrencyID,
+			dbRate.DestinationCurrencyID,
+			dbRate.ValidFromDate,
+			dbRate.ValidToDate,
+			dbRate.SourceID,
+			dbRate.TypeID,
+			ExchangeRateStatusQuarantined,
+			sqlmock.AnyArg(),
+		).
+		WillReturnRows(exchangeRateRows(dbRate))
+
+	rate, err := fxService.CreateExchangeRate(context.Background(), CreateExchangeRateInput{
+		RateValue:             dbRate.RateValue,
+		SourceCurrencyID:      dbRate.SourceCurrencyID,
+		DestinationCurrencyID: dbRate.DestinationCurrencyID,
+		ValidFromDate:         dbRate.ValidFromDate,
+		ValidToDate:           dbRate.ValidToDate.Time,
+		SourceID:              dbRate.SourceID.Int32,
+		TypeID:                dbRate.TypeID.Int32,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, ExchangeRateStatusQuarantined, rate.Status)
+	require.NotEmpty(t, rate.QuarantineReason)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceIngestExchangeRatesQuarantined(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	expectIngestLookups(mock)
+
+	quarantined := ingestedExchangeRate()
+	quarantined.Status = ExchangeRateStatusQuarantined
+	quarantined.QuarantineReason = sql.NullString{String: "deviates", Valid: true}
+	mock.ExpectQuery("CROSS JOIN LATERAL").
+		WillReturnRows(ingestAnomalyBaselineRows(10, "2500", "5", "2500", 0, "0"))
+	expectIngestSeriesLock(mock)
+	// A quarantined rate does not supersede the open rate, so nothing is closed.
+	mock.ExpectQuery("INSERT INTO exchange_rates").
+		WillReturnRows(exchangeRateRows(quarantined))
+	mock.ExpectCommit()
+
+	result, err := fxService.IngestExchangeRates(context.Background(), IngestExchangeRatesInput{
+		Rates: []IngestExchangeRateRow{validIngestExchangeRateRow()},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, int32(1), result.Quarantined)
+	require.Zero(t, result.Inserted)
+	require.Equal(t, IngestStatusQuarantined, result.Rows[0].Status)
+	require.Equal(t, quarantined.RateID, *result.Rows[0].RateID)
+	require.NotEmpty(t, result.Rows[0].Reason)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceReviewQuarantinedExchangeRate(t *testing.T) {
+	t.Run("Approve", func(t *testing.T) {
+		fxService, mock := newTestFXService(t)
+		dbRate := testExchangeRateForFXService()
+
+		mock.ExpectQuery("UPDATE exchange_rates").
+			WithArgs(ExchangeRateStatusActive, dbRate.RateID).
+			WillReturnRows(exchangeRateRows(dbRate))
+
+		rate, err := fxService.ReviewQuarantinedExchangeRate(context.Background(), ReviewQuarantinedExchangeRateInput{
+			RateID: dbRate.RateID,
+			Action: "Approve",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, ExchangeRateStatusActive, rate.Status)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotQuarantined", func(t *testing.T) {
+		fxService, mock := newTestFXService(t)
+
+		mock.ExpectQuery("UPDATE exchange_rates").
+			WithArgs(ExchangeRateStatusRejected, int32(7)).
+			WillReturnError(sql.ErrNoRows)
+
+		rate, err := fxService.ReviewQuarantinedExchangeRate(context.Background(), ReviewQuarantinedExchangeRateInput{
+			RateID: 7,
+			Action: ReviewActionReject,
+		})
+
+		requireFXServiceErrorCode(t, err, ErrNotFound.Code)
+		require.Empty(t, rate)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("InvalidAction", func(t *testing.T) {
+		fxService, mock := newTestFXService(t)
+
+		rate, err := fxService.ReviewQuarantinedExchangeRate(context.Background(), ReviewQuarantinedExchangeRateInput{
+			RateID: 7,
+			Action: "ignore",
+		})
+
+		requireFXServiceErrorCode(t, err, ErrInvalidInput.Code)
+		require.Empty(t, rate)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func testAnomalyConfig(zScore, percentBand float64) util.Config {
+	return util.Config{RateAnomalyZScore: zScore, RateAnomalyPercentBand: percentBand}
+}
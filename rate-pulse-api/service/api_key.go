@@ -0,0 +1,261 @@
+/*
+api key service is responsible for machine-to-machine credentials.
+It provides methods for creating, listing and revoking a user's API keys,
+and for resolving a presented key to the user it acts for.
+*/
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/token"
+)
+
+const (
+	APIKeyScopeReadRates      = "read:rates"
+	APIKeyScopeReadHistorical = "read:historical"
+	APIKeyScopeAdmin          = "admin"
+
+	apiKeyPrefix       = "rp_"
+	apiKeyDisplayChars = 10
+	maxActiveAPIKeys   = 10
+	maxAPIKeyNameLen   = 100
+
+	userTypeAdmin = "admin"
+)
+
+var apiKeyScopes = []string{APIKeyScopeReadRates, APIKeyScopeReadHistorical, APIKeyScopeAdmin}
+
+type APIKeyService struct {
+	store db.Store
+}
+
+func NewAPIKeyService(store db.Store) *APIKeyService {
+	return &APIKeyService{store: store}
+}
+
+/*
+CreateAPIKey Service is responsible for issuing a new API key to a user.
+  - Validate name, scopes and that expires_at is in the future
+  - Check the scopes against the user: admin needs user_type admin, read:historical
+    needs an active subscription whose plan includes historical data
+  - Cap the number of active keys per user
+  - Store only the SHA-256 of the key and return the plaintext key once
+  - Return ErrInvalidInput, ErrForbidden, ErrNotFound or ErrInternal
+*/
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, input CreateAPIKeyInput) (CreatedAPIKey, error) {
+	if input.UserID <= 0 {
+		return CreatedAPIKey{}, Wrap(nil, ErrUnauthorized.Code, "user_id is required")
+	}
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return CreatedAPIKey{}, Wrap(nil, ErrInvalidInput.Code, "name is required")
+	}
+	if len(name) > maxAPIKeyNameLen {
+		return CreatedAPIKey{}, Wrap(nil, ErrInvalidInput.Code, "name must be at most 100 characters")
+	}
+	scopes, err := normalizeAPIKeyScopes(input.Scopes)
+	if err != nil {
+		return CreatedAPIKey{}, err
+	}
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(time.Now()) {
+		return CreatedAPIKey{}, Wrap(nil, ErrInvalidInput.Code, "expires_at must be in the future")
+	}
+
+	user, err := s.store.GetUserByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return CreatedAPIKey{}, Wrap(err, ErrNotFound.Code, "user not found")
+		}
+		return CreatedAPIKey{}, Wrap(err, ErrInternal.Code, "failed to get user")
+	}
+	if err := s.checkAPIKeyScopes(ctx, user, scopes); err != nil {
+		return CreatedAPIKey{}, err
+	}
+
+	active, err := s.store.CountActiveAPIKeysByUser(ctx, input.UserID)
+	if err != nil {
+		return CreatedAPIKey{}, Wrap(err, ErrInternal.Code, "failed to count api keys")
+	}
+	if active >= maxActiveAPIKeys {
+		return CreatedAPIKey{}, Wrap(nil, ErrInvalidInput.Code, "active api key limit reached, revoke a key first")
+	}
+
+	rawKey, err := newAPIKey()
+	if err != nil {
+		return CreatedAPIKey{}, Wrap(err, ErrInternal.Code, "failed to generate api key")
+	}
+
+	key, err := s.store.CreateAPIKey(ctx, db.CreateAPIKeyParams{
+		UserID:    input.UserID,
+		Name:      name,
+		KeyPrefix: rawKey[:apiKeyDisplayChars],
+		KeyHash:   hashAPIKey(rawKey),
+		Scopes:    scopes,
+		ExpiresAt: optionalTimestamp(input.ExpiresAt),
+	})
+	if err != nil {
+		return CreatedAPIKey{}, Wrap(err, ErrInternal.Code, "failed to create api key")
+	}
+
+	return CreatedAPIKey{APIKey: NewAPIKey(key), Key: rawKey}, nil
+}
+
+func (s *APIKeyService) ListAPIKeys(ctx context.Context, userID int32) ([]APIKey, error) {
+	if userID <= 0 {
+		return nil, Wrap(nil, ErrUnauthorized.Code, "user_id is required")
+	}
+
+	keys, err := s.store.ListAPIKeysByUser(ctx, userID)
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to list api keys")
+	}
+	return NewAPIKeys(keys), nil
+}
+
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, input RevokeAPIKeyInput) (APIKey, error) {
+	if input.UserID <= 0 {
+		return APIKey{}, Wrap(nil, ErrUnauthorized.Code, "user_id is required")
+	}
+	if input.APIKeyID <= 0 {
+		return APIKey{}, Wrap(nil, ErrInvalidInput.Code, "api_key_id must be greater than 0")
+	}
+
+	key, err := s.store.RevokeAPIKey(ctx, db.RevokeAPIKeyParams{
+		ApiKeyID: input.APIKeyID,
+		UserID:   input.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return APIKey{}, Wrap(err, ErrNotFound.Code, "api key not found or already revoked")
+		}
+		return APIKey{}, Wrap(err, ErrInternal.Code, "failed to revoke api key")
+	}
+	return NewAPIKey(key), nil
+}
+
+/*
+AuthenticateAPIKey Service is responsible for resolving an X-API-Key to its owner.
+- Look the key up by its SHA-256; revoked and expired keys are rejected
+- Reject keys of inactive users
+- Drop the admin scope when the owner is no longer an admin
+- Record last_used_at, at most once a minute
+- Return ErrUnauthorized, ErrInactiveUser or ErrInternal
+*/
+func (s *APIKeyService) AuthenticateAPIKey(ctx context.Context, rawKey string) (APIKeyPrincipal, error) {
+	if !strings.HasPrefix(rawKey, apiKeyPrefix) {
+		return APIKeyPrincipal{}, Wrap(nil, ErrUnauthorized.Code, "invalid api key")
+	}
+
+	owner, err := s.store.GetAPIKeyOwnerByHash(ctx, hashAPIKey(rawKey))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return APIKeyPrincipal{}, Wrap(err, ErrUnauthorized.Code, "invalid api key")
+		}
+		return APIKeyPrincipal{}, Wrap(err, ErrInternal.Code, "failed to get api key")
+	}
+
+	now := time.Now()
+	if owner.RevokedAt.Valid {
+		return APIKeyPrincipal{}, Wrap(nil, ErrUnauthorized.Code, "api key has been revoked")
+	}
+	if owner.ExpiresAt.Valid && !owner.ExpiresAt.Time.After(now) {
+		return APIKeyPrincipal{}, Wrap(nil, ErrUnauthorized.Code, "api key has expired")
+	}
+	if owner.IsActive.Valid && !owner.IsActive.Bool {
+		return APIKeyPrincipal{}, Wrap(nil, ErrInactiveUser.Code, "user is inactive")
+	}
+
+	scopes := owner.Scopes
+	if owner.UserType.String != userTypeAdmin {
+		scopes = slices.DeleteFunc(slices.Clone(scopes), func(scope string) bool {
+			return scope == APIKeyScopeAdmin
+		})
+	}
+
+	// last_used_at is informational, so a failed write must not reject the request.
+	_ = s.store.TouchAPIKeyLastUsed(ctx, owner.ApiKeyID)
+
+	payload := &token.Payload{
+		UserID:   owner.UserID,
+		Username: owner.Username,
+		Email:    owner.Email,
+		UserType: owner.UserType.String,
+		IssuedAt: now,
+	}
+	if owner.ExpiresAt.Valid {
+		payload.ExpiredAt = owner.ExpiresAt.Time
+	}
+
+	return APIKeyPrincipal{APIKeyID: owner.ApiKeyID, Payload: payload, Scopes: scopes}, nil
+}
+
+// checkAPIKeyScopes ties each requested scope to what the user's role and plan allow.
+func (s *APIKeyService) checkAPIKeyScopes(ctx context.Context, user db.User, scopes []string) error {
+	isAdmin := user.UserType.String == userTypeAdmin
+	if slices.Contains(scopes, APIKeyScopeAdmin) && !isAdmin {
+		return Wrap(nil, ErrForbidden.Code, "the admin scope is only available to admin users")
+	}
+	if !slices.Contains(scopes, APIKeyScopeReadHistorical) || isAdmin {
+		return nil
+	}
+
+	subscription, err := s.store.GetActiveUserSubscriptionByUserID(ctx, user.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Wrap(err, ErrForbidden.Code, "the read:historical scope needs an active subscription")
+		}
+		return Wrap(err, ErrInternal.Code, "failed to get active subscription")
+	}
+	plan, err := s.store.GetSubscriptionPlanByID(ctx, subscription.PlanID)
+	if err != nil {
+		return Wrap(err, ErrInternal.Code, "failed to get subscription plan")
+	}
+	if plan.HistoricalDays <= 0 {
+		return Wrap(nil, ErrForbidden.Code, "the read:historical scope is not included in your plan")
+	}
+	return nil
+}
+
+func normalizeAPIKeyScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, Wrap(nil, ErrInvalidInput.Code, "at least one scope is required")
+	}
+
+	normalized := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(strings.ToLower(scope))
+		if !slices.Contains(apiKeyScopes, scope) {
+			return nil, Wrap(nil, ErrInvalidInput.Code, "scopes must be read:rates, read:historical or admin")
+		}
+		if !slices.Contains(normalized, scope) {
+			normalized = append(normalized, scope)
+		}
+	}
+	return normalized, nil
+}
+
+func newAPIKey() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// hashAPIKey uses a plain SHA-256: keys carry 256 bits of entropy, so a slow hash adds
+// nothing, and a deterministic digest lets the key be looked up by its hash.
+func hashAPIKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAPIKeyService(t *testing.T) (*APIKeyService, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = sqlDB.Close()
+	})
+
+	return NewAPIKeyService(db.NewStore(sqlDB)), mock
+}
+
+// capturedArg matches any query argument and keeps it for later assertions.
+type capturedArg struct {
+	value driver.Value
+}
+
+func (arg *capturedArg) Match(value driver.Value) bool {
+	arg.value = value
+	return true
+}
+
+func apiKeyRows(keys ...db.ApiKey) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{
+		"api_key_id",
+		"user_id",
+		"name",
+		"key_prefix",
+		"key_hash",
+		"scopes",
+		"expires_at",
+		"last_used_at",
+		"revoked_at",
+		"created_at",
+	})
+
+	for _, key := range keys {
+		rows.AddRow(
+			key.ApiKeyID,
+			key.UserID,
+			key.Name,
+			key.KeyPrefix,
+			key.KeyHash,
+			"{"+strings.Join(key.Scopes, ",")+"}",
+			key.ExpiresAt,
+			key.LastUsedAt,
+			key.RevokedAt,
+			key.CreatedAt,
+		)
+	}
+
+	return rows
+}
+
+func apiKeyOwnerRows(owner db.GetAPIKeyOwnerByHashRow) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"api_key_id",
+		"user_id",
+		"scopes",
+		"expires_at",
+		"revoked_at",
+		"username",
+		"email",
+		"user_type",
+		"is_active",
+	}).AddRow(
+		owner.ApiKeyID,
+		owner.UserID,
+		"{"+strings.Join(owner.Scopes, ",")+"}",
+		owner.ExpiresAt,
+		owner.RevokedAt,
+		owner.Username,
+		owner.Email,
+		owner.UserType,
+		owner.IsActive,
+	)
+}
+
+func testAPIKeyOwner() db.GetAPIKeyOwnerByHashRow {
+	return db.GetAPIKeyOwnerByHashRow{
+		ApiKeyID: 5,
+		UserID:   42,
+		Scopes:   []string{APIKeyScopeReadRates},
+		Username: "testuser",
+		Email:    "test@example.com",
+		UserType: sql.NullString{String: "free", Valid: true},
+		IsActive: sql.NullBool{Bool: true, Valid: true},
+	}
+}
+
+func TestAPIKeyServiceCreateAPIKeyInvalidInput(t *testing.T) {
+	apiKeyService, mock := newTestAPIKeyService(t)
+	past := time.Now().Add(-time.Hour)
+
+	testCases := []struct {
+		name  string
+		input CreateAPIKeyInput
+	}{
+		{name: "missing name", input: CreateAPIKeyInput{UserID: 42, Name: " ", Scopes: []string{APIKeyScopeReadRates}}},
+		{name: "name too long", input: CreateAPIKeyInput{UserID: 42, Name: strings.Repeat("k", 101), Scopes: []string{APIKeyScopeReadRates}}},
+		{name: "missing scopes", input: CreateAPIKeyInput{UserID: 42, Name: "etl"}},
+		{name: "unknown scope", input: CreateAPIKeyInput{UserID: 42, Name: "etl", Scopes: []string{"write:rates"}}},
+		{name: "expired", input: CreateAPIKeyInput{UserID: 42, Name: "etl", Scopes: []string{APIKeyScopeReadRates}, ExpiresAt: &past}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := apiKeyService.CreateAPIKey(context.Background(), tc.input)
+
+			requireServiceErrorCode(t, err, ErrInvalidInput.Code)
+			require.Empty(t, key)
+		})
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAPIKeyServiceCreateAPIKeyAdminScopeRequiresAdmin(t *testing.T) {
+	apiKeyService, mock := newTestAPIKeyService(t)
+
+	mock.ExpectQuery("SELECT (.+) FROM users").
+		WithArgs(int32(42)).
+		WillReturnRows(userRows(testDBUserForUserService()))
+
+	key, err := apiKeyService.CreateAPIKey(context.Background(), CreateAPIKeyInput{
+		UserID: 42,
+		Name:   "ops",
+		Scopes: []string{APIKeyScopeAdmin},
+	})
+
+	requireServiceErrorCode(t, err, ErrForbidden.Code)
+	require.Empty(t, key)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAPIKeyServiceCreateAPIKeyStoresHash(t *testing.T) {
+	apiKeyService, mock := newTestAPIKeyService(t)
+	prefix, hash := &capturedArg{}, &capturedArg{}
+	created := db.ApiKey{
+		ApiKeyID:  5,
+		UserID:    42,
+		Name:      "etl",
+		KeyPrefix: "rp_abcdefg",
+		KeyHash:   "stored",
+		Scopes:    []string{APIKeyScopeReadRates},
+		CreatedAt: time.Now(),
+	}
+
+	mock.ExpectQuery("SELECT (.+) FROM users").
+		WithArgs(int32(42)).
+		WillReturnRows(userRows(testDBUserForUserService()))
+	mock.ExpectQuery("SELECT COUNT").
+		WithArgs(int32(42)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(1)))
+	mock.ExpectQuery("INSERT INTO api_keys").
+		WithArgs(int32(42), "etl", prefix, hash, "{\"read:rates\"}", sql.NullTime{}).
+		WillReturnRows(apiKeyRows(created))
+
+	key, err := apiKeyService.CreateAPIKey(context.Background(), CreateAPIKeyInput{
+		UserID: 42,
+		Name:   " etl ",
+		Scopes: []string{"read:rates", "READ:RATES"},
+	})
+
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(key.Key, apiKeyPrefix))
+	require.Equal(t, key.Key[:apiKeyDisplayChars], prefix.value)
+	require.Equal(t, hashAPIKey(key.Key), hash.value)
+	require.NotContains(t, hash.value, key.Key)
+	require.Equal(t, created.ApiKeyID, key.APIKeyID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAPIKeyServiceRevokeAPIKeyNotFound(t *testing.T) {
+	apiKeyService, mock := newTestAPIKeyService(t)
+
+	mock.ExpectQuery("UPDATE api_keys").
+		WithArgs(int32(5), int32(42)).
+		WillReturnError(sql.ErrNoRows)
+
+	key, err := apiKeyService.RevokeAPIKey(context.Background(), RevokeAPIKeyInput{UserID: 42, APIKeyID: 5})
+
+	requireServiceErrorCode(t, err, ErrNotFound.Code)
+	require.Empty(t, key)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAPIKeyServiceAuthenticateAPIKey(t *testing.T) {
+	rawKey := apiKeyPrefix + "secret"
+
+	testCases := []struct {
+		name   string
+		mutate func(owner *db.GetAPIKeyOwnerByHashRow)
+		code   string
+		scopes []string
+	}{
+		{
+			name:   "OK",
+			mutate: func(owner *db.GetAPIKeyOwnerByHashRow) {},
+			scopes: []string{APIKeyScopeReadRates},
+		},
+		{
+			name: "Revoked",
+			mutate: func(owner *db.GetAPIKeyOwnerByHashRow) {
+				owner.RevokedAt = sql.NullTime{Time: time.Now(), Valid: true}
+			},
+			code: ErrUnauthorized.Code,
+		},
+		{
+			name: "Expired",
+			mutate: func(owner *db.GetAPIKeyOwnerByHashRow) {
+				owner.ExpiresAt = sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}
+			},
+			code: ErrUnauthorized.Code,
+		},
+		{
+			name: "InactiveUser",
+			mutate: func(owner *db.GetAPIKeyOwnerByHashRow) {
+				owner.IsActive = sql.NullBool{Bool: false, Valid: true}
+			},
+			code: ErrInactiveUser.Code,
+		},
+		{
+			name: "AdminScopeDroppedAfterDemotion",
+			mutate: func(owner *db.GetAPIKeyOwnerByHashRow) {
+				owner.Scopes = []string{APIKeyScopeAdmin, APIKeyScopeReadRates}
+			},
+			scopes: []string{APIKeyScopeReadRates},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			apiKeyService, mock := newTestAPIKeyService(t)
+			owner := testAPIKeyOwner()
+			tc.mutate(&owner)
+
+			mock.ExpectQuery("FROM api_keys k").
+				WithArgs(hashAPIKey(rawKey)).
+				WillReturnRows(apiKeyOwnerRows(owner))
+			if tc.code == "" {
+				mock.ExpectExec("UPDATE api_keys").
+					WithArgs(owner.ApiKeyID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			principal, err := apiKeyService.AuthenticateAPIKey(context.Background(), rawKey)
+			if tc.code != "" {
+				requireServiceErrorCode(t, err, tc.code)
+				require.NoError(t, mock.ExpectationsWereMet())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.scopes, principal.Scopes)
+			require.Equal(t, owner.UserID, principal.Payload.UserID)
+			require.Equal(t, "free", principal.Payload.UserType)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAPIKeyServiceAuthenticateAPIKeyRejectsForeignFormat(t *testing.T) {
+	apiKeyService, mock := newTestAPIKeyService(t)
+
+	_, err := apiKeyService.AuthenticateAPIKey(context.Background(), "Bearer token")
+
+	requireServiceErrorCode(t, err, ErrUnauthorized.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,37 +1,48 @@
 /*
 auth service is responsible for handling all authentication related operations.
-It provides methods for creating users, signing in users, renewing access tokens, and signing out users.
+It provides methods for creating users, signing in users with an optional second factor,
+renewing access tokens, and signing out users.
 */
 package service
 
 import (
 	"context"
+	"crypto/rand"
 	"database/sql"
+	"encoding/base64"
 	"errors"
+	"fmt"
 	"net/mail"
 	"strings"
 	"time"
 
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
 	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/ThanhVinhTong/rate-pulse/totp"
 	"github.com/ThanhVinhTong/rate-pulse/util"
 	"github.com/ThanhVinhTong/rate-pulse/worker"
+	"github.com/google/uuid"
 	"github.com/hibiken/asynq"
 	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
 )
 
 type AuthService struct {
 	config          util.Config
 	store           db.Store
 	tokenMaker      token.Maker
+	revocations     token.RevocationList
+	totpCipher      *totp.SecretCipher
 	taskDistributor worker.TaskDistributor
 }
 
-func NewAuthService(config util.Config, store db.Store, tokenMaker token.Maker, taskDistributor worker.TaskDistributor) *AuthService {
+func NewAuthService(config util.Config, store db.Store, tokenMaker token.Maker, revocations token.RevocationList, totpCipher *totp.SecretCipher, taskDistributor worker.TaskDistributor) *AuthService {
 	return &AuthService{
 		config:          config,
 		store:           store,
 		tokenMaker:      tokenMaker,
+		revocations:     revocations,
+		totpCipher:      totpCipher,
 		taskDistributor: taskDistributor,
 	}
 }
@@ -89,17 +100,7 @@ func (s *AuthService) CreateUser(ctx context.Context, input CreateUserInput) (Us
 			FirstName:          sql.NullString{String: input.FirstName, Valid: true},
 		},
 		AfterCreate: func(user db.User) error {
-			opts := []asynq.Option{
-				asynq.MaxRetry(3),
-				asynq.Timeout(60 * time.Second),
-				asynq.Queue(worker.QueueCritical),
-			}
-
-			return s.taskDistributor.DistributeTaskSendVerifyEmail(
-				ctx,
-				&worker.PayloadSendVerifyEmail{UserId: user.UserID},
-				opts...,
-			)
+			return sendVerifyEmail(ctx, s.taskDistributor, user.UserID)
 		},
 	}
 
@@ -144,8 +145,9 @@ func (s *AuthService) SignIn(ctx context.Context, input SignInInput) (SignInResu
 		return SignInResult{}, Wrap(err, ErrInvalidCredentials.Code, "invalid email or password")
 	}
 
-	// Check if the user is email verified
-	if !user.EmailVerified.Valid || !user.EmailVerified.Bool {
+	// Check if the user is email verified; with ALLOW_UNVERIFIED_SIGN_IN they may sign in and
+	// read, and verifiedEmailMiddleware keeps them from creating anything.
+	if !s.config.AllowUnverifiedSignIn && (!user.EmailVerified.Valid || !user.EmailVerified.Bool) {
 		return SignInResult{}, Wrap(
 			errors.New("email is not verified"),
 			ErrEmailNotVerified.Code,
@@ -158,12 +160,193 @@ func (s *AuthService) SignIn(ctx context.Context, input SignInInput) (SignInResu
 		return SignInResult{}, Wrap(errors.New("user is inactive"), ErrInvalidCredentials.Code, "user is inactive")
 	}
 
+	userTOTP, enrolled, err := getUserTOTP(ctx, s.store, user.UserID)
+	if err != nil {
+		return SignInResult{}, err
+	}
+	totpEnabled := enrolled && userTOTP.ConfirmedAt.Valid
+	if totpEnabled || mfaRequired(user) {
+		return s.createMFAChallenge(ctx, user, !totpEnabled)
+	}
+
+	return s.createSession(ctx, user, input.UserAgent, input.ClientIP)
+}
+
+/*
+EnrollSignInTOTP Service is responsible for enrolling an authenticator app mid sign-in.
+- Accept only the MFA token of a sign-in that requires enrolment, i.e. an admin without TOTP
+- Return ErrForbidden for the token of a sign-in that only asks for a code
+- Return a new secret and its otpauth:// URI; CompleteSignInMFA confirms it with a first code
+*/
+func (s *AuthService) EnrollSignInTOTP(ctx context.Context, input EnrollSignInTOTPInput) (TOTPEnrollment, error) {
+	challenge, user, err := s.getMFAChallenge(ctx, input.MFAToken)
+	if err != nil {
+		return TOTPEnrollment{}, err
+	}
+	if !challenge.EnrollmentRequired {
+		return TOTPEnrollment{}, Wrap(
+			errors.New("mfa challenge does not require enrolment"),
+			ErrForbidden.Code,
+			"this sign-in does not require enrolling an authenticator app",
+		)
+	}
+	return enrollTOTP(ctx, s.store, s.totpCipher, user)
+}
+
+/*
+CompleteSignInMFA Service is responsible for the second step of a sign-in.
+- Take one attempt of the MFA token before checking the code, so after maxMFAChallengeAttempts the user signs in again
+- Accept a TOTP code or, once TOTP is confirmed, an unused recovery code
+- A code for a pending enrolment confirms it and returns the new recovery codes once
+- Consume the MFA token and create the session
+*/
+func (s *AuthService) CompleteSignInMFA(ctx context.Context, input CompleteSignInMFAInput) (SignInResult, error) {
+	if strings.TrimSpace(input.Code) == "" {
+		return SignInResult{}, Wrap(errors.New("code is required"), ErrInvalidInput.Code, "code is required")
+	}
+
+	challenge, user, err := s.reserveMFAAttempt(ctx, input.MFAToken)
+	if err != nil {
+		return SignInResult{}, err
+	}
+
+	userTOTP, enrolled, err := getUserTOTP(ctx, s.store, user.UserID)
+	if err != nil {
+		return SignInResult{}, err
+	}
+	if !enrolled {
+		return SignInResult{}, Wrap(errors.New("totp is not enrolled"), ErrInvalidInput.Code, "set up an authenticator app first")
+	}
+
+	var ok bool
+	var step int64
+	if userTOTP.ConfirmedAt.Valid {
+		ok, err = verifyMFACode(ctx, s.store, s.totpCipher, userTOTP, input.Code, true)
+	} else {
+		step, ok, err = checkTOTPCode(s.totpCipher, userTOTP, input.Code)
+	}
+	if err != nil {
+		return SignInResult{}, err
+	}
+	if !ok {
+		return SignInResult{}, Wrap(errors.New("invalid two-factor code"), ErrInvalidCredentials.Code, "invalid two-factor code")
+	}
+
+	consumed, err := s.store.ConsumeMFAChallenge(ctx, db.ConsumeMFAChallengeParams{
+		TokenHash:   challenge.TokenHash,
+		MaxAttempts: maxMFAChallengeAttempts,
+	})
+	if err != nil {
+		return SignInResult{}, Wrap(err, ErrInternal.Code, "failed to consume mfa token")
+	}
+	if consumed == 0 {
+		return SignInResult{}, Wrap(errors.New("mfa token was already used"), ErrUnauthorized.Code, "invalid or expired mfa token")
+	}
+
+	var recoveryCodes []string
+	if !userTOTP.ConfirmedAt.Valid {
+		recoveryCodes, err = confirmTOTP(ctx, s.store, user.UserID, step)
+		if err != nil {
+			return SignInResult{}, err
+		}
+	}
+
+	result, err := s.createSession(ctx, user, input.UserAgent, input.ClientIP)
+	if err != nil {
+		return SignInResult{}, err
+	}
+	result.RecoveryCodes = recoveryCodes
+	return result, nil
+}
+
+// createMFAChallenge stores the hash of a short-lived random token that stands for a correct
+// password. It is not a PASETO token, so it cannot be used as an access token.
+func (s *AuthService) createMFAChallenge(ctx context.Context, user db.User, enrollmentRequired bool) (SignInResult, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return SignInResult{}, Wrap(err, ErrInternal.Code, "failed to create mfa token")
+	}
+	mfaToken := base64.RawURLEncoding.EncodeToString(raw)
+
+	challenge, err := s.store.CreateMFAChallenge(ctx, db.CreateMFAChallengeParams{
+		TokenHash:          hashMFAToken(mfaToken),
+		UserID:             user.UserID,
+		ExpiresAt:          time.Now().Add(mfaChallengeDuration),
+		EnrollmentRequired: enrollmentRequired,
+	})
+	if err != nil {
+		return SignInResult{}, Wrap(err, ErrInternal.Code, "failed to create mfa challenge")
+	}
+
+	return SignInResult{
+		User:                  NewUser(user),
+		MFARequired:           true,
+		MFAEnrollmentRequired: enrollmentRequired,
+		MFAToken:              mfaToken,
+		MFATokenExpiresAt:     challenge.ExpiresAt,
+	}, nil
+}
+
+func (s *AuthService) getMFAChallenge(ctx context.Context, mfaToken string) (db.MfaChallenge, db.User, error) {
+	if strings.TrimSpace(mfaToken) == "" {
+		return db.MfaChallenge{}, db.User{}, Wrap(errors.New("mfa_token is required"), ErrInvalidInput.Code, "mfa_token is required")
+	}
+
+	challenge, err := s.store.GetMFAChallenge(ctx, hashMFAToken(mfaToken))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.MfaChallenge{}, db.User{}, Wrap(err, ErrUnauthorized.Code, "invalid or expired mfa token")
+		}
+		return db.MfaChallenge{}, db.User{}, Wrap(err, ErrInternal.Code, "failed to get mfa challenge")
+	}
+	if challenge.ConsumedAt.Valid || time.Now().After(challenge.ExpiresAt) || challenge.Attempts >= maxMFAChallengeAttempts {
+		return db.MfaChallenge{}, db.User{}, Wrap(errors.New("mfa challenge is used, expired or locked"), ErrUnauthorized.Code, "invalid or expired mfa token")
+	}
+	return s.getMFAChallengeUser(ctx, challenge)
+}
+
+// reserveMFAAttempt takes one attempt of an open challenge in a single UPDATE, so the attempt is
+// counted before the code is checked and concurrent requests cannot exceed the cap.
+func (s *AuthService) reserveMFAAttempt(ctx context.Context, mfaToken string) (db.MfaChallenge, db.User, error) {
+	if strings.TrimSpace(mfaToken) == "" {
+		return db.MfaChallenge{}, db.User{}, Wrap(errors.New("mfa_token is required"), ErrInvalidInput.Code, "mfa_token is required")
+	}
+
+	challenge, err := s.store.ReserveMFAChallengeAttempt(ctx, db.ReserveMFAChallengeAttemptParams{
+		TokenHash:   hashMFAToken(mfaToken),
+		MaxAttempts: maxMFAChallengeAttempts,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.MfaChallenge{}, db.User{}, Wrap(err, ErrUnauthorized.Code, "invalid or expired mfa token")
+		}
+		return db.MfaChallenge{}, db.User{}, Wrap(err, ErrInternal.Code, "failed to get mfa challenge")
+	}
+	return s.getMFAChallengeUser(ctx, challenge)
+}
+
+func (s *AuthService) getMFAChallengeUser(ctx context.Context, challenge db.MfaChallenge) (db.MfaChallenge, db.User, error) {
+	user, err := s.store.GetUserByID(ctx, challenge.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.MfaChallenge{}, db.User{}, Wrap(err, ErrUnauthorized.Code, "invalid or expired mfa token")
+		}
+		return db.MfaChallenge{}, db.User{}, Wrap(err, ErrInternal.Code, "failed to get user")
+	}
+	if user.IsActive.Valid && !user.IsActive.Bool {
+		return db.MfaChallenge{}, db.User{}, Wrap(errors.New("user is inactive"), ErrUnauthorized.Code, "user is inactive")
+	}
+	return challenge, user, nil
+}
+
+func (s *AuthService) createSession(ctx context.Context, user db.User, userAgent, clientIP string) (SignInResult, error) {
 	accessToken, accessPayload, err := s.tokenMaker.CreateToken(
 		user.UserID,
 		user.Username,
 		user.Email,
 		user.UserType.String,
 		s.config.AccessTokenDuration,
+		token.TokenTypeAccess,
 	)
 	if err != nil {
 		return SignInResult{}, Wrap(err, ErrInternal.Code, "failed to create access token")
@@ -175,17 +358,18 @@ func (s *AuthService) SignIn(ctx context.Context, input SignInInput) (SignInResu
 		user.Email,
 		user.UserType.String,
 		s.config.RefreshTokenDuration,
+		token.TokenTypeRefresh,
 	)
 	if err != nil {
 		return SignInResult{}, Wrap(err, ErrInternal.Code, "failed to create refresh token")
 	}
 
-	session, err := s.store.CreateSession(ctx, db.CreateSessionParams{
+	session, err := s.store.CreateSessionTx(ctx, db.CreateSessionParams{
 		SessionID:    refreshPayload.ID,
 		UserID:       user.UserID,
 		RefreshToken: refreshToken,
-		UserAgent:    input.UserAgent,
-		ClientIp:     input.ClientIP,
+		UserAgent:    userAgent,
+		ClientIp:     clientIP,
 		IsBlocked:    sql.NullBool{Bool: false, Valid: true},
 		ExpiresAt:    refreshPayload.ExpiredAt,
 	})
@@ -204,17 +388,28 @@ func (s *AuthService) SignIn(ctx context.Context, input SignInInput) (SignInResu
 	return res, nil
 }
 
+/*
+RenewAccessToken Service is responsible for exchanging a refresh token for new tokens.
+  - Resolve the session the refresh token belongs to
+  - A refresh token that was already rotated is being replayed, so the whole session is
+    blocked and ErrRefreshTokenReused is returned
+  - Reload the user, so a deactivated user cannot renew and new tokens carry the current
+    user_type
+  - Refuse admins without TOTP, e.g. users promoted after they signed in
+  - Rotate the refresh token: the new one expires with the session and the presented one
+    stops working
+*/
 func (s *AuthService) RenewAccessToken(ctx context.Context, input RenewAccessTokenInput) (RenewAccessTokenResult, error) {
 	if input.RefreshToken == "" {
 		return RenewAccessTokenResult{}, Wrap(errors.New("refresh token is required"), ErrInvalidInput.Code, "refresh token is required")
 	}
 
-	refreshPayload, err := s.tokenMaker.VerifyToken(input.RefreshToken)
+	refreshPayload, err := s.tokenMaker.VerifyToken(input.RefreshToken, token.TokenTypeRefresh)
 	if err != nil {
 		return RenewAccessTokenResult{}, Wrap(err, ErrUnauthorized.Code, "invalid refresh token")
 	}
 
-	session, err := s.store.GetSessionByID(ctx, refreshPayload.ID)
+	session, err := s.store.GetRefreshTokenSession(ctx, refreshPayload.ID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return RenewAccessTokenResult{}, Wrap(err, ErrSessionNotFound.Code, "session not found")
@@ -222,12 +417,16 @@ func (s *AuthService) RenewAccessToken(ctx context.Context, input RenewAccessTok
 		return RenewAccessTokenResult{}, Wrap(err, ErrInternal.Code, "failed to get session")
 	}
 
+	if session.UserID != refreshPayload.UserID {
+		return RenewAccessTokenResult{}, Wrap(errors.New("incorrect session user"), ErrUnauthorized.Code, "invalid session")
+	}
+
 	if session.IsBlocked.Valid && session.IsBlocked.Bool {
 		return RenewAccessTokenResult{}, Wrap(errors.New("session is blocked"), ErrSessionBlocked.Code, "session is blocked")
 	}
 
-	if session.UserID != refreshPayload.UserID {
-		return RenewAccessTokenResult{}, Wrap(errors.New("incorrect session user"), ErrUnauthorized.Code, "invalid session")
+	if session.RotatedAt.Valid {
+		return RenewAccessTokenResult{}, s.revokeReusedSession(ctx, session.SessionID, session.UserID)
 	}
 
 	if session.RefreshToken != input.RefreshToken {
@@ -238,23 +437,86 @@ func (s *AuthService) RenewAccessToken(ctx context.Context, input RenewAccessTok
 		return RenewAccessTokenResult{}, Wrap(errors.New("session expired"), ErrSessionExpired.Code, "session expired")
 	}
 
+	user, err := s.store.GetUserByID(ctx, session.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return RenewAccessTokenResult{}, Wrap(err, ErrUnauthorized.Code, "invalid session")
+		}
+		return RenewAccessTokenResult{}, Wrap(err, ErrInternal.Code, "failed to get user")
+	}
+	if user.IsActive.Valid && !user.IsActive.Bool {
+		return RenewAccessTokenResult{}, Wrap(errors.New("user is inactive"), ErrUnauthorized.Code, "user is inactive")
+	}
+	if mfaRequired(user) {
+		userTOTP, enrolled, err := getUserTOTP(ctx, s.store, user.UserID)
+		if err != nil {
+			return RenewAccessTokenResult{}, err
+		}
+		if !enrolled || !userTOTP.ConfirmedAt.Valid {
+			return RenewAccessTokenResult{}, Wrap(errors.New("mfa is not enabled"), ErrUnauthorized.Code, "two-factor authentication is required, please sign in again")
+		}
+	}
+
 	accessToken, accessPayload, err := s.tokenMaker.CreateToken(
-		refreshPayload.UserID,
-		refreshPayload.Username,
-		refreshPayload.Email,
-		refreshPayload.UserType,
+		user.UserID,
+		user.Username,
+		user.Email,
+		user.UserType.String,
 		s.config.AccessTokenDuration,
+		token.TokenTypeAccess,
 	)
 	if err != nil {
 		return RenewAccessTokenResult{}, Wrap(err, ErrInternal.Code, "failed to create access token")
 	}
 
+	refreshToken, newRefreshPayload, err := s.tokenMaker.CreateToken(
+		user.UserID,
+		user.Username,
+		user.Email,
+		user.UserType.String,
+		time.Until(session.ExpiresAt),
+		token.TokenTypeRefresh,
+	)
+	if err != nil {
+		return RenewAccessTokenResult{}, Wrap(err, ErrInternal.Code, "failed to create refresh token")
+	}
+
+	_, err = s.store.RotateSessionTx(ctx, db.RotateSessionTxParams{
+		TokenID:      refreshPayload.ID,
+		SessionID:    session.SessionID,
+		NewTokenID:   newRefreshPayload.ID,
+		RefreshToken: refreshToken,
+		UserAgent:    input.UserAgent,
+		ClientIp:     input.ClientIP,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Another renew rotated the same token first.
+			return RenewAccessTokenResult{}, s.revokeReusedSession(ctx, session.SessionID, session.UserID)
+		}
+		return RenewAccessTokenResult{}, Wrap(err, ErrInternal.Code, "failed to rotate refresh token")
+	}
+
 	return RenewAccessTokenResult{
-		AccessToken:          accessToken,
-		AccessTokenExpiresAt: accessPayload.ExpiredAt,
+		SessionID:             session.SessionID,
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessPayload.ExpiredAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: newRefreshPayload.ExpiredAt,
 	}, nil
 }
 
+// revokeReusedSession blocks a session whose rotated refresh token was presented again: either
+// the token leaked or its owner is racing an attacker, and neither should keep the session or
+// the access tokens already issued from it.
+func (s *AuthService) revokeReusedSession(ctx context.Context, sessionID uuid.UUID, userID int32) error {
+	if _, err := s.store.BlockSession(ctx, db.BlockSessionParams{SessionID: sessionID, UserID: userID}); err != nil {
+		return Wrap(err, ErrInternal.Code, "failed to revoke session")
+	}
+	revokeAccessTokens(ctx, s.revocations, userID)
+	return Wrap(errors.New("rotated refresh token was presented again"), ErrRefreshTokenReused.Code, "refresh token was already used, please sign in again")
+}
+
 func (s *AuthService) VerifyEmail(ctx context.Context, input VerifyEmailInput) (VerifyEmailResult, error) {
 	if input.EmailID <= 0 {
 		return VerifyEmailResult{}, Wrap(errors.New("email_id is required"), ErrInvalidInput.Code, "email_id is required")
@@ -296,10 +558,205 @@ func (s *AuthService) VerifyEmail(ctx context.Context, input VerifyEmailInput) (
 	}, nil
 }
 
-func (s *AuthService) SignOut(ctx context.Context, refreshToken string) error {
-	// TODO: Implement real signout after adding a query to block or revoke sessions.
-	if refreshToken == "" {
+// maxVerifyEmailsPerHour caps the verification emails a user is sent, on top of the per-IP
+// route limit, so a resend loop cannot flood their inbox.
+const maxVerifyEmailsPerHour = 3
+
+/*
+ResendVerifyEmail Service is responsible for sending a new verification link.
+- Succeed whether or not an unverified account uses the email, so the route cannot be used to find accounts
+- Skip verified and inactive users
+- Enqueue the same task as sign-up, which drops links past maxVerifyEmailsPerHour
+*/
+func (s *AuthService) ResendVerifyEmail(ctx context.Context, input ResendVerifyEmailInput) error {
+	if strings.TrimSpace(input.Email) == "" {
+		return Wrap(errors.New("email is required"), ErrInvalidInput.Code, "email is required")
+	}
+	if len(input.Email) > 254 {
+		return Wrap(errors.New("email is too long"), ErrInvalidInput.Code, "email is too long")
+	}
+
+	user, err := s.store.GetUserByEmail(ctx, util.NormalizeEmail(input.Email))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return Wrap(err, ErrInternal.Code, "failed to get user by email")
+	}
+	if user.EmailVerified.Bool || (user.IsActive.Valid && !user.IsActive.Bool) {
+		return nil
+	}
+
+	if err := sendVerifyEmail(ctx, s.taskDistributor, user.UserID); err != nil {
+		return Wrap(err, ErrInternal.Code, "failed to send verification email")
+	}
+	return nil
+}
+
+// sendVerifyEmail enqueues the task that emails a verification link to the user's current address.
+// Each task claims one of maxVerifyEmailsPerHour task IDs for the user, and asynq keeps the ID for an
+// hour after the task finishes, so the cap holds at enqueue time however many requests race. The link
+// is silently dropped when every ID is taken.
+func sendVerifyEmail(ctx context.Context, taskDistributor worker.TaskDistributor, userID int32) error {
+	for slot := 0; slot < maxVerifyEmailsPerHour; slot++ {
+		opts := []asynq.Option{
+			asynq.MaxRetry(3),
+			asynq.Timeout(60 * time.Second),
+			asynq.Queue(worker.QueueCritical),
+			asynq.TaskID(fmt.Sprintf("%s:%d:%d", worker.TaskSendVerifyEmail, userID, slot)),
+			asynq.Retention(time.Hour),
+		}
+		err := taskDistributor.DistributeTaskSendVerifyEmail(
+			ctx,
+			&worker.PayloadSendVerifyEmail{UserId: userID},
+			opts...,
+		)
+		if !errors.Is(err, asynq.ErrTaskIDConflict) {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+RequestPasswordReset Service is responsible for emailing a one-time password reset link.
+- Succeed whether or not an account uses the email, so the route cannot be used to find accounts
+- Skip inactive users
+- Enqueue the email task; the worker stores the hashed secret
+*/
+func (s *AuthService) RequestPasswordReset(ctx context.Context, input RequestPasswordResetInput) error {
+	if strings.TrimSpace(input.Email) == "" {
+		return Wrap(errors.New("email is required"), ErrInvalidInput.Code, "email is required")
+	}
+	if len(input.Email) > 254 {
+		return Wrap(errors.New("email is too long"), ErrInvalidInput.Code, "email is too long")
+	}
+
+	user, err := s.store.GetUserByEmail(ctx, util.NormalizeEmail(input.Email))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return Wrap(err, ErrInternal.Code, "failed to get user by email")
+	}
+	if user.IsActive.Valid && !user.IsActive.Bool {
+		return nil
+	}
+
+	opts := []asynq.Option{
+		asynq.MaxRetry(3),
+		asynq.Timeout(60 * time.Second),
+		asynq.Queue(worker.QueueCritical),
+	}
+	err = s.taskDistributor.DistributeTaskSendPasswordResetEmail(
+		ctx,
+		&worker.PayloadSendPasswordResetEmail{UserId: user.UserID},
+		opts...,
+	)
+	if err != nil {
+		return Wrap(err, ErrInternal.Code, "failed to send password reset email")
+	}
+	return nil
+}
+
+/*
+ConfirmPasswordReset Service is responsible for setting a new password from a reset link.
+- Check the link is unused, unexpired and its secret matches
+- Validate the new password with the same policy as sign-up
+- Consume the link, store the password and block every session in one transaction
+- Revoke the user's access tokens
+*/
+func (s *AuthService) ConfirmPasswordReset(ctx context.Context, input ConfirmPasswordResetInput) error {
+	if input.ResetID <= 0 {
+		return Wrap(errors.New("reset_id is required"), ErrInvalidInput.Code, "reset_id is required")
+	}
+	if strings.TrimSpace(input.SecretCode) == "" {
+		return Wrap(errors.New("secret_code is required"), ErrInvalidInput.Code, "secret_code is required")
+	}
+	if input.NewPassword == "" {
+		return Wrap(errors.New("new_password is required"), ErrInvalidInput.Code, "new_password is required")
+	}
+
+	passwordReset, err := s.store.GetPasswordReset(ctx, input.ResetID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Wrap(err, ErrInvalidInput.Code, "invalid or expired reset link")
+		}
+		return Wrap(err, ErrInternal.Code, "failed to get password reset")
+	}
+
+	if passwordReset.IsUsed || time.Now().After(passwordReset.ExpiredAt) {
+		return Wrap(errors.New("reset link is expired or already used"), ErrInvalidInput.Code, "invalid or expired reset link")
+	}
+
+	if err := util.CheckPassword(input.SecretCode, passwordReset.SecretCodeHash); err != nil {
+		return Wrap(err, ErrInvalidInput.Code, "invalid or expired reset link")
+	}
+
+	if err := util.ValidatePassword(input.NewPassword); err != nil {
+		return Wrap(err, ErrInvalidInput.Code, "weak password")
+	}
+
+	hashedPassword, err := util.HashPassword(input.NewPassword)
+	if err != nil {
+		return Wrap(err, ErrInternal.Code, "failed to hash password")
+	}
+
+	result, err := s.store.ResetPasswordTx(ctx, db.ResetPasswordTxParams{
+		ResetID:        passwordReset.ID,
+		UserID:         passwordReset.UserID,
+		SecretCodeHash: passwordReset.SecretCodeHash,
+		HashedPassword: hashedPassword,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Wrap(err, ErrInvalidInput.Code, "invalid or expired reset link")
+		}
+		return Wrap(err, ErrInternal.Code, "failed to reset password")
+	}
+
+	revokeAccessTokens(ctx, s.revocations, result.User.UserID)
+	return nil
+}
+
+/*
+SignOut Service is responsible for signing the session of a refresh token out.
+- Block the session; signing out of a session that is already blocked succeeds
+- Revoke the access token sent along, when it belongs to the same user
+- Return ErrInvalidInput, ErrUnauthorized, ErrSessionNotFound or ErrInternal
+*/
+func (s *AuthService) SignOut(ctx context.Context, input SignOutInput) error {
+	if input.RefreshToken == "" {
 		return Wrap(errors.New("refresh token is required"), ErrInvalidInput.Code, "refresh token is required")
 	}
+
+	refreshPayload, err := s.tokenMaker.VerifyToken(input.RefreshToken, token.TokenTypeRefresh)
+	if err != nil {
+		return Wrap(err, ErrUnauthorized.Code, "invalid refresh token")
+	}
+
+	session, err := s.store.GetRefreshTokenSession(ctx, refreshPayload.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Wrap(err, ErrSessionNotFound.Code, "session not found")
+		}
+		return Wrap(err, ErrInternal.Code, "failed to get session")
+	}
+	if session.UserID != refreshPayload.UserID {
+		return Wrap(errors.New("incorrect session user"), ErrUnauthorized.Code, "invalid session")
+	}
+
+	if _, err := s.store.BlockSession(ctx, db.BlockSessionParams{SessionID: session.SessionID, UserID: session.UserID}); err != nil {
+		return Wrap(err, ErrInternal.Code, "failed to sign out")
+	}
+
+	if input.AccessToken != "" && s.revocations != nil {
+		accessPayload, err := s.tokenMaker.VerifyToken(input.AccessToken, token.TokenTypeAccess)
+		if err == nil && accessPayload.UserID == session.UserID {
+			if err := s.revocations.RevokeToken(ctx, accessPayload); err != nil {
+				log.Error().Err(err).Int32("user_id", session.UserID).Msg("cannot revoke access token")
+			}
+		}
+	}
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
 	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/ThanhVinhTong/rate-pulse/totp"
 	"github.com/ThanhVinhTong/rate-pulse/util"
 	"github.com/ThanhVinhTong/rate-pulse/worker"
 	"github.com/google/uuid"
@@ -18,9 +19,11 @@ import (
 )
 
 type fakeTaskDistributor struct {
-	called  bool
-	payload *worker.PayloadSendVerifyEmail
-	err     error
+	called       bool
+	payload      *worker.PayloadSendVerifyEmail
+	resetPayload *worker.PayloadSendPasswordResetEmail
+	err          error
+	taskIDs      map[string]bool
 }
 
 func (f *fakeTaskDistributor) DistributeTaskSendVerifyEmail(
@@ -28,11 +31,33 @@ func (f *fakeTaskDistributor) DistributeTaskSendVerifyEmail(
 	payload *worker.PayloadSendVerifyEmail,
 	opts ...asynq.Option,
 ) error {
+	for _, opt := range opts {
+		if opt.Type() != asynq.TaskIDOpt {
+			continue
+		}
+		if f.taskIDs[opt.Value().(string)] {
+			return asynq.ErrTaskIDConflict
+		}
+		if f.taskIDs == nil {
+			f.taskIDs = map[string]bool{}
+		}
+		f.taskIDs[opt.Value().(string)] = true
+	}
 	f.called = true
 	f.payload = payload
 	return f.err
 }
 
+func (f *fakeTaskDistributor) DistributeTaskSendPasswordResetEmail(
+	ctx context.Context,
+	payload *worker.PayloadSendPasswordResetEmail,
+	opts ...asynq.Option,
+) error {
+	f.called = true
+	f.resetPayload = payload
+	return f.err
+}
+
 func newTestAuthService(t *testing.T) (*AuthService, sqlmock.Sqlmock, token.Maker, *fakeTaskDistributor) {
 	t.Helper()
 
@@ -55,7 +80,17 @@ func newTestAuthService(t *testing.T) (*AuthService, sqlmock.Sqlmock, token.Make
 	store := db.NewStore(sqlDB)
 	taskDistributor := &fakeTaskDistributor{}
 
-	return NewAuthService(config, store, tokenMaker, taskDistributor), mock, tokenMaker, taskDistributor
+	revocations := token.NewMemoryRevocationList(config.AccessTokenDuration)
+
+	return NewAuthService(config, store, tokenMaker, revocations, newTestTOTPCipher(t), taskDistributor), mock, tokenMaker, taskDistributor
+}
+
+func newTestTOTPCipher(t *testing.T) *totp.SecretCipher {
+	t.Helper()
+
+	totpCipher, err := totp.NewSecretCipher("0123456789abcdef0123456789abcdef")
+	require.NoError(t, err)
+	return totpCipher
 }
 
 func requireServiceErrorCode(t *testing.T, err error, expectedCode string) {
@@ -246,6 +281,63 @@ func TestAuthServiceSignInUserNotFound(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func sessionRows(sessions ...db.Session) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{
+		"session_id",
+		"user_id",
+		"refresh_token",
+		"user_agent",
+		"client_ip",
+		"is_blocked",
+		"expires_at",
+		"created_at",
+		"updated_at",
+		"last_used_at",
+	})
+
+	for _, session := range sessions {
+		rows.AddRow(
+			session.SessionID,
+			session.UserID,
+			session.RefreshToken,
+			session.UserAgent,
+			session.ClientIp,
+			session.IsBlocked,
+			session.ExpiresAt,
+			session.CreatedAt,
+			session.UpdatedAt,
+			session.LastUsedAt,
+		)
+	}
+
+	return rows
+}
+
+func refreshTokenRows(refreshToken db.RefreshToken) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"token_id", "session_id", "expires_at", "rotated_at", "created_at"}).
+		AddRow(refreshToken.TokenID, refreshToken.SessionID, refreshToken.ExpiresAt, refreshToken.RotatedAt, refreshToken.CreatedAt)
+}
+
+func refreshTokenSessionRows(row db.GetRefreshTokenSessionRow) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"token_id",
+		"rotated_at",
+		"session_id",
+		"user_id",
+		"refresh_token",
+		"is_blocked",
+		"expires_at",
+	}).AddRow(
+		row.TokenID,
+		row.RotatedAt,
+		row.SessionID,
+		row.UserID,
+		row.RefreshToken,
+		row.IsBlocked,
+		row.ExpiresAt,
+	)
+}
+
 func TestAuthServiceRenewAccessTokenSessionNotFound(t *testing.T) {
 	authService, mock, tokenMaker, _ := newTestAuthService(t)
 
@@ -255,10 +347,11 @@ func TestAuthServiceRenewAccessTokenSessionNotFound(t *testing.T) {
 		"test@example.com",
 		"free",
 		time.Hour,
+		token.TokenTypeRefresh,
 	)
 	require.NoError(t, err)
 
-	mock.ExpectQuery("SELECT session_id, user_id, refresh_token").
+	mock.ExpectQuery("FROM refresh_tokens rt").
 		WithArgs(refreshPayload.ID).
 		WillReturnError(sql.ErrNoRows)
 
@@ -271,8 +364,10 @@ func TestAuthServiceRenewAccessTokenSessionNotFound(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestAuthServiceRenewAccessTokenSuccess(t *testing.T) {
+func TestAuthServiceRenewAccessTokenRotatesRefreshToken(t *testing.T) {
 	authService, mock, tokenMaker, _ := newTestAuthService(t)
+	sessionID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
 
 	refreshToken, refreshPayload, err := tokenMaker.CreateToken(
 		42,
@@ -280,40 +375,175 @@ func TestAuthServiceRenewAccessTokenSuccess(t *testing.T) {
 		"test@example.com",
 		"free",
 		time.Hour,
+		token.TokenTypeRefresh,
 	)
 	require.NoError(t, err)
+	newRefreshToken, newTokenID := &capturedArg{}, &capturedArg{}
 
-	mock.ExpectQuery("SELECT session_id, user_id, refresh_token").
+	mock.ExpectQuery("FROM refresh_tokens rt").
 		WithArgs(refreshPayload.ID).
-		WillReturnRows(sqlmock.NewRows([]string{
-			"session_id",
-			"user_id",
-			"refresh_token",
-			"user_agent",
-			"client_ip",
-			"is_blocked",
-			"expires_at",
-			"created_at",
-			"updated_at",
-		}).AddRow(
-			refreshPayload.ID,
-			refreshPayload.UserID,
-			refreshToken,
-			"test-agent",
-			"127.0.0.1",
-			sql.NullBool{Bool: false, Valid: true},
-			refreshPayload.ExpiredAt,
-			sql.NullTime{Time: time.Now(), Valid: true},
-			sql.NullTime{Time: time.Now(), Valid: true},
-		))
+		WillReturnRows(refreshTokenSessionRows(db.GetRefreshTokenSessionRow{
+			TokenID:      refreshPayload.ID,
+			SessionID:    sessionID,
+			UserID:       42,
+			RefreshToken: refreshToken,
+			IsBlocked:    sql.NullBool{Bool: false, Valid: true},
+			ExpiresAt:    expiresAt,
+		}))
+	user := testDBUserForUserService()
+	user.UserType = sql.NullString{String: "premium", Valid: true}
+	mock.ExpectQuery("FROM users").
+		WithArgs(int32(42)).
+		WillReturnRows(userRows(user))
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE refresh_tokens").
+		WithArgs(refreshPayload.ID).
+		WillReturnRows(refreshTokenRows(db.RefreshToken{
+			TokenID:   refreshPayload.ID,
+			SessionID: sessionID,
+			ExpiresAt: expiresAt,
+			RotatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		}))
+	mock.ExpectQuery("UPDATE sessions").
+		WithArgs(sessionID, newRefreshToken, "test-agent", "127.0.0.1").
+		WillReturnRows(sessionRows(db.Session{SessionID: sessionID, UserID: 42, ExpiresAt: expiresAt}))
+	mock.ExpectQuery("INSERT INTO refresh_tokens").
+		WithArgs(newTokenID, sessionID, expiresAt).
+		WillReturnRows(refreshTokenRows(db.RefreshToken{SessionID: sessionID, ExpiresAt: expiresAt}))
+	mock.ExpectCommit()
 
 	result, err := authService.RenewAccessToken(context.Background(), RenewAccessTokenInput{
 		RefreshToken: refreshToken,
+		UserAgent:    "test-agent",
+		ClientIP:     "127.0.0.1",
 	})
 
 	require.NoError(t, err)
 	require.NotEmpty(t, result.AccessToken)
 	require.True(t, result.AccessTokenExpiresAt.After(time.Now()))
+	require.NotEqual(t, refreshToken, result.RefreshToken)
+	require.Equal(t, result.RefreshToken, newRefreshToken.value)
+	require.NotEqual(t, refreshPayload.ID, newTokenID.value)
+	require.Equal(t, sessionID, result.SessionID)
+	require.WithinDuration(t, expiresAt, result.RefreshTokenExpiresAt, time.Second)
+
+	accessPayload, err := tokenMaker.VerifyToken(result.AccessToken, token.TokenTypeAccess)
+	require.NoError(t, err)
+	require.Equal(t, "premium", accessPayload.UserType)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthServiceRenewAccessTokenRejectsInactiveUser(t *testing.T) {
+	authService, mock, tokenMaker, _ := newTestAuthService(t)
+
+	refreshToken, refreshPayload, err := tokenMaker.CreateToken(42, "testuser", "test@example.com", "free", time.Hour, token.TokenTypeRefresh)
+	require.NoError(t, err)
+
+	mock.ExpectQuery("FROM refresh_tokens rt").
+		WithArgs(refreshPayload.ID).
+		WillReturnRows(refreshTokenSessionRows(db.GetRefreshTokenSessionRow{
+			TokenID:      refreshPayload.ID,
+			SessionID:    uuid.New(),
+			UserID:       42,
+			RefreshToken: refreshToken,
+			IsBlocked:    sql.NullBool{Bool: false, Valid: true},
+			ExpiresAt:    time.Now().Add(time.Hour),
+		}))
+	user := testDBUserForUserService()
+	user.IsActive = sql.NullBool{Bool: false, Valid: true}
+	mock.ExpectQuery("FROM users").
+		WithArgs(int32(42)).
+		WillReturnRows(userRows(user))
+
+	result, err := authService.RenewAccessToken(context.Background(), RenewAccessTokenInput{RefreshToken: refreshToken})
+
+	requireServiceErrorCode(t, err, ErrUnauthorized.Code)
+	require.Empty(t, result)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthServiceRenewAccessTokenReuseRevokesSession(t *testing.T) {
+	authService, mock, tokenMaker, _ := newTestAuthService(t)
+	sessionID := uuid.New()
+
+	refreshToken, refreshPayload, err := tokenMaker.CreateToken(
+		42,
+		"testuser",
+		"test@example.com",
+		"free",
+		time.Hour,
+		token.TokenTypeRefresh,
+	)
+	require.NoError(t, err)
+
+	mock.ExpectQuery("FROM refresh_tokens rt").
+		WithArgs(refreshPayload.ID).
+		WillReturnRows(refreshTokenSessionRows(db.GetRefreshTokenSessionRow{
+			TokenID:      refreshPayload.ID,
+			RotatedAt:    sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true},
+			SessionID:    sessionID,
+			UserID:       42,
+			RefreshToken: "the-rotated-successor",
+			IsBlocked:    sql.NullBool{Bool: false, Valid: true},
+			ExpiresAt:    time.Now().Add(time.Hour),
+		}))
+	mock.ExpectExec("UPDATE sessions").
+		WithArgs(sessionID, int32(42)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	result, err := authService.RenewAccessToken(context.Background(), RenewAccessTokenInput{
+		RefreshToken: refreshToken,
+	})
+
+	requireServiceErrorCode(t, err, ErrRefreshTokenReused.Code)
+	require.Empty(t, result)
+
+	// Access tokens issued from the session before the reuse stop working too.
+	revoked, err := authService.revocations.IsRevoked(context.Background(), refreshPayload)
+	require.NoError(t, err)
+	require.True(t, revoked)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthServiceSignOutBlocksSession(t *testing.T) {
+	authService, mock, tokenMaker, _ := newTestAuthService(t)
+	sessionID := uuid.New()
+
+	refreshToken, refreshPayload, err := tokenMaker.CreateToken(42, "testuser", "test@example.com", "free", time.Hour, token.TokenTypeRefresh)
+	require.NoError(t, err)
+
+	mock.ExpectQuery("FROM refresh_tokens rt").
+		WithArgs(refreshPayload.ID).
+		WillReturnRows(refreshTokenSessionRows(db.GetRefreshTokenSessionRow{
+			TokenID:      refreshPayload.ID,
+			SessionID:    sessionID,
+			UserID:       42,
+			RefreshToken: refreshToken,
+			ExpiresAt:    time.Now().Add(time.Hour),
+		}))
+	mock.ExpectExec("UPDATE sessions").
+		WithArgs(sessionID, int32(42)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	accessToken, accessPayload, err := tokenMaker.CreateToken(42, "testuser", "test@example.com", "free", time.Minute, token.TokenTypeAccess)
+	require.NoError(t, err)
+	otherAccessToken, otherAccessPayload, err := tokenMaker.CreateToken(42, "testuser", "test@example.com", "free", time.Minute, token.TokenTypeAccess)
+	require.NoError(t, err)
+	require.NotEmpty(t, otherAccessToken)
+
+	require.NoError(t, authService.SignOut(context.Background(), SignOutInput{
+		RefreshToken: refreshToken,
+		AccessToken:  accessToken,
+	}))
+
+	revoked, err := authService.revocations.IsRevoked(context.Background(), accessPayload)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// Only the signed-out device loses its access token.
+	revoked, err = authService.revocations.IsRevoked(context.Background(), otherAccessPayload)
+	require.NoError(t, err)
+	require.False(t, revoked)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -387,7 +617,7 @@ func TestAuthServiceVerifyEmailSuccess(t *testing.T) {
 		WithArgs(emailID, userID, secretCodeHash).
 		WillReturnRows(newVerifyEmailRows(emailID, userID, secretCodeHash, true, time.Now().Add(time.Minute)))
 	mock.ExpectQuery("UPDATE users").
-		WithArgs(userID).
+		WithArgs(userID, "test@example.com").
 		WillReturnRows(newCreateUserRowsWithEmailVerified(userID, time.Now(), true))
 	mock.ExpectCommit()
 
@@ -402,6 +632,38 @@ func TestAuthServiceVerifyEmailSuccess(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+// A link sent before the user changed their email matches no user row and does not verify the new address.
+func TestAuthServiceVerifyEmailRejectsChangedEmail(t *testing.T) {
+	authService, mock, _, _ := newTestAuthService(t)
+
+	const secretCode = "plain-secret-code"
+	const emailID = int64(42)
+	const userID = int32(7)
+	secretCodeHash, err := util.HashPassword(secretCode)
+	require.NoError(t, err)
+
+	mock.ExpectQuery("SELECT (.+) FROM verify_emails").
+		WithArgs(emailID).
+		WillReturnRows(newVerifyEmailRows(emailID, userID, secretCodeHash, false, time.Now().Add(time.Minute)))
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE verify_emails").
+		WithArgs(emailID, userID, secretCodeHash).
+		WillReturnRows(newVerifyEmailRows(emailID, userID, secretCodeHash, true, time.Now().Add(time.Minute)))
+	mock.ExpectQuery("UPDATE users").
+		WithArgs(userID, "test@example.com").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	result, err := authService.VerifyEmail(context.Background(), VerifyEmailInput{
+		EmailID:    emailID,
+		SecretCode: secretCode,
+	})
+
+	requireServiceErrorCode(t, err, ErrInvalidInput.Code)
+	require.Empty(t, result)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestAuthServiceVerifyEmailRejectsWrongSecret(t *testing.T) {
 	authService, mock, _, _ := newTestAuthService(t)
 
@@ -424,6 +686,137 @@ func TestAuthServiceVerifyEmailRejectsWrongSecret(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestAuthServiceRequestPasswordResetUnknownEmail(t *testing.T) {
+	authService, mock, _, taskDistributor := newTestAuthService(t)
+
+	mock.ExpectQuery("SELECT user_id, username, email, password").
+		WithArgs("missing@example.com").
+		WillReturnError(sql.ErrNoRows)
+
+	err := authService.RequestPasswordReset(context.Background(), RequestPasswordResetInput{
+		Email: "missing@example.com",
+	})
+
+	require.NoError(t, err)
+	require.False(t, taskDistributor.called)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthServiceRequestPasswordResetSendsEmail(t *testing.T) {
+	authService, mock, _, taskDistributor := newTestAuthService(t)
+
+	mock.ExpectQuery("SELECT user_id, username, email, password").
+		WithArgs("test@example.com").
+		WillReturnRows(userRows(testDBUserForUserService()))
+
+	err := authService.RequestPasswordReset(context.Background(), RequestPasswordResetInput{
+		Email: " Test@Example.com ",
+	})
+
+	require.NoError(t, err)
+	require.True(t, taskDistributor.called)
+	require.Equal(t, int32(42), taskDistributor.resetPayload.UserId)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthServiceConfirmPasswordResetSuccess(t *testing.T) {
+	authService, mock, _, _ := newTestAuthService(t)
+
+	const secretCode = "plain-secret-code"
+	const resetID = int64(9)
+	secretCodeHash, err := util.HashPassword(secretCode)
+	require.NoError(t, err)
+
+	mock.ExpectQuery("SELECT (.+) FROM password_resets").
+		WithArgs(resetID).
+		WillReturnRows(newVerifyEmailRows(resetID, 42, secretCodeHash, false, time.Now().Add(time.Minute)))
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE password_resets").
+		WithArgs(resetID, int32(42), secretCodeHash).
+		WillReturnRows(newVerifyEmailRows(resetID, 42, secretCodeHash, true, time.Now().Add(time.Minute)))
+	mock.ExpectQuery("UPDATE users").
+		WithArgs(int32(42), sqlmock.AnyArg()).
+		WillReturnRows(userRows(testDBUserForUserService()))
+	mock.ExpectExec("UPDATE password_resets").
+		WithArgs(int32(42)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE sessions").
+		WithArgs(int32(42)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err = authService.ConfirmPasswordReset(context.Background(), ConfirmPasswordResetInput{
+		ResetID:     resetID,
+		SecretCode:  secretCode,
+		NewPassword: "StrongPass123!xyz",
+	})
+
+	require.NoError(t, err)
+
+	payload, err := token.NewPayload(42, "testuser", "test@example.com", "free", time.Minute, token.TokenTypeAccess)
+	require.NoError(t, err)
+	payload.IssuedAt = payload.IssuedAt.Add(-time.Second)
+	revoked, err := authService.revocations.IsRevoked(context.Background(), payload)
+	require.NoError(t, err)
+	require.True(t, revoked)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthServiceConfirmPasswordResetRejectsInvalidLinks(t *testing.T) {
+	secretCodeHash, err := util.HashPassword("expected-secret")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		secretCode string
+		isUsed     bool
+		expiresAt  time.Time
+	}{
+		{name: "WrongSecret", secretCode: "wrong-secret", expiresAt: time.Now().Add(time.Minute)},
+		{name: "AlreadyUsed", secretCode: "expected-secret", isUsed: true, expiresAt: time.Now().Add(time.Minute)},
+		{name: "Expired", secretCode: "expected-secret", expiresAt: time.Now().Add(-time.Minute)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authService, mock, _, _ := newTestAuthService(t)
+
+			mock.ExpectQuery("SELECT (.+) FROM password_resets").
+				WithArgs(int64(9)).
+				WillReturnRows(newVerifyEmailRows(9, 42, secretCodeHash, tc.isUsed, tc.expiresAt))
+
+			err := authService.ConfirmPasswordReset(context.Background(), ConfirmPasswordResetInput{
+				ResetID:     9,
+				SecretCode:  tc.secretCode,
+				NewPassword: "StrongPass123!xyz",
+			})
+
+			requireServiceErrorCode(t, err, ErrInvalidInput.Code)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAuthServiceConfirmPasswordResetRejectsWeakPassword(t *testing.T) {
+	authService, mock, _, _ := newTestAuthService(t)
+
+	secretCodeHash, err := util.HashPassword("expected-secret")
+	require.NoError(t, err)
+
+	mock.ExpectQuery("SELECT (.+) FROM password_resets").
+		WithArgs(int64(9)).
+		WillReturnRows(newVerifyEmailRows(9, 42, secretCodeHash, false, time.Now().Add(time.Minute)))
+
+	err = authService.ConfirmPasswordReset(context.Background(), ConfirmPasswordResetInput{
+		ResetID:     9,
+		SecretCode:  "expected-secret",
+		NewPassword: "short",
+	})
+
+	requireServiceErrorCode(t, err, ErrInvalidInput.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestAuthServiceSignInSuccess(t *testing.T) {
 	authService, mock, _, _ := newTestAuthService(t)
 
@@ -471,7 +864,11 @@ func TestAuthServiceSignInSuccess(t *testing.T) {
 			sql.NullString{String: "Test", Valid: true},
 			sql.NullString{String: "User", Valid: true},
 		))
+	mock.ExpectQuery("FROM user_totp").
+		WithArgs(userID).
+		WillReturnError(sql.ErrNoRows)
 
+	mock.ExpectBegin()
 	mock.ExpectQuery("INSERT INTO sessions").
 		WithArgs(
 			sqlmock.AnyArg(),
@@ -482,27 +879,22 @@ func TestAuthServiceSignInSuccess(t *testing.T) {
 			sql.NullBool{Bool: false, Valid: true},
 			sqlmock.AnyArg(),
 		).
-		WillReturnRows(sqlmock.NewRows([]string{
-			"session_id",
-			"user_id",
-			"refresh_token",
-			"user_agent",
-			"client_ip",
-			"is_blocked",
-			"expires_at",
-			"created_at",
-			"updated_at",
-		}).AddRow(
-			sessionID,
-			userID,
-			"refresh-token",
-			"test-agent",
-			"127.0.0.1",
-			sql.NullBool{Bool: false, Valid: true},
-			time.Now().Add(time.Hour),
-			sql.NullTime{Time: now, Valid: true},
-			sql.NullTime{Time: now, Valid: true},
-		))
+		WillReturnRows(sessionRows(db.Session{
+			SessionID:    sessionID,
+			UserID:       userID,
+			RefreshToken: "refresh-token",
+			UserAgent:    "test-agent",
+			ClientIp:     "127.0.0.1",
+			IsBlocked:    sql.NullBool{Bool: false, Valid: true},
+			ExpiresAt:    time.Now().Add(time.Hour),
+			CreatedAt:    sql.NullTime{Time: now, Valid: true},
+			UpdatedAt:    sql.NullTime{Time: now, Valid: true},
+			LastUsedAt:   now,
+		}))
+	mock.ExpectQuery("INSERT INTO refresh_tokens").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(refreshTokenRows(db.RefreshToken{TokenID: sessionID, SessionID: sessionID, CreatedAt: now}))
+	mock.ExpectCommit()
 
 	result, err := authService.SignIn(context.Background(), SignInInput{
 		Email:     "test@example.com",
@@ -576,3 +968,79 @@ func TestAuthServiceSignInEmailNotVerified(t *testing.T) {
 	require.Empty(t, result)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestAuthServiceSignInAllowsUnverifiedWhenConfigured(t *testing.T) {
+	authService, mock, _, _ := newTestAuthService(t)
+	authService.config.AllowUnverifiedSignIn = true
+
+	const password = "correct horse battery staple"
+	hashedPassword, err := util.HashPassword(password)
+	require.NoError(t, err)
+
+	user := testDBUserForUserService()
+	user.Password = hashedPassword
+	mock.ExpectQuery("SELECT user_id, username, email, password").
+		WithArgs("test@example.com").
+		WillReturnRows(userRows(user))
+	mock.ExpectQuery("FROM user_totp").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO sessions").
+		WillReturnRows(sessionRows(db.Session{SessionID: uuid.New(), UserID: user.UserID, ExpiresAt: time.Now().Add(time.Hour)}))
+	mock.ExpectQuery("INSERT INTO refresh_tokens").
+		WillReturnRows(refreshTokenRows(db.RefreshToken{}))
+	mock.ExpectCommit()
+
+	result, err := authService.SignIn(context.Background(), SignInInput{
+		Email:    "test@example.com",
+		Password: password,
+	})
+
+	require.NoError(t, err)
+	require.False(t, result.User.EmailVerified)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthServiceResendVerifyEmail(t *testing.T) {
+	verifiedUser := testDBUserForUserService()
+	verifiedUser.EmailVerified = sql.NullBool{Bool: true, Valid: true}
+
+	testCases := []struct {
+		name     string
+		user     *db.User
+		sent     int
+		enqueued bool
+	}{
+		{name: "UnknownEmail"},
+		{name: "AlreadyVerified", user: &verifiedUser},
+		{name: "Unverified", user: &db.User{UserID: 42, Email: "test@example.com"}, enqueued: true},
+		{name: "UnderHourlyCap", user: &db.User{UserID: 42, Email: "test@example.com"}, sent: maxVerifyEmailsPerHour - 1, enqueued: true},
+		{name: "TooManyThisHour", user: &db.User{UserID: 42, Email: "test@example.com"}, sent: maxVerifyEmailsPerHour},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authService, mock, _, taskDistributor := newTestAuthService(t)
+			for i := 0; i < tc.sent; i++ {
+				require.NoError(t, sendVerifyEmail(context.Background(), taskDistributor, 42))
+			}
+			taskDistributor.called = false
+
+			query := mock.ExpectQuery("SELECT user_id, username, email, password").WithArgs("test@example.com")
+			if tc.user == nil {
+				query.WillReturnError(sql.ErrNoRows)
+			} else {
+				query.WillReturnRows(userRows(*tc.user))
+			}
+
+			err := authService.ResendVerifyEmail(context.Background(), ResendVerifyEmailInput{Email: "Test@example.com"})
+
+			require.NoError(t, err)
+			require.Equal(t, tc.enqueued, taskDistributor.called)
+			if tc.enqueued {
+				require.Equal(t, int32(42), taskDistributor.payload.UserId)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
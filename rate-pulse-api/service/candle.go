@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/util"
+)
+
+const (
+	defaultCandleLimit = 500
+	maxCandleLimit     = 1000
+	candleTimeZone     = "UTC"
+)
+
+// candleBucketUnits maps the public interval names to date_trunc units.
+var candleBucketUnits = map[string]string{
+	"1h": "hour",
+	"1d": "day",
+	"1w": "week",
+	"1M": "month",
+}
+
+/*
+GetCandles Service is responsible for aggregating historical FX data into OHLC candles.
+- Validate currency/source/type IDs and the candle interval (1h, 1d, 1w, 1M)
+- Convert the requested time range into a repository start date
+- Bucket rates by valid_from_date on calendar boundaries so every candle, including the first, covers a full interval
+- Return the most recent candles up to the limit, oldest first
+*/
+func (s *FXService) GetCandles(ctx context.Context, input GetCandlesInput) ([]Candle, error) {
+	if input.SourceCurrencyID <= 0 {
+		return nil, Wrap(nil, ErrInvalidInput.Code, "source_currency_id must be greater than 0")
+	}
+	if input.DestinationCurrencyID <= 0 {
+		return nil, Wrap(nil, ErrInvalidInput.Code, "destination_currency_id must be greater than 0")
+	}
+	if input.SourceID <= 0 {
+		return nil, Wrap(nil, ErrInvalidInput.Code, "source_id must be greater than 0")
+	}
+	if input.TypeID <= 0 {
+		return nil, Wrap(nil, ErrInvalidInput.Code, "type_id must be greater than 0")
+	}
+	bucketUnit, ok := candleBucketUnits[input.Interval]
+	if !ok {
+		return nil, Wrap(nil, ErrInvalidInput.Code, "interval must be one of 1h, 1d, 1w, 1M")
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultCandleLimit
+	}
+	if limit < 0 || limit > maxCandleLimit {
+		return nil, Wrap(nil, ErrInvalidInput.Code, "limit must be between 1 and 1000")
+	}
+
+	duration, err := util.ParseTimeRangeToDuration(input.TimeRange)
+	if err != nil {
+		return nil, Wrap(err, ErrInvalidInput.Code, err.Error())
+	}
+
+	now := time.Now()
+	rows, err := s.store.GetExchangeRateCandles(ctx, db.GetExchangeRateCandlesParams{
+		BucketUnit:            bucketUnit,
+		TimeZone:              candleTimeZone,
+		SourceCurrencyID:      input.SourceCurrencyID,
+		DestinationCurrencyID: input.DestinationCurrencyID,
+		SourceID:              sql.NullInt32{Int32: input.SourceID, Valid: true},
+		TypeID:                sql.NullInt32{Int32: input.TypeID, Valid: true},
+		StartTime:             now.Add(-duration),
+		EndTime:               now,
+		MaxCandles:            limit,
+	})
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to get exchange rate candles")
+	}
+
+	// The query returns the newest candles first so the limit keeps the most recent ones.
+	res := make([]Candle, len(rows))
+	for i, row := range rows {
+		res[len(rows)-1-i] = NewCandle(row)
+	}
+	return res, nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func validGetCandlesInput() GetCandlesInput {
+	return GetCandlesInput{
+		SourceCurrencyID:      1,
+		DestinationCurrencyID: 2,
+		SourceID:              10,
+		TypeID:                4,
+		Interval:              "1d",
+		TimeRange:             "7d",
+	}
+}
+
+func TestFXServiceGetCandlesInvalidInput(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+
+	testCases := []struct {
+		name   string
+		mutate func(input *GetCandlesInput)
+	}{
+		{name: "missing source", mutate: func(input *GetCandlesInput) { input.SourceID = 0 }},
+		{name: "unsupported interval", mutate: func(input *GetCandlesInput) { input.Interval = "4h" }},
+		{name: "lowercase month", mutate: func(input *GetCandlesInput) { input.Interval = "1m" }},
+		{name: "limit too large", mutate: func(input *GetCandlesInput) { input.Limit = 1001 }},
+		{name: "invalid time range", mutate: func(input *GetCandlesInput) { input.TimeRange = "3d" }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := validGetCandlesInput()
+			tc.mutate(&input)
+
+			candles, err := fxService.GetCandles(context.Background(), input)
+
+			requireFXServiceErrorCode(t, err, ErrInvalidInput.Code)
+			require.Empty(t, candles)
+		})
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceGetCandlesReturnsOldestFirst(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	day := time.Date(2026, 5, 12, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT\s+date_trunc\(\$1::TEXT, er.valid_from_date`).
+		WithArgs("day", "UTC", int32(1), int32(2), sql.NullInt32{Int32: 10, Valid: true},
+			sql.NullInt32{Int32: 4, Valid: true}, sqlmock.AnyArg(), sqlmock.AnyArg(), int32(500)).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket_start", "open_rate", "high_rate", "low_rate", "close_rate", "sample_count"}).
+			AddRow(day, "25100", "25180", "25090", "25150", int64(6)).
+			AddRow(day.AddDate(0, 0, -1), "25000", "25120", "24980", "25100", int64(12)))
+
+	candles, err := fxService.GetCandles(context.Background(), validGetCandlesInput())
+
+	require.NoError(t, err)
+	require.Len(t, candles, 2)
+	require.Equal(t, day.AddDate(0, 0, -1), candles[0].BucketStart)
+	require.Equal(t, "25000", candles[0].Open)
+	require.Equal(t, "25120", candles[0].High)
+	require.Equal(t, "24980", candles[0].Low)
+	require.Equal(t, "25100", candles[0].Close)
+	require.Equal(t, int64(12), candles[0].SampleCount)
+	require.Equal(t, day, candles[1].BucketStart)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
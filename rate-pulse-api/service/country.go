@@ -0,0 +1,145 @@
+/*
+country service is responsible for the countries and the currency each one uses.
+*/
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+)
+
+type CountryService struct {
+	store db.Store
+}
+
+func NewCountryService(store db.Store) *CountryService {
+	return &CountryService{store: store}
+}
+
+func (s *CountryService) ListCountries(ctx context.Context) ([]Country, error) {
+	rows, err := s.store.GetAllCountries(ctx)
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to list countries")
+	}
+
+	countries := make([]Country, len(rows))
+	for i, row := range rows {
+		countries[i] = NewCountryFromGetRow(db.GetCountryByIDRow(row))
+	}
+	return countries, nil
+}
+
+func (s *CountryService) GetCountry(ctx context.Context, countryID int32) (Country, error) {
+	if countryID <= 0 {
+		return Country{}, Wrap(nil, ErrInvalidInput.Code, "country_id must be greater than 0")
+	}
+
+	country, err := s.store.GetCountryByID(ctx, countryID)
+	if err != nil {
+		return Country{}, wrapRecordDBError(err, "country", "failed to get country")
+	}
+	return NewCountryFromGetRow(country), nil
+}
+
+func (s *CountryService) GetCountryByCode(ctx context.Context, countryCode string) (Country, error) {
+	code, err := normalizeCountryCode(countryCode)
+	if err != nil {
+		return Country{}, err
+	}
+
+	country, err := s.store.GetCountryByCode(ctx, sql.NullString{String: code, Valid: true})
+	if err != nil {
+		return Country{}, wrapRecordDBError(err, "country", "failed to get country")
+	}
+	return NewCountryFromGetRow(db.GetCountryByIDRow(country)), nil
+}
+
+/*
+CreateCountry Service is responsible for adding a country.
+- Require country_name, a 2 or 3 letter country_code (stored upper case) and currency_id
+- Return ErrInvalidInput for a duplicate code or an unknown currency
+*/
+func (s *CountryService) CreateCountry(ctx context.Context, input CreateCountryInput) (Country, error) {
+	if strings.TrimSpace(input.CountryName) == "" {
+		return Country{}, Wrap(nil, ErrInvalidInput.Code, "country_name is required")
+	}
+	code, err := normalizeCountryCode(input.CountryCode)
+	if err != nil {
+		return Country{}, err
+	}
+	if input.CurrencyID <= 0 {
+		return Country{}, Wrap(nil, ErrInvalidInput.Code, "currency_id must be greater than 0")
+	}
+
+	country, err := s.store.CreateCountry(ctx, db.CreateCountryParams{
+		CountryName: strings.TrimSpace(input.CountryName),
+		CountryCode: sql.NullString{String: code, Valid: true},
+		CurrencyID:  input.CurrencyID,
+	})
+	if err != nil {
+		return Country{}, wrapRecordDBError(err, "country", "failed to create country")
+	}
+	return NewCountry(country), nil
+}
+
+/*
+UpdateCountry Service is responsible for editing a country.
+- Keep the current value of every field that is not set
+- Return ErrNotFound for an unknown country_id
+*/
+func (s *CountryService) UpdateCountry(ctx context.Context, input UpdateCountryInput) (Country, error) {
+	current, err := s.GetCountry(ctx, input.CountryID)
+	if err != nil {
+		return Country{}, err
+	}
+
+	arg := db.UpdateCountryParams{
+		CountryID:   input.CountryID,
+		CountryName: current.CountryName,
+		CurrencyID:  current.CurrencyID,
+	}
+	if hasValue(input.CountryName) {
+		arg.CountryName = strings.TrimSpace(*input.CountryName)
+	}
+	if input.CountryCode != nil {
+		code, err := normalizeCountryCode(*input.CountryCode)
+		if err != nil {
+			return Country{}, err
+		}
+		arg.CountryCode = sql.NullString{String: code, Valid: true}
+	}
+	if input.CurrencyID != nil {
+		if *input.CurrencyID <= 0 {
+			return Country{}, Wrap(nil, ErrInvalidInput.Code, "currency_id must be greater than 0")
+		}
+		arg.CurrencyID = *input.CurrencyID
+	}
+
+	country, err := s.store.UpdateCountry(ctx, arg)
+	if err != nil {
+		return Country{}, wrapRecordDBError(err, "country", "failed to update country")
+	}
+	return NewCountry(country), nil
+}
+
+func (s *CountryService) DeleteCountry(ctx context.Context, countryID int32) error {
+	if countryID <= 0 {
+		return Wrap(nil, ErrInvalidInput.Code, "country_id must be greater than 0")
+	}
+
+	if err := s.store.DeleteCountry(ctx, countryID); err != nil {
+		return wrapRecordDBError(err, "country", "failed to delete country")
+	}
+	return nil
+}
+
+func normalizeCountryCode(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) < 2 || len(code) > 3 {
+		return "", Wrap(nil, ErrInvalidInput.Code, "country_code must be 2 or 3 letters")
+	}
+	return code, nil
+}
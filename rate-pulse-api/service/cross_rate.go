@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+)
+
+// crossRatePair keys a source's latest rates by currency pair, ignoring the quoted direction.
+type crossRatePair struct {
+	low  int32
+	high int32
+}
+
+func newCrossRatePair(a, b int32) crossRatePair {
+	if a > b {
+		a, b = b, a
+	}
+	return crossRatePair{low: a, high: b}
+}
+
+/*
+GetCrossRate Service is responsible for pricing a currency pair a source may not publish directly.
+- Validate the currency pair, source and type
+- Load the source's latest rate for every pair of the same type
+- Use the direct rate when the pair is published in either direction, the newer one if both are
+- Otherwise triangulate through a pivot currency quoted against both sides (e.g. AUD->VND->JPY)
+- Prefer the requested pivot, then the pivot whose oldest leg is the most recent
+- Return the derived rate with the path and the legs used
+*/
+func (s *FXService) GetCrossRate(ctx context.Context, input CrossRateInput) (CrossRate, error) {
+	if err := validateCrossRateInput(input); err != nil {
+		return CrossRate{}, err
+	}
+
+	rates, err := s.store.ListLatestExchangeRatesForSource(ctx, db.ListLatestExchangeRatesForSourceParams{
+		SourceID: sql.NullInt32{Int32: input.SourceID, Valid: true},
+		TypeID:   sql.NullInt32{Int32: input.TypeID, Valid: true},
+	})
+	if err != nil {
+		return CrossRate{}, Wrap(err, ErrInternal.Code, "failed to list latest exchange rates")
+	}
+
+	latest := make(map[crossRatePair]db.ExchangeRate, len(rates))
+	quotedAgainst := make(map[int32][]int32)
+	for _, rate := range rates {
+		pair := newCrossRatePair(rate.SourceCurrencyID, rate.DestinationCurrencyID)
+		if current, ok := latest[pair]; ok {
+			// Both directions are published; keep the more recently updated one.
+			if rate.UpdatedAt.Time.After(current.UpdatedAt.Time) {
+				latest[pair] = rate
+			}
+			continue
+		}
+		latest[pair] = rate
+		quotedAgainst[rate.SourceCurrencyID] = append(quotedAgainst[rate.SourceCurrencyID], rate.DestinationCurrencyID)
+		quotedAgainst[rate.DestinationCurrencyID] = append(quotedAgainst[rate.DestinationCurrencyID], rate.SourceCurrencyID)
+	}
+
+	result := CrossRate{
+		FromCurrencyID: input.FromCurrencyID,
+		ToCurrencyID:   input.ToCurrencyID,
+		SourceID:       input.SourceID,
+		TypeID:         input.TypeID,
+	}
+
+	if direct, ok := latest[newCrossRatePair(input.FromCurrencyID, input.ToCurrencyID)]; ok {
+		leg, value, err := newCrossRateLeg(direct, input.FromCurrencyID, input.ToCurrencyID)
+		if err != nil {
+			return CrossRate{}, err
+		}
+		result.Direct = true
+		result.Rate = formatQuoteDecimal(value)
+		result.Path = []int32{input.FromCurrencyID, input.ToCurrencyID}
+		result.Legs = []CrossRateLeg{leg}
+		return result, nil
+	}
+
+	pivots := crossRatePivots(latest, quotedAgainst[input.FromCurrencyID], input)
+	if len(pivots) == 0 {
+		return CrossRate{}, Wrap(nil, ErrNotFound.Code, "no direct or pivot exchange rate found for currency pair")
+	}
+	pivot := pivots[0]
+
+	first, firstValue, err := newCrossRateLeg(latest[newCrossRatePair(input.FromCurrencyID, pivot)], input.FromCurrencyID, pivot)
+	if err != nil {
+		return CrossRate{}, err
+	}
+	second, secondValue, err := newCrossRateLeg(latest[newCrossRatePair(pivot, input.ToCurrencyID)], pivot, input.ToCurrencyID)
+	if err != nil {
+		return CrossRate{}, err
+	}
+
+	result.PivotCurrencyID = &pivot
+	result.Rate = formatQuoteDecimal(firstValue * secondValue)
+	result.Path = []int32{input.FromCurrencyID, pivot, input.ToCurrencyID}
+	result.Legs = []CrossRateLeg{first, second}
+	return result, nil
+}
+
+func validateCrossRateInput(input CrossRateInput) error {
+	if input.FromCurrencyID <= 0 {
+		return Wrap(nil, ErrInvalidInput.Code, "from_currency_id must be greater than 0")
+	}
+	if input.ToCurrencyID <= 0 {
+		return Wrap(nil, ErrInvalidInput.Code, "to_currency_id must be greater than 0")
+	}
+	if input.FromCurrencyID == input.ToCurrencyID {
+		return Wrap(nil, ErrInvalidInput.Code, "from_currency_id and to_currency_id must be different")
+	}
+	if input.SourceID <= 0 {
+		return Wrap(nil, ErrInvalidInput.Code, "source_id must be greater than 0")
+	}
+	if input.TypeID <= 0 {
+		return Wrap(nil, ErrInvalidInput.Code, "type_id must be greater than 0")
+	}
+	if input.PivotCurrencyID != nil {
+		pivot := *input.PivotCurrencyID
+		if pivot <= 0 {
+			return Wrap(nil, ErrInvalidInput.Code, "pivot_currency_id must be greater than 0")
+		}
+		if pivot == input.FromCurrencyID || pivot == input.ToCurrencyID {
+			return Wrap(nil, ErrInvalidInput.Code, "pivot_currency_id must differ from from_currency_id and to_currency_id")
+		}
+	}
+	return nil
+}
+
+// crossRatePivots returns the currencies quoted against both sides of the pair, best candidate first.
+func crossRatePivots(latest map[crossRatePair]db.ExchangeRate, candidates []int32, input CrossRateInput) []int32 {
+	oldestLeg := make(map[int32]time.Time)
+	pivots := make([]int32, 0, len(candidates))
+	for _, pivot := range candidates {
+		if pivot == input.ToCurrencyID {
+			continue
+		}
+		if input.PivotCurrencyID != nil && pivot != *input.PivotCurrencyID {
+			continue
+		}
+		second, ok := latest[newCrossRatePair(pivot, input.ToCurrencyID)]
+		if !ok {
+			continue
+		}
+		first := latest[newCrossRatePair(input.FromCurrencyID, pivot)]
+		// A leg without updated_at counts as the oldest possible.
+		oldest := first.UpdatedAt.Time
+		if second.UpdatedAt.Time.Before(oldest) {
+			oldest = second.UpdatedAt.Time
+		}
+		oldestLeg[pivot] = oldest
+		pivots = append(pivots, pivot)
+	}
+
+	sort.Slice(pivots, func(i, j int) bool {
+		a, b := oldestLeg[pivots[i]], oldestLeg[pivots[j]]
+		if !a.Equal(b) {
+			return a.After(b)
+		}
+		return pivots[i] < pivots[j]
+	})
+	return pivots
+}
+
+// newCrossRateLeg expresses a stored rate as units of toCurrencyID per 1 fromCurrencyID.
+func newCrossRateLeg(rate db.ExchangeRate, fromCurrencyID, toCurrencyID int32) (CrossRateLeg, float64, error) {
+	value, err := rate.RateIn(toCurrencyID)
+	if err != nil {
+		return CrossRateLeg{}, 0, Wrap(err, ErrInternal.Code, "exchange rate has an invalid rate_value")
+	}
+	return CrossRateLeg{
+		RateID:         rate.RateID,
+		FromCurrencyID: fromCurrencyID,
+		ToCurrencyID:   toCurrencyID,
+		Rate:           formatQuoteDecimal(value),
+		Inverted:       rate.SourceCurrencyID != toCurrencyID,
+		RateUpdatedAt:  nullTimePtr(rate.UpdatedAt),
+	}, value, nil
+}
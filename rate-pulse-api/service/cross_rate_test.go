@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+// testCrossRateSnapshot mirrors a Vietnamese bank that only publishes X->VND (1) rates.
+func testCrossRateSnapshot() []db.ExchangeRate {
+	updatedAt := time.Date(2026, 5, 12, 9, 0, 0, 0, time.UTC)
+	rate := func(rateID int32, destinationCurrencyID int32, value string, updated time.Time) db.ExchangeRate {
+		r := testQuoteExchangeRate()
+		r.RateID = rateID
+		r.DestinationCurrencyID = destinationCurrencyID
+		r.RateValue = value
+		r.UpdatedAt = sql.NullTime{Time: updated, Valid: true}
+		return r
+	}
+	return []db.ExchangeRate{
+		rate(50, 2, "25000", updatedAt),
+		rate(51, 3, "16500", updatedAt),
+		rate(52, 4, "165", updatedAt.Add(-time.Hour)),
+	}
+}
+
+func TestFXServiceGetCrossRateDirect(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+
+	mock.ExpectQuery("SELECT DISTINCT ON \\(source_currency_id, destination_currency_id\\)").
+		WithArgs(sql.NullInt32{Int32: 10, Valid: true}, sql.NullInt32{Int32: 4, Valid: true}).
+		WillReturnRows(exchangeRateRows(testCrossRateSnapshot()...))
+
+	crossRate, err := fxService.GetCrossRate(context.Background(), CrossRateInput{
+		FromCurrencyID: 1,
+		ToCurrencyID:   2,
+		SourceID:       10,
+		TypeID:         4,
+	})
+
+	require.NoError(t, err)
+	require.True(t, crossRate.Direct)
+	require.Nil(t, crossRate.PivotCurrencyID)
+	require.Equal(t, "0.00004", crossRate.Rate)
+	require.Equal(t, []int32{1, 2}, crossRate.Path)
+	require.Len(t, crossRate.Legs, 1)
+	require.Equal(t, int32(50), crossRate.Legs[0].RateID)
+	require.True(t, crossRate.Legs[0].Inverted)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceGetCrossRateDirectPrefersNewerDirection(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	snapshot := testCrossRateSnapshot()
+	reverse := testQuoteExchangeRate()
+	reverse.RateID = 60
+	reverse.SourceCurrencyID = 2
+	reverse.DestinationCurrencyID = 1
+	reverse.RateValue = "0.00005"
+	reverse.UpdatedAt = sql.NullTime{Time: snapshot[0].UpdatedAt.Time.Add(time.Hour), Valid: true}
+
+	mock.ExpectQuery("SELECT DISTINCT ON \\(source_currency_id, destination_currency_id\\)").
+		WillReturnRows(exchangeRateRows(append(snapshot, reverse)...))
+
+	crossRate, err := fxService.GetCrossRate(context.Background(), CrossRateInput{
+		FromCurrencyID: 1,
+		ToCurrencyID:   2,
+		SourceID:       10,
+		TypeID:         4,
+	})
+
+	require.NoError(t, err)
+	require.True(t, crossRate.Direct)
+	require.Equal(t, "0.00005", crossRate.Rate)
+	require.Len(t, crossRate.Legs, 1)
+	require.Equal(t, int32(60), crossRate.Legs[0].RateID)
+	require.False(t, crossRate.Legs[0].Inverted)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceGetCrossRateThroughPivot(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+
+	mock.ExpectQuery("SELECT DISTINCT ON \\(source_currency_id, destination_currency_id\\)").
+		WillReturnRows(exchangeRateRows(testCrossRateSnapshot()...))
+
+	crossRate, err := fxService.GetCrossRate(context.Background(), CrossRateInput{
+		FromCurrencyID: 3,
+		ToCurrencyID:   4,
+		SourceID:       10,
+		TypeID:         4,
+	})
+
+	require.NoError(t, err)
+	require.False(t, crossRate.Direct)
+	require.Equal(t, int32(1), *crossRate.PivotCurrencyID)
+	// 1 AUD = 16500 VND and 1 JPY = 165 VND, so 1 AUD = 100 JPY.
+	require.Equal(t, "100", crossRate.Rate)
+	require.Equal(t, []int32{3, 1, 4}, crossRate.Path)
+	require.Len(t, crossRate.Legs, 2)
+
+	require.Equal(t, int32(51), crossRate.Legs[0].RateID)
+	require.Equal(t, int32(3), crossRate.Legs[0].FromCurrencyID)
+	require.Equal(t, int32(1), crossRate.Legs[0].ToCurrencyID)
+	require.Equal(t, "16500", crossRate.Legs[0].Rate)
+	require.False(t, crossRate.Legs[0].Inverted)
+
+	require.Equal(t, int32(52), crossRate.Legs[1].RateID)
+	require.Equal(t, int32(1), crossRate.Legs[1].FromCurrencyID)
+	require.Equal(t, int32(4), crossRate.Legs[1].ToCurrencyID)
+	require.Equal(t, "0.00606061", crossRate.Legs[1].Rate)
+	require.True(t, crossRate.Legs[1].Inverted)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceGetCrossRateNoPath(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+
+	mock.ExpectQuery("SELECT DISTINCT ON \\(source_currency_id, destination_currency_id\\)").
+		WillReturnRows(exchangeRateRows(testCrossRateSnapshot()...))
+
+	pivot := int32(2)
+	crossRate, err := fxService.GetCrossRate(context.Background(), CrossRateInput{
+		FromCurrencyID:  3,
+		ToCurrencyID:    4,
+		SourceID:        10,
+		TypeID:          4,
+		PivotCurrencyID: &pivot,
+	})
+
+	requireFXServiceErrorCode(t, err, ErrNotFound.Code)
+	require.Empty(t, crossRate)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceGetCrossRateInvalidInput(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+
+	_, err := fxService.GetCrossRate(context.Background(), CrossRateInput{
+		FromCurrencyID: 3,
+		ToCurrencyID:   3,
+		SourceID:       10,
+		TypeID:         4,
+	})
+
+	requireFXServiceErrorCode(t, err, ErrInvalidInput.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,121 @@
+/*
+currency service is responsible for the currencies rates are quoted in.
+*/
+package service
+
+import (
+	"context"
+	"strings"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+)
+
+type CurrencyService struct {
+	store db.Store
+}
+
+func NewCurrencyService(store db.Store) *CurrencyService {
+	return &CurrencyService{store: store}
+}
+
+func (s *CurrencyService) ListCurrencies(ctx context.Context) ([]Currency, error) {
+	rows, err := s.store.GetAllCurrencies(ctx)
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to list currencies")
+	}
+
+	currencies := make([]Currency, len(rows))
+	for i, row := range rows {
+		currencies[i] = NewCurrencyFromGetRow(db.GetCurrencyByIDRow(row))
+	}
+	return currencies, nil
+}
+
+func (s *CurrencyService) GetCurrency(ctx context.Context, currencyID int32) (Currency, error) {
+	if currencyID <= 0 {
+		return Currency{}, Wrap(nil, ErrInvalidInput.Code, "currency_id must be greater than 0")
+	}
+
+	currency, err := s.store.GetCurrencyByID(ctx, currencyID)
+	if err != nil {
+		return Currency{}, wrapRecordDBError(err, "currency", "failed to get currency")
+	}
+	return NewCurrencyFromGetRow(currency), nil
+}
+
+/*
+CreateCurrency Service is responsible for adding a currency.
+- Require currency_code (3 letters, stored upper case), currency_name and currency_symbol
+- Return ErrInvalidInput for a duplicate code
+*/
+func (s *CurrencyService) CreateCurrency(ctx context.Context, input CreateCurrencyInput) (Currency, error) {
+	code := normalizeCurrencyCode(input.CurrencyCode)
+	if len(code) != 3 {
+		return Currency{}, Wrap(nil, ErrInvalidInput.Code, "currency_code must be 3 letters")
+	}
+	if strings.TrimSpace(input.CurrencyName) == "" {
+		return Currency{}, Wrap(nil, ErrInvalidInput.Code, "currency_name is required")
+	}
+	if strings.TrimSpace(input.CurrencySymbol) == "" {
+		return Currency{}, Wrap(nil, ErrInvalidInput.Code, "currency_symbol is required")
+	}
+
+	currency, err := s.store.CreateCurrency(ctx, db.CreateCurrencyParams{
+		CurrencyCode:   code,
+		CurrencyName:   strings.TrimSpace(input.CurrencyName),
+		CurrencySymbol: optionalString(&input.CurrencySymbol),
+	})
+	if err != nil {
+		return Currency{}, wrapRecordDBError(err, "currency", "failed to create currency")
+	}
+	return NewCurrency(currency), nil
+}
+
+/*
+UpdateCurrency Service is responsible for editing a currency.
+- Keep the current value of every field that is not set
+- Return ErrNotFound for an unknown currency_id
+*/
+func (s *CurrencyService) UpdateCurrency(ctx context.Context, input UpdateCurrencyInput) (Currency, error) {
+	current, err := s.GetCurrency(ctx, input.CurrencyID)
+	if err != nil {
+		return Currency{}, err
+	}
+
+	arg := db.UpdateCurrencyParams{
+		CurrencyID:     input.CurrencyID,
+		CurrencyCode:   current.CurrencyCode,
+		CurrencyName:   current.CurrencyName,
+		CurrencySymbol: optionalString(input.CurrencySymbol),
+	}
+	if input.CurrencyCode != nil {
+		arg.CurrencyCode = normalizeCurrencyCode(*input.CurrencyCode)
+		if len(arg.CurrencyCode) != 3 {
+			return Currency{}, Wrap(nil, ErrInvalidInput.Code, "currency_code must be 3 letters")
+		}
+	}
+	if hasValue(input.CurrencyName) {
+		arg.CurrencyName = strings.TrimSpace(*input.CurrencyName)
+	}
+
+	currency, err := s.store.UpdateCurrency(ctx, arg)
+	if err != nil {
+		return Currency{}, wrapRecordDBError(err, "currency", "failed to update currency")
+	}
+	return NewCurrency(currency), nil
+}
+
+func (s *CurrencyService) DeleteCurrency(ctx context.Context, currencyID int32) error {
+	if currencyID <= 0 {
+		return Wrap(nil, ErrInvalidInput.Code, "currency_id must be greater than 0")
+	}
+
+	if err := s.store.DeleteCurrency(ctx, currencyID); err != nil {
+		return wrapRecordDBError(err, "currency", "failed to delete currency")
+	}
+	return nil
+}
+
+func normalizeCurrencyCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
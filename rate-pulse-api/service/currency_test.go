@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyServiceCreateCurrency(t *testing.T) {
+	testCases := []struct {
+		name      string
+		err       error
+		errorCode string
+	}{
+		{name: "OK"},
+		{name: "Duplicate", err: &pq.Error{Code: "23505"}, errorCode: ErrInvalidInput.Code},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sqlDB, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer sqlDB.Close()
+			currencyService := NewCurrencyService(db.NewStore(sqlDB))
+
+			query := mock.ExpectQuery("INSERT INTO currencies").WithArgs("EUR", "Euro", "€")
+			if tc.err != nil {
+				query.WillReturnError(tc.err)
+			} else {
+				query.WillReturnRows(sqlmock.NewRows([]string{
+					"currency_id", "currency_code", "currency_name", "currency_symbol", "updated_at", "created_at",
+				}).AddRow(int32(4), "EUR", "Euro", "€", nil, nil))
+			}
+
+			currency, err := currencyService.CreateCurrency(context.Background(), CreateCurrencyInput{
+				CurrencyCode:   " eur ",
+				CurrencyName:   "Euro",
+				CurrencySymbol: "€",
+			})
+
+			if tc.errorCode != "" {
+				requireServiceErrorCode(t, err, tc.errorCode)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "EUR", currency.CurrencyCode)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
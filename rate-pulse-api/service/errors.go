@@ -1,6 +1,11 @@
 package service
 
-import "errors"
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+)
 
 // Error represents a service layer error with rich context.
 type Error struct {
@@ -14,13 +19,15 @@ var (
 	ErrInvalidInput = NewError("INVALID_INPUT", "invalid input") // 400
 
 	// Authentication / Authorization (4xx)
-	ErrInvalidCredentials = NewError("INVALID_CREDENTIALS", "invalid email or password") // 401
-	ErrUnauthorized       = NewError("UNAUTHORIZED", "unauthorized")                     // 401
-	ErrEmailNotVerified   = NewError("EMAIL_NOT_VERIFIED", "email is not verified")      // 403
-	ErrInactiveUser       = NewError("INACTIVE_USER", "user is inactive")                // 401
-	ErrSessionNotFound    = NewError("SESSION_NOT_FOUND", "session not found")           // 401
-	ErrSessionBlocked     = NewError("SESSION_BLOCKED", "session is blocked")            // 401
-	ErrSessionExpired     = NewError("SESSION_EXPIRED", "session expired")               // 401
+	ErrInvalidCredentials = NewError("INVALID_CREDENTIALS", "invalid email or password")       // 401
+	ErrUnauthorized       = NewError("UNAUTHORIZED", "unauthorized")                           // 401
+	ErrEmailNotVerified   = NewError("EMAIL_NOT_VERIFIED", "email is not verified")            // 403
+	ErrInactiveUser       = NewError("INACTIVE_USER", "user is inactive")                      // 401
+	ErrSessionNotFound    = NewError("SESSION_NOT_FOUND", "session not found")                 // 401
+	ErrSessionBlocked     = NewError("SESSION_BLOCKED", "session is blocked")                  // 401
+	ErrSessionExpired     = NewError("SESSION_EXPIRED", "session expired")                     // 401
+	ErrRefreshTokenReused = NewError("REFRESH_TOKEN_REUSED", "refresh token was already used") // 401
+	ErrForbidden          = NewError("FORBIDDEN", "forbidden")                                 // 403
 
 	// Not found errors (4xx)
 	ErrNotFound = NewError("NOT_FOUND", "not found") // 404
@@ -29,6 +36,9 @@ var (
 	ErrDuplicateEmail        = NewError("DUPLICATE_EMAIL", "email already exists")            // 409
 	ErrDuplicateExchangeRate = NewError("DUPLICATE_EXCHANGE_RATE", "duplicate exchange rate") // 409
 
+	// Quota errors (4xx)
+	ErrQuotaExceeded = NewError("QUOTA_EXCEEDED", "daily quota exceeded") // 429
+
 	// Server errors (5xx)
 	ErrInternal = NewError("INTERNAL_SERVER_ERROR", "internal server error") // 500
 )
@@ -86,3 +96,23 @@ func ServiceErrorMessage(err error) string {
 	}
 	return "internal server error"
 }
+
+// wrapRecordDBError maps the store errors of simple CRUD records: a missing row, a duplicate key,
+// a reference to a row that does not exist and, on delete, a row other records still reference.
+func wrapRecordDBError(err error, record string, defaultMessage string) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return Wrap(err, ErrNotFound.Code, record+" not found")
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "23505":
+			return Wrap(err, ErrInvalidInput.Code, record+" already exists")
+		case "23503":
+			return Wrap(err, ErrInvalidInput.Code, record+" references a missing record or is still in use")
+		case "23514":
+			return Wrap(err, ErrInvalidInput.Code, record+" violates database constraints")
+		}
+	}
+	return Wrap(err, ErrInternal.Code, defaultMessage)
+}
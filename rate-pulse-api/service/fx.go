@@ -8,26 +8,35 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
 	"github.com/ThanhVinhTong/rate-pulse/util"
 	"github.com/lib/pq"
 )
 
+const historicalIntervalDay = "1d"
+
 type FXService struct {
-	store db.Store
+	config      util.Config
+	store       db.Store
+	anomaly     anomalyThresholds
+	rateUpdates pubsub.Publisher
 }
 
-func NewFXService(store db.Store) *FXService {
-	return &FXService{store: store}
+func NewFXService(config util.Config, store db.Store, rateUpdates pubsub.Publisher) *FXService {
+	return &FXService{config: config, store: store, anomaly: newAnomalyThresholds(config), rateUpdates: rateUpdates}
 }
 
 /*
 CreateExchangeRate Service is responsible for creating a new exchange rate.
 - Validate required rate fields and positive reference IDs
+- Quarantine the rate when it deviates too far from the source's history or other sources
 - Build db.CreateExchangeRateParams
 - Convert database constraint failures into service errors
+- Publish the new rate to live subscribers when it is active
 */
 func (s *FXService) CreateExchangeRate(ctx context.Context, input CreateExchangeRateInput) (ExchangeRate, error) {
 	if err := validateExchangeRateValues(
@@ -40,6 +49,18 @@ func (s *FXService) CreateExchangeRate(ctx context.Context, input CreateExchange
 		return ExchangeRate{}, err
 	}
 
+	reason, err := s.detectRateAnomaly(ctx, rateAnomalyCandidate{
+		RateValue:             input.RateValue,
+		SourceCurrencyID:      input.SourceCurrencyID,
+		DestinationCurrencyID: input.DestinationCurrencyID,
+		SourceID:              input.SourceID,
+		TypeID:                input.TypeID,
+	}, time.Now().UTC())
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	status, quarantineReason := exchangeRateStatusFor(reason)
+
 	rate, err := s.store.CreateExchangeRate(ctx, db.CreateExchangeRateParams{
 		RateValue:             input.RateValue,
 		SourceCurrencyID:      input.SourceCurrencyID,
@@ -48,11 +69,14 @@ func (s *FXService) CreateExchangeRate(ctx context.Context, input CreateExchange
 		ValidToDate:           sql.NullTime{Time: input.ValidToDate, Valid: !input.ValidToDate.IsZero()},
 		SourceID:              sql.NullInt32{Int32: input.SourceID, Valid: input.SourceID > 0},
 		TypeID:                sql.NullInt32{Int32: input.TypeID, Valid: input.TypeID > 0},
+		Status:                status,
+		QuarantineReason:      quarantineReason,
 	})
 	if err != nil {
 		return ExchangeRate{}, wrapExchangeRateDBError(err, "failed to create exchange rate")
 	}
 
+	s.publishRateUpdates(ctx, rate)
 	return NewExchangeRate(rate), nil
 }
 
@@ -112,6 +136,7 @@ UpdateExchangeRate Service is responsible for updating an exchange rate by ID.
 - Validate rate_id and any provided IDs/dates
 - Build db.UpdateExchangeRateParams from optional fields
 - Return ErrNotFound when no row exists
+- Publish the changed rate to live subscribers when it is active
 */
 func (s *FXService) UpdateExchangeRate(ctx context.Context, input UpdateExchangeRateInput) (ExchangeRate, error) {
 	if input.RateID <= 0 {
@@ -156,6 +181,7 @@ func (s *FXService) UpdateExchangeRate(ctx context.Context, input UpdateExchange
 		return ExchangeRate{}, wrapExchangeRateDBError(err, "failed to update exchange rate")
 	}
 
+	s.publishRateUpdates(ctx, rate)
 	return NewExchangeRate(rate), nil
 }
 
@@ -181,7 +207,9 @@ func (s *FXService) DeleteExchangeRate(ctx context.Context, input DeleteExchange
 GetHistoricalData Service is responsible for fetching sampled historical FX data.
 - Validate currency/source/type IDs
 - Normalize data point count to API bounds
-- Convert the requested time range into a repository start date
+- Resolve the range from time_range or absolute from/to bounds
+- Hold the range to the caller's plan: time_range is clamped to historical_days, an older from is rejected
+- Sample evenly with NTILE, or return one end-of-day rate per calendar day in the caller's time zone
 */
 func (s *FXService) GetHistoricalData(ctx context.Context, input GetHistoricalDataInput) ([]HistoricalDataPoint, error) {
 	if input.SourceCurrencyID <= 0 {
@@ -196,6 +224,9 @@ func (s *FXService) GetHistoricalData(ctx context.Context, input GetHistoricalDa
 	if input.TypeID <= 0 {
 		return nil, Wrap(nil, ErrInvalidInput.Code, "type_id must be greater than 0")
 	}
+	if input.Interval != "" && input.Interval != historicalIntervalDay {
+		return nil, Wrap(nil, ErrInvalidInput.Code, "interval must be empty or 1d")
+	}
 
 	dataPoints := input.DataPoints
 	if dataPoints == 0 {
@@ -208,18 +239,53 @@ func (s *FXService) GetHistoricalData(ctx context.Context, input GetHistoricalDa
 		return nil, Wrap(nil, ErrInvalidInput.Code, "data_points must be greater than or equal to 0")
 	}
 
-	duration, err := util.ParseTimeRangeToDuration(input.TimeRange)
+	loc, err := s.historicalLocation(ctx, input.TimeZone, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	startTime, endTime, err := resolveHistoricalRange(input, loc, now)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := resolvePlanLimits(ctx, s.config, s.store, input.UserID, input.UserType)
+	if err != nil {
+		return nil, err
+	}
+	startTime, err = limitHistoricalRange(input, limits, startTime, now)
 	if err != nil {
-		return nil, Wrap(err, ErrInvalidInput.Code, err.Error())
+		return nil, err
+	}
+
+	if input.Interval == historicalIntervalDay {
+		rows, err := s.store.GetDailyHistoricalData(ctx, db.GetDailyHistoricalDataParams{
+			TimeZone:              loc.String(),
+			SourceCurrencyID:      input.SourceCurrencyID,
+			DestinationCurrencyID: input.DestinationCurrencyID,
+			SourceID:              sql.NullInt32{Int32: input.SourceID, Valid: true},
+			TypeID:                sql.NullInt32{Int32: input.TypeID, Valid: true},
+			StartTime:             sql.NullTime{Time: startTime, Valid: true},
+			EndTime:               sql.NullTime{Time: endTime, Valid: true},
+		})
+		if err != nil {
+			return nil, Wrap(err, ErrInternal.Code, "failed to get daily historical exchange rates")
+		}
+
+		res := make([]HistoricalDataPoint, len(rows))
+		for i, row := range rows {
+			res[i] = NewDailyHistoricalDataPoint(row)
+		}
+		return res, nil
 	}
 
 	rows, err := s.store.GetHistoricalData(ctx, db.GetHistoricalDataParams{
 		SourceCurrencyID:      input.SourceCurrencyID,
 		DestinationCurrencyID: input.DestinationCurrencyID,
 		SourceID:              sql.NullInt32{Int32: input.SourceID, Valid: true},
-		UpdatedAt:             sql.NullTime{Time: time.Now().Add(-duration), Valid: true},
+		UpdatedAt:             sql.NullTime{Time: startTime, Valid: true},
 		TypeID:                sql.NullInt32{Int32: input.TypeID, Valid: true},
 		Ntile:                 dataPoints,
+		UpdatedAt_2:           sql.NullTime{Time: endTime, Valid: true},
 	})
 	if err != nil {
 		return nil, Wrap(err, ErrInternal.Code, "failed to get historical exchange rates")
@@ -232,6 +298,107 @@ func (s *FXService) GetHistoricalData(ctx context.Context, input GetHistoricalDa
 	return res, nil
 }
 
+// historicalLocation picks the zone calendar days are cut in: the explicit tz, then the
+// signed-in user's time_zone, then UTC.
+func (s *FXService) historicalLocation(ctx context.Context, timeZone string, userID int32) (*time.Location, error) {
+	if timeZone != "" {
+		loc, err := loadIANALocation(timeZone)
+		if err != nil {
+			return nil, Wrap(err, ErrInvalidInput.Code, "tz must be an IANA time zone such as Asia/Ho_Chi_Minh")
+		}
+		return loc, nil
+	}
+	if userID <= 0 {
+		return time.UTC, nil
+	}
+
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.UTC, nil
+		}
+		return nil, Wrap(err, ErrInternal.Code, "failed to get user time zone")
+	}
+	if !user.TimeZone.Valid {
+		return time.UTC, nil
+	}
+	loc, err := loadIANALocation(user.TimeZone.String)
+	if err != nil {
+		// A profile saved before time zones were validated should not break charts.
+		return time.UTC, nil
+	}
+	return loc, nil
+}
+
+// resolveHistoricalRange turns either time_range (relative to now) or from/to into [start, end).
+// A date-only to is inclusive, so to=2026-05-31 covers the whole last day of May.
+func resolveHistoricalRange(input GetHistoricalDataInput, loc *time.Location, now time.Time) (time.Time, time.Time, error) {
+	if input.From == "" && input.To == "" {
+		if input.TimeRange == "" {
+			return time.Time{}, time.Time{}, Wrap(nil, ErrInvalidInput.Code, "time_range or from is required")
+		}
+		duration, err := util.ParseTimeRangeToDuration(input.TimeRange)
+		if err != nil {
+			return time.Time{}, time.Time{}, Wrap(err, ErrInvalidInput.Code, err.Error())
+		}
+		return now.Add(-duration), now, nil
+	}
+	if input.TimeRange != "" {
+		return time.Time{}, time.Time{}, Wrap(nil, ErrInvalidInput.Code, "use either time_range or from/to, not both")
+	}
+	if input.From == "" {
+		return time.Time{}, time.Time{}, Wrap(nil, ErrInvalidInput.Code, "from is required when to is set")
+	}
+
+	startTime, _, err := util.ParseTimeBound(input.From, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, Wrap(err, ErrInvalidInput.Code, "from: "+err.Error())
+	}
+	endTime := now
+	if input.To != "" {
+		var dateOnly bool
+		endTime, dateOnly, err = util.ParseTimeBound(input.To, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, Wrap(err, ErrInvalidInput.Code, "to: "+err.Error())
+		}
+		if dateOnly {
+			endTime = endTime.AddDate(0, 0, 1)
+		}
+	}
+	if !endTime.After(startTime) {
+		return time.Time{}, time.Time{}, Wrap(nil, ErrInvalidInput.Code, "to must be after from")
+	}
+	return startTime, endTime, nil
+}
+
+// limitHistoricalRange keeps startTime within the plan's historical_days. A relative time_range
+// such as "all" is clamped to the window; explicit from bounds outside it are rejected, since
+// silently returning a shorter series would misrepresent the requested period. Plans with
+// historical_days <= 0 arrive here with the free window already filled in by resolvePlanLimits.
+func limitHistoricalRange(input GetHistoricalDataInput, limits PlanLimits, startTime time.Time, now time.Time) (time.Time, error) {
+	if limits.Unlimited {
+		return startTime, nil
+	}
+
+	earliest := now.AddDate(0, 0, -int(limits.HistoricalDays))
+	if !startTime.Before(earliest) {
+		return startTime, nil
+	}
+	if input.TimeRange != "" {
+		return earliest, nil
+	}
+	return time.Time{}, Wrap(nil, ErrForbidden.Code,
+		fmt.Sprintf("from is outside the %d days of history included in the %s plan", limits.HistoricalDays, limits.PlanName))
+}
+
+// loadIANALocation loads a named zone; "Local" is rejected because Postgres cannot resolve it.
+func loadIANALocation(name string) (*time.Location, error) {
+	if name == "Local" {
+		return nil, fmt.Errorf("unknown time zone %s", name)
+	}
+	return time.LoadLocation(name)
+}
+
 func validateExchangeRateValues(rateValue string, sourceCurrencyID, destinationCurrencyID int32, validFromDate, validToDate time.Time) error {
 	if rateValue == "" {
 		return Wrap(nil, ErrInvalidInput.Code, "rate_value is required")
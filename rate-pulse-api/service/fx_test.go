@@ -9,6 +9,8 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
+	"github.com/ThanhVinhTong/rate-pulse/util"
 	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 )
@@ -23,7 +25,7 @@ func newTestFXService(t *testing.T) (*FXService, sqlmock.Sqlmock) {
 		_ = sqlDB.Close()
 	})
 
-	return NewFXService(db.NewStore(sqlDB)), mock
+	return NewFXService(util.Config{}, db.NewStore(sqlDB), pubsub.NoopBroker{}), mock
 }
 
 func requireFXServiceErrorCode(t *testing.T, err error, code string) {
@@ -48,6 +50,7 @@ func testExchangeRateForFXService() db.ExchangeRate {
 		TypeID:                sql.NullInt32{Int32: 1, Valid: true},
 		CreatedAt:             sql.NullTime{Time: now, Valid: true},
 		UpdatedAt:             sql.NullTime{Time: now, Valid: true},
+		Status:                ExchangeRateStatusActive,
 	}
 }
 
@@ -63,6 +66,8 @@ func exchangeRateRows(rates ...db.ExchangeRate) *sqlmock.Rows {
 		"updated_at",
 		"created_at",
 		"type_id",
+		"status",
+		"quarantine_reason",
 	})
 
 	for _, rate := range rates {
@@ -77,6 +82,8 @@ func exchangeRateRows(rates ...db.ExchangeRate) *sqlmock.Rows {
 			rate.UpdatedAt,
 			rate.CreatedAt,
 			rate.TypeID,
+			rate.Status,
+			rate.QuarantineReason,
 		)
 	}
 
@@ -95,6 +102,8 @@ func exchangeRateGetRows(rates ...db.ExchangeRate) *sqlmock.Rows {
 		"type_id",
 		"created_at",
 		"updated_at",
+		"status",
+		"quarantine_reason",
 	})
 
 	for _, rate := range rates {
@@ -109,6 +118,8 @@ func exchangeRateGetRows(rates ...db.ExchangeRate) *sqlmock.Rows {
 			rate.TypeID,
 			rate.CreatedAt,
 			rate.UpdatedAt,
+			rate.Status,
+			rate.QuarantineReason,
 		)
 	}
 
@@ -134,6 +145,7 @@ func TestFXServiceCreateExchangeRateDuplicate(t *testing.T) {
 	fxService, mock := newTestFXService(t)
 
 	now := time.Now()
+	mock.ExpectQuery("WITH history AS").WillReturnRows(anomalyBaselineRows(0, "0", "0", "0", 0, "0"))
 	mock.ExpectQuery("INSERT INTO exchange_rates").
 		WillReturnError(&pq.Error{Code: "23505", Message: "duplicate key value violates unique constraint"})
 
@@ -155,6 +167,7 @@ func TestFXServiceCreateExchangeRateSuccess(t *testing.T) {
 	fxService, mock := newTestFXService(t)
 	dbRate := testExchangeRateForFXService()
 
+	mock.ExpectQuery("WITH history AS").WillReturnRows(anomalyBaselineRows(0, "0", "0", "0", 0, "0"))
 	mock.ExpectQuery("INSERT INTO exchange_rates").
 		WithArgs(
 			dbRate.RateValue,
@@ -164,6 +177,8 @@ func TestFXServiceCreateExchangeRateSuccess(t *testing.T) {
 			dbRate.ValidToDate,
 			dbRate.SourceID,
 			dbRate.TypeID,
+			ExchangeRateStatusActive,
+			sql.NullString{},
 		).
 		WillReturnRows(exchangeRateRows(dbRate))
 
@@ -394,6 +409,7 @@ func TestFXServiceGetHistoricalDataSuccess(t *testing.T) {
 			sqlmock.AnyArg(),
 			sql.NullInt32{Int32: 1, Valid: true},
 			int32(50),
+			sqlmock.AnyArg(),
 		).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"rate_value",
@@ -419,3 +435,92 @@ func TestFXServiceGetHistoricalDataSuccess(t *testing.T) {
 	require.Equal(t, int32(1), points[0].TypeID)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestFXServiceGetHistoricalDataDailyUsesUserTimeZone(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	saigon, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	require.NoError(t, err)
+
+	user := testDBUserForUserService()
+	user.TimeZone = sql.NullString{String: "Asia/Ho_Chi_Minh", Valid: true}
+	mock.ExpectQuery("SELECT user_id, username, email, password").
+		WithArgs(user.UserID).
+		WillReturnRows(userRows(user))
+	mock.ExpectQuery("FROM user_subscriptions us").
+		WithArgs(user.UserID).
+		WillReturnRows(planLimitRows(db.GetUserPlanLimitsRow{PlanID: 2, PlanName: "premium", HistoricalDays: 3650, RateLimitPerDay: 10000}))
+
+	closeOfDay := time.Date(2026, 5, 1, 16, 30, 0, 0, saigon)
+	mock.ExpectQuery("SELECT DISTINCT ON \\(day_start\\)").
+		WithArgs(
+			"Asia/Ho_Chi_Minh",
+			int32(1),
+			int32(2),
+			sql.NullInt32{Int32: 10, Valid: true},
+			sql.NullInt32{Int32: 1, Valid: true},
+			sql.NullTime{Time: time.Date(2026, 5, 1, 0, 0, 0, 0, saigon), Valid: true},
+			// A date-only "to" includes the whole last day.
+			sql.NullTime{Time: time.Date(2026, 6, 1, 0, 0, 0, 0, saigon), Valid: true},
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"day_start", "rate_value", "updated_at", "type_id"}).
+			AddRow(time.Date(2026, 5, 1, 0, 0, 0, 0, saigon), "25410", sql.NullTime{Time: closeOfDay, Valid: true}, int32(1)))
+
+	points, err := fxService.GetHistoricalData(context.Background(), GetHistoricalDataInput{
+		SourceCurrencyID:      1,
+		DestinationCurrencyID: 2,
+		SourceID:              10,
+		TypeID:                1,
+		From:                  "2026-05-01",
+		To:                    "2026-05-31",
+		Interval:              "1d",
+		UserID:                user.UserID,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, points, 1)
+	require.Equal(t, "25410", points[0].RateValue)
+	require.True(t, closeOfDay.Equal(points[0].UpdatedAt))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceGetHistoricalDataInvalidRange(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+
+	testCases := []struct {
+		name   string
+		mutate func(input *GetHistoricalDataInput)
+	}{
+		{name: "no range", mutate: func(input *GetHistoricalDataInput) { input.TimeRange = "" }},
+		{name: "time range and from", mutate: func(input *GetHistoricalDataInput) { input.From = "2026-05-01" }},
+		{name: "to without from", mutate: func(input *GetHistoricalDataInput) {
+			input.TimeRange = ""
+			input.To = "2026-05-01"
+		}},
+		{name: "to before from", mutate: func(input *GetHistoricalDataInput) {
+			input.TimeRange = ""
+			input.From = "2026-05-02T00:00:00Z"
+			input.To = "2026-05-01T00:00:00Z"
+		}},
+		{name: "unknown time zone", mutate: func(input *GetHistoricalDataInput) { input.TimeZone = "Mars/Olympus" }},
+		{name: "unsupported interval", mutate: func(input *GetHistoricalDataInput) { input.Interval = "1h" }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := GetHistoricalDataInput{
+				SourceCurrencyID:      1,
+				DestinationCurrencyID: 2,
+				SourceID:              10,
+				TypeID:                1,
+				TimeRange:             "7d",
+			}
+			tc.mutate(&input)
+
+			points, err := fxService.GetHistoricalData(context.Background(), input)
+
+			requireFXServiceErrorCode(t, err, ErrInvalidInput.Code)
+			require.Nil(t, points)
+		})
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
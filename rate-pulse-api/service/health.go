@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
@@ -11,6 +13,8 @@ const (
 	HealthStatusHealthy   = "healthy"
 	HealthStatusDegraded  = "degraded"
 	HealthStatusUnhealthy = "unhealthy"
+
+	rateSourceStatusActive = "active"
 )
 
 type HealthService struct {
@@ -35,6 +39,7 @@ func (s *HealthService) CheckHealth(ctx context.Context) CheckHealthResult {
 		CheckedAt:     now,
 		Dependencies: []DependencyHealth{
 			s.databaseHealth(ctx),
+			s.rateSourceFreshnessHealth(ctx),
 		},
 	}
 
@@ -74,3 +79,89 @@ func (s *HealthService) databaseHealth(ctx context.Context) DependencyHealth {
 		Message: "database is reachable",
 	}
 }
+
+func (s *HealthService) rateSourceFreshnessHealth(ctx context.Context) DependencyHealth {
+	if s.store == nil {
+		return DependencyHealth{
+			Name:    "rate-sources",
+			Status:  HealthStatusUnhealthy,
+			Message: "database store is not configured",
+		}
+	}
+
+	report, err := s.GetRateSourceFreshness(ctx)
+	if err != nil {
+		return DependencyHealth{
+			Name:    "rate-sources",
+			Status:  HealthStatusUnhealthy,
+			Message: "rate source freshness check failed",
+		}
+	}
+
+	active := 0
+	var stale []string
+	for _, source := range report.Sources {
+		if isMonitoredRateSource(source.SourceStatus) {
+			active++
+		}
+		if source.Stale {
+			stale = append(stale, rateSourceLabel(source))
+		}
+	}
+	if len(stale) > 0 {
+		return DependencyHealth{
+			Name:    "rate-sources",
+			Status:  HealthStatusDegraded,
+			Message: fmt.Sprintf("%d of %d active sources are stale: %s", len(stale), active, strings.Join(stale, ", ")),
+		}
+	}
+
+	return DependencyHealth{
+		Name:    "rate-sources",
+		Status:  HealthStatusHealthy,
+		Message: fmt.Sprintf("all %d active sources are fresh", active),
+	}
+}
+
+/*
+GetRateSourceFreshness Service is responsible for reporting how current each rate source is.
+- Report the last ingested rate, the expected cadence and the time it turns stale for every source
+- Only active sources can be stale; inactive ones are listed for reference
+- Return degraded overall when any active source has missed its window
+*/
+func (s *HealthService) GetRateSourceFreshness(ctx context.Context) (RateSourceFreshnessReport, error) {
+	rows, err := s.store.ListRateSourceFreshness(ctx)
+	if err != nil {
+		return RateSourceFreshnessReport{}, Wrap(err, ErrInternal.Code, "failed to list rate source freshness")
+	}
+
+	now := time.Now().UTC()
+	report := RateSourceFreshnessReport{
+		Status:    HealthStatusHealthy,
+		CheckedAt: now,
+		Sources:   make([]RateSourceFreshness, len(rows)),
+	}
+	for i, row := range rows {
+		source := NewRateSourceFreshness(row)
+		source.Stale = isMonitoredRateSource(source.SourceStatus) && !now.Before(source.StaleAt)
+		if source.Stale {
+			report.StaleCount++
+			report.Status = HealthStatusDegraded
+		}
+		report.Sources[i] = source
+	}
+
+	return report, nil
+}
+
+// isMonitoredRateSource reports whether a source is expected to keep publishing rates.
+func isMonitoredRateSource(status string) bool {
+	return strings.EqualFold(strings.TrimSpace(status), rateSourceStatusActive)
+}
+
+func rateSourceLabel(source RateSourceFreshness) string {
+	if source.SourceCode != "" {
+		return source.SourceCode
+	}
+	return source.SourceName
+}
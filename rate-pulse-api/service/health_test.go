@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHealthService(t *testing.T) (*HealthService, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = sqlDB.Close()
+	})
+
+	return NewHealthService(db.NewStore(sqlDB)), mock
+}
+
+func rateSourceFreshnessRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"source_id", "source_name", "source_code", "source_status", "expected_interval_minutes",
+		"stale_notified_at", "last_ingested_at", "stale_at",
+	})
+}
+
+func TestHealthServiceGetRateSourceFreshness(t *testing.T) {
+	healthService, mock := newTestHealthService(t)
+
+	now := time.Now().UTC()
+	mock.ExpectQuery("SELECT(.|\n)+FROM rate_sources rs").
+		WillReturnRows(rateSourceFreshnessRows().
+			AddRow(int32(1), "Vietcombank", "VCB", "active", int32(120), nil, now.Add(-30*time.Minute), now.Add(150*time.Minute)).
+			AddRow(int32(2), "BIDV", "BIDV", "active", int32(120), nil, now.Add(-4*time.Hour), now.Add(-time.Hour)).
+			AddRow(int32(3), "Retired Bank", "OLD", "inactive", int32(120), nil, nil, now.Add(-72*time.Hour)))
+
+	report, err := healthService.GetRateSourceFreshness(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, HealthStatusDegraded, report.Status)
+	require.Equal(t, int32(1), report.StaleCount)
+	require.Len(t, report.Sources, 3)
+	require.False(t, report.Sources[0].Stale)
+	require.NotNil(t, report.Sources[0].LastIngestedAt)
+	require.True(t, report.Sources[1].Stale)
+	// Inactive sources are reported but never count as stale.
+	require.False(t, report.Sources[2].Stale)
+	require.Nil(t, report.Sources[2].LastIngestedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHealthServiceGetRateSourceFreshnessDBError(t *testing.T) {
+	healthService, mock := newTestHealthService(t)
+
+	mock.ExpectQuery("SELECT(.|\n)+FROM rate_sources rs").WillReturnError(sql.ErrConnDone)
+
+	report, err := healthService.GetRateSourceFreshness(context.Background())
+
+	requireFXServiceErrorCode(t, err, ErrInternal.Code)
+	require.Empty(t, report)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHealthServiceCheckHealthDegradedByStaleSource(t *testing.T) {
+	healthService, mock := newTestHealthService(t)
+
+	now := time.Now().UTC()
+	mock.ExpectQuery("SELECT(.|\n)+FROM rate_sources rs").
+		WillReturnRows(rateSourceFreshnessRows().
+			AddRow(int32(1), "Vietcombank", "VCB", "active", int32(120), nil, now.Add(-30*time.Minute), now.Add(150*time.Minute)).
+			AddRow(int32(2), "BIDV", "BIDV", "active", int32(120), nil, now.Add(-4*time.Hour), now.Add(-time.Hour)))
+
+	result := healthService.CheckHealth(context.Background())
+
+	require.Equal(t, HealthStatusDegraded, result.Status)
+	require.Len(t, result.Dependencies, 2)
+	require.Equal(t, HealthStatusHealthy, result.Dependencies[0].Status)
+	require.Equal(t, "rate-sources", result.Dependencies[1].Name)
+	require.Equal(t, HealthStatusDegraded, result.Dependencies[1].Status)
+	require.Equal(t, "1 of 2 active sources are stale: BIDV", result.Dependencies[1].Message)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHealthServiceCheckHealthFreshSources(t *testing.T) {
+	healthService, mock := newTestHealthService(t)
+
+	now := time.Now().UTC()
+	mock.ExpectQuery("SELECT(.|\n)+FROM rate_sources rs").
+		WillReturnRows(rateSourceFreshnessRows().
+			AddRow(int32(1), "Vietcombank", "VCB", "active", int32(120), nil, now.Add(-30*time.Minute), now.Add(150*time.Minute)))
+
+	result := healthService.CheckHealth(context.Background())
+
+	require.Equal(t, HealthStatusHealthy, result.Status)
+	require.Equal(t, "all 1 active sources are fresh", result.Dependencies[1].Message)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
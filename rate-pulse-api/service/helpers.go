@@ -5,6 +5,7 @@ import (
 	"time"
 
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
 )
 
 /*
@@ -44,6 +45,8 @@ func NewExchangeRate(rate db.ExchangeRate) ExchangeRate {
 		TypeID:                rate.TypeID.Int32,
 		CreatedAt:             rate.CreatedAt.Time,
 		UpdatedAt:             rate.UpdatedAt.Time,
+		Status:                rate.Status,
+		QuarantineReason:      rate.QuarantineReason.String,
 	}
 }
 
@@ -59,9 +62,28 @@ func NewExchangeRateFromGetRow(rate db.GetExchangeRateByIDRow) ExchangeRate {
 		TypeID:                rate.TypeID.Int32,
 		CreatedAt:             rate.CreatedAt.Time,
 		UpdatedAt:             rate.UpdatedAt.Time,
+		Status:                rate.Status,
+		QuarantineReason:      rate.QuarantineReason.String,
 	}
 }
 
+func NewRateUpdate(rate db.ExchangeRate) pubsub.RateUpdate {
+	update := pubsub.RateUpdate{
+		RateID:                rate.RateID,
+		RateValue:             rate.RateValue,
+		SourceCurrencyID:      rate.SourceCurrencyID,
+		DestinationCurrencyID: rate.DestinationCurrencyID,
+		SourceID:              rate.SourceID.Int32,
+		TypeID:                rate.TypeID.Int32,
+		ValidFromDate:         rate.ValidFromDate,
+		UpdatedAt:             rate.UpdatedAt.Time,
+	}
+	if !rate.UpdatedAt.Valid {
+		update.UpdatedAt = rate.ValidFromDate
+	}
+	return update
+}
+
 func NewLatestExchangeRate(rate db.GetAllExchangeRatesTodayNormalisedRow) LatestExchangeRate {
 	return LatestExchangeRate{
 		RateID:                  rate.RateID,
@@ -83,6 +105,25 @@ func NewHistoricalDataPoint(point db.GetHistoricalDataRow) HistoricalDataPoint {
 	}
 }
 
+func NewDailyHistoricalDataPoint(point db.GetDailyHistoricalDataRow) HistoricalDataPoint {
+	return HistoricalDataPoint{
+		RateValue: point.RateValue,
+		UpdatedAt: point.UpdatedAt.Time,
+		TypeID:    point.TypeID.Int32,
+	}
+}
+
+func NewCandle(row db.GetExchangeRateCandlesRow) Candle {
+	return Candle{
+		BucketStart: row.BucketStart,
+		Open:        row.OpenRate,
+		High:        row.HighRate,
+		Low:         row.LowRate,
+		Close:       row.CloseRate,
+		SampleCount: row.SampleCount,
+	}
+}
+
 func NewRateSourceFeeRule(rule db.RateSourceFeeRule) RateSourceFeeRule {
 	return RateSourceFeeRule{
 		FeeRuleID:          rule.FeeRuleID,
@@ -120,6 +161,86 @@ func NewRateSourceFeeRules(rules []db.RateSourceFeeRule) []RateSourceFeeRule {
 	return res
 }
 
+func NewRateAlert(alert db.RateAlert) RateAlert {
+	return RateAlert{
+		AlertID:         alert.AlertID,
+		UserID:          alert.UserID,
+		SourceID:        alert.SourceID,
+		TypeID:          alert.TypeID,
+		FromCurrencyID:  alert.FromCurrencyID,
+		ToCurrencyID:    alert.ToCurrencyID,
+		Condition:       alert.Condition,
+		Threshold:       nullStringPtr(alert.Threshold),
+		ChangePercent:   nullStringPtr(alert.ChangePercent),
+		WindowHours:     alert.WindowHours,
+		IsActive:        alert.IsActive,
+		LastRateValue:   nullStringPtr(alert.LastRateValue),
+		LastTriggeredAt: nullTimePtr(alert.LastTriggeredAt),
+		CreatedAt:       alert.CreatedAt,
+		UpdatedAt:       alert.UpdatedAt,
+	}
+}
+
+func NewRateAlerts(alerts []db.RateAlert) []RateAlert {
+	res := make([]RateAlert, len(alerts))
+	for i, alert := range alerts {
+		res[i] = NewRateAlert(alert)
+	}
+	return res
+}
+
+func NewAPIKey(key db.ApiKey) APIKey {
+	return APIKey{
+		APIKeyID:   key.ApiKeyID,
+		Name:       key.Name,
+		KeyPrefix:  key.KeyPrefix,
+		Scopes:     key.Scopes,
+		ExpiresAt:  nullTimePtr(key.ExpiresAt),
+		LastUsedAt: nullTimePtr(key.LastUsedAt),
+		RevokedAt:  nullTimePtr(key.RevokedAt),
+		CreatedAt:  key.CreatedAt,
+	}
+}
+
+func NewAPIKeys(keys []db.ApiKey) []APIKey {
+	res := make([]APIKey, len(keys))
+	for i, key := range keys {
+		res[i] = NewAPIKey(key)
+	}
+	return res
+}
+
+func NewSession(session db.Session) Session {
+	return Session{
+		SessionID:  session.SessionID,
+		UserAgent:  session.UserAgent,
+		ClientIP:   session.ClientIp,
+		LastUsedAt: session.LastUsedAt,
+		CreatedAt:  session.CreatedAt.Time,
+		ExpiresAt:  session.ExpiresAt,
+	}
+}
+
+func NewSessions(sessions []db.Session) []Session {
+	res := make([]Session, len(sessions))
+	for i, session := range sessions {
+		res[i] = NewSession(session)
+	}
+	return res
+}
+
+func NewRateSourceFreshness(row db.ListRateSourceFreshnessRow) RateSourceFreshness {
+	return RateSourceFreshness{
+		SourceID:                row.SourceID,
+		SourceName:              row.SourceName,
+		SourceCode:              row.SourceCode.String,
+		SourceStatus:            row.SourceStatus.String,
+		ExpectedIntervalMinutes: row.ExpectedIntervalMinutes,
+		LastIngestedAt:          nullTimePtr(row.LastIngestedAt),
+		StaleAt:                 row.StaleAt,
+	}
+}
+
 func nullStringPtr(value sql.NullString) *string {
 	if !value.Valid {
 		return nil
@@ -140,3 +261,177 @@ func nullTimePtr(value sql.NullTime) *time.Time {
 	}
 	return &value.Time
 }
+
+func NewRateSource(source db.RateSource) RateSource {
+	return RateSource{
+		SourceID:      source.SourceID,
+		SourceName:    source.SourceName,
+		SourceLink:    nullStringPtr(source.SourceLink),
+		SourceCountry: nullStringPtr(source.SourceCountry),
+		SourceStatus:  nullStringPtr(source.SourceStatus),
+		SourceCode:    nullStringPtr(source.SourceCode),
+	}
+}
+
+func NewRateSourceFromGetRow(source db.GetRateSourceByIDRow) RateSource {
+	return RateSource{
+		SourceID:      source.SourceID,
+		SourceName:    source.SourceName,
+		SourceLink:    nullStringPtr(source.SourceLink),
+		SourceCountry: nullStringPtr(source.SourceCountry),
+		SourceStatus:  nullStringPtr(source.SourceStatus),
+		SourceCode:    nullStringPtr(source.SourceCode),
+	}
+}
+
+func NewRateSourcesFromPageRows(rows []db.ListRateSourcesPageRow) []RateSource {
+	res := make([]RateSource, len(rows))
+	for i, row := range rows {
+		res[i] = NewRateSourceFromGetRow(db.GetRateSourceByIDRow(row))
+	}
+	return res
+}
+
+func NewCurrency(currency db.Currency) Currency {
+	return Currency{
+		CurrencyID:     currency.CurrencyID,
+		CurrencyCode:   currency.CurrencyCode,
+		CurrencyName:   currency.CurrencyName,
+		CurrencySymbol: nullStringPtr(currency.CurrencySymbol),
+	}
+}
+
+func NewCurrencyFromGetRow(currency db.GetCurrencyByIDRow) Currency {
+	return Currency{
+		CurrencyID:     currency.CurrencyID,
+		CurrencyCode:   currency.CurrencyCode,
+		CurrencyName:   currency.CurrencyName,
+		CurrencySymbol: nullStringPtr(currency.CurrencySymbol),
+	}
+}
+
+func NewCountry(country db.Country) Country {
+	return Country{
+		CountryID:   country.CountryID,
+		CountryName: country.CountryName,
+		CountryCode: nullStringPtr(country.CountryCode),
+		CurrencyID:  country.CurrencyID,
+		UpdatedAt:   nullTimePtr(country.UpdatedAt),
+		CreatedAt:   nullTimePtr(country.CreatedAt),
+	}
+}
+
+func NewCountryFromGetRow(country db.GetCountryByIDRow) Country {
+	return Country{
+		CountryID:   country.CountryID,
+		CountryName: country.CountryName,
+		CountryCode: nullStringPtr(country.CountryCode),
+		CurrencyID:  country.CurrencyID,
+		UpdatedAt:   nullTimePtr(country.UpdatedAt),
+		CreatedAt:   nullTimePtr(country.CreatedAt),
+	}
+}
+
+func NewPayment(payment db.Payment) Payment {
+	return Payment{
+		PaymentID:      payment.PaymentID,
+		SubscriptionID: payment.SubscriptionID,
+		TransactionID:  nullStringPtr(payment.TransactionID),
+		Amount:         payment.Amount,
+		CurrencyCode:   payment.CurrencyCode,
+		PaymentMethod:  nullStringPtr(payment.PaymentMethod),
+		PaymentStatus:  payment.PaymentStatus.String,
+		PaymentDate:    nullTimePtr(payment.PaymentDate),
+		CreatedAt:      nullTimePtr(payment.CreatedAt),
+		UpdatedAt:      nullTimePtr(payment.UpdatedAt),
+	}
+}
+
+func NewPayments(payments []db.Payment) []Payment {
+	res := make([]Payment, len(payments))
+	for i, payment := range payments {
+		res[i] = NewPayment(payment)
+	}
+	return res
+}
+
+func NewUserSubscription(subscription db.UserSubscription) UserSubscription {
+	return UserSubscription{
+		SubscriptionID: subscription.SubscriptionID,
+		UserID:         subscription.UserID,
+		PlanID:         subscription.PlanID,
+		Status:         subscription.Status.String,
+		StartDate:      subscription.StartDate,
+		EndDate:        nullTimePtr(subscription.EndDate),
+		AutoRenew:      subscription.AutoRenew.Bool,
+		CreatedAt:      nullTimePtr(subscription.CreatedAt),
+		UpdatedAt:      nullTimePtr(subscription.UpdatedAt),
+	}
+}
+
+func NewUserSubscriptions(subscriptions []db.UserSubscription) []UserSubscription {
+	res := make([]UserSubscription, len(subscriptions))
+	for i, subscription := range subscriptions {
+		res[i] = NewUserSubscription(subscription)
+	}
+	return res
+}
+
+func NewSubscriptionPlan(plan db.SubscriptionPlan) SubscriptionPlan {
+	return SubscriptionPlan{
+		PlanID:          plan.PlanID,
+		PlanName:        plan.PlanName,
+		PlanPrice:       plan.PlanPrice,
+		HistoricalDays:  plan.HistoricalDays,
+		RateLimitPerDay: plan.RateLimitPerDay,
+		Features:        nullStringPtr(plan.Features),
+		IsActive:        plan.IsActive.Bool,
+		CreatedAt:       nullTimePtr(plan.CreatedAt),
+		UpdatedAt:       nullTimePtr(plan.UpdatedAt),
+	}
+}
+
+func NewSubscriptionPlans(plans []db.SubscriptionPlan) []SubscriptionPlan {
+	res := make([]SubscriptionPlan, len(plans))
+	for i, plan := range plans {
+		res[i] = NewSubscriptionPlan(plan)
+	}
+	return res
+}
+
+func NewCurrencyPreference(preference db.UserCurrencyPreference) CurrencyPreference {
+	return CurrencyPreference{
+		CurrencyID:   preference.CurrencyID,
+		UserID:       preference.UserID,
+		IsFavorite:   preference.IsFavorite.Bool,
+		DisplayOrder: nullInt32Ptr(preference.DisplayOrder),
+		UpdatedAt:    nullTimePtr(preference.UpdatedAt),
+		CreatedAt:    nullTimePtr(preference.CreatedAt),
+	}
+}
+
+func NewCurrencyPreferences(preferences []db.UserCurrencyPreference) []CurrencyPreference {
+	res := make([]CurrencyPreference, len(preferences))
+	for i, preference := range preferences {
+		res[i] = NewCurrencyPreference(preference)
+	}
+	return res
+}
+
+func NewRateSourcePreference(preference db.UserRateSourcePreference) RateSourcePreference {
+	return RateSourcePreference{
+		SourceID:  preference.SourceID,
+		UserID:    preference.UserID,
+		IsPrimary: preference.IsPrimary.Bool,
+		UpdatedAt: nullTimePtr(preference.UpdatedAt),
+		CreatedAt: nullTimePtr(preference.CreatedAt),
+	}
+}
+
+func NewRateSourcePreferences(preferences []db.UserRateSourcePreference) []RateSourcePreference {
+	res := make([]RateSourcePreference, len(preferences))
+	for i, preference := range preferences {
+		res[i] = NewRateSourcePreference(preference)
+	}
+	return res
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+)
+
+const (
+	IngestStatusInserted    = "inserted"
+	IngestStatusQuarantined = "quarantined"
+	IngestStatusDuplicate   = "duplicate"
+	IngestStatusRejected    = "rejected"
+
+	// ExchangeRateIngestBucket matches the scraper's two-hour run window: a source publishes
+	// at most one rate per pair and type inside a bucket.
+	ExchangeRateIngestBucket = 2 * time.Hour
+
+	maxIngestExchangeRates = 1000
+)
+
+// ingestLookups resolves the natural keys scrapers publish into reference IDs.
+type ingestLookups struct {
+	sources    map[string]int32
+	currencies map[string]int32
+	types      map[string]int32
+}
+
+/*
+IngestExchangeRates Service is responsible for loading a batch of scraped rates.
+- Resolve source_code, currency codes and type name case-insensitively
+- Validate each row and reject it with a reason instead of failing the batch
+- Quarantine rates that deviate too far from the source's history or other sources, loading every baseline in one query
+- Insert the batch as one snapshot with at most one rate per (source, pair, type, time-bucket), so replays are harmless
+- Close the valid_to_date of the rates the new active rates supersede, keeping them as history
+- Return per-row results with inserted/quarantined/duplicate/rejected counts
+- Publish the inserted active rates to live subscribers in one batch
+*/
+func (s *FXService) IngestExchangeRates(ctx context.Context, input IngestExchangeRatesInput) (IngestExchangeRatesResult, error) {
+	if len(input.Rates) == 0 {
+		return IngestExchangeRatesResult{}, Wrap(nil, ErrInvalidInput.Code, "rates must contain at least one row")
+	}
+	if len(input.Rates) > maxIngestExchangeRates {
+		return IngestExchangeRatesResult{}, Wrap(nil, ErrInvalidInput.Code, "rates must contain at most 1000 rows")
+	}
+
+	lookups, err := s.loadIngestLookups(ctx)
+	if err != nil {
+		return IngestExchangeRatesResult{}, err
+	}
+
+	now := time.Now().UTC()
+	result := IngestExchangeRatesResult{Rows: make([]IngestExchangeRateRowResult, len(input.Rates))}
+	snapshot := db.RefreshExchangeRatesParams{Bucket: ExchangeRateIngestBucket}
+	var positions []int
+	var candidates []rateAnomalyCandidate
+	for i, row := range input.Rates {
+		rate, err := lookups.resolve(row, now)
+		if err != nil {
+			result.Rows[i] = rejectedIngestRow(err)
+			continue
+		}
+		snapshot.Rates = append(snapshot.Rates, rate)
+		positions = append(positions, i)
+		candidates = append(candidates, rateAnomalyCandidate{
+			RateValue:             rate.RateValue,
+			SourceCurrencyID:      rate.SourceCurrencyID,
+			DestinationCurrencyID: rate.DestinationCurrencyID,
+			SourceID:              rate.SourceID,
+			TypeID:                rate.TypeID,
+		})
+	}
+
+	reasons, err := s.detectRateAnomalies(ctx, candidates, now)
+	if err != nil {
+		return IngestExchangeRatesResult{}, err
+	}
+	for j, reason := range reasons {
+		snapshot.Rates[j].Status, snapshot.Rates[j].QuarantineReason = ExchangeRateStatusActive, reason
+		if reason != "" {
+			snapshot.Rates[j].Status = ExchangeRateStatusQuarantined
+		}
+	}
+
+	stored, err := s.store.RefreshExchangeRatesTx(ctx, snapshot)
+	if err != nil {
+		return IngestExchangeRatesResult{}, wrapExchangeRateDBError(err, "failed to ingest exchange rates")
+	}
+
+	var inserted []db.ExchangeRate
+	for j, rate := range stored.Rates {
+		switch {
+		case rate.RateID == 0:
+			result.Rows[positions[j]] = IngestExchangeRateRowResult{Status: IngestStatusDuplicate}
+		case rate.Status == ExchangeRateStatusQuarantined:
+			result.Rows[positions[j]] = IngestExchangeRateRowResult{
+				Status: IngestStatusQuarantined,
+				RateID: &rate.RateID,
+				Reason: snapshot.Rates[j].QuarantineReason,
+			}
+		default:
+			result.Rows[positions[j]] = IngestExchangeRateRowResult{Status: IngestStatusInserted, RateID: &rate.RateID}
+			inserted = append(inserted, rate)
+		}
+	}
+
+	for i := range result.Rows {
+		result.Rows[i].Index = int32(i)
+		switch result.Rows[i].Status {
+		case IngestStatusInserted:
+			result.Inserted++
+		case IngestStatusQuarantined:
+			result.Quarantined++
+		case IngestStatusDuplicate:
+			result.Duplicates++
+		default:
+			result.Rejected++
+		}
+	}
+
+	s.publishRateUpdates(ctx, inserted...)
+	return result, nil
+}
+
+func (s *FXService) loadIngestLookups(ctx context.Context) (ingestLookups, error) {
+	sources, err := s.store.ListRateSources(ctx)
+	if err != nil {
+		return ingestLookups{}, Wrap(err, ErrInternal.Code, "failed to list rate sources")
+	}
+	currencies, err := s.store.GetAllCurrencyCodesAndNames(ctx)
+	if err != nil {
+		return ingestLookups{}, Wrap(err, ErrInternal.Code, "failed to list currencies")
+	}
+	types, err := s.store.ListExchangeRateTypes(ctx)
+	if err != nil {
+		return ingestLookups{}, Wrap(err, ErrInternal.Code, "failed to list exchange rate types")
+	}
+
+	lookups := ingestLookups{
+		sources:    make(map[string]int32, len(sources)),
+		currencies: make(map[string]int32, len(currencies)),
+		types:      make(map[string]int32, len(types)),
+	}
+	for _, source := range sources {
+		if source.SourceCode.Valid {
+			lookups.sources[normalizeIngestKey(source.SourceCode.String)] = source.SourceID
+		}
+	}
+	for _, currency := range currencies {
+		lookups.currencies[normalizeIngestKey(currency.CurrencyCode)] = currency.CurrencyID
+	}
+	for _, rateType := range types {
+		lookups.types[normalizeIngestKey(rateType.TypeName)] = rateType.TypeID
+	}
+	return lookups, nil
+}
+
+func (l ingestLookups) resolve(row IngestExchangeRateRow, now time.Time) (db.RefreshExchangeRate, error) {
+	sourceID, ok := l.sources[normalizeIngestKey(row.SourceCode)]
+	if !ok {
+		return db.RefreshExchangeRate{}, Wrap(nil, ErrInvalidInput.Code, "unknown source_code")
+	}
+	sourceCurrencyID, ok := l.currencies[normalizeIngestKey(row.SourceCurrencyCode)]
+	if !ok {
+		return db.RefreshExchangeRate{}, Wrap(nil, ErrInvalidInput.Code, "unknown source_currency_code")
+	}
+	destinationCurrencyID, ok := l.currencies[normalizeIngestKey(row.DestinationCurrencyCode)]
+	if !ok {
+		return db.RefreshExchangeRate{}, Wrap(nil, ErrInvalidInput.Code, "unknown destination_currency_code")
+	}
+	if sourceCurrencyID == destinationCurrencyID {
+		return db.RefreshExchangeRate{}, Wrap(nil, ErrInvalidInput.Code, "source and destination currencies must be different")
+	}
+	typeID, ok := l.types[normalizeIngestKey(row.TypeName)]
+	if !ok {
+		return db.RefreshExchangeRate{}, Wrap(nil, ErrInvalidInput.Code, "unknown type_name")
+	}
+
+	validFromDate := row.ValidFromDate
+	if validFromDate.IsZero() {
+		validFromDate = now
+	}
+	rateValue := strings.TrimSpace(row.RateValue)
+	if err := validateExchangeRateValues(rateValue, sourceCurrencyID, destinationCurrencyID, validFromDate, time.Time{}); err != nil {
+		return db.RefreshExchangeRate{}, err
+	}
+	if value, err := parseDecimal(rateValue); err != nil || value <= 0 {
+		return db.RefreshExchangeRate{}, Wrap(nil, ErrInvalidInput.Code, "rate_value must be a positive decimal")
+	}
+
+	return db.RefreshExchangeRate{
+		RateValue:             rateValue,
+		SourceCurrencyID:      sourceCurrencyID,
+		DestinationCurrencyID: destinationCurrencyID,
+		ValidFromDate:         validFromDate,
+		SourceID:              sourceID,
+		TypeID:                typeID,
+	}, nil
+}
+
+func rejectedIngestRow(err error) IngestExchangeRateRowResult {
+	return IngestExchangeRateRowResult{Status: IngestStatusRejected, Reason: err.Error()}
+}
+
+func normalizeIngestKey(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func expectIngestLookups(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT source_id, source_name, source_link").
+		WillReturnRows(sqlmock.NewRows([]string{"source_id", "source_name", "source_link", "source_country", "source_status", "source_code"}).
+			AddRow(int32(10), "Vietcombank", nil, "VN", "active", "VCB"))
+	mock.ExpectQuery("SELECT currency_id, currency_code, currency_name FROM currencies").
+		WillReturnRows(sqlmock.NewRows([]string{"currency_id", "currency_code", "currency_name"}).
+			AddRow(int32(1), "VND", "Vietnamese Dong").
+			AddRow(int32(2), "USD", "US Dollar"))
+	mock.ExpectQuery("SELECT type_id, type_name FROM exchange_rate_types").
+		WillReturnRows(sqlmock.NewRows([]string{"type_id", "type_name"}).
+			AddRow(int32(4), "Sell Transfer"))
+}
+
+// expectIngestSeriesLock expects the transaction and advisory locks that precede
+// the ingest insert.
+func expectIngestSeriesLock(mock sqlmock.Sqlmock) {
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT pg_advisory_xact_lock").WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+// ingestAnomalyBaselineRows returns the baseline of the series validIngestExchangeRateRow belongs to.
+func ingestAnomalyBaselineRows(historyCount int64, mean, stddev, median string, peerCount int64, peerMedian string) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"source_id", "type_id", "source_currency_id", "destination_currency_id",
+		"history_count", "history_mean", "history_stddev", "history_median", "peer_count", "peer_median",
+	}).AddRow(int32(10), int32(4), int32(1), int32(2), historyCount, mean, stddev, median, peerCount, peerMedian)
+}
+
+// ingestedExchangeRate is the rate stored for validIngestExchangeRateRow.
+func ingestedExchangeRate() db.ExchangeRate {
+	rate := testQuoteExchangeRate()
+	rate.ValidFromDate = validIngestExchangeRateRow().ValidFromDate
+	rate.ValidToDate = sql.NullTime{}
+	return rate
+}
+
+func validIngestExchangeRateRow() IngestExchangeRateRow {
+	return IngestExchangeRateRow{
+		SourceCode:              "vcb",
+		SourceCurrencyCode:      "vnd",
+		DestinationCurrencyCode: "USD",
+		TypeName:                "sell transfer",
+		RateValue:               "25000",
+		ValidFromDate:           time.Date(2026, 5, 12, 9, 30, 0, 0, time.UTC),
+	}
+}
+
+func TestFXServiceIngestExchangeRatesEmptyBatch(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+
+	result, err := fxService.IngestExchangeRates(context.Background(), IngestExchangeRatesInput{})
+
+	requireFXServiceErrorCode(t, err, ErrInvalidInput.Code)
+	require.Empty(t, result)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceIngestExchangeRatesCountsEachOutcome(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	expectIngestLookups(mock)
+
+	inserted := ingestedExchangeRate()
+	replayed := validIngestExchangeRateRow()
+	replayed.ValidFromDate = replayed.ValidFromDate.Add(2 * time.Hour)
+	bucketStart := time.Date(2026, 5, 12, 8, 0, 0, 0, time.UTC)
+	// Every row of the series is checked against one baseline loaded for the whole batch.
+	mock.ExpectQuery("CROSS JOIN LATERAL").
+		WithArgs(pq.Array([]int32{10, 10, 10}), pq.Array([]int32{4, 4, 4}), pq.Array([]int32{1, 1, 1}), pq.Array([]int32{2, 2, 2}),
+			int32(anomalyHistorySize), sqlmock.AnyArg()).
+		WillReturnRows(ingestAnomalyBaselineRows(0, "0", "0", "0", 0, "0"))
+	expectIngestSeriesLock(mock)
+	// The second row shares the first one's bucket and is not sent; the replayed row's bucket
+	// already has a rate, so the insert skips it.
+	mock.ExpectQuery("INSERT INTO exchange_rates").
+		WithArgs(
+			pq.Array([]string{"25000", "25000"}),
+			pq.Array([]int32{1, 1}),
+			pq.Array([]int32{2, 2}),
+			pq.Array([]time.Time{validIngestExchangeRateRow().ValidFromDate, replayed.ValidFromDate}),
+			pq.Array([]int32{10, 10}),
+			pq.Array([]int32{4, 4}),
+			pq.Array([]string{ExchangeRateStatusActive, ExchangeRateStatusActive}),
+			pq.Array([]string{"", ""}),
+			pq.Array([]time.Time{bucketStart, bucketStart.Add(2 * time.Hour)}),
+			pq.Array([]time.Time{bucketStart.Add(2 * time.Hour), bucketStart.Add(4 * time.Hour)}),
+		).
+		WillReturnRows(exchangeRateRows(inserted))
+	mock.ExpectExec("UPDATE exchange_rates er").
+		WithArgs(pq.Array([]int32{10}), pq.Array([]int32{1}), pq.Array([]int32{2}), pq.Array([]int32{4})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	unknownType := validIngestExchangeRateRow()
+	unknownType.TypeName = "Buy Gold"
+	badValue := validIngestExchangeRateRow()
+	badValue.RateValue = "-1"
+
+	result, err := fxService.IngestExchangeRates(context.Background(), IngestExchangeRatesInput{
+		Rates: []IngestExchangeRateRow{validIngestExchangeRateRow(), validIngestExchangeRateRow(), replayed, unknownType, badValue},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, int32(1), result.Inserted)
+	require.Equal(t, int32(2), result.Duplicates)
+	require.Equal(t, int32(2), result.Rejected)
+	require.Len(t, result.Rows, 5)
+	require.Equal(t, IngestStatusInserted, result.Rows[0].Status)
+	require.Equal(t, inserted.RateID, *result.Rows[0].RateID)
+	require.Equal(t, IngestStatusDuplicate, result.Rows[1].Status)
+	require.Nil(t, result.Rows[1].RateID)
+	require.Equal(t, IngestStatusDuplicate, result.Rows[2].Status)
+	require.Equal(t, IngestStatusRejected, result.Rows[3].Status)
+	require.Equal(t, "unknown type_name", result.Rows[3].Reason)
+	require.Equal(t, int32(4), result.Rows[4].Index)
+	require.Equal(t, "rate_value must be a positive decimal", result.Rows[4].Reason)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceIngestExchangeRatesDBError(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	expectIngestLookups(mock)
+
+	mock.ExpectQuery("CROSS JOIN LATERAL").WillReturnRows(ingestAnomalyBaselineRows(0, "0", "0", "0", 0, "0"))
+	expectIngestSeriesLock(mock)
+	mock.ExpectQuery("INSERT INTO exchange_rates").
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	result, err := fxService.IngestExchangeRates(context.Background(), IngestExchangeRatesInput{
+		Rates: []IngestExchangeRateRow{validIngestExchangeRateRow()},
+	})
+
+	requireFXServiceErrorCode(t, err, ErrInternal.Code)
+	require.Empty(t, result)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,366 @@
+/*
+mfa service is responsible for a user's two-factor authentication.
+It provides methods for enrolling and confirming an authenticator app (TOTP),
+disabling it, and replacing the recovery codes that stand in for it.
+*/
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/totp"
+)
+
+const (
+	totpIssuer              = "Rate Pulse"
+	mfaChallengeDuration    = 5 * time.Minute
+	maxMFAChallengeAttempts = 5
+	recoveryCodeCount       = 10
+	recoveryCodeBytes       = 6
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type MFAService struct {
+	store      db.Store
+	totpCipher *totp.SecretCipher
+}
+
+func NewMFAService(store db.Store, totpCipher *totp.SecretCipher) *MFAService {
+	return &MFAService{store: store, totpCipher: totpCipher}
+}
+
+/*
+GetMFAStatus Service is responsible for reporting a user's two-factor settings.
+- TOTP counts as enabled once its enrolment is confirmed
+- Required is set for admins, who cannot sign in without it
+*/
+func (s *MFAService) GetMFAStatus(ctx context.Context, userID int32) (MFAStatus, error) {
+	user, err := getMFAUser(ctx, s.store, userID)
+	if err != nil {
+		return MFAStatus{}, err
+	}
+
+	status := MFAStatus{Required: mfaRequired(user)}
+	userTOTP, found, err := getUserTOTP(ctx, s.store, userID)
+	if err != nil {
+		return MFAStatus{}, err
+	}
+	if !found || !userTOTP.ConfirmedAt.Valid {
+		return status, nil
+	}
+
+	status.TOTPEnabled = true
+	status.RecoveryCodesRemaining, err = s.store.CountUnusedRecoveryCodes(ctx, userID)
+	if err != nil {
+		return MFAStatus{}, Wrap(err, ErrInternal.Code, "failed to count recovery codes")
+	}
+	return status, nil
+}
+
+/*
+EnrollTOTP Service is responsible for starting an authenticator app enrolment.
+- Generate a new secret, replacing a pending one, and store it encrypted
+- Return the secret and its otpauth:// URI for the QR code
+- Return ErrInvalidInput when TOTP is already enabled
+*/
+func (s *MFAService) EnrollTOTP(ctx context.Context, userID int32) (TOTPEnrollment, error) {
+	user, err := getMFAUser(ctx, s.store, userID)
+	if err != nil {
+		return TOTPEnrollment{}, err
+	}
+	return enrollTOTP(ctx, s.store, s.totpCipher, user)
+}
+
+/*
+ConfirmTOTP Service is responsible for enabling TOTP with a first code from the app.
+- Check the code against the pending secret
+- Confirm the enrolment and issue new recovery codes in one transaction
+- Return the plaintext recovery codes once
+*/
+func (s *MFAService) ConfirmTOTP(ctx context.Context, input MFACodeInput) (RecoveryCodes, error) {
+	if strings.TrimSpace(input.Code) == "" {
+		return RecoveryCodes{}, Wrap(nil, ErrInvalidInput.Code, "code is required")
+	}
+
+	userTOTP, found, err := getUserTOTP(ctx, s.store, input.UserID)
+	if err != nil {
+		return RecoveryCodes{}, err
+	}
+	if !found {
+		return RecoveryCodes{}, Wrap(nil, ErrInvalidInput.Code, "start a two-factor enrolment first")
+	}
+	if userTOTP.ConfirmedAt.Valid {
+		return RecoveryCodes{}, Wrap(nil, ErrInvalidInput.Code, "two-factor authentication is already enabled")
+	}
+
+	step, ok, err := checkTOTPCode(s.totpCipher, userTOTP, input.Code)
+	if err != nil {
+		return RecoveryCodes{}, err
+	}
+	if !ok {
+		return RecoveryCodes{}, Wrap(errors.New("invalid two-factor code"), ErrInvalidInput.Code, "invalid two-factor code")
+	}
+
+	codes, err := confirmTOTP(ctx, s.store, input.UserID, step)
+	if err != nil {
+		return RecoveryCodes{}, err
+	}
+	return RecoveryCodes{RecoveryCodes: codes}, nil
+}
+
+/*
+DisableTOTP Service is responsible for turning two-factor authentication off.
+- Return ErrForbidden for admins, for whom it is mandatory
+- Require a current TOTP code or an unused recovery code
+- Delete the secret and the recovery codes
+*/
+func (s *MFAService) DisableTOTP(ctx context.Context, input MFACodeInput) error {
+	if strings.TrimSpace(input.Code) == "" {
+		return Wrap(nil, ErrInvalidInput.Code, "code is required")
+	}
+
+	user, err := getMFAUser(ctx, s.store, input.UserID)
+	if err != nil {
+		return err
+	}
+	if mfaRequired(user) {
+		return Wrap(errors.New("mfa is mandatory for admins"), ErrForbidden.Code, "two-factor authentication is required for admins")
+	}
+
+	userTOTP, err := getConfirmedUserTOTP(ctx, s.store, input.UserID)
+	if err != nil {
+		return err
+	}
+	ok, err := verifyMFACode(ctx, s.store, s.totpCipher, userTOTP, input.Code, true)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return Wrap(errors.New("invalid two-factor code"), ErrInvalidInput.Code, "invalid two-factor code")
+	}
+
+	if err := s.store.DisableTOTPTx(ctx, input.UserID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Wrap(err, ErrInvalidInput.Code, "two-factor authentication is not enabled")
+		}
+		return Wrap(err, ErrInternal.Code, "failed to disable two-factor authentication")
+	}
+	return nil
+}
+
+/*
+RegenerateRecoveryCodes Service is responsible for replacing a user's recovery codes.
+- Require a current TOTP code; a recovery code cannot mint new ones
+- Replace every code, used or not, and return the new ones once
+*/
+func (s *MFAService) RegenerateRecoveryCodes(ctx context.Context, input MFACodeInput) (RecoveryCodes, error) {
+	if strings.TrimSpace(input.Code) == "" {
+		return RecoveryCodes{}, Wrap(nil, ErrInvalidInput.Code, "code is required")
+	}
+
+	userTOTP, err := getConfirmedUserTOTP(ctx, s.store, input.UserID)
+	if err != nil {
+		return RecoveryCodes{}, err
+	}
+	ok, err := verifyMFACode(ctx, s.store, s.totpCipher, userTOTP, input.Code, false)
+	if err != nil {
+		return RecoveryCodes{}, err
+	}
+	if !ok {
+		return RecoveryCodes{}, Wrap(errors.New("invalid two-factor code"), ErrInvalidInput.Code, "invalid two-factor code")
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return RecoveryCodes{}, Wrap(err, ErrInternal.Code, "failed to generate recovery codes")
+	}
+	err = s.store.ReplaceRecoveryCodesTx(ctx, db.ReplaceRecoveryCodesTxParams{
+		UserID:     input.UserID,
+		CodeHashes: hashes,
+	})
+	if err != nil {
+		return RecoveryCodes{}, Wrap(err, ErrInternal.Code, "failed to replace recovery codes")
+	}
+	return RecoveryCodes{RecoveryCodes: codes}, nil
+}
+
+// mfaRequired reports whether the user cannot sign in without two-factor authentication:
+// admins can delete users and payments, so a password alone is not enough.
+func mfaRequired(user db.User) bool {
+	return user.UserType.String == userTypeAdmin
+}
+
+func getMFAUser(ctx context.Context, store db.Store, userID int32) (db.User, error) {
+	if userID <= 0 {
+		return db.User{}, Wrap(nil, ErrUnauthorized.Code, "user_id is required")
+	}
+
+	user, err := store.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.User{}, Wrap(err, ErrNotFound.Code, "user not found")
+		}
+		return db.User{}, Wrap(err, ErrInternal.Code, "failed to get user")
+	}
+	return user, nil
+}
+
+// getUserTOTP returns the user's TOTP secret, confirmed or pending, and whether there is one.
+func getUserTOTP(ctx context.Context, store db.Store, userID int32) (db.UserTotp, bool, error) {
+	userTOTP, err := store.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.UserTotp{}, false, nil
+		}
+		return db.UserTotp{}, false, Wrap(err, ErrInternal.Code, "failed to get two-factor settings")
+	}
+	return userTOTP, true, nil
+}
+
+func getConfirmedUserTOTP(ctx context.Context, store db.Store, userID int32) (db.UserTotp, error) {
+	userTOTP, found, err := getUserTOTP(ctx, store, userID)
+	if err != nil {
+		return db.UserTotp{}, err
+	}
+	if !found || !userTOTP.ConfirmedAt.Valid {
+		return db.UserTotp{}, Wrap(nil, ErrInvalidInput.Code, "two-factor authentication is not enabled")
+	}
+	return userTOTP, nil
+}
+
+func enrollTOTP(ctx context.Context, store db.Store, totpCipher *totp.SecretCipher, user db.User) (TOTPEnrollment, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return TOTPEnrollment{}, Wrap(err, ErrInternal.Code, "failed to generate two-factor secret")
+	}
+	ciphertext, err := totpCipher.Encrypt(secret)
+	if err != nil {
+		return TOTPEnrollment{}, Wrap(err, ErrInternal.Code, "failed to encrypt two-factor secret")
+	}
+
+	_, err = store.UpsertUserTOTP(ctx, db.UpsertUserTOTPParams{
+		UserID:           user.UserID,
+		SecretCiphertext: ciphertext,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// The upsert skips confirmed secrets.
+			return TOTPEnrollment{}, Wrap(err, ErrInvalidInput.Code, "two-factor authentication is already enabled")
+		}
+		return TOTPEnrollment{}, Wrap(err, ErrInternal.Code, "failed to save two-factor secret")
+	}
+
+	return TOTPEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(secret, totpIssuer, user.Email),
+	}, nil
+}
+
+// confirmTOTP enables a pending secret whose code matched at step and returns the new
+// plaintext recovery codes.
+func confirmTOTP(ctx context.Context, store db.Store, userID int32, step int64) ([]string, error) {
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to generate recovery codes")
+	}
+
+	_, err = store.ConfirmTOTPTx(ctx, db.ConfirmTOTPTxParams{
+		UserID:             userID,
+		LastUsedStep:       step,
+		RecoveryCodeHashes: hashes,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, Wrap(err, ErrInvalidInput.Code, "two-factor authentication is already enabled")
+		}
+		return nil, Wrap(err, ErrInternal.Code, "failed to enable two-factor authentication")
+	}
+	return codes, nil
+}
+
+// checkTOTPCode matches code against the user's secret without recording it, and returns the
+// matched step. A step at or before the last accepted one does not match.
+func checkTOTPCode(totpCipher *totp.SecretCipher, userTOTP db.UserTotp, code string) (int64, bool, error) {
+	secret, err := totpCipher.Decrypt(userTOTP.SecretCiphertext)
+	if err != nil {
+		return 0, false, Wrap(err, ErrInternal.Code, "failed to decrypt two-factor secret")
+	}
+
+	step, ok := totp.Validate(code, secret, time.Now())
+	if !ok || step <= userTOTP.LastUsedStep {
+		return 0, false, nil
+	}
+	return step, true, nil
+}
+
+// verifyMFACode accepts a TOTP code of a confirmed secret or, with allowRecoveryCode, an unused
+// recovery code, and uses it up so the same code cannot be presented twice.
+func verifyMFACode(ctx context.Context, store db.Store, totpCipher *totp.SecretCipher, userTOTP db.UserTotp, code string, allowRecoveryCode bool) (bool, error) {
+	step, ok, err := checkTOTPCode(totpCipher, userTOTP, code)
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		updated, err := store.UpdateUserTOTPStep(ctx, db.UpdateUserTOTPStepParams{
+			UserID:       userTOTP.UserID,
+			LastUsedStep: step,
+		})
+		if err != nil {
+			return false, Wrap(err, ErrInternal.Code, "failed to record two-factor code")
+		}
+		// Zero rows means a concurrent request used this step first.
+		return updated == 1, nil
+	}
+	if !allowRecoveryCode {
+		return false, nil
+	}
+
+	used, err := store.UseRecoveryCode(ctx, db.UseRecoveryCodeParams{
+		UserID:   userTOTP.UserID,
+		CodeHash: hashRecoveryCode(code),
+	})
+	if err != nil {
+		return false, Wrap(err, ErrInternal.Code, "failed to use recovery code")
+	}
+	return used == 1, nil
+}
+
+// newRecoveryCodes returns recoveryCodeCount codes formatted as "xxxxx-xxxxx" and their hashes.
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		raw := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+		encoded := strings.ToLower(recoveryCodeEncoding.EncodeToString(raw))
+		codes[i] = encoded[:5] + "-" + encoded[5:]
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode ignores case, spaces and dashes so codes can be typed as printed. A plain
+// SHA-256 is enough: a code is only tried against its own user, behind attempt limits.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(code)))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// hashMFAToken is the SHA-256 the sign-in challenge token is looked up by.
+func hashMFAToken(mfaToken string) string {
+	sum := sha256.Sum256([]byte(mfaToken))
+	return hex.EncodeToString(sum[:])
+}
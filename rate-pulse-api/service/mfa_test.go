@@ -0,0 +1,544 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/ThanhVinhTong/rate-pulse/totp"
+	"github.com/ThanhVinhTong/rate-pulse/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// testTOTPSecret is the base32 of the RFC 6238 SHA1 seed "12345678901234567890".
+const testTOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func newTestMFAService(t *testing.T) (*MFAService, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = sqlDB.Close()
+	})
+
+	return NewMFAService(db.NewStore(sqlDB), newTestTOTPCipher(t)), mock
+}
+
+func testAdminUser() db.User {
+	user := testDBUserForUserService()
+	user.UserType = sql.NullString{String: userTypeAdmin, Valid: true}
+	user.EmailVerified = sql.NullBool{Bool: true, Valid: true}
+	return user
+}
+
+func testUserTOTP(t *testing.T, userID int32, confirmed bool) db.UserTotp {
+	t.Helper()
+
+	ciphertext, err := newTestTOTPCipher(t).Encrypt(testTOTPSecret)
+	require.NoError(t, err)
+
+	userTOTP := db.UserTotp{
+		UserID:           userID,
+		SecretCiphertext: ciphertext,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+	if confirmed {
+		userTOTP.ConfirmedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+	return userTOTP
+}
+
+func userTOTPRows(userTOTP db.UserTotp) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"user_id",
+		"secret_ciphertext",
+		"confirmed_at",
+		"last_used_step",
+		"created_at",
+		"updated_at",
+	}).AddRow(
+		userTOTP.UserID,
+		userTOTP.SecretCiphertext,
+		userTOTP.ConfirmedAt,
+		userTOTP.LastUsedStep,
+		userTOTP.CreatedAt,
+		userTOTP.UpdatedAt,
+	)
+}
+
+func mfaChallengeRows(challenge db.MfaChallenge) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"token_hash",
+		"user_id",
+		"attempts",
+		"consumed_at",
+		"created_at",
+		"expires_at",
+		"enrollment_required",
+	}).AddRow(
+		challenge.TokenHash,
+		challenge.UserID,
+		challenge.Attempts,
+		challenge.ConsumedAt,
+		challenge.CreatedAt,
+		challenge.ExpiresAt,
+		challenge.EnrollmentRequired,
+	)
+}
+
+func currentTOTPCode(t *testing.T) string {
+	t.Helper()
+
+	code, err := totp.GenerateCode(testTOTPSecret, time.Now())
+	require.NoError(t, err)
+	return code
+}
+
+func expectCreateSession(mock sqlmock.Sqlmock, userID int32) {
+	sessionID := uuid.New()
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO sessions").
+		WillReturnRows(sessionRows(db.Session{SessionID: sessionID, UserID: userID, ExpiresAt: time.Now().Add(time.Hour)}))
+	mock.ExpectQuery("INSERT INTO refresh_tokens").
+		WillReturnRows(refreshTokenRows(db.RefreshToken{TokenID: sessionID, SessionID: sessionID}))
+	mock.ExpectCommit()
+}
+
+// expectValidChallenge expects the lookup of an open challenge for mfaToken and of its user.
+func expectValidChallenge(mock sqlmock.Sqlmock, mfaToken string, user db.User, enrollmentRequired bool) {
+	mock.ExpectQuery("FROM mfa_challenges").
+		WithArgs(hashMFAToken(mfaToken)).
+		WillReturnRows(mfaChallengeRows(db.MfaChallenge{
+			TokenHash:          hashMFAToken(mfaToken),
+			UserID:             user.UserID,
+			CreatedAt:          time.Now(),
+			ExpiresAt:          time.Now().Add(mfaChallengeDuration),
+			EnrollmentRequired: enrollmentRequired,
+		}))
+	mock.ExpectQuery("FROM users").
+		WithArgs(user.UserID).
+		WillReturnRows(userRows(user))
+}
+
+// expectReservedAttempt expects an attempt of the open challenge for mfaToken to be taken and
+// the lookup of its user.
+func expectReservedAttempt(mock sqlmock.Sqlmock, mfaToken string, user db.User) {
+	mock.ExpectQuery("UPDATE mfa_challenges").
+		WithArgs(hashMFAToken(mfaToken), maxMFAChallengeAttempts).
+		WillReturnRows(mfaChallengeRows(db.MfaChallenge{
+			TokenHash: hashMFAToken(mfaToken),
+			UserID:    user.UserID,
+			Attempts:  1,
+			CreatedAt: time.Now(),
+			ExpiresAt: time.Now().Add(mfaChallengeDuration),
+		}))
+	mock.ExpectQuery("FROM users").
+		WithArgs(user.UserID).
+		WillReturnRows(userRows(user))
+}
+
+func TestAuthServiceSignInReturnsMFAChallenge(t *testing.T) {
+	const password = "correct horse battery staple"
+	hashedPassword, err := util.HashPassword(password)
+	require.NoError(t, err)
+
+	freeUser := testDBUserForUserService()
+	freeUser.EmailVerified = sql.NullBool{Bool: true, Valid: true}
+
+	testCases := []struct {
+		name               string
+		user               db.User
+		totp               string
+		enrollmentRequired bool
+	}{
+		{name: "AdminWithoutTOTP", user: testAdminUser(), enrollmentRequired: true},
+		{name: "AdminWithPendingTOTP", user: testAdminUser(), totp: "pending", enrollmentRequired: true},
+		{name: "AdminWithTOTP", user: testAdminUser(), totp: "confirmed"},
+		{name: "UserWithTOTP", user: freeUser, totp: "confirmed"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authService, mock, _, _ := newTestAuthService(t)
+			tc.user.Password = hashedPassword
+			tokenHash := &capturedArg{}
+
+			mock.ExpectQuery("SELECT user_id, username, email, password").
+				WillReturnRows(userRows(tc.user))
+			if tc.totp == "" {
+				mock.ExpectQuery("FROM user_totp").WillReturnError(sql.ErrNoRows)
+			} else {
+				mock.ExpectQuery("FROM user_totp").
+					WillReturnRows(userTOTPRows(testUserTOTP(t, tc.user.UserID, tc.totp == "confirmed")))
+			}
+			expiresAt := time.Now().Add(mfaChallengeDuration)
+			mock.ExpectQuery("INSERT INTO mfa_challenges").
+				WithArgs(tokenHash, tc.user.UserID, sqlmock.AnyArg(), tc.enrollmentRequired).
+				WillReturnRows(mfaChallengeRows(db.MfaChallenge{
+					UserID:             tc.user.UserID,
+					ExpiresAt:          expiresAt,
+					EnrollmentRequired: tc.enrollmentRequired,
+				}))
+
+			result, err := authService.SignIn(context.Background(), SignInInput{
+				Email:    tc.user.Email,
+				Password: password,
+			})
+
+			require.NoError(t, err)
+			require.True(t, result.MFARequired)
+			require.Equal(t, tc.enrollmentRequired, result.MFAEnrollmentRequired)
+			require.NotEmpty(t, result.MFAToken)
+			require.Equal(t, hashMFAToken(result.MFAToken), tokenHash.value)
+			require.Equal(t, expiresAt, result.MFATokenExpiresAt)
+			require.Empty(t, result.AccessToken)
+			require.Empty(t, result.RefreshToken)
+			require.Equal(t, tc.user.UserID, result.User.UserID)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAuthServiceCompleteSignInMFAWithTOTPCode(t *testing.T) {
+	authService, mock, tokenMaker, _ := newTestAuthService(t)
+	user := testAdminUser()
+
+	expectReservedAttempt(mock, "mfa-token", user)
+	mock.ExpectQuery("FROM user_totp").
+		WithArgs(user.UserID).
+		WillReturnRows(userTOTPRows(testUserTOTP(t, user.UserID, true)))
+	mock.ExpectExec("UPDATE user_totp").
+		WithArgs(sqlmock.AnyArg(), user.UserID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE mfa_challenges").
+		WithArgs(hashMFAToken("mfa-token"), maxMFAChallengeAttempts).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectCreateSession(mock, user.UserID)
+
+	result, err := authService.CompleteSignInMFA(context.Background(), CompleteSignInMFAInput{
+		MFAToken: "mfa-token",
+		Code:     currentTOTPCode(t),
+	})
+
+	require.NoError(t, err)
+	require.False(t, result.MFARequired)
+	require.Empty(t, result.RecoveryCodes)
+
+	payload, err := tokenMaker.VerifyToken(result.AccessToken, token.TokenTypeAccess)
+	require.NoError(t, err)
+	require.Equal(t, user.UserID, payload.UserID)
+	require.Equal(t, userTypeAdmin, payload.UserType)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthServiceCompleteSignInMFAWithRecoveryCode(t *testing.T) {
+	authService, mock, _, _ := newTestAuthService(t)
+	user := testAdminUser()
+
+	expectReservedAttempt(mock, "mfa-token", user)
+	mock.ExpectQuery("FROM user_totp").
+		WillReturnRows(userTOTPRows(testUserTOTP(t, user.UserID, true)))
+	mock.ExpectExec("UPDATE mfa_recovery_codes").
+		WithArgs(user.UserID, hashRecoveryCode("abcde-fghij")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE mfa_challenges").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectCreateSession(mock, user.UserID)
+
+	result, err := authService.CompleteSignInMFA(context.Background(), CompleteSignInMFAInput{
+		MFAToken: "mfa-token",
+		Code:     "ABCDE FGHIJ",
+	})
+
+	require.NoError(t, err)
+	require.NotEmpty(t, result.AccessToken)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthServiceCompleteSignInMFAConfirmsEnrollment(t *testing.T) {
+	authService, mock, _, _ := newTestAuthService(t)
+	user := testAdminUser()
+
+	expectReservedAttempt(mock, "mfa-token", user)
+	mock.ExpectQuery("FROM user_totp").
+		WillReturnRows(userTOTPRows(testUserTOTP(t, user.UserID, false)))
+	mock.ExpectExec("UPDATE mfa_challenges").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE user_totp").
+		WithArgs(sqlmock.AnyArg(), user.UserID).
+		WillReturnRows(userTOTPRows(testUserTOTP(t, user.UserID, true)))
+	mock.ExpectExec("DELETE FROM mfa_recovery_codes").
+		WithArgs(user.UserID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	for i := 0; i < recoveryCodeCount; i++ {
+		mock.ExpectExec("INSERT INTO mfa_recovery_codes").
+			WithArgs(user.UserID, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectCommit()
+	expectCreateSession(mock, user.UserID)
+
+	result, err := authService.CompleteSignInMFA(context.Background(), CompleteSignInMFAInput{
+		MFAToken: "mfa-token",
+		Code:     currentTOTPCode(t),
+	})
+
+	require.NoError(t, err)
+	require.NotEmpty(t, result.AccessToken)
+	require.Len(t, result.RecoveryCodes, recoveryCodeCount)
+	require.Regexp(t, "^[a-z2-7]{5}-[a-z2-7]{5}$", result.RecoveryCodes[0])
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// The attempt was taken before the code was checked, so a wrong code needs no further write.
+func TestAuthServiceCompleteSignInMFACountsFailedAttempts(t *testing.T) {
+	authService, mock, _, _ := newTestAuthService(t)
+	user := testAdminUser()
+
+	staleCode, err := totp.GenerateCode(testTOTPSecret, time.Now().Add(-10*totp.Period))
+	require.NoError(t, err)
+
+	expectReservedAttempt(mock, "mfa-token", user)
+	mock.ExpectQuery("FROM user_totp").
+		WillReturnRows(userTOTPRows(testUserTOTP(t, user.UserID, true)))
+	mock.ExpectExec("UPDATE mfa_recovery_codes").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	result, err := authService.CompleteSignInMFA(context.Background(), CompleteSignInMFAInput{
+		MFAToken: "mfa-token",
+		Code:     staleCode,
+	})
+
+	requireServiceErrorCode(t, err, ErrInvalidCredentials.Code)
+	require.Empty(t, result)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// A used, expired or exhausted challenge matches no row of the reservation UPDATE.
+func TestAuthServiceCompleteSignInMFARejectsClosedChallenges(t *testing.T) {
+	authService, mock, _, _ := newTestAuthService(t)
+
+	mock.ExpectQuery("UPDATE mfa_challenges").
+		WithArgs(hashMFAToken("mfa-token"), maxMFAChallengeAttempts).
+		WillReturnError(sql.ErrNoRows)
+
+	result, err := authService.CompleteSignInMFA(context.Background(), CompleteSignInMFAInput{
+		MFAToken: "mfa-token",
+		Code:     "123456",
+	})
+
+	requireServiceErrorCode(t, err, ErrUnauthorized.Code)
+	require.Empty(t, result)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthServiceEnrollSignInTOTPRejectsClosedChallenges(t *testing.T) {
+	testCases := []struct {
+		name      string
+		challenge *db.MfaChallenge
+	}{
+		{name: "Unknown"},
+		{name: "Consumed", challenge: &db.MfaChallenge{
+			ConsumedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			ExpiresAt:  time.Now().Add(time.Minute),
+		}},
+		{name: "Expired", challenge: &db.MfaChallenge{ExpiresAt: time.Now().Add(-time.Second)}},
+		{name: "TooManyAttempts", challenge: &db.MfaChallenge{
+			Attempts:  maxMFAChallengeAttempts,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authService, mock, _, _ := newTestAuthService(t)
+
+			if tc.challenge == nil {
+				mock.ExpectQuery("FROM mfa_challenges").WillReturnError(sql.ErrNoRows)
+			} else {
+				tc.challenge.UserID = 42
+				mock.ExpectQuery("FROM mfa_challenges").WillReturnRows(mfaChallengeRows(*tc.challenge))
+			}
+
+			enrollment, err := authService.EnrollSignInTOTP(context.Background(), EnrollSignInTOTPInput{MFAToken: "mfa-token"})
+
+			requireServiceErrorCode(t, err, ErrUnauthorized.Code)
+			require.Empty(t, enrollment)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAuthServiceEnrollSignInTOTP(t *testing.T) {
+	authService, mock, _, _ := newTestAuthService(t)
+	user := testAdminUser()
+	ciphertext := &capturedArg{}
+
+	expectValidChallenge(mock, "mfa-token", user, true)
+	mock.ExpectQuery("INSERT INTO user_totp").
+		WithArgs(user.UserID, ciphertext).
+		WillReturnRows(userTOTPRows(testUserTOTP(t, user.UserID, false)))
+
+	enrollment, err := authService.EnrollSignInTOTP(context.Background(), EnrollSignInTOTPInput{MFAToken: "mfa-token"})
+
+	require.NoError(t, err)
+	require.Contains(t, enrollment.ProvisioningURI, "otpauth://totp/Rate%20Pulse:test@example.com?")
+	require.Contains(t, enrollment.ProvisioningURI, "secret="+enrollment.Secret)
+
+	secret, err := newTestTOTPCipher(t).Decrypt(ciphertext.value.(string))
+	require.NoError(t, err)
+	require.Equal(t, enrollment.Secret, secret)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthServiceEnrollSignInTOTPRejectsChallengeWithoutEnrolment(t *testing.T) {
+	authService, mock, _, _ := newTestAuthService(t)
+	// A user whose TOTP is already enabled only gets a challenge for a code.
+	user := testAdminUser()
+
+	expectValidChallenge(mock, "mfa-token", user, false)
+
+	enrollment, err := authService.EnrollSignInTOTP(context.Background(), EnrollSignInTOTPInput{MFAToken: "mfa-token"})
+
+	requireServiceErrorCode(t, err, ErrForbidden.Code)
+	require.Empty(t, enrollment)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthServiceRenewAccessTokenRequiresTOTPForAdmins(t *testing.T) {
+	authService, mock, tokenMaker, _ := newTestAuthService(t)
+
+	refreshToken, refreshPayload, err := tokenMaker.CreateToken(42, "testuser", "test@example.com", "free", time.Hour, token.TokenTypeRefresh)
+	require.NoError(t, err)
+
+	mock.ExpectQuery("FROM refresh_tokens rt").
+		WillReturnRows(refreshTokenSessionRows(db.GetRefreshTokenSessionRow{
+			TokenID:      refreshPayload.ID,
+			SessionID:    uuid.New(),
+			UserID:       42,
+			RefreshToken: refreshToken,
+			IsBlocked:    sql.NullBool{Bool: false, Valid: true},
+			ExpiresAt:    time.Now().Add(time.Hour),
+		}))
+	// Promoted to admin after signing in.
+	mock.ExpectQuery("FROM users").WillReturnRows(userRows(testAdminUser()))
+	mock.ExpectQuery("FROM user_totp").WillReturnError(sql.ErrNoRows)
+
+	result, err := authService.RenewAccessToken(context.Background(), RenewAccessTokenInput{RefreshToken: refreshToken})
+
+	requireServiceErrorCode(t, err, ErrUnauthorized.Code)
+	require.Empty(t, result)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMFAServiceConfirmTOTP(t *testing.T) {
+	mfaService, mock := newTestMFAService(t)
+
+	mock.ExpectQuery("FROM user_totp").
+		WillReturnRows(userTOTPRows(testUserTOTP(t, 42, false)))
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE user_totp").
+		WithArgs(totp.Step(time.Now()), int32(42)).
+		WillReturnRows(userTOTPRows(testUserTOTP(t, 42, true)))
+	mock.ExpectExec("DELETE FROM mfa_recovery_codes").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	for i := 0; i < recoveryCodeCount; i++ {
+		mock.ExpectExec("INSERT INTO mfa_recovery_codes").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectCommit()
+
+	codes, err := mfaService.ConfirmTOTP(context.Background(), MFACodeInput{UserID: 42, Code: currentTOTPCode(t)})
+
+	require.NoError(t, err)
+	require.Len(t, codes.RecoveryCodes, recoveryCodeCount)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMFAServiceRejectsReplayedTOTPCode(t *testing.T) {
+	mfaService, mock := newTestMFAService(t)
+
+	userTOTP := testUserTOTP(t, 42, true)
+	userTOTP.LastUsedStep = totp.Step(time.Now()) + totp.Skew
+	mock.ExpectQuery("FROM user_totp").WillReturnRows(userTOTPRows(userTOTP))
+
+	codes, err := mfaService.RegenerateRecoveryCodes(context.Background(), MFACodeInput{UserID: 42, Code: currentTOTPCode(t)})
+
+	requireServiceErrorCode(t, err, ErrInvalidInput.Code)
+	require.Empty(t, codes)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMFAServiceDisableTOTP(t *testing.T) {
+	t.Run("ForbiddenForAdmins", func(t *testing.T) {
+		mfaService, mock := newTestMFAService(t)
+		mock.ExpectQuery("FROM users").WillReturnRows(userRows(testAdminUser()))
+
+		err := mfaService.DisableTOTP(context.Background(), MFACodeInput{UserID: 42, Code: currentTOTPCode(t)})
+
+		requireServiceErrorCode(t, err, ErrForbidden.Code)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("WithTOTPCode", func(t *testing.T) {
+		mfaService, mock := newTestMFAService(t)
+		mock.ExpectQuery("FROM users").WillReturnRows(userRows(testDBUserForUserService()))
+		mock.ExpectQuery("FROM user_totp").WillReturnRows(userTOTPRows(testUserTOTP(t, 42, true)))
+		mock.ExpectExec("UPDATE user_totp").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM user_totp").WithArgs(int32(42)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM mfa_recovery_codes").WithArgs(int32(42)).WillReturnResult(sqlmock.NewResult(0, 10))
+		mock.ExpectCommit()
+
+		err := mfaService.DisableTOTP(context.Background(), MFACodeInput{UserID: 42, Code: currentTOTPCode(t)})
+
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMFAServiceGetMFAStatus(t *testing.T) {
+	mfaService, mock := newTestMFAService(t)
+
+	mock.ExpectQuery("FROM users").WillReturnRows(userRows(testAdminUser()))
+	mock.ExpectQuery("FROM user_totp").WillReturnRows(userTOTPRows(testUserTOTP(t, 42, true)))
+	mock.ExpectQuery("FROM mfa_recovery_codes").
+		WithArgs(int32(42)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(7)))
+
+	status, err := mfaService.GetMFAStatus(context.Background(), 42)
+
+	require.NoError(t, err)
+	require.Equal(t, MFAStatus{TOTPEnabled: true, Required: true, RecoveryCodesRemaining: 7}, status)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHashRecoveryCodeIgnoresFormatting(t *testing.T) {
+	require.Equal(t, hashRecoveryCode("abcde-fghij"), hashRecoveryCode(" ABCDE FGHIJ "))
+	require.NotEqual(t, hashRecoveryCode("abcde-fghij"), hashRecoveryCode("abcde-fghik"))
+}
+
+// The MFA token is opaque: it must not pass as an access token.
+func TestMFATokenIsNotAnAccessToken(t *testing.T) {
+	authService, mock, tokenMaker, _ := newTestAuthService(t)
+	user := testAdminUser()
+
+	mock.ExpectQuery("INSERT INTO mfa_challenges").
+		WillReturnRows(mfaChallengeRows(db.MfaChallenge{UserID: user.UserID, ExpiresAt: time.Now().Add(mfaChallengeDuration)}))
+
+	result, err := authService.createMFAChallenge(context.Background(), user, true)
+	require.NoError(t, err)
+
+	_, err = tokenMaker.VerifyToken(result.MFAToken, token.TokenTypeAccess)
+	require.ErrorIs(t, err, token.ErrInvalidToken)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
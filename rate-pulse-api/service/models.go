@@ -3,6 +3,8 @@ package service
 import (
 	"time"
 
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/token"
 	"github.com/google/uuid"
 )
 
@@ -23,6 +25,16 @@ type User struct {
 	UpdatedAt          time.Time
 }
 
+/*
+pagination models
+*/
+// PageInput is the cursor pagination shared by every list use case; NextCursor is empty on the last page.
+type PageInput struct {
+	Cursor   string
+	PageSize int32
+	Sort     string
+}
+
 /*
 auth service models
 */
@@ -47,6 +59,15 @@ type SignInInput struct {
 
 type RenewAccessTokenInput struct {
 	RefreshToken string
+	UserAgent    string
+	ClientIP     string
+}
+
+// SignOutInput carries the refresh token of the session to end and, when the caller sent one,
+// its access token so that stops working too.
+type SignOutInput struct {
+	RefreshToken string
+	AccessToken  string
 }
 
 type VerifyEmailInput struct {
@@ -54,6 +75,35 @@ type VerifyEmailInput struct {
 	SecretCode string
 }
 
+type ResendVerifyEmailInput struct {
+	Email string
+}
+
+type RequestPasswordResetInput struct {
+	Email string
+}
+
+type ConfirmPasswordResetInput struct {
+	ResetID     int64
+	SecretCode  string
+	NewPassword string
+}
+
+// CompleteSignInMFAInput carries the challenge token of a sign-in and a TOTP or recovery code.
+type CompleteSignInMFAInput struct {
+	MFAToken  string
+	Code      string
+	UserAgent string
+	ClientIP  string
+}
+
+type EnrollSignInTOTPInput struct {
+	MFAToken string
+}
+
+// SignInResult either carries the session tokens or, when MFARequired is set, only the MFA
+// challenge token to complete the sign-in with. RecoveryCodes are set once, when the sign-in
+// also confirmed a TOTP enrolment.
 type SignInResult struct {
 	SessionID             uuid.UUID
 	AccessToken           string
@@ -61,11 +111,21 @@ type SignInResult struct {
 	RefreshToken          string
 	RefreshTokenExpiresAt time.Time
 	User                  User
+
+	MFARequired           bool
+	MFAEnrollmentRequired bool
+	MFAToken              string
+	MFATokenExpiresAt     time.Time
+	RecoveryCodes         []string
 }
 
+// RenewAccessTokenResult carries the rotated refresh token; the presented one is no longer valid.
 type RenewAccessTokenResult struct {
-	AccessToken          string
-	AccessTokenExpiresAt time.Time
+	SessionID             uuid.UUID
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
 }
 
 type VerifyEmailResult struct {
@@ -90,6 +150,25 @@ type DependencyHealth struct {
 	Message string
 }
 
+// RateSourceFreshness reports when a source last produced a rate and whether it is overdue.
+type RateSourceFreshness struct {
+	SourceID                int32      `json:"source_id"`
+	SourceName              string     `json:"source_name"`
+	SourceCode              string     `json:"source_code"`
+	SourceStatus            string     `json:"source_status"`
+	ExpectedIntervalMinutes int32      `json:"expected_interval_minutes"`
+	LastIngestedAt          *time.Time `json:"last_ingested_at"`
+	StaleAt                 time.Time  `json:"stale_at"`
+	Stale                   bool       `json:"stale"`
+}
+
+type RateSourceFreshnessReport struct {
+	Status     string                `json:"status"`
+	CheckedAt  time.Time             `json:"checked_at"`
+	StaleCount int32                 `json:"stale_count"`
+	Sources    []RateSourceFreshness `json:"sources"`
+}
+
 /*
 user service models
 */
@@ -98,8 +177,16 @@ type GetUserInput struct {
 }
 
 type ListUsersInput struct {
-	PageID   int32
-	PageSize int32
+	Page        PageInput
+	UserType    *string
+	IsActive    *bool
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+type UserPage struct {
+	Users      []User
+	NextCursor string
 }
 
 type UpdateUserInput struct {
@@ -149,6 +236,8 @@ type ExchangeRate struct {
 	TypeID                int32
 	CreatedAt             time.Time
 	UpdatedAt             time.Time
+	Status                string
+	QuarantineReason      string
 }
 
 type LatestExchangeRate struct {
@@ -202,6 +291,17 @@ type DeleteExchangeRateInput struct {
 	RateID int32
 }
 
+type ListQuarantinedExchangeRatesInput struct {
+	PageID   int32
+	PageSize int32
+}
+
+// ReviewQuarantinedExchangeRateInput resolves a quarantined rate; action is approve or reject.
+type ReviewQuarantinedExchangeRateInput struct {
+	RateID int32
+	Action string
+}
+
 type GetHistoricalDataInput struct {
 	SourceCurrencyID      int32
 	DestinationCurrencyID int32
@@ -209,6 +309,205 @@ type GetHistoricalDataInput struct {
 	TypeID                int32
 	TimeRange             string
 	DataPoints            int32
+	From                  string
+	To                    string
+	TimeZone              string
+	Interval              string
+	UserID                int32
+	UserType              string
+}
+
+type Candle struct {
+	BucketStart time.Time `json:"bucket_start"`
+	Open        string    `json:"open"`
+	High        string    `json:"high"`
+	Low         string    `json:"low"`
+	Close       string    `json:"close"`
+	SampleCount int64     `json:"sample_count"`
+}
+
+type GetCandlesInput struct {
+	SourceCurrencyID      int32
+	DestinationCurrencyID int32
+	SourceID              int32
+	TypeID                int32
+	Interval              string
+	TimeRange             string
+	Limit                 int32
+}
+
+/*
+fx quote service models
+*/
+type QuoteInput struct {
+	Amount          string
+	FromCurrencyID  int32
+	ToCurrencyID    int32
+	SourceID        int32
+	TypeID          int32
+	TransactionType string
+	Channel         string
+	EffectiveDate   time.Time
+}
+
+type Quote struct {
+	Amount           string     `json:"amount"`
+	FromCurrencyID   int32      `json:"from_currency_id"`
+	ToCurrencyID     int32      `json:"to_currency_id"`
+	SourceID         int32      `json:"source_id"`
+	TypeID           int32      `json:"type_id"`
+	TransactionType  string     `json:"transaction_type"`
+	Channel          string     `json:"channel"`
+	RateID           int32      `json:"rate_id"`
+	AppliedRate      string     `json:"applied_rate"`
+	GrossAmount      string     `json:"gross_amount"`
+	FeeRuleID        *int32     `json:"fee_rule_id"`
+	FeeRate          string     `json:"fee_rate"`
+	PercentageFee    string     `json:"percentage_fee"`
+	FixedFee         string     `json:"fixed_fee"`
+	VatAmount        string     `json:"vat_amount"`
+	VatIncluded      bool       `json:"vat_included"`
+	SwiftFee         string     `json:"swift_fee"`
+	SwiftFeeIncluded bool       `json:"swift_fee_included"`
+	TotalFees        string     `json:"total_fees"`
+	NetAmount        string     `json:"net_amount"`
+	EffectiveRate    string     `json:"effective_rate"`
+	RateUpdatedAt    *time.Time `json:"rate_updated_at"`
+}
+
+type CompareQuotesInput struct {
+	Amount          string
+	FromCurrencyID  int32
+	ToCurrencyID    int32
+	TypeID          int32
+	TransactionType string
+	Channel         string
+	EffectiveDate   time.Time
+}
+
+type RankedQuote struct {
+	Rank       int32  `json:"rank"`
+	SourceName string `json:"source_name"`
+	SourceCode string `json:"source_code"`
+	Quote
+}
+
+/*
+fx cross rate service models
+*/
+type CrossRateInput struct {
+	FromCurrencyID  int32
+	ToCurrencyID    int32
+	SourceID        int32
+	TypeID          int32
+	PivotCurrencyID *int32
+}
+
+// CrossRateLeg is one stored rate used on the path, expressed as to_currency units per 1 from_currency.
+// inverted is true when the source publishes the pair in the opposite direction.
+type CrossRateLeg struct {
+	RateID         int32      `json:"rate_id"`
+	FromCurrencyID int32      `json:"from_currency_id"`
+	ToCurrencyID   int32      `json:"to_currency_id"`
+	Rate           string     `json:"rate"`
+	Inverted       bool       `json:"inverted"`
+	RateUpdatedAt  *time.Time `json:"rate_updated_at"`
+}
+
+type CrossRate struct {
+	FromCurrencyID  int32          `json:"from_currency_id"`
+	ToCurrencyID    int32          `json:"to_currency_id"`
+	SourceID        int32          `json:"source_id"`
+	TypeID          int32          `json:"type_id"`
+	Rate            string         `json:"rate"`
+	Direct          bool           `json:"direct"`
+	PivotCurrencyID *int32         `json:"pivot_currency_id"`
+	Path            []int32        `json:"path"`
+	Legs            []CrossRateLeg `json:"legs"`
+}
+
+/*
+fx spread service models
+*/
+type GetSpreadsInput struct {
+	SourceCurrencyID      int32
+	DestinationCurrencyID int32
+	SourceID              int32
+	Channel               string
+	TimeRange             string
+	Interval              string
+}
+
+// SpreadValues relates a buy and sell rate; spread_bps is the spread relative to the mid rate.
+type SpreadValues struct {
+	BuyRate   string `json:"buy_rate"`
+	SellRate  string `json:"sell_rate"`
+	MidRate   string `json:"mid_rate"`
+	Spread    string `json:"spread"`
+	SpreadBps string `json:"spread_bps"`
+}
+
+type RateSpread struct {
+	Rank       int32  `json:"rank"`
+	SourceID   int32  `json:"source_id"`
+	SourceName string `json:"source_name"`
+	SourceCode string `json:"source_code"`
+	Channel    string `json:"channel"`
+	BuyTypeID  int32  `json:"buy_type_id"`
+	SellTypeID int32  `json:"sell_type_id"`
+	BuyRateID  int32  `json:"buy_rate_id"`
+	SellRateID int32  `json:"sell_rate_id"`
+	SpreadValues
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+type SpreadPoint struct {
+	BucketStart time.Time `json:"bucket_start"`
+	SpreadValues
+}
+
+type SpreadSeries struct {
+	SourceID int32         `json:"source_id"`
+	Channel  string        `json:"channel"`
+	Points   []SpreadPoint `json:"points"`
+}
+
+type ExchangeRateSpreads struct {
+	SourceCurrencyID      int32          `json:"source_currency_id"`
+	DestinationCurrencyID int32          `json:"destination_currency_id"`
+	Spreads               []RateSpread   `json:"spreads"`
+	Series                []SpreadSeries `json:"series,omitempty"`
+}
+
+/*
+exchange rate ingestion service models
+*/
+type IngestExchangeRateRow struct {
+	SourceCode              string
+	SourceCurrencyCode      string
+	DestinationCurrencyCode string
+	TypeName                string
+	RateValue               string
+	ValidFromDate           time.Time
+}
+
+type IngestExchangeRatesInput struct {
+	Rates []IngestExchangeRateRow
+}
+
+type IngestExchangeRateRowResult struct {
+	Index  int32  `json:"index"`
+	Status string `json:"status"`
+	RateID *int32 `json:"rate_id,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type IngestExchangeRatesResult struct {
+	Inserted    int32                         `json:"inserted"`
+	Quarantined int32                         `json:"quarantined"`
+	Duplicates  int32                         `json:"duplicates"`
+	Rejected    int32                         `json:"rejected"`
+	Rows        []IngestExchangeRateRowResult `json:"rows"`
 }
 
 /*
@@ -270,10 +569,17 @@ type GetRateSourceFeeRuleInput struct {
 }
 
 type ListRateSourceFeeRulesInput struct {
+	Page     PageInput
 	SourceID *int32
+	TypeID   *int32
 	ActiveOn *time.Time
 }
 
+type RateSourceFeeRulePage struct {
+	FeeRules   []RateSourceFeeRule `json:"fee_rules"`
+	NextCursor string              `json:"next_cursor"`
+}
+
 type GetActiveRateSourceFeeRuleInput struct {
 	SourceID        int32
 	TypeID          int32
@@ -310,3 +616,426 @@ type UpdateRateSourceFeeRuleInput struct {
 type DeleteRateSourceFeeRuleInput struct {
 	FeeRuleID int32
 }
+
+/*
+rate alert service models
+*/
+type RateAlert struct {
+	AlertID         int32      `json:"alert_id"`
+	UserID          int32      `json:"user_id"`
+	SourceID        int32      `json:"source_id"`
+	TypeID          int32      `json:"type_id"`
+	FromCurrencyID  int32      `json:"from_currency_id"`
+	ToCurrencyID    int32      `json:"to_currency_id"`
+	Condition       string     `json:"condition"`
+	Threshold       *string    `json:"threshold"`
+	ChangePercent   *string    `json:"change_percent"`
+	WindowHours     int32      `json:"window_hours"`
+	IsActive        bool       `json:"is_active"`
+	LastRateValue   *string    `json:"last_rate_value"`
+	LastTriggeredAt *time.Time `json:"last_triggered_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+type CreateRateAlertInput struct {
+	UserID         int32
+	SourceID       int32
+	TypeID         int32
+	FromCurrencyID int32
+	ToCurrencyID   int32
+	Condition      string
+	Threshold      *string
+	ChangePercent  *string
+	WindowHours    int32
+}
+
+type GetRateAlertInput struct {
+	UserID  int32
+	AlertID int32
+}
+
+type ListRateAlertsInput struct {
+	UserID   int32
+	PageID   int32
+	PageSize int32
+}
+
+type UpdateRateAlertInput struct {
+	UserID        int32
+	AlertID       int32
+	Condition     *string
+	Threshold     *string
+	ChangePercent *string
+	WindowHours   *int32
+	IsActive      *bool
+}
+
+type DeleteRateAlertInput struct {
+	UserID  int32
+	AlertID int32
+}
+
+/*
+payment service models
+*/
+type ListPaymentsInput struct {
+	Page           PageInput
+	Status         *string
+	SubscriptionID *int32
+	From           *time.Time
+	To             *time.Time
+}
+
+type PaymentPage struct {
+	Payments   []db.Payment `json:"payments"`
+	NextCursor string       `json:"next_cursor"`
+}
+
+type Payment struct {
+	PaymentID      int32      `json:"payment_id"`
+	SubscriptionID int32      `json:"subscription_id"`
+	TransactionID  *string    `json:"transaction_id"`
+	Amount         string     `json:"amount"`
+	CurrencyCode   string     `json:"currency_code"`
+	PaymentMethod  *string    `json:"payment_method"`
+	PaymentStatus  string     `json:"payment_status"`
+	PaymentDate    *time.Time `json:"payment_date"`
+	CreatedAt      *time.Time `json:"created_at"`
+	UpdatedAt      *time.Time `json:"updated_at"`
+}
+
+type CreatePaymentInput struct {
+	SubscriptionID int32
+	TransactionID  *string
+	Amount         string
+	CurrencyCode   string
+	PaymentMethod  *string
+	PaymentStatus  *string
+	PaymentDate    *time.Time
+}
+
+type UpdatePaymentInput struct {
+	PaymentID      int32
+	SubscriptionID *int32
+	TransactionID  *string
+	Amount         *string
+	CurrencyCode   *string
+	PaymentMethod  *string
+	PaymentStatus  *string
+	PaymentDate    *time.Time
+}
+
+/*
+user subscription service models
+*/
+type ListUserSubscriptionsInput struct {
+	Page   PageInput
+	Status *string
+	PlanID *int32
+	From   *time.Time
+	To     *time.Time
+}
+
+type UserSubscriptionPage struct {
+	Subscriptions []db.UserSubscription `json:"subscriptions"`
+	NextCursor    string                `json:"next_cursor"`
+}
+
+type UserSubscription struct {
+	SubscriptionID int32      `json:"subscription_id"`
+	UserID         int32      `json:"user_id"`
+	PlanID         int32      `json:"plan_id"`
+	Status         string     `json:"status"`
+	StartDate      time.Time  `json:"start_date"`
+	EndDate        *time.Time `json:"end_date"`
+	AutoRenew      bool       `json:"auto_renew"`
+	CreatedAt      *time.Time `json:"created_at"`
+	UpdatedAt      *time.Time `json:"updated_at"`
+}
+
+type CreateUserSubscriptionInput struct {
+	UserID    int32
+	PlanID    int32
+	Status    *string
+	StartDate *time.Time
+	EndDate   *time.Time
+	AutoRenew *bool
+}
+
+type UpdateUserSubscriptionInput struct {
+	SubscriptionID int32
+	PlanID         *int32
+	Status         *string
+	StartDate      *time.Time
+	EndDate        *time.Time
+	AutoRenew      *bool
+}
+
+/*
+rate source service models
+*/
+type ListRateSourcesInput struct {
+	Page    PageInput
+	Status  *string
+	Country *string
+}
+
+type RateSourcePage struct {
+	RateSources []db.ListRateSourcesPageRow `json:"rate_sources"`
+	NextCursor  string                      `json:"next_cursor"`
+}
+
+type RateSource struct {
+	SourceID      int32   `json:"source_id"`
+	SourceName    string  `json:"source_name"`
+	SourceLink    *string `json:"source_link"`
+	SourceCountry *string `json:"source_country"`
+	SourceStatus  *string `json:"source_status"`
+	SourceCode    *string `json:"source_code"`
+}
+
+type CreateRateSourceInput struct {
+	SourceName    string
+	SourceLink    string
+	SourceCountry string
+	SourceStatus  string
+	SourceCode    string
+}
+
+// UpdateRateSourceInput changes only the fields that are set.
+type UpdateRateSourceInput struct {
+	SourceID                int32
+	SourceName              *string
+	SourceLink              *string
+	SourceCountry           *string
+	SourceStatus            *string
+	SourceCode              *string
+	ExpectedIntervalMinutes *int32
+}
+
+/*
+currency service models
+*/
+type Currency struct {
+	CurrencyID     int32   `json:"currency_id"`
+	CurrencyCode   string  `json:"currency_code"`
+	CurrencyName   string  `json:"currency_name"`
+	CurrencySymbol *string `json:"currency_symbol"`
+}
+
+type CreateCurrencyInput struct {
+	CurrencyCode   string
+	CurrencyName   string
+	CurrencySymbol string
+}
+
+// UpdateCurrencyInput changes only the fields that are set.
+type UpdateCurrencyInput struct {
+	CurrencyID     int32
+	CurrencyCode   *string
+	CurrencyName   *string
+	CurrencySymbol *string
+}
+
+/*
+country service models
+*/
+type Country struct {
+	CountryID   int32      `json:"country_id"`
+	CountryName string     `json:"country_name"`
+	CountryCode *string    `json:"country_code"`
+	CurrencyID  int32      `json:"currency_id"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+	CreatedAt   *time.Time `json:"created_at"`
+}
+
+type CreateCountryInput struct {
+	CountryName string
+	CountryCode string
+	CurrencyID  int32
+}
+
+// UpdateCountryInput changes only the fields that are set.
+type UpdateCountryInput struct {
+	CountryID   int32
+	CountryName *string
+	CountryCode *string
+	CurrencyID  *int32
+}
+
+/*
+subscription plan service models
+*/
+type SubscriptionPlan struct {
+	PlanID          int32      `json:"plan_id"`
+	PlanName        string     `json:"plan_name"`
+	PlanPrice       string     `json:"plan_price"`
+	HistoricalDays  int32      `json:"historical_days"`
+	RateLimitPerDay int32      `json:"rate_limit_per_day"`
+	Features        *string    `json:"features"`
+	IsActive        bool       `json:"is_active"`
+	CreatedAt       *time.Time `json:"created_at"`
+	UpdatedAt       *time.Time `json:"updated_at"`
+}
+
+type CreateSubscriptionPlanInput struct {
+	PlanName        string
+	PlanPrice       string
+	HistoricalDays  int32
+	RateLimitPerDay int32
+	Features        *string
+	IsActive        *bool
+}
+
+type UpdateSubscriptionPlanInput struct {
+	PlanID          int32
+	PlanName        *string
+	PlanPrice       *string
+	HistoricalDays  *int32
+	RateLimitPerDay *int32
+	Features        *string
+	IsActive        *bool
+}
+
+/*
+preference service models
+*/
+type CurrencyPreference struct {
+	CurrencyID   int32      `json:"currency_id"`
+	UserID       int32      `json:"user_id"`
+	IsFavorite   bool       `json:"is_favorite"`
+	DisplayOrder *int32     `json:"display_order"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+	CreatedAt    *time.Time `json:"created_at"`
+}
+
+type RateSourcePreference struct {
+	SourceID  int32      `json:"source_id"`
+	UserID    int32      `json:"user_id"`
+	IsPrimary bool       `json:"is_primary"`
+	UpdatedAt *time.Time `json:"updated_at"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+type ListPreferencesInput struct {
+	UserID   int32
+	PageID   int32
+	PageSize int32
+}
+
+type CurrencyPreferenceInput struct {
+	UserID       int32
+	CurrencyID   int32
+	IsFavorite   *bool
+	DisplayOrder *int32
+}
+
+type RateSourcePreferenceInput struct {
+	UserID    int32
+	SourceID  int32
+	IsPrimary *bool
+}
+
+/*
+api key service models
+*/
+type APIKey struct {
+	APIKeyID   int32      `json:"api_key_id"`
+	Name       string     `json:"name"`
+	KeyPrefix  string     `json:"key_prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type CreateAPIKeyInput struct {
+	UserID    int32
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time
+}
+
+// CreatedAPIKey carries the plaintext key, which is only ever returned by CreateAPIKey.
+type CreatedAPIKey struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+type RevokeAPIKeyInput struct {
+	UserID   int32
+	APIKeyID int32
+}
+
+// APIKeyPrincipal is the caller behind an authenticated API key; Scopes are the ones still granted.
+type APIKeyPrincipal struct {
+	APIKeyID int32
+	Payload  *token.Payload
+	Scopes   []string
+}
+
+type QuotaInput struct {
+	UserID   int32
+	UserType string
+}
+
+// Usage reports a caller's plan limits and today's metered requests. The limits are null for
+// admins, who are not metered.
+type Usage struct {
+	PlanID            *int32    `json:"plan_id"`
+	PlanName          string    `json:"plan_name"`
+	Date              string    `json:"date"`
+	RequestsUsed      int32     `json:"requests_used"`
+	DailyLimit        *int32    `json:"daily_limit"`
+	RequestsRemaining *int32    `json:"requests_remaining"`
+	HistoricalDays    *int32    `json:"historical_days"`
+	ResetsAt          time.Time `json:"resets_at"`
+}
+
+/*
+session service models
+*/
+type Session struct {
+	SessionID  uuid.UUID `json:"session_id"`
+	UserAgent  string    `json:"user_agent"`
+	ClientIP   string    `json:"client_ip"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	CreatedAt  time.Time `json:"created_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+type RevokeSessionInput struct {
+	UserID    int32
+	SessionID uuid.UUID
+}
+
+type RevokedSessions struct {
+	Revoked int64 `json:"revoked"`
+}
+
+/*
+mfa service models
+*/
+type MFAStatus struct {
+	TOTPEnabled            bool  `json:"totp_enabled"`
+	Required               bool  `json:"required"`
+	RecoveryCodesRemaining int64 `json:"recovery_codes_remaining"`
+}
+
+// TOTPEnrollment is a pending authenticator secret; ProvisioningURI is rendered as a QR code.
+type TOTPEnrollment struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type RecoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// MFACodeInput carries a TOTP code, or a recovery code where one is accepted, of the user.
+type MFACodeInput struct {
+	UserID int32
+	Code   string
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// pageCursorDateLayout keeps DATE sort keys (effective_from) at day precision in the cursor.
+const pageCursorDateLayout = "2006-01-02"
+
+/*
+pageCursor is the decoded form of the opaque cursor returned as next_cursor.
+- Sort pins the cursor to the sort it was issued for
+- Value is the sort key of the last row (RFC 3339 time, date or text); empty when sorting by id
+- ID breaks ties between rows with the same sort key
+*/
+type pageCursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v,omitempty"`
+	ID    int32  `json:"id"`
+}
+
+type pageSort struct {
+	Key  string
+	Desc bool
+}
+
+func (sort pageSort) String() string {
+	if sort.Desc {
+		return "-" + sort.Key
+	}
+	return sort.Key
+}
+
+// pageQuery is a validated PageInput ready to be turned into keyset query params.
+type pageQuery struct {
+	sort     pageSort
+	pageSize int32
+	after    *pageCursor
+}
+
+/*
+newPageQuery validates the cursor, page size and sort shared by every list use case.
+- An empty sort uses defaultSort; a leading "-" sorts descending
+- page_size defaults to DefaultPageSize and must be between 1 and MaxPageSize
+- A cursor must decode and must have been issued for the same sort
+*/
+func newPageQuery(input PageInput, defaultSort string, sortKeys ...string) (pageQuery, error) {
+	sortValue := strings.TrimSpace(input.Sort)
+	if sortValue == "" {
+		sortValue = defaultSort
+	}
+	sort := pageSort{Key: strings.TrimPrefix(sortValue, "-"), Desc: strings.HasPrefix(sortValue, "-")}
+	if !slices.Contains(sortKeys, sort.Key) {
+		return pageQuery{}, Wrap(nil, ErrInvalidInput.Code, fmt.Sprintf("sort must be one of %s, optionally prefixed with -", strings.Join(sortKeys, ", ")))
+	}
+
+	pageSize := input.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+	if pageSize < 1 || pageSize > MaxPageSize {
+		return pageQuery{}, Wrap(nil, ErrInvalidInput.Code, fmt.Sprintf("page_size must be between 1 and %d", MaxPageSize))
+	}
+
+	query := pageQuery{sort: sort, pageSize: pageSize}
+	if input.Cursor != "" {
+		cursor, err := decodePageCursor(input.Cursor)
+		if err != nil {
+			return pageQuery{}, Wrap(err, ErrInvalidInput.Code, "cursor is invalid")
+		}
+		if cursor.Sort != sort.String() {
+			return pageQuery{}, Wrap(nil, ErrInvalidInput.Code, "cursor was issued for a different sort")
+		}
+		query.after = &cursor
+	}
+	return query, nil
+}
+
+// limit fetches one extra row so the page knows whether another one follows.
+func (query pageQuery) limit() int32 {
+	return query.pageSize + 1
+}
+
+func (query pageQuery) afterID() sql.NullInt32 {
+	if query.after == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: query.after.ID, Valid: true}
+}
+
+func (query pageQuery) afterTime() (sql.NullTime, error) {
+	if query.after == nil || query.after.Value == "" {
+		return sql.NullTime{}, nil
+	}
+	value, err := time.Parse(time.RFC3339Nano, query.after.Value)
+	if err != nil {
+		return sql.NullTime{}, Wrap(err, ErrInvalidInput.Code, "cursor is invalid")
+	}
+	return sql.NullTime{Time: value, Valid: true}, nil
+}
+
+func (query pageQuery) afterDate() (sql.NullTime, error) {
+	if query.after == nil || query.after.Value == "" {
+		return sql.NullTime{}, nil
+	}
+	value, err := time.Parse(pageCursorDateLayout, query.after.Value)
+	if err != nil {
+		return sql.NullTime{}, Wrap(err, ErrInvalidInput.Code, "cursor is invalid")
+	}
+	return sql.NullTime{Time: value, Valid: true}, nil
+}
+
+func (query pageQuery) afterText() sql.NullString {
+	if query.after == nil || query.after.Value == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: query.after.Value, Valid: true}
+}
+
+// cursor builds the cursor that resumes after a row with the given sort value and id.
+func (query pageQuery) cursor(value string, id int32) string {
+	return encodePageCursor(pageCursor{Sort: query.sort.String(), Value: value, ID: id})
+}
+
+// trimPage drops the extra row fetched by limit and reports whether there was one.
+func trimPage[T any](query pageQuery, rows []T) ([]T, bool) {
+	if int32(len(rows)) <= query.pageSize {
+		return rows, false
+	}
+	return rows[:query.pageSize], true
+}
+
+// cursorTime formats a nullable timestamp the way the keyset queries COALESCE it.
+func cursorTime(value sql.NullTime) string {
+	if !value.Valid {
+		return time.Unix(0, 0).UTC().Format(time.RFC3339Nano)
+	}
+	return value.Time.UTC().Format(time.RFC3339Nano)
+}
+
+// validateDateRange rejects a range filter whose start is not before its end.
+func validateDateRange(fromField string, from *time.Time, toField string, to *time.Time) error {
+	if from != nil && to != nil && !from.Before(*to) {
+		return Wrap(nil, ErrInvalidInput.Code, fmt.Sprintf("%s must be before %s", fromField, toField))
+	}
+	return nil
+}
+
+func encodePageCursor(cursor pageCursor) string {
+	payload, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func decodePageCursor(value string) (pageCursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return pageCursor{}, err
+	}
+	var cursor pageCursor
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return pageCursor{}, err
+	}
+	if cursor.ID <= 0 {
+		return pageCursor{}, fmt.Errorf("cursor id must be greater than 0")
+	}
+	return cursor, nil
+}
+
+// optionalTimestamp keeps the full instant, unlike optionalTime which truncates to the day.
+func optionalTimestamp(value *time.Time) sql.NullTime {
+	if value == nil || value.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *value, Valid: true}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPageQueryDefaults(t *testing.T) {
+	query, err := newPageQuery(PageInput{}, "-payment_date", "payment_date", "payment_id")
+
+	require.NoError(t, err)
+	require.Equal(t, pageSort{Key: "payment_date", Desc: true}, query.sort)
+	require.Equal(t, int32(DefaultPageSize+1), query.limit())
+	require.False(t, query.afterID().Valid)
+}
+
+func TestNewPageQueryRejectsInvalidInput(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input PageInput
+	}{
+		{name: "UnknownSort", input: PageInput{Sort: "amount"}},
+		{name: "PageSizeTooLarge", input: PageInput{PageSize: MaxPageSize + 1}},
+		{name: "NegativePageSize", input: PageInput{PageSize: -1}},
+		{name: "MalformedCursor", input: PageInput{Cursor: "not a cursor"}},
+		{name: "CursorForOtherSort", input: PageInput{Sort: "payment_id", Cursor: encodePageCursor(pageCursor{Sort: "-payment_date", ID: 1})}},
+		{name: "CursorWithoutID", input: PageInput{Cursor: encodePageCursor(pageCursor{Sort: "-payment_date"})}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newPageQuery(tc.input, "-payment_date", "payment_date", "payment_id")
+			require.Error(t, err)
+			require.Equal(t, ErrInvalidInput.Code, ServiceErrorCode(err))
+		})
+	}
+}
+
+func TestPageCursorRoundTrip(t *testing.T) {
+	first, err := newPageQuery(PageInput{PageSize: 2}, "-payment_date", "payment_date", "payment_id")
+	require.NoError(t, err)
+
+	paymentDate := time.Date(2026, 3, 4, 5, 6, 7, 8, time.UTC)
+	rows, hasMore := trimPage(first, []int{1, 2, 3})
+	require.True(t, hasMore)
+	require.Equal(t, []int{1, 2}, rows)
+
+	next, err := newPageQuery(PageInput{PageSize: 2, Cursor: first.cursor(paymentDate.Format(time.RFC3339Nano), 9)}, "-payment_date", "payment_date", "payment_id")
+	require.NoError(t, err)
+	require.Equal(t, int32(9), next.afterID().Int32)
+
+	afterTime, err := next.afterTime()
+	require.NoError(t, err)
+	require.True(t, afterTime.Time.Equal(paymentDate))
+
+	_, hasMore = trimPage(next, []int{4})
+	require.False(t, hasMore)
+}
@@ -0,0 +1,240 @@
+/*
+payment service is responsible for recording payments and the admin view over them.
+*/
+package service
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+)
+
+type PaymentService struct {
+	store db.Store
+}
+
+func NewPaymentService(store db.Store) *PaymentService {
+	return &PaymentService{store: store}
+}
+
+/*
+ListPayments Service is responsible for listing payments one cursor page at a time.
+- Validate cursor, page_size and sort (payment_date or payment_id, "-" for descending)
+- Validate status, subscription_id and that from is before to
+- Call the store.ListPaymentsBy* query of the sort with the filters and the keyset of the cursor
+- Return next_cursor when more payments follow
+- Return ErrInvalidInput or ErrInternal
+*/
+func (s *PaymentService) ListPayments(ctx context.Context, input ListPaymentsInput) (PaymentPage, error) {
+	query, err := newPageQuery(input.Page, "-payment_date", "payment_date", "payment_id")
+	if err != nil {
+		return PaymentPage{}, err
+	}
+	if input.SubscriptionID != nil && *input.SubscriptionID <= 0 {
+		return PaymentPage{}, Wrap(nil, ErrInvalidInput.Code, "subscription_id must be greater than 0")
+	}
+	if err := validateDateRange("from", input.From, "to", input.To); err != nil {
+		return PaymentPage{}, err
+	}
+	afterTime, err := query.afterTime()
+	if err != nil {
+		return PaymentPage{}, err
+	}
+
+	payments, err := s.listPayments(ctx, query, db.ListPaymentsByDateParams{
+		PaymentStatus:  optionalString(input.Status),
+		SubscriptionID: optionalInt32(input.SubscriptionID),
+		DateFrom:       optionalTimestamp(input.From),
+		DateTo:         optionalTimestamp(input.To),
+		AfterID:        query.afterID(),
+		AfterTime:      afterTime,
+		PageLimit:      query.limit(),
+	})
+	if err != nil {
+		return PaymentPage{}, Wrap(err, ErrInternal.Code, "failed to list payments")
+	}
+
+	payments, hasMore := trimPage(query, payments)
+	page := PaymentPage{Payments: payments}
+	if hasMore {
+		last := payments[len(payments)-1]
+		value := ""
+		if query.sort.Key == "payment_date" {
+			value = cursorTime(last.PaymentDate)
+		}
+		page.NextCursor = query.cursor(value, last.PaymentID)
+	}
+	return page, nil
+}
+
+// listPayments runs the keyset query of the page's sort; arg.AfterTime is ignored when sorting by id.
+func (s *PaymentService) listPayments(ctx context.Context, query pageQuery, arg db.ListPaymentsByDateParams) ([]db.Payment, error) {
+	if query.sort.Key == "payment_date" {
+		if query.sort.Desc {
+			return s.store.ListPaymentsByDateDesc(ctx, db.ListPaymentsByDateDescParams(arg))
+		}
+		return s.store.ListPaymentsByDate(ctx, arg)
+	}
+
+	byID := db.ListPaymentsByIDParams{
+		PaymentStatus:  arg.PaymentStatus,
+		SubscriptionID: arg.SubscriptionID,
+		DateFrom:       arg.DateFrom,
+		DateTo:         arg.DateTo,
+		AfterID:        arg.AfterID,
+		PageLimit:      arg.PageLimit,
+	}
+	if query.sort.Desc {
+		return s.store.ListPaymentsByIDDesc(ctx, db.ListPaymentsByIDDescParams(byID))
+	}
+	return s.store.ListPaymentsByID(ctx, byID)
+}
+
+var paymentStatuses = []string{"pending", "completed", "failed", "refunded"}
+
+/*
+CreatePayment Service is responsible for recording a payment against a subscription.
+- Require subscription_id, a non-negative amount and a 3 letter currency_code (stored upper case)
+- Default payment_status to pending and payment_date to now
+- Return ErrInvalidInput for an unknown subscription or a duplicate transaction_id
+*/
+func (s *PaymentService) CreatePayment(ctx context.Context, input CreatePaymentInput) (Payment, error) {
+	if input.SubscriptionID <= 0 {
+		return Payment{}, Wrap(nil, ErrInvalidInput.Code, "subscription_id must be greater than 0")
+	}
+	if !hasValue(&input.Amount) {
+		return Payment{}, Wrap(nil, ErrInvalidInput.Code, "amount is required")
+	}
+	if err := validateNonNegativeDecimal("amount", &input.Amount); err != nil {
+		return Payment{}, err
+	}
+	currencyCode := normalizeCurrencyCode(input.CurrencyCode)
+	if len(currencyCode) != 3 {
+		return Payment{}, Wrap(nil, ErrInvalidInput.Code, "currency_code must be 3 letters")
+	}
+	status := "pending"
+	if input.PaymentStatus != nil {
+		status = *input.PaymentStatus
+	}
+	if err := validatePaymentStatus(&status); err != nil {
+		return Payment{}, err
+	}
+	paymentDate := time.Now()
+	if input.PaymentDate != nil {
+		paymentDate = *input.PaymentDate
+	}
+
+	payment, err := s.store.CreatePayment(ctx, db.CreatePaymentParams{
+		SubscriptionID: input.SubscriptionID,
+		TransactionID:  optionalString(input.TransactionID),
+		Amount:         strings.TrimSpace(input.Amount),
+		CurrencyCode:   currencyCode,
+		PaymentMethod:  optionalString(input.PaymentMethod),
+		PaymentStatus:  sql.NullString{String: status, Valid: true},
+		PaymentDate:    sql.NullTime{Time: paymentDate, Valid: true},
+	})
+	if err != nil {
+		return Payment{}, wrapRecordDBError(err, "payment", "failed to create payment")
+	}
+	return NewPayment(payment), nil
+}
+
+func (s *PaymentService) ListUserPayments(ctx context.Context, userID int32) ([]Payment, error) {
+	payments, err := s.store.GetPaymentsByUserID(ctx, userID)
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to list payments")
+	}
+	return NewPayments(payments), nil
+}
+
+func (s *PaymentService) GetPayment(ctx context.Context, paymentID int32) (Payment, error) {
+	if paymentID <= 0 {
+		return Payment{}, Wrap(nil, ErrInvalidInput.Code, "payment_id must be greater than 0")
+	}
+
+	payment, err := s.store.GetPaymentByID(ctx, paymentID)
+	if err != nil {
+		return Payment{}, wrapRecordDBError(err, "payment", "failed to get payment")
+	}
+	return NewPayment(payment), nil
+}
+
+/*
+GetUserPayment Service is responsible for returning one of the user's own payments.
+- Return ErrNotFound when the payment belongs to another user's subscription, so ids are not leaked
+*/
+func (s *PaymentService) GetUserPayment(ctx context.Context, userID int32, paymentID int32) (Payment, error) {
+	payment, err := s.GetPayment(ctx, paymentID)
+	if err != nil {
+		return Payment{}, err
+	}
+
+	subscription, err := s.store.GetUserSubscriptionByID(ctx, payment.SubscriptionID)
+	if err != nil {
+		return Payment{}, wrapRecordDBError(err, "payment", "failed to get payment")
+	}
+	if subscription.UserID != userID {
+		return Payment{}, Wrap(nil, ErrNotFound.Code, "payment not found")
+	}
+	return payment, nil
+}
+
+// UpdatePayment changes only the fields that are set.
+func (s *PaymentService) UpdatePayment(ctx context.Context, input UpdatePaymentInput) (Payment, error) {
+	if input.PaymentID <= 0 {
+		return Payment{}, Wrap(nil, ErrInvalidInput.Code, "payment_id must be greater than 0")
+	}
+	if input.SubscriptionID != nil && *input.SubscriptionID <= 0 {
+		return Payment{}, Wrap(nil, ErrInvalidInput.Code, "subscription_id must be greater than 0")
+	}
+	if err := validateNonNegativeDecimal("amount", input.Amount); err != nil {
+		return Payment{}, err
+	}
+	currencyCode := sql.NullString{}
+	if input.CurrencyCode != nil {
+		currencyCode = sql.NullString{String: normalizeCurrencyCode(*input.CurrencyCode), Valid: true}
+		if len(currencyCode.String) != 3 {
+			return Payment{}, Wrap(nil, ErrInvalidInput.Code, "currency_code must be 3 letters")
+		}
+	}
+	if err := validatePaymentStatus(input.PaymentStatus); err != nil {
+		return Payment{}, err
+	}
+
+	payment, err := s.store.UpdatePayment(ctx, db.UpdatePaymentParams{
+		SubscriptionID: optionalInt32(input.SubscriptionID),
+		TransactionID:  optionalString(input.TransactionID),
+		Amount:         optionalString(input.Amount),
+		CurrencyCode:   currencyCode,
+		PaymentMethod:  optionalString(input.PaymentMethod),
+		PaymentStatus:  optionalString(input.PaymentStatus),
+		PaymentDate:    optionalTimestamp(input.PaymentDate),
+		PaymentID:      input.PaymentID,
+	})
+	if err != nil {
+		return Payment{}, wrapRecordDBError(err, "payment", "failed to update payment")
+	}
+	return NewPayment(payment), nil
+}
+
+func (s *PaymentService) DeletePayment(ctx context.Context, paymentID int32) error {
+	if paymentID <= 0 {
+		return Wrap(nil, ErrInvalidInput.Code, "payment_id must be greater than 0")
+	}
+
+	if err := s.store.DeletePayment(ctx, paymentID); err != nil {
+		return wrapRecordDBError(err, "payment", "failed to delete payment")
+	}
+	return nil
+}
+
+func validatePaymentStatus(status *string) error {
+	if status != nil && !slices.Contains(paymentStatuses, *status) {
+		return Wrap(nil, ErrInvalidInput.Code, "payment_status must be one of "+strings.Join(paymentStatuses, ", "))
+	}
+	return nil
+}
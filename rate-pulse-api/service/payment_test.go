@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPaymentService(t *testing.T) (*PaymentService, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = sqlDB.Close()
+	})
+
+	return NewPaymentService(db.NewStore(sqlDB)), mock
+}
+
+func TestPaymentServiceGetUserPayment(t *testing.T) {
+	now := time.Date(2026, 5, 12, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		ownerID   int32
+		errorCode string
+	}{
+		{name: "OwnPayment", ownerID: 9},
+		{name: "OtherUsersPayment", ownerID: 10, errorCode: ErrNotFound.Code},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			paymentService, mock := newTestPaymentService(t)
+
+			mock.ExpectQuery("FROM payments").
+				WithArgs(int32(5)).
+				WillReturnRows(sqlmock.NewRows([]string{
+					"payment_id", "subscription_id", "transaction_id", "amount", "currency_code",
+					"payment_method", "payment_status", "payment_date", "created_at", "updated_at",
+				}).AddRow(int32(5), int32(3), nil, "9.99", "USD", nil, "completed", now, now, now))
+			mock.ExpectQuery("FROM user_subscriptions").
+				WithArgs(int32(3)).
+				WillReturnRows(sqlmock.NewRows([]string{
+					"subscription_id", "user_id", "plan_id", "status", "start_date",
+					"end_date", "auto_renew", "created_at", "updated_at",
+				}).AddRow(int32(3), tc.ownerID, int32(2), "active", now, nil, true, now, now))
+
+			payment, err := paymentService.GetUserPayment(context.Background(), 9, 5)
+
+			if tc.errorCode != "" {
+				requireServiceErrorCode(t, err, tc.errorCode)
+				require.Empty(t, payment)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, int32(5), payment.PaymentID)
+				require.Equal(t, "completed", payment.PaymentStatus)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPaymentServiceCreatePaymentInvalidInput(t *testing.T) {
+	badStatus := "settled"
+
+	testCases := []struct {
+		name  string
+		input CreatePaymentInput
+	}{
+		{name: "MissingSubscription", input: CreatePaymentInput{Amount: "9.99", CurrencyCode: "USD"}},
+		{name: "NegativeAmount", input: CreatePaymentInput{SubscriptionID: 3, Amount: "-1", CurrencyCode: "USD"}},
+		{name: "BadCurrencyCode", input: CreatePaymentInput{SubscriptionID: 3, Amount: "9.99", CurrencyCode: "US"}},
+		{name: "BadStatus", input: CreatePaymentInput{SubscriptionID: 3, Amount: "9.99", CurrencyCode: "usd", PaymentStatus: &badStatus}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			paymentService, mock := newTestPaymentService(t)
+
+			_, err := paymentService.CreatePayment(context.Background(), tc.input)
+
+			requireServiceErrorCode(t, err, ErrInvalidInput.Code)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,185 @@
+/*
+preference service is responsible for the currencies and rate sources a user favours.
+*/
+package service
+
+import (
+	"context"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+)
+
+type PreferenceService struct {
+	store db.Store
+}
+
+func NewPreferenceService(store db.Store) *PreferenceService {
+	return &PreferenceService{store: store}
+}
+
+/*
+CreateCurrencyPreference Service is responsible for adding a currency to the user's preferences.
+- Return ErrNotFound for an unknown currency_id
+- Return ErrInvalidInput when the user already has a preference for the currency
+*/
+func (s *PreferenceService) CreateCurrencyPreference(ctx context.Context, input CurrencyPreferenceInput) (CurrencyPreference, error) {
+	if err := validatePreferenceTarget("currency_id", input.CurrencyID); err != nil {
+		return CurrencyPreference{}, err
+	}
+	if input.DisplayOrder != nil && *input.DisplayOrder < 0 {
+		return CurrencyPreference{}, Wrap(nil, ErrInvalidInput.Code, "display_order must not be negative")
+	}
+	if _, err := s.store.GetCurrencyByID(ctx, input.CurrencyID); err != nil {
+		return CurrencyPreference{}, wrapRecordDBError(err, "currency", "failed to get currency")
+	}
+
+	preference, err := s.store.CreateCurrencyPreference(ctx, db.CreateCurrencyPreferenceParams{
+		CurrencyID:   input.CurrencyID,
+		UserID:       input.UserID,
+		IsFavorite:   optionalBool(input.IsFavorite),
+		DisplayOrder: optionalInt32(input.DisplayOrder),
+	})
+	if err != nil {
+		return CurrencyPreference{}, wrapRecordDBError(err, "currency preference", "failed to create currency preference")
+	}
+	return NewCurrencyPreference(preference), nil
+}
+
+func (s *PreferenceService) ListCurrencyPreferences(ctx context.Context, input ListPreferencesInput) ([]CurrencyPreference, error) {
+	if err := validatePreferencePage(input); err != nil {
+		return nil, err
+	}
+
+	preferences, err := s.store.GetCurrencyPreferencesByUserID(ctx, db.GetCurrencyPreferencesByUserIDParams{
+		UserID: input.UserID,
+		Limit:  input.PageSize,
+		Offset: (input.PageID - 1) * input.PageSize,
+	})
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to list currency preferences")
+	}
+	return NewCurrencyPreferences(preferences), nil
+}
+
+// UpdateCurrencyPreference changes only the fields that are set.
+func (s *PreferenceService) UpdateCurrencyPreference(ctx context.Context, input CurrencyPreferenceInput) (CurrencyPreference, error) {
+	if err := validatePreferenceTarget("currency_id", input.CurrencyID); err != nil {
+		return CurrencyPreference{}, err
+	}
+	if input.DisplayOrder != nil && *input.DisplayOrder < 0 {
+		return CurrencyPreference{}, Wrap(nil, ErrInvalidInput.Code, "display_order must not be negative")
+	}
+
+	preference, err := s.store.UpdateCurrencyPreference(ctx, db.UpdateCurrencyPreferenceParams{
+		IsFavorite:   optionalBool(input.IsFavorite),
+		DisplayOrder: optionalInt32(input.DisplayOrder),
+		CurrencyID:   input.CurrencyID,
+		UserID:       input.UserID,
+	})
+	if err != nil {
+		return CurrencyPreference{}, wrapRecordDBError(err, "currency preference", "failed to update currency preference")
+	}
+	return NewCurrencyPreference(preference), nil
+}
+
+func (s *PreferenceService) DeleteCurrencyPreference(ctx context.Context, userID int32, currencyID int32) error {
+	if err := validatePreferenceTarget("currency_id", currencyID); err != nil {
+		return err
+	}
+
+	if err := s.store.DeleteCurrencyPreference(ctx, db.DeleteCurrencyPreferenceParams{
+		CurrencyID: currencyID,
+		UserID:     userID,
+	}); err != nil {
+		return Wrap(err, ErrInternal.Code, "failed to delete currency preference")
+	}
+	return nil
+}
+
+/*
+CreateRateSourcePreference Service is responsible for adding a rate source to the user's preferences.
+- Return ErrNotFound for an unknown source_id
+- Return ErrInvalidInput when the user already has a preference for the source
+*/
+func (s *PreferenceService) CreateRateSourcePreference(ctx context.Context, input RateSourcePreferenceInput) (RateSourcePreference, error) {
+	if err := validatePreferenceTarget("source_id", input.SourceID); err != nil {
+		return RateSourcePreference{}, err
+	}
+	if _, err := s.store.GetRateSourceByID(ctx, input.SourceID); err != nil {
+		return RateSourcePreference{}, wrapRecordDBError(err, "rate source", "failed to get rate source")
+	}
+
+	preference, err := s.store.CreateRateSourcePreference(ctx, db.CreateRateSourcePreferenceParams{
+		SourceID:  input.SourceID,
+		UserID:    input.UserID,
+		IsPrimary: optionalBool(input.IsPrimary),
+	})
+	if err != nil {
+		return RateSourcePreference{}, wrapRecordDBError(err, "rate source preference", "failed to create rate source preference")
+	}
+	return NewRateSourcePreference(preference), nil
+}
+
+func (s *PreferenceService) ListRateSourcePreferences(ctx context.Context, input ListPreferencesInput) ([]RateSourcePreference, error) {
+	if err := validatePreferencePage(input); err != nil {
+		return nil, err
+	}
+
+	preferences, err := s.store.GetRateSourcePreferencesByUserID(ctx, db.GetRateSourcePreferencesByUserIDParams{
+		UserID: input.UserID,
+		Limit:  input.PageSize,
+		Offset: (input.PageID - 1) * input.PageSize,
+	})
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to list rate source preferences")
+	}
+	return NewRateSourcePreferences(preferences), nil
+}
+
+// UpdateRateSourcePreference changes only the fields that are set.
+func (s *PreferenceService) UpdateRateSourcePreference(ctx context.Context, input RateSourcePreferenceInput) (RateSourcePreference, error) {
+	if err := validatePreferenceTarget("source_id", input.SourceID); err != nil {
+		return RateSourcePreference{}, err
+	}
+
+	preference, err := s.store.UpdateRateSourcePreference(ctx, db.UpdateRateSourcePreferenceParams{
+		IsPrimary: optionalBool(input.IsPrimary),
+		SourceID:  input.SourceID,
+		UserID:    input.UserID,
+	})
+	if err != nil {
+		return RateSourcePreference{}, wrapRecordDBError(err, "rate source preference", "failed to update rate source preference")
+	}
+	return NewRateSourcePreference(preference), nil
+}
+
+func (s *PreferenceService) DeleteRateSourcePreference(ctx context.Context, userID int32, sourceID int32) error {
+	if err := validatePreferenceTarget("source_id", sourceID); err != nil {
+		return err
+	}
+
+	if err := s.store.DeleteRateSourcePreference(ctx, db.DeleteRateSourcePreferenceParams{
+		SourceID: sourceID,
+		UserID:   userID,
+	}); err != nil {
+		return Wrap(err, ErrInternal.Code, "failed to delete rate source preference")
+	}
+	return nil
+}
+
+func validatePreferenceTarget(field string, id int32) error {
+	if id <= 0 {
+		return Wrap(nil, ErrInvalidInput.Code, field+" must be greater than 0")
+	}
+	return nil
+}
+
+func validatePreferencePage(input ListPreferencesInput) error {
+	if input.PageID <= 0 {
+		return Wrap(nil, ErrInvalidInput.Code, "page_id must be greater than 0")
+	}
+	if input.PageSize < 5 || input.PageSize > 10 {
+		return Wrap(nil, ErrInvalidInput.Code, "page_size must be between 5 and 10")
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+/*
+quota service is responsible for the limits of subscription plans.
+It resolves the plan behind a user, meters rate reads against the plan's
+daily quota and reports the day's usage.
+*/
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/util"
+)
+
+const (
+	defaultFreeRateLimitPerDay = 100
+	defaultFreeHistoricalDays  = 30
+
+	freePlanName  = "free"
+	adminPlanName = "admin"
+)
+
+// PlanLimits are the limits a caller is held to. Users without an active subscription,
+// and anonymous callers, get the free limits from config; admins are unlimited.
+type PlanLimits struct {
+	PlanID          *int32
+	PlanName        string
+	RateLimitPerDay int32
+	HistoricalDays  int32
+	Unlimited       bool
+}
+
+type QuotaService struct {
+	config util.Config
+	store  db.Store
+}
+
+func NewQuotaService(config util.Config, store db.Store) *QuotaService {
+	return &QuotaService{config: config, store: store}
+}
+
+/*
+ConsumeDailyQuota Service is responsible for counting one metered request.
+- Resolve the plan of the caller; admins are not metered
+- Increment today's (UTC) counter unless it already reached rate_limit_per_day
+- Return the usage after the request, or ErrQuotaExceeded with the usage
+*/
+func (s *QuotaService) ConsumeDailyQuota(ctx context.Context, input QuotaInput) (Usage, error) {
+	if input.UserID <= 0 {
+		return Usage{}, Wrap(nil, ErrUnauthorized.Code, "user_id is required")
+	}
+
+	limits, err := resolvePlanLimits(ctx, s.config, s.store, input.UserID, input.UserType)
+	if err != nil {
+		return Usage{}, err
+	}
+	now := time.Now().UTC()
+	if limits.Unlimited {
+		return newUsage(limits, now, 0), nil
+	}
+
+	used, err := s.store.IncrementDailyUsage(ctx, db.IncrementDailyUsageParams{
+		UserID:     input.UserID,
+		UsageDate:  usageDate(now),
+		DailyLimit: limits.RateLimitPerDay,
+	})
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && used > limits.RateLimitPerDay) {
+		return newUsage(limits, now, limits.RateLimitPerDay), Wrap(err, ErrQuotaExceeded.Code, "daily request quota of your plan is exhausted")
+	}
+	if err != nil {
+		return Usage{}, Wrap(err, ErrInternal.Code, "failed to count request")
+	}
+
+	return newUsage(limits, now, used), nil
+}
+
+/*
+GetUsage Service is responsible for reporting the caller's plan limits and today's usage.
+- Resolve the plan of the caller
+- Read today's (UTC) counter without changing it
+- Return ErrUnauthorized or ErrInternal
+*/
+func (s *QuotaService) GetUsage(ctx context.Context, input QuotaInput) (Usage, error) {
+	if input.UserID <= 0 {
+		return Usage{}, Wrap(nil, ErrUnauthorized.Code, "user_id is required")
+	}
+
+	limits, err := resolvePlanLimits(ctx, s.config, s.store, input.UserID, input.UserType)
+	if err != nil {
+		return Usage{}, err
+	}
+
+	now := time.Now().UTC()
+	used, err := s.store.GetDailyUsage(ctx, db.GetDailyUsageParams{
+		UserID:    input.UserID,
+		UsageDate: usageDate(now),
+	})
+	if err != nil {
+		return Usage{}, Wrap(err, ErrInternal.Code, "failed to get usage")
+	}
+
+	return newUsage(limits, now, used), nil
+}
+
+// resolvePlanLimits picks the limits of the user's active subscription, falling back to the
+// free limits for anonymous callers and users without one.
+func resolvePlanLimits(ctx context.Context, config util.Config, store db.Store, userID int32, userType string) (PlanLimits, error) {
+	if userType == userTypeAdmin {
+		return PlanLimits{PlanName: adminPlanName, Unlimited: true}, nil
+	}
+
+	free := PlanLimits{
+		PlanName:        freePlanName,
+		RateLimitPerDay: config.FreeRateLimitPerDay,
+		HistoricalDays:  config.FreeHistoricalDays,
+	}
+	if free.RateLimitPerDay <= 0 {
+		free.RateLimitPerDay = defaultFreeRateLimitPerDay
+	}
+	if free.HistoricalDays <= 0 {
+		free.HistoricalDays = defaultFreeHistoricalDays
+	}
+	if userID <= 0 {
+		return free, nil
+	}
+
+	plan, err := store.GetUserPlanLimits(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return free, nil
+		}
+		return PlanLimits{}, Wrap(err, ErrInternal.Code, "failed to get subscription plan")
+	}
+
+	// A plan with no historical_days still gets the free window rather than no history at all.
+	historicalDays := plan.HistoricalDays
+	if historicalDays <= 0 {
+		historicalDays = free.HistoricalDays
+	}
+	return PlanLimits{
+		PlanID:          &plan.PlanID,
+		PlanName:        plan.PlanName,
+		RateLimitPerDay: plan.RateLimitPerDay,
+		HistoricalDays:  historicalDays,
+	}, nil
+}
+
+// usageDate is the UTC calendar day a request is counted against.
+func usageDate(now time.Time) time.Time {
+	year, month, day := now.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func newUsage(limits PlanLimits, now time.Time, used int32) Usage {
+	usage := Usage{
+		PlanID:       limits.PlanID,
+		PlanName:     limits.PlanName,
+		Date:         usageDate(now).Format(time.DateOnly),
+		RequestsUsed: used,
+		ResetsAt:     usageDate(now).AddDate(0, 0, 1),
+	}
+	if limits.Unlimited {
+		return usage
+	}
+
+	dailyLimit, historicalDays := limits.RateLimitPerDay, limits.HistoricalDays
+	usage.DailyLimit = &dailyLimit
+	usage.HistoricalDays = &historicalDays
+	remaining := max(dailyLimit-used, 0)
+	usage.RequestsRemaining = &remaining
+	return usage
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
+	"github.com/ThanhVinhTong/rate-pulse/util"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQuotaService(t *testing.T, config util.Config) (*QuotaService, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = sqlDB.Close()
+	})
+
+	return NewQuotaService(config, db.NewStore(sqlDB)), mock
+}
+
+func planLimitRows(plan db.GetUserPlanLimitsRow) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"plan_id", "plan_name", "historical_days", "rate_limit_per_day"}).
+		AddRow(plan.PlanID, plan.PlanName, plan.HistoricalDays, plan.RateLimitPerDay)
+}
+
+func TestQuotaServiceConsumeDailyQuotaCountsAgainstPlan(t *testing.T) {
+	quotaService, mock := newTestQuotaService(t, util.Config{})
+
+	mock.ExpectQuery("FROM user_subscriptions us").
+		WithArgs(int32(42)).
+		WillReturnRows(planLimitRows(db.GetUserPlanLimitsRow{PlanID: 2, PlanName: "premium", HistoricalDays: 365, RateLimitPerDay: 1000}))
+	mock.ExpectQuery("INSERT INTO user_daily_usage").
+		WithArgs(int32(42), usageDate(time.Now()), int32(1000)).
+		WillReturnRows(sqlmock.NewRows([]string{"request_count"}).AddRow(int32(10)))
+
+	usage, err := quotaService.ConsumeDailyQuota(context.Background(), QuotaInput{UserID: 42, UserType: "premium"})
+
+	require.NoError(t, err)
+	require.Equal(t, "premium", usage.PlanName)
+	require.Equal(t, int32(10), usage.RequestsUsed)
+	require.Equal(t, int32(990), *usage.RequestsRemaining)
+	require.True(t, usage.ResetsAt.After(time.Now()))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestQuotaServiceConsumeDailyQuotaExhausted(t *testing.T) {
+	quotaService, mock := newTestQuotaService(t, util.Config{FreeRateLimitPerDay: 5})
+
+	mock.ExpectQuery("FROM user_subscriptions us").
+		WithArgs(int32(42)).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("INSERT INTO user_daily_usage").
+		WithArgs(int32(42), usageDate(time.Now()), int32(5)).
+		WillReturnError(sql.ErrNoRows)
+
+	usage, err := quotaService.ConsumeDailyQuota(context.Background(), QuotaInput{UserID: 42, UserType: "free"})
+
+	requireServiceErrorCode(t, err, ErrQuotaExceeded.Code)
+	require.Equal(t, freePlanName, usage.PlanName)
+	require.Equal(t, int32(0), *usage.RequestsRemaining)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestQuotaServiceConsumeDailyQuotaSkipsAdmins(t *testing.T) {
+	quotaService, mock := newTestQuotaService(t, util.Config{})
+
+	usage, err := quotaService.ConsumeDailyQuota(context.Background(), QuotaInput{UserID: 1, UserType: userTypeAdmin})
+
+	require.NoError(t, err)
+	require.Nil(t, usage.DailyLimit)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestQuotaServiceGetUsageDefaultsToFreePlan(t *testing.T) {
+	quotaService, mock := newTestQuotaService(t, util.Config{})
+
+	mock.ExpectQuery("FROM user_subscriptions us").
+		WithArgs(int32(42)).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("FROM user_daily_usage").
+		WithArgs(int32(42), usageDate(time.Now())).
+		WillReturnRows(sqlmock.NewRows([]string{"request_count"}).AddRow(int32(7)))
+
+	usage, err := quotaService.GetUsage(context.Background(), QuotaInput{UserID: 42, UserType: "free"})
+
+	require.NoError(t, err)
+	require.Nil(t, usage.PlanID)
+	require.Equal(t, int32(defaultFreeRateLimitPerDay), *usage.DailyLimit)
+	require.Equal(t, int32(defaultFreeRateLimitPerDay-7), *usage.RequestsRemaining)
+	require.Equal(t, int32(defaultFreeHistoricalDays), *usage.HistoricalDays)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceGetHistoricalDataPlanWithoutHistoryGetsFreeWindow(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	start := &capturedArg{}
+
+	mock.ExpectQuery("FROM user_subscriptions us").
+		WithArgs(int32(42)).
+		WillReturnRows(planLimitRows(db.GetUserPlanLimitsRow{PlanID: 3, PlanName: "basic", HistoricalDays: 0, RateLimitPerDay: 500}))
+	mock.ExpectQuery("WITH bucketed AS").
+		WithArgs(int32(1), int32(2), sqlmock.AnyArg(), start, sqlmock.AnyArg(), int32(50), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"rate_value", "updated_at", "type_id"}))
+
+	_, err := fxService.GetHistoricalData(context.Background(), GetHistoricalDataInput{
+		SourceCurrencyID:      1,
+		DestinationCurrencyID: 2,
+		SourceID:              10,
+		TypeID:                1,
+		TimeRange:             "all",
+		TimeZone:              "UTC",
+		UserID:                42,
+	})
+
+	require.NoError(t, err)
+	startTime, ok := start.value.(time.Time)
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().AddDate(0, 0, -defaultFreeHistoricalDays), startTime, time.Minute)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceGetHistoricalDataClampsTimeRangeToPlan(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	fxService := NewFXService(util.Config{FreeHistoricalDays: 7}, db.NewStore(sqlDB), pubsub.NoopBroker{})
+	start := &capturedArg{}
+
+	mock.ExpectQuery("WITH bucketed AS").
+		WithArgs(int32(1), int32(2), sqlmock.AnyArg(), start, sqlmock.AnyArg(), int32(50), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"rate_value", "updated_at", "type_id"}))
+
+	_, err = fxService.GetHistoricalData(context.Background(), GetHistoricalDataInput{
+		SourceCurrencyID:      1,
+		DestinationCurrencyID: 2,
+		SourceID:              10,
+		TypeID:                1,
+		TimeRange:             "all",
+	})
+
+	require.NoError(t, err)
+	startTime, ok := start.value.(time.Time)
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().AddDate(0, 0, -7), startTime, time.Minute)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceGetHistoricalDataRejectsFromOutsidePlan(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+
+	points, err := fxService.GetHistoricalData(context.Background(), GetHistoricalDataInput{
+		SourceCurrencyID:      1,
+		DestinationCurrencyID: 2,
+		SourceID:              10,
+		TypeID:                1,
+		From:                  time.Now().AddDate(0, 0, -defaultFreeHistoricalDays-1).Format(time.DateOnly),
+	})
+
+	requireServiceErrorCode(t, err, ErrForbidden.Code)
+	require.Nil(t, points)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,443 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+)
+
+const quoteDecimalPlaces = 8
+
+type quoteFees struct {
+	FeeRate          float64
+	PercentageFee    float64
+	FixedFee         float64
+	VatAmount        float64
+	VatIncluded      bool
+	SwiftFee         float64
+	SwiftFeeIncluded bool
+}
+
+func (f quoteFees) total() float64 {
+	total := f.PercentageFee + f.FixedFee + f.SwiftFee
+	if !f.VatIncluded {
+		total += f.VatAmount
+	}
+	return total
+}
+
+/*
+Quote Service is responsible for pricing a conversion with the source's fees applied.
+- Validate amount, currency pair, source and type
+- Resolve the latest rate for the pair in either quoted direction
+- Apply the active fee rule (fee_rate clamped to min_fee/max_fee, fixed_fee, VAT, SWIFT fee)
+- Express every fee in the destination currency and return the net amount received
+*/
+func (s *FXService) Quote(ctx context.Context, input QuoteInput) (Quote, error) {
+	if input.SourceID <= 0 {
+		return Quote{}, Wrap(nil, ErrInvalidInput.Code, "source_id must be greater than 0")
+	}
+	amount, err := validateQuoteInput(&input)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	return s.quoteForSource(ctx, input, amount)
+}
+
+/*
+CompareQuotes Service is responsible for ranking every active rate source for the same conversion.
+- Validate amount, currency pair and type once for all sources
+- Load the latest rates and active fee rules of every active source in one query each
+- Quote each source with its latest rate and active fee rule
+- Skip sources that do not publish the pair or whose fees cannot be converted
+- Sort by net amount received, best first
+*/
+func (s *FXService) CompareQuotes(ctx context.Context, input CompareQuotesInput) ([]RankedQuote, error) {
+	quoteInput := QuoteInput{
+		Amount:          input.Amount,
+		FromCurrencyID:  input.FromCurrencyID,
+		ToCurrencyID:    input.ToCurrencyID,
+		TypeID:          input.TypeID,
+		TransactionType: input.TransactionType,
+		Channel:         input.Channel,
+		EffectiveDate:   input.EffectiveDate,
+	}
+	amount, err := validateQuoteInput(&quoteInput)
+	if err != nil {
+		return nil, err
+	}
+
+	sources, err := s.store.ListActiveRateSources(ctx)
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to list active rate sources")
+	}
+	if len(sources) == 0 {
+		return []RankedQuote{}, nil
+	}
+	sourceIDs := make([]int32, len(sources))
+	for i, source := range sources {
+		sourceIDs[i] = source.SourceID
+	}
+	lookupsFor, err := s.loadQuoteLookups(ctx, quoteInput, sourceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := make([]RankedQuote, 0, len(sources))
+	netAmounts := make(map[int32]float64, len(sources))
+	for _, source := range sources {
+		quoteInput.SourceID = source.SourceID
+		quote, err := priceQuote(quoteInput, amount, lookupsFor(source.SourceID))
+		if err != nil {
+			switch ServiceErrorCode(err) {
+			case ErrNotFound.Code, ErrInvalidInput.Code:
+				continue
+			}
+			return nil, err
+		}
+		netAmounts[source.SourceID], _ = parseDecimal(quote.NetAmount)
+		ranked = append(ranked, RankedQuote{
+			SourceName: source.SourceName,
+			SourceCode: source.SourceCode.String,
+			Quote:      quote,
+		})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return netAmounts[ranked[i].SourceID] > netAmounts[ranked[j].SourceID]
+	})
+	for i := range ranked {
+		ranked[i].Rank = int32(i + 1)
+	}
+
+	return ranked, nil
+}
+
+func validateQuoteInput(input *QuoteInput) (float64, error) {
+	amount, err := parseDecimal(input.Amount)
+	if err != nil || amount <= 0 {
+		return 0, Wrap(nil, ErrInvalidInput.Code, "amount must be a positive decimal")
+	}
+	if input.FromCurrencyID <= 0 {
+		return 0, Wrap(nil, ErrInvalidInput.Code, "from_currency_id must be greater than 0")
+	}
+	if input.ToCurrencyID <= 0 {
+		return 0, Wrap(nil, ErrInvalidInput.Code, "to_currency_id must be greater than 0")
+	}
+	if input.FromCurrencyID == input.ToCurrencyID {
+		return 0, Wrap(nil, ErrInvalidInput.Code, "from_currency_id and to_currency_id must be different")
+	}
+	if input.TypeID <= 0 {
+		return 0, Wrap(nil, ErrInvalidInput.Code, "type_id must be greater than 0")
+	}
+	input.TransactionType = normalizeTransactionType(input.TransactionType)
+	if err := validateTransactionType(input.TransactionType); err != nil {
+		return 0, err
+	}
+	input.Channel = normalizeChannel(input.Channel)
+	if input.EffectiveDate.IsZero() {
+		input.EffectiveDate = time.Now().UTC()
+	}
+	return amount, nil
+}
+
+// quoteLookups supplies the rates and fee rule a single source's quote is priced with.
+type quoteLookups struct {
+	// latestRate returns the source's latest rate between currencyID and the quote's destination currency.
+	latestRate func(currencyID int32) (db.ExchangeRate, error)
+	// feeRule returns the source's active fee rule, or sql.ErrNoRows when it has none.
+	feeRule func() (db.RateSourceFeeRule, error)
+}
+
+func (s *FXService) quoteForSource(ctx context.Context, input QuoteInput, amount float64) (Quote, error) {
+	return priceQuote(input, amount, quoteLookups{
+		latestRate: func(currencyID int32) (db.ExchangeRate, error) {
+			return s.store.GetLatestExchangeRateForPair(ctx, db.GetLatestExchangeRateForPairParams{
+				SourceID:  sql.NullInt32{Int32: input.SourceID, Valid: true},
+				TypeID:    sql.NullInt32{Int32: input.TypeID, Valid: true},
+				CurrencyA: currencyID,
+				CurrencyB: input.ToCurrencyID,
+			})
+		},
+		feeRule: func() (db.RateSourceFeeRule, error) {
+			return s.store.GetActiveRateSourceFeeRule(ctx, db.GetActiveRateSourceFeeRuleParams{
+				SourceID:        input.SourceID,
+				TypeID:          input.TypeID,
+				TransactionType: input.TransactionType,
+				Channel:         input.Channel,
+				EffectiveFrom:   startOfDay(input.EffectiveDate),
+			})
+		},
+	})
+}
+
+/*
+loadQuoteLookups loads what CompareQuotes prices every source with in two queries, instead of a
+rate and a fee rule query per source.
+- Rates: each source's latest rate of every pair with the destination currency, fee currencies included
+- Fee rules: each source's active rule for the type, transaction type and channel
+*/
+func (s *FXService) loadQuoteLookups(ctx context.Context, input QuoteInput, sourceIDs []int32) (func(sourceID int32) quoteLookups, error) {
+	rates, err := s.store.ListLatestExchangeRatesAgainstCurrency(ctx, db.ListLatestExchangeRatesAgainstCurrencyParams{
+		SourceIds:  sourceIDs,
+		TypeID:     sql.NullInt32{Int32: input.TypeID, Valid: true},
+		CurrencyID: input.ToCurrencyID,
+	})
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to list latest exchange rates")
+	}
+	type sourceCurrency struct {
+		sourceID   int32
+		currencyID int32
+	}
+	latest := make(map[sourceCurrency]db.ExchangeRate, len(rates))
+	for _, rate := range rates {
+		key := sourceCurrency{sourceID: rate.SourceID.Int32, currencyID: rate.SourceCurrencyID}
+		if key.currencyID == input.ToCurrencyID {
+			key.currencyID = rate.DestinationCurrencyID
+		}
+		// The query returns each quoted direction; keep the one GetLatestExchangeRateForPair would.
+		if current, ok := latest[key]; ok && !newerExchangeRate(rate, current) {
+			continue
+		}
+		latest[key] = rate
+	}
+
+	rules, err := s.store.ListActiveRateSourceFeeRulesForSources(ctx, db.ListActiveRateSourceFeeRulesForSourcesParams{
+		SourceIds:       sourceIDs,
+		TypeID:          input.TypeID,
+		TransactionType: input.TransactionType,
+		Channel:         input.Channel,
+		EffectiveOn:     startOfDay(input.EffectiveDate),
+	})
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to list active rate source fee rules")
+	}
+	feeRules := make(map[int32]db.RateSourceFeeRule, len(rules))
+	for _, rule := range rules {
+		feeRules[rule.SourceID] = rule
+	}
+
+	return func(sourceID int32) quoteLookups {
+		return quoteLookups{
+			latestRate: func(currencyID int32) (db.ExchangeRate, error) {
+				rate, ok := latest[sourceCurrency{sourceID: sourceID, currencyID: currencyID}]
+				if !ok {
+					return db.ExchangeRate{}, sql.ErrNoRows
+				}
+				return rate, nil
+			},
+			feeRule: func() (db.RateSourceFeeRule, error) {
+				rule, ok := feeRules[sourceID]
+				if !ok {
+					return db.RateSourceFeeRule{}, sql.ErrNoRows
+				}
+				return rule, nil
+			},
+		}
+	}, nil
+}
+
+// newerExchangeRate orders rates like GetLatestExchangeRateForPair: updated_at DESC NULLS LAST, then rate_id DESC.
+func newerExchangeRate(a, b db.ExchangeRate) bool {
+	if a.UpdatedAt.Valid != b.UpdatedAt.Valid {
+		return a.UpdatedAt.Valid
+	}
+	if !a.UpdatedAt.Time.Equal(b.UpdatedAt.Time) {
+		return a.UpdatedAt.Time.After(b.UpdatedAt.Time)
+	}
+	return a.RateID > b.RateID
+}
+
+// priceQuote prices a conversion at one source from the rates and fee rule its lookups supply.
+func priceQuote(input QuoteInput, amount float64, lookups quoteLookups) (Quote, error) {
+	rate, err := lookups.latestRate(input.FromCurrencyID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Quote{}, Wrap(err, ErrNotFound.Code, "exchange rate not found for currency pair")
+		}
+		return Quote{}, Wrap(err, ErrInternal.Code, "failed to get latest exchange rate")
+	}
+	appliedRate, err := rate.RateIn(input.ToCurrencyID)
+	if err != nil {
+		return Quote{}, Wrap(err, ErrInternal.Code, "exchange rate has an invalid rate_value")
+	}
+	grossAmount := amount * appliedRate
+
+	quote := Quote{
+		Amount:          formatQuoteDecimal(amount),
+		FromCurrencyID:  input.FromCurrencyID,
+		ToCurrencyID:    input.ToCurrencyID,
+		SourceID:        input.SourceID,
+		TypeID:          input.TypeID,
+		TransactionType: input.TransactionType,
+		Channel:         input.Channel,
+		RateID:          rate.RateID,
+		AppliedRate:     formatQuoteDecimal(appliedRate),
+		GrossAmount:     formatQuoteDecimal(grossAmount),
+		RateUpdatedAt:   nullTimePtr(rate.UpdatedAt),
+	}
+
+	rule, err := lookups.feeRule()
+	var fees quoteFees
+	switch {
+	case err == nil:
+		convert := func(currencyID int32, value float64) (float64, error) {
+			return convertFeeToQuoteCurrency(input, appliedRate, lookups.latestRate, currencyID, value)
+		}
+		fees, err = calculateQuoteFees(grossAmount, rule, convert)
+		if err != nil {
+			return Quote{}, err
+		}
+		quote.FeeRuleID = &rule.FeeRuleID
+	case errors.Is(err, sql.ErrNoRows):
+		// Sources without a published fee schedule are quoted at the bare rate.
+	default:
+		return Quote{}, Wrap(err, ErrInternal.Code, "failed to get active rate source fee rule")
+	}
+
+	totalFees := fees.total()
+	netAmount := math.Max(grossAmount-totalFees, 0)
+
+	quote.FeeRate = formatQuoteDecimal(fees.FeeRate)
+	quote.PercentageFee = formatQuoteDecimal(fees.PercentageFee)
+	quote.FixedFee = formatQuoteDecimal(fees.FixedFee)
+	quote.VatAmount = formatQuoteDecimal(fees.VatAmount)
+	quote.VatIncluded = fees.VatIncluded
+	quote.SwiftFee = formatQuoteDecimal(fees.SwiftFee)
+	quote.SwiftFeeIncluded = fees.SwiftFeeIncluded
+	quote.TotalFees = formatQuoteDecimal(totalFees)
+	quote.NetAmount = formatQuoteDecimal(netAmount)
+	quote.EffectiveRate = formatQuoteDecimal(netAmount / amount)
+	return quote, nil
+}
+
+// convertFeeToQuoteCurrency expresses a fee published in currencyID in the quote's destination currency.
+func convertFeeToQuoteCurrency(
+	input QuoteInput,
+	appliedRate float64,
+	latestRate func(currencyID int32) (db.ExchangeRate, error),
+	currencyID int32,
+	value float64,
+) (float64, error) {
+	switch currencyID {
+	case input.ToCurrencyID:
+		return value, nil
+	case input.FromCurrencyID:
+		return value * appliedRate, nil
+	}
+
+	rate, err := latestRate(currencyID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, Wrap(err, ErrInvalidInput.Code, "no exchange rate available to convert fee currency")
+		}
+		return 0, Wrap(err, ErrInternal.Code, "failed to get fee currency exchange rate")
+	}
+	feeRate, err := rate.RateIn(input.ToCurrencyID)
+	if err != nil {
+		return 0, Wrap(err, ErrInternal.Code, "exchange rate has an invalid rate_value")
+	}
+	return value * feeRate, nil
+}
+
+/*
+calculateQuoteFees applies a fee rule to a gross amount already expressed in the destination currency.
+- fee_rate wins; otherwise the fee_rate_max of a published range is used so quotes never understate cost
+- The percentage fee is clamped to min_fee/max_fee
+- VAT is added on top unless fee_includes_vat, in which case it is only reported
+*/
+func calculateQuoteFees(
+	grossAmount float64,
+	rule db.RateSourceFeeRule,
+	convert func(currencyID int32, value float64) (float64, error),
+) (quoteFees, error) {
+	var fees quoteFees
+
+	hasFeeRate := true
+	switch {
+	case rule.FeeRate.Valid:
+		fees.FeeRate, _ = parseDecimal(rule.FeeRate.String)
+	case rule.FeeRateMax.Valid:
+		fees.FeeRate, _ = parseDecimal(rule.FeeRateMax.String)
+	case rule.FeeRateMin.Valid:
+		fees.FeeRate, _ = parseDecimal(rule.FeeRateMin.String)
+	default:
+		hasFeeRate = false
+	}
+
+	feeAmount := func(value sql.NullString) (float64, error) {
+		if !value.Valid || !rule.FeeCurrencyID.Valid {
+			return 0, nil
+		}
+		parsed, err := parseDecimal(value.String)
+		if err != nil {
+			return 0, Wrap(err, ErrInternal.Code, "rate source fee rule has an invalid fee amount")
+		}
+		return convert(rule.FeeCurrencyID.Int32, parsed)
+	}
+
+	if hasFeeRate {
+		fees.PercentageFee = grossAmount * fees.FeeRate
+		minFee, err := feeAmount(rule.MinFee)
+		if err != nil {
+			return quoteFees{}, err
+		}
+		if rule.MinFee.Valid && fees.PercentageFee < minFee {
+			fees.PercentageFee = minFee
+		}
+		maxFee, err := feeAmount(rule.MaxFee)
+		if err != nil {
+			return quoteFees{}, err
+		}
+		if rule.MaxFee.Valid && fees.PercentageFee > maxFee {
+			fees.PercentageFee = maxFee
+		}
+	}
+
+	fixedFee, err := feeAmount(rule.FixedFee)
+	if err != nil {
+		return quoteFees{}, err
+	}
+	fees.FixedFee = fixedFee
+
+	if normalizeVatApplies(rule.VatApplies) == "true" {
+		vatRate, err := parseDecimal(rule.VatRate)
+		if err != nil {
+			return quoteFees{}, Wrap(err, ErrInternal.Code, "rate source fee rule has an invalid vat_rate")
+		}
+		serviceFee := fees.PercentageFee + fees.FixedFee
+		if rule.FeeIncludesVat {
+			fees.VatAmount = serviceFee - serviceFee/(1+vatRate)
+			fees.VatIncluded = true
+		} else {
+			fees.VatAmount = serviceFee * vatRate
+		}
+	}
+
+	fees.SwiftFeeIncluded = rule.SwiftFeeIncluded
+	if !rule.SwiftFeeIncluded && rule.SwiftFee.Valid && rule.SwiftFeeCurrencyID.Valid {
+		swiftFee, err := parseDecimal(rule.SwiftFee.String)
+		if err != nil {
+			return quoteFees{}, Wrap(err, ErrInternal.Code, "rate source fee rule has an invalid swift_fee")
+		}
+		fees.SwiftFee, err = convert(rule.SwiftFeeCurrencyID.Int32, swiftFee)
+		if err != nil {
+			return quoteFees{}, err
+		}
+	}
+
+	return fees, nil
+}
+
+func formatQuoteDecimal(value float64) string {
+	scale := math.Pow10(quoteDecimalPlaces)
+	return strconv.FormatFloat(math.Round(value*scale)/scale, 'f', -1, 64)
+}
@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func testQuoteExchangeRate() db.ExchangeRate {
+	rate := testExchangeRateForFXService()
+	// 1 USD (2) = 25000 VND (1), quoted the way Vietnamese banks publish it.
+	rate.RateValue = "25000"
+	rate.TypeID = sql.NullInt32{Int32: 4, Valid: true}
+	return rate
+}
+
+func testQuoteFeeRule() db.RateSourceFeeRule {
+	return db.RateSourceFeeRule{
+		FeeRuleID:          7,
+		SourceID:           10,
+		TypeID:             4,
+		TransactionType:    "transfer",
+		Channel:            "default",
+		FeeRate:            sql.NullString{String: "0.002", Valid: true},
+		FeeCurrencyID:      sql.NullInt32{Int32: 2, Valid: true},
+		MinFee:             sql.NullString{String: "2", Valid: true},
+		MaxFee:             sql.NullString{String: "200", Valid: true},
+		VatRate:            "0.1",
+		VatApplies:         "true",
+		SwiftFee:           sql.NullString{String: "20", Valid: true},
+		SwiftFeeCurrencyID: sql.NullInt32{Int32: 2, Valid: true},
+		EffectiveFrom:      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func feeRuleRows(rules ...db.RateSourceFeeRule) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{
+		"fee_rule_id",
+		"source_id",
+		"type_id",
+		"fee_rate",
+		"vat_rate",
+		"vat_applies",
+		"fee_includes_vat",
+		"swift_fee",
+		"swift_fee_currency_id",
+		"source_url",
+		"source_note",
+		"effective_from",
+		"effective_to",
+		"updated_at",
+		"created_at",
+		"transaction_type",
+		"channel",
+		"fee_currency_id",
+		"fixed_fee",
+		"min_fee",
+		"max_fee",
+		"fee_rate_min",
+		"fee_rate_max",
+		"swift_fee_included",
+	})
+
+	for _, rule := range rules {
+		rows.AddRow(
+			rule.FeeRuleID,
+			rule.SourceID,
+			rule.TypeID,
+			rule.FeeRate,
+			rule.VatRate,
+			rule.VatApplies,
+			rule.FeeIncludesVat,
+			rule.SwiftFee,
+			rule.SwiftFeeCurrencyID,
+			rule.SourceUrl,
+			rule.SourceNote,
+			rule.EffectiveFrom,
+			rule.EffectiveTo,
+			rule.UpdatedAt,
+			rule.CreatedAt,
+			rule.TransactionType,
+			rule.Channel,
+			rule.FeeCurrencyID,
+			rule.FixedFee,
+			rule.MinFee,
+			rule.MaxFee,
+			rule.FeeRateMin,
+			rule.FeeRateMax,
+			rule.SwiftFeeIncluded,
+		)
+	}
+
+	return rows
+}
+
+func validQuoteInput() QuoteInput {
+	return QuoteInput{
+		Amount:         "1000",
+		FromCurrencyID: 2,
+		ToCurrencyID:   1,
+		SourceID:       10,
+		TypeID:         4,
+		EffectiveDate:  time.Date(2026, 5, 12, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestFXServiceQuoteInvalidInput(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+
+	testCases := []struct {
+		name   string
+		mutate func(input *QuoteInput)
+	}{
+		{name: "invalid amount", mutate: func(input *QuoteInput) { input.Amount = "abc" }},
+		{name: "zero amount", mutate: func(input *QuoteInput) { input.Amount = "0" }},
+		{name: "missing source", mutate: func(input *QuoteInput) { input.SourceID = 0 }},
+		{name: "missing type", mutate: func(input *QuoteInput) { input.TypeID = 0 }},
+		{name: "same currency", mutate: func(input *QuoteInput) { input.ToCurrencyID = input.FromCurrencyID }},
+		{name: "invalid transaction type", mutate: func(input *QuoteInput) { input.TransactionType = "wire" }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := validQuoteInput()
+			tc.mutate(&input)
+
+			quote, err := fxService.Quote(context.Background(), input)
+
+			requireFXServiceErrorCode(t, err, ErrInvalidInput.Code)
+			require.Empty(t, quote)
+		})
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceQuoteRateNotFound(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+
+	mock.ExpectQuery("SELECT rate_id, rate_value").
+		WithArgs(sql.NullInt32{Int32: 10, Valid: true}, sql.NullInt32{Int32: 4, Valid: true}, int32(2), int32(1)).
+		WillReturnError(sql.ErrNoRows)
+
+	quote, err := fxService.Quote(context.Background(), validQuoteInput())
+
+	requireFXServiceErrorCode(t, err, ErrNotFound.Code)
+	require.Empty(t, quote)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceQuoteAppliesFeeRule(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+
+	mock.ExpectQuery("SELECT rate_id, rate_value").
+		WithArgs(sql.NullInt32{Int32: 10, Valid: true}, sql.NullInt32{Int32: 4, Valid: true}, int32(2), int32(1)).
+		WillReturnRows(exchangeRateRows(testQuoteExchangeRate()))
+	mock.ExpectQuery("SELECT fee_rule_id").
+		WithArgs(int32(10), int32(4), "transfer", "default", time.Date(2026, 5, 12, 0, 0, 0, 0, time.UTC)).
+		WillReturnRows(feeRuleRows(testQuoteFeeRule()))
+
+	quote, err := fxService.Quote(context.Background(), validQuoteInput())
+
+	require.NoError(t, err)
+	require.Equal(t, "25000", quote.AppliedRate)
+	require.Equal(t, "25000000", quote.GrossAmount)
+	require.Equal(t, "50000", quote.PercentageFee)
+	require.Equal(t, "5000", quote.VatAmount)
+	require.False(t, quote.VatIncluded)
+	require.Equal(t, "500000", quote.SwiftFee)
+	require.Equal(t, "555000", quote.TotalFees)
+	require.Equal(t, "24445000", quote.NetAmount)
+	require.Equal(t, "24445", quote.EffectiveRate)
+	require.NotNil(t, quote.FeeRuleID)
+	require.Equal(t, int32(7), *quote.FeeRuleID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceQuoteWithoutFeeRule(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	input := validQuoteInput()
+	input.FromCurrencyID, input.ToCurrencyID = 1, 2
+	input.Amount = "2500000"
+
+	mock.ExpectQuery("SELECT rate_id, rate_value").
+		WithArgs(sql.NullInt32{Int32: 10, Valid: true}, sql.NullInt32{Int32: 4, Valid: true}, int32(1), int32(2)).
+		WillReturnRows(exchangeRateRows(testQuoteExchangeRate()))
+	mock.ExpectQuery("SELECT fee_rule_id").
+		WillReturnError(sql.ErrNoRows)
+
+	quote, err := fxService.Quote(context.Background(), input)
+
+	require.NoError(t, err)
+	require.Equal(t, "0.00004", quote.AppliedRate)
+	require.Equal(t, "100", quote.GrossAmount)
+	require.Equal(t, "0", quote.TotalFees)
+	require.Equal(t, "100", quote.NetAmount)
+	require.Nil(t, quote.FeeRuleID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCalculateQuoteFees(t *testing.T) {
+	usdToVND := func(currencyID int32, value float64) (float64, error) {
+		if currencyID == 2 {
+			return value * 25000, nil
+		}
+		return value, nil
+	}
+
+	testCases := []struct {
+		name        string
+		grossAmount float64
+		mutate      func(rule *db.RateSourceFeeRule)
+		check       func(t *testing.T, fees quoteFees)
+	}{
+		{
+			name:        "clamps to min fee",
+			grossAmount: 250000,
+			check: func(t *testing.T, fees quoteFees) {
+				require.InDelta(t, 50000, fees.PercentageFee, 1e-6)
+			},
+		},
+		{
+			name:        "clamps to max fee",
+			grossAmount: 5000000000,
+			check: func(t *testing.T, fees quoteFees) {
+				require.InDelta(t, 5000000, fees.PercentageFee, 1e-6)
+			},
+		},
+		{
+			name:        "fee includes vat",
+			grossAmount: 25000000,
+			mutate: func(rule *db.RateSourceFeeRule) {
+				rule.FeeIncludesVat = true
+			},
+			check: func(t *testing.T, fees quoteFees) {
+				require.True(t, fees.VatIncluded)
+				require.InDelta(t, 50000-50000/1.1, fees.VatAmount, 1e-6)
+				require.InDelta(t, 550000, fees.total(), 1e-6)
+			},
+		},
+		{
+			name:        "vat not applicable",
+			grossAmount: 25000000,
+			mutate: func(rule *db.RateSourceFeeRule) {
+				rule.VatApplies = "unknown"
+			},
+			check: func(t *testing.T, fees quoteFees) {
+				require.Zero(t, fees.VatAmount)
+			},
+		},
+		{
+			name:        "fee rate range uses maximum",
+			grossAmount: 25000000,
+			mutate: func(rule *db.RateSourceFeeRule) {
+				rule.FeeRate = sql.NullString{}
+				rule.FeeRateMin = sql.NullString{String: "0.001", Valid: true}
+				rule.FeeRateMax = sql.NullString{String: "0.003", Valid: true}
+			},
+			check: func(t *testing.T, fees quoteFees) {
+				require.InDelta(t, 75000, fees.PercentageFee, 1e-6)
+			},
+		},
+		{
+			name:        "fixed fee and included swift fee",
+			grossAmount: 25000000,
+			mutate: func(rule *db.RateSourceFeeRule) {
+				rule.FeeRate = sql.NullString{}
+				rule.FixedFee = sql.NullString{String: "5", Valid: true}
+				rule.SwiftFee = sql.NullString{}
+				rule.SwiftFeeIncluded = true
+			},
+			check: func(t *testing.T, fees quoteFees) {
+				require.Zero(t, fees.PercentageFee)
+				require.InDelta(t, 125000, fees.FixedFee, 1e-6)
+				require.InDelta(t, 12500, fees.VatAmount, 1e-6)
+				require.True(t, fees.SwiftFeeIncluded)
+				require.Zero(t, fees.SwiftFee)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule := testQuoteFeeRule()
+			if tc.mutate != nil {
+				tc.mutate(&rule)
+			}
+
+			fees, err := calculateQuoteFees(tc.grossAmount, rule, usdToVND)
+
+			require.NoError(t, err)
+			tc.check(t, fees)
+		})
+	}
+}
+
+func TestFXServiceCompareQuotesRanksByNetAmount(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	input := CompareQuotesInput{
+		Amount:         "1000",
+		FromCurrencyID: 2,
+		ToCurrencyID:   1,
+		TypeID:         4,
+		EffectiveDate:  time.Date(2026, 5, 12, 0, 0, 0, 0, time.UTC),
+	}
+
+	mock.ExpectQuery("SELECT source_id, source_name, source_code FROM rate_sources").
+		WillReturnRows(sqlmock.NewRows([]string{"source_id", "source_name", "source_code"}).
+			AddRow(int32(10), "Vietcombank", "VCB").
+			AddRow(int32(11), "Techcombank", "TCB").
+			AddRow(int32(12), "BIDV", "BIDV"))
+
+	// VCB: better rate but charges the fee rule. Its stale quote of the other direction is ignored.
+	vcbRate := testQuoteExchangeRate()
+	vcbStale := testQuoteExchangeRate()
+	vcbStale.RateID = 40
+	vcbStale.SourceCurrencyID, vcbStale.DestinationCurrencyID = 2, 1
+	vcbStale.RateValue = "0.00005"
+	vcbStale.UpdatedAt = sql.NullTime{Time: vcbRate.UpdatedAt.Time.Add(-time.Hour), Valid: true}
+	// TCB: slightly lower rate, no published fees.
+	tcbRate := testQuoteExchangeRate()
+	tcbRate.RateID = 43
+	tcbRate.RateValue = "24900"
+	tcbRate.SourceID = sql.NullInt32{Int32: 11, Valid: true}
+	// BIDV: does not publish the pair.
+	mock.ExpectQuery("SELECT DISTINCT ON \\(source_id, source_currency_id, destination_currency_id\\)").
+		WithArgs(pq.Array([]int32{10, 11, 12}), sql.NullInt32{Int32: 4, Valid: true}, int32(1)).
+		WillReturnRows(exchangeRateRows(vcbRate, vcbStale, tcbRate))
+	mock.ExpectQuery("SELECT DISTINCT ON \\(source_id\\) fee_rule_id").
+		WithArgs(pq.Array([]int32{10, 11, 12}), int32(4), "transfer", "default", input.EffectiveDate).
+		WillReturnRows(feeRuleRows(testQuoteFeeRule()))
+
+	quotes, err := fxService.CompareQuotes(context.Background(), input)
+
+	require.NoError(t, err)
+	require.Len(t, quotes, 2)
+	require.Equal(t, int32(1), quotes[0].Rank)
+	require.Equal(t, "TCB", quotes[0].SourceCode)
+	require.Equal(t, "24900000", quotes[0].NetAmount)
+	require.Equal(t, int32(2), quotes[1].Rank)
+	require.Equal(t, "VCB", quotes[1].SourceCode)
+	require.Equal(t, "24445000", quotes[1].NetAmount)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/lib/pq"
+)
+
+const (
+	RateAlertConditionAbove         = "above"
+	RateAlertConditionBelow         = "below"
+	RateAlertConditionPercentChange = "percent_change"
+
+	defaultRateAlertWindowHours = 24
+	maxRateAlertWindowHours     = 720
+)
+
+type RateAlertService struct {
+	store db.Store
+}
+
+func NewRateAlertService(store db.Store) *RateAlertService {
+	return &RateAlertService{store: store}
+}
+
+func (s *RateAlertService) CreateRateAlert(ctx context.Context, input CreateRateAlertInput) (RateAlert, error) {
+	if input.UserID <= 0 {
+		return RateAlert{}, Wrap(nil, ErrUnauthorized.Code, "user_id is required")
+	}
+	if input.SourceID <= 0 {
+		return RateAlert{}, Wrap(nil, ErrInvalidInput.Code, "source_id must be greater than 0")
+	}
+	if input.TypeID <= 0 {
+		return RateAlert{}, Wrap(nil, ErrInvalidInput.Code, "type_id must be greater than 0")
+	}
+	if input.FromCurrencyID <= 0 || input.ToCurrencyID <= 0 {
+		return RateAlert{}, Wrap(nil, ErrInvalidInput.Code, "from_currency_id and to_currency_id must be greater than 0")
+	}
+	if input.FromCurrencyID == input.ToCurrencyID {
+		return RateAlert{}, Wrap(nil, ErrInvalidInput.Code, "from_currency_id and to_currency_id must be different")
+	}
+	condition := normalizeRateAlertCondition(input.Condition)
+	windowHours := input.WindowHours
+	if windowHours == 0 {
+		windowHours = defaultRateAlertWindowHours
+	}
+	if err := validateRateAlertCriteria(condition, input.Threshold, input.ChangePercent, windowHours); err != nil {
+		return RateAlert{}, err
+	}
+
+	alert, err := s.store.CreateRateAlert(ctx, db.CreateRateAlertParams{
+		UserID:         input.UserID,
+		SourceID:       input.SourceID,
+		TypeID:         input.TypeID,
+		FromCurrencyID: input.FromCurrencyID,
+		ToCurrencyID:   input.ToCurrencyID,
+		Condition:      condition,
+		Threshold:      optionalString(input.Threshold),
+		ChangePercent:  optionalString(input.ChangePercent),
+		WindowHours:    windowHours,
+	})
+	if err != nil {
+		return RateAlert{}, wrapRateAlertDBError(err, "failed to create rate alert")
+	}
+
+	return NewRateAlert(alert), nil
+}
+
+func (s *RateAlertService) GetRateAlert(ctx context.Context, input GetRateAlertInput) (RateAlert, error) {
+	if input.AlertID <= 0 {
+		return RateAlert{}, Wrap(nil, ErrInvalidInput.Code, "alert_id must be greater than 0")
+	}
+
+	alert, err := s.getRateAlertForUser(ctx, input.AlertID, input.UserID)
+	if err != nil {
+		return RateAlert{}, err
+	}
+
+	return NewRateAlert(alert), nil
+}
+
+func (s *RateAlertService) ListRateAlerts(ctx context.Context, input ListRateAlertsInput) ([]RateAlert, error) {
+	if input.PageID <= 0 {
+		return nil, Wrap(nil, ErrInvalidInput.Code, "page_id must be greater than 0")
+	}
+	if input.PageSize < 5 || input.PageSize > 50 {
+		return nil, Wrap(nil, ErrInvalidInput.Code, "page_size must be between 5 and 50")
+	}
+
+	alerts, err := s.store.ListRateAlertsByUser(ctx, db.ListRateAlertsByUserParams{
+		UserID: input.UserID,
+		Limit:  input.PageSize,
+		Offset: (input.PageID - 1) * input.PageSize,
+	})
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to list rate alerts")
+	}
+
+	return NewRateAlerts(alerts), nil
+}
+
+func (s *RateAlertService) UpdateRateAlert(ctx context.Context, input UpdateRateAlertInput) (RateAlert, error) {
+	if input.AlertID <= 0 {
+		return RateAlert{}, Wrap(nil, ErrInvalidInput.Code, "alert_id must be greater than 0")
+	}
+
+	existing, err := s.getRateAlertForUser(ctx, input.AlertID, input.UserID)
+	if err != nil {
+		return RateAlert{}, err
+	}
+
+	condition := existing.Condition
+	if input.Condition != nil {
+		condition = normalizeRateAlertCondition(*input.Condition)
+	}
+	threshold := nullStringPtr(existing.Threshold)
+	if input.Threshold != nil {
+		threshold = input.Threshold
+	}
+	changePercent := nullStringPtr(existing.ChangePercent)
+	if input.ChangePercent != nil {
+		changePercent = input.ChangePercent
+	}
+	windowHours := existing.WindowHours
+	if input.WindowHours != nil {
+		windowHours = *input.WindowHours
+	}
+	if err := validateRateAlertCriteria(condition, threshold, changePercent, windowHours); err != nil {
+		return RateAlert{}, err
+	}
+
+	var conditionArg *string
+	if input.Condition != nil {
+		conditionArg = &condition
+	}
+	alert, err := s.store.UpdateRateAlert(ctx, db.UpdateRateAlertParams{
+		Condition:     optionalString(conditionArg),
+		Threshold:     optionalString(input.Threshold),
+		ChangePercent: optionalString(input.ChangePercent),
+		WindowHours:   optionalInt32(input.WindowHours),
+		IsActive:      optionalBool(input.IsActive),
+		AlertID:       input.AlertID,
+		UserID:        input.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return RateAlert{}, Wrap(err, ErrNotFound.Code, "rate alert not found")
+		}
+		return RateAlert{}, wrapRateAlertDBError(err, "failed to update rate alert")
+	}
+
+	return NewRateAlert(alert), nil
+}
+
+func (s *RateAlertService) DeleteRateAlert(ctx context.Context, input DeleteRateAlertInput) error {
+	if input.AlertID <= 0 {
+		return Wrap(nil, ErrInvalidInput.Code, "alert_id must be greater than 0")
+	}
+
+	deleted, err := s.store.DeleteRateAlert(ctx, db.DeleteRateAlertParams{
+		AlertID: input.AlertID,
+		UserID:  input.UserID,
+	})
+	if err != nil {
+		return Wrap(err, ErrInternal.Code, "failed to delete rate alert")
+	}
+	if deleted == 0 {
+		return Wrap(nil, ErrNotFound.Code, "rate alert not found")
+	}
+
+	return nil
+}
+
+func (s *RateAlertService) getRateAlertForUser(ctx context.Context, alertID, userID int32) (db.RateAlert, error) {
+	alert, err := s.store.GetRateAlertForUser(ctx, db.GetRateAlertForUserParams{
+		AlertID: alertID,
+		UserID:  userID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.RateAlert{}, Wrap(err, ErrNotFound.Code, "rate alert not found")
+		}
+		return db.RateAlert{}, Wrap(err, ErrInternal.Code, "failed to get rate alert")
+	}
+	return alert, nil
+}
+
+func validateRateAlertCriteria(condition string, threshold, changePercent *string, windowHours int32) error {
+	switch condition {
+	case RateAlertConditionAbove, RateAlertConditionBelow:
+		if !hasValue(threshold) {
+			return Wrap(nil, ErrInvalidInput.Code, "threshold is required for above and below alerts")
+		}
+		if value, err := parseDecimal(*threshold); err != nil || value <= 0 {
+			return Wrap(nil, ErrInvalidInput.Code, "threshold must be a positive decimal")
+		}
+	case RateAlertConditionPercentChange:
+		if !hasValue(changePercent) {
+			return Wrap(nil, ErrInvalidInput.Code, "change_percent is required for percent_change alerts")
+		}
+		if value, err := parseDecimal(*changePercent); err != nil || value <= 0 {
+			return Wrap(nil, ErrInvalidInput.Code, "change_percent must be a positive decimal")
+		}
+	default:
+		return Wrap(nil, ErrInvalidInput.Code, "condition must be one of above, below, percent_change")
+	}
+	if windowHours < 1 || windowHours > maxRateAlertWindowHours {
+		return Wrap(nil, ErrInvalidInput.Code, "window_hours must be between 1 and 720")
+	}
+	return nil
+}
+
+func normalizeRateAlertCondition(value string) string {
+	return strings.TrimSpace(strings.ToLower(value))
+}
+
+func wrapRateAlertDBError(err error, defaultMessage string) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "23503":
+			return Wrap(err, ErrInvalidInput.Code, "invalid reference id")
+		case "23514":
+			return Wrap(err, ErrInvalidInput.Code, "rate alert violates database constraints")
+		}
+	}
+	return Wrap(err, ErrInternal.Code, defaultMessage)
+}
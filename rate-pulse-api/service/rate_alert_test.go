@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRateAlertService(t *testing.T) (*RateAlertService, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = sqlDB.Close()
+	})
+
+	return NewRateAlertService(db.NewStore(sqlDB)), mock
+}
+
+func testDBRateAlert() db.RateAlert {
+	now := time.Date(2026, 5, 12, 12, 0, 0, 0, time.UTC)
+
+	return db.RateAlert{
+		AlertID:        3,
+		UserID:         9,
+		SourceID:       10,
+		TypeID:         4,
+		FromCurrencyID: 2,
+		ToCurrencyID:   1,
+		Condition:      RateAlertConditionAbove,
+		Threshold:      sql.NullString{String: "25800", Valid: true},
+		WindowHours:    24,
+		IsActive:       true,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+func rateAlertRows(alerts ...db.RateAlert) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{
+		"alert_id",
+		"user_id",
+		"source_id",
+		"type_id",
+		"from_currency_id",
+		"to_currency_id",
+		"condition",
+		"threshold",
+		"change_percent",
+		"window_hours",
+		"is_active",
+		"last_rate_id",
+		"last_rate_value",
+		"last_triggered_at",
+		"created_at",
+		"updated_at",
+	})
+
+	for _, alert := range alerts {
+		rows.AddRow(
+			alert.AlertID,
+			alert.UserID,
+			alert.SourceID,
+			alert.TypeID,
+			alert.FromCurrencyID,
+			alert.ToCurrencyID,
+			alert.Condition,
+			alert.Threshold,
+			alert.ChangePercent,
+			alert.WindowHours,
+			alert.IsActive,
+			alert.LastRateID,
+			alert.LastRateValue,
+			alert.LastTriggeredAt,
+			alert.CreatedAt,
+			alert.UpdatedAt,
+		)
+	}
+
+	return rows
+}
+
+func validCreateRateAlertInput() CreateRateAlertInput {
+	threshold := "25800"
+	return CreateRateAlertInput{
+		UserID:         9,
+		SourceID:       10,
+		TypeID:         4,
+		FromCurrencyID: 2,
+		ToCurrencyID:   1,
+		Condition:      "above",
+		Threshold:      &threshold,
+	}
+}
+
+func TestRateAlertServiceCreateRateAlertInvalidInput(t *testing.T) {
+	alertService, mock := newTestRateAlertService(t)
+	changePercent := "-1"
+
+	testCases := []struct {
+		name   string
+		mutate func(input *CreateRateAlertInput)
+	}{
+		{name: "same currency", mutate: func(input *CreateRateAlertInput) { input.ToCurrencyID = input.FromCurrencyID }},
+		{name: "unknown condition", mutate: func(input *CreateRateAlertInput) { input.Condition = "equals" }},
+		{name: "missing threshold", mutate: func(input *CreateRateAlertInput) { input.Threshold = nil }},
+		{name: "missing change percent", mutate: func(input *CreateRateAlertInput) { input.Condition = "percent_change" }},
+		{name: "negative change percent", mutate: func(input *CreateRateAlertInput) {
+			input.Condition = "percent_change"
+			input.ChangePercent = &changePercent
+		}},
+		{name: "window too long", mutate: func(input *CreateRateAlertInput) { input.WindowHours = 1000 }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := validCreateRateAlertInput()
+			tc.mutate(&input)
+
+			alert, err := alertService.CreateRateAlert(context.Background(), input)
+
+			requireServiceErrorCode(t, err, ErrInvalidInput.Code)
+			require.Empty(t, alert)
+		})
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRateAlertServiceCreateRateAlertSuccess(t *testing.T) {
+	alertService, mock := newTestRateAlertService(t)
+	dbAlert := testDBRateAlert()
+
+	mock.ExpectQuery("INSERT INTO rate_alerts").
+		WithArgs(int32(9), int32(10), int32(4), int32(2), int32(1), "above",
+			sql.NullString{String: "25800", Valid: true}, sql.NullString{}, int32(24)).
+		WillReturnRows(rateAlertRows(dbAlert))
+
+	alert, err := alertService.CreateRateAlert(context.Background(), validCreateRateAlertInput())
+
+	require.NoError(t, err)
+	require.Equal(t, dbAlert.AlertID, alert.AlertID)
+	require.Equal(t, "25800", *alert.Threshold)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRateAlertServiceUpdateRateAlertValidatesMergedCriteria(t *testing.T) {
+	alertService, mock := newTestRateAlertService(t)
+	condition := "percent_change"
+
+	mock.ExpectQuery("SELECT alert_id").
+		WithArgs(int32(3), int32(9)).
+		WillReturnRows(rateAlertRows(testDBRateAlert()))
+
+	alert, err := alertService.UpdateRateAlert(context.Background(), UpdateRateAlertInput{
+		UserID:    9,
+		AlertID:   3,
+		Condition: &condition,
+	})
+
+	requireServiceErrorCode(t, err, ErrInvalidInput.Code)
+	require.Empty(t, alert)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRateAlertServiceDeleteRateAlertNotFound(t *testing.T) {
+	alertService, mock := newTestRateAlertService(t)
+
+	mock.ExpectExec("DELETE FROM rate_alerts").
+		WithArgs(int32(3), int32(9)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := alertService.DeleteRateAlert(context.Background(), DeleteRateAlertInput{UserID: 9, AlertID: 3})
+
+	requireServiceErrorCode(t, err, ErrNotFound.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,188 @@
+/*
+rate source service is responsible for browsing the configured rate sources.
+*/
+package service
+
+import (
+	"context"
+	"strings"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+)
+
+type RateSourceService struct {
+	store db.Store
+}
+
+func NewRateSourceService(store db.Store) *RateSourceService {
+	return &RateSourceService{store: store}
+}
+
+/*
+ListRateSources Service is responsible for listing rate sources one cursor page at a time.
+- Validate cursor, page_size and sort (source_name or source_id, "-" for descending)
+- Call the store.ListRateSourcesPage* query of the sort with the status and country filters
+- Return next_cursor when more rate sources follow
+- Return ErrInvalidInput or ErrInternal
+*/
+func (s *RateSourceService) ListRateSources(ctx context.Context, input ListRateSourcesInput) (RateSourcePage, error) {
+	query, err := newPageQuery(input.Page, "source_id", "source_name", "source_id")
+	if err != nil {
+		return RateSourcePage{}, err
+	}
+
+	rateSources, err := s.listRateSources(ctx, query, db.ListRateSourcesPageByNameParams{
+		SourceStatus:  optionalString(input.Status),
+		SourceCountry: optionalString(input.Country),
+		AfterID:       query.afterID(),
+		AfterName:     query.afterText(),
+		PageLimit:     query.limit(),
+	})
+	if err != nil {
+		return RateSourcePage{}, Wrap(err, ErrInternal.Code, "failed to list rate sources")
+	}
+
+	rateSources, hasMore := trimPage(query, rateSources)
+	page := RateSourcePage{RateSources: rateSources}
+	if hasMore {
+		last := rateSources[len(rateSources)-1]
+		value := ""
+		if query.sort.Key == "source_name" {
+			value = last.SourceName
+		}
+		page.NextCursor = query.cursor(value, last.SourceID)
+	}
+	return page, nil
+}
+
+/*
+listRateSources runs the keyset query of the page's sort; arg.AfterName is ignored when sorting by id.
+The rows of every sort order have the same columns, so they are returned as ListRateSourcesPageRow.
+*/
+func (s *RateSourceService) listRateSources(ctx context.Context, query pageQuery, arg db.ListRateSourcesPageByNameParams) ([]db.ListRateSourcesPageRow, error) {
+	byID := db.ListRateSourcesPageParams{
+		SourceStatus:  arg.SourceStatus,
+		SourceCountry: arg.SourceCountry,
+		AfterID:       arg.AfterID,
+		PageLimit:     arg.PageLimit,
+	}
+	switch {
+	case query.sort.Key == "source_name" && query.sort.Desc:
+		rows, err := s.store.ListRateSourcesPageByNameDesc(ctx, db.ListRateSourcesPageByNameDescParams(arg))
+		return rateSourcePageRows(rows), err
+	case query.sort.Key == "source_name":
+		rows, err := s.store.ListRateSourcesPageByName(ctx, arg)
+		return rateSourcePageRows(rows), err
+	case query.sort.Desc:
+		rows, err := s.store.ListRateSourcesPageDesc(ctx, db.ListRateSourcesPageDescParams(byID))
+		return rateSourcePageRows(rows), err
+	default:
+		return s.store.ListRateSourcesPage(ctx, byID)
+	}
+}
+
+func rateSourcePageRows[T db.ListRateSourcesPageByNameRow | db.ListRateSourcesPageByNameDescRow | db.ListRateSourcesPageDescRow](rows []T) []db.ListRateSourcesPageRow {
+	pageRows := make([]db.ListRateSourcesPageRow, len(rows))
+	for i, row := range rows {
+		pageRows[i] = db.ListRateSourcesPageRow(row)
+	}
+	return pageRows
+}
+
+func (s *RateSourceService) GetRateSource(ctx context.Context, sourceID int32) (RateSource, error) {
+	if sourceID <= 0 {
+		return RateSource{}, Wrap(nil, ErrInvalidInput.Code, "source_id must be greater than 0")
+	}
+
+	rateSource, err := s.store.GetRateSourceByID(ctx, sourceID)
+	if err != nil {
+		return RateSource{}, wrapRecordDBError(err, "rate source", "failed to get rate source")
+	}
+	return NewRateSourceFromGetRow(rateSource), nil
+}
+
+/*
+CreateRateSource Service is responsible for adding a rate source.
+- Require source_name, source_link, source_country, source_status and source_code
+- Return ErrInvalidInput for a duplicate source
+*/
+func (s *RateSourceService) CreateRateSource(ctx context.Context, input CreateRateSourceInput) (RateSource, error) {
+	for _, field := range []struct{ name, value string }{
+		{"source_name", input.SourceName},
+		{"source_link", input.SourceLink},
+		{"source_country", input.SourceCountry},
+		{"source_status", input.SourceStatus},
+		{"source_code", input.SourceCode},
+	} {
+		if strings.TrimSpace(field.value) == "" {
+			return RateSource{}, Wrap(nil, ErrInvalidInput.Code, field.name+" is required")
+		}
+	}
+
+	rateSource, err := s.store.CreateRateSource(ctx, db.CreateRateSourceParams{
+		SourceName:    strings.TrimSpace(input.SourceName),
+		SourceLink:    optionalString(&input.SourceLink),
+		SourceCountry: optionalString(&input.SourceCountry),
+		SourceStatus:  optionalString(&input.SourceStatus),
+		SourceCode:    optionalString(&input.SourceCode),
+	})
+	if err != nil {
+		return RateSource{}, wrapRecordDBError(err, "rate source", "failed to create rate source")
+	}
+	return NewRateSource(rateSource), nil
+}
+
+/*
+UpdateRateSource Service is responsible for editing a rate source.
+- Keep the current value of every field that is not set
+- Validate expected_interval_minutes (5 minutes to 7 days) and store it separately
+- Return ErrNotFound for an unknown source_id
+*/
+func (s *RateSourceService) UpdateRateSource(ctx context.Context, input UpdateRateSourceInput) (RateSource, error) {
+	if input.ExpectedIntervalMinutes != nil && (*input.ExpectedIntervalMinutes < 5 || *input.ExpectedIntervalMinutes > 10080) {
+		return RateSource{}, Wrap(nil, ErrInvalidInput.Code, "expected_interval_minutes must be between 5 and 10080")
+	}
+	current, err := s.GetRateSource(ctx, input.SourceID)
+	if err != nil {
+		return RateSource{}, err
+	}
+
+	sourceName := current.SourceName
+	if hasValue(input.SourceName) {
+		sourceName = strings.TrimSpace(*input.SourceName)
+	}
+
+	rateSource, err := s.store.UpdateRateSource(ctx, db.UpdateRateSourceParams{
+		SourceID:      input.SourceID,
+		SourceName:    sourceName,
+		SourceLink:    optionalString(input.SourceLink),
+		SourceCountry: optionalString(input.SourceCountry),
+		SourceStatus:  optionalString(input.SourceStatus),
+		SourceCode:    optionalString(input.SourceCode),
+	})
+	if err != nil {
+		return RateSource{}, wrapRecordDBError(err, "rate source", "failed to update rate source")
+	}
+
+	if input.ExpectedIntervalMinutes != nil {
+		rateSource, err = s.store.UpdateRateSourceExpectedInterval(ctx, db.UpdateRateSourceExpectedIntervalParams{
+			SourceID:                input.SourceID,
+			ExpectedIntervalMinutes: *input.ExpectedIntervalMinutes,
+		})
+		if err != nil {
+			return RateSource{}, wrapRecordDBError(err, "rate source", "failed to update rate source")
+		}
+	}
+	return NewRateSource(rateSource), nil
+}
+
+func (s *RateSourceService) DeleteRateSource(ctx context.Context, sourceID int32) error {
+	if sourceID <= 0 {
+		return Wrap(nil, ErrInvalidInput.Code, "source_id must be greater than 0")
+	}
+
+	if err := s.store.DeleteRateSource(ctx, sourceID); err != nil {
+		return wrapRecordDBError(err, "rate source", "failed to delete rate source")
+	}
+	return nil
+}
@@ -77,34 +77,72 @@ func (s *RateSourceFeeRuleService) GetRateSourceFeeRule(ctx context.Context, inp
 	return NewRateSourceFeeRule(rule), nil
 }
 
-func (s *RateSourceFeeRuleService) ListRateSourceFeeRules(ctx context.Context, input ListRateSourceFeeRulesInput) ([]RateSourceFeeRule, error) {
+func (s *RateSourceFeeRuleService) ListRateSourceFeeRules(ctx context.Context, input ListRateSourceFeeRulesInput) (RateSourceFeeRulePage, error) {
 	if input.SourceID != nil && *input.SourceID <= 0 {
-		return nil, Wrap(nil, ErrInvalidInput.Code, "source_id must be greater than 0")
+		return RateSourceFeeRulePage{}, Wrap(nil, ErrInvalidInput.Code, "source_id must be greater than 0")
+	}
+	if input.TypeID != nil && *input.TypeID <= 0 {
+		return RateSourceFeeRulePage{}, Wrap(nil, ErrInvalidInput.Code, "type_id must be greater than 0")
+	}
+	query, err := newPageQuery(input.Page, "fee_rule_id", "effective_from", "fee_rule_id")
+	if err != nil {
+		return RateSourceFeeRulePage{}, err
+	}
+	afterDate, err := query.afterDate()
+	if err != nil {
+		return RateSourceFeeRulePage{}, err
 	}
 
-	var (
-		rules []db.RateSourceFeeRule
-		err   error
-	)
+	var activeOn sql.NullTime
+	if input.ActiveOn != nil {
+		activeOn = sql.NullTime{Time: startOfDay(*input.ActiveOn), Valid: true}
+	}
+
+	rules, err := s.listRateSourceFeeRules(ctx, query, db.ListRateSourceFeeRulesByEffectiveFromParams{
+		SourceID:  optionalInt32(input.SourceID),
+		TypeID:    optionalInt32(input.TypeID),
+		ActiveOn:  activeOn,
+		AfterID:   query.afterID(),
+		AfterDate: afterDate,
+		PageLimit: query.limit(),
+	})
+	if err != nil {
+		return RateSourceFeeRulePage{}, Wrap(err, ErrInternal.Code, "failed to list rate source fee rules")
+	}
 
-	if input.SourceID != nil && input.ActiveOn != nil {
-		rules, err = s.store.ListActiveRateSourceFeeRulesBySource(ctx, db.ListActiveRateSourceFeeRulesBySourceParams{
-			SourceID:      *input.SourceID,
-			EffectiveFrom: startOfDay(*input.ActiveOn),
-		})
-	} else if input.SourceID != nil {
-		rules, err = s.store.ListRateSourceFeeRulesBySource(ctx, *input.SourceID)
-	} else {
-		rules, err = s.store.ListRateSourceFeeRules(ctx)
-		if err == nil && input.ActiveOn != nil {
-			rules = filterActiveRateSourceFeeRules(rules, *input.ActiveOn)
+	rules, hasMore := trimPage(query, rules)
+	page := RateSourceFeeRulePage{FeeRules: NewRateSourceFeeRules(rules)}
+	if hasMore {
+		last := rules[len(rules)-1]
+		value := ""
+		if query.sort.Key == "effective_from" {
+			value = last.EffectiveFrom.Format(pageCursorDateLayout)
 		}
+		page.NextCursor = query.cursor(value, last.FeeRuleID)
 	}
-	if err != nil {
-		return nil, Wrap(err, ErrInternal.Code, "failed to list rate source fee rules")
+	return page, nil
+}
+
+// listRateSourceFeeRules runs the keyset query of the page's sort; arg.AfterDate is ignored when sorting by id.
+func (s *RateSourceFeeRuleService) listRateSourceFeeRules(ctx context.Context, query pageQuery, arg db.ListRateSourceFeeRulesByEffectiveFromParams) ([]db.RateSourceFeeRule, error) {
+	if query.sort.Key == "effective_from" {
+		if query.sort.Desc {
+			return s.store.ListRateSourceFeeRulesByEffectiveFromDesc(ctx, db.ListRateSourceFeeRulesByEffectiveFromDescParams(arg))
+		}
+		return s.store.ListRateSourceFeeRulesByEffectiveFrom(ctx, arg)
 	}
 
-	return NewRateSourceFeeRules(rules), nil
+	byID := db.ListRateSourceFeeRulesParams{
+		SourceID:  arg.SourceID,
+		TypeID:    arg.TypeID,
+		ActiveOn:  arg.ActiveOn,
+		AfterID:   arg.AfterID,
+		PageLimit: arg.PageLimit,
+	}
+	if query.sort.Desc {
+		return s.store.ListRateSourceFeeRulesDesc(ctx, db.ListRateSourceFeeRulesDescParams(byID))
+	}
+	return s.store.ListRateSourceFeeRules(ctx, byID)
 }
 
 func (s *RateSourceFeeRuleService) GetActiveRateSourceFeeRule(ctx context.Context, input GetActiveRateSourceFeeRuleInput) (RateSourceFeeRule, error) {
@@ -375,21 +413,6 @@ func parseDecimal(value string) (float64, error) {
 	return parsed, nil
 }
 
-func filterActiveRateSourceFeeRules(rules []db.RateSourceFeeRule, activeOn time.Time) []db.RateSourceFeeRule {
-	date := startOfDay(activeOn)
-	active := make([]db.RateSourceFeeRule, 0, len(rules))
-	for _, rule := range rules {
-		if rule.EffectiveFrom.After(date) {
-			continue
-		}
-		if rule.EffectiveTo.Valid && rule.EffectiveTo.Time.Before(date) {
-			continue
-		}
-		active = append(active, rule)
-	}
-	return active
-}
-
 func normalizeTransactionType(value string) string {
 	value = strings.TrimSpace(strings.ToLower(value))
 	if value == "" {
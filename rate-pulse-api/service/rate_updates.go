@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
+	"github.com/rs/zerolog/log"
+)
+
+// publishRateUpdates announces newly written active rates to live subscribers.
+// The rates are already stored, so a publish failure is logged instead of failing the write.
+func (s *FXService) publishRateUpdates(ctx context.Context, rates ...db.ExchangeRate) {
+	if s.rateUpdates == nil {
+		return
+	}
+
+	updates := make([]pubsub.RateUpdate, 0, len(rates))
+	for _, rate := range rates {
+		if rate.Status == ExchangeRateStatusActive {
+			updates = append(updates, NewRateUpdate(rate))
+		}
+	}
+	if len(updates) == 0 {
+		return
+	}
+
+	if err := s.rateUpdates.PublishRateUpdates(ctx, updates); err != nil {
+		log.Error().Err(err).Int("rates", len(updates)).Msg("cannot publish exchange rate updates")
+	}
+}
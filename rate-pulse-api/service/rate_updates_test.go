@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingRateUpdates struct {
+	updates []pubsub.RateUpdate
+}
+
+func (r *recordingRateUpdates) PublishRateUpdates(ctx context.Context, updates []pubsub.RateUpdate) error {
+	r.updates = append(r.updates, updates...)
+	return nil
+}
+
+func TestFXServiceCreateExchangeRatePublishesActiveRate(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	published := &recordingRateUpdates{}
+	fxService.rateUpdates = published
+	dbRate := testExchangeRateForFXService()
+
+	mock.ExpectQuery("WITH history AS").WillReturnRows(anomalyBaselineRows(0, "0", "0", "0", 0, "0"))
+	mock.ExpectQuery("INSERT INTO exchange_rates").WillReturnRows(exchangeRateRows(dbRate))
+
+	_, err := fxService.CreateExchangeRate(context.Background(), CreateExchangeRateInput{
+		RateValue:             dbRate.RateValue,
+		SourceCurrencyID:      dbRate.SourceCurrencyID,
+		DestinationCurrencyID: dbRate.DestinationCurrencyID,
+		ValidFromDate:         dbRate.ValidFromDate,
+		SourceID:              dbRate.SourceID.Int32,
+		TypeID:                dbRate.TypeID.Int32,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, published.updates, 1)
+	require.Equal(t, dbRate.RateID, published.updates[0].RateID)
+	require.Equal(t, dbRate.SourceID.Int32, published.updates[0].SourceID)
+	require.Equal(t, dbRate.UpdatedAt.Time, published.updates[0].UpdatedAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceIngestExchangeRatesPublishesOnlyActiveRates(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	published := &recordingRateUpdates{}
+	fxService.rateUpdates = published
+	expectIngestLookups(mock)
+
+	next := validIngestExchangeRateRow()
+	next.ValidFromDate = next.ValidFromDate.Add(2 * time.Hour)
+	next.RateValue = "90000"
+	active := ingestedExchangeRate()
+	quarantined := ingestedExchangeRate()
+	quarantined.RateID++
+	quarantined.ValidFromDate = next.ValidFromDate
+	quarantined.Status = ExchangeRateStatusQuarantined
+	quarantined.QuarantineReason = sql.NullString{String: "deviates", Valid: true}
+	// 90000 is far from the series' median of 25000, 25000 is not.
+	mock.ExpectQuery("CROSS JOIN LATERAL").WillReturnRows(ingestAnomalyBaselineRows(10, "25000", "5", "25000", 0, "0"))
+	expectIngestSeriesLock(mock)
+	mock.ExpectQuery("INSERT INTO exchange_rates").WillReturnRows(exchangeRateRows(active, quarantined))
+	mock.ExpectExec("UPDATE exchange_rates er").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	result, err := fxService.IngestExchangeRates(context.Background(), IngestExchangeRatesInput{
+		Rates: []IngestExchangeRateRow{validIngestExchangeRateRow(), next},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, int32(1), result.Inserted)
+	require.Equal(t, int32(1), result.Quarantined)
+	require.Len(t, published.updates, 1)
+	require.Equal(t, active.RateID, published.updates[0].RateID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
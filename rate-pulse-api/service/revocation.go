@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/rs/zerolog/log"
+)
+
+// revokeAccessTokens makes every access token of the user stop working, so a sign-out,
+// password change, deactivation or downgrade takes effect at once instead of at token expiry.
+// Other devices renew through their sessions, which reload the user. The change behind the
+// revocation is already stored, so a failure is logged instead of failing the request.
+func revokeAccessTokens(ctx context.Context, revocations token.RevocationList, userID int32) {
+	if revocations == nil {
+		return
+	}
+
+	if err := revocations.RevokeUserTokens(ctx, userID); err != nil {
+		log.Error().Err(err).Int32("user_id", userID).Msg("cannot revoke access tokens")
+	}
+}
+
+// signOutEverywhere blocks every session of the user and revokes their access tokens, after a
+// change that should not let any device stay signed in, such as a new password.
+func signOutEverywhere(ctx context.Context, store db.Store, revocations token.RevocationList, userID int32) {
+	if _, err := store.BlockUserSessions(ctx, userID); err != nil {
+		log.Error().Err(err).Int32("user_id", userID).Msg("cannot block sessions")
+	}
+	revokeAccessTokens(ctx, revocations, userID)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/pubsub"
 	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/ThanhVinhTong/rate-pulse/totp"
 	"github.com/ThanhVinhTong/rate-pulse/util"
 	"github.com/ThanhVinhTong/rate-pulse/worker"
 )
@@ -15,42 +17,78 @@ type Services struct {
 	Users    UserUseCase
 	FX       FXUseCase
 	FeeRules RateSourceFeeRuleUseCase
+	Alerts   RateAlertUseCase
 	Health   HealthUseCase
+
+	Payments      PaymentUseCase
+	Subscriptions UserSubscriptionUseCase
+	RateSources   RateSourceUseCase
+	Currencies    CurrencyUseCase
+	Countries     CountryUseCase
+	Plans         SubscriptionPlanUseCase
+	Preferences   PreferenceUseCase
+	APIKeys       APIKeyUseCase
+	Quotas        QuotaUseCase
+	Sessions      SessionUseCase
+	MFA           MFAUseCase
 }
 
 func NewServices(
 	config util.Config,
 	store db.Store,
 	tokenMaker token.Maker,
+	revocations token.RevocationList,
+	totpCipher *totp.SecretCipher,
 	taskDistributor worker.TaskDistributor,
+	rateUpdates pubsub.Publisher,
 ) *Services {
 	return &Services{
-		Auth:     NewAuthService(config, store, tokenMaker, taskDistributor),
-		Users:    NewUserService(store),
-		FX:       NewFXService(store),
+		Auth:     NewAuthService(config, store, tokenMaker, revocations, totpCipher, taskDistributor),
+		Users:    NewUserService(store, revocations, taskDistributor),
+		FX:       NewFXService(config, store, rateUpdates),
 		FeeRules: NewRateSourceFeeRuleService(store),
+		Alerts:   NewRateAlertService(store),
 		Health:   NewHealthService(store),
+
+		Payments:      NewPaymentService(store),
+		Subscriptions: NewUserSubscriptionService(store),
+		RateSources:   NewRateSourceService(store),
+		Currencies:    NewCurrencyService(store),
+		Countries:     NewCountryService(store),
+		Plans:         NewSubscriptionPlanService(store),
+		Preferences:   NewPreferenceService(store),
+		APIKeys:       NewAPIKeyService(store),
+		Quotas:        NewQuotaService(config, store),
+		Sessions:      NewSessionService(store, revocations),
+		MFA:           NewMFAService(store, totpCipher),
 	}
 }
 
 type AuthUseCase interface {
 	CreateUser(ctx context.Context, input CreateUserInput) (User, error)
 	SignIn(ctx context.Context, input SignInInput) (SignInResult, error)
+	EnrollSignInTOTP(ctx context.Context, input EnrollSignInTOTPInput) (TOTPEnrollment, error)
+	CompleteSignInMFA(ctx context.Context, input CompleteSignInMFAInput) (SignInResult, error)
 	RenewAccessToken(ctx context.Context, input RenewAccessTokenInput) (RenewAccessTokenResult, error)
 	VerifyEmail(ctx context.Context, input VerifyEmailInput) (VerifyEmailResult, error)
-	SignOut(ctx context.Context, refreshToken string) error
+	ResendVerifyEmail(ctx context.Context, input ResendVerifyEmailInput) error
+	RequestPasswordReset(ctx context.Context, input RequestPasswordResetInput) error
+	ConfirmPasswordReset(ctx context.Context, input ConfirmPasswordResetInput) error
+	SignOut(ctx context.Context, input SignOutInput) error
 }
 
 type HealthUseCase interface {
 	CheckHealth(ctx context.Context) CheckHealthResult
+	GetRateSourceFreshness(ctx context.Context) (RateSourceFreshnessReport, error)
 }
 
 type UserUseCase interface {
 	GetUser(ctx context.Context, input GetUserInput) (User, error)
-	ListUsers(ctx context.Context, input ListUsersInput) ([]User, error)
+	ListUsers(ctx context.Context, input ListUsersInput) (UserPage, error)
 	UpdateUser(ctx context.Context, input UpdateUserInput) (User, error)
 	AdminUpdateUser(ctx context.Context, input AdminUpdateUserInput) (User, error)
 	DeleteUser(ctx context.Context, input DeleteUserInput) error
+	RequireVerifiedEmail(ctx context.Context, userID int32) error
 }
 
 type FXUseCase interface {
@@ -60,13 +98,119 @@ type FXUseCase interface {
 	UpdateExchangeRate(ctx context.Context, input UpdateExchangeRateInput) (ExchangeRate, error)
 	DeleteExchangeRate(ctx context.Context, input DeleteExchangeRateInput) error
 	GetHistoricalData(ctx context.Context, input GetHistoricalDataInput) ([]HistoricalDataPoint, error)
+	GetCandles(ctx context.Context, input GetCandlesInput) ([]Candle, error)
+	Quote(ctx context.Context, input QuoteInput) (Quote, error)
+	CompareQuotes(ctx context.Context, input CompareQuotesInput) ([]RankedQuote, error)
+	GetCrossRate(ctx context.Context, input CrossRateInput) (CrossRate, error)
+	GetSpreads(ctx context.Context, input GetSpreadsInput) (ExchangeRateSpreads, error)
+	IngestExchangeRates(ctx context.Context, input IngestExchangeRatesInput) (IngestExchangeRatesResult, error)
+	ListQuarantinedExchangeRates(ctx context.Context, input ListQuarantinedExchangeRatesInput) ([]ExchangeRate, error)
+	ReviewQuarantinedExchangeRate(ctx context.Context, input ReviewQuarantinedExchangeRateInput) (ExchangeRate, error)
 }
 
 type RateSourceFeeRuleUseCase interface {
 	CreateRateSourceFeeRule(ctx context.Context, input CreateRateSourceFeeRuleInput) (RateSourceFeeRule, error)
 	GetRateSourceFeeRule(ctx context.Context, input GetRateSourceFeeRuleInput) (RateSourceFeeRule, error)
-	ListRateSourceFeeRules(ctx context.Context, input ListRateSourceFeeRulesInput) ([]RateSourceFeeRule, error)
+	ListRateSourceFeeRules(ctx context.Context, input ListRateSourceFeeRulesInput) (RateSourceFeeRulePage, error)
 	GetActiveRateSourceFeeRule(ctx context.Context, input GetActiveRateSourceFeeRuleInput) (RateSourceFeeRule, error)
 	UpdateRateSourceFeeRule(ctx context.Context, input UpdateRateSourceFeeRuleInput) (RateSourceFeeRule, error)
 	DeleteRateSourceFeeRule(ctx context.Context, input DeleteRateSourceFeeRuleInput) error
 }
+
+type RateAlertUseCase interface {
+	CreateRateAlert(ctx context.Context, input CreateRateAlertInput) (RateAlert, error)
+	GetRateAlert(ctx context.Context, input GetRateAlertInput) (RateAlert, error)
+	ListRateAlerts(ctx context.Context, input ListRateAlertsInput) ([]RateAlert, error)
+	UpdateRateAlert(ctx context.Context, input UpdateRateAlertInput) (RateAlert, error)
+	DeleteRateAlert(ctx context.Context, input DeleteRateAlertInput) error
+}
+
+type PaymentUseCase interface {
+	CreatePayment(ctx context.Context, input CreatePaymentInput) (Payment, error)
+	ListPayments(ctx context.Context, input ListPaymentsInput) (PaymentPage, error)
+	ListUserPayments(ctx context.Context, userID int32) ([]Payment, error)
+	GetPayment(ctx context.Context, paymentID int32) (Payment, error)
+	GetUserPayment(ctx context.Context, userID int32, paymentID int32) (Payment, error)
+	UpdatePayment(ctx context.Context, input UpdatePaymentInput) (Payment, error)
+	DeletePayment(ctx context.Context, paymentID int32) error
+}
+
+type UserSubscriptionUseCase interface {
+	CreateUserSubscription(ctx context.Context, input CreateUserSubscriptionInput) (UserSubscription, error)
+	ListUserSubscriptions(ctx context.Context, input ListUserSubscriptionsInput) (UserSubscriptionPage, error)
+	ListUserSubscriptionsForUser(ctx context.Context, userID int32) ([]UserSubscription, error)
+	GetActiveUserSubscription(ctx context.Context, userID int32) (UserSubscription, error)
+	UpdateUserSubscription(ctx context.Context, input UpdateUserSubscriptionInput) (UserSubscription, error)
+	DeleteUserSubscription(ctx context.Context, subscriptionID int32) error
+}
+
+type RateSourceUseCase interface {
+	ListRateSources(ctx context.Context, input ListRateSourcesInput) (RateSourcePage, error)
+	GetRateSource(ctx context.Context, sourceID int32) (RateSource, error)
+	CreateRateSource(ctx context.Context, input CreateRateSourceInput) (RateSource, error)
+	UpdateRateSource(ctx context.Context, input UpdateRateSourceInput) (RateSource, error)
+	DeleteRateSource(ctx context.Context, sourceID int32) error
+}
+
+type CurrencyUseCase interface {
+	ListCurrencies(ctx context.Context) ([]Currency, error)
+	GetCurrency(ctx context.Context, currencyID int32) (Currency, error)
+	CreateCurrency(ctx context.Context, input CreateCurrencyInput) (Currency, error)
+	UpdateCurrency(ctx context.Context, input UpdateCurrencyInput) (Currency, error)
+	DeleteCurrency(ctx context.Context, currencyID int32) error
+}
+
+type CountryUseCase interface {
+	ListCountries(ctx context.Context) ([]Country, error)
+	GetCountry(ctx context.Context, countryID int32) (Country, error)
+	GetCountryByCode(ctx context.Context, countryCode string) (Country, error)
+	CreateCountry(ctx context.Context, input CreateCountryInput) (Country, error)
+	UpdateCountry(ctx context.Context, input UpdateCountryInput) (Country, error)
+	DeleteCountry(ctx context.Context, countryID int32) error
+}
+
+type SubscriptionPlanUseCase interface {
+	ListSubscriptionPlans(ctx context.Context, includeInactive bool) ([]SubscriptionPlan, error)
+	GetSubscriptionPlan(ctx context.Context, planID int32) (SubscriptionPlan, error)
+	CreateSubscriptionPlan(ctx context.Context, input CreateSubscriptionPlanInput) (SubscriptionPlan, error)
+	UpdateSubscriptionPlan(ctx context.Context, input UpdateSubscriptionPlanInput) (SubscriptionPlan, error)
+	DeleteSubscriptionPlan(ctx context.Context, planID int32) error
+}
+
+type PreferenceUseCase interface {
+	CreateCurrencyPreference(ctx context.Context, input CurrencyPreferenceInput) (CurrencyPreference, error)
+	ListCurrencyPreferences(ctx context.Context, input ListPreferencesInput) ([]CurrencyPreference, error)
+	UpdateCurrencyPreference(ctx context.Context, input CurrencyPreferenceInput) (CurrencyPreference, error)
+	DeleteCurrencyPreference(ctx context.Context, userID int32, currencyID int32) error
+	CreateRateSourcePreference(ctx context.Context, input RateSourcePreferenceInput) (RateSourcePreference, error)
+	ListRateSourcePreferences(ctx context.Context, input ListPreferencesInput) ([]RateSourcePreference, error)
+	UpdateRateSourcePreference(ctx context.Context, input RateSourcePreferenceInput) (RateSourcePreference, error)
+	DeleteRateSourcePreference(ctx context.Context, userID int32, sourceID int32) error
+}
+
+type APIKeyUseCase interface {
+	CreateAPIKey(ctx context.Context, input CreateAPIKeyInput) (CreatedAPIKey, error)
+	ListAPIKeys(ctx context.Context, userID int32) ([]APIKey, error)
+	RevokeAPIKey(ctx context.Context, input RevokeAPIKeyInput) (APIKey, error)
+	AuthenticateAPIKey(ctx context.Context, rawKey string) (APIKeyPrincipal, error)
+}
+
+type QuotaUseCase interface {
+	ConsumeDailyQuota(ctx context.Context, input QuotaInput) (Usage, error)
+	GetUsage(ctx context.Context, input QuotaInput) (Usage, error)
+}
+
+type SessionUseCase interface {
+	ListSessions(ctx context.Context, userID int32) ([]Session, error)
+	RevokeSession(ctx context.Context, input RevokeSessionInput) error
+	RevokeAllSessions(ctx context.Context, userID int32) (RevokedSessions, error)
+	BlockUserSessions(ctx context.Context, userID int32) (RevokedSessions, error)
+}
+
+type MFAUseCase interface {
+	GetMFAStatus(ctx context.Context, userID int32) (MFAStatus, error)
+	EnrollTOTP(ctx context.Context, userID int32) (TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, input MFACodeInput) (RecoveryCodes, error)
+	DisableTOTP(ctx context.Context, input MFACodeInput) error
+	RegenerateRecoveryCodes(ctx context.Context, input MFACodeInput) (RecoveryCodes, error)
+}
@@ -0,0 +1,119 @@
+/*
+session service is responsible for a user's signed-in devices.
+It lists the active sessions of a user and revokes one, all of them,
+or, for admins, every session of another user.
+*/
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/token"
+	"github.com/google/uuid"
+)
+
+type SessionService struct {
+	store       db.Store
+	revocations token.RevocationList
+}
+
+func NewSessionService(store db.Store, revocations token.RevocationList) *SessionService {
+	return &SessionService{store: store, revocations: revocations}
+}
+
+/*
+ListSessions Service is responsible for listing the devices a user is signed in on.
+- Blocked and expired sessions are left out
+- Most recently used first
+*/
+func (s *SessionService) ListSessions(ctx context.Context, userID int32) ([]Session, error) {
+	if userID <= 0 {
+		return nil, Wrap(nil, ErrUnauthorized.Code, "user_id is required")
+	}
+
+	sessions, err := s.store.ListActiveSessionsByUser(ctx, userID)
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to list sessions")
+	}
+
+	return NewSessions(sessions), nil
+}
+
+/*
+RevokeSession Service is responsible for signing a user out of one device.
+- Block the session so its refresh token can no longer be renewed
+- Revoke every access token of the user by bumping their token epoch; the other devices renew theirs
+- Return ErrNotFound when the session is not an active session of the user
+*/
+func (s *SessionService) RevokeSession(ctx context.Context, input RevokeSessionInput) error {
+	if input.UserID <= 0 {
+		return Wrap(nil, ErrUnauthorized.Code, "user_id is required")
+	}
+	if input.SessionID == uuid.Nil {
+		return Wrap(nil, ErrInvalidInput.Code, "session_id is required")
+	}
+
+	revoked, err := s.store.BlockSession(ctx, db.BlockSessionParams{
+		SessionID: input.SessionID,
+		UserID:    input.UserID,
+	})
+	if err != nil {
+		return Wrap(err, ErrInternal.Code, "failed to revoke session")
+	}
+	if revoked == 0 {
+		return Wrap(nil, ErrNotFound.Code, "session not found")
+	}
+
+	revokeAccessTokens(ctx, s.revocations, input.UserID)
+	return nil
+}
+
+/*
+RevokeAllSessions Service is responsible for signing a user out everywhere.
+- Block all of their active sessions so no refresh token can be renewed
+- Revoke their access tokens
+- Return the number of sessions blocked
+*/
+func (s *SessionService) RevokeAllSessions(ctx context.Context, userID int32) (RevokedSessions, error) {
+	if userID <= 0 {
+		return RevokedSessions{}, Wrap(nil, ErrUnauthorized.Code, "user_id is required")
+	}
+
+	revoked, err := s.store.BlockUserSessions(ctx, userID)
+	if err != nil {
+		return RevokedSessions{}, Wrap(err, ErrInternal.Code, "failed to revoke sessions")
+	}
+	revokeAccessTokens(ctx, s.revocations, userID)
+
+	return RevokedSessions{Revoked: revoked}, nil
+}
+
+/*
+BlockUserSessions Service is responsible for an admin blocking another user's sessions.
+- Check that the user exists
+- Block all of their active sessions and revoke their access tokens
+- Return ErrInvalidInput, ErrNotFound or ErrInternal
+*/
+func (s *SessionService) BlockUserSessions(ctx context.Context, userID int32) (RevokedSessions, error) {
+	if userID <= 0 {
+		return RevokedSessions{}, Wrap(nil, ErrInvalidInput.Code, "user_id is required")
+	}
+
+	if _, err := s.store.GetUserByID(ctx, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return RevokedSessions{}, Wrap(err, ErrNotFound.Code, "user not found")
+		}
+		return RevokedSessions{}, Wrap(err, ErrInternal.Code, "failed to get user")
+	}
+
+	revoked, err := s.store.BlockUserSessions(ctx, userID)
+	if err != nil {
+		return RevokedSessions{}, Wrap(err, ErrInternal.Code, "failed to block sessions")
+	}
+	revokeAccessTokens(ctx, s.revocations, userID)
+
+	return RevokedSessions{Revoked: revoked}, nil
+}
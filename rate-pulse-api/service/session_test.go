@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSessionService(t *testing.T) (*SessionService, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = sqlDB.Close()
+	})
+
+	return NewSessionService(db.NewStore(sqlDB), nil), mock
+}
+
+func TestSessionServiceListSessions(t *testing.T) {
+	sessionService, mock := newTestSessionService(t)
+	now := time.Now()
+	session := db.Session{
+		SessionID:    uuid.New(),
+		UserID:       42,
+		RefreshToken: "secret",
+		UserAgent:    "Firefox",
+		ClientIp:     "10.0.0.1",
+		ExpiresAt:    now.Add(time.Hour),
+		CreatedAt:    sql.NullTime{Time: now.Add(-time.Hour), Valid: true},
+		LastUsedAt:   now,
+	}
+
+	mock.ExpectQuery("FROM sessions").
+		WithArgs(int32(42)).
+		WillReturnRows(sessionRows(session))
+
+	sessions, err := sessionService.ListSessions(context.Background(), 42)
+
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, session.SessionID, sessions[0].SessionID)
+	require.Equal(t, "Firefox", sessions[0].UserAgent)
+	require.Equal(t, "10.0.0.1", sessions[0].ClientIP)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionServiceRevokeSessionNotFound(t *testing.T) {
+	sessionService, mock := newTestSessionService(t)
+	sessionID := uuid.New()
+
+	mock.ExpectExec("UPDATE sessions").
+		WithArgs(sessionID, int32(42)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := sessionService.RevokeSession(context.Background(), RevokeSessionInput{UserID: 42, SessionID: sessionID})
+
+	requireServiceErrorCode(t, err, ErrNotFound.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionServiceBlockUserSessions(t *testing.T) {
+	sessionService, mock := newTestSessionService(t)
+
+	mock.ExpectQuery("SELECT (.+) FROM users").
+		WithArgs(int32(42)).
+		WillReturnRows(userRows(testDBUserForUserService()))
+	mock.ExpectExec("UPDATE sessions").
+		WithArgs(int32(42)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	blocked, err := sessionService.BlockUserSessions(context.Background(), 42)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(3), blocked.Revoked)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionServiceBlockUserSessionsUserNotFound(t *testing.T) {
+	sessionService, mock := newTestSessionService(t)
+
+	mock.ExpectQuery("SELECT (.+) FROM users").
+		WithArgs(int32(42)).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := sessionService.BlockUserSessions(context.Background(), 42)
+
+	requireServiceErrorCode(t, err, ErrNotFound.Code)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
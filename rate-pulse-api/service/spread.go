@@ -0,0 +1,345 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/util"
+)
+
+const (
+	defaultSpreadInterval = "1d"
+	maxSpreadSeriesPoints = 1000
+)
+
+// spreadIntervalDurations bounds how far back a spread series may reach for each candle interval.
+var spreadIntervalDurations = map[string]time.Duration{
+	"1h": time.Hour,
+	"1d": 24 * time.Hour,
+	"1w": 7 * 24 * time.Hour,
+	"1M": 31 * 24 * time.Hour,
+}
+
+// spreadTypePair is a buy type and the sell type published for the same channel (e.g. Buy Cash/Sell Cash).
+type spreadTypePair struct {
+	Channel    string
+	BuyTypeID  int32
+	SellTypeID int32
+}
+
+// spreadKey identifies one source's rate of a given type in a given bucket.
+type spreadKey struct {
+	sourceID int32
+	typeID   int32
+	bucket   time.Time
+}
+
+/*
+GetSpreads Service is responsible for relating each source's buy and sell rates for a currency pair.
+- Pair buy and sell exchange rate types by channel from their type names (Buy Cash/Sell Cash, Buy Transfer/Sell Transfer, ...)
+- Compute the mid rate, absolute spread and spread in basis points from each source's latest rates
+- Rank active sources by tightest spread within each channel
+- When a time range is given, return the spread per source and channel for each interval bucket
+*/
+func (s *FXService) GetSpreads(ctx context.Context, input GetSpreadsInput) (ExchangeRateSpreads, error) {
+	if input.SourceCurrencyID <= 0 {
+		return ExchangeRateSpreads{}, Wrap(nil, ErrInvalidInput.Code, "source_currency_id must be greater than 0")
+	}
+	if input.DestinationCurrencyID <= 0 {
+		return ExchangeRateSpreads{}, Wrap(nil, ErrInvalidInput.Code, "destination_currency_id must be greater than 0")
+	}
+	if input.SourceCurrencyID == input.DestinationCurrencyID {
+		return ExchangeRateSpreads{}, Wrap(nil, ErrInvalidInput.Code, "source_currency_id and destination_currency_id must be different")
+	}
+	if input.SourceID < 0 {
+		return ExchangeRateSpreads{}, Wrap(nil, ErrInvalidInput.Code, "source_id must be greater than 0")
+	}
+	interval := input.Interval
+	if interval == "" {
+		interval = defaultSpreadInterval
+	}
+	bucketUnit, ok := candleBucketUnits[interval]
+	if !ok {
+		return ExchangeRateSpreads{}, Wrap(nil, ErrInvalidInput.Code, "interval must be one of 1h, 1d, 1w, 1M")
+	}
+
+	types, err := s.store.ListExchangeRateTypes(ctx)
+	if err != nil {
+		return ExchangeRateSpreads{}, Wrap(err, ErrInternal.Code, "failed to list exchange rate types")
+	}
+	pairs := pairSpreadTypes(types)
+	if channel := normalizeIngestKey(input.Channel); channel != "" {
+		pairs = filterSpreadTypePairs(pairs, channel)
+		if len(pairs) == 0 {
+			return ExchangeRateSpreads{}, Wrap(nil, ErrInvalidInput.Code, "channel has no matching buy and sell exchange rate types")
+		}
+	}
+
+	sources, err := s.store.ListActiveRateSources(ctx)
+	if err != nil {
+		return ExchangeRateSpreads{}, Wrap(err, ErrInternal.Code, "failed to list active rate sources")
+	}
+	activeSources := make(map[int32]db.ListActiveRateSourcesRow, len(sources))
+	for _, source := range sources {
+		activeSources[source.SourceID] = source
+	}
+
+	typeIDs := make([]int32, 0, len(pairs)*2)
+	for _, pair := range pairs {
+		typeIDs = append(typeIDs, pair.BuyTypeID, pair.SellTypeID)
+	}
+	sourceID := sql.NullInt32{Int32: input.SourceID, Valid: input.SourceID > 0}
+
+	result := ExchangeRateSpreads{
+		SourceCurrencyID:      input.SourceCurrencyID,
+		DestinationCurrencyID: input.DestinationCurrencyID,
+		Spreads:               []RateSpread{},
+	}
+	if len(pairs) == 0 {
+		return result, nil
+	}
+
+	latest, err := s.store.ListLatestExchangeRatesForPair(ctx, db.ListLatestExchangeRatesForPairParams{
+		SourceCurrencyID:      input.SourceCurrencyID,
+		DestinationCurrencyID: input.DestinationCurrencyID,
+		TypeIds:               typeIDs,
+		SourceID:              sourceID,
+	})
+	if err != nil {
+		return ExchangeRateSpreads{}, Wrap(err, ErrInternal.Code, "failed to list latest exchange rates")
+	}
+	result.Spreads = rankSpreads(latestSpreads(latest, pairs, activeSources))
+
+	if input.TimeRange == "" {
+		return result, nil
+	}
+	duration, err := util.ParseTimeRangeToDuration(input.TimeRange)
+	if err != nil {
+		return ExchangeRateSpreads{}, Wrap(err, ErrInvalidInput.Code, err.Error())
+	}
+	// Long ranges on short intervals keep only the most recent buckets.
+	if maxDuration := maxSpreadSeriesPoints * spreadIntervalDurations[interval]; duration > maxDuration {
+		duration = maxDuration
+	}
+
+	now := time.Now()
+	samples, err := s.store.ListExchangeRateSpreadSamples(ctx, db.ListExchangeRateSpreadSamplesParams{
+		BucketUnit:            bucketUnit,
+		TimeZone:              candleTimeZone,
+		SourceCurrencyID:      input.SourceCurrencyID,
+		DestinationCurrencyID: input.DestinationCurrencyID,
+		TypeIds:               typeIDs,
+		SourceID:              sourceID,
+		StartTime:             now.Add(-duration),
+		EndTime:               now,
+	})
+	if err != nil {
+		return ExchangeRateSpreads{}, Wrap(err, ErrInternal.Code, "failed to list exchange rate spread samples")
+	}
+	result.Series = spreadSeries(samples, pairs, activeSources)
+
+	return result, nil
+}
+
+// pairSpreadTypes matches "Buy <channel>" with "Sell <channel>" type names; types without a counterpart are left out.
+func pairSpreadTypes(types []db.ExchangeRateType) []spreadTypePair {
+	buy := make(map[string]int32)
+	sell := make(map[string]int32)
+	for _, rateType := range types {
+		side, channel, ok := strings.Cut(normalizeIngestKey(rateType.TypeName), " ")
+		if !ok {
+			continue
+		}
+		channel = strings.TrimSpace(channel)
+		switch side {
+		case "buy":
+			buy[channel] = rateType.TypeID
+		case "sell":
+			sell[channel] = rateType.TypeID
+		}
+	}
+
+	pairs := make([]spreadTypePair, 0, len(buy))
+	for channel, buyTypeID := range buy {
+		if sellTypeID, ok := sell[channel]; ok {
+			pairs = append(pairs, spreadTypePair{Channel: channel, BuyTypeID: buyTypeID, SellTypeID: sellTypeID})
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].BuyTypeID < pairs[j].BuyTypeID
+	})
+	return pairs
+}
+
+func filterSpreadTypePairs(pairs []spreadTypePair, channel string) []spreadTypePair {
+	for _, pair := range pairs {
+		if pair.Channel == channel {
+			return []spreadTypePair{pair}
+		}
+	}
+	return nil
+}
+
+func latestSpreads(
+	rates []db.ExchangeRate,
+	pairs []spreadTypePair,
+	sources map[int32]db.ListActiveRateSourcesRow,
+) []RateSpread {
+	latest := make(map[spreadKey]db.ExchangeRate, len(rates))
+	sourceIDs := make([]int32, 0)
+	for _, rate := range rates {
+		if !rate.SourceID.Valid || !rate.TypeID.Valid {
+			continue
+		}
+		if _, ok := sources[rate.SourceID.Int32]; !ok {
+			continue
+		}
+		key := spreadKey{sourceID: rate.SourceID.Int32, typeID: rate.TypeID.Int32}
+		if _, seen := latest[key]; !seen {
+			latest[key] = rate
+		}
+		if len(sourceIDs) == 0 || sourceIDs[len(sourceIDs)-1] != rate.SourceID.Int32 {
+			sourceIDs = append(sourceIDs, rate.SourceID.Int32)
+		}
+	}
+
+	spreads := make([]RateSpread, 0, len(sourceIDs)*len(pairs))
+	for _, sourceID := range sourceIDs {
+		for _, pair := range pairs {
+			buy, hasBuy := latest[spreadKey{sourceID: sourceID, typeID: pair.BuyTypeID}]
+			sell, hasSell := latest[spreadKey{sourceID: sourceID, typeID: pair.SellTypeID}]
+			if !hasBuy || !hasSell {
+				continue
+			}
+			values, ok := newSpreadValues(buy.RateValue, sell.RateValue)
+			if !ok {
+				continue
+			}
+			source := sources[sourceID]
+			updatedAt := nullTimePtr(buy.UpdatedAt)
+			if sell.UpdatedAt.Valid && (updatedAt == nil || sell.UpdatedAt.Time.Before(*updatedAt)) {
+				updatedAt = &sell.UpdatedAt.Time
+			}
+			spreads = append(spreads, RateSpread{
+				SourceID:     sourceID,
+				SourceName:   source.SourceName,
+				SourceCode:   source.SourceCode.String,
+				Channel:      pair.Channel,
+				BuyTypeID:    pair.BuyTypeID,
+				SellTypeID:   pair.SellTypeID,
+				BuyRateID:    buy.RateID,
+				SellRateID:   sell.RateID,
+				SpreadValues: values,
+				UpdatedAt:    updatedAt,
+			})
+		}
+	}
+	return spreads
+}
+
+// rankSpreads orders spreads by channel, then tightest spread in basis points, and numbers them within each channel.
+func rankSpreads(spreads []RateSpread) []RateSpread {
+	sort.SliceStable(spreads, func(i, j int) bool {
+		if spreads[i].Channel != spreads[j].Channel {
+			return spreads[i].Channel < spreads[j].Channel
+		}
+		a, _ := parseDecimal(spreads[i].SpreadBps)
+		b, _ := parseDecimal(spreads[j].SpreadBps)
+		return a < b
+	})
+
+	var rank int32
+	for i := range spreads {
+		if i == 0 || spreads[i].Channel != spreads[i-1].Channel {
+			rank = 0
+		}
+		rank++
+		spreads[i].Rank = rank
+	}
+	return spreads
+}
+
+func spreadSeries(
+	samples []db.ListExchangeRateSpreadSamplesRow,
+	pairs []spreadTypePair,
+	sources map[int32]db.ListActiveRateSourcesRow,
+) []SpreadSeries {
+	values := make(map[spreadKey]string, len(samples))
+	buckets := make(map[int32][]time.Time)
+	seenBucket := make(map[spreadKey]bool)
+	for _, sample := range samples {
+		if !sample.SourceID.Valid || !sample.TypeID.Valid {
+			continue
+		}
+		sourceID := sample.SourceID.Int32
+		if _, ok := sources[sourceID]; !ok {
+			continue
+		}
+		values[spreadKey{sourceID: sourceID, typeID: sample.TypeID.Int32, bucket: sample.BucketStart}] = sample.RateValue
+		bucketKey := spreadKey{sourceID: sourceID, bucket: sample.BucketStart}
+		if !seenBucket[bucketKey] {
+			seenBucket[bucketKey] = true
+			buckets[sourceID] = append(buckets[sourceID], sample.BucketStart)
+		}
+	}
+
+	sourceIDs := make([]int32, 0, len(buckets))
+	for sourceID := range buckets {
+		sourceIDs = append(sourceIDs, sourceID)
+	}
+	sort.Slice(sourceIDs, func(i, j int) bool { return sourceIDs[i] < sourceIDs[j] })
+
+	series := make([]SpreadSeries, 0, len(sourceIDs)*len(pairs))
+	for _, sourceID := range sourceIDs {
+		sourceBuckets := buckets[sourceID]
+		sort.Slice(sourceBuckets, func(i, j int) bool { return sourceBuckets[i].Before(sourceBuckets[j]) })
+
+		for _, pair := range pairs {
+			points := make([]SpreadPoint, 0, len(sourceBuckets))
+			for _, bucket := range sourceBuckets {
+				buy, hasBuy := values[spreadKey{sourceID: sourceID, typeID: pair.BuyTypeID, bucket: bucket}]
+				sell, hasSell := values[spreadKey{sourceID: sourceID, typeID: pair.SellTypeID, bucket: bucket}]
+				if !hasBuy || !hasSell {
+					continue
+				}
+				if spread, ok := newSpreadValues(buy, sell); ok {
+					points = append(points, SpreadPoint{BucketStart: bucket, SpreadValues: spread})
+				}
+			}
+			if len(points) == 0 {
+				continue
+			}
+			series = append(series, SpreadSeries{
+				SourceID: sourceID,
+				Channel:  pair.Channel,
+				Points:   points,
+			})
+		}
+	}
+	return series
+}
+
+// newSpreadValues derives mid, spread and basis points from a buy and sell rate quoted in the same direction.
+func newSpreadValues(buyRate, sellRate string) (SpreadValues, bool) {
+	buy, err := parseDecimal(buyRate)
+	if err != nil || buy <= 0 {
+		return SpreadValues{}, false
+	}
+	sell, err := parseDecimal(sellRate)
+	if err != nil || sell <= 0 {
+		return SpreadValues{}, false
+	}
+	mid := (buy + sell) / 2
+	spread := sell - buy
+	return SpreadValues{
+		BuyRate:   formatQuoteDecimal(buy),
+		SellRate:  formatQuoteDecimal(sell),
+		MidRate:   formatQuoteDecimal(mid),
+		Spread:    formatQuoteDecimal(spread),
+		SpreadBps: formatQuoteDecimal(spread / mid * 10000),
+	}, true
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func expectSpreadLookups(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT type_id, type_name FROM exchange_rate_types").
+		WillReturnRows(sqlmock.NewRows([]string{"type_id", "type_name"}).
+			AddRow(int32(1), "Buy Cash").
+			AddRow(int32(2), "Sell Cash").
+			AddRow(int32(3), "Buy Transfer").
+			AddRow(int32(4), "Sell Transfer").
+			AddRow(int32(9), "Sell Cash/Transfer"))
+	mock.ExpectQuery("SELECT source_id, source_name, source_code FROM rate_sources").
+		WillReturnRows(sqlmock.NewRows([]string{"source_id", "source_name", "source_code"}).
+			AddRow(int32(10), "Vietcombank", "VCB").
+			AddRow(int32(11), "ACB", "ACB"))
+}
+
+func testSpreadExchangeRate(rateID, sourceID, typeID int32, value string) db.ExchangeRate {
+	rate := testQuoteExchangeRate()
+	rate.RateID = rateID
+	rate.SourceID = sql.NullInt32{Int32: sourceID, Valid: true}
+	rate.TypeID = sql.NullInt32{Int32: typeID, Valid: true}
+	rate.RateValue = value
+	return rate
+}
+
+func TestFXServiceGetSpreadsRanksTightestFirst(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	expectSpreadLookups(mock)
+
+	mock.ExpectQuery("SELECT DISTINCT ON \\(source_id, type_id\\)").
+		WithArgs(int32(1), int32(2), sqlmock.AnyArg(), sql.NullInt32{}).
+		WillReturnRows(exchangeRateRows(
+			testSpreadExchangeRate(60, 10, 1, "24900"),
+			testSpreadExchangeRate(61, 10, 2, "25300"),
+			testSpreadExchangeRate(62, 10, 3, "25000"),
+			testSpreadExchangeRate(63, 10, 4, "25300"),
+			testSpreadExchangeRate(64, 11, 3, "25100"),
+			testSpreadExchangeRate(65, 11, 4, "25200"),
+			// A rate type without a buy counterpart is never paired.
+			testSpreadExchangeRate(66, 11, 9, "25250"),
+		))
+
+	spreads, err := fxService.GetSpreads(context.Background(), GetSpreadsInput{
+		SourceCurrencyID:      1,
+		DestinationCurrencyID: 2,
+	})
+
+	require.NoError(t, err)
+	require.Nil(t, spreads.Series)
+	require.Len(t, spreads.Spreads, 3)
+
+	cash := spreads.Spreads[0]
+	require.Equal(t, "cash", cash.Channel)
+	require.Equal(t, int32(1), cash.Rank)
+	require.Equal(t, int32(10), cash.SourceID)
+	require.Equal(t, "25100", cash.MidRate)
+	require.Equal(t, "400", cash.Spread)
+	require.Equal(t, "159.3625498", cash.SpreadBps)
+
+	tightest := spreads.Spreads[1]
+	require.Equal(t, "transfer", tightest.Channel)
+	require.Equal(t, int32(1), tightest.Rank)
+	require.Equal(t, int32(11), tightest.SourceID)
+	require.Equal(t, "ACB", tightest.SourceCode)
+	require.Equal(t, int32(64), tightest.BuyRateID)
+	require.Equal(t, int32(65), tightest.SellRateID)
+	require.Equal(t, "25150", tightest.MidRate)
+	require.Equal(t, "100", tightest.Spread)
+	require.Equal(t, "39.76143141", tightest.SpreadBps)
+
+	widest := spreads.Spreads[2]
+	require.Equal(t, int32(2), widest.Rank)
+	require.Equal(t, int32(10), widest.SourceID)
+	require.Equal(t, "119.28429423", widest.SpreadBps)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceGetSpreadsSeries(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	expectSpreadLookups(mock)
+
+	mock.ExpectQuery("SELECT DISTINCT ON \\(source_id, type_id\\)").
+		WithArgs(int32(1), int32(2), sqlmock.AnyArg(), sql.NullInt32{Int32: 10, Valid: true}).
+		WillReturnRows(exchangeRateRows(
+			testSpreadExchangeRate(62, 10, 3, "25000"),
+			testSpreadExchangeRate(63, 10, 4, "25300"),
+		))
+
+	dayOne := time.Date(2026, 5, 11, 0, 0, 0, 0, time.UTC)
+	dayTwo := dayOne.AddDate(0, 0, 1)
+	mock.ExpectQuery("SELECT DISTINCT ON \\(er.source_id, er.type_id, bucket_start\\)").
+		WithArgs("day", "UTC", int32(1), int32(2), sqlmock.AnyArg(), sql.NullInt32{Int32: 10, Valid: true},
+			sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket_start", "source_id", "type_id", "rate_value"}).
+			AddRow(dayOne, int32(10), int32(3), "24900").
+			AddRow(dayTwo, int32(10), int32(3), "25000").
+			AddRow(dayOne, int32(10), int32(4), "25200").
+			AddRow(dayTwo, int32(10), int32(4), "25300"))
+
+	spreads, err := fxService.GetSpreads(context.Background(), GetSpreadsInput{
+		SourceCurrencyID:      1,
+		DestinationCurrencyID: 2,
+		SourceID:              10,
+		Channel:               "Transfer",
+		TimeRange:             "7d",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, spreads.Spreads, 1)
+	require.Len(t, spreads.Series, 1)
+	series := spreads.Series[0]
+	require.Equal(t, int32(10), series.SourceID)
+	require.Equal(t, "transfer", series.Channel)
+	require.Len(t, series.Points, 2)
+	require.True(t, series.Points[0].BucketStart.Equal(dayOne))
+	require.Equal(t, "300", series.Points[0].Spread)
+	require.Equal(t, "25050", series.Points[0].MidRate)
+	require.True(t, series.Points[1].BucketStart.Equal(dayTwo))
+	require.Equal(t, "300", series.Points[1].Spread)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFXServiceGetSpreadsUnknownChannel(t *testing.T) {
+	fxService, mock := newTestFXService(t)
+	mock.ExpectQuery("SELECT type_id, type_name FROM exchange_rate_types").
+		WillReturnRows(sqlmock.NewRows([]string{"type_id", "type_name"}).
+			AddRow(int32(1), "Buy Cash").
+			AddRow(int32(2), "Sell Cash"))
+
+	spreads, err := fxService.GetSpreads(context.Background(), GetSpreadsInput{
+		SourceCurrencyID:      1,
+		DestinationCurrencyID: 2,
+		Channel:               "cheque",
+	})
+
+	requireFXServiceErrorCode(t, err, ErrInvalidInput.Code)
+	require.Empty(t, spreads)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,124 @@
+/*
+subscription plan service is responsible for the plans users can subscribe to.
+*/
+package service
+
+import (
+	"context"
+	"strings"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+)
+
+type SubscriptionPlanService struct {
+	store db.Store
+}
+
+func NewSubscriptionPlanService(store db.Store) *SubscriptionPlanService {
+	return &SubscriptionPlanService{store: store}
+}
+
+// ListSubscriptionPlans returns the plans on sale, or every plan when includeInactive is set.
+func (s *SubscriptionPlanService) ListSubscriptionPlans(ctx context.Context, includeInactive bool) ([]SubscriptionPlan, error) {
+	var plans []db.SubscriptionPlan
+	var err error
+	if includeInactive {
+		plans, err = s.store.GetAllSubscriptionPlans(ctx)
+	} else {
+		plans, err = s.store.GetActiveSubscriptionPlans(ctx)
+	}
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to list subscription plans")
+	}
+	return NewSubscriptionPlans(plans), nil
+}
+
+func (s *SubscriptionPlanService) GetSubscriptionPlan(ctx context.Context, planID int32) (SubscriptionPlan, error) {
+	if planID <= 0 {
+		return SubscriptionPlan{}, Wrap(nil, ErrInvalidInput.Code, "plan_id must be greater than 0")
+	}
+
+	plan, err := s.store.GetSubscriptionPlanByID(ctx, planID)
+	if err != nil {
+		return SubscriptionPlan{}, wrapRecordDBError(err, "subscription plan", "failed to get subscription plan")
+	}
+	return NewSubscriptionPlan(plan), nil
+}
+
+/*
+CreateSubscriptionPlan Service is responsible for adding a plan.
+- Require plan_name and a non-negative plan_price
+- Reject negative historical_days and rate_limit_per_day
+*/
+func (s *SubscriptionPlanService) CreateSubscriptionPlan(ctx context.Context, input CreateSubscriptionPlanInput) (SubscriptionPlan, error) {
+	if strings.TrimSpace(input.PlanName) == "" {
+		return SubscriptionPlan{}, Wrap(nil, ErrInvalidInput.Code, "plan_name is required")
+	}
+	if !hasValue(&input.PlanPrice) {
+		return SubscriptionPlan{}, Wrap(nil, ErrInvalidInput.Code, "plan_price is required")
+	}
+	if err := validateSubscriptionPlanLimits(&input.PlanPrice, &input.HistoricalDays, &input.RateLimitPerDay); err != nil {
+		return SubscriptionPlan{}, err
+	}
+
+	plan, err := s.store.CreateSubscriptionPlan(ctx, db.CreateSubscriptionPlanParams{
+		PlanName:        strings.TrimSpace(input.PlanName),
+		PlanPrice:       strings.TrimSpace(input.PlanPrice),
+		HistoricalDays:  input.HistoricalDays,
+		RateLimitPerDay: input.RateLimitPerDay,
+		Features:        optionalString(input.Features),
+		IsActive:        optionalBool(input.IsActive),
+	})
+	if err != nil {
+		return SubscriptionPlan{}, wrapRecordDBError(err, "subscription plan", "failed to create subscription plan")
+	}
+	return NewSubscriptionPlan(plan), nil
+}
+
+// UpdateSubscriptionPlan changes only the fields that are set.
+func (s *SubscriptionPlanService) UpdateSubscriptionPlan(ctx context.Context, input UpdateSubscriptionPlanInput) (SubscriptionPlan, error) {
+	if input.PlanID <= 0 {
+		return SubscriptionPlan{}, Wrap(nil, ErrInvalidInput.Code, "plan_id must be greater than 0")
+	}
+	if err := validateSubscriptionPlanLimits(input.PlanPrice, input.HistoricalDays, input.RateLimitPerDay); err != nil {
+		return SubscriptionPlan{}, err
+	}
+
+	plan, err := s.store.UpdateSubscriptionPlan(ctx, db.UpdateSubscriptionPlanParams{
+		PlanName:        optionalString(input.PlanName),
+		PlanPrice:       optionalString(input.PlanPrice),
+		HistoricalDays:  optionalInt32(input.HistoricalDays),
+		RateLimitPerDay: optionalInt32(input.RateLimitPerDay),
+		Features:        optionalString(input.Features),
+		IsActive:        optionalBool(input.IsActive),
+		PlanID:          input.PlanID,
+	})
+	if err != nil {
+		return SubscriptionPlan{}, wrapRecordDBError(err, "subscription plan", "failed to update subscription plan")
+	}
+	return NewSubscriptionPlan(plan), nil
+}
+
+func (s *SubscriptionPlanService) DeleteSubscriptionPlan(ctx context.Context, planID int32) error {
+	if planID <= 0 {
+		return Wrap(nil, ErrInvalidInput.Code, "plan_id must be greater than 0")
+	}
+
+	if err := s.store.DeleteSubscriptionPlan(ctx, planID); err != nil {
+		return wrapRecordDBError(err, "subscription plan", "failed to delete subscription plan")
+	}
+	return nil
+}
+
+func validateSubscriptionPlanLimits(planPrice *string, historicalDays *int32, rateLimitPerDay *int32) error {
+	if err := validateNonNegativeDecimal("plan_price", planPrice); err != nil {
+		return err
+	}
+	if historicalDays != nil && *historicalDays < 0 {
+		return Wrap(nil, ErrInvalidInput.Code, "historical_days must not be negative")
+	}
+	if rateLimitPerDay != nil && *rateLimitPerDay < 0 {
+		return Wrap(nil, ErrInvalidInput.Code, "rate_limit_per_day must not be negative")
+	}
+	return nil
+}
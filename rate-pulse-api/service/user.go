@@ -10,15 +10,20 @@ import (
 	"errors"
 
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/token"
 	"github.com/ThanhVinhTong/rate-pulse/util"
+	"github.com/ThanhVinhTong/rate-pulse/worker"
+	"github.com/rs/zerolog/log"
 )
 
 type UserService struct {
-	store db.Store
+	store           db.Store
+	revocations     token.RevocationList
+	taskDistributor worker.TaskDistributor
 }
 
-func NewUserService(store db.Store) *UserService {
-	return &UserService{store: store}
+func NewUserService(store db.Store, revocations token.RevocationList, taskDistributor worker.TaskDistributor) *UserService {
+	return &UserService{store: store, revocations: revocations, taskDistributor: taskDistributor}
 }
 
 /*
@@ -45,36 +50,103 @@ func (s *UserService) GetUser(ctx context.Context, input GetUserInput) (User, er
 }
 
 /*
-ListUsers Service is responsible for listing users with pagination.
-- Validate page_id >= 1
-- Validate page_size range between 5 and 10
-- Calculate offset = (page_id - 1) * page_size
-- Call store.ListUsers
-- Convert []db.User to []User
-- Return ErrInvalidInput, ErrInternal, or ErrNotFound
+RequireVerifiedEmail Service is responsible for gating actions behind a verified email.
+- Read users.email_verified, not the token, so a verification counts at once
+- Return ErrEmailNotVerified for unverified users, ErrUnauthorized for deleted ones
 */
-func (s *UserService) ListUsers(ctx context.Context, input ListUsersInput) ([]User, error) {
-	if input.PageID <= 0 {
-		return nil, Wrap(nil, ErrInvalidInput.Code, "page_id must be greater than 0")
+func (s *UserService) RequireVerifiedEmail(ctx context.Context, userID int32) error {
+	if userID <= 0 {
+		return Wrap(nil, ErrUnauthorized.Code, "user_id is required")
 	}
-	if input.PageSize < 5 || input.PageSize > 10 {
-		return nil, Wrap(nil, ErrInvalidInput.Code, "page_size must be between 5 and 10")
+
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Wrap(err, ErrUnauthorized.Code, "user not found")
+		}
+		return Wrap(err, ErrInternal.Code, "failed to get user by id")
+	}
+
+	if !user.EmailVerified.Valid || !user.EmailVerified.Bool {
+		return Wrap(errors.New("email is not verified"), ErrEmailNotVerified.Code, "please verify your email address first")
+	}
+	return nil
+}
+
+/*
+ListUsers Service is responsible for listing users one cursor page at a time.
+- Validate cursor, page_size and sort (created_at or user_id, "-" for descending)
+- Validate created_from is before created_to
+- Call the store.ListUsersBy* query of the sort with the filters and the keyset of the cursor
+- Convert []db.User to []User and return next_cursor when more users follow
+- Return ErrInvalidInput or ErrInternal
+*/
+func (s *UserService) ListUsers(ctx context.Context, input ListUsersInput) (UserPage, error) {
+	query, err := newPageQuery(input.Page, "created_at", "created_at", "user_id")
+	if err != nil {
+		return UserPage{}, err
+	}
+	if err := validateDateRange("created_from", input.CreatedFrom, "created_to", input.CreatedTo); err != nil {
+		return UserPage{}, err
 	}
-	arg := db.ListUsersParams{
-		Limit:  input.PageSize,
-		Offset: (input.PageID - 1) * input.PageSize,
+	afterTime, err := query.afterTime()
+	if err != nil {
+		return UserPage{}, err
 	}
 
-	users, err := s.store.ListUsers(ctx, arg)
+	users, err := s.listUsers(ctx, query, db.ListUsersByCreatedAtParams{
+		UserType:    optionalString(input.UserType),
+		IsActive:    optionalBool(input.IsActive),
+		CreatedFrom: optionalTimestamp(input.CreatedFrom),
+		CreatedTo:   optionalTimestamp(input.CreatedTo),
+		AfterID:     query.afterID(),
+		AfterTime:   afterTime,
+		PageLimit:   query.limit(),
+	})
 	if err != nil {
-		return nil, Wrap(err, ErrInternal.Code, "failed to list users")
+		return UserPage{}, Wrap(err, ErrInternal.Code, "failed to list users")
 	}
 
-	res := make([]User, len(users))
+	users, hasMore := trimPage(query, users)
+	page := UserPage{Users: make([]User, len(users))}
 	for i, user := range users {
-		res[i] = NewUser(user)
+		page.Users[i] = NewUser(user)
+	}
+	if hasMore {
+		last := users[len(users)-1]
+		page.NextCursor = query.cursor(userSortValue(query.sort.Key, last), last.UserID)
+	}
+	return page, nil
+}
+
+// listUsers runs the keyset query of the page's sort; arg.AfterTime is ignored when sorting by id.
+func (s *UserService) listUsers(ctx context.Context, query pageQuery, arg db.ListUsersByCreatedAtParams) ([]db.User, error) {
+	if query.sort.Key == "created_at" {
+		if query.sort.Desc {
+			return s.store.ListUsersByCreatedAtDesc(ctx, db.ListUsersByCreatedAtDescParams(arg))
+		}
+		return s.store.ListUsersByCreatedAt(ctx, arg)
+	}
+
+	byID := db.ListUsersByIDParams{
+		UserType:    arg.UserType,
+		IsActive:    arg.IsActive,
+		CreatedFrom: arg.CreatedFrom,
+		CreatedTo:   arg.CreatedTo,
+		AfterID:     arg.AfterID,
+		PageLimit:   arg.PageLimit,
+	}
+	if query.sort.Desc {
+		return s.store.ListUsersByIDDesc(ctx, db.ListUsersByIDDescParams(byID))
 	}
-	return res, nil
+	return s.store.ListUsersByID(ctx, byID)
+}
+
+func userSortValue(key string, user db.User) string {
+	if key == "created_at" {
+		return cursorTime(user.CreatedAt)
+	}
+	return ""
 }
 
 /*
@@ -83,7 +155,9 @@ UpdateUser Service is responsible for updating a user by their ID.
 - Hash password if provided
 - Normalize email if provided
 - Build db.UpdateUserParams
-- Call store.UpdateUser
+- Call store.UpdateUser; a new email resets email_verified
+- Email a verification link to a new email
+- Sign the user out everywhere after a password change
 - Return safe user model
 */
 func (s *UserService) UpdateUser(ctx context.Context, input UpdateUserInput) (User, error) {
@@ -96,6 +170,10 @@ func (s *UserService) UpdateUser(ctx context.Context, input UpdateUserInput) (Us
 		normalized := util.NormalizeEmail(*input.Email)
 		email = &normalized
 	}
+	emailChanged, err := s.emailChanged(ctx, input.UserID, email)
+	if err != nil {
+		return User{}, err
+	}
 
 	// Hash password if provided
 	var hashedPassword *string
@@ -125,9 +203,42 @@ func (s *UserService) UpdateUser(ctx context.Context, input UpdateUserInput) (Us
 		}
 		return User{}, Wrap(err, ErrInternal.Code, "failed to update user")
 	}
+
+	if emailChanged {
+		s.verifyNewEmail(ctx, user)
+	}
+	if hashedPassword != nil {
+		signOutEverywhere(ctx, s.store, s.revocations, user.UserID)
+	}
 	return NewUser(user), nil
 }
 
+// emailChanged reports whether email is set and differs from the user's current address.
+func (s *UserService) emailChanged(ctx context.Context, userID int32, email *string) (bool, error) {
+	if email == nil {
+		return false, nil
+	}
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, Wrap(err, ErrNotFound.Code, "user not found")
+		}
+		return false, Wrap(err, ErrInternal.Code, "failed to get user")
+	}
+	return user.Email != *email, nil
+}
+
+// verifyNewEmail emails a verification link to the new address, unless an admin marked it
+// verified. The change is already saved, so a failure is logged and the user can resend the link.
+func (s *UserService) verifyNewEmail(ctx context.Context, user db.User) {
+	if user.EmailVerified.Bool {
+		return
+	}
+	if err := sendVerifyEmail(ctx, s.taskDistributor, user.UserID); err != nil {
+		log.Error().Err(err).Int32("user_id", user.UserID).Msg("cannot send verification email for changed email")
+	}
+}
+
 /*
 AdminUpdateUser Service is responsible for updating a user by their ID.
 - Validate UserID > 0
@@ -136,6 +247,9 @@ AdminUpdateUser Service is responsible for updating a user by their ID.
 - Validate admin-only UserType if provided
 - Build db.UpdateUserParams
 - Allow UserType, EmailVerified, IsActive
+- A new email resets email_verified unless it is set too; email a verification link to it
+- Sign the user out everywhere after a password change or deactivation
+- Revoke their access tokens after a user_type change
 */
 func (s *UserService) AdminUpdateUser(ctx context.Context, input AdminUpdateUserInput) (User, error) {
 	if input.UserID <= 0 {
@@ -155,6 +269,10 @@ func (s *UserService) AdminUpdateUser(ctx context.Context, input AdminUpdateUser
 		normalized := util.NormalizeEmail(*input.Email)
 		email = &normalized
 	}
+	emailChanged, err := s.emailChanged(ctx, input.UserID, email)
+	if err != nil {
+		return User{}, err
+	}
 
 	var hashedPassword *string
 	if input.Password != nil {
@@ -187,6 +305,16 @@ func (s *UserService) AdminUpdateUser(ctx context.Context, input AdminUpdateUser
 		return User{}, Wrap(err, ErrInternal.Code, "failed to update user")
 	}
 
+	if emailChanged {
+		s.verifyNewEmail(ctx, user)
+	}
+	switch {
+	case hashedPassword != nil, input.IsActive != nil && !*input.IsActive:
+		signOutEverywhere(ctx, s.store, s.revocations, user.UserID)
+	case input.UserType != nil:
+		// Renewed tokens pick up the new user_type, so sessions may stay.
+		revokeAccessTokens(ctx, s.revocations, user.UserID)
+	}
 	return NewUser(user), nil
 }
 
@@ -194,6 +322,7 @@ func (s *UserService) AdminUpdateUser(ctx context.Context, input AdminUpdateUser
 DeleteUser Service is responsible for deleting a user by their ID.
 - Validate user_id > 0
 - Call store.DeleteUserByID
+- Revoke the user's access tokens
 - Convert DB errors to service errors
 */
 func (s *UserService) DeleteUser(ctx context.Context, input DeleteUserInput) error {
@@ -205,5 +334,7 @@ func (s *UserService) DeleteUser(ctx context.Context, input DeleteUserInput) err
 		return Wrap(err, ErrInternal.Code, "failed to delete user")
 	}
 
+	// The user's sessions are deleted with them; their access tokens have to be revoked.
+	revokeAccessTokens(ctx, s.revocations, input.UserID)
 	return nil
 }
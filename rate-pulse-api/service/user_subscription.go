@@ -0,0 +1,202 @@
+/*
+user subscription service is responsible for the plans users subscribe to and the admin view over them.
+*/
+package service
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+)
+
+type UserSubscriptionService struct {
+	store db.Store
+}
+
+func NewUserSubscriptionService(store db.Store) *UserSubscriptionService {
+	return &UserSubscriptionService{store: store}
+}
+
+/*
+ListUserSubscriptions Service is responsible for listing subscriptions one cursor page at a time.
+- Validate cursor, page_size and sort (start_date or subscription_id, "-" for descending)
+- Validate status, plan_id and that from is before to
+- Call the store.ListUserSubscriptionsBy* query of the sort with the filters and the keyset of the cursor
+- Return next_cursor when more subscriptions follow
+- Return ErrInvalidInput or ErrInternal
+*/
+func (s *UserSubscriptionService) ListUserSubscriptions(ctx context.Context, input ListUserSubscriptionsInput) (UserSubscriptionPage, error) {
+	query, err := newPageQuery(input.Page, "-start_date", "start_date", "subscription_id")
+	if err != nil {
+		return UserSubscriptionPage{}, err
+	}
+	if input.PlanID != nil && *input.PlanID <= 0 {
+		return UserSubscriptionPage{}, Wrap(nil, ErrInvalidInput.Code, "plan_id must be greater than 0")
+	}
+	if err := validateDateRange("from", input.From, "to", input.To); err != nil {
+		return UserSubscriptionPage{}, err
+	}
+	afterTime, err := query.afterTime()
+	if err != nil {
+		return UserSubscriptionPage{}, err
+	}
+
+	subscriptions, err := s.listUserSubscriptions(ctx, query, db.ListUserSubscriptionsByStartDateParams{
+		Status:    optionalString(input.Status),
+		PlanID:    optionalInt32(input.PlanID),
+		DateFrom:  optionalTimestamp(input.From),
+		DateTo:    optionalTimestamp(input.To),
+		AfterID:   query.afterID(),
+		AfterTime: afterTime,
+		PageLimit: query.limit(),
+	})
+	if err != nil {
+		return UserSubscriptionPage{}, Wrap(err, ErrInternal.Code, "failed to list user subscriptions")
+	}
+
+	subscriptions, hasMore := trimPage(query, subscriptions)
+	page := UserSubscriptionPage{Subscriptions: subscriptions}
+	if hasMore {
+		last := subscriptions[len(subscriptions)-1]
+		value := ""
+		if query.sort.Key == "start_date" {
+			value = cursorTime(sql.NullTime{Time: last.StartDate, Valid: true})
+		}
+		page.NextCursor = query.cursor(value, last.SubscriptionID)
+	}
+	return page, nil
+}
+
+// listUserSubscriptions runs the keyset query of the page's sort; arg.AfterTime is ignored when sorting by id.
+func (s *UserSubscriptionService) listUserSubscriptions(ctx context.Context, query pageQuery, arg db.ListUserSubscriptionsByStartDateParams) ([]db.UserSubscription, error) {
+	if query.sort.Key == "start_date" {
+		if query.sort.Desc {
+			return s.store.ListUserSubscriptionsByStartDateDesc(ctx, db.ListUserSubscriptionsByStartDateDescParams(arg))
+		}
+		return s.store.ListUserSubscriptionsByStartDate(ctx, arg)
+	}
+
+	byID := db.ListUserSubscriptionsByIDParams{
+		Status:    arg.Status,
+		PlanID:    arg.PlanID,
+		DateFrom:  arg.DateFrom,
+		DateTo:    arg.DateTo,
+		AfterID:   arg.AfterID,
+		PageLimit: arg.PageLimit,
+	}
+	if query.sort.Desc {
+		return s.store.ListUserSubscriptionsByIDDesc(ctx, db.ListUserSubscriptionsByIDDescParams(byID))
+	}
+	return s.store.ListUserSubscriptionsByID(ctx, byID)
+}
+
+var userSubscriptionStatuses = []string{"active", "cancelled", "expired", "suspended", "pending"}
+
+/*
+CreateUserSubscription Service is responsible for subscribing a user to a plan.
+- Default status to active and start_date to now
+- Reject an end_date before start_date
+- Return ErrInvalidInput for an unknown plan
+*/
+func (s *UserSubscriptionService) CreateUserSubscription(ctx context.Context, input CreateUserSubscriptionInput) (UserSubscription, error) {
+	if input.UserID <= 0 {
+		return UserSubscription{}, Wrap(nil, ErrUnauthorized.Code, "user_id is required")
+	}
+	if input.PlanID <= 0 {
+		return UserSubscription{}, Wrap(nil, ErrInvalidInput.Code, "plan_id must be greater than 0")
+	}
+	status := "active"
+	if input.Status != nil {
+		status = *input.Status
+	}
+	if err := validateUserSubscriptionStatus(&status); err != nil {
+		return UserSubscription{}, err
+	}
+	startDate := time.Now()
+	if input.StartDate != nil {
+		startDate = *input.StartDate
+	}
+	if input.EndDate != nil && input.EndDate.Before(startDate) {
+		return UserSubscription{}, Wrap(nil, ErrInvalidInput.Code, "end_date must be on or after start_date")
+	}
+
+	subscription, err := s.store.CreateUserSubscription(ctx, db.CreateUserSubscriptionParams{
+		UserID:    input.UserID,
+		PlanID:    input.PlanID,
+		Status:    sql.NullString{String: status, Valid: true},
+		StartDate: startDate,
+		EndDate:   optionalTimestamp(input.EndDate),
+		AutoRenew: optionalBool(input.AutoRenew),
+	})
+	if err != nil {
+		return UserSubscription{}, wrapRecordDBError(err, "user subscription", "failed to create user subscription")
+	}
+	return NewUserSubscription(subscription), nil
+}
+
+func (s *UserSubscriptionService) ListUserSubscriptionsForUser(ctx context.Context, userID int32) ([]UserSubscription, error) {
+	subscriptions, err := s.store.GetUserSubscriptionsByUserID(ctx, userID)
+	if err != nil {
+		return nil, Wrap(err, ErrInternal.Code, "failed to list user subscriptions")
+	}
+	return NewUserSubscriptions(subscriptions), nil
+}
+
+func (s *UserSubscriptionService) GetActiveUserSubscription(ctx context.Context, userID int32) (UserSubscription, error) {
+	subscription, err := s.store.GetActiveUserSubscriptionByUserID(ctx, userID)
+	if err != nil {
+		return UserSubscription{}, wrapRecordDBError(err, "active subscription", "failed to get active subscription")
+	}
+	return NewUserSubscription(subscription), nil
+}
+
+// UpdateUserSubscription changes only the fields that are set.
+func (s *UserSubscriptionService) UpdateUserSubscription(ctx context.Context, input UpdateUserSubscriptionInput) (UserSubscription, error) {
+	if input.SubscriptionID <= 0 {
+		return UserSubscription{}, Wrap(nil, ErrInvalidInput.Code, "subscription_id must be greater than 0")
+	}
+	if input.PlanID != nil && *input.PlanID <= 0 {
+		return UserSubscription{}, Wrap(nil, ErrInvalidInput.Code, "plan_id must be greater than 0")
+	}
+	if err := validateUserSubscriptionStatus(input.Status); err != nil {
+		return UserSubscription{}, err
+	}
+	if err := validateDateRange("start_date", input.StartDate, "end_date", input.EndDate); err != nil {
+		return UserSubscription{}, err
+	}
+
+	subscription, err := s.store.UpdateUserSubscription(ctx, db.UpdateUserSubscriptionParams{
+		PlanID:         optionalInt32(input.PlanID),
+		Status:         optionalString(input.Status),
+		StartDate:      optionalTimestamp(input.StartDate),
+		EndDate:        optionalTimestamp(input.EndDate),
+		AutoRenew:      optionalBool(input.AutoRenew),
+		SubscriptionID: input.SubscriptionID,
+	})
+	if err != nil {
+		return UserSubscription{}, wrapRecordDBError(err, "user subscription", "failed to update user subscription")
+	}
+	return NewUserSubscription(subscription), nil
+}
+
+func (s *UserSubscriptionService) DeleteUserSubscription(ctx context.Context, subscriptionID int32) error {
+	if subscriptionID <= 0 {
+		return Wrap(nil, ErrInvalidInput.Code, "subscription_id must be greater than 0")
+	}
+
+	if err := s.store.DeleteUserSubscription(ctx, subscriptionID); err != nil {
+		return wrapRecordDBError(err, "user subscription", "failed to delete user subscription")
+	}
+	return nil
+}
+
+func validateUserSubscriptionStatus(status *string) error {
+	if status != nil && !slices.Contains(userSubscriptionStatuses, *status) {
+		return Wrap(nil, ErrInvalidInput.Code, "status must be one of "+strings.Join(userSubscriptionStatuses, ", "))
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/token"
 	"github.com/stretchr/testify/require"
 )
 
@@ -22,7 +23,19 @@ func newTestUserService(t *testing.T) (*UserService, sqlmock.Sqlmock) {
 		_ = sqlDB.Close()
 	})
 
-	return NewUserService(db.NewStore(sqlDB)), mock
+	return NewUserService(db.NewStore(sqlDB), token.NewMemoryRevocationList(time.Minute), &fakeTaskDistributor{}), mock
+}
+
+func requireUserTokensRevoked(t *testing.T, userService *UserService, userID int32, want bool) {
+	t.Helper()
+
+	payload, err := token.NewPayload(userID, "testuser", "test@example.com", "free", time.Minute, token.TokenTypeAccess)
+	require.NoError(t, err)
+	payload.IssuedAt = payload.IssuedAt.Add(-time.Second)
+
+	revoked, err := userService.revocations.IsRevoked(context.Background(), payload)
+	require.NoError(t, err)
+	require.Equal(t, want, revoked)
 }
 
 func requireUserServiceErrorCode(t *testing.T, err error, code string) {
@@ -137,29 +150,41 @@ func TestUserServiceGetUserSuccess(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestUserServiceListUsersInvalidPageID(t *testing.T) {
+func TestUserServiceListUsersInvalidSort(t *testing.T) {
 	userService, mock := newTestUserService(t)
 
-	users, err := userService.ListUsers(context.Background(), ListUsersInput{
-		PageID:   0,
-		PageSize: 5,
+	page, err := userService.ListUsers(context.Background(), ListUsersInput{
+		Page: PageInput{Sort: "email"},
 	})
 
 	requireUserServiceErrorCode(t, err, ErrInvalidInput.Code)
-	require.Nil(t, users)
+	require.Empty(t, page.Users)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestUserServiceListUsersInvalidPageSize(t *testing.T) {
 	userService, mock := newTestUserService(t)
 
-	users, err := userService.ListUsers(context.Background(), ListUsersInput{
-		PageID:   1,
-		PageSize: 11,
+	page, err := userService.ListUsers(context.Background(), ListUsersInput{
+		Page: PageInput{PageSize: MaxPageSize + 1},
+	})
+
+	requireUserServiceErrorCode(t, err, ErrInvalidInput.Code)
+	require.Empty(t, page.Users)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserServiceListUsersInvalidDateRange(t *testing.T) {
+	userService, mock := newTestUserService(t)
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, -1, 0)
+
+	_, err := userService.ListUsers(context.Background(), ListUsersInput{
+		CreatedFrom: &from,
+		CreatedTo:   &to,
 	})
 
 	requireUserServiceErrorCode(t, err, ErrInvalidInput.Code)
-	require.Nil(t, users)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -171,18 +196,87 @@ func TestUserServiceListUsersSuccess(t *testing.T) {
 	user2.Email = "second@example.com"
 
 	mock.ExpectQuery("SELECT user_id, username, email, password").
-		WithArgs(int32(5), int32(0)).
+		WithArgs(nil, nil, nil, nil, nil, nil, int32(DefaultPageSize+1)).
 		WillReturnRows(userRows(user1, user2))
 
-	users, err := userService.ListUsers(context.Background(), ListUsersInput{
-		PageID:   1,
-		PageSize: 5,
+	page, err := userService.ListUsers(context.Background(), ListUsersInput{})
+
+	require.NoError(t, err)
+	require.Len(t, page.Users, 2)
+	require.Equal(t, user1.UserID, page.Users[0].UserID)
+	require.Equal(t, user2.UserID, page.Users[1].UserID)
+	require.Empty(t, page.NextCursor)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserServiceListUsersNextCursor(t *testing.T) {
+	userService, mock := newTestUserService(t)
+	user1 := testDBUserForUserService()
+	user2 := testDBUserForUserService()
+	user2.UserID = 43
+	user3 := testDBUserForUserService()
+	user3.UserID = 44
+
+	mock.ExpectQuery("SELECT user_id, username, email, password").
+		WithArgs(sql.NullString{String: "free", Valid: true}, nil, nil, nil, nil, int32(3)).
+		WillReturnRows(userRows(user1, user2, user3))
+
+	userType := "free"
+	page, err := userService.ListUsers(context.Background(), ListUsersInput{
+		Page:     PageInput{PageSize: 2, Sort: "-user_id"},
+		UserType: &userType,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, page.Users, 2)
+	require.NotEmpty(t, page.NextCursor)
+
+	mock.ExpectQuery("SELECT user_id, username, email, password").
+		WithArgs(sql.NullString{String: "free", Valid: true}, nil, nil, nil, int32(43), int32(3)).
+		WillReturnRows(userRows(user3))
+
+	page, err = userService.ListUsers(context.Background(), ListUsersInput{
+		Page:     PageInput{PageSize: 2, Sort: "-user_id", Cursor: page.NextCursor},
+		UserType: &userType,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, page.Users, 1)
+	require.Empty(t, page.NextCursor)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserServiceListUsersCreatedAtDescUsesKeysetOfCursor(t *testing.T) {
+	userService, mock := newTestUserService(t)
+	user1 := testDBUserForUserService()
+	user2 := testDBUserForUserService()
+	user2.UserID = 43
+	user2.CreatedAt.Time = user1.CreatedAt.Time.Add(-time.Hour)
+
+	mock.ExpectQuery(`ORDER BY COALESCE\(created_at, 'epoch'\) DESC, user_id DESC`).
+		WithArgs(nil, nil, nil, nil, nil, nil, int32(2)).
+		WillReturnRows(userRows(user1, user2))
+
+	page, err := userService.ListUsers(context.Background(), ListUsersInput{
+		Page: PageInput{PageSize: 1, Sort: "-created_at"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, page.Users, 1)
+	require.NotEmpty(t, page.NextCursor)
+
+	mock.ExpectQuery(`ORDER BY COALESCE\(created_at, 'epoch'\) DESC, user_id DESC`).
+		WithArgs(nil, nil, nil, nil, int32(42), user1.CreatedAt.Time.UTC(), int32(2)).
+		WillReturnRows(userRows(user2))
+
+	page, err = userService.ListUsers(context.Background(), ListUsersInput{
+		Page: PageInput{PageSize: 1, Sort: "-created_at", Cursor: page.NextCursor},
 	})
 
 	require.NoError(t, err)
-	require.Len(t, users, 2)
-	require.Equal(t, user1.UserID, users[0].UserID)
-	require.Equal(t, user2.UserID, users[1].UserID)
+	require.Len(t, page.Users, 1)
+	require.Equal(t, user2.UserID, page.Users[0].UserID)
+	require.Empty(t, page.NextCursor)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -238,6 +332,9 @@ func TestUserServiceUpdateUserSuccess(t *testing.T) {
 	updatedUser.Email = "test@example.com"
 	updatedUser.FirstName = sql.NullString{String: firstName, Valid: true}
 
+	mock.ExpectQuery("SELECT user_id, username, email, password").
+		WithArgs(int32(42)).
+		WillReturnRows(userRows(testDBUserForUserService()))
 	mock.ExpectQuery("UPDATE users").
 		WithArgs(
 			sql.NullString{},
@@ -265,6 +362,54 @@ func TestUserServiceUpdateUserSuccess(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, updatedUser.Email, user.Email)
 	require.Equal(t, firstName, user.FirstName)
+	requireUserTokensRevoked(t, userService, 42, false)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserServiceUpdateUserNewEmailNeedsVerification(t *testing.T) {
+	userService, mock := newTestUserService(t)
+	taskDistributor := userService.taskDistributor.(*fakeTaskDistributor)
+
+	verifiedUser := testDBUserForUserService()
+	verifiedUser.EmailVerified = sql.NullBool{Bool: true, Valid: true}
+	email := "new@example.com"
+	updatedUser := testDBUserForUserService()
+	updatedUser.Email = email
+
+	mock.ExpectQuery("SELECT user_id, username, email, password").
+		WithArgs(int32(42)).
+		WillReturnRows(userRows(verifiedUser))
+	mock.ExpectQuery("UPDATE users").WillReturnRows(userRows(updatedUser))
+
+	user, err := userService.UpdateUser(context.Background(), UpdateUserInput{
+		UserID: 42,
+		Email:  &email,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, email, user.Email)
+	require.False(t, user.EmailVerified)
+	require.True(t, taskDistributor.called)
+	require.Equal(t, int32(42), taskDistributor.payload.UserId)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserServiceUpdateUserPasswordSignsOut(t *testing.T) {
+	userService, mock := newTestUserService(t)
+
+	password := "new-secret-password"
+	mock.ExpectQuery("UPDATE users").WillReturnRows(userRows(testDBUserForUserService()))
+	mock.ExpectExec("UPDATE sessions").
+		WithArgs(int32(42)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	_, err := userService.UpdateUser(context.Background(), UpdateUserInput{
+		UserID:   42,
+		Password: &password,
+	})
+
+	require.NoError(t, err)
+	requireUserTokensRevoked(t, userService, 42, true)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -319,6 +464,30 @@ func TestUserServiceAdminUpdateUserSuccess(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, userType, user.UserType)
 	require.True(t, user.IsActive)
+	requireUserTokensRevoked(t, userService, 42, true)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserServiceAdminUpdateUserDeactivationSignsOut(t *testing.T) {
+	userService, mock := newTestUserService(t)
+
+	isActive := false
+	updatedUser := testDBUserForUserService()
+	updatedUser.IsActive = sql.NullBool{Bool: isActive, Valid: true}
+
+	mock.ExpectQuery("UPDATE users").WillReturnRows(userRows(updatedUser))
+	mock.ExpectExec("UPDATE sessions").
+		WithArgs(int32(42)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	user, err := userService.AdminUpdateUser(context.Background(), AdminUpdateUserInput{
+		UserID:   42,
+		IsActive: &isActive,
+	})
+
+	require.NoError(t, err)
+	require.False(t, user.IsActive)
+	requireUserTokensRevoked(t, userService, 42, true)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -354,5 +523,43 @@ func TestUserServiceDeleteUserSuccess(t *testing.T) {
 	err := userService.DeleteUser(context.Background(), DeleteUserInput{UserID: 42})
 
 	require.NoError(t, err)
+	requireUserTokensRevoked(t, userService, 42, true)
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestUserServiceRequireVerifiedEmail(t *testing.T) {
+	verifiedUser := testDBUserForUserService()
+	verifiedUser.EmailVerified = sql.NullBool{Bool: true, Valid: true}
+
+	testCases := []struct {
+		name string
+		rows *sqlmock.Rows
+		err  error
+		code string
+	}{
+		{name: "Verified", rows: userRows(verifiedUser)},
+		{name: "Unverified", rows: userRows(testDBUserForUserService()), code: ErrEmailNotVerified.Code},
+		{name: "Deleted", err: sql.ErrNoRows, code: ErrUnauthorized.Code},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			userService, mock := newTestUserService(t)
+
+			query := mock.ExpectQuery("FROM users").WithArgs(int32(42))
+			if tc.err != nil {
+				query.WillReturnError(tc.err)
+			} else {
+				query.WillReturnRows(tc.rows)
+			}
+
+			err := userService.RequireVerifiedEmail(context.Background(), 42)
+			if tc.code == "" {
+				require.NoError(t, err)
+			} else {
+				requireUserServiceErrorCode(t, err, tc.code)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
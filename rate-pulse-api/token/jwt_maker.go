@@ -23,9 +23,9 @@ func NewJWTMaker(secretKey string) (Maker, error) {
 	return &JWTMaker{secretKey}, nil
 }
 
-// CreateToken creates a new token for a given userID, username, email, userType and duration.
-func (maker *JWTMaker) CreateToken(userID int32, username, email, userType string, duration time.Duration) (string, *Payload, error) {
-	payload, err := NewPayload(userID, username, email, userType, duration)
+// CreateToken creates a new token of tokenType for a given userID, username, email, userType and duration.
+func (maker *JWTMaker) CreateToken(userID int32, username, email, userType string, duration time.Duration, tokenType TokenType) (string, *Payload, error) {
+	payload, err := NewPayload(userID, username, email, userType, duration, tokenType)
 	if err != nil {
 		return "", payload, err
 	}
@@ -35,8 +35,8 @@ func (maker *JWTMaker) CreateToken(userID int32, username, email, userType strin
 	return token, payload, err
 }
 
-// VerifyToken verifies a token and returns the payload if it is valid.
-func (maker *JWTMaker) VerifyToken(token string) (*Payload, error) {
+// VerifyToken verifies a token and returns the payload if it is valid and of tokenType.
+func (maker *JWTMaker) VerifyToken(token string, tokenType TokenType) (*Payload, error) {
 	// keyFunc is used by the parser to supply the key for verification
 	keyFunc := func(token *jwt.Token) (interface{}, error) {
 		// Validate the signing method
@@ -58,7 +58,7 @@ func (maker *JWTMaker) VerifyToken(token string) (*Payload, error) {
 
 	// Extract the payload from the token
 	payload, ok := jwtToken.Claims.(*Payload)
-	if !ok {
+	if !ok || payload.TokenType != tokenType {
 		return nil, ErrInvalidToken
 	}
 
@@ -25,13 +25,13 @@ func TestJWTMaker(t *testing.T) {
 	issued_at := time.Now()
 	expired_at := issued_at.Add(duration)
 
-	token, payload, err := maker.CreateToken(userID, username, email, "user_type", duration)
+	token, payload, err := maker.CreateToken(userID, username, email, "user_type", duration, TokenTypeAccess)
 	fmt.Println(token)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, payload)
 
-	payload, err = maker.VerifyToken(token)
+	payload, err = maker.VerifyToken(token, TokenTypeAccess)
 	fmt.Println(payload)
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
@@ -53,12 +53,12 @@ func TestExpiredToken(t *testing.T) {
 	userID := int32(1)
 	duration := -time.Minute
 
-	token, payload, err := maker.CreateToken(userID, username, email, "user_type", duration)
+	token, payload, err := maker.CreateToken(userID, username, email, "user_type", duration, TokenTypeAccess)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, payload)
 
-	payload, err = maker.VerifyToken(token)
+	payload, err = maker.VerifyToken(token, TokenTypeAccess)
 	require.Error(t, err)
 	require.EqualError(t, err, ErrExpiredToken.Error())
 	require.Nil(t, payload)
@@ -71,7 +71,7 @@ func TestInvalidToken(t *testing.T) {
 	email := string(make([]byte, MAX_LENGTH))
 	duration := time.Minute
 
-	payload, err := NewPayload(userID, username, email, "user_type", duration)
+	payload, err := NewPayload(userID, username, email, "user_type", duration, TokenTypeAccess)
 	require.NoError(t, err)
 
 	jwtToken := jwt.NewWithClaims(jwt.SigningMethodNone, payload)
@@ -81,7 +81,7 @@ func TestInvalidToken(t *testing.T) {
 	maker, err := NewJWTMaker(string(make([]byte, MAX_LENGTH)))
 	require.NoError(t, err)
 
-	payload, err = maker.VerifyToken(token)
+	payload, err = maker.VerifyToken(token, TokenTypeAccess)
 	require.Error(t, err)
 	require.EqualError(t, err, ErrInvalidToken.Error())
 	require.Nil(t, payload)
@@ -8,9 +8,9 @@ import "time"
 
 // Maker is the interface for creating and verifying JWT tokens.
 type Maker interface {
-	// CreateToken creates a new token for a given userID, username, email, userType and duration.
-	CreateToken(userID int32, username, email, userType string, duration time.Duration) (string, *Payload, error)
+	// CreateToken creates a new token of tokenType for a given userID, username, email, userType and duration.
+	CreateToken(userID int32, username, email, userType string, duration time.Duration, tokenType TokenType) (string, *Payload, error)
 
-	// VerifyToken verifies a token and returns the payload if it is valid.
-	VerifyToken(token string) (*Payload, error)
+	// VerifyToken verifies a token and returns the payload if it is valid and of tokenType.
+	VerifyToken(token string, tokenType TokenType) (*Payload, error)
 }
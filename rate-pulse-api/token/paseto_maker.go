@@ -26,9 +26,9 @@ func NewPasetoMaker(symmetricKey string) (Maker, error) {
 	return maker, nil
 }
 
-// CreateToken creates a new token for a given userID, username, email, userType and duration.
-func (maker *PasetoMaker) CreateToken(userID int32, username, email, userType string, duration time.Duration) (string, *Payload, error) {
-	payload, err := NewPayload(userID, username, email, userType, duration)
+// CreateToken creates a new token of tokenType for a given userID, username, email, userType and duration.
+func (maker *PasetoMaker) CreateToken(userID int32, username, email, userType string, duration time.Duration, tokenType TokenType) (string, *Payload, error) {
+	payload, err := NewPayload(userID, username, email, userType, duration, tokenType)
 	if err != nil {
 		return "", payload, err
 	}
@@ -37,8 +37,8 @@ func (maker *PasetoMaker) CreateToken(userID int32, username, email, userType st
 	return token, payload, err
 }
 
-// VerifyToken verifies a token and returns the payload if it is valid.
-func (maker *PasetoMaker) VerifyToken(token string) (*Payload, error) {
+// VerifyToken verifies a token and returns the payload if it is valid and of tokenType.
+func (maker *PasetoMaker) VerifyToken(token string, tokenType TokenType) (*Payload, error) {
 	payload := &Payload{}
 
 	err := maker.paseto.Decrypt(token, maker.symmetricKey, payload, nil)
@@ -51,5 +51,9 @@ func (maker *PasetoMaker) VerifyToken(token string) (*Payload, error) {
 		return nil, err
 	}
 
+	if payload.TokenType != tokenType {
+		return nil, ErrInvalidToken
+	}
+
 	return payload, nil
 }
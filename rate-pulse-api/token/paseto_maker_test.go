@@ -25,13 +25,13 @@ func TestPasetoMaker(t *testing.T) {
 	issued_at := time.Now()
 	expired_at := issued_at.Add(duration)
 
-	token, payload, err := maker.CreateToken(userID, username, email, "user_type", duration)
+	token, payload, err := maker.CreateToken(userID, username, email, "user_type", duration, TokenTypeAccess)
 	fmt.Println(token)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, payload)
 
-	payload, err = maker.VerifyToken(token)
+	payload, err = maker.VerifyToken(token, TokenTypeAccess)
 	fmt.Println(payload)
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
@@ -42,6 +42,22 @@ func TestPasetoMaker(t *testing.T) {
 	require.WithinDuration(t, expired_at, payload.ExpiredAt, time.Second)
 }
 
+func TestPasetoTokenOfOtherType(t *testing.T) {
+	maker, err := NewPasetoMaker(string(make([]byte, SYMMETRIC_KEY_LENGTH)))
+	require.NoError(t, err)
+
+	refreshToken, _, err := maker.CreateToken(1, "user", "user@example.com", "free", time.Minute, TokenTypeRefresh)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(refreshToken, TokenTypeAccess)
+	require.ErrorIs(t, err, ErrInvalidToken)
+	require.Nil(t, payload)
+
+	payload, err = maker.VerifyToken(refreshToken, TokenTypeRefresh)
+	require.NoError(t, err)
+	require.Equal(t, TokenTypeRefresh, payload.TokenType)
+}
+
 func TestExpiredPasetoToken(t *testing.T) {
 	maker, err := NewPasetoMaker(string(make([]byte, SYMMETRIC_KEY_LENGTH)))
 	require.NoError(t, err)
@@ -51,12 +67,12 @@ func TestExpiredPasetoToken(t *testing.T) {
 	duration := -time.Minute
 	userID := int32(1)
 
-	token, payload, err := maker.CreateToken(userID, username, email, "user_type", duration)
+	token, payload, err := maker.CreateToken(userID, username, email, "user_type", duration, TokenTypeAccess)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, payload)
 
-	payload, err = maker.VerifyToken(token)
+	payload, err = maker.VerifyToken(token, TokenTypeAccess)
 	require.Error(t, err)
 	require.EqualError(t, err, ErrExpiredToken.Error())
 	require.Nil(t, payload)
@@ -69,7 +85,7 @@ func TestInvalidPasetoToken(t *testing.T) {
 	email := string(make([]byte, SYMMETRIC_KEY_LENGTH))
 	duration := time.Minute
 
-	payload, err := NewPayload(userID, username, email, "user_type", duration)
+	payload, err := NewPayload(userID, username, email, "user_type", duration, TokenTypeAccess)
 	require.NoError(t, err)
 
 	jwtToken := jwt.NewWithClaims(jwt.SigningMethodNone, payload)
@@ -79,7 +95,7 @@ func TestInvalidPasetoToken(t *testing.T) {
 	maker, err := NewPasetoMaker(string(make([]byte, SYMMETRIC_KEY_LENGTH)))
 	require.NoError(t, err)
 
-	payload, err = maker.VerifyToken(token)
+	payload, err = maker.VerifyToken(token, TokenTypeAccess)
 	require.Error(t, err)
 	require.EqualError(t, err, ErrInvalidToken.Error())
 	require.Nil(t, payload)
@@ -14,6 +14,15 @@ var (
 	ErrInvalidToken = errors.New("token is invalid")
 )
 
+// TokenType tells access tokens apart from refresh tokens, so a refresh token is never
+// accepted as a bearer token and an access token cannot be used to renew a session.
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
+)
+
 // Payload contains the payload data of the JWT.
 // Implements jwt.Claims interface for golang-jwt/jwt/v5
 type Payload struct {
@@ -22,12 +31,13 @@ type Payload struct {
 	Username  string    `json:"username"` // display only
 	Email     string    `json:"email"`    // optional display
 	UserType  string    `json:"user_type"`
+	TokenType TokenType `json:"token_type"`
 	IssuedAt  time.Time `json:"issued_at"`
 	ExpiredAt time.Time `json:"expired_at"`
 }
 
-// NewPayload creates a new payload for a given username, userType and duration.
-func NewPayload(userID int32, username, email, userType string, duration time.Duration) (*Payload, error) {
+// NewPayload creates a new payload for a given username, userType, duration and tokenType.
+func NewPayload(userID int32, username, email, userType string, duration time.Duration, tokenType TokenType) (*Payload, error) {
 	tokenID, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
@@ -39,6 +49,7 @@ func NewPayload(userID int32, username, email, userType string, duration time.Du
 		Email:     email,
 		Username:  username,
 		UserType:  userType,
+		TokenType: tokenType,
 		IssuedAt:  time.Now(),
 		ExpiredAt: time.Now().Add(duration),
 	}
@@ -0,0 +1,162 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrRevokedToken = errors.New("token has been revoked")
+
+// RevocationList invalidates access tokens before they expire: one token by its ID, or every
+// token of a user issued before a point in time (the user's token epoch).
+type RevocationList interface {
+	// RevokeToken revokes one token until it expires.
+	RevokeToken(ctx context.Context, payload *Payload) error
+	// RevokeUserTokens revokes every token issued to the user so far.
+	RevokeUserTokens(ctx context.Context, userID int32) error
+	// IsRevoked reports whether the token was revoked either way.
+	IsRevoked(ctx context.Context, payload *Payload) (bool, error)
+}
+
+// NewRevocationList keeps revocations in Redis so every replica sees them. Without a Redis
+// client they are kept in process, which only suits a single replica. maxTokenDuration is the
+// longest lifetime of the tokens checked; a user's epoch is dropped once all older tokens expired.
+func NewRevocationList(client *redis.Client, maxTokenDuration time.Duration) RevocationList {
+	if client == nil {
+		return NewMemoryRevocationList(maxTokenDuration)
+	}
+	return &RedisRevocationList{client: client, maxTokenDuration: maxTokenDuration}
+}
+
+type RedisRevocationList struct {
+	client           *redis.Client
+	maxTokenDuration time.Duration
+}
+
+func revokedTokenKey(payload *Payload) string {
+	return "auth:revoked_token:" + payload.ID.String()
+}
+
+func tokenEpochKey(userID int32) string {
+	return fmt.Sprintf("auth:token_epoch:%d", userID)
+}
+
+func (list *RedisRevocationList) RevokeToken(ctx context.Context, payload *Payload) error {
+	ttl := time.Until(payload.ExpiredAt)
+	if ttl <= 0 {
+		return nil
+	}
+	return list.client.Set(ctx, revokedTokenKey(payload), 1, ttl).Err()
+}
+
+func (list *RedisRevocationList) RevokeUserTokens(ctx context.Context, userID int32) error {
+	return list.client.Set(ctx, tokenEpochKey(userID), time.Now().UnixNano(), list.maxTokenDuration).Err()
+}
+
+func (list *RedisRevocationList) IsRevoked(ctx context.Context, payload *Payload) (bool, error) {
+	values, err := list.client.MGet(ctx, revokedTokenKey(payload), tokenEpochKey(payload.UserID)).Result()
+	if err != nil {
+		return false, err
+	}
+	if values[0] != nil {
+		return true, nil
+	}
+
+	epoch, ok := values[1].(string)
+	if !ok {
+		return false, nil
+	}
+	epochNanos, err := strconv.ParseInt(epoch, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("parse token epoch of user %d: %w", payload.UserID, err)
+	}
+	return payload.IssuedAt.UnixNano() <= epochNanos, nil
+}
+
+// MemoryRevocationList keeps revocations in process.
+type MemoryRevocationList struct {
+	maxTokenDuration time.Duration
+
+	mu     sync.Mutex
+	tokens map[string]time.Time
+	epochs map[int32]time.Time
+}
+
+func NewMemoryRevocationList(maxTokenDuration time.Duration) *MemoryRevocationList {
+	return &MemoryRevocationList{
+		maxTokenDuration: maxTokenDuration,
+		tokens:           make(map[string]time.Time),
+		epochs:           make(map[int32]time.Time),
+	}
+}
+
+func (list *MemoryRevocationList) RevokeToken(ctx context.Context, payload *Payload) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	list.prune()
+	list.tokens[payload.ID.String()] = payload.ExpiredAt
+	return nil
+}
+
+func (list *MemoryRevocationList) RevokeUserTokens(ctx context.Context, userID int32) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	list.prune()
+	list.epochs[userID] = time.Now()
+	return nil
+}
+
+func (list *MemoryRevocationList) IsRevoked(ctx context.Context, payload *Payload) (bool, error) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	if _, ok := list.tokens[payload.ID.String()]; ok {
+		return true, nil
+	}
+	epoch, ok := list.epochs[payload.UserID]
+	return ok && !payload.IssuedAt.After(epoch), nil
+}
+
+// prune drops revocations of tokens that expired anyway; callers hold mu.
+func (list *MemoryRevocationList) prune() {
+	now := time.Now()
+	for id, expiredAt := range list.tokens {
+		if now.After(expiredAt) {
+			delete(list.tokens, id)
+		}
+	}
+	for userID, epoch := range list.epochs {
+		if now.After(epoch.Add(list.maxTokenDuration)) {
+			delete(list.epochs, userID)
+		}
+	}
+}
+
+// VerifyAccessToken verifies an access token and rejects it when it was revoked. When the
+// revocation list cannot be read the token is accepted, like the rate limiter and quota fail
+// open, so a Redis outage does not sign everyone out.
+func VerifyAccessToken(ctx context.Context, maker Maker, revocations RevocationList, accessToken string) (*Payload, error) {
+	payload, err := maker.VerifyToken(accessToken, TokenTypeAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := revocations.IsRevoked(ctx, payload)
+	if err != nil {
+		log.Warn().Err(err).Int32("user_id", payload.UserID).Msg("failed to check token revocation, accepting token")
+		return payload, nil
+	}
+	if revoked {
+		return nil, ErrRevokedToken
+	}
+	return payload, nil
+}
@@ -0,0 +1,94 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPasetoMaker(t *testing.T) Maker {
+	maker, err := NewPasetoMaker(string(make([]byte, SYMMETRIC_KEY_LENGTH)))
+	require.NoError(t, err)
+	return maker
+}
+
+func TestMemoryRevocationListRevokesOneToken(t *testing.T) {
+	maker := newTestPasetoMaker(t)
+	revocations := NewMemoryRevocationList(time.Minute)
+
+	revokedToken, revokedPayload, err := maker.CreateToken(1, "user", "user@example.com", "free", time.Minute, TokenTypeAccess)
+	require.NoError(t, err)
+	otherToken, _, err := maker.CreateToken(1, "user", "user@example.com", "free", time.Minute, TokenTypeAccess)
+	require.NoError(t, err)
+
+	require.NoError(t, revocations.RevokeToken(context.Background(), revokedPayload))
+
+	_, err = VerifyAccessToken(context.Background(), maker, revocations, revokedToken)
+	require.ErrorIs(t, err, ErrRevokedToken)
+
+	payload, err := VerifyAccessToken(context.Background(), maker, revocations, otherToken)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), payload.UserID)
+}
+
+func TestMemoryRevocationListRevokesUserTokensIssuedBefore(t *testing.T) {
+	maker := newTestPasetoMaker(t)
+	revocations := NewMemoryRevocationList(time.Minute)
+
+	oldToken, _, err := maker.CreateToken(1, "user", "user@example.com", "free", time.Minute, TokenTypeAccess)
+	require.NoError(t, err)
+	otherUserToken, _, err := maker.CreateToken(2, "other", "other@example.com", "free", time.Minute, TokenTypeAccess)
+	require.NoError(t, err)
+
+	require.NoError(t, revocations.RevokeUserTokens(context.Background(), 1))
+
+	newToken, _, err := maker.CreateToken(1, "user", "user@example.com", "premium", time.Minute, TokenTypeAccess)
+	require.NoError(t, err)
+
+	_, err = VerifyAccessToken(context.Background(), maker, revocations, oldToken)
+	require.ErrorIs(t, err, ErrRevokedToken)
+
+	_, err = VerifyAccessToken(context.Background(), maker, revocations, newToken)
+	require.NoError(t, err)
+
+	_, err = VerifyAccessToken(context.Background(), maker, revocations, otherUserToken)
+	require.NoError(t, err)
+}
+
+type failingRevocationList struct{}
+
+func (failingRevocationList) RevokeToken(ctx context.Context, payload *Payload) error {
+	return errors.New("redis: connection refused")
+}
+
+func (failingRevocationList) RevokeUserTokens(ctx context.Context, userID int32) error {
+	return errors.New("redis: connection refused")
+}
+
+func (failingRevocationList) IsRevoked(ctx context.Context, payload *Payload) (bool, error) {
+	return false, errors.New("redis: connection refused")
+}
+
+func TestVerifyAccessTokenFailsOpen(t *testing.T) {
+	maker := newTestPasetoMaker(t)
+
+	accessToken, _, err := maker.CreateToken(1, "user", "user@example.com", "free", time.Minute, TokenTypeAccess)
+	require.NoError(t, err)
+
+	payload, err := VerifyAccessToken(context.Background(), maker, failingRevocationList{}, accessToken)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), payload.UserID)
+}
+
+func TestVerifyAccessTokenRejectsRefreshToken(t *testing.T) {
+	maker := newTestPasetoMaker(t)
+
+	refreshToken, _, err := maker.CreateToken(1, "user", "user@example.com", "free", time.Hour, TokenTypeRefresh)
+	require.NoError(t, err)
+
+	_, err = VerifyAccessToken(context.Background(), maker, NewMemoryRevocationList(time.Minute), refreshToken)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
@@ -0,0 +1,62 @@
+package totp
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+const encryptionKeySize = 32
+
+var ErrInvalidCiphertext = errors.New("totp secret ciphertext is invalid")
+
+// SecretCipher encrypts TOTP secrets at rest with AES-256-GCM. Unlike passwords and API keys
+// they cannot be hashed, because the server needs them to compute codes.
+type SecretCipher struct {
+	aead cipher.AEAD
+}
+
+// NewSecretCipher takes the 32-character TOTP_ENCRYPTION_KEY.
+func NewSecretCipher(key string) (*SecretCipher, error) {
+	if len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("invalid key size: must be exactly %d characters", encryptionKeySize)
+	}
+
+	block, err := aes.NewCipher([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &SecretCipher{aead: aead}, nil
+}
+
+// Encrypt returns the base64 encoded nonce and ciphertext of secret.
+func (c *SecretCipher) Encrypt(secret string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := c.aead.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *SecretCipher) Decrypt(ciphertext string) (string, error) {
+	sealed, err := base64.RawStdEncoding.DecodeString(ciphertext)
+	if err != nil || len(sealed) < c.aead.NonceSize() {
+		return "", ErrInvalidCiphertext
+	}
+
+	nonce, sealed := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	secret, err := c.aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+	return string(secret), nil
+}
@@ -0,0 +1,135 @@
+package totp
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// RFC 6238 Appendix B: 8-digit codes of the ASCII seeds below with a 30 second step.
+func TestHOTPMatchesRFC6238Vectors(t *testing.T) {
+	seeds := map[string]struct {
+		key     []byte
+		newHash func() hash.Hash
+	}{
+		"SHA1":   {key: []byte("12345678901234567890"), newHash: sha1.New},
+		"SHA256": {key: []byte("12345678901234567890123456789012"), newHash: sha256.New},
+		"SHA512": {key: []byte("1234567890123456789012345678901234567890123456789012345678901234"), newHash: sha512.New},
+	}
+
+	testCases := []struct {
+		unix int64
+		mode string
+		code string
+	}{
+		{59, "SHA1", "94287082"},
+		{59, "SHA256", "46119246"},
+		{59, "SHA512", "90693936"},
+		{1111111109, "SHA1", "07081804"},
+		{1111111109, "SHA256", "68084774"},
+		{1111111109, "SHA512", "25091201"},
+		{1111111111, "SHA1", "14050471"},
+		{1111111111, "SHA256", "67062674"},
+		{1111111111, "SHA512", "99943326"},
+		{1234567890, "SHA1", "89005924"},
+		{1234567890, "SHA256", "91819424"},
+		{1234567890, "SHA512", "93441116"},
+		{2000000000, "SHA1", "69279037"},
+		{2000000000, "SHA256", "90698825"},
+		{2000000000, "SHA512", "38618901"},
+		{20000000000, "SHA1", "65353130"},
+		{20000000000, "SHA256", "77737706"},
+		{20000000000, "SHA512", "47863826"},
+	}
+
+	for _, tc := range testCases {
+		seed := seeds[tc.mode]
+		step := uint64(Step(time.Unix(tc.unix, 0)))
+
+		require.Equal(t, tc.code, hotp(seed.key, step, 8, seed.newHash), "%s at %d", tc.mode, tc.unix)
+	}
+}
+
+func TestGenerateCodeIsTheLastSixDigitsOfTheRFCVector(t *testing.T) {
+	secret := encoding.EncodeToString([]byte("12345678901234567890"))
+
+	code, err := GenerateCode(secret, time.Unix(1111111109, 0))
+	require.NoError(t, err)
+	require.Equal(t, "081804", code)
+}
+
+func TestValidateAcceptsNeighbouringSteps(t *testing.T) {
+	secret := encoding.EncodeToString([]byte("12345678901234567890"))
+	now := time.Unix(1234567890, 0)
+
+	code, err := GenerateCode(secret, now)
+	require.NoError(t, err)
+
+	step, ok := Validate(code, secret, now.Add(Period))
+	require.True(t, ok)
+	require.Equal(t, Step(now), step)
+
+	_, ok = Validate(code, secret, now.Add(2*Period))
+	require.False(t, ok)
+
+	_, ok = Validate("000000", secret, now)
+	require.False(t, ok)
+
+	_, ok = Validate("12345", secret, now)
+	require.False(t, ok)
+}
+
+func TestGenerateSecretRoundTrips(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	require.Len(t, secret, 32)
+
+	now := time.Now()
+	code, err := GenerateCode(secret, now)
+	require.NoError(t, err)
+
+	_, ok := Validate(code, secret, now)
+	require.True(t, ok)
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := ProvisioningURI("JBSWY3DPEHPK3PXP", "Rate Pulse", "admin@example.com")
+
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	require.Equal(t, "otpauth", parsed.Scheme)
+	require.Equal(t, "totp", parsed.Host)
+	require.Equal(t, "/Rate Pulse:admin@example.com", parsed.Path)
+	require.Equal(t, "JBSWY3DPEHPK3PXP", parsed.Query().Get("secret"))
+	require.Equal(t, "Rate Pulse", parsed.Query().Get("issuer"))
+	require.Equal(t, "6", parsed.Query().Get("digits"))
+	require.False(t, strings.Contains(uri, " "))
+}
+
+func TestSecretCipherRoundTrips(t *testing.T) {
+	secretCipher, err := NewSecretCipher(strings.Repeat("k", 32))
+	require.NoError(t, err)
+
+	ciphertext, err := secretCipher.Encrypt("JBSWY3DPEHPK3PXP")
+	require.NoError(t, err)
+	require.NotContains(t, ciphertext, "JBSWY3DPEHPK3PXP")
+
+	secret, err := secretCipher.Decrypt(ciphertext)
+	require.NoError(t, err)
+	require.Equal(t, "JBSWY3DPEHPK3PXP", secret)
+
+	otherCipher, err := NewSecretCipher(strings.Repeat("o", 32))
+	require.NoError(t, err)
+	_, err = otherCipher.Decrypt(ciphertext)
+	require.ErrorIs(t, err, ErrInvalidCiphertext)
+
+	_, err = NewSecretCipher("short")
+	require.Error(t, err)
+}
@@ -9,28 +9,38 @@ import (
 
 // Config represents the configuration for the application
 type Config struct {
-	DBDriver               string        `mapstructure:"DB_DRIVER"`
-	DBSource               string        `mapstructure:"DB_SOURCE"`
-	HTTPServerAddress      string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	GRPCServerAddress      string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	RedisAddress           string        `mapstructure:"REDIS_ADDRESS"`
-	RedisUsername          string        `mapstructure:"REDIS_USERNAME"`
-	RedisPassword          string        `mapstructure:"REDIS_PASSWORD"`
-	RedisTLS               bool          `mapstructure:"REDIS_TLS"`
-	TokenSymmetricKey      string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	AccessTokenDuration    time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration   time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
-	EmailSenderName        string        `mapstructure:"EMAIL_SENDER_NAME"`
-	EmailSenderAddress     string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
-	EmailSMTPHost          string        `mapstructure:"EMAIL_SMTP_HOST"`
-	EmailSMTPPort          int           `mapstructure:"EMAIL_SMTP_PORT"`
-	EmailSMTPUsername      string        `mapstructure:"EMAIL_SMTP_USERNAME"`
-	EmailSMTPPassword      string        `mapstructure:"EMAIL_SMTP_PASSWORD"`
-	FrontendVerifyEmailURL string        `mapstructure:"FRONTEND_VERIFY_EMAIL_URL"`
-	RateLimitPerMinute     int           `mapstructure:"RATE_LIMIT_PER_MINUTE"`
-	EnableHTTPServer       bool          `mapstructure:"ENABLE_HTTP_SERVER"`
-	EnableGRPCServer       bool          `mapstructure:"ENABLE_GRPC_SERVER"`
-	EnableTaskProcessor    bool          `mapstructure:"ENABLE_TASK_PROCESSOR"`
+	DBDriver                    string        `mapstructure:"DB_DRIVER"`
+	DBSource                    string        `mapstructure:"DB_SOURCE"`
+	HTTPServerAddress           string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	GRPCServerAddress           string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	RedisAddress                string        `mapstructure:"REDIS_ADDRESS"`
+	RedisUsername               string        `mapstructure:"REDIS_USERNAME"`
+	RedisPassword               string        `mapstructure:"REDIS_PASSWORD"`
+	RedisTLS                    bool          `mapstructure:"REDIS_TLS"`
+	TokenSymmetricKey           string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	TOTPEncryptionKey           string        `mapstructure:"TOTP_ENCRYPTION_KEY"`
+	AccessTokenDuration         time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration        time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	EmailSenderName             string        `mapstructure:"EMAIL_SENDER_NAME"`
+	EmailSenderAddress          string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
+	EmailSMTPHost               string        `mapstructure:"EMAIL_SMTP_HOST"`
+	EmailSMTPPort               int           `mapstructure:"EMAIL_SMTP_PORT"`
+	EmailSMTPUsername           string        `mapstructure:"EMAIL_SMTP_USERNAME"`
+	EmailSMTPPassword           string        `mapstructure:"EMAIL_SMTP_PASSWORD"`
+	FrontendVerifyEmailURL      string        `mapstructure:"FRONTEND_VERIFY_EMAIL_URL"`
+	FrontendResetPasswordURL    string        `mapstructure:"FRONTEND_RESET_PASSWORD_URL"`
+	AllowUnverifiedSignIn       bool          `mapstructure:"ALLOW_UNVERIFIED_SIGN_IN"`
+	RateLimitPerMinute          int           `mapstructure:"RATE_LIMIT_PER_MINUTE"`
+	RateLimitAlgorithm          string        `mapstructure:"RATE_LIMIT_ALGORITHM"`
+	FreeRateLimitPerDay         int32         `mapstructure:"FREE_RATE_LIMIT_PER_DAY"`
+	FreeHistoricalDays          int32         `mapstructure:"FREE_HISTORICAL_DAYS"`
+	RateAlertInterval           time.Duration `mapstructure:"RATE_ALERT_INTERVAL"`
+	RateSourceFreshnessInterval time.Duration `mapstructure:"RATE_SOURCE_FRESHNESS_INTERVAL"`
+	RateAnomalyZScore           float64       `mapstructure:"RATE_ANOMALY_Z_SCORE"`
+	RateAnomalyPercentBand      float64       `mapstructure:"RATE_ANOMALY_PERCENT_BAND"`
+	EnableHTTPServer            bool          `mapstructure:"ENABLE_HTTP_SERVER"`
+	EnableGRPCServer            bool          `mapstructure:"ENABLE_GRPC_SERVER"`
+	EnableTaskProcessor         bool          `mapstructure:"ENABLE_TASK_PROCESSOR"`
 }
 
 // LoadConfig loads the configuration from the environment variables
@@ -50,6 +60,7 @@ func LoadConfig(path string) (config Config, err error) {
 	viper.BindEnv("REDIS_PASSWORD")
 	viper.BindEnv("REDIS_TLS")
 	viper.BindEnv("TOKEN_SYMMETRIC_KEY")
+	viper.BindEnv("TOTP_ENCRYPTION_KEY")
 	viper.BindEnv("ACCESS_TOKEN_DURATION")
 	viper.BindEnv("REFRESH_TOKEN_DURATION")
 	viper.BindEnv("EMAIL_SENDER_NAME")
@@ -59,7 +70,16 @@ func LoadConfig(path string) (config Config, err error) {
 	viper.BindEnv("EMAIL_SMTP_USERNAME")
 	viper.BindEnv("EMAIL_SMTP_PASSWORD")
 	viper.BindEnv("FRONTEND_VERIFY_EMAIL_URL")
+	viper.BindEnv("FRONTEND_RESET_PASSWORD_URL")
+	viper.BindEnv("ALLOW_UNVERIFIED_SIGN_IN")
 	viper.BindEnv("RATE_LIMIT_PER_MINUTE")
+	viper.BindEnv("RATE_LIMIT_ALGORITHM")
+	viper.BindEnv("FREE_RATE_LIMIT_PER_DAY")
+	viper.BindEnv("FREE_HISTORICAL_DAYS")
+	viper.BindEnv("RATE_ALERT_INTERVAL")
+	viper.BindEnv("RATE_SOURCE_FRESHNESS_INTERVAL")
+	viper.BindEnv("RATE_ANOMALY_Z_SCORE")
+	viper.BindEnv("RATE_ANOMALY_PERCENT_BAND")
 	viper.BindEnv("ENABLE_HTTP_SERVER")
 	viper.BindEnv("ENABLE_GRPC_SERVER")
 	viper.BindEnv("ENABLE_TASK_PROCESSOR")
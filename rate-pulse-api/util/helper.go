@@ -40,3 +40,15 @@ func ParseTimeRangeToDuration(timeRange string) (time.Duration, error) {
 	}
 	return duration, nil
 }
+
+// ParseTimeBound parses an RFC3339 timestamp or a YYYY-MM-DD date. Dates are read
+// as midnight in loc and reported with dateOnly so callers can treat an end date as inclusive.
+func ParseTimeBound(value string, loc *time.Location) (t time.Time, dateOnly bool, err error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, loc); err == nil {
+		return t, true, nil
+	}
+	return time.Time{}, false, fmt.Errorf("invalid time %q. Use RFC3339 or YYYY-MM-DD", value)
+}
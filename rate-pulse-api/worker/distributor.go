@@ -12,6 +12,11 @@ type TaskDistributor interface {
 		payload *PayloadSendVerifyEmail,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskSendPasswordResetEmail(
+		ctx context.Context,
+		payload *PayloadSendPasswordResetEmail,
+		opts ...asynq.Option,
+	) error
 }
 
 type RedisTaskDistributor struct {
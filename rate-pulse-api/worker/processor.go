@@ -20,6 +20,9 @@ const (
 type TaskProcessor interface {
 	Start() error // Register task handlers before processing async tasks
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendPasswordResetEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskEvaluateRateAlerts(ctx context.Context, task *asynq.Task) error
+	ProcessTaskCheckRateSourceFreshness(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
@@ -45,7 +48,8 @@ func NewRedisTaskProcessor(
 			JanitorBatchSize:         10,
 			HealthCheckInterval:      time.Hour,
 			Queues: map[string]int{
-				QueueCritical: 1,
+				QueueCritical: 2,
+				QueueDefault:  1,
 			},
 			ErrorHandler: asynq.ErrorHandlerFunc(func(ctx context.Context, task *asynq.Task, err error) {
 				log.Error().Err(err).Str("type", task.Type()).
@@ -67,6 +71,9 @@ func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
 
 	mux.HandleFunc(TaskSendVerifyEmail, processor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskSendPasswordResetEmail, processor.ProcessTaskSendPasswordResetEmail)
+	mux.HandleFunc(TaskEvaluateRateAlerts, processor.ProcessTaskEvaluateRateAlerts)
+	mux.HandleFunc(TaskCheckRateSourceFreshness, processor.ProcessTaskCheckRateSourceFreshness)
 
 	return processor.server.Start(mux)
 }
@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ThanhVinhTong/rate-pulse/util"
+	"github.com/hibiken/asynq"
+)
+
+const (
+	defaultRateAlertInterval           = 5 * time.Minute
+	defaultRateSourceFreshnessInterval = 15 * time.Minute
+)
+
+// NewTaskScheduler registers the periodic tasks that keep derived data up to date.
+// Every instance may run a scheduler; asynq.Unique keeps them from enqueuing the same tick twice.
+func NewTaskScheduler(redisOpt asynq.RedisClientOpt, config util.Config) (*asynq.Scheduler, error) {
+	scheduler := asynq.NewScheduler(redisOpt, &asynq.SchedulerOpts{
+		Location: time.UTC,
+		Logger:   NewLogger(),
+	})
+
+	interval := config.RateAlertInterval
+	if interval <= 0 {
+		interval = defaultRateAlertInterval
+	}
+	_, err := scheduler.Register(
+		fmt.Sprintf("@every %s", interval),
+		asynq.NewTask(TaskEvaluateRateAlerts, nil),
+		asynq.Queue(QueueDefault),
+		asynq.MaxRetry(3),
+		asynq.Unique(interval),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register %s: %w", TaskEvaluateRateAlerts, err)
+	}
+
+	freshnessInterval := config.RateSourceFreshnessInterval
+	if freshnessInterval <= 0 {
+		freshnessInterval = defaultRateSourceFreshnessInterval
+	}
+	_, err = scheduler.Register(
+		fmt.Sprintf("@every %s", freshnessInterval),
+		asynq.NewTask(TaskCheckRateSourceFreshness, nil),
+		asynq.Queue(QueueDefault),
+		asynq.MaxRetry(3),
+		asynq.Unique(freshnessInterval),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register %s: %w", TaskCheckRateSourceFreshness, err)
+	}
+
+	return scheduler, nil
+}
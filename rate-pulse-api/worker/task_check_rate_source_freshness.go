@@ -0,0 +1,110 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskCheckRateSourceFreshness = "task:check_rate_source_freshness"
+
+func (processor *RedisTaskProcessor) ProcessTaskCheckRateSourceFreshness(
+	ctx context.Context,
+	task *asynq.Task,
+) error {
+	sources, err := processor.store.ListRateSourceFreshness(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list rate source freshness: %w", err)
+	}
+
+	now := time.Now().UTC()
+	var stale []db.ListRateSourceFreshnessRow
+	for _, source := range sources {
+		if needsStaleNotification(source, now) {
+			stale = append(stale, source)
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+
+	admins, err := processor.store.ListActiveAdminEmails(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list admin emails: %w", err)
+	}
+	if len(admins) == 0 {
+		log.Warn().Str("type", task.Type()).Int("stale_sources", len(stale)).
+			Msg("no active admin to notify about stale rate sources")
+		return nil
+	}
+
+	if err := processor.sendStaleRateSourcesEmail(stale, admins, now); err != nil {
+		return err
+	}
+
+	for _, source := range stale {
+		err := processor.store.MarkRateSourceStaleNotified(ctx, db.MarkRateSourceStaleNotifiedParams{
+			SourceID:        source.SourceID,
+			StaleNotifiedAt: sql.NullTime{Time: now, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to mark rate source %d as notified: %w", source.SourceID, err)
+		}
+	}
+
+	log.Info().Str("type", task.Type()).Int("stale_sources", len(stale)).
+		Int("admins", len(admins)).Msg("notified admins about stale rate sources")
+	return nil
+}
+
+/*
+needsStaleNotification decides whether admins should hear about a source now.
+- Only active sources that are past their stale_at are reported
+- A source is reported once per outage: stale_at moves forward when a new rate arrives, so a notification sent before it belongs to an earlier outage
+*/
+func needsStaleNotification(source db.ListRateSourceFreshnessRow, now time.Time) bool {
+	if !strings.EqualFold(strings.TrimSpace(source.SourceStatus.String), "active") {
+		return false
+	}
+	if now.Before(source.StaleAt) {
+		return false
+	}
+	return !source.StaleNotifiedAt.Valid || source.StaleNotifiedAt.Time.Before(source.StaleAt)
+}
+
+func (processor *RedisTaskProcessor) sendStaleRateSourcesEmail(
+	sources []db.ListRateSourceFreshnessRow,
+	admins []string,
+	now time.Time,
+) error {
+	var lines strings.Builder
+	for _, source := range sources {
+		lastIngested := "never"
+		if source.LastIngestedAt.Valid {
+			lastIngested = source.LastIngestedAt.Time.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(&lines, "%s (%s): last rate %s, expected every %d minutes<br/>\n",
+			html.EscapeString(source.SourceName), html.EscapeString(source.SourceCode.String),
+			lastIngested, source.ExpectedIntervalMinutes)
+	}
+
+	subject := fmt.Sprintf("Rate Pulse: %d rate source(s) stopped publishing", len(sources))
+	content := fmt.Sprintf(`Hello,<br/>
+	The following rate sources have missed their expected update window as of %s.<br/>
+	Their last rates are still being served as the latest.<br/>
+	%s
+	`, now.Format(time.RFC3339), lines.String())
+
+	err := processor.emailSender.SendEmail(subject, content, admins, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send stale rate sources email: %w", err)
+	}
+	return nil
+}
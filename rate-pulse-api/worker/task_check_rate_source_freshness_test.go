@@ -0,0 +1,38 @@
+package worker
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNeedsStaleNotification(t *testing.T) {
+	now := time.Date(2026, 5, 12, 12, 0, 0, 0, time.UTC)
+	source := db.ListRateSourceFreshnessRow{
+		SourceID:     1,
+		SourceStatus: sql.NullString{String: "active", Valid: true},
+		StaleAt:      now.Add(-time.Hour),
+	}
+
+	require.True(t, needsStaleNotification(source, now))
+
+	// Not yet past its window.
+	fresh := source
+	fresh.StaleAt = now.Add(time.Minute)
+	require.False(t, needsStaleNotification(fresh, now))
+
+	// Already notified during this outage.
+	source.StaleNotifiedAt = sql.NullTime{Time: now.Add(-30 * time.Minute), Valid: true}
+	require.False(t, needsStaleNotification(source, now))
+
+	// The notification belongs to an earlier outage: the source recovered and went quiet again.
+	source.StaleNotifiedAt = sql.NullTime{Time: now.Add(-48 * time.Hour), Valid: true}
+	require.True(t, needsStaleNotification(source, now))
+
+	// Inactive sources are not monitored.
+	source.SourceStatus = sql.NullString{String: "inactive", Valid: true}
+	require.False(t, needsStaleNotification(source, now))
+}
@@ -0,0 +1,233 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskEvaluateRateAlerts = "task:evaluate_rate_alerts"
+
+const (
+	rateAlertConditionAbove         = "above"
+	rateAlertConditionBelow         = "below"
+	rateAlertConditionPercentChange = "percent_change"
+)
+
+// rateAlertEvaluation is the outcome of checking one alert against the latest rate.
+type rateAlertEvaluation struct {
+	Triggered     bool
+	ChangePercent float64
+}
+
+/*
+ProcessTaskEvaluateRateAlerts checks every active alert against the latest rate.
+- An alert that fails is logged and skipped, so one bad alert does not hold back the rest
+- An alert whose evaluation was not saved is picked up again by the next scheduled run
+*/
+func (processor *RedisTaskProcessor) ProcessTaskEvaluateRateAlerts(
+	ctx context.Context,
+	task *asynq.Task,
+) error {
+	alerts, err := processor.store.ListActiveRateAlerts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list active rate alerts: %w", err)
+	}
+
+	triggered, failed := 0, 0
+	for _, alert := range alerts {
+		fired, err := processor.evaluateRateAlert(ctx, alert, time.Now().UTC())
+		if fired {
+			triggered++
+		}
+		if err != nil {
+			failed++
+			log.Error().Err(err).Int32("alert_id", alert.AlertID).Msg("failed to evaluate rate alert")
+		}
+	}
+
+	log.Info().Str("type", task.Type()).Int("alerts", len(alerts)).
+		Int("triggered", triggered).Int("failed", failed).Msg("evaluated rate alerts")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) evaluateRateAlert(ctx context.Context, alert db.RateAlert, now time.Time) (bool, error) {
+	latest, err := processor.store.GetLatestExchangeRateForPair(ctx, db.GetLatestExchangeRateForPairParams{
+		SourceID:  sql.NullInt32{Int32: alert.SourceID, Valid: true},
+		TypeID:    sql.NullInt32{Int32: alert.TypeID, Valid: true},
+		CurrencyA: alert.FromCurrencyID,
+		CurrencyB: alert.ToCurrencyID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get latest exchange rate: %w", err)
+	}
+	if alert.LastRateID.Valid && alert.LastRateID.Int32 == latest.RateID {
+		// Nothing new was inserted for this pair since the last run.
+		return false, nil
+	}
+
+	current, err := latest.RateIn(alert.ToCurrencyID)
+	if err != nil {
+		return false, err
+	}
+
+	var baseline *float64
+	if alert.Condition == rateAlertConditionPercentChange {
+		reference := now
+		if latest.UpdatedAt.Valid {
+			reference = latest.UpdatedAt.Time
+		}
+		windowStart := reference.Add(-time.Duration(alert.WindowHours) * time.Hour)
+		previous, err := processor.store.GetExchangeRateForPairAsOf(ctx, db.GetExchangeRateForPairAsOfParams{
+			SourceID:  sql.NullInt32{Int32: alert.SourceID, Valid: true},
+			TypeID:    sql.NullInt32{Int32: alert.TypeID, Valid: true},
+			CurrencyA: alert.FromCurrencyID,
+			CurrencyB: alert.ToCurrencyID,
+			AsOf:      sql.NullTime{Time: windowStart, Valid: true},
+		})
+		switch {
+		case err == nil:
+			value, err := previous.RateIn(alert.ToCurrencyID)
+			if err != nil {
+				return false, err
+			}
+			baseline = &value
+		case !errors.Is(err, sql.ErrNoRows):
+			return false, fmt.Errorf("failed to get baseline exchange rate: %w", err)
+		}
+	}
+
+	evaluation := evaluateRateAlertCondition(alert, current, baseline, now)
+
+	// Save the evaluation before sending, so a failing email neither blocks the state
+	// update nor fires the same crossing again on the next run.
+	arg := db.UpdateRateAlertEvaluationParams{
+		LastRateID:    sql.NullInt32{Int32: latest.RateID, Valid: true},
+		LastRateValue: sql.NullString{String: strconv.FormatFloat(current, 'f', -1, 64), Valid: true},
+		AlertID:       alert.AlertID,
+	}
+	if evaluation.Triggered {
+		arg.LastTriggeredAt = sql.NullTime{Time: now, Valid: true}
+	}
+	if err := processor.store.UpdateRateAlertEvaluation(ctx, arg); err != nil {
+		return false, fmt.Errorf("failed to save rate alert evaluation: %w", err)
+	}
+
+	if evaluation.Triggered {
+		if err := processor.sendRateAlertEmail(ctx, alert, current, evaluation); err != nil {
+			return true, err
+		}
+	}
+
+	return evaluation.Triggered, nil
+}
+
+/*
+evaluateRateAlertCondition decides whether an alert fires for the current rate.
+- above/below fire when the rate crosses the threshold, not on every row beyond it
+- percent_change compares against the rate at the start of the window and fires at most once per window
+*/
+func evaluateRateAlertCondition(alert db.RateAlert, current float64, baseline *float64, now time.Time) rateAlertEvaluation {
+	switch alert.Condition {
+	case rateAlertConditionAbove, rateAlertConditionBelow:
+		threshold, ok := parseAlertDecimal(alert.Threshold)
+		if !ok {
+			return rateAlertEvaluation{}
+		}
+		previous, hasPrevious := parseAlertDecimal(alert.LastRateValue)
+		if alert.Condition == rateAlertConditionAbove {
+			return rateAlertEvaluation{Triggered: current >= threshold && (!hasPrevious || previous < threshold)}
+		}
+		return rateAlertEvaluation{Triggered: current <= threshold && (!hasPrevious || previous > threshold)}
+	case rateAlertConditionPercentChange:
+		changePercent, ok := parseAlertDecimal(alert.ChangePercent)
+		if !ok || baseline == nil || *baseline <= 0 {
+			return rateAlertEvaluation{}
+		}
+		change := (current - *baseline) / *baseline * 100
+		window := time.Duration(alert.WindowHours) * time.Hour
+		cooledDown := !alert.LastTriggeredAt.Valid || now.Sub(alert.LastTriggeredAt.Time) >= window
+		return rateAlertEvaluation{
+			Triggered:     math.Abs(change) >= changePercent && cooledDown,
+			ChangePercent: change,
+		}
+	}
+	return rateAlertEvaluation{}
+}
+
+func (processor *RedisTaskProcessor) sendRateAlertEmail(
+	ctx context.Context,
+	alert db.RateAlert,
+	current float64,
+	evaluation rateAlertEvaluation,
+) error {
+	user, err := processor.store.GetUserByID(ctx, alert.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.IsActive.Valid && !user.IsActive.Bool {
+		return nil
+	}
+
+	pair := fmt.Sprintf("%d/%d", alert.FromCurrencyID, alert.ToCurrencyID)
+	if from, err := processor.store.GetCurrencyByID(ctx, alert.FromCurrencyID); err == nil {
+		if to, err := processor.store.GetCurrencyByID(ctx, alert.ToCurrencyID); err == nil {
+			pair = from.CurrencyCode + "/" + to.CurrencyCode
+		}
+	}
+	source := fmt.Sprintf("source %d", alert.SourceID)
+	if rateSource, err := processor.store.GetRateSourceByID(ctx, alert.SourceID); err == nil {
+		source = rateSource.SourceName
+	}
+
+	subject := fmt.Sprintf("Rate alert: %s %s", source, pair)
+	content := fmt.Sprintf(`Hello %s,<br/>
+	Your rate alert for %s at %s was triggered.<br/>
+	%s<br/>
+	Current rate: %s<br/>
+	`, user.Username, pair, source, describeRateAlert(alert, evaluation), strconv.FormatFloat(current, 'f', -1, 64))
+
+	err = processor.emailSender.SendEmail(subject, content, []string{user.Email}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send rate alert email: %w", err)
+	}
+
+	log.Info().Int32("alert_id", alert.AlertID).Int32("user_id", user.UserID).
+		Str("condition", alert.Condition).Msg("sent rate alert email")
+	return nil
+}
+
+func describeRateAlert(alert db.RateAlert, evaluation rateAlertEvaluation) string {
+	switch alert.Condition {
+	case rateAlertConditionAbove:
+		return "The rate rose above " + alert.Threshold.String + "."
+	case rateAlertConditionBelow:
+		return "The rate fell below " + alert.Threshold.String + "."
+	default:
+		return fmt.Sprintf("The rate moved %.2f%% in %dh (alert at %s%%).",
+			evaluation.ChangePercent, alert.WindowHours, alert.ChangePercent.String)
+	}
+}
+
+func parseAlertDecimal(value sql.NullString) (float64, bool) {
+	if !value.Valid {
+		return 0, false
+	}
+	parsed, err := strconv.ParseFloat(strings.TrimSpace(value.String), 64)
+	if err != nil {
+		return 0, false
+	}
+	return parsed, true
+}
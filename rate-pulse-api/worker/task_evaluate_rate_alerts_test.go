@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateRateAlertConditionThresholdCrossing(t *testing.T) {
+	now := time.Date(2026, 5, 12, 12, 0, 0, 0, time.UTC)
+	alert := db.RateAlert{
+		Condition: rateAlertConditionAbove,
+		Threshold: sql.NullString{String: "25800", Valid: true},
+	}
+
+	// First evaluation already beyond the threshold fires once.
+	require.True(t, evaluateRateAlertCondition(alert, 25810, nil, now).Triggered)
+
+	// Staying above the threshold does not fire again.
+	alert.LastRateValue = sql.NullString{String: "25810", Valid: true}
+	require.False(t, evaluateRateAlertCondition(alert, 25820, nil, now).Triggered)
+
+	// Dropping back below and crossing again fires.
+	alert.LastRateValue = sql.NullString{String: "25790", Valid: true}
+	require.True(t, evaluateRateAlertCondition(alert, 25800, nil, now).Triggered)
+	require.False(t, evaluateRateAlertCondition(alert, 25795, nil, now).Triggered)
+
+	alert.Condition = rateAlertConditionBelow
+	alert.LastRateValue = sql.NullString{String: "25810", Valid: true}
+	require.True(t, evaluateRateAlertCondition(alert, 25790, nil, now).Triggered)
+	require.False(t, evaluateRateAlertCondition(alert, 25805, nil, now).Triggered)
+}
+
+func TestEvaluateRateAlertConditionPercentChange(t *testing.T) {
+	now := time.Date(2026, 5, 12, 12, 0, 0, 0, time.UTC)
+	baseline := 25000.0
+	alert := db.RateAlert{
+		Condition:     rateAlertConditionPercentChange,
+		ChangePercent: sql.NullString{String: "0.5", Valid: true},
+		WindowHours:   24,
+	}
+
+	evaluation := evaluateRateAlertCondition(alert, 24870, &baseline, now)
+	require.True(t, evaluation.Triggered)
+	require.InDelta(t, -0.52, evaluation.ChangePercent, 1e-9)
+
+	require.False(t, evaluateRateAlertCondition(alert, 25100, &baseline, now).Triggered)
+	require.False(t, evaluateRateAlertCondition(alert, 25200, nil, now).Triggered)
+
+	// Fires at most once per window.
+	alert.LastTriggeredAt = sql.NullTime{Time: now.Add(-2 * time.Hour), Valid: true}
+	require.False(t, evaluateRateAlertCondition(alert, 25200, &baseline, now).Triggered)
+	alert.LastTriggeredAt = sql.NullTime{Time: now.Add(-25 * time.Hour), Valid: true}
+	require.True(t, evaluateRateAlertCondition(alert, 25200, &baseline, now).Triggered)
+}
+
+func TestProcessTaskEvaluateRateAlertsContinuesPastFailures(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	processor := &RedisTaskProcessor{store: db.NewStore(sqlDB)}
+	now := time.Now().UTC()
+
+	alertRows := sqlmock.NewRows([]string{
+		"alert_id", "user_id", "source_id", "type_id", "from_currency_id", "to_currency_id",
+		"condition", "threshold", "change_percent", "window_hours", "is_active",
+		"last_rate_id", "last_rate_value", "last_triggered_at", "created_at", "updated_at",
+	})
+	for _, alertID := range []int32{1, 2} {
+		alertRows.AddRow(alertID, int32(9), int32(10), int32(4), int32(2), int32(1),
+			rateAlertConditionAbove, "25800", nil, int32(24), true, nil, nil, nil, now, now)
+	}
+	mock.ExpectQuery("FROM rate_alerts").WillReturnRows(alertRows)
+
+	// The first alert cannot read its rate and is skipped.
+	mock.ExpectQuery("FROM exchange_rates").WillReturnError(errors.New("connection reset"))
+
+	// The second alert fires: its evaluation is saved even though the email cannot be sent.
+	mock.ExpectQuery("FROM exchange_rates").WillReturnRows(sqlmock.NewRows([]string{
+		"rate_id", "rate_value", "source_currency_id", "destination_currency_id", "valid_from_date",
+		"valid_to_date", "source_id", "updated_at", "created_at", "type_id", "status", "quarantine_reason",
+	}).AddRow(int32(77), "26000", int32(1), int32(2), now, nil, int32(10), now, now, int32(4), "active", nil))
+	mock.ExpectExec("UPDATE rate_alerts").
+		WithArgs(int32(77), "26000", sqlmock.AnyArg(), int32(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("FROM users").WillReturnError(sql.ErrConnDone)
+
+	err = processor.ProcessTaskEvaluateRateAlerts(context.Background(), asynq.NewTask(TaskEvaluateRateAlerts, nil))
+
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
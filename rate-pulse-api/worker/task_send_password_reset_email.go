@@ -0,0 +1,111 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	db "github.com/ThanhVinhTong/rate-pulse/db/sqlc"
+	"github.com/ThanhVinhTong/rate-pulse/util"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskSendPasswordResetEmail = "task:send_password_reset_email"
+
+type PayloadSendPasswordResetEmail struct {
+	UserId int32 `json:"user_id"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendPasswordResetEmail(
+	ctx context.Context,
+	payload *PayloadSendPasswordResetEmail,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendPasswordResetEmail, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendPasswordResetEmail(
+	ctx context.Context,
+	task *asynq.Task,
+) error {
+	var payload PayloadSendPasswordResetEmail
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal task payload: %w", asynq.SkipRetry)
+	}
+
+	user, err := processor.store.GetUserByID(ctx, payload.UserId)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	secretCode, err := newVerifyEmailSecret()
+	if err != nil {
+		return fmt.Errorf("failed to generate password reset secret: %w", err)
+	}
+
+	secretCodeHash, err := util.HashPassword(secretCode)
+	if err != nil {
+		return fmt.Errorf("failed to generate hashed secret: %w", err)
+	}
+
+	passwordReset, err := processor.store.CreatePasswordReset(ctx, db.CreatePasswordResetParams{
+		UserID:         user.UserID,
+		Email:          user.Email,
+		SecretCodeHash: secretCodeHash,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create password reset: %w", err)
+	}
+
+	subject := "Reset your Rate Pulse password"
+	resetUrl := buildResetPasswordURL(processor.config.FrontendResetPasswordURL, passwordReset.ID, secretCode)
+	content := fmt.Sprintf(`Hello %s,<br/>
+	We received a request to reset your password.<br/>
+	Please <a href="%s">click here</a> to choose a new one. The link expires in 30 minutes.<br/>
+	If you did not ask for a reset, you can ignore this email.<br/>
+	`, user.Username, resetUrl)
+	to := []string{user.Email}
+
+	err = processor.emailSender.SendEmail(
+		subject,
+		content,
+		to,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send password reset email: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Int32("user_id", user.UserID).
+		Str("email", user.Email).Msg("sent password reset email")
+
+	return nil
+}
+
+func buildResetPasswordURL(baseURL string, resetID int64, secretCode string) string {
+	if strings.TrimSpace(baseURL) == "" {
+		baseURL = "https://rate-pulse.me/reset_password"
+	}
+
+	baseURL = strings.TrimRight(baseURL, "?&")
+	return fmt.Sprintf("%s?reset_id=%d&secret_code=%s", baseURL, resetID, url.QueryEscape(secretCode))
+}
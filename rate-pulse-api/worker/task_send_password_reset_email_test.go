@@ -0,0 +1,25 @@
+package worker
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildResetPasswordURL(t *testing.T) {
+	resetURL := buildResetPasswordURL("https://rate-pulse.me/reset_password?", 42, "secret code+/")
+
+	parsedURL, err := url.Parse(resetURL)
+	require.NoError(t, err)
+	require.Equal(t, "rate-pulse.me", parsedURL.Host)
+	require.Equal(t, "/reset_password", parsedURL.Path)
+	require.Equal(t, "42", parsedURL.Query().Get("reset_id"))
+	require.Equal(t, "secret code+/", parsedURL.Query().Get("secret_code"))
+}
+
+func TestBuildResetPasswordURLUsesProductionFallback(t *testing.T) {
+	resetURL := buildResetPasswordURL("", 42, "secret")
+
+	require.Equal(t, "https://rate-pulse.me/reset_password?reset_id=42&secret_code=secret", resetURL)
+}